@@ -2,6 +2,7 @@ package app
 
 import (
 	"io"
+	"sync"
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -13,26 +14,44 @@ import (
 	tmos "github.com/tendermint/tendermint/libs/os"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/KuChainNetwork/kuchain/app/keepers"
 	"github.com/KuChainNetwork/kuchain/chain/ante"
 	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
 	"github.com/KuChainNetwork/kuchain/chain/constants"
 	"github.com/KuChainNetwork/kuchain/chain/fee"
+	"github.com/KuChainNetwork/kuchain/chain/querycache"
+	"github.com/KuChainNetwork/kuchain/chain/sdkcompat"
+	"github.com/KuChainNetwork/kuchain/chain/sigcache"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/plugins"
 	"github.com/KuChainNetwork/kuchain/test/simapp"
 	"github.com/KuChainNetwork/kuchain/x/account"
 	"github.com/KuChainNetwork/kuchain/x/asset"
+	assetclient "github.com/KuChainNetwork/kuchain/x/asset/client"
+	"github.com/KuChainNetwork/kuchain/x/attestation"
+	"github.com/KuChainNetwork/kuchain/x/conversion"
+	conversionclient "github.com/KuChainNetwork/kuchain/x/conversion/client"
 	distr "github.com/KuChainNetwork/kuchain/x/distribution"
 	"github.com/KuChainNetwork/kuchain/x/evidence"
+	"github.com/KuChainNetwork/kuchain/x/feature"
+	"github.com/KuChainNetwork/kuchain/x/feemarket"
 	"github.com/KuChainNetwork/kuchain/x/genutil"
 	"github.com/KuChainNetwork/kuchain/x/gov"
+	"github.com/KuChainNetwork/kuchain/x/insurance"
+	"github.com/KuChainNetwork/kuchain/x/lane"
+	"github.com/KuChainNetwork/kuchain/x/liquidstake"
 	"github.com/KuChainNetwork/kuchain/x/mint"
 	"github.com/KuChainNetwork/kuchain/x/params"
 	paramsclient "github.com/KuChainNetwork/kuchain/x/params/client"
-	paramproposal "github.com/KuChainNetwork/kuchain/x/params/types/proposal"
+	"github.com/KuChainNetwork/kuchain/x/paychan"
 	"github.com/KuChainNetwork/kuchain/x/plugin"
 	"github.com/KuChainNetwork/kuchain/x/slashing"
 	"github.com/KuChainNetwork/kuchain/x/staking"
+	stakingclient "github.com/KuChainNetwork/kuchain/x/staking/client"
 	"github.com/KuChainNetwork/kuchain/x/supply"
+	"github.com/KuChainNetwork/kuchain/x/upgrade"
+	upgradeclient "github.com/KuChainNetwork/kuchain/x/upgrade/client"
 )
 
 var (
@@ -49,14 +68,29 @@ var (
 		staking.NewAppModuleBasic(),
 		slashing.NewAppModuleBasic(),
 		evidence.NewAppModuleBasic(),
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler),
+		gov.NewAppModuleBasic(
+			paramsclient.ProposalHandler, distr.ProposalHandler, upgradeclient.HaltProposalHandler,
+			upgradeclient.UpgradeProposalHandler, upgradeclient.CancelUpgradeProposalHandler,
+			assetclient.IssuanceApprovalProposalHandler, stakingclient.ValidatorAdmissionProposalHandler,
+			conversionclient.ConversionProposalHandler,
+		),
 		mint.NewAppModuleBasic(),
+		paychan.NewAppModuleBasic(),
+		lane.NewAppModuleBasic(),
+		feemarket.NewAppModuleBasic(),
+		feature.NewAppModuleBasic(),
+		attestation.NewAppModuleBasic(),
+		conversion.NewAppModuleBasic(),
+		liquidstake.NewAppModuleBasic(),
+		insurance.NewAppModuleBasic(),
+		upgrade.NewAppModuleBasic(),
 		params.NewAppModuleBasic(),
 		plugin.NewAppModuleBasic(),
 	)
 
 	// maccPerms module account permissions
 	maccPerms = map[string][]string{
+		account.ModuleName:        nil,
 		fee.CollectorName:         nil,
 		distr.ModuleName:          nil,
 		supply.BlackHole:          nil,
@@ -64,6 +98,9 @@ var (
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
 		mint.ModuleName:           {supply.Minter},
+		paychan.ModuleName:        nil,
+		liquidstake.ModuleName:    nil,
+		insurance.ModuleName:      nil,
 	}
 	allowedReceivingModAcc = map[string]bool{
 		distr.ModuleName: true,
@@ -88,16 +125,7 @@ type KuchainApp struct {
 	subspaces map[string]params.Subspace
 
 	// keepers
-	accountKeeper  account.Keeper
-	assetKeeper    asset.Keeper
-	supplyKeeper   supply.Keeper
-	distrKeeper    distr.Keeper
-	mintKeeper     mint.Keeper
-	paramsKeeper   params.Keeper
-	stakingKeeper  staking.Keeper
-	slashingKeeper slashing.Keeper
-	evidenceKeeper evidence.Keeper
-	govKeeper      gov.Keeper
+	keepers *keepers.AppKeepers
 
 	// the module manager
 	mm *module.Manager
@@ -105,8 +133,16 @@ type KuchainApp struct {
 	// simulation manager
 	sm *module.SimulationManager
 
-	// function manager
-	stakingFuncManager staking.FuncManager
+	// the cache of the expensive queries, dropped per block
+	queryCache *querycache.Cache
+
+	// the cache of the signatures verified in CheckTx, not verified again in DeliverTx
+	sigCache *sigcache.Cache
+
+	// the root multistore loaded, read by the debug store server out of the abci,
+	// locked against the commits
+	cms    sdk.CommitMultiStore
+	cmsMtx sync.RWMutex
 }
 
 // custom tx codec
@@ -114,6 +150,7 @@ func MakeCodec() *codec.Codec {
 	var cdc = codec.New()
 
 	chainTypes.RegisterCodec(cdc)
+	sdkcompat.RegisterCodec(cdc)
 	ModuleBasics.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
@@ -126,8 +163,8 @@ func MakeCodec() *codec.Codec {
 
 // NewKuchainApp returns a reference to an initialized KuchainApp.
 func NewKuchainApp(
-	logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool,
-	invCheckPeriod uint, baseAppOptions ...func(*bam.BaseApp),
+	logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bool, skipUpgradeHeights map[int64]bool,
+	homePath string, maintenanceMode bool, invCheckPeriod uint, baseAppOptions ...func(*bam.BaseApp),
 ) *KuchainApp {
 	cdc := MakeCodec()
 
@@ -135,146 +172,64 @@ func NewKuchainApp(
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
 
-	keys := sdk.NewKVStoreKeys(
-		bam.MainStoreKey, staking.StoreKey, slashing.StoreKey, evidence.StoreKey, gov.StoreKey,
-		account.StoreKey, asset.StoreKey, supply.StoreKey, params.StoreKey, mint.StoreKey, distr.StoreKey, params.StoreKey,
-	)
-	tKeys := sdk.NewTransientStoreKeys(params.TStoreKey, staking.TStoreKey, params.TStoreKey)
+	b := wiring.NewBuilder(cdc)
+	b.KVStoreKey(bam.MainStoreKey)
 
 	app := &KuchainApp{
 		BaseApp:        bApp,
 		cdc:            cdc,
 		invCheckPeriod: invCheckPeriod,
-		keys:           keys,
-		tKeys:          tKeys,
-		subspaces:      make(map[string]params.Subspace),
+		queryCache:     querycache.NewCache(querycache.DefaultConfig()),
+		sigCache:       sigcache.NewCache(sigcache.DefaultConfig()),
 	}
 
-	// init params keeper and subspaces
-	app.paramsKeeper = params.NewKeeper(cdc, keys[params.StoreKey], tKeys[params.TStoreKey])
-	app.subspaces[account.ModuleName] = app.paramsKeeper.Subspace(account.DefaultParamspace)
-	app.subspaces[distr.ModuleName] = app.paramsKeeper.Subspace(distr.DefaultParamspace)
-	app.subspaces[staking.ModuleName] = app.paramsKeeper.Subspace(staking.DefaultParamspace)
-	app.subspaces[slashing.ModuleName] = app.paramsKeeper.Subspace(slashing.DefaultParamspace)
-	app.subspaces[evidence.ModuleName] = app.paramsKeeper.Subspace(evidence.DefaultParamspace)
-	app.subspaces[mint.ModuleName] = app.paramsKeeper.Subspace(mint.DefaultParamspace)
-	app.subspaces[gov.ModuleName] = app.paramsKeeper.Subspace(gov.DefaultParamspace).WithKeyTable(gov.ParamKeyTable())
-
-	// add keepers
-	app.accountKeeper = account.NewAccountKeeper(cdc, keys[account.StoreKey])
-	app.assetKeeper = asset.NewAssetKeeper(cdc, keys[asset.StoreKey], app.accountKeeper)
-	app.supplyKeeper = supply.NewKeeper(
-		cdc, keys[supply.StoreKey], app.accountKeeper, app.assetKeeper, maccPerms,
-	)
-
-	stakingKeeper := staking.NewKeeper(
-		app.cdc, keys[staking.StoreKey], app.assetKeeper, app.supplyKeeper, app.subspaces[staking.ModuleName], app.accountKeeper,
-	)
-	app.stakingFuncManager = staking.NewFuncManager()
-
-	app.distrKeeper = distr.NewKeeper(
-		cdc, keys[distr.StoreKey], app.subspaces[distr.ModuleName],
-		app.assetKeeper,
-		&app.stakingKeeper,
-		app.supplyKeeper,
-		app.accountKeeper,
-		fee.CollectorName,
-		app.ModuleAccountAddrs())
-
-	app.slashingKeeper = slashing.NewKeeper(
-		cdc, keys[slashing.StoreKey], &stakingKeeper, app.subspaces[slashing.ModuleName],
-	)
-
-	// create evidence keeper with evidence router
-	evidenceKeeper := evidence.NewKeeper(
-		keys[evidence.StoreKey], app.subspaces[evidence.ModuleName], &stakingKeeper, app.slashingKeeper,
-	)
-	evidenceRouter := evidence.NewRouter()
-
-	app.evidenceKeeper = *evidenceKeeper
-
-	// register the proposal types
-	govRouter := gov.NewRouter()
-	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper))
-	app.govKeeper = gov.NewKeeper(cdc,
-		keys[gov.StoreKey], app.subspaces[gov.ModuleName],
-		app.supplyKeeper, &stakingKeeper, app.distrKeeper, govRouter,
-	)
-
-	// register the staking hooks
-	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
-	app.stakingKeeper = *stakingKeeper.SetHooks(
-		staking.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()),
-	)
-
-	// TODO: register evidence routes
-	evidenceKeeper.SetRouter(evidenceRouter)
-	app.mintKeeper = mint.NewKeeper(
-		cdc, keys[mint.StoreKey], app.subspaces[mint.ModuleName], &app.stakingKeeper,
-		app.supplyKeeper, constants.FeeSystemAccountStr,
-	)
+	// the modules declare the store keys and params subspaces to the builder by creating keepers
+	app.keepers = keepers.NewAppKeepers(b, keepers.Options{
+		MaccPerms:          maccPerms,
+		SkipUpgradeHeights: skipUpgradeHeights,
+		HomePath:           homePath,
+		MaintenanceMode:    maintenanceMode,
+	})
+	app.keys = b.KVStoreKeys()
+	app.tKeys = b.TransientStoreKeys()
+	app.subspaces = b.Subspaces()
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
-	app.mm = module.NewManager(
-		account.NewAppModule(app.accountKeeper, app.assetKeeper),
-		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx, app.stakingFuncManager),
-		asset.NewAppModule(app.accountKeeper, app.assetKeeper),
-		supply.NewAppModule(app.supplyKeeper, app.assetKeeper, app.accountKeeper),
-		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper, app.stakingKeeper),
-		slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.assetKeeper, app.stakingKeeper),
-		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper),
-		mint.NewAppModule(app.mintKeeper, app.supplyKeeper),
-		evidence.NewAppModule(app.evidenceKeeper, app.accountKeeper, app.assetKeeper),
-		gov.NewAppModule(app.govKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper),
-		plugin.NewAppModule(),
-	)
+	app.mm = module.NewManager(app.keepers.AppModules(app.BaseApp.DeliverTx)...)
 
-	// plugin.ModuleName MUST be the last
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName, evidence.ModuleName, plugin.ModuleName)
-	app.mm.SetOrderEndBlockers(staking.ModuleName, gov.ModuleName, plugin.ModuleName)
-
-	// NOTE: The genutils module must occur after staking so that pools are
-	// properly initialized with tokens from genesis accounts.
-	app.mm.SetOrderInitGenesis(
-		account.ModuleName,
-		asset.ModuleName,
-		distr.ModuleName,
-		staking.ModuleName,
-		slashing.ModuleName, evidence.ModuleName, gov.ModuleName,
-		supply.ModuleName,
-		genutil.ModuleName,
-		mint.ModuleName,
-	)
+	app.mm.SetOrderBeginBlockers(keepers.OrderBeginBlockers...)
+	app.mm.SetOrderEndBlockers(keepers.OrderEndBlockers...)
+	app.mm.SetOrderInitGenesis(keepers.OrderInitGenesis...)
 
-	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	// the msg routes can be disabled by governance, except the gov route itself,
+	// the standard msgs of cosmos-sdk are handled as the KuMsgs they translated to
+	app.SetRouter(sdkcompat.NewRouter(feature.NewRouter(app.Router(), app.keepers.FeatureKeeper, gov.RouterKey)))
+	app.mm.RegisterRoutes(app.Router(), querycache.NewRouter(app.QueryRouter(), app.queryCache))
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
 	// NOTE: This is not required for apps that don't use the simulator for fuzz testing
 	// transactions.
-	app.sm = module.NewSimulationManager(
-		account.NewAppModule(app.accountKeeper, app.assetKeeper),
-		supply.NewAppModule(app.supplyKeeper, app.assetKeeper, app.accountKeeper),
-		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper, app.stakingKeeper),
-		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper),
-		slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.assetKeeper, app.stakingKeeper),
-		mint.NewAppModule(app.mintKeeper, app.supplyKeeper),
-		gov.NewAppModule(app.govKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper),
-	)
+	app.sm = module.NewSimulationManager(app.keepers.SimulationModules()...)
 
 	app.sm.RegisterStoreDecoders()
 
+	// keep the root multistore for the reads of the committed state by the debug store server
+	app.SetStoreLoader(func(ms sdk.CommitMultiStore) error {
+		app.cms = ms
+		return bam.DefaultStoreLoader(ms)
+	})
+
 	// initialize stores
-	app.MountKVStores(keys)
-	app.MountTransientStores(tKeys)
+	app.MountKVStores(app.keys)
+	app.MountTransientStores(app.tKeys)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
-	app.SetAnteHandler(ante.NewHandler(app.accountKeeper, app.assetKeeper))
+	app.SetAnteHandler(ante.NewHandler(app.keepers.AccountKeeper, app.keepers.AssetKeeper, app.keepers.DistrKeeper, app.keepers.LaneKeeper, app.keepers.FeemarketKeeper, app.keepers.FeatureKeeper, app.sigCache))
 
 	app.SetEndBlocker(app.EndBlocker)
 
@@ -300,13 +255,60 @@ func (app *KuchainApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock)
 
 // EndBlocker application updates every end block
 func (app *KuchainApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	return app.mm.EndBlock(ctx, req)
+	res := app.mm.EndBlock(ctx, req)
+	res.ConsensusParamUpdates = app.keepers.FeemarketKeeper.BlockParamsUpdate(ctx)
+	plugins.HandleBlockEvents(ctx, res.Events)
+	return res
+}
+
+// Commit commits the block, if the halt height scheduled by governance reached,
+// halts the node after the block committed.
+func (app *KuchainApp) Commit() abci.ResponseCommit {
+	app.cmsMtx.Lock()
+	res := app.BaseApp.Commit()
+	app.cmsMtx.Unlock()
+
+	app.queryCache.Reset()
+
+	if height, ok := app.keepers.UpgradeKeeper.HaltPending(); ok {
+		upgrade.HaltNode(app.Logger(), height)
+	}
+
+	return res
+}
+
+// SetQueryCacheConfig sets the config of the cache of the expensive queries, such as from app.toml
+func (app *KuchainApp) SetQueryCacheConfig(config querycache.Config) {
+	app.queryCache.SetConfig(config)
+}
+
+// SetSigCacheConfig sets the config of the cache of the signatures verified in CheckTx, such as from app.toml
+func (app *KuchainApp) SetSigCacheConfig(config sigcache.Config) {
+	app.sigCache.SetConfig(config)
+}
+
+// Query handles the tx simulate and the node info queries, other queries are handled by the BaseApp.
+func (app *KuchainApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+	switch req.Path {
+	case chainTypes.QueryPathSimulate:
+		return chainTypes.HandleSimulateQuery(app.BaseApp, txutil.DefaultTxDecoder(app.cdc), app.cdc, req)
+	case chainTypes.QueryPathNodeInfo:
+		return chainTypes.HandleNodeInfoQuery(chainTypes.NewNodeInfo(app.AppVersion(), app.mm.Modules), app.cdc, req)
+	}
+
+	return app.BaseApp.Query(req)
 }
 
 // InitChainer application update at chain initialization
 func (app *KuchainApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState simapp.GenesisState
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
+
+	// the block max bytes is needed by the block gas limit update to tendermint
+	if req.ConsensusParams != nil && req.ConsensusParams.Block != nil {
+		app.keepers.FeemarketKeeper.SetBlockMaxBytes(ctx, uint64(req.ConsensusParams.Block.MaxBytes))
+	}
+
 	return app.mm.InitGenesis(ctx, genesisState)
 }
 
@@ -317,12 +319,7 @@ func (app *KuchainApp) LoadHeight(height int64) error {
 
 // ModuleAccountAddrs returns all the app's module account addresses.
 func (app *KuchainApp) ModuleAccountAddrs() map[string]bool {
-	modAccAddrs := make(map[string]bool)
-	for acc := range maccPerms {
-		modAccAddrs[supply.NewModuleAddress(acc).String()] = true
-	}
-
-	return modAccAddrs
+	return keepers.ModuleAccountAddrs(maccPerms)
 }
 
 // Codec returns the application's sealed codec.
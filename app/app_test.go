@@ -9,12 +9,12 @@ import (
 /*
 func TestKuchainAppExport(t *testing.T) {
 	db := tmdb.NewMemDB()
-	kuApp := NewKuchainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, 0)
+	kuApp := NewKuchainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, false, 0)
 	err := setGenesis(kuApp)
 	require.NoError(t, err)
 
 	// Making a new app object with the db, so that init chain hasn't been called
-	newKuApp := NewKuchainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, 0)
+	newKuApp := NewKuchainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, false, 0)
 	_, _, err = newKuApp.ExportAppStateAndValidators(false, []string{})
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
@@ -24,7 +24,7 @@ func TestKuchainAppExport(t *testing.T) {
 // ensure that black listed addresses are properly set in bank keeper
 func TestBlackListedAddrs(t *testing.T) {
 	db := tmdb.NewMemDB()
-	kuApp := NewKuchainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, 0)
+	kuApp := NewKuchainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, false, 0)
 
 	for acc := range maccPerms {
 		require.True(t, kuApp.assetKeeper.BlacklistedAddr(kuApp.supplyKeeper.GetModuleAddress(acc)))
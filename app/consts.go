@@ -2,14 +2,16 @@ package app
 
 import (
 	"os"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants/keys"
 )
 
 const appName = "KuchainApp"
 
 var (
-	// DefaultCLIHome default home directories for kucli
-	DefaultCLIHome = os.ExpandEnv("$HOME/.kucli")
+	// DefaultCLIHome default home directories for the cli
+	DefaultCLIHome = os.ExpandEnv("$HOME/." + keys.CLIName)
 
-	// DefaultNodeHome default home directories for kucd
-	DefaultNodeHome = os.ExpandEnv("$HOME/.kucd")
+	// DefaultNodeHome default home directories for the node
+	DefaultNodeHome = os.ExpandEnv("$HOME/." + keys.DaemonName)
 )
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/KuChainNetwork/kuchain/chain/debugstore"
+)
+
+var _ debugstore.Source = (*KuchainApp)(nil)
+
+// IterateCommittedStore iterates the pairs in [start, end) of the store at the last committed height,
+// the commits are blocked until the iteration finished.
+func (app *KuchainApp) IterateCommittedStore(storeName string, start, end []byte, cb func(key, value []byte) bool) (int64, error) {
+	key, ok := app.keys[storeName]
+	if !ok {
+		return 0, fmt.Errorf("store %s not found", storeName)
+	}
+
+	app.cmsMtx.RLock()
+	defer app.cmsMtx.RUnlock()
+
+	if app.cms == nil {
+		return 0, fmt.Errorf("store not loaded")
+	}
+
+	height := app.cms.LastCommitID().Version
+	ms, err := app.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return 0, err
+	}
+
+	iter := ms.GetKVStore(key).Iterator(start, end)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key(), iter.Value()) {
+			break
+		}
+	}
+
+	return height, nil
+}
+
+// DecodeStoreValue decodes the value by the store decoder of the module registered to the simulation manager,
+// the decoders panic on the unknown keys, so the decoding is best-effort.
+func (app *KuchainApp) DecodeStoreValue(storeName string, key, value []byte) (res string, ok bool) {
+	decoder, found := app.sm.StoreDecoders[storeName]
+	if !found || len(key) == 0 {
+		return "", false
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			res, ok = "", false
+		}
+	}()
+
+	// the decoders print the values of the two pairs compared by the simulation, separated by a newline
+	pair := tmkv.Pair{Key: key, Value: value}
+	res = decoder(app.cdc, pair, pair)
+	if n := len(res); n%2 == 1 && res[n/2] == '\n' && res[:n/2] == res[n/2+1:] {
+		res = res[:n/2]
+	}
+
+	return strings.TrimSpace(res), true
+}
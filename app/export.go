@@ -28,7 +28,7 @@ func (app *KuchainApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhite
 	if err != nil {
 		return nil, nil, err
 	}
-	validators = staking.WriteValidators(ctx, app.stakingKeeper)
+	validators = staking.WriteValidators(ctx, app.keepers.StakingKeeper)
 	return appState, validators, nil
 }
 
@@ -59,20 +59,20 @@ func (app *KuchainApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList [
 
 	/* Handle staking state. */
 	// iterate through redelegations, reset creation height
-	app.stakingKeeper.IterateRedelegations(ctx, func(_ int64, red staking.Redelegation) (stop bool) {
+	app.keepers.StakingKeeper.IterateRedelegations(ctx, func(_ int64, red staking.Redelegation) (stop bool) {
 		for i := range red.Entries {
 			red.Entries[i].CreationHeight = 0
 		}
-		app.stakingKeeper.SetRedelegation(ctx, red)
+		app.keepers.StakingKeeper.SetRedelegation(ctx, red)
 		return false
 	})
 
 	// iterate through unbonding delegations, reset creation height
-	app.stakingKeeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd staking.UnbondingDelegation) (stop bool) {
+	app.keepers.StakingKeeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd staking.UnbondingDelegation) (stop bool) {
 		for i := range ubd.Entries {
 			ubd.Entries[i].CreationHeight = 0
 		}
-		app.stakingKeeper.SetUnbondingDelegation(ctx, ubd)
+		app.keepers.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
 		return false
 	})
 
@@ -85,7 +85,7 @@ func (app *KuchainApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList [
 	for ; iter.Valid(); iter.Next() {
 		addr := sdk.ValAddress(iter.Key()[1:])
 		accountID := types.NewAccountIDFromAccAdd(sdk.AccAddress(addr))
-		validator, found := app.stakingKeeper.GetValidator(ctx, accountID)
+		validator, found := app.keepers.StakingKeeper.GetValidator(ctx, accountID)
 		if !found {
 			panic("expected validator, not found")
 		}
@@ -95,11 +95,11 @@ func (app *KuchainApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList [
 			validator.Jailed = true
 		}
 
-		app.stakingKeeper.SetValidator(ctx, validator)
+		app.keepers.StakingKeeper.SetValidator(ctx, validator)
 		counter++
 	}
 
 	iter.Close()
 
-	_ = app.stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	_ = app.keepers.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 }
@@ -0,0 +1,195 @@
+package app
+
+import (
+	"encoding/json"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/asset"
+	genutilTypes "github.com/KuChainNetwork/kuchain/x/genutil/types"
+	"github.com/KuChainNetwork/kuchain/x/gov"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/KuChainNetwork/kuchain/x/staking"
+	stakingexport "github.com/KuChainNetwork/kuchain/x/staking/exported"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisChecks returns the cross-module checks for the strict genesis validation
+func GenesisChecks(cdc *codec.Codec) []genutilTypes.GenesisCheck {
+	return []genutilTypes.GenesisCheck{
+		func(appState map[string]json.RawMessage) []genutilTypes.GenesisViolation {
+			return checkValidatorPubKeys(cdc, appState)
+		},
+		func(appState map[string]json.RawMessage) []genutilTypes.GenesisViolation {
+			return checkStakedCovered(cdc, appState)
+		},
+		func(appState map[string]json.RawMessage) []genutilTypes.GenesisViolation {
+			return checkGovDeposits(cdc, appState)
+		},
+	}
+}
+
+// loadGenesis unmarshal the genesis state of module, returns false if module not in genesis
+func loadGenesis(
+	cdc *codec.Codec, appState map[string]json.RawMessage, module string, val interface{},
+) (bool, []genutilTypes.GenesisViolation) {
+	bz, ok := appState[module]
+	if !ok || len(bz) == 0 {
+		return false, nil
+	}
+
+	if err := cdc.UnmarshalJSON(bz, val); err != nil {
+		return false, []genutilTypes.GenesisViolation{
+			genutilTypes.NewGenesisViolation(genutilTypes.AppStatePath(module), "unmarshal genesis error: %s", err.Error()),
+		}
+	}
+
+	return true, nil
+}
+
+// genesisAssetsOf returns the coins of the account in asset genesis
+func genesisAssetsOf(gs asset.GenesisState, id chainTypes.AccountID) chainTypes.Coins {
+	coins := chainTypes.Coins{}
+	for _, a := range gs.GenesisAssets {
+		if a.GetID().Eq(id) {
+			coins = coins.Add(a.GetCoins()...)
+		}
+	}
+
+	return coins
+}
+
+// checkValidatorPubKeys checks the consensus pubkeys of validators are unique
+func checkValidatorPubKeys(cdc *codec.Codec, appState map[string]json.RawMessage) []genutilTypes.GenesisViolation {
+	var stakingGenesis staking.GenesisState
+	ok, violations := loadGenesis(cdc, appState, staking.ModuleName, &stakingGenesis)
+	if !ok {
+		return violations
+	}
+
+	seen := make(map[string]int, len(stakingGenesis.Validators))
+	for i, val := range stakingGenesis.Validators {
+		path := genutilTypes.AppStatePath(staking.ModuleName, "validators", i, "consensus_pubkey")
+		if val.ConsensusPubkey == "" {
+			violations = append(violations, genutilTypes.NewGenesisViolation(path, "validator %s has no consensus pubkey", val.OperatorAccount))
+			continue
+		}
+
+		if idx, ok := seen[val.ConsensusPubkey]; ok {
+			violations = append(violations, genutilTypes.NewGenesisViolation(path,
+				"validator %s consensus pubkey duplicated with validators[%d]", val.OperatorAccount, idx))
+			continue
+		}
+		seen[val.ConsensusPubkey] = i
+	}
+
+	return violations
+}
+
+// checkStakedCovered checks the tokens staked in genesis are covered by the balances of staking pools
+func checkStakedCovered(cdc *codec.Codec, appState map[string]json.RawMessage) []genutilTypes.GenesisViolation {
+	var (
+		stakingGenesis staking.GenesisState
+		assetGenesis   asset.GenesisState
+	)
+
+	ok, violations := loadGenesis(cdc, appState, staking.ModuleName, &stakingGenesis)
+	if !ok {
+		return violations
+	}
+	if ok, vs := loadGenesis(cdc, appState, asset.ModuleName, &assetGenesis); !ok {
+		return append(violations, vs...)
+	}
+
+	// the invalid bond denom is reported by the staking genesis validation
+	bondDenom := stakingGenesis.Params.BondDenom
+	if err := chainTypes.ValidateDenom(bondDenom); err != nil {
+		return violations
+	}
+
+	bondedTokens, notBondedTokens := sdk.ZeroInt(), sdk.ZeroInt()
+
+	for _, val := range stakingGenesis.Validators {
+		if val.GetStatus() == stakingexport.Bonded {
+			bondedTokens = bondedTokens.Add(val.GetTokens())
+		} else {
+			notBondedTokens = notBondedTokens.Add(val.GetTokens())
+		}
+	}
+
+	for _, ubd := range stakingGenesis.UnbondingDelegations {
+		for _, entry := range ubd.Entries {
+			notBondedTokens = notBondedTokens.Add(entry.Balance)
+		}
+	}
+
+	if balance := genesisAssetsOf(assetGenesis, stakingTypes.BondedPoolAccountID).AmountOf(bondDenom); balance.LT(bondedTokens) {
+		violations = append(violations, genutilTypes.NewGenesisViolation(
+			genutilTypes.AppStatePath(staking.ModuleName, "validators"),
+			"bonded tokens %s not covered by %s balance %s", bondedTokens, staking.BondedPoolName, balance))
+	}
+
+	if balance := genesisAssetsOf(assetGenesis, stakingTypes.NotBondedPoolAccountID).AmountOf(bondDenom); balance.LT(notBondedTokens) {
+		violations = append(violations, genutilTypes.NewGenesisViolation(
+			genutilTypes.AppStatePath(staking.ModuleName, "unbonding_delegations"),
+			"not bonded tokens %s not covered by %s balance %s", notBondedTokens, staking.NotBondedPoolName, balance))
+	}
+
+	return violations
+}
+
+// checkGovDeposits checks the deposits in genesis are consistent with proposals and the gov module account
+func checkGovDeposits(cdc *codec.Codec, appState map[string]json.RawMessage) []genutilTypes.GenesisViolation {
+	var (
+		govGenesis   gov.GenesisState
+		assetGenesis asset.GenesisState
+	)
+
+	ok, violations := loadGenesis(cdc, appState, gov.ModuleName, &govGenesis)
+	if !ok {
+		return violations
+	}
+
+	proposals := make(map[uint64]int, len(govGenesis.Proposals))
+	for i, p := range govGenesis.Proposals {
+		proposals[p.ProposalID] = i
+	}
+
+	totalDeposits := chainTypes.Coins{}
+	proposalDeposits := make(map[uint64]chainTypes.Coins, len(govGenesis.Proposals))
+	for i, d := range govGenesis.Deposits {
+		if _, ok := proposals[d.ProposalID]; !ok {
+			violations = append(violations, genutilTypes.NewGenesisViolation(
+				genutilTypes.AppStatePath(gov.ModuleName, "deposits", i, "proposal_id"),
+				"deposit of %s to unknown proposal %d", d.Depositor, d.ProposalID))
+		}
+
+		totalDeposits = totalDeposits.Add(d.Amount...)
+		proposalDeposits[d.ProposalID] = proposalDeposits[d.ProposalID].Add(d.Amount...)
+	}
+
+	for i, p := range govGenesis.Proposals {
+		if deposits := proposalDeposits[p.ProposalID]; !deposits.IsEqual(p.TotalDeposit) {
+			violations = append(violations, genutilTypes.NewGenesisViolation(
+				genutilTypes.AppStatePath(gov.ModuleName, "proposals", i, "total_deposit"),
+				"total deposit %s of proposal %d not equal to deposits %s", p.TotalDeposit, p.ProposalID, deposits))
+		}
+	}
+
+	if totalDeposits.IsZero() {
+		return violations
+	}
+
+	if ok, vs := loadGenesis(cdc, appState, asset.ModuleName, &assetGenesis); !ok {
+		return append(violations, vs...)
+	}
+
+	if balance := genesisAssetsOf(assetGenesis, govTypes.ModuleAccountID); !balance.IsAllGTE(totalDeposits) {
+		violations = append(violations, genutilTypes.NewGenesisViolation(
+			genutilTypes.AppStatePath(gov.ModuleName, "deposits"),
+			"total deposits %s not covered by %s balance %s", totalDeposits, gov.ModuleName, balance))
+	}
+
+	return violations
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/asset"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	genutilcli "github.com/KuChainNetwork/kuchain/x/genutil/client/cli"
+	"github.com/KuChainNetwork/kuchain/x/gov"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/KuChainNetwork/kuchain/x/staking"
+	stakingexport "github.com/KuChainNetwork/kuchain/x/staking/exported"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestStrictValidateGenesis(t *testing.T) {
+	cdc := MakeCodec()
+	genState := ModuleBasics.DefaultGenesis()
+
+	// default genesis has no violations
+	violations := genutilcli.StrictValidateGenesis(ModuleBasics, genState, GenesisChecks(cdc)...)
+	require.Empty(t, violations)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	val1 := staking.NewValidator(chainTypes.MustAccountID("validator1"), pubKey, stakingTypes.Description{})
+	val2 := staking.NewValidator(chainTypes.MustAccountID("validator2"), pubKey, stakingTypes.Description{})
+	val1.Status, val1.Tokens = stakingexport.Bonded, sdk.NewInt(100)
+	val2.Status, val2.Tokens = stakingexport.Bonded, sdk.NewInt(100)
+
+	stakingGenesis := staking.DefaultGenesisState()
+	stakingGenesis.Params.BondDenom = constants.DefaultBondDenom
+	stakingGenesis.Validators = staking.Validators{val1, val2}
+	genState[staking.ModuleName] = cdc.MustMarshalJSON(stakingGenesis)
+
+	assetGenesis := asset.DefaultGenesisState()
+	assetGenesis.GenesisAssets = append(assetGenesis.GenesisAssets,
+		assetTypes.NewGenesisAsset(stakingTypes.BondedPoolAccountID, chainTypes.NewInt64CoreCoins(150)...))
+	genState[asset.ModuleName] = cdc.MustMarshalJSON(assetGenesis)
+
+	govGenesis := gov.DefaultGenesisState()
+	govGenesis.Deposits = govTypes.Deposits{
+		govTypes.NewDeposit(1, chainTypes.MustAccountID("validator1"), chainTypes.NewInt64CoreCoins(10)),
+	}
+	genState[gov.ModuleName] = cdc.MustMarshalJSON(govGenesis)
+
+	violations = genutilcli.StrictValidateGenesis(ModuleBasics, genState, GenesisChecks(cdc)...)
+
+	paths := make([]string, 0, len(violations))
+	for _, v := range violations {
+		paths = append(paths, v.Path)
+	}
+
+	require.Contains(t, paths, "app_state.kustaking.validators[1].consensus_pubkey")
+	require.Contains(t, paths, "app_state.kustaking.validators")
+	require.Contains(t, paths, "app_state.kugov.deposits[0].proposal_id")
+	require.Contains(t, paths, "app_state.kugov.deposits")
+
+	// module validation errors are reported too
+	genState[staking.ModuleName] = json.RawMessage(`{"params":{}}`)
+	violations = genutilcli.StrictValidateGenesis(ModuleBasics, genState, GenesisChecks(cdc)...)
+	require.NotEmpty(t, violations)
+	require.Equal(t, "app_state.kustaking", violations[0].Path)
+}
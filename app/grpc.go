@@ -0,0 +1,13 @@
+package app
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/KuChainNetwork/kuchain/chain/grpcserver"
+)
+
+// RegisterGRPCServices registers the gRPC services of the modules, the services query the app by the querier,
+// which should be serialized with the abci connections of tendermint.
+func (app *KuchainApp) RegisterGRPCServices(server *grpc.Server, querier grpcserver.ABCIQuerier) {
+	grpcserver.RegisterServices(ModuleBasics, server, querier)
+}
@@ -0,0 +1,203 @@
+package keepers
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/fee"
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/account"
+	"github.com/KuChainNetwork/kuchain/x/asset"
+	"github.com/KuChainNetwork/kuchain/x/attestation"
+	"github.com/KuChainNetwork/kuchain/x/conversion"
+	distr "github.com/KuChainNetwork/kuchain/x/distribution"
+	"github.com/KuChainNetwork/kuchain/x/evidence"
+	"github.com/KuChainNetwork/kuchain/x/feature"
+	"github.com/KuChainNetwork/kuchain/x/feemarket"
+	"github.com/KuChainNetwork/kuchain/x/gov"
+	"github.com/KuChainNetwork/kuchain/x/insurance"
+	"github.com/KuChainNetwork/kuchain/x/lane"
+	"github.com/KuChainNetwork/kuchain/x/liquidstake"
+	"github.com/KuChainNetwork/kuchain/x/mint"
+	"github.com/KuChainNetwork/kuchain/x/params"
+	paramproposal "github.com/KuChainNetwork/kuchain/x/params/types/proposal"
+	"github.com/KuChainNetwork/kuchain/x/paychan"
+	"github.com/KuChainNetwork/kuchain/x/slashing"
+	"github.com/KuChainNetwork/kuchain/x/staking"
+	"github.com/KuChainNetwork/kuchain/x/supply"
+	"github.com/KuChainNetwork/kuchain/x/upgrade"
+)
+
+// Options the configurations for the keepers from the app
+type Options struct {
+	// MaccPerms the module account permissions
+	MaccPerms map[string][]string
+
+	// SkipUpgradeHeights the upgrade heights to skip for the old binary
+	SkipUpgradeHeights map[int64]bool
+
+	// HomePath the home of the node for the upgrade info
+	HomePath string
+
+	// MaintenanceMode the node only accepts the msgs allowed in maintenance mode in CheckTx
+	MaintenanceMode bool
+}
+
+// AppKeepers the keepers of all modules in kuchain app, assembled by the
+// dependencies each module declared, the app holds it by pointer, as some
+// keepers hold the reference to the others.
+type AppKeepers struct {
+	AccountKeeper     account.Keeper
+	AuctionKeeper     account.AuctionKeeper
+	RecoveryKeeper    account.RecoveryKeeper
+	AssetKeeper       asset.Keeper
+	SupplyKeeper      supply.Keeper
+	DistrKeeper       distr.Keeper
+	MintKeeper        mint.Keeper
+	PaychanKeeper     paychan.Keeper
+	LaneKeeper        lane.Keeper
+	FeemarketKeeper   feemarket.Keeper
+	FeatureKeeper     feature.Keeper
+	AttestationKeeper attestation.Keeper
+	ConversionKeeper  conversion.Keeper
+	LiquidStakeKeeper liquidstake.Keeper
+	InsuranceKeeper   insurance.Keeper
+	UpgradeKeeper     upgrade.Keeper
+	ParamsKeeper      params.Keeper
+	StakingKeeper     staking.Keeper
+	SlashingKeeper    slashing.Keeper
+	EvidenceKeeper    evidence.Keeper
+	GovKeeper         gov.Keeper
+
+	StakingFuncManager staking.FuncManager
+}
+
+// NewAppKeepers creates all keepers, the store keys and params subspaces are declared to the builder by modules
+func NewAppKeepers(b *wiring.Builder, opts Options) *AppKeepers {
+	k := &AppKeepers{}
+
+	k.ParamsKeeper = b.ParamsKeeper()
+
+	k.AccountKeeper = account.ProvideKeeper(b)
+	k.RecoveryKeeper = account.ProvideRecoveryKeeper(b, k.AccountKeeper)
+	k.AssetKeeper = asset.ProvideKeeper(b, asset.Inputs{
+		AccountKeeper: k.AccountKeeper,
+	})
+	k.SupplyKeeper = supply.ProvideKeeper(b, supply.Inputs{
+		AccountKeeper: k.AccountKeeper,
+		BankKeeper:    k.AssetKeeper,
+		MaccPerms:     opts.MaccPerms,
+	})
+
+	stakingKeeper := staking.ProvideKeeper(b, staking.Inputs{
+		BankKeeper:    k.AssetKeeper,
+		SupplyKeeper:  k.SupplyKeeper,
+		AccountKeeper: k.AccountKeeper,
+	})
+	k.StakingFuncManager = staking.NewFuncManager()
+
+	k.DistrKeeper = distr.ProvideKeeper(b, distr.Inputs{
+		BankKeeper:       k.AssetKeeper,
+		StakingKeeper:    &k.StakingKeeper,
+		SupplyKeeper:     k.SupplyKeeper,
+		AccountKeeper:    k.AccountKeeper,
+		FeeCollectorName: fee.CollectorName,
+		BlacklistedAddrs: ModuleAccountAddrs(opts.MaccPerms),
+	})
+
+	k.AuctionKeeper = account.ProvideAuctionKeeper(b, account.AuctionInputs{
+		AccountKeeper:      k.AccountKeeper,
+		SupplyKeeper:       k.SupplyKeeper,
+		DistributionKeeper: k.DistrKeeper,
+	})
+
+	k.SlashingKeeper = slashing.ProvideKeeper(b, slashing.Inputs{
+		StakingKeeper: &stakingKeeper,
+	})
+
+	// create evidence keeper with evidence router
+	evidenceKeeper := evidence.ProvideKeeper(b, evidence.Inputs{
+		StakingKeeper:  &stakingKeeper,
+		SlashingKeeper: k.SlashingKeeper,
+	})
+	evidenceRouter := evidence.NewRouter()
+
+	k.EvidenceKeeper = *evidenceKeeper
+
+	k.UpgradeKeeper = upgrade.ProvideKeeper(b, upgrade.Inputs{
+		SkipUpgradeHeights: opts.SkipUpgradeHeights,
+		HomePath:           opts.HomePath,
+	})
+
+	k.ConversionKeeper = conversion.ProvideKeeper(b, conversion.Inputs{
+		AssetKeeper: k.AssetKeeper,
+	})
+
+	// register the proposal types
+	govRouter := gov.NewRouter()
+	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(k.ParamsKeeper)).
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(k.DistrKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewUpgradeProposalHandler(k.UpgradeKeeper)).
+		AddRoute(asset.RouterKey, asset.NewIssuanceProposalHandler(k.AssetKeeper)).
+		AddRoute(staking.RouterKey, staking.NewValidatorAdmissionProposalHandler(stakingKeeper)).
+		AddRoute(conversion.RouterKey, conversion.NewConversionProposalHandler(k.ConversionKeeper))
+	k.GovKeeper = gov.ProvideKeeper(b, gov.Inputs{
+		SupplyKeeper:       k.SupplyKeeper,
+		StakingKeeper:      &stakingKeeper,
+		DistributionKeeper: k.DistrKeeper,
+		Router:             govRouter,
+	})
+
+	// register the staking hooks
+	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	k.StakingKeeper = *stakingKeeper.SetHooks(
+		staking.NewMultiStakingHooks(k.DistrKeeper.Hooks(), k.SlashingKeeper.Hooks()),
+	).SetTombstoneChecker(k.SlashingKeeper)
+
+	// TODO: register evidence routes
+	evidenceKeeper.SetRouter(evidenceRouter)
+	k.MintKeeper = mint.ProvideKeeper(b, mint.Inputs{
+		StakingKeeper:    &k.StakingKeeper,
+		SupplyKeeper:     k.SupplyKeeper,
+		FeeCollectorName: constants.FeeSystemAccountStr,
+	})
+	k.PaychanKeeper = paychan.ProvideKeeper(b, paychan.Inputs{
+		SupplyKeeper:  k.SupplyKeeper,
+		AccountKeeper: k.AccountKeeper,
+	})
+	k.LiquidStakeKeeper = liquidstake.ProvideKeeper(b, liquidstake.Inputs{
+		StakingKeeper:      &k.StakingKeeper,
+		DistributionKeeper: k.DistrKeeper,
+		AssetKeeper:        k.AssetKeeper,
+	})
+	k.InsuranceKeeper = insurance.ProvideKeeper(b, insurance.Inputs{
+		StakingKeeper: &k.StakingKeeper,
+		SupplyKeeper:  k.SupplyKeeper,
+		AssetKeeper:   k.AssetKeeper,
+	})
+
+	// register the slashing hooks to record the downtime slashes for the insurance claims
+	k.SlashingKeeper.SetHooks(k.InsuranceKeeper.Hooks())
+
+	k.LaneKeeper = lane.ProvideKeeper(b)
+	k.FeemarketKeeper = feemarket.ProvideKeeper(b, feemarket.Inputs{
+		SupplyKeeper:       k.SupplyKeeper,
+		DistributionKeeper: k.DistrKeeper,
+		FeeCollectorName:   fee.CollectorName,
+	})
+	k.FeatureKeeper = feature.ProvideKeeper(b).WithLocalMaintenance(opts.MaintenanceMode)
+	k.AttestationKeeper = attestation.ProvideKeeper(b)
+
+	k.registerMigrations()
+
+	return k
+}
+
+// ModuleAccountAddrs returns all the module account addresses by the permissions.
+func ModuleAccountAddrs(maccPerms map[string][]string) map[string]bool {
+	modAccAddrs := make(map[string]bool)
+	for acc := range maccPerms {
+		modAccAddrs[supply.NewModuleAddress(acc).String()] = true
+	}
+
+	return modAccAddrs
+}
@@ -0,0 +1,97 @@
+package keepers
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/gov"
+	"github.com/KuChainNetwork/kuchain/x/upgrade"
+)
+
+// PruneEmptyAccountsUpgrade the name of the upgrade plan to prune the empty address accounts,
+// it is in the binary, so the plan is applied without halting the chain.
+const PruneEmptyAccountsUpgrade = "prune-empty-accounts"
+
+// PruneEmptyAccountsConfig the config of the empty accounts pruning, as json in the info of the plan
+type PruneEmptyAccountsConfig struct {
+	// Limit the max number of the accounts to prune, 0 for no limit
+	Limit int `json:"limit" yaml:"limit"`
+}
+
+// registerMigrations registers the state migrations in the binary as upgrade handlers
+func (k *AppKeepers) registerMigrations() {
+	k.UpgradeKeeper.SetUpgradeHandler(PruneEmptyAccountsUpgrade, k.pruneEmptyAccounts)
+}
+
+// pruneEmptyAccounts removes the address accounts with zero balance, no delegations,
+// no pending gov activity and no sub-records such as the named accounts of the auth.
+func (k *AppKeepers) pruneEmptyAccounts(ctx sdk.Context, plan upgrade.Plan) {
+	logger := k.UpgradeKeeper.Logger(ctx)
+
+	var config PruneEmptyAccountsConfig
+	if info := strings.TrimSpace(plan.Info); info != "" {
+		if err := json.Unmarshal([]byte(info), &config); err != nil {
+			logger.Error("invalid prune empty accounts config, no account pruned", "info", plan.Info, "err", err)
+			return
+		}
+	}
+
+	govActive := k.govActiveAccounts(ctx)
+	pruned := k.AccountKeeper.PruneEmptyAuths(ctx, config.Limit, func(ctx sdk.Context, id chainTypes.AccountID) bool {
+		return govActive[id.String()] || k.isAccountInUse(ctx, id)
+	})
+
+	logger.Info("pruned empty accounts", "pruned", len(pruned), "limit", config.Limit)
+}
+
+// isAccountInUse returns true if the account has coins or staking states
+func (k *AppKeepers) isAccountInUse(ctx sdk.Context, id chainTypes.AccountID) bool {
+	if coins, err := k.AssetKeeper.GetCoins(ctx, id); err != nil || !coins.IsZero() {
+		return true
+	}
+
+	if !k.AssetKeeper.GetCoinPowers(ctx, id).IsZero() {
+		return true
+	}
+
+	if locked, _, err := k.AssetKeeper.GetLockCoins(ctx, id); err != nil || !locked.IsZero() {
+		return true
+	}
+
+	if _, ok := k.AssetKeeper.GetClawbackGrant(ctx, id); ok {
+		return true
+	}
+
+	if _, ok := k.StakingKeeper.GetValidator(ctx, id); ok {
+		return true
+	}
+
+	return len(k.StakingKeeper.GetDelegatorDelegations(ctx, id, 1)) > 0 ||
+		len(k.StakingKeeper.GetUnbondingDelegations(ctx, id, 1)) > 0 ||
+		len(k.StakingKeeper.GetRedelegations(ctx, id, 1)) > 0
+}
+
+// govActiveAccounts returns the depositors and voters of the proposals not finished
+func (k *AppKeepers) govActiveAccounts(ctx sdk.Context) map[string]bool {
+	res := make(map[string]bool)
+
+	k.GovKeeper.IterateProposals(ctx, func(proposal gov.Proposal) bool {
+		if proposal.Status != gov.StatusDepositPeriod && proposal.Status != gov.StatusVotingPeriod {
+			return false
+		}
+
+		for _, deposit := range k.GovKeeper.GetDeposits(ctx, proposal.ProposalID) {
+			res[deposit.Depositor.String()] = true
+		}
+		for _, vote := range k.GovKeeper.GetVotes(ctx, proposal.ProposalID) {
+			res[vote.Voter.String()] = true
+		}
+
+		return false
+	})
+
+	return res
+}
@@ -0,0 +1,103 @@
+package keepers
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/x/account"
+	"github.com/KuChainNetwork/kuchain/x/asset"
+	"github.com/KuChainNetwork/kuchain/x/attestation"
+	"github.com/KuChainNetwork/kuchain/x/conversion"
+	distr "github.com/KuChainNetwork/kuchain/x/distribution"
+	"github.com/KuChainNetwork/kuchain/x/evidence"
+	"github.com/KuChainNetwork/kuchain/x/feature"
+	"github.com/KuChainNetwork/kuchain/x/feemarket"
+	"github.com/KuChainNetwork/kuchain/x/genutil"
+	"github.com/KuChainNetwork/kuchain/x/gov"
+	"github.com/KuChainNetwork/kuchain/x/insurance"
+	"github.com/KuChainNetwork/kuchain/x/lane"
+	"github.com/KuChainNetwork/kuchain/x/liquidstake"
+	"github.com/KuChainNetwork/kuchain/x/mint"
+	"github.com/KuChainNetwork/kuchain/x/paychan"
+	"github.com/KuChainNetwork/kuchain/x/plugin"
+	"github.com/KuChainNetwork/kuchain/x/slashing"
+	"github.com/KuChainNetwork/kuchain/x/staking"
+	"github.com/KuChainNetwork/kuchain/x/supply"
+	"github.com/KuChainNetwork/kuchain/x/upgrade"
+)
+
+var (
+	// OrderBeginBlockers the order of modules begin blockers, plugin.ModuleName MUST be the last
+	OrderBeginBlockers = []string{
+		upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName, evidence.ModuleName, account.ModuleName, asset.ModuleName, plugin.ModuleName,
+	}
+
+	// OrderEndBlockers the order of modules end blockers, plugin.ModuleName MUST be the last
+	OrderEndBlockers = []string{
+		staking.ModuleName, gov.ModuleName, paychan.ModuleName, insurance.ModuleName, conversion.ModuleName, feemarket.ModuleName, upgrade.ModuleName, account.ModuleName, plugin.ModuleName,
+	}
+
+	// OrderInitGenesis the order of modules init genesis
+	// NOTE: The genutils module must occur after staking so that pools are
+	// properly initialized with tokens from genesis accounts.
+	OrderInitGenesis = []string{
+		account.ModuleName,
+		asset.ModuleName,
+		distr.ModuleName,
+		staking.ModuleName,
+		slashing.ModuleName, evidence.ModuleName, gov.ModuleName,
+		supply.ModuleName,
+		lane.ModuleName,
+		feemarket.ModuleName,
+		feature.ModuleName,
+		attestation.ModuleName,
+		conversion.ModuleName,
+		upgrade.ModuleName,
+		genutil.ModuleName,
+		mint.ModuleName,
+		paychan.ModuleName,
+		liquidstake.ModuleName,
+		insurance.ModuleName,
+	}
+)
+
+// AppModules returns the app modules for the module manager,
+// the deliverTx is used by genutil to deliver the gentxs.
+func (k *AppKeepers) AppModules(deliverTx func(abci.RequestDeliverTx) abci.ResponseDeliverTx) []module.AppModule {
+	return []module.AppModule{
+		account.NewAppModule(k.AccountKeeper, k.AuctionKeeper, k.RecoveryKeeper, k.AssetKeeper),
+		genutil.NewAppModule(k.AccountKeeper, k.StakingKeeper, deliverTx, k.StakingFuncManager),
+		asset.NewAppModule(k.AccountKeeper, k.AssetKeeper),
+		supply.NewAppModule(k.SupplyKeeper, k.AssetKeeper, k.AccountKeeper),
+		distr.NewAppModule(k.DistrKeeper, k.AccountKeeper, k.AssetKeeper, k.SupplyKeeper, k.StakingKeeper),
+		slashing.NewAppModule(k.SlashingKeeper, k.AccountKeeper, k.AssetKeeper, k.StakingKeeper),
+		staking.NewAppModule(k.StakingKeeper, k.AccountKeeper, k.AssetKeeper, k.SupplyKeeper),
+		mint.NewAppModule(k.MintKeeper, k.SupplyKeeper),
+		paychan.NewAppModule(k.PaychanKeeper, k.AccountKeeper, k.AssetKeeper, k.SupplyKeeper),
+		lane.NewAppModule(k.LaneKeeper),
+		feemarket.NewAppModule(k.FeemarketKeeper),
+		feature.NewAppModule(k.FeatureKeeper),
+		attestation.NewAppModule(k.AttestationKeeper, k.AccountKeeper, k.AssetKeeper),
+		conversion.NewAppModule(k.ConversionKeeper, k.AccountKeeper, k.AssetKeeper),
+		liquidstake.NewAppModule(k.LiquidStakeKeeper, k.AccountKeeper, k.AssetKeeper, k.SupplyKeeper),
+		insurance.NewAppModule(k.InsuranceKeeper, k.AccountKeeper, k.AssetKeeper, k.SupplyKeeper),
+		upgrade.NewAppModule(k.UpgradeKeeper),
+		evidence.NewAppModule(k.EvidenceKeeper, k.AccountKeeper, k.AssetKeeper),
+		gov.NewAppModule(k.GovKeeper, k.AccountKeeper, k.AssetKeeper, k.SupplyKeeper),
+		plugin.NewAppModule(),
+	}
+}
+
+// SimulationModules returns the app modules for the simulation manager,
+// the order of the modules is for deterministic simulations.
+func (k *AppKeepers) SimulationModules() []module.AppModuleSimulation {
+	return []module.AppModuleSimulation{
+		account.NewAppModule(k.AccountKeeper, k.AuctionKeeper, k.RecoveryKeeper, k.AssetKeeper),
+		supply.NewAppModule(k.SupplyKeeper, k.AssetKeeper, k.AccountKeeper),
+		distr.NewAppModule(k.DistrKeeper, k.AccountKeeper, k.AssetKeeper, k.SupplyKeeper, k.StakingKeeper),
+		staking.NewAppModule(k.StakingKeeper, k.AccountKeeper, k.AssetKeeper, k.SupplyKeeper),
+		slashing.NewAppModule(k.SlashingKeeper, k.AccountKeeper, k.AssetKeeper, k.StakingKeeper),
+		mint.NewAppModule(k.MintKeeper, k.SupplyKeeper),
+		gov.NewAppModule(k.GovKeeper, k.AccountKeeper, k.AssetKeeper, k.SupplyKeeper),
+	}
+}
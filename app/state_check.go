@@ -0,0 +1,42 @@
+package app
+
+import (
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/statecheck"
+)
+
+var _ statecheck.Source = (*KuchainApp)(nil)
+
+// StateStoreNames returns the names of the kv stores verified by the state check
+func (app *KuchainApp) StateStoreNames() []string {
+	names := make([]string, 0, len(app.keys))
+	for name := range app.keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// SampleStateKeys returns at most limit keys not less than the start key in the store,
+// the keys are sampled from the check state, which may have the changes not committed.
+func (app *KuchainApp) SampleStateKeys(storeName string, start []byte, limit int) [][]byte {
+	key, ok := app.keys[storeName]
+	if !ok {
+		return nil
+	}
+
+	ctx := app.NewContext(true, abci.Header{})
+	iter := ctx.KVStore(key).Iterator(start, nil)
+	defer iter.Close()
+
+	keys := make([][]byte, 0, limit)
+	for ; iter.Valid() && len(keys) < limit; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	return keys
+}
@@ -0,0 +1,71 @@
+package ante
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// freeTxKey is the context key to mark the tx which fee is paid by community pool
+type freeTxKey struct{}
+
+// IsFreeTx returns true if the fee of tx in ctx is paid by community pool
+func IsFreeTx(ctx sdk.Context) bool {
+	free, ok := ctx.Value(freeTxKey{}).(bool)
+	return ok && free
+}
+
+// BaseFeeDecorator checks the fee of tx is not less than the base fee of the fee market,
+// the base fee is taken from the fee collector and then burned or sent to community pool.
+// Free txs, simulations and the txs in genesis are not charged.
+// CONTRACT: should be after the DeductFeeDecorator and before the ReferralFeeDecorator
+type BaseFeeDecorator struct {
+	fm FeeMarketKeeper
+}
+
+func NewBaseFeeDecorator(fm FeeMarketKeeper) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		fm: fm,
+	}
+}
+
+func (bfd BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if ctx.BlockHeight() == 0 || simulate || IsFreeTx(ctx) {
+		return next(ctx, tx, simulate)
+	}
+
+	baseFee := bfd.fm.BaseFeeOf(ctx, feeTx.GetGas())
+	if baseFee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	if feeTx.GetFee().AmountOf(constants.DefaultBondDenom).LT(baseFee.AmountOf(constants.DefaultBondDenom)) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee,
+			"insufficient fees; got: %s required base fee: %s", feeTx.GetFee(), baseFee)
+	}
+
+	if err := bfd.fm.CollectBaseFee(ctx, baseFee); err != nil {
+		return ctx, sdkerrors.Wrap(err, "collect base fee")
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// TipOf returns the fee of tx except the base fee, which is the part left in fee collector
+func TipOf(ctx sdk.Context, fm FeeMarketKeeper, fee Coins, gas uint64) Coins {
+	if fm == nil || ctx.BlockHeight() == 0 || IsFreeTx(ctx) {
+		return fee
+	}
+
+	tip, hasNeg := fee.SafeSub(fm.BaseFeeOf(ctx, gas))
+	if hasNeg {
+		return Coins{}
+	}
+
+	return tip
+}
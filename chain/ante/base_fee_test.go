@@ -0,0 +1,59 @@
+package ante_test
+
+import (
+	"testing"
+
+	. "github.com/KuChainNetwork/kuchain/chain/ante"
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAnteBaseFeeHandler(t *testing.T) {
+	app, _ := createAppForTest()
+
+	ak := *app.AccountKeeper()
+	asset := app.AssetKeeper()
+	feemarket := app.FeeMarketKeeper()
+	handler := sdk.ChainAnteDecorators(
+		NewDeductFeeDecorator(ak, asset),
+		NewBaseFeeDecorator(feemarket),
+	)
+
+	Convey("BaseFeeDecorator test base fee ante handler", t, func() {
+		stdTx4Test := testStdTx(app, account4)
+		ctx, _ := app.NewTestContext().CacheContext()
+
+		// base fee is 0.01 per gas
+		feemarket.SetBaseFee(ctx, sdk.NewDecWithPrec(1, 2))
+		baseFee := feemarket.BaseFeeOf(ctx, stdTx4Test.Fee.Gas)
+		So(baseFee, simapp.ShouldEq, types.NewInt64CoreCoins(10000))
+
+		Convey("test base fee taken from fee collector", func() {
+			collectorOld := asset.GetCoinPowers(ctx, constants.GetFeeCollector())
+
+			_, err := handler(ctx, stdTx4Test, false)
+			So(err, ShouldBeNil)
+
+			collectorAfter := asset.GetCoinPowers(ctx, constants.GetFeeCollector())
+			So(collectorOld.Add(simapp.DefaultTestFee...).Sub(baseFee), simapp.ShouldEq, collectorAfter)
+		})
+
+		Convey("test fee less than base fee", func() {
+			stdTx4Test.Fee.Amount = types.NewInt64CoreCoins(9999)
+
+			_, err := handler(ctx, stdTx4Test, false)
+			So(err, simapp.ShouldErrIs, sdkerrors.ErrInsufficientFee)
+		})
+
+		Convey("test base fee not charged in simulate", func() {
+			stdTx4Test.Fee.Amount = types.Coins{}
+
+			_, err := handler(ctx, stdTx4Test, true)
+			So(err, ShouldBeNil)
+		})
+	})
+}
@@ -41,6 +41,25 @@ func TestValidateBasic(t *testing.T) {
 	})
 }
 
+func TestTxTimeoutHeight(t *testing.T) {
+	app, ctx := createAppForTest()
+
+	Convey("test tx timeout height check", t, func() {
+		antehandler := sdk.ChainAnteDecorators(ante.NewTxTimeoutHeightDecorator())
+		ctx = ctx.WithBlockHeight(10)
+
+		// no timeout
+		_, err := antehandler(ctx, testStdTx(app, account2), false)
+		So(err, ShouldBeNil)
+
+		_, err = antehandler(ctx, testStdTx(app, account2).WithTimeoutHeight(10), false)
+		So(err, ShouldBeNil)
+
+		_, err = antehandler(ctx, testStdTx(app, account2).WithTimeoutHeight(9), false)
+		So(err, simapp.ShouldErrIs, types.ErrTxTimeoutHeight)
+	})
+}
+
 func TestConsumeGasForTxSize(t *testing.T) {
 	app, ctx := createAppForTest()
 
@@ -0,0 +1,25 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DeactivationDecorator rejects the txs with the msgs from a deactivated account or signed by the auth of
+// a deactivated account, whoever pays the fee of the tx.
+type DeactivationDecorator struct {
+	ak AccountKeeper
+}
+
+func NewDeactivationDecorator(ak AccountKeeper) DeactivationDecorator {
+	return DeactivationDecorator{
+		ak: ak,
+	}
+}
+
+func (dd DeactivationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := dd.ak.ValidateMsgsActive(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
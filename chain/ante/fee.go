@@ -129,6 +129,10 @@ func checkPayerAuth(ctx sdk.Context, ak AccountKeeper, tx sdk.Tx, simulate bool,
 		return sdkerrors.Wrap(sdkerrors.ErrUnknownAddress, "payer not found")
 	}
 
+	if ak.IsAccountDeactivated(ctx, acc.GetName()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "fee payer account %s is deactivated", payer)
+	}
+
 	accAuth := acc.GetAuth()
 
 	if !isHasAuth(auths, accAuth) {
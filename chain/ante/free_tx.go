@@ -0,0 +1,51 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FreeTxDecorator let the zero fee tx from payer which has free tx allowance pass,
+// the fee of the tx will be paid by community pool.
+// CONTRACT: should be before the MempoolFeeDecorator
+type FreeTxDecorator struct {
+	account AccountKeeper
+	free    FreeTxKeeper
+}
+
+func NewFreeTxDecorator(acc AccountKeeper, free FreeTxKeeper) FreeTxDecorator {
+	return FreeTxDecorator{
+		account: acc,
+		free:    free,
+	}
+}
+
+func (ftd FreeTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if !feeTx.GetFee().IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	feePayer := feeTx.FeePayer()
+	if err := checkPayerAuth(ctx, ftd.account, tx, simulate, feePayer); err != nil {
+		return ctx, err
+	}
+
+	used, err := ftd.free.UseFreeTxAllowance(ctx, feePayer, feeTx.GetGas())
+	if err != nil {
+		return ctx, sdkerrors.Wrap(err, "use free tx allowance")
+	}
+
+	if !used {
+		return next(ctx, tx, simulate)
+	}
+
+	ctx.Logger().Debug("free tx", "feePayer", feePayer, "gas", feeTx.GetGas())
+
+	// the fee is paid by community pool, so no need to check min gas prices and base fee
+	return next(ctx.WithMinGasPrices(sdk.DecCoins{}).WithValue(freeTxKey{}, true), tx, simulate)
+}
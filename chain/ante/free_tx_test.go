@@ -0,0 +1,116 @@
+package ante_test
+
+import (
+	"testing"
+
+	. "github.com/KuChainNetwork/kuchain/chain/ante"
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	distrTypes "github.com/KuChainNetwork/kuchain/x/distribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAnteFreeTxHandler(t *testing.T) {
+	app, _ := createAppForTest()
+
+	ak := *app.AccountKeeper()
+	asset := app.AssetKeeper()
+	distr := app.DistrKeeper()
+	handler := sdk.ChainAnteDecorators(
+		NewFreeTxDecorator(ak, distr),
+		NewMempoolFeeDecorator(),
+	)
+
+	highGasPrice := types.DecCoins{types.NewDecCoinFromDec(constants.DefaultBondDenom, types.NewDec(1))}
+
+	Convey("FreeTxDecorator test free tx ante handler", t, func() {
+		ctx := app.NewTestContext().WithIsCheckTx(true).WithMinGasPrices(highGasPrice.ToSDK())
+
+		stdTx4Test := testStdTx(app, account5)
+		stdTx4Test.Fee.Amount = types.Coins{}
+		stdTx4Test.Fee.Gas = distr.GetFreeTxMaxGas(ctx)
+
+		allowance := distr.GetFreeTxAllowance(ctx)
+		So(allowance, ShouldBeGreaterThan, 0)
+
+		Convey("test free tx of the account not new", func() {
+			ctx, _ := ctx.CacheContext()
+
+			pool := types.NewInt64CoreCoins(100000000)
+			_, err := asset.IssueCoinPower(ctx, distrTypes.ModuleAccountID, pool)
+			So(err, ShouldBeNil)
+
+			feePool := distr.GetFeePool(ctx)
+			feePool.CommunityPool = feePool.CommunityPool.Add(types.NewDecCoinsFromCoins(pool...)...)
+			distr.SetFeePool(ctx, feePool)
+
+			// the account in genesis is not new
+			_, err = handler(ctx, stdTx4Test, false)
+			So(err, simapp.ShouldErrIs, sdkerrors.ErrInsufficientFee)
+			So(distr.GetFreeTxUsed(ctx, account5), ShouldEqual, 0)
+
+			// the account created out of the free tx account age
+			age := distr.GetFreeTxAccountAge(ctx)
+			ak.SetAccountCreatedHeight(ctx, name5, ctx.BlockHeight())
+			_, err = handler(ctx.WithBlockHeight(ctx.BlockHeight()+age+1), stdTx4Test, false)
+			So(err, simapp.ShouldErrIs, sdkerrors.ErrInsufficientFee)
+			So(distr.GetFreeTxUsed(ctx, account5), ShouldEqual, 0)
+
+			_, err = handler(ctx.WithBlockHeight(ctx.BlockHeight()+age), stdTx4Test, false)
+			So(err, ShouldBeNil)
+			So(distr.GetFreeTxUsed(ctx, account5), ShouldEqual, 1)
+		})
+
+		ak.SetAccountCreatedHeight(ctx, name5, ctx.BlockHeight())
+
+		Convey("test free tx without community pool funds", func() {
+			_, err := handler(ctx, stdTx4Test, false)
+			So(err, simapp.ShouldErrIs, sdkerrors.ErrInsufficientFee)
+			So(distr.GetFreeTxUsed(ctx, account5), ShouldEqual, 0)
+		})
+
+		Convey("test free tx paid by community pool", func() {
+			pool := types.NewInt64CoreCoins(100000000)
+			_, err := asset.IssueCoinPower(ctx, distrTypes.ModuleAccountID, pool)
+			So(err, ShouldBeNil)
+
+			feePool := distr.GetFeePool(ctx)
+			feePool.CommunityPool = feePool.CommunityPool.Add(types.NewDecCoinsFromCoins(pool...)...)
+			distr.SetFeePool(ctx, feePool)
+
+			fee := distr.FreeTxFee(ctx, stdTx4Test.Fee.Gas)
+			So(fee.IsZero(), ShouldBeFalse)
+
+			for i := uint64(1); i <= allowance; i++ {
+				collectorOld := asset.GetCoinPowers(ctx, constants.GetFeeCollector())
+
+				_, err := handler(ctx, stdTx4Test, false)
+				So(err, ShouldBeNil)
+				So(distr.GetFreeTxUsed(ctx, account5), ShouldEqual, i)
+
+				collectorAfter := asset.GetCoinPowers(ctx, constants.GetFeeCollector())
+				So(collectorOld.Add(fee...), simapp.ShouldEq, collectorAfter)
+			}
+
+			poolCost := types.NewDecCoinsFromCoins(fee...).MulDec(types.NewDec(int64(allowance)))
+			So(distr.GetFeePool(ctx).CommunityPool.IsEqual(feePool.CommunityPool.Sub(poolCost)), ShouldBeTrue)
+
+			// no allowance left
+			_, err = handler(ctx, stdTx4Test, false)
+			So(err, simapp.ShouldErrIs, sdkerrors.ErrInsufficientFee)
+			So(distr.GetFreeTxUsed(ctx, account5), ShouldEqual, allowance)
+		})
+
+		Convey("test tx with fee not use allowance", func() {
+			stdTx4Test.Fee.Amount = simapp.DefaultTestFee
+			usedOld := distr.GetFreeTxUsed(ctx, account5)
+
+			_, err := handler(ctx.WithMinGasPrices(sdk.DecCoins{}), stdTx4Test, false)
+			So(err, ShouldBeNil)
+			So(distr.GetFreeTxUsed(ctx, account5), ShouldEqual, usedOld)
+		})
+	})
+}
@@ -1,6 +1,7 @@
 package ante
 
 import (
+	"github.com/KuChainNetwork/kuchain/chain/sigcache"
 	"github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/account/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,16 +13,25 @@ type (
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
-func NewHandler(ak keeper.AccountKeeper, asset AssetKeeper) sdk.AnteHandler {
+// signer. The signatures verified in CheckTx are cached in the sigCache if not nil.
+func NewHandler(ak keeper.AccountKeeper, asset AssetKeeper, distr DistributionKeeper, lane LaneKeeper, feemarket FeeMarketKeeper, feature FeatureKeeper, sigCache *sigcache.Cache) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		NewSetUpContextDecorator(),
 		NewValidateBasicDecorator(),
+		NewTxTimeoutHeightDecorator(),
+		NewEncryptedMemoDecorator(),
+		NewMaintenanceDecorator(feature),
+		NewSubAccountScopeDecorator(ak),
+		NewDeactivationDecorator(ak),
+		NewFreeTxDecorator(ak, distr),
 		NewMempoolFeeDecorator(),
 		NewConsumeGasForTxSizeDecorator(),
 		NewDeductFeeDecorator(ak, asset),
+		NewLaneDecorator(lane),
+		NewBaseFeeDecorator(feemarket),
+		NewReferralFeeDecorator(ak, asset, distr, feemarket),
 		NewSetPubKeyDecorator(ak),
-		NewSigVerificationDecorator(ak),
+		NewCachedSigVerificationDecorator(ak, sigCache),
 		NewIncrementSequenceDecorator(ak),
 		NewPluginHandlerDecorator(),
 	)
@@ -3,6 +3,7 @@ package ante
 import (
 	"github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/account/exported"
+	laneTypes "github.com/KuChainNetwork/kuchain/x/lane/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,11 +14,59 @@ type SigVerifiableTx interface {
 	GetSigners() []types.AccAddress
 }
 
+// TxWithTimeoutHeight defines a Tx interface with the timeout height
+type TxWithTimeoutHeight interface {
+	types.Tx
+	GetTimeoutHeight() uint64
+}
+
+// TxWithMemo defines a Tx interface with the memo
+type TxWithMemo interface {
+	types.Tx
+	GetMemo() string
+}
+
 // AssetKeeper
 type AssetKeeper interface {
 	PayFee(sdk.Context, types.AccountID, types.Coins) error
+	SendCoinPower(ctx sdk.Context, from, to types.AccountID, amt types.Coins) error
+}
+
+// ReferralParamsKeeper get the params for referral fee
+type ReferralParamsKeeper interface {
+	GetReferralFeeRate(ctx sdk.Context) sdk.Dec
+}
+
+// FreeTxKeeper use the free tx allowance of payer
+type FreeTxKeeper interface {
+	UseFreeTxAllowance(ctx sdk.Context, payer types.AccountID, gas uint64) (bool, error)
+}
+
+// LaneKeeper consume the block space of lanes
+type LaneKeeper interface {
+	ConsumeLaneGas(ctx sdk.Context, lane laneTypes.Lane, gas uint64) error
+}
+
+// FeeMarketKeeper get and collect the base fee of tx
+type FeeMarketKeeper interface {
+	BaseFeeOf(ctx sdk.Context, gas uint64) types.Coins
+	CollectBaseFee(ctx sdk.Context, baseFee types.Coins) error
+}
+
+// FeatureKeeper checks the msgs allowed in maintenance mode
+type FeatureKeeper interface {
+	ValidateMsgsAllowed(ctx sdk.Context, msgs []sdk.Msg) error
+}
+
+// DistributionKeeper the distribution keeper used by ante handler
+type DistributionKeeper interface {
+	ReferralParamsKeeper
+	FreeTxKeeper
 }
 
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, id AccountID) exported.Account
+	IsAccountDeactivated(ctx sdk.Context, name types.Name) bool
+	ValidateMsgsScope(ctx sdk.Context, msgs []sdk.Msg) error
+	ValidateMsgsActive(ctx sdk.Context, msgs []sdk.Msg) error
 }
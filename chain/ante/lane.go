@@ -0,0 +1,41 @@
+package ante
+
+import (
+	laneTypes "github.com/KuChainNetwork/kuchain/x/lane/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// LaneDecorator puts the tx into its lane, gov votes, evidence submissions and unjail txs
+// are in priority lanes which have reserved block space, so that they cannot be crowded out.
+// The gas of the tx is consumed from its lane in CheckTx and DeliverTx, so the txs accepted
+// into the mempool in a block are limited by the lanes too, the tx will fail if no space left.
+// The txs in all lanes pay the fee by the min gas prices and the base fee.
+// CONTRACT: should be after the DeductFeeDecorator
+type LaneDecorator struct {
+	lk LaneKeeper
+}
+
+func NewLaneDecorator(lk LaneKeeper) LaneDecorator {
+	return LaneDecorator{
+		lk: lk,
+	}
+}
+
+func (ld LaneDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	lane := laneTypes.GetTxLane(tx.GetMsgs())
+	if err := ld.lk.ConsumeLaneGas(ctx, lane, feeTx.GetGas()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,71 @@
+package ante_test
+
+import (
+	"testing"
+
+	. "github.com/KuChainNetwork/kuchain/chain/ante"
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	laneTypes "github.com/KuChainNetwork/kuchain/x/lane/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestAnteLaneHandler(t *testing.T) {
+	app, _ := createAppForTest()
+
+	lk := app.LaneKeeper()
+	handler := sdk.ChainAnteDecorators(
+		NewMempoolFeeDecorator(),
+		NewLaneDecorator(lk),
+	)
+
+	vote := govTypes.NewKuMsgVote(addr1, account1, 1, govTypes.OptionYes)
+
+	Convey("LaneDecorator test the priority lane pays the min gas prices", t, func() {
+		voteTx := simapp.NewTxForTest(account1, []sdk.Msg{vote}, wallet.PrivKey(addr1)).GetTx(app)
+		voteTx.Fee.Gas = 1000
+
+		ctx, _ := app.NewTestContext().WithIsCheckTx(true).CacheContext()
+		minGasPrices := types.DecCoins{types.NewDecCoinFromDec(constants.DefaultBondDenom, types.NewDec(1))}
+
+		voteTx.Fee.Amount = types.Coins{}
+		_, err := handler(ctx.WithMinGasPrices(minGasPrices.ToSDK()), voteTx, false)
+		So(err, simapp.ShouldErrIs, sdkerrors.ErrInsufficientFee)
+		So(lk.GetLaneGasUsed(ctx, laneTypes.LaneGov), ShouldEqual, 0)
+
+		voteTx.Fee.Amount = types.NewInt64CoreCoins(1000)
+		_, err = handler(ctx.WithMinGasPrices(minGasPrices.ToSDK()), voteTx, false)
+		So(err, ShouldBeNil)
+		So(lk.GetLaneGasUsed(ctx, laneTypes.LaneGov), ShouldEqual, 1000)
+	})
+
+	Convey("LaneDecorator test the lane gas consumed in CheckTx", t, func() {
+		voteTx := simapp.NewTxForTest(account1, []sdk.Msg{vote}, wallet.PrivKey(addr1)).GetTx(app)
+		voteTx.Fee.Gas = 1000
+
+		ctx, _ := app.NewTestContext().WithIsCheckTx(true).WithConsensusParams(&abci.ConsensusParams{
+			Block: &abci.BlockParams{MaxGas: 5500},
+		}).CacheContext()
+		lk.SetParams(ctx, laneTypes.NewParams(1500, 1000, 1000))
+
+		// the gov lane is full, then the default lane is used
+		for i := 0; i < 3; i++ {
+			_, err := handler(ctx, voteTx, false)
+			So(err, ShouldBeNil)
+		}
+		So(lk.GetLaneGasUsed(ctx, laneTypes.LaneGov), ShouldEqual, 1000)
+		So(lk.GetLaneGasUsed(ctx, laneTypes.LaneDefault), ShouldEqual, 2000)
+
+		_, err := handler(ctx, voteTx, false)
+		So(err, simapp.ShouldErrIs, laneTypes.ErrLaneFull)
+
+		// no lane gas consumed in simulation
+		_, err = handler(ctx, voteTx, true)
+		So(err, ShouldBeNil)
+	})
+}
@@ -0,0 +1,25 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaintenanceDecorator rejects the txs with the msgs not in the whitelist in maintenance mode,
+// the chain is in maintenance mode by governance, or the node for CheckTx only.
+type MaintenanceDecorator struct {
+	fk FeatureKeeper
+}
+
+func NewMaintenanceDecorator(fk FeatureKeeper) MaintenanceDecorator {
+	return MaintenanceDecorator{
+		fk: fk,
+	}
+}
+
+func (md MaintenanceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := md.fk.ValidateMsgsAllowed(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,47 @@
+package ante
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	EventTypeEncryptedMemo    = "encrypted_memo"
+	AttributeKeyMemoRecipient = "recipient"
+)
+
+// EncryptedMemoDecorator rejects the tx with a malformed encrypted memo, and emits an event with
+// the recipient of the encrypted memo, so the wallets can find the memos to decrypt by the events.
+// The sealed box can only be checked by the recipient, so the content of the memo is not verified.
+type EncryptedMemoDecorator struct{}
+
+func NewEncryptedMemoDecorator() EncryptedMemoDecorator {
+	return EncryptedMemoDecorator{}
+}
+
+func (emd EncryptedMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	memoTx, ok := tx.(TxWithMemo)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	}
+
+	memo := memoTx.GetMemo()
+	if !types.IsEncryptedMemo(memo) {
+		return next(ctx, tx, simulate)
+	}
+
+	recipient, _, err := types.ParseEncryptedMemo(memo)
+	if err != nil {
+		return ctx, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeEncryptedMemo,
+			sdk.NewAttribute(AttributeKeyMemoRecipient, recipient.String()),
+		),
+	)
+
+	return next(ctx, tx, simulate)
+}
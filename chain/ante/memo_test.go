@@ -0,0 +1,44 @@
+package ante_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/ante"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEncryptedMemo(t *testing.T) {
+	app, ctx := createAppForTest()
+
+	Convey("test encrypted memo check", t, func() {
+		antehandler := sdk.ChainAnteDecorators(ante.NewEncryptedMemoDecorator())
+		memoKey, _ := types.DeriveMemoKey([]byte("seed"))
+
+		// plain memo
+		tx := testStdTx(app, account2)
+		tx.Memo = "invoice 42"
+		newCtx, err := antehandler(ctx.WithEventManager(sdk.NewEventManager()), tx, false)
+		So(err, ShouldBeNil)
+		So(newCtx.EventManager().Events(), ShouldBeEmpty)
+
+		memo, err := types.EncryptMemo(account2, memoKey, "invoice 42")
+		So(err, ShouldBeNil)
+
+		tx.Memo = memo
+		newCtx, err = antehandler(ctx.WithEventManager(sdk.NewEventManager()), tx, false)
+		So(err, ShouldBeNil)
+
+		events := newCtx.EventManager().Events()
+		So(events, ShouldHaveLength, 1)
+		So(events[0].Type, ShouldEqual, ante.EventTypeEncryptedMemo)
+		So(string(events[0].Attributes[0].Value), ShouldEqual, account2.String())
+
+		tx.Memo = types.EncryptedMemoPrefix + account2.String() + ":invalid"
+		_, err = antehandler(ctx, tx, false)
+		So(err, simapp.ShouldErrIs, types.ErrEncryptedMemo)
+	})
+}
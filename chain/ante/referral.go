@@ -0,0 +1,90 @@
+package ante
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	EventTypeReferralFee    = "referral_fee"
+	AttributeKeyReferrer    = "referrer"
+	AttributeKeyReferralFee = "fee"
+)
+
+// ReferralFeeTx defines the interface to be implemented by Tx to use the ReferralFeeDecorator
+type ReferralFeeTx interface {
+	FeeTx
+	FeeReferrer() AccountID
+}
+
+// ReferralFeeDecorator send a share of the fee which has been paid to fee collector
+// to the referrer of tx, the share is capped by the referral fee rate param.
+// The base fee is not shared, it will be burned or sent to community pool.
+// CONTRACT: should be after the DeductFeeDecorator and the BaseFeeDecorator
+type ReferralFeeDecorator struct {
+	ak      AssetKeeper
+	account AccountKeeper
+	params  ReferralParamsKeeper
+	fm      FeeMarketKeeper
+}
+
+func NewReferralFeeDecorator(acc AccountKeeper, ak AssetKeeper, params ReferralParamsKeeper, fm FeeMarketKeeper) ReferralFeeDecorator {
+	return ReferralFeeDecorator{
+		ak:      ak,
+		account: acc,
+		params:  params,
+		fm:      fm,
+	}
+}
+
+func (rfd ReferralFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(ReferralFeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a ReferralFeeTx")
+	}
+
+	referrer := feeTx.FeeReferrer()
+	if referrer.Empty() || referrer.Eq(feeTx.FeePayer()) || feeTx.GetFee().IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	if _, ok := referrer.ToName(); ok {
+		if acc := rfd.account.GetAccount(ctx, referrer); acc == nil {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "referrer %s not found", referrer)
+		}
+	}
+
+	tip := TipOf(ctx, rfd.fm, feeTx.GetFee(), feeTx.GetGas())
+	share := ReferralFee(tip, rfd.params.GetReferralFeeRate(ctx))
+	if share.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	ctx.Logger().Debug("referral fee", "referrer", referrer, "fee", share)
+
+	if err := rfd.ak.SendCoinPower(ctx, constants.GetFeeCollector(), referrer, share); err != nil {
+		return ctx, sdkerrors.Wrap(err, "pay referral fee")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeReferralFee,
+			sdk.NewAttribute(AttributeKeyReferrer, referrer.String()),
+			sdk.NewAttribute(AttributeKeyReferralFee, share.String()),
+		),
+	)
+
+	return next(ctx, tx, simulate)
+}
+
+// ReferralFee returns the share of fee to referrer, the decimal part will be truncated
+func ReferralFee(fee Coins, rate types.Dec) Coins {
+	if rate.IsNil() || !rate.IsPositive() {
+		return Coins{}
+	}
+
+	share, _ := types.NewDecCoinsFromCoins(fee...).MulDecTruncate(rate).TruncateDecimal()
+	return share
+}
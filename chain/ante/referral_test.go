@@ -0,0 +1,77 @@
+package ante_test
+
+import (
+	"testing"
+
+	. "github.com/KuChainNetwork/kuchain/chain/ante"
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAnteReferralFeeHandler(t *testing.T) {
+	app, _ := createAppForTest()
+
+	ak := *app.AccountKeeper()
+	asset := app.AssetKeeper()
+	distr := app.DistrKeeper()
+	feemarket := app.FeeMarketKeeper()
+	handler := sdk.ChainAnteDecorators(
+		NewDeductFeeDecorator(ak, asset),
+		NewReferralFeeDecorator(ak, asset, distr, feemarket),
+	)
+
+	Convey("ReferralFeeDecorator test referral fee ante handler", t, func() {
+		stdTx4Test := testStdTx(app, account4)
+		ctx := app.NewTestContext()
+
+		// the base fee is not shared to referrer
+		tip := TipOf(ctx, feemarket, simapp.DefaultTestFee, stdTx4Test.Fee.Gas)
+		share := ReferralFee(tip, distr.GetReferralFeeRate(ctx))
+		So(share.IsZero(), ShouldBeFalse)
+
+		Convey("test referrer get a share of fee", func() {
+			stdTx4Test.Fee.Referrer = account5
+
+			powerOld := asset.GetCoinPowers(ctx, account5)
+			collectorOld := asset.GetCoinPowers(ctx, constants.GetFeeCollector())
+
+			_, err := handler(ctx, stdTx4Test, true)
+			So(err, ShouldBeNil)
+
+			powerAfter := asset.GetCoinPowers(ctx, account5)
+			collectorAfter := asset.GetCoinPowers(ctx, constants.GetFeeCollector())
+
+			So(powerOld.Add(share...), simapp.ShouldEq, powerAfter)
+			So(collectorOld.Add(simapp.DefaultTestFee...).Sub(share), simapp.ShouldEq, collectorAfter)
+		})
+
+		Convey("test referrer is payer will get nothing", func() {
+			stdTx4Test.Fee.Referrer = account4
+
+			collectorOld := asset.GetCoinPowers(ctx, constants.GetFeeCollector())
+
+			_, err := handler(ctx, stdTx4Test, true)
+			So(err, ShouldBeNil)
+
+			collectorAfter := asset.GetCoinPowers(ctx, constants.GetFeeCollector())
+			So(collectorOld.Add(simapp.DefaultTestFee...), simapp.ShouldEq, collectorAfter)
+		})
+
+		Convey("test referrer no existing", func() {
+			stdTx4Test.Fee.Referrer = types.MustAccountID("adddddd")
+
+			_, err := handler(ctx, stdTx4Test, true)
+			So(err, simapp.ShouldErrIs, sdkerrors.ErrUnknownAddress)
+		})
+
+		Convey("test referral fee capped by rate", func() {
+			fee := types.NewInt64CoreCoins(1001)
+			So(ReferralFee(fee, sdk.NewDecWithPrec(1, 1)), simapp.ShouldEq, types.NewInt64CoreCoins(100))
+			So(ReferralFee(fee, types.NewDec(0)).IsZero(), ShouldBeTrue)
+		})
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
 	"github.com/KuChainNetwork/kuchain/chain/constants/keys"
+	"github.com/KuChainNetwork/kuchain/chain/sigcache"
 	"github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/account/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -22,7 +23,8 @@ import (
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
-	ak keeper.AccountKeeper
+	ak    keeper.AccountKeeper
+	cache *sigcache.Cache
 }
 
 func NewSigVerificationDecorator(ak keeper.AccountKeeper) SigVerificationDecorator {
@@ -31,6 +33,15 @@ func NewSigVerificationDecorator(ak keeper.AccountKeeper) SigVerificationDecorat
 	}
 }
 
+// NewCachedSigVerificationDecorator creates the decorator which caches the signatures verified in CheckTx,
+// so the signatures are not verified again in DeliverTx, the cache can be nil.
+func NewCachedSigVerificationDecorator(ak keeper.AccountKeeper, cache *sigcache.Cache) SigVerificationDecorator {
+	return SigVerificationDecorator{
+		ak:    ak,
+		cache: cache,
+	}
+}
+
 func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// no need to verify signatures on recheck tx
 	if ctx.IsReCheckTx() {
@@ -74,8 +85,8 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
-		// verify signature
-		if !simulate && !pubKey.VerifyBytes(signBytes, sig.Signature) {
+		// verify signature, the ones verified in CheckTx are kept in the cache for DeliverTx
+		if !simulate && !svd.cache.VerifyBytes(pubKey, signBytes, sig.Signature, ctx.IsCheckTx()) {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed; verify correct account sequence and chain-id")
 		}
 	}
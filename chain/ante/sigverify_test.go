@@ -8,6 +8,7 @@ import (
 
 	"github.com/KuChainNetwork/kuchain/chain/ante"
 	"github.com/KuChainNetwork/kuchain/chain/constants/keys"
+	"github.com/KuChainNetwork/kuchain/chain/sigcache"
 	"github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/test/simapp"
 	"github.com/KuChainNetwork/kuchain/x/account"
@@ -164,6 +165,44 @@ func TestSigVerification(t *testing.T) {
 	})
 }
 
+func TestCachedSigVerification(t *testing.T) {
+	app, _ := createAppForTest()
+	ctx := app.NewTestContext()
+
+	Convey("test sig verification with the cache", t, func() {
+		priv1, priv2, priv3 := wallet.PrivKey(addr1), wallet.PrivKey(addr2), wallet.PrivKey(addr3)
+		ttx := testStdTx(app, account1, addAccount2, account3)
+
+		ak := app.AccountKeeper()
+		cache := sigcache.NewCache(sigcache.DefaultConfig())
+		antehandler := sdk.ChainAnteDecorators(
+			ante.NewSetPubKeyDecorator(*ak),
+			ante.NewCachedSigVerificationDecorator(*ak, cache))
+
+		privs := []crypto.PrivKey{priv1, priv2, priv3}
+		tx := simapp.NewTestTx(ctx, ttx.Msgs, privs, []uint64{1, 2, 3}, []uint64{1, 1, 1}, ttx.Fee)
+
+		// the invalid signatures are not cached
+		invalid := simapp.NewTestTx(ctx, ttx.Msgs, privs, []uint64{1, 2, 3}, []uint64{2, 2, 2}, ttx.Fee)
+		_, err := antehandler(ctx.WithIsCheckTx(true), invalid, false)
+		So(err, ShouldNotBeNil)
+		So(cache.Len(), ShouldEqual, 0)
+
+		// the signatures are cached when checked
+		_, err = antehandler(ctx.WithIsCheckTx(true), tx, false)
+		So(err, ShouldBeNil)
+		So(cache.Len(), ShouldEqual, 3)
+
+		// and dropped when delivered
+		_, err = antehandler(ctx.WithIsCheckTx(false), tx, false)
+		So(err, ShouldBeNil)
+		So(cache.Len(), ShouldEqual, 0)
+
+		_, err = antehandler(ctx.WithIsCheckTx(false), invalid, false)
+		So(err, ShouldNotBeNil)
+	})
+}
+
 func TestIncrementSequenceDecorator(t *testing.T) {
 	app, ctx := createAppForTest()
 
@@ -0,0 +1,25 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SubAccountScopeDecorator rejects the txs with the msgs signed by the auth of a sub-account,
+// but not in the permissions of the sub-account, the permissions are set by the parent account.
+type SubAccountScopeDecorator struct {
+	ak AccountKeeper
+}
+
+func NewSubAccountScopeDecorator(ak AccountKeeper) SubAccountScopeDecorator {
+	return SubAccountScopeDecorator{
+		ak: ak,
+	}
+}
+
+func (sd SubAccountScopeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := sd.ak.ValidateMsgsScope(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
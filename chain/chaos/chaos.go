@@ -0,0 +1,230 @@
+//go:build chaos
+// +build chaos
+
+package chaos
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Enabled the faults are compiled into the binary
+const Enabled = true
+
+// Injector injects the random faults by the config
+type Injector struct {
+	config Config
+	logger log.Logger
+
+	mtx  sync.Mutex
+	rand *rand.Rand
+}
+
+// NewInjector creates the injector, which injects nothing if the config not enabled
+func NewInjector(config Config, logger log.Logger) (*Injector, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	seed := config.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	if config.Enable {
+		logger.Error("CHAOS faults enabled, ONLY for the testnets", "seed", seed,
+			"abci-delay-rate", config.ABCIDelayRate, "peer-drop-interval", config.PeerDropInterval,
+			"disk-delay-rate", config.DiskDelayRate)
+	}
+
+	return &Injector{
+		config: config,
+		logger: logger,
+		rand:   rand.New(rand.NewSource(seed)),
+	}, nil
+}
+
+// WrapApplication returns the app with the calls of the consensus connection delayed randomly
+func (i *Injector) WrapApplication(app abci.Application) abci.Application {
+	if !i.config.Enable || i.config.ABCIDelayRate == 0 || i.config.ABCIMaxDelay == 0 {
+		return app
+	}
+
+	return &application{Application: app, injector: i}
+}
+
+// WrapDB returns the db with the writes delayed randomly
+func (i *Injector) WrapDB(db dbm.DB) dbm.DB {
+	if !i.config.Enable || i.config.DiskDelayRate == 0 || i.config.DiskMaxDelay == 0 {
+		return db
+	}
+
+	return &slowDB{DB: db, injector: i}
+}
+
+// DBProvider returns the provider of the tendermint databases with the writes delayed randomly
+func (i *Injector) DBProvider(provider node.DBProvider) node.DBProvider {
+	return func(ctx *node.DBContext) (dbm.DB, error) {
+		db, err := provider(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return i.WrapDB(db), nil
+	}
+}
+
+// StartPeerDropper drops a random peer of the switch in each interval, returns the func to stop it
+func (i *Injector) StartPeerDropper(sw *p2p.Switch) func() {
+	if !i.config.Enable || i.config.PeerDropInterval == 0 || i.config.PeerDropRate == 0 {
+		return func() {}
+	}
+
+	quit := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(i.config.PeerDropInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-quit:
+				return
+			case <-ticker.C:
+				i.dropPeer(sw)
+			}
+		}
+	}()
+
+	return func() { close(quit) }
+}
+
+func (i *Injector) dropPeer(sw *p2p.Switch) {
+	peers := sw.Peers().List()
+	if len(peers) == 0 || !i.hit(i.config.PeerDropRate) {
+		return
+	}
+
+	peer := peers[i.intn(len(peers))]
+	i.logger.Info("chaos drop peer", "peer", peer.ID())
+	sw.StopPeerForError(peer, fmt.Errorf("dropped by chaos"))
+}
+
+// delay sleeps a random duration in [0, max) with the probability of rate
+func (i *Injector) delay(rate float64, max time.Duration, what string) {
+	if !i.hit(rate) {
+		return
+	}
+
+	d := time.Duration(i.int63n(int64(max)))
+	i.logger.Debug("chaos delay", "what", what, "delay", d)
+	time.Sleep(d)
+}
+
+func (i *Injector) hit(rate float64) bool {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	return i.rand.Float64() < rate
+}
+
+func (i *Injector) intn(n int) int {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	return i.rand.Intn(n)
+}
+
+func (i *Injector) int63n(n int64) int64 {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+
+	return i.rand.Int63n(n)
+}
+
+// application the app with the calls of the consensus connection delayed
+type application struct {
+	abci.Application
+	injector *Injector
+}
+
+func (a *application) delay(what string) {
+	a.injector.delay(a.injector.config.ABCIDelayRate, a.injector.config.ABCIMaxDelay, what)
+}
+
+func (a *application) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	a.delay("begin_block")
+	return a.Application.BeginBlock(req)
+}
+
+func (a *application) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	a.delay("deliver_tx")
+	return a.Application.DeliverTx(req)
+}
+
+func (a *application) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	a.delay("end_block")
+	return a.Application.EndBlock(req)
+}
+
+func (a *application) Commit() abci.ResponseCommit {
+	a.delay("commit")
+	return a.Application.Commit()
+}
+
+// slowDB the db with the writes delayed
+type slowDB struct {
+	dbm.DB
+	injector *Injector
+}
+
+func (db *slowDB) delay(what string) {
+	db.injector.delay(db.injector.config.DiskDelayRate, db.injector.config.DiskMaxDelay, what)
+}
+
+func (db *slowDB) Set(key, value []byte) error {
+	db.delay("db_set")
+	return db.DB.Set(key, value)
+}
+
+func (db *slowDB) SetSync(key, value []byte) error {
+	db.delay("db_set")
+	return db.DB.SetSync(key, value)
+}
+
+func (db *slowDB) Delete(key []byte) error {
+	db.delay("db_delete")
+	return db.DB.Delete(key)
+}
+
+func (db *slowDB) DeleteSync(key []byte) error {
+	db.delay("db_delete")
+	return db.DB.DeleteSync(key)
+}
+
+func (db *slowDB) NewBatch() dbm.Batch {
+	return &slowBatch{Batch: db.DB.NewBatch(), db: db}
+}
+
+// slowBatch the batch of the slow db, the writes of which are delayed
+type slowBatch struct {
+	dbm.Batch
+	db *slowDB
+}
+
+func (b *slowBatch) Write() error {
+	b.db.delay("db_batch_write")
+	return b.Batch.Write()
+}
+
+func (b *slowBatch) WriteSync() error {
+	b.db.delay("db_batch_write")
+	return b.Batch.WriteSync()
+}
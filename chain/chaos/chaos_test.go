@@ -0,0 +1,71 @@
+//go:build chaos
+// +build chaos
+
+package chaos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func newTestInjector(t *testing.T, rate float64) *Injector {
+	config := DefaultConfig()
+	config.Enable = true
+	config.Seed = 1
+	config.ABCIDelayRate = rate
+	config.ABCIMaxDelay = 50 * time.Millisecond
+	config.DiskDelayRate = rate
+	config.DiskMaxDelay = 50 * time.Millisecond
+
+	injector, err := NewInjector(config, log.NewNopLogger())
+	require.NoError(t, err)
+
+	return injector
+}
+
+// elapsed returns the total duration of the calls
+func elapsed(n int, call func()) time.Duration {
+	start := time.Now()
+	for i := 0; i < n; i++ {
+		call()
+	}
+
+	return time.Since(start)
+}
+
+func TestApplicationDelay(t *testing.T) {
+	app := newTestInjector(t, 1).WrapApplication(abci.NewBaseApplication())
+
+	// the calls of the consensus connection are delayed, the others are not
+	require.True(t, elapsed(10, func() { app.Commit() }) > 50*time.Millisecond)
+	require.True(t, elapsed(10, func() { app.Info(abci.RequestInfo{}) }) < 50*time.Millisecond)
+
+	// nothing delayed if the rate is zero
+	app = newTestInjector(t, 0).WrapApplication(abci.NewBaseApplication())
+	require.True(t, elapsed(10, func() { app.Commit() }) < 50*time.Millisecond)
+}
+
+func TestDBDelay(t *testing.T) {
+	db := newTestInjector(t, 1).WrapDB(dbm.NewMemDB())
+
+	require.True(t, elapsed(10, func() {
+		batch := db.NewBatch()
+		batch.Set([]byte("key"), []byte("value"))
+		require.NoError(t, batch.Write())
+	}) > 50*time.Millisecond)
+
+	// the writes are applied
+	value, err := db.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	require.True(t, elapsed(10, func() {
+		_, err := db.Get([]byte("key"))
+		require.NoError(t, err)
+	}) < 50*time.Millisecond)
+}
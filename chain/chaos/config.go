@@ -0,0 +1,150 @@
+// Package chaos injects the faults into the node for the testnets, such as the random delays of the ABCI calls,
+// the dropped peers and the slow disk, so the protocol behavior under adverse conditions, such as the missed
+// proposals and the round skips, can be exercised regularly.
+//
+// The faults are only compiled into the binary built with the `chaos` build tag, such as:
+//
+//	go build -tags chaos ./cmd/kucd
+//
+// and then enabled by the [chaos] section of app.toml, the binary built without the tag ignores the config.
+package chaos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// The keys of the chaos config in the [chaos] section of app.toml
+const (
+	FlagEnable           = "chaos.enable"
+	FlagSeed             = "chaos.seed"
+	FlagABCIDelayRate    = "chaos.abci-delay-rate"
+	FlagABCIMaxDelay     = "chaos.abci-max-delay"
+	FlagPeerDropInterval = "chaos.peer-drop-interval"
+	FlagPeerDropRate     = "chaos.peer-drop-rate"
+	FlagDiskDelayRate    = "chaos.disk-delay-rate"
+	FlagDiskMaxDelay     = "chaos.disk-max-delay"
+)
+
+// Config the config of the faults injected
+type Config struct {
+	Enable bool  // Enable enables the faults
+	Seed   int64 // Seed the seed of the random faults, by the time if 0
+
+	ABCIDelayRate float64       // ABCIDelayRate the probability to delay a call of the consensus connection to the app
+	ABCIMaxDelay  time.Duration // ABCIMaxDelay the max delay of a call, the delays are random in [0, max)
+
+	PeerDropInterval time.Duration // PeerDropInterval the interval to try to drop a random peer, disabled if 0
+	PeerDropRate     float64       // PeerDropRate the probability to drop a peer in each interval
+
+	DiskDelayRate float64       // DiskDelayRate the probability to delay a write to the databases
+	DiskMaxDelay  time.Duration // DiskMaxDelay the max delay of a write, the delays are random in [0, max)
+}
+
+// DefaultConfig returns the default config, the faults are disabled by default
+func DefaultConfig() Config {
+	return Config{
+		Enable:           false,
+		ABCIDelayRate:    0.1,
+		ABCIMaxDelay:     2 * time.Second,
+		PeerDropInterval: time.Minute,
+		PeerDropRate:     0.5,
+		DiskDelayRate:    0.05,
+		DiskMaxDelay:     500 * time.Millisecond,
+	}
+}
+
+// Validate returns error if the config is invalid
+func (c Config) Validate() error {
+	if err := validateRate(FlagABCIDelayRate, c.ABCIDelayRate); err != nil {
+		return err
+	}
+	if err := validateRate(FlagPeerDropRate, c.PeerDropRate); err != nil {
+		return err
+	}
+	if err := validateRate(FlagDiskDelayRate, c.DiskDelayRate); err != nil {
+		return err
+	}
+
+	if c.ABCIMaxDelay < 0 || c.PeerDropInterval < 0 || c.DiskMaxDelay < 0 {
+		return fmt.Errorf("the delays and the interval should not be negative")
+	}
+
+	return nil
+}
+
+func validateRate(name string, rate float64) error {
+	if rate < 0 || rate > 1 {
+		return fmt.Errorf("%s should be in [0, 1], got %v", name, rate)
+	}
+
+	return nil
+}
+
+// ReadConfig reads the config from viper, which has the app.toml merged in
+func ReadConfig() Config {
+	config := DefaultConfig()
+
+	if viper.IsSet(FlagEnable) {
+		config.Enable = viper.GetBool(FlagEnable)
+	}
+	if viper.IsSet(FlagSeed) {
+		config.Seed = viper.GetInt64(FlagSeed)
+	}
+	if viper.IsSet(FlagABCIDelayRate) {
+		config.ABCIDelayRate = viper.GetFloat64(FlagABCIDelayRate)
+	}
+	if viper.IsSet(FlagABCIMaxDelay) {
+		config.ABCIMaxDelay = viper.GetDuration(FlagABCIMaxDelay)
+	}
+	if viper.IsSet(FlagPeerDropInterval) {
+		config.PeerDropInterval = viper.GetDuration(FlagPeerDropInterval)
+	}
+	if viper.IsSet(FlagPeerDropRate) {
+		config.PeerDropRate = viper.GetFloat64(FlagPeerDropRate)
+	}
+	if viper.IsSet(FlagDiskDelayRate) {
+		config.DiskDelayRate = viper.GetFloat64(FlagDiskDelayRate)
+	}
+	if viper.IsSet(FlagDiskMaxDelay) {
+		config.DiskMaxDelay = viper.GetDuration(FlagDiskMaxDelay)
+	}
+
+	return config
+}
+
+// ConfigTemplate the chaos section appended to the app.toml created by the binary built with the chaos tag
+const ConfigTemplate = `
+###############################################################################
+###                           Chaos Configuration                           ###
+###############################################################################
+
+[chaos]
+
+# Inject the faults into the node, ONLY for the testnets.
+enable = false
+
+# The seed of the random faults, by the time if 0
+seed = 0
+
+# The probability to delay a call of the consensus connection to the app, such as the BeginBlock and the Commit.
+# NOTE: the state check is disabled while the ABCI calls are delayed.
+abci-delay-rate = 0.1
+
+# The max delay of an ABCI call
+abci-max-delay = "2s"
+
+# The interval to try to drop a random peer, 0 to disable, the persistent peers are reconnected later
+peer-drop-interval = "1m0s"
+
+# The probability to drop a peer in each interval
+peer-drop-rate = 0.5
+
+# The probability to delay a write to the databases of the app and tendermint
+disk-delay-rate = 0.05
+
+# The max delay of a write
+disk-max-delay = "500ms"
+`
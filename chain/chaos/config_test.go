@@ -0,0 +1,39 @@
+package chaos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, DefaultConfig().Validate())
+
+	config := DefaultConfig()
+	config.ABCIDelayRate = 1.5
+	require.Error(t, config.Validate())
+
+	config = DefaultConfig()
+	config.PeerDropRate = -0.1
+	require.Error(t, config.Validate())
+
+	config = DefaultConfig()
+	config.DiskMaxDelay = -time.Second
+	require.Error(t, config.Validate())
+}
+
+func TestInjectorDisabled(t *testing.T) {
+	injector, err := NewInjector(DefaultConfig(), log.NewNopLogger())
+	require.NoError(t, err)
+
+	// nothing is wrapped if the faults are not enabled
+	app := abci.NewBaseApplication()
+	require.Equal(t, abci.Application(app), injector.WrapApplication(app))
+
+	db := dbm.NewMemDB()
+	require.Equal(t, dbm.DB(db), injector.WrapDB(db))
+}
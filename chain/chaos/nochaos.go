@@ -0,0 +1,47 @@
+//go:build !chaos
+// +build !chaos
+
+package chaos
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Enabled the faults are not compiled into the binary built without the chaos tag
+const Enabled = false
+
+// Injector injects nothing in the binary built without the chaos tag
+type Injector struct{}
+
+// NewInjector creates the injector, the config is ignored without the chaos tag
+func NewInjector(config Config, logger log.Logger) (*Injector, error) {
+	if config.Enable {
+		logger.Error("chaos enabled in config but the binary is built without the chaos tag, ignored")
+	}
+
+	return &Injector{}, nil
+}
+
+// WrapApplication returns the app as it is
+func (i *Injector) WrapApplication(app abci.Application) abci.Application {
+	return app
+}
+
+// WrapDB returns the db as it is
+func (i *Injector) WrapDB(db dbm.DB) dbm.DB {
+	return db
+}
+
+// DBProvider returns the provider as it is
+func (i *Injector) DBProvider(provider node.DBProvider) node.DBProvider {
+	return provider
+}
+
+// StartPeerDropper drops nothing
+func (i *Injector) StartPeerDropper(sw *p2p.Switch) func() {
+	return func() {}
+}
@@ -0,0 +1,74 @@
+package alias
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// ConfigKey the key of the aliases table in the config file of the cli, such as:
+//
+//	[aliases]
+//	vote = "tx kugov vote"
+//	props = "query kugov proposals --status VotingPeriod"
+const ConfigKey = "aliases"
+
+// Load loads the aliases from the config file in the home of the cli,
+// it returns no aliases if the config file not exists.
+func Load(home string) (map[string]string, error) {
+	cfgFile := filepath.Join(home, "config", "config.toml")
+	if _, err := os.Stat(cfgFile); err != nil {
+		return nil, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(cfgFile)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	return v.GetStringMapString(ConfigKey), nil
+}
+
+// Expand expands the first arg to the command line of it if it is an alias,
+// the commands of the root command take priority over the aliases.
+func Expand(rootCmd *cobra.Command, aliases map[string]string, args []string) []string {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return args
+	}
+
+	for _, c := range rootCmd.Commands() {
+		if c.Name() == args[0] || c.HasAlias(args[0]) {
+			return args
+		}
+	}
+
+	cmdLine, ok := aliases[args[0]]
+	if !ok {
+		return args
+	}
+
+	return append(strings.Fields(cmdLine), args[1:]...)
+}
+
+// HomeFromArgs returns the home of the cli by the --home flag in args,
+// or the environment variable, or the default home.
+func HomeFromArgs(args []string, envPrefix, defaultHome string) string {
+	for i, arg := range args {
+		switch {
+		case arg == "--home" && i+1 < len(args):
+			return args[i+1]
+		case strings.HasPrefix(arg, "--home="):
+			return strings.TrimPrefix(arg, "--home=")
+		}
+	}
+
+	if home := os.Getenv(envPrefix + "_HOME"); home != "" {
+		return home
+	}
+
+	return defaultHome
+}
@@ -0,0 +1,56 @@
+package alias_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/alias"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpand(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "kucli"}
+	rootCmd.AddCommand(&cobra.Command{Use: "query", Aliases: []string{"q"}})
+
+	aliases := map[string]string{
+		"vote":  "tx kugov vote",
+		"query": "tx kugov vote",
+		"q":     "tx kugov vote",
+	}
+
+	require.Equal(t, []string{"tx", "kugov", "vote", "jack", "1", "yes"},
+		alias.Expand(rootCmd, aliases, []string{"vote", "jack", "1", "yes"}))
+
+	// the commands take priority
+	require.Equal(t, []string{"query", "kugov"}, alias.Expand(rootCmd, aliases, []string{"query", "kugov"}))
+	require.Equal(t, []string{"q", "kugov"}, alias.Expand(rootCmd, aliases, []string{"q", "kugov"}))
+
+	require.Equal(t, []string{"other"}, alias.Expand(rootCmd, aliases, []string{"other"}))
+	require.Equal(t, []string{"--home", "vote"}, alias.Expand(rootCmd, aliases, []string{"--home", "vote"}))
+	require.Empty(t, alias.Expand(rootCmd, aliases, nil))
+}
+
+func TestLoad(t *testing.T) {
+	home, err := ioutil.TempDir("", "kucli")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	aliases, err := alias.Load(home)
+	require.NoError(t, err)
+	require.Empty(t, aliases)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(home, "config", "config.toml"),
+		[]byte("chain-id = \"testing\"\n\n[aliases]\nvote = \"tx kugov vote\"\n"), 0644))
+
+	aliases, err = alias.Load(home)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"vote": "tx kugov vote"}, aliases)
+
+	require.Equal(t, home, alias.HomeFromArgs([]string{"vote", "--home", home}, "GA", "default"))
+	require.Equal(t, home, alias.HomeFromArgs([]string{"--home=" + home}, "GA", "default"))
+	require.Equal(t, "default", alias.HomeFromArgs([]string{"vote"}, "KUCLI_TEST_NO_EXIST", "default"))
+}
@@ -0,0 +1,103 @@
+package blockutil
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// DefaultBlockTimeWindow the number of the latest blocks to average the block time
+const DefaultBlockTimeWindow int64 = 100
+
+// BlockTime the rolling average block time by the latest blocks, used to estimate the time of a future height
+type BlockTime struct {
+	Height  int64         `json:"height" yaml:"height"`                         // Height the latest height
+	Time    time.Time     `json:"time" yaml:"time"`                             // Time the time of the latest height
+	Average time.Duration `json:"average_block_time" yaml:"average_block_time"` // Average the average block time in the window
+}
+
+// NewBlockTime creates the block time averaged between the two blocks
+func NewBlockTime(fromHeight int64, fromTime time.Time, toHeight int64, toTime time.Time) BlockTime {
+	var average time.Duration
+	if toHeight > fromHeight {
+		average = toTime.Sub(fromTime) / time.Duration(toHeight-fromHeight)
+	}
+
+	return BlockTime{
+		Height:  toHeight,
+		Time:    toTime,
+		Average: average,
+	}
+}
+
+// EstimateTime estimates the time of the height by the average block time
+func (b BlockTime) EstimateTime(height int64) time.Time {
+	return b.Time.Add(time.Duration(height-b.Height) * b.Average)
+}
+
+// Describe describes the height with the estimated time, such as `12345 (~2020-08-01 12:00 UTC)`
+func (b BlockTime) Describe(height int64) string {
+	if height <= b.Height {
+		return fmt.Sprintf("%d (passed)", height)
+	}
+
+	return fmt.Sprintf("%d (~%s)", height, b.EstimateTime(height).UTC().Format("2006-01-02 15:04 MST"))
+}
+
+// QueryBlockTime queries the latest block and the block before the window to average the block time
+func QueryBlockTime(cliCtx context.CLIContext, window int64) (BlockTime, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return BlockTime{}, err
+	}
+
+	latest, err := node.Block(nil)
+	if err != nil {
+		return BlockTime{}, err
+	}
+
+	fromHeight := latest.Block.Height - window
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+
+	from, err := node.Block(&fromHeight)
+	if err != nil {
+		return BlockTime{}, err
+	}
+
+	return NewBlockTime(from.Block.Height, from.Block.Time, latest.Block.Height, latest.Block.Time), nil
+}
+
+// HeightEstimate the estimated time of a height
+type HeightEstimate struct {
+	Height        int64     `json:"height" yaml:"height"`
+	EstimatedTime time.Time `json:"estimated_time" yaml:"estimated_time"`
+	BlockTime     BlockTime `json:"block_time" yaml:"block_time"`
+}
+
+// NewHeightEstimate creates the estimated time of the height by the block time
+func NewHeightEstimate(blockTime BlockTime, height int64) HeightEstimate {
+	return HeightEstimate{
+		Height:        height,
+		EstimatedTime: blockTime.EstimateTime(height),
+		BlockTime:     blockTime,
+	}
+}
+
+// PrintHeightNote prints the estimated time of the height as a note for the text output,
+// the json output is kept for scripts, and any query error is ignored as it is just a hint.
+func PrintHeightNote(cliCtx context.CLIContext, w io.Writer, label string, height int64) {
+	if cliCtx.OutputFormat != "text" || height <= 0 {
+		return
+	}
+
+	blockTime, err := QueryBlockTime(cliCtx, DefaultBlockTimeWindow)
+	if err != nil {
+		return
+	}
+
+	fmt.Fprintf(w, "%s at height %s\n", label, blockTime.Describe(height))
+}
@@ -0,0 +1,30 @@
+package blockutil_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/blockutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockTime(t *testing.T) {
+	from := time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC)
+	to := from.Add(500 * time.Second)
+
+	blockTime := blockutil.NewBlockTime(900, from, 1000, to)
+	require.Equal(t, 5*time.Second, blockTime.Average)
+	require.Equal(t, int64(1000), blockTime.Height)
+
+	require.Equal(t, to.Add(time.Hour), blockTime.EstimateTime(1720))
+	require.Equal(t, "1720 (~2020-08-01 13:08 UTC)", blockTime.Describe(1720))
+	require.Equal(t, "1000 (passed)", blockTime.Describe(1000))
+
+	estimate := blockutil.NewHeightEstimate(blockTime, 1720)
+	require.Equal(t, to.Add(time.Hour), estimate.EstimatedTime)
+
+	// no block time at the first block
+	first := blockutil.NewBlockTime(1, from, 1, from)
+	require.Equal(t, time.Duration(0), first.Average)
+	require.Equal(t, from, first.EstimateTime(100))
+}
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/blockutil"
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// FlagWindow the number of the latest blocks to average the block time
+const FlagWindow = "window"
+
+// BlockTimeCommand returns the command to estimate the time of a future height
+func BlockTimeCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-time [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Estimate the time of a height by the average block time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Estimate the time of a height, such as the upgrade height or the end of a swap window,
+by the average block time of the latest blocks.
+
+Example:
+$ %s query block-time 1000000 --window 200
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "height parse error")
+			}
+
+			window := viper.GetInt64(FlagWindow)
+			if window <= 0 {
+				return fmt.Errorf("window should be positive")
+			}
+
+			blockTime, err := blockutil.QueryBlockTime(cliCtx, window)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(blockutil.NewHeightEstimate(blockTime, height))
+		},
+	}
+
+	cmd.Flags().Int64(FlagWindow, blockutil.DefaultBlockTimeWindow, "the number of the latest blocks to average the block time")
+
+	return flags.GetCommands(cmd)[0]
+}
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"github.com/KuChainNetwork/kuchain/chain/client/blockutil"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -52,3 +53,33 @@ func LatestDecodeBlockRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFu
 		rest.PostProcessResponseBare(w, cliCtx, output)
 	}
 }
+
+func EstimateBlockTimeRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		height, err := strconv.ParseInt(vars["height"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest,
+				"couldn't parse block height. Assumed format is '/blocks/{height}/estimate'.")
+			return
+		}
+
+		window := blockutil.DefaultBlockTimeWindow
+		if v := r.URL.Query().Get("window"); v != "" {
+			window, err = strconv.ParseInt(v, 10, 64)
+			if err != nil || window <= 0 {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "couldn't parse window, should be positive")
+				return
+			}
+		}
+
+		blockTime, err := blockutil.QueryBlockTime(cliCtx, window)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, blockutil.NewHeightEstimate(blockTime, height))
+	}
+}
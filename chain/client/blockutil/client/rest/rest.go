@@ -8,4 +8,5 @@ import (
 func RegisterBlockRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/blocks/latest/decode", LatestDecodeBlockRequestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/blocks/{height}/decode", QueryDecodeBlockRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/blocks/{height}/estimate", EstimateBlockTimeRequestHandlerFn(cliCtx)).Methods("GET")
 }
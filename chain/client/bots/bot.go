@@ -0,0 +1,21 @@
+package bots
+
+import (
+	"context"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// Bot handles the events from the node subscribed by the queries,
+// and sends txs by the runner, such as voting the proposals.
+type Bot interface {
+	// Name returns the name of the bot, used as the subscriber to the node
+	Name() string
+
+	// Queries returns the event queries to subscribe, such as "tm.event='NewBlock'"
+	Queries() []string
+
+	// HandleEvent handles the event, the events of a bot are handled one by one,
+	// so the bot no need to lock itself, the error returned will be logged.
+	HandleEvent(ctx context.Context, r *Runner, event ctypes.ResultEvent) error
+}
@@ -0,0 +1,70 @@
+package bots
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+
+	sender := func(results ...sdk.TxResponse) (func() (sdk.TxResponse, error), *int) {
+		times := 0
+		return func() (sdk.TxResponse, error) {
+			res := results[times]
+			times++
+			if res.Code == 0 && res.TxHash == "" {
+				return res, errors.New("broadcast error")
+			}
+			return res, nil
+		}, &times
+	}
+
+	sequenceErr := sdk.TxResponse{TxHash: "1", Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrUnauthorized.ABCICode()}
+	fundsErr := sdk.TxResponse{TxHash: "2", Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrInsufficientFunds.ABCICode()}
+	ok := sdk.TxResponse{TxHash: "3"}
+
+	Convey("test retry send tx", t, func() {
+		send, times := sender(sdk.TxResponse{}, sequenceErr, ok)
+		res, err := retry(ctx, 3, 0, send)
+		So(err, ShouldBeNil)
+		So(res.TxHash, ShouldEqual, "3")
+		So(*times, ShouldEqual, 3)
+
+		send, times = sender(fundsErr, ok)
+		_, err = retry(ctx, 3, 0, send)
+		So(err, ShouldNotBeNil)
+		So(*times, ShouldEqual, 1)
+
+		send, times = sender(sequenceErr, sequenceErr, ok)
+		_, err = retry(ctx, 2, 0, send)
+		So(err, ShouldNotBeNil)
+		So(*times, ShouldEqual, 2)
+	})
+}
+
+func TestVotingStartProposalIDs(t *testing.T) {
+	Convey("test proposal ids from voting start events", t, func() {
+		ids, err := votingStartProposalIDs(ctypes.ResultEvent{Events: map[string][]string{
+			votingStartKeys[0]: {"1"},
+			votingStartKeys[1]: {"2", "3"},
+			"message.module":   {"governance"},
+		}})
+		So(err, ShouldBeNil)
+		So(ids, ShouldResemble, []uint64{1, 2, 3})
+
+		_, err = votingStartProposalIDs(ctypes.ResultEvent{Events: map[string][]string{votingStartKeys[0]: {"a"}}})
+		So(err, ShouldNotBeNil)
+
+		option, ok := FixedOption(govTypes.OptionNo)(govTypes.Proposal{})
+		So(ok, ShouldBeTrue)
+		So(option, ShouldEqual, govTypes.OptionNo)
+	})
+}
@@ -0,0 +1,116 @@
+package bots
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txbuilder"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	distrTypes "github.com/KuChainNetwork/kuchain/x/distribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// AutoCompounder withdraws the delegation rewards and delegates them back to the
+// validators every interval blocks, the rewards of denom less than min are skipped.
+//
+// NOTE: the rewards are withdrawn to the withdraw address of the delegator, so the
+// withdraw address should be the delegator itself, or the delegation will fail.
+type AutoCompounder struct {
+	delegator  types.AccountID
+	keyName    string
+	validators []types.AccountID
+	interval   int64
+	denom      string
+	min        sdk.Int
+}
+
+var _ Bot = (*AutoCompounder)(nil)
+
+// NewAutoCompounder creates an auto compounder for the delegations of the delegator to the validators
+func NewAutoCompounder(delegator types.AccountID, keyName string, validators []types.AccountID,
+	interval int64, denom string, min sdk.Int) *AutoCompounder {
+	if interval <= 0 {
+		interval = 1
+	}
+
+	return &AutoCompounder{
+		delegator:  delegator,
+		keyName:    keyName,
+		validators: validators,
+		interval:   interval,
+		denom:      denom,
+		min:        min,
+	}
+}
+
+// Name implements Bot
+func (c *AutoCompounder) Name() string {
+	return "auto-compounder-" + c.delegator.String()
+}
+
+// Queries implements Bot
+func (c *AutoCompounder) Queries() []string {
+	return []string{tmtypes.QueryForEvent(tmtypes.EventNewBlock).String()}
+}
+
+// HandleEvent implements Bot
+func (c *AutoCompounder) HandleEvent(ctx context.Context, r *Runner, event ctypes.ResultEvent) error {
+	block, ok := event.Data.(tmtypes.EventDataNewBlock)
+	if !ok || block.Block == nil {
+		return fmt.Errorf("unexpected event data %T", event.Data)
+	}
+
+	if block.Block.Height%c.interval != 0 {
+		return nil
+	}
+
+	for _, validator := range c.validators {
+		reward, err := c.queryReward(r, validator)
+		if err != nil {
+			return err
+		}
+
+		if reward.IsZero() || reward.LT(c.min) {
+			continue
+		}
+
+		validator := validator
+		res, err := r.SendTx(ctx, func() *txbuilder.Builder {
+			return r.Client().NewTx(c.delegator, c.keyName).
+				WithdrawReward(validator).
+				Delegate(validator, types.NewCoin(c.denom, reward))
+		})
+		if err != nil {
+			return fmt.Errorf("compound reward from %s: %w", validator, err)
+		}
+
+		r.Logger().Info("compounded reward", "bot", c.Name(), "validator", validator, "amount", reward, "tx", res.TxHash)
+	}
+
+	return nil
+}
+
+// queryReward queries the reward of the denom for the delegation, the decimal is truncated
+func (c *AutoCompounder) queryReward(r *Runner, validator types.AccountID) (sdk.Int, error) {
+	cliCtx := r.Client().CLIContext()
+
+	bz, err := cliCtx.Codec.MarshalJSON(distrTypes.NewQueryDelegationRewardsParams(c.delegator, validator))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", distrTypes.QuerierRoute, distrTypes.QueryDelegationRewards), bz)
+	if err != nil {
+		return sdk.Int{}, fmt.Errorf("query rewards from %s: %w", validator, err)
+	}
+
+	var rewards types.DecCoins
+	if err := cliCtx.Codec.UnmarshalJSON(res, &rewards); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return rewards.AmountOf(c.denom).TruncateInt(), nil
+}
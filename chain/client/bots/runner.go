@@ -0,0 +1,182 @@
+package bots
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txbuilder"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+const (
+	// DefaultRetryTimes the default times to send a tx
+	DefaultRetryTimes = 3
+
+	// DefaultRetryInterval the default interval before resend a tx
+	DefaultRetryInterval = 3 * time.Second
+
+	// DefaultEventCapacity the default capacity of the event channel for a subscription
+	DefaultEventCapacity = 100
+)
+
+// Runner subscribes the events for the bots and sends the txs with retry
+type Runner struct {
+	client txbuilder.Client
+	logger log.Logger
+	bots   []Bot
+
+	retryTimes    int
+	retryInterval time.Duration
+}
+
+// NewRunner creates a runner by the client to the node
+func NewRunner(client txbuilder.Client, logger log.Logger) *Runner {
+	return &Runner{
+		client:        client,
+		logger:        logger.With("module", "bots"),
+		retryTimes:    DefaultRetryTimes,
+		retryInterval: DefaultRetryInterval,
+	}
+}
+
+// WithRetry sets the times to send a tx and the interval before resend
+func (r *Runner) WithRetry(times int, interval time.Duration) *Runner {
+	r.retryTimes = times
+	r.retryInterval = interval
+	return r
+}
+
+// AddBots adds the bots to run
+func (r *Runner) AddBots(bots ...Bot) *Runner {
+	r.bots = append(r.bots, bots...)
+	return r
+}
+
+// Client returns the client to the node
+func (r *Runner) Client() txbuilder.Client {
+	return r.client
+}
+
+// Logger returns the logger of the runner
+func (r *Runner) Logger() log.Logger {
+	return r.logger
+}
+
+// Run subscribes the events for all bots and handles them, until the ctx done
+func (r *Runner) Run(ctx context.Context) error {
+	node := r.client.CLIContext().Client
+	if node == nil {
+		return fmt.Errorf("no node client in the cli context")
+	}
+
+	if !node.IsRunning() {
+		if err := node.Start(); err != nil {
+			return fmt.Errorf("start node client: %w", err)
+		}
+		defer node.Stop() //nolint: errcheck
+	}
+
+	var wg sync.WaitGroup
+	for _, bot := range r.bots {
+		var mu sync.Mutex
+		subscriber := "bots-" + bot.Name()
+
+		for _, query := range bot.Queries() {
+			events, err := node.Subscribe(ctx, subscriber, query, DefaultEventCapacity)
+			if err != nil {
+				return fmt.Errorf("subscribe %s for bot %s: %w", query, bot.Name(), err)
+			}
+
+			wg.Add(1)
+			go func(bot Bot, mu *sync.Mutex, events <-chan ctypes.ResultEvent) {
+				defer wg.Done()
+				r.handleEvents(ctx, bot, mu, events)
+			}(bot, &mu, events)
+		}
+
+		defer node.UnsubscribeAll(context.Background(), subscriber) //nolint: errcheck
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (r *Runner) handleEvents(ctx context.Context, bot Bot, mu *sync.Mutex, events <-chan ctypes.ResultEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				r.logger.Error("event subscription closed", "bot", bot.Name())
+				return
+			}
+
+			mu.Lock()
+			if err := bot.HandleEvent(ctx, r, event); err != nil {
+				r.logger.Error("handle event error", "bot", bot.Name(), "query", event.Query, "err", err)
+			}
+			mu.Unlock()
+		}
+	}
+}
+
+// SendTx builds, signs and broadcasts the tx created by newTx, the tx will be
+// resent if broadcast failed or the sequence of the account mismatched, a new
+// builder is needed for each time to resolve the sequence of the account again.
+func (r *Runner) SendTx(ctx context.Context, newTx func() *txbuilder.Builder) (sdk.TxResponse, error) {
+	return retry(ctx, r.retryTimes, r.retryInterval, func() (sdk.TxResponse, error) {
+		return newTx().Broadcast()
+	})
+}
+
+func retry(ctx context.Context, times int, interval time.Duration, send func() (sdk.TxResponse, error)) (sdk.TxResponse, error) {
+	var (
+		res sdk.TxResponse
+		err error
+	)
+
+	for i := 0; i < times; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return res, ctx.Err()
+			case <-time.After(interval):
+			}
+		}
+
+		res, err = send()
+		if err == nil && res.Code == 0 {
+			return res, nil
+		}
+
+		if err == nil {
+			err = fmt.Errorf("tx %s failed, code %d: %s", res.TxHash, res.Code, res.RawLog)
+			if !isRetryable(res) {
+				return res, err
+			}
+		}
+	}
+
+	return res, err
+}
+
+// isRetryable returns if the tx failed by the errors may success when resend
+func isRetryable(res sdk.TxResponse) bool {
+	if res.Codespace != sdkerrors.RootCodespace {
+		return false
+	}
+
+	switch res.Code {
+	case sdkerrors.ErrUnauthorized.ABCICode(), sdkerrors.ErrInvalidSequence.ABCICode(), sdkerrors.ErrMempoolIsFull.ABCICode():
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,115 @@
+package bots
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txbuilder"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	govUtils "github.com/KuChainNetwork/kuchain/x/gov/client/utils"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// VotePolicy decides the option to vote the proposal, the proposal will not be voted if returns false
+type VotePolicy func(proposal govTypes.Proposal) (govTypes.VoteOption, bool)
+
+// FixedOption returns a policy to vote all proposals by the option
+func FixedOption(option govTypes.VoteOption) VotePolicy {
+	return func(govTypes.Proposal) (govTypes.VoteOption, bool) {
+		return option, true
+	}
+}
+
+// votingStartKeys the event attributes of the proposals entered voting period
+var votingStartKeys = []string{
+	govTypes.EventTypeSubmitProposal + "." + govTypes.AttributeKeyVotingPeriodStart,
+	govTypes.EventTypeProposalDeposit + "." + govTypes.AttributeKeyVotingPeriodStart,
+}
+
+// AutoVoter votes the proposals by the policy when they entered voting period
+type AutoVoter struct {
+	voter   types.AccountID
+	keyName string
+	policy  VotePolicy
+}
+
+var _ Bot = (*AutoVoter)(nil)
+
+// NewAutoVoter creates an auto voter for the voter account, the votes will be signed by the key of the name
+func NewAutoVoter(voter types.AccountID, keyName string, policy VotePolicy) *AutoVoter {
+	return &AutoVoter{
+		voter:   voter,
+		keyName: keyName,
+		policy:  policy,
+	}
+}
+
+// Name implements Bot
+func (v *AutoVoter) Name() string {
+	return "auto-voter-" + v.voter.String()
+}
+
+// Queries implements Bot
+func (v *AutoVoter) Queries() []string {
+	queries := make([]string, 0, len(votingStartKeys))
+	for _, key := range votingStartKeys {
+		queries = append(queries, fmt.Sprintf("tm.event='Tx' AND %s EXISTS", key))
+	}
+	return queries
+}
+
+// HandleEvent implements Bot
+func (v *AutoVoter) HandleEvent(ctx context.Context, r *Runner, event ctypes.ResultEvent) error {
+	ids, err := votingStartProposalIDs(event)
+	if err != nil {
+		return err
+	}
+
+	cliCtx := r.Client().CLIContext()
+	for _, id := range ids {
+		bz, err := govUtils.QueryProposalByID(id, cliCtx, govTypes.QuerierRoute)
+		if err != nil {
+			return fmt.Errorf("query proposal %d: %w", id, err)
+		}
+
+		var proposal govTypes.Proposal
+		if err := cliCtx.Codec.UnmarshalJSON(bz, &proposal); err != nil {
+			return fmt.Errorf("unmarshal proposal %d: %w", id, err)
+		}
+
+		option, ok := v.policy(proposal)
+		if !ok {
+			r.Logger().Info("skip proposal by policy", "bot", v.Name(), "proposal", id)
+			continue
+		}
+
+		res, err := r.SendTx(ctx, func() *txbuilder.Builder {
+			return r.Client().NewTx(v.voter, v.keyName).Vote(id, option)
+		})
+		if err != nil {
+			return fmt.Errorf("vote proposal %d: %w", id, err)
+		}
+
+		r.Logger().Info("voted proposal", "bot", v.Name(), "proposal", id, "option", option, "tx", res.TxHash)
+	}
+
+	return nil
+}
+
+// votingStartProposalIDs returns the ids of the proposals entered voting period in the tx event
+func votingStartProposalIDs(event ctypes.ResultEvent) ([]uint64, error) {
+	var ids []uint64
+	for _, key := range votingStartKeys {
+		for _, value := range event.Events[key] {
+			id, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parse proposal id %s: %w", value, err)
+			}
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,126 @@
+package completion
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// MaxCandidates the max number of the candidates queried from node for completion
+const MaxCandidates = 100
+
+// ArgCompleter returns the candidates for the arg to complete, a candidate can
+// have a description after a tab, such as "1\tproposal title".
+type ArgCompleter func(cmd *cobra.Command, toComplete string) []string
+
+// Args returns a ValidArgsFunction which completes the arg at each position by the completers,
+// use nil completer for the arg with no completion.
+func Args(completers ...ArgCompleter) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= len(completers) || completers[len(args)] == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return filterPrefix(completers[len(args)](cmd, toComplete), toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// Words returns a completer by the fixed words
+func Words(words ...string) ArgCompleter {
+	return func(_ *cobra.Command, _ string) []string {
+		return words
+	}
+}
+
+// KeyNames completes the names of the keys in local keyring, the keyring which need
+// passphrase to list keys will give no candidates, as completion cannot prompt.
+func KeyNames(cmd *cobra.Command, _ string) []string {
+	// use the flags of the command, as the flags may be not bound to viper in completion
+	backend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
+	home, _ := cmd.Flags().GetString(flags.FlagHome)
+
+	kb, err := keys.NewKeyring(sdk.KeyringServiceName(), backend, home, strings.NewReader(""))
+	if err != nil {
+		return nil
+	}
+
+	infos, err := kb.List()
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		names = append(names, info.GetName())
+	}
+
+	return names
+}
+
+// RegisterFromFlag registers the completion of key names for all the --from flags under the cmd
+func RegisterFromFlag(cmd *cobra.Command) {
+	if cmd.Flags().Lookup(flags.FlagFrom) != nil {
+		_ = cmd.RegisterFlagCompletionFunc(flags.FlagFrom, func(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return filterPrefix(KeyNames(cmd, toComplete), toComplete), cobra.ShellCompDirectiveNoFileComp
+		})
+	}
+
+	for _, c := range cmd.Commands() {
+		RegisterFromFlag(c)
+	}
+}
+
+// Command returns the command to generate the completion script of the root command
+func Command(rootCmd *cobra.Command) *cobra.Command {
+	return &cobra.Command{
+		Use:   "completion [bash|zsh|fish|powershell]",
+		Short: "Generate completion script to STDOUT",
+		Long: fmt.Sprintf(`Generate the completion script for the shell, the proposal ids, validators
+and local key names are completed by light queries for bash and fish.
+
+To load completion for bash in current session run
+
+$ . <(%[1]s completion bash)
+
+To configure your bash shell to load completions for each session add to your bashrc
+
+# ~/.bashrc or ~/.profile
+. <(%[1]s completion bash)
+
+For fish
+
+$ %[1]s completion fish > ~/.config/fish/completions/%[1]s.fish
+`, rootCmd.Name()),
+		ValidArgs: []string{"bash", "zsh", "fish", "powershell"},
+		Args:      cobra.ExactValidArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out := cmd.OutOrStdout()
+			switch args[0] {
+			case "zsh":
+				return rootCmd.GenZshCompletion(out)
+			case "fish":
+				return rootCmd.GenFishCompletion(out, true)
+			case "powershell":
+				return rootCmd.GenPowerShellCompletion(out)
+			default:
+				return rootCmd.GenBashCompletion(out)
+			}
+		},
+	}
+}
+
+// filterPrefix filters the candidates by the prefix, the description is not matched
+func filterPrefix(candidates []string, prefix string) []string {
+	res := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		if strings.HasPrefix(strings.SplitN(c, "\t", 2)[0], prefix) {
+			res = append(res, c)
+		}
+	}
+
+	return res
+}
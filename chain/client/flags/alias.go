@@ -11,6 +11,8 @@ const (
 	FlagFrom         = cosmosFlags.FlagFrom
 	FlagChainID      = cosmosFlags.FlagChainID
 	FlagName         = cosmosFlags.FlagName
+
+	FlagSkipConfirmation = cosmosFlags.FlagSkipConfirmation
 )
 
 var (
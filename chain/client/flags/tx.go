@@ -1,16 +1,68 @@
 package flags
 
 import (
+	"fmt"
+
 	"github.com/KuChainNetwork/kuchain/chain/transaction"
 	cosmosFlags "github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 )
 
+// FlagNoPrompt the global flag to skip all the confirmations before broadcasting txs, for automation,
+// it can be set in the config file of the cli too.
+const FlagNoPrompt = "no-prompt"
+
+// FlagPreview the flag to print the payload to sign in a human-auditable form, without signing and broadcasting.
+const FlagPreview = "preview"
+
+// FlagEncryptMemo the flag to encrypt the memo to the memo key of the recipient account,
+// so only the recipient can read the memo.
+const FlagEncryptMemo = "encrypt-memo"
+
 // PostCommands adds common flags for commands to post tx
 func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 	for _, c := range cmds {
 		c.Flags().String(transaction.FlagPayer, "", "fee payer for tx")
+		c.Flags().String(transaction.FlagReferrer, "", "referrer account to share the fee of tx")
+		c.Flags().Uint64(transaction.FlagTimeoutHeight, 0, "Block height after which the tx will not be included, 0 for no timeout")
+		c.Flags().String(FlagEncryptMemo, "", "Encrypt the memo to the memo key of the recipient account")
+		c.Flags().Bool(FlagPreview, false, "Print the payload to sign (msgs, fee, memo, chain-id, account number and sequence) without signing and broadcasting")
 	}
 
 	return cosmosFlags.PostCommands(cmds...)
 }
+
+// FlagOffline the flag to build the generate-only txs without any query to the node, for the airgapped signers.
+const FlagOffline = "offline"
+
+// OfflineCommands adds the --offline flag to the commands posting txs, which requires --generate-only,
+// and --account-number and --sequence for the payload to sign, as no query to the node is made.
+func OfflineCommands(cmds ...*cobra.Command) []*cobra.Command {
+	for _, c := range cmds {
+		c.Flags().Bool(FlagOffline, false,
+			"Build the unsigned tx without querying a node, requires --generate-only, --account-number and --sequence")
+
+		preRunE := c.PreRunE
+		c.PreRunE = func(cmd *cobra.Command, args []string) error {
+			if offline, _ := cmd.Flags().GetBool(FlagOffline); offline {
+				if generateOnly, _ := cmd.Flags().GetBool(cosmosFlags.FlagGenerateOnly); !generateOnly {
+					return fmt.Errorf("--%s requires --%s", FlagOffline, cosmosFlags.FlagGenerateOnly)
+				}
+
+				for _, flag := range []string{cosmosFlags.FlagAccountNumber, cosmosFlags.FlagSequence} {
+					if err := cmd.MarkFlagRequired(flag); err != nil {
+						return err
+					}
+				}
+			}
+
+			if preRunE != nil {
+				return preRunE(cmd, args)
+			}
+
+			return nil
+		}
+	}
+
+	return cmds
+}
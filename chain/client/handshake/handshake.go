@@ -0,0 +1,108 @@
+package handshake
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/spf13/cobra"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+// FlagStrict the flag to refuse the commands if the client binary is older than the chain
+const FlagStrict = "strict"
+
+// Result is the result of the version negotiation between the client and the node
+type Result struct {
+	ClientVersion string
+	NodeVersion   string
+
+	// the msg routes registered in the node but unknown to the client
+	UnknownRoutes []types.ModuleRoute
+}
+
+// Outdated returns true if the client binary is older than the chain,
+// the node has the modules the client does not know.
+func (r Result) Outdated() bool {
+	return len(r.UnknownRoutes) > 0
+}
+
+// VersionMismatch returns true if the version of the client is not the app version of the node
+func (r Result) VersionMismatch() bool {
+	return r.ClientVersion != "" && r.NodeVersion != "" && r.ClientVersion != r.NodeVersion
+}
+
+// String implements fmt.Stringer
+func (r Result) String() string {
+	if !r.Outdated() {
+		return fmt.Sprintf("client version %s differs from the node app version %s", r.ClientVersion, r.NodeVersion)
+	}
+
+	modules := make([]string, 0, len(r.UnknownRoutes))
+	for _, route := range r.UnknownRoutes {
+		modules = append(modules, route.Module)
+	}
+
+	return fmt.Sprintf("client version %s is older than the node app version %s, unknown modules: %s",
+		r.ClientVersion, r.NodeVersion, strings.Join(modules, ", "))
+}
+
+// Compare compares the version and the modules of the client with the node info
+func Compare(clientVersion string, basics module.BasicManager, node types.NodeInfo) Result {
+	res := Result{
+		ClientVersion: clientVersion,
+		NodeVersion:   node.AppVersion,
+	}
+
+	for _, route := range node.Routes {
+		if _, ok := basics[route.Module]; !ok {
+			res.UnknownRoutes = append(res.UnknownRoutes, route)
+		}
+	}
+
+	return res
+}
+
+// QueryNodeInfo queries the app version and the msg routes of the node
+func QueryNodeInfo(cliCtx context.CLIContext) (types.NodeInfo, error) {
+	var info types.NodeInfo
+
+	bz, _, err := cliCtx.Query(types.QueryPathNodeInfo)
+	if err != nil {
+		return info, err
+	}
+
+	if err := cliCtx.Codec.UnmarshalJSON(bz, &info); err != nil {
+		return info, err
+	}
+
+	return info, nil
+}
+
+// Check negotiates the version with the node before running the command, warns if the client
+// binary is older than the chain, or refuses the command if strict. The check is skipped if the
+// node is not reachable or not supporting the node info query, the command reports the error itself.
+func Check(cmd *cobra.Command, cdc *codec.Codec, clientVersion string, basics module.BasicManager, strict bool) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+	if cliCtx.GenerateOnly || cliCtx.Simulate {
+		return nil
+	}
+
+	info, err := QueryNodeInfo(cliCtx)
+	if err != nil {
+		return nil
+	}
+
+	res := Compare(clientVersion, basics, info)
+	switch {
+	case res.Outdated() && strict:
+		return fmt.Errorf("%s, please upgrade the client", res)
+	case res.Outdated() || res.VersionMismatch():
+		fmt.Fprintf(cmd.ErrOrStderr(), "WARNING: %s\n", res)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package handshake_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/handshake"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/gov"
+	"github.com/KuChainNetwork/kuchain/x/staking"
+)
+
+func TestCompare(t *testing.T) {
+	Convey("test compare client with node", t, func() {
+		basics := module.NewBasicManager(gov.AppModuleBasic{}, staking.AppModuleBasic{})
+		node := types.NodeInfo{
+			AppVersion: "v1.0.0",
+			Routes: []types.ModuleRoute{
+				{Module: gov.ModuleName, Route: gov.RouterKey},
+				{Module: staking.ModuleName, Route: staking.RouterKey},
+			},
+		}
+
+		res := handshake.Compare("v1.0.0", basics, node)
+		So(res.Outdated(), ShouldBeFalse)
+		So(res.VersionMismatch(), ShouldBeFalse)
+
+		res = handshake.Compare("v0.9.0", basics, node)
+		So(res.Outdated(), ShouldBeFalse)
+		So(res.VersionMismatch(), ShouldBeTrue)
+
+		node.Routes = append(node.Routes, types.ModuleRoute{Module: "kunew", Route: "kunew"})
+		res = handshake.Compare("v0.9.0", basics, node)
+		So(res.Outdated(), ShouldBeTrue)
+		So(res.UnknownRoutes, ShouldResemble, []types.ModuleRoute{{Module: "kunew", Route: "kunew"}})
+		So(res.String(), ShouldContainSubstring, "kunew")
+	})
+}
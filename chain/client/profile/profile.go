@@ -0,0 +1,71 @@
+package profile
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	// FlagProfile the flag to select the profile in config
+	FlagProfile = "profile"
+
+	// EnvProfile the environment variable to select the profile, used if no --profile flag
+	EnvProfile = "KUCLI_PROFILE"
+
+	// ConfigKey the key of the profiles tables in the config file of the cli, such as:
+	//
+	//	[profiles.testnet]
+	//	chain-id = "testnet"
+	//	node = "tcp://testnet.node:26657"
+	//	keyring-backend = "test"
+	//	fees = "0.01kuchain/kcs"
+	ConfigKey = "profiles"
+)
+
+// Keys the config keys can be set in a profile
+var Keys = []string{
+	flags.FlagChainID,
+	flags.FlagNode,
+	flags.FlagKeyringBackend,
+	flags.FlagFees,
+}
+
+// Name returns the name of the profile selected by the --profile flag or the environment variable
+func Name(cmd *cobra.Command) string {
+	if name, _ := cmd.Flags().GetString(FlagProfile); name != "" {
+		return name
+	}
+
+	return os.Getenv(EnvProfile)
+}
+
+// Apply applies the profile in the config loaded to viper, the flags set in
+// the command line take priority over the profile.
+func Apply(cmd *cobra.Command, v *viper.Viper, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	profile := v.Sub(fmt.Sprintf("%s.%s", ConfigKey, name))
+	if profile == nil {
+		return fmt.Errorf("profile %s not found in config", name)
+	}
+
+	for _, key := range Keys {
+		if !profile.IsSet(key) {
+			continue
+		}
+
+		if f := cmd.Flags().Lookup(key); f != nil && f.Changed {
+			continue
+		}
+
+		v.Set(key, profile.Get(key))
+	}
+
+	return nil
+}
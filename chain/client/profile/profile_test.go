@@ -0,0 +1,73 @@
+package profile_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/profile"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+const config = `
+chain-id = "mainnet"
+node = "tcp://mainnet:26657"
+
+[profiles.testnet]
+chain-id = "testnet"
+node = "tcp://testnet:26657"
+fees = "1kuchain/kcs"
+`
+
+func newConfig(t *testing.T) *viper.Viper {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(bytes.NewBufferString(config)))
+
+	return v
+}
+
+func newCmd(args ...string) *cobra.Command {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().String(profile.FlagProfile, "", "")
+	cmd.Flags().String(flags.FlagChainID, "", "")
+	cmd.Flags().String(flags.FlagNode, "", "")
+	_ = cmd.Flags().Parse(args)
+
+	return cmd
+}
+
+func TestApply(t *testing.T) {
+	v := newConfig(t)
+	require.NoError(t, profile.Apply(newCmd(), v, ""))
+	require.Equal(t, "mainnet", v.GetString(flags.FlagChainID))
+
+	v = newConfig(t)
+	require.NoError(t, profile.Apply(newCmd(), v, "testnet"))
+	require.Equal(t, "testnet", v.GetString(flags.FlagChainID))
+	require.Equal(t, "tcp://testnet:26657", v.GetString(flags.FlagNode))
+	require.Equal(t, "1kuchain/kcs", v.GetString(flags.FlagFees))
+
+	// the flags in command line take priority
+	v = newConfig(t)
+	cmd := newCmd("--node", "tcp://local:26657")
+	require.NoError(t, v.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode)))
+	require.NoError(t, profile.Apply(cmd, v, "testnet"))
+	require.Equal(t, "testnet", v.GetString(flags.FlagChainID))
+	require.Equal(t, "tcp://local:26657", v.GetString(flags.FlagNode))
+
+	require.Error(t, profile.Apply(newCmd(), newConfig(t), "other"))
+}
+
+func TestName(t *testing.T) {
+	require.Equal(t, "testnet", profile.Name(newCmd("--profile", "testnet")))
+
+	os.Setenv(profile.EnvProfile, "devnet")
+	defer os.Unsetenv(profile.EnvProfile)
+
+	require.Equal(t, "devnet", profile.Name(newCmd()))
+	require.Equal(t, "testnet", profile.Name(newCmd("--profile", "testnet")))
+}
@@ -0,0 +1,299 @@
+package txbuilder
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	distrTypes "github.com/KuChainNetwork/kuchain/x/distribution/types"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	ckeys "github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgFunc creates a msg by the auth of the account which sends the tx
+type MsgFunc func(auth types.AccAddress) sdk.Msg
+
+// Builder builds a tx for an account fluently, the auth, account number and sequence
+// of the account are resolved from the node if not set, errors in building are returned
+// by Build, Sign or Broadcast.
+type Builder struct {
+	client  Client
+	from    types.AccountID
+	keyName string
+
+	auth          types.AccAddress
+	accountNumber uint64
+	sequence      uint64
+	numLoaded     bool
+
+	msgs      []MsgFunc
+	memo      string
+	gas       uint64
+	simulate  bool
+	fees      types.Coins
+	gasPrices types.DecCoins
+	payer     string
+	referrer  string
+
+	err error
+}
+
+func newBuilder(client Client, from types.AccountID, keyName string) *Builder {
+	return &Builder{
+		client:  client,
+		from:    from,
+		keyName: keyName,
+		gas:     flags.DefaultGasLimit,
+	}
+}
+
+// WithAuth sets the auth of the account, so no need to query it from the node
+func (b *Builder) WithAuth(auth types.AccAddress) *Builder {
+	b.auth = auth
+	return b
+}
+
+// WithAccountNumberSequence sets the account number and sequence of the auth, so no need to query them from the node
+func (b *Builder) WithAccountNumberSequence(accountNumber, sequence uint64) *Builder {
+	b.accountNumber = accountNumber
+	b.sequence = sequence
+	b.numLoaded = true
+	return b
+}
+
+// WithMemo sets the memo of the tx
+func (b *Builder) WithMemo(memo string) *Builder {
+	b.memo = memo
+	return b
+}
+
+// WithGas sets the gas limit of the tx
+func (b *Builder) WithGas(gas uint64) *Builder {
+	b.gas = gas
+	return b
+}
+
+// WithSimulate makes the gas limit of the tx by simulating it in the node, adjusted by the gas adjustment of the client
+func (b *Builder) WithSimulate() *Builder {
+	b.simulate = true
+	return b
+}
+
+// WithFees sets the fees of the tx, such as "100kuchain/kcs", cannot be used with gas prices
+func (b *Builder) WithFees(fees string) *Builder {
+	parsed, err := types.ParseCoins(fees)
+	if err != nil {
+		return b.withErr(fmt.Errorf("parse fees %s: %w", fees, err))
+	}
+
+	b.fees = parsed
+	return b
+}
+
+// WithGasPrices sets the gas prices of the tx, cannot be used with fees
+func (b *Builder) WithGasPrices(gasPrices string) *Builder {
+	parsed, err := types.ParseDecCoins(gasPrices)
+	if err != nil {
+		return b.withErr(fmt.Errorf("parse gas prices %s: %w", gasPrices, err))
+	}
+
+	b.gasPrices = parsed
+	return b
+}
+
+// WithPayer sets the account to pay the fee
+func (b *Builder) WithPayer(payer types.AccountID) *Builder {
+	b.payer = payer.String()
+	return b
+}
+
+// WithReferrer sets the referrer account to share the fee
+func (b *Builder) WithReferrer(referrer types.AccountID) *Builder {
+	b.referrer = referrer.String()
+	return b
+}
+
+// Msg adds a msg created by the auth of the account, for the msgs have no builder method
+func (b *Builder) Msg(fn MsgFunc) *Builder {
+	b.msgs = append(b.msgs, fn)
+	return b
+}
+
+// Transfer adds a msg to transfer coins to the account
+func (b *Builder) Transfer(to types.AccountID, amount types.Coins) *Builder {
+	return b.Msg(func(auth types.AccAddress) sdk.Msg {
+		return assetTypes.NewMsgTransfer(auth, b.from, to, amount)
+	})
+}
+
+// SubmitProposal adds a msg to submit a gov proposal with the initial deposit
+func (b *Builder) SubmitProposal(content govTypes.Content, initialDeposit types.Coins) *Builder {
+	return b.Msg(func(auth types.AccAddress) sdk.Msg {
+		return govTypes.NewKuMsgSubmitProposal(auth, content, initialDeposit, b.from)
+	})
+}
+
+// Deposit adds a msg to deposit to the gov proposal
+func (b *Builder) Deposit(proposalID uint64, amount types.Coins) *Builder {
+	return b.Msg(func(auth types.AccAddress) sdk.Msg {
+		return govTypes.NewKuMsgDeposit(auth, b.from, proposalID, amount)
+	})
+}
+
+// Vote adds a msg to vote the gov proposal
+func (b *Builder) Vote(proposalID uint64, option govTypes.VoteOption) *Builder {
+	return b.Msg(func(auth types.AccAddress) sdk.Msg {
+		return govTypes.NewKuMsgVote(auth, b.from, proposalID, option)
+	})
+}
+
+// Delegate adds a msg to delegate coins to the validator
+func (b *Builder) Delegate(validator types.AccountID, amount types.Coin) *Builder {
+	return b.Msg(func(auth types.AccAddress) sdk.Msg {
+		return stakingTypes.NewKuMsgDelegate(auth, b.from, validator, amount)
+	})
+}
+
+// Redelegate adds a msg to redelegate coins from the src validator to the dst validator
+func (b *Builder) Redelegate(src, dst types.AccountID, amount types.Coin) *Builder {
+	return b.Msg(func(auth types.AccAddress) sdk.Msg {
+		return stakingTypes.NewKuMsgRedelegate(auth, b.from, src, dst, amount)
+	})
+}
+
+// Unbond adds a msg to unbond coins from the validator
+func (b *Builder) Unbond(validator types.AccountID, amount types.Coin) *Builder {
+	return b.Msg(func(auth types.AccAddress) sdk.Msg {
+		return stakingTypes.NewKuMsgUnbond(auth, b.from, validator, amount)
+	})
+}
+
+// WithdrawReward adds a msg to withdraw the delegation reward from the validator
+func (b *Builder) WithdrawReward(validator types.AccountID) *Builder {
+	return b.Msg(func(auth types.AccAddress) sdk.Msg {
+		return distrTypes.NewMsgWithdrawDelegatorReward(auth, b.from, validator)
+	})
+}
+
+// Msgs returns the msgs of the tx, the auth will be queried if not set
+func (b *Builder) Msgs() ([]sdk.Msg, error) {
+	if err := b.resolveAuth(); err != nil {
+		return nil, err
+	}
+
+	msgs := make([]sdk.Msg, 0, len(b.msgs))
+	for _, fn := range b.msgs {
+		msg := fn(b.auth)
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+// Build builds the msg to sign of the tx
+func (b *Builder) Build() (types.StdSignMsg, error) {
+	txBldr, msgs, err := b.txBuilder()
+	if err != nil {
+		return types.StdSignMsg{}, err
+	}
+
+	return txBldr.BuildSignMsg(msgs)
+}
+
+// Sign builds and signs the tx, returns the encoded tx to broadcast
+func (b *Builder) Sign() ([]byte, error) {
+	txBldr, msgs, err := b.txBuilder()
+	if err != nil {
+		return nil, err
+	}
+
+	return txBldr.BuildAndSign(b.keyName, ckeys.DefaultKeyPass, msgs)
+}
+
+// Broadcast builds, signs and broadcasts the tx to the node, by the broadcast mode of the client
+func (b *Builder) Broadcast() (sdk.TxResponse, error) {
+	txBytes, err := b.Sign()
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	return b.client.cliCtx.BroadcastTx(txBytes)
+}
+
+func (b *Builder) txBuilder() (txutil.TxBuilder, []sdk.Msg, error) {
+	if b.err != nil {
+		return txutil.TxBuilder{}, nil, b.err
+	}
+
+	if len(b.msgs) == 0 {
+		return txutil.TxBuilder{}, nil, fmt.Errorf("no msgs in tx")
+	}
+
+	msgs, err := b.Msgs()
+	if err != nil {
+		return txutil.TxBuilder{}, nil, err
+	}
+
+	if !b.numLoaded {
+		num, seq, err := txutil.NewAccountRetriever(b.kuCliCtx()).GetAuthNumberSequence(b.from)
+		if err != nil {
+			return txutil.TxBuilder{}, nil, fmt.Errorf("query account number and sequence of %s: %w", b.from, err)
+		}
+		b.WithAccountNumberSequence(num, seq)
+	}
+
+	gasPrices := b.gasPrices
+	if b.fees.IsZero() && gasPrices.IsZero() {
+		gasPrices = constants.MinGasPrice
+	}
+
+	cdc := b.client.cliCtx.Codec
+	txBldr := txutil.NewTxBuilder(txutil.GetTxEncoder(cdc),
+		b.accountNumber, b.sequence, b.gas, b.client.gasAdjustment, b.simulate,
+		b.client.chainID, b.memo, b.fees, gasPrices).
+		WithKeybase(b.client.keybase).
+		WithPayer(b.payer).
+		WithReferrer(b.referrer)
+
+	if b.simulate {
+		txBldr, err = txutil.EnrichWithGas(txBldr, b.kuCliCtx(), msgs)
+		if err != nil {
+			return txutil.TxBuilder{}, nil, fmt.Errorf("simulate tx: %w", err)
+		}
+	}
+
+	return txBldr, msgs, nil
+}
+
+func (b *Builder) resolveAuth() error {
+	if !b.auth.Empty() {
+		return nil
+	}
+
+	auth, err := txutil.QueryAccountAuth(b.kuCliCtx(), b.from)
+	if err != nil {
+		return fmt.Errorf("query auth of %s: %w", b.from, err)
+	}
+
+	b.auth = auth
+	return nil
+}
+
+func (b *Builder) kuCliCtx() txutil.KuCLIContext {
+	return txutil.NewKuCLICtx(b.client.cliCtx).WithFromAccount(b.from)
+}
+
+func (b *Builder) withErr(err error) *Builder {
+	if b.err == nil {
+		b.err = err
+	}
+	return b
+}
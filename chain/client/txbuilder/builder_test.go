@@ -0,0 +1,72 @@
+package txbuilder_test
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txbuilder"
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	ckeys "github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBuilderSign(t *testing.T) {
+	cdc := simapp.MakeCodec()
+
+	kb := keys.NewInMemory()
+	info, _, err := kb.CreateMnemonic("test", keys.English, ckeys.DefaultKeyPass, keys.Secp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := types.AccAddress(info.GetAddress())
+
+	client := txbuilder.NewClient(cdc, "tcp://localhost:26657", "testchain", kb)
+	from := types.MustAccountID("alice")
+	to := types.MustAccountID("bob")
+	amount := types.NewCoins(types.NewInt64Coin(constants.DefaultBondDenom, 100))
+
+	newTx := func() *txbuilder.Builder {
+		return client.NewTx(from, "test").
+			WithAuth(auth).
+			WithAccountNumberSequence(3, 0)
+	}
+
+	Convey("test builder sign tx offline", t, func() {
+		txBytes, err := newTx().
+			Transfer(to, amount).
+			Vote(1, govTypes.OptionYes).
+			WithMemo("memo").
+			WithFees("100" + constants.DefaultBondDenom).
+			Sign()
+		So(err, ShouldBeNil)
+
+		var tx types.StdTx
+		So(cdc.UnmarshalBinaryLengthPrefixed(txBytes, &tx), ShouldBeNil)
+		So(tx.GetMsgs(), ShouldHaveLength, 2)
+		So(tx.GetMemo(), ShouldEqual, "memo")
+		So(tx.Fee.Amount.String(), ShouldEqual, "100"+constants.DefaultBondDenom)
+		So(tx.GetSigners(), ShouldResemble, []sdk.AccAddress{sdk.AccAddress(auth)})
+
+		signBytes := types.StdSignBytes("testchain", 3, 0, tx.Fee, tx.GetMsgs(), tx.GetMemo())
+		So(tx.GetSignatures(), ShouldHaveLength, 1)
+		So(tx.GetSignatures()[0].PubKey.VerifyBytes(signBytes, tx.GetSignatures()[0].Signature), ShouldBeTrue)
+	})
+
+	Convey("test builder errors", t, func() {
+		_, err := newTx().Sign()
+		So(err, ShouldNotBeNil)
+
+		_, err = newTx().Transfer(to, amount).WithFees("abc").Sign()
+		So(err, ShouldNotBeNil)
+
+		_, err = newTx().Transfer(to, amount).
+			WithFees("100" + constants.DefaultBondDenom).
+			WithGasPrices(constants.MinGasPriceString).
+			Build()
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,84 @@
+package txbuilder
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+)
+
+// Client builds, signs and broadcasts txs to a node, for the go services
+// which need to send txs without the cli.
+type Client struct {
+	cliCtx  context.CLIContext
+	keybase keys.Keybase
+	chainID string
+
+	gasAdjustment float64
+}
+
+// NewClient creates a client to the node, the keys in keybase are used to sign txs.
+//
+// NOTE: the client trusts the node, the query results are not verified by proofs.
+func NewClient(cdc *codec.Codec, nodeURI, chainID string, keybase keys.Keybase) Client {
+	cliCtx := context.CLIContext{}.
+		WithCodec(cdc).
+		WithNodeURI(nodeURI).
+		WithChainID(chainID).
+		WithTrustNode(true).
+		WithBroadcastMode(flags.BroadcastSync)
+
+	return NewClientWithCtx(cliCtx, keybase)
+}
+
+// NewClientWithCtx creates a client by a cli context, for the caller which need more configurations
+func NewClientWithCtx(cliCtx context.CLIContext, keybase keys.Keybase) Client {
+	return Client{
+		cliCtx:        cliCtx,
+		keybase:       keybase,
+		chainID:       cliCtx.ChainID,
+		gasAdjustment: flags.DefaultGasAdjustment,
+	}
+}
+
+// WithBroadcastMode returns a copy of the client with the broadcast mode, sync, async or block
+func (c Client) WithBroadcastMode(mode string) Client {
+	c.cliCtx = c.cliCtx.WithBroadcastMode(mode)
+	return c
+}
+
+// WithGasAdjustment returns a copy of the client with the gas adjustment for the simulated gas
+func (c Client) WithGasAdjustment(adjustment float64) Client {
+	c.gasAdjustment = adjustment
+	return c
+}
+
+// CLIContext returns the cli context of the client, can be used to query the node
+func (c Client) CLIContext() context.CLIContext {
+	return c.cliCtx
+}
+
+// NewTx creates a tx builder for the account from, the auth of which is resolved
+// from the node, and the tx will be signed by the key of the name in keybase.
+func (c Client) NewTx(from types.AccountID, keyName string) *Builder {
+	return newBuilder(c, from, keyName)
+}
+
+// QueryAuth queries the auth address, account number and sequence of the account
+func (c Client) QueryAuth(id types.AccountID) (types.AccAddress, uint64, uint64, error) {
+	ctx := txutil.NewKuCLICtx(c.cliCtx).WithFromAccount(id)
+
+	auth, err := txutil.QueryAccountAuth(ctx, id)
+	if err != nil {
+		return types.AccAddress{}, 0, 0, err
+	}
+
+	num, seq, err := txutil.NewAccountRetriever(ctx).GetAuthNumberSequence(id)
+	if err != nil {
+		return types.AccAddress{}, 0, 0, err
+	}
+
+	return auth, num, seq, nil
+}
@@ -31,7 +31,7 @@ func NewAccountRetriever(cliCtx KuCLIContext) accountTypes.AccountRetriever {
 func GetSignBytes(ctx sdk.Context, tx *StdTx, accNum, seq uint64) []byte {
 	chainID := ctx.ChainID()
 
-	return types.StdSignBytes(
-		chainID, accNum, seq, tx.Fee, tx.Msgs, tx.Memo,
+	return types.StdSignBytesWithTimeout(
+		chainID, accNum, seq, tx.Fee, tx.Msgs, tx.Memo, tx.TimeoutHeight,
 	)
 }
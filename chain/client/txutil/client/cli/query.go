@@ -12,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
@@ -70,6 +72,68 @@ If you supply a dash (-) argument in place of an input filename, the command rea
 	return flags.PostCommands(cmd)[0]
 }
 
+// GetDecryptMemoCommand returns the command to decrypt the encrypted memo of a tx by the memo key of the recipient
+func GetDecryptMemoCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrypt-memo [hash]",
+		Short: "Decrypt the encrypted memo of a transaction by the memo key derived from the --from key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			output, err := QueryTx(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			if output.Empty() {
+				return fmt.Errorf("no transaction found with hash %s", args[0])
+			}
+
+			stdTx, ok := output.Tx.(types.StdTx)
+			if !ok {
+				return fmt.Errorf("unsupported tx type %T", output.Tx)
+			}
+
+			recipient, _, err := types.ParseEncryptedMemo(stdTx.GetMemo())
+			if err != nil {
+				return err
+			}
+
+			kb, err := keys.NewKeyring(sdk.KeyringServiceName(),
+				viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+
+			_, privKey, err := txutil.DeriveMemoKey(kb, cliCtx.GetFromName())
+			if err != nil {
+				return err
+			}
+
+			memo, err := types.DecryptMemo(stdTx.GetMemo(), privKey)
+			if err != nil {
+				return fmt.Errorf("decrypt memo to %s: %w", recipient, err)
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), memo)
+			return err
+		},
+	}
+
+	cmd.Flags().String(flags.FlagFrom, "", "Name of the key to derive the memo key")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().StringP(flags.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+	cmd.Flags().Bool(flags.FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
+	viper.BindPFlag(flags.FlagFrom, cmd.Flags().Lookup(flags.FlagFrom))
+	viper.BindPFlag(flags.FlagKeyringBackend, cmd.Flags().Lookup(flags.FlagKeyringBackend))
+	viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
+	viper.BindPFlag(flags.FlagTrustNode, cmd.Flags().Lookup(flags.FlagTrustNode))
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
 func QueryTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tx [hash]",
@@ -118,6 +182,18 @@ $ <appcli> tx broadcast ./mytxn.json
 				return
 			}
 
+			if !txutil.SkipConfirm(cliCtx) {
+				signers := make([]string, 0, len(stdTx.GetSigners()))
+				for _, signer := range stdTx.GetSigners() {
+					signers = append(signers, signer.String())
+				}
+
+				ok, err := txutil.ConfirmTx(cliCtx, signers, stdTx.Fee, stdTx.Msgs, stdTx.Memo)
+				if err != nil || !ok {
+					return err
+				}
+			}
+
 			txBytes, err := cliCtx.Codec.MarshalBinaryLengthPrefixed(stdTx)
 			if err != nil {
 				return
@@ -133,6 +209,39 @@ $ <appcli> tx broadcast ./mytxn.json
 	return flags.PostCommands(cmd)[0]
 }
 
+// GetSimulateCommand returns the simulate command to execute a transaction generated offline
+// against the latest state of the node without committing it
+func GetSimulateCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate [file_path]",
+		Short: "Simulate transactions generated offline",
+		Long: strings.TrimSpace(`Simulate a transaction created with the --generate-only flag,
+signed or not, against the latest state of the node without committing it. Read a transaction
+from [file_path] and print the gas used, events and the error of the transaction. If you supply
+a dash (-) argument in place of an input filename, the command reads from standard input.
+
+$ <appcli> tx simulate ./mytxn.json
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			stdTx, err := txutil.ReadStdTxFromFile(cliCtx.Codec, args[0])
+			if err != nil {
+				return
+			}
+
+			res, err := txutil.SimulateTx(cliCtx, stdTx)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(res)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
 // GetDecodeCommand returns the decode command to take Amino-serialized bytes
 // and turn it into a JSONified transaction.
 func GetDecodeCommand(codec *amino.Codec) *cobra.Command {
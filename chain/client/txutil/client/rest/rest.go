@@ -11,6 +11,7 @@ func RegisterTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/txs", QueryTxsRequestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/txs", BroadcastTxRequest(cliCtx)).Methods("POST")
 	r.HandleFunc("/txs/encode", EncodeTxRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/txs/simulate", SimulateTxRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/txs/decode", DecodeTxRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/sign_msg/encode", EncodeMsgRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/sign_msg/decode", DecodeMsgRequestHandlerFn(cliCtx)).Methods("POST")
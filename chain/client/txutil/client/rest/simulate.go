@@ -0,0 +1,46 @@
+package rest
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// SimulateReq defines a tx simulation request.
+type SimulateReq struct {
+	Tx types.StdTx `json:"tx" yaml:"tx"`
+}
+
+// SimulateTxRequestHandlerFn returns the simulate tx REST handler. It takes a
+// json-formatted transaction, signed or not, executes it against the latest
+// state of the node without committing, and responds with the gas used, events
+// and the error of the transaction.
+func SimulateTxRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SimulateReq
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = cliCtx.Codec.UnmarshalJSON(body, &req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := txutil.SimulateTx(cliCtx, req.Tx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, res)
+	}
+}
@@ -0,0 +1,56 @@
+package txutil
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	chainFlags "github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+)
+
+// SkipConfirm returns whether to skip the confirmation before broadcasting tx,
+// by the --yes flag of the command or the global --no-prompt flag.
+func SkipConfirm(cliCtx context.CLIContext) bool {
+	return cliCtx.SkipConfirm || viper.GetBool(chainFlags.FlagNoPrompt)
+}
+
+// ConfirmTx prints the signers, fee and msgs of the tx to broadcast, then prompts
+// the user to confirm, it returns false if the user not confirmed.
+func ConfirmTx(cliCtx context.CLIContext, signers []string, fee types.StdFee, msgs []sdk.Msg, memo string) (bool, error) {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "signers: %s\n", strings.Join(signers, ", "))
+	fmt.Fprintf(&sb, "fee: %s, gas: %d, payer: %s\n", fee.Amount, fee.Gas, fee.Payer)
+	if !fee.Referrer.Empty() {
+		fmt.Fprintf(&sb, "referrer: %s\n", fee.Referrer)
+	}
+	if memo != "" {
+		fmt.Fprintf(&sb, "memo: %s\n", memo)
+	}
+
+	fmt.Fprintf(&sb, "msgs:\n")
+	for i, msg := range msgs {
+		bz, err := cliCtx.Codec.MarshalJSONIndent(msg, "  ", "  ")
+		if err != nil {
+			return false, err
+		}
+		fmt.Fprintf(&sb, "  %d. %s %s\n", i+1, msg.Route(), msg.Type())
+		fmt.Fprintf(&sb, "  %s\n", bz)
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "%s\n", sb.String())
+
+	ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", bufio.NewReader(cliCtx.Input))
+	if err != nil || !ok {
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", "cancelled transaction")
+		return false, err
+	}
+
+	return true, nil
+}
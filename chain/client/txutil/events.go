@@ -0,0 +1,113 @@
+package txutil
+
+import (
+	"fmt"
+	"os"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chainMsg "github.com/KuChainNetwork/kuchain/chain/msg"
+)
+
+// EventFormatter formats an event of a msg to the human readable lines. The events of a msg are
+// merged by type in the result logs, so the event may have the attributes of many emitted events.
+// All the events of the msg are given for the related attributes, such as the sender of the msg.
+type EventFormatter func(event sdk.StringEvent, msgEvents sdk.StringEvents) []string
+
+var eventFormatters = make(map[string]EventFormatter)
+
+func init() {
+	RegisterEventFormatter(chainMsg.EventTypeTransfer, formatTransferEvent)
+}
+
+// RegisterEventFormatter registers the formatter of the events of the type, the modules register
+// the formatters of their events in the init of their client packages.
+func RegisterEventFormatter(eventType string, formatter EventFormatter) {
+	if _, ok := eventFormatters[eventType]; ok {
+		panic(fmt.Sprintf("event formatter for %s already registered", eventType))
+	}
+
+	eventFormatters[eventType] = formatter
+}
+
+// FormatTxEvents formats the events of the msgs in the tx result by the registered formatters,
+// the events with no formatter are skipped.
+func FormatTxEvents(res sdk.TxResponse) []string {
+	lines := make([]string, 0, len(res.Logs))
+	if res.Code != 0 {
+		return lines
+	}
+
+	for _, log := range res.Logs {
+		for _, event := range log.Events {
+			if formatter, ok := eventFormatters[event.Type]; ok {
+				lines = append(lines, formatter(event, log.Events)...)
+			}
+		}
+	}
+
+	return lines
+}
+
+// printTxEvents prints the formatted events of the tx to stderr,
+// note the events are only returned in the block broadcast mode.
+func printTxEvents(res sdk.TxResponse) {
+	for _, line := range FormatTxEvents(res) {
+		_, _ = fmt.Fprintln(os.Stderr, line)
+	}
+}
+
+// EventAttributes returns the values of the attribute in the event, in the order emitted
+func EventAttributes(event sdk.StringEvent, key string) []string {
+	values := make([]string, 0, 1)
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			values = append(values, attr.Value)
+		}
+	}
+
+	return values
+}
+
+// EventAttribute returns the first value of the attribute in the event, empty if not found
+func EventAttribute(event sdk.StringEvent, key string) string {
+	if values := EventAttributes(event, key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// FindEvent returns the event of the type in the events of a msg
+func FindEvent(events sdk.StringEvents, eventType string) (sdk.StringEvent, bool) {
+	for _, event := range events {
+		if event.Type == eventType {
+			return event, true
+		}
+	}
+
+	return sdk.StringEvent{}, false
+}
+
+// MsgSender returns the sender of the msg from its message event, empty if not found
+func MsgSender(events sdk.StringEvents) string {
+	event, ok := FindEvent(events, sdk.EventTypeMessage)
+	if !ok {
+		return ""
+	}
+
+	return EventAttribute(event, sdk.AttributeKeySender)
+}
+
+func formatTransferEvent(event sdk.StringEvent, _ sdk.StringEvents) []string {
+	froms := EventAttributes(event, chainMsg.AttributeKeyFrom)
+	tos := EventAttributes(event, chainMsg.AttributeKeyTo)
+	amounts := EventAttributes(event, chainMsg.AttributeKeyAmount)
+
+	lines := make([]string, 0, len(froms))
+	for i := 0; i < len(froms) && i < len(tos) && i < len(amounts); i++ {
+		lines = append(lines, fmt.Sprintf("Transferred %s from %s to %s", amounts[i], froms[i], tos[i]))
+	}
+
+	return lines
+}
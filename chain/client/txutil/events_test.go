@@ -0,0 +1,51 @@
+package txutil_test
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	_ "github.com/KuChainNetwork/kuchain/x/gov/client/cli"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatTxEvents(t *testing.T) {
+	submitLog := sdk.NewABCIMessageLog(0, "", sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeySender, "jack")),
+		sdk.NewEvent(govTypes.EventTypeProposalDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "100kts"), sdk.NewAttribute(govTypes.AttributeKeyProposalID, "42")),
+		sdk.NewEvent(govTypes.EventTypeSubmitProposal, sdk.NewAttribute(govTypes.AttributeKeyProposalID, "42")),
+	})
+
+	// the events of the same type are merged in the log
+	transferLog := sdk.NewABCIMessageLog(1, "", sdk.Events{
+		sdk.NewEvent("transfer", sdk.NewAttribute("from", "jack"), sdk.NewAttribute("to", "alice"), sdk.NewAttribute("amount", "1kts")),
+		sdk.NewEvent("transfer", sdk.NewAttribute("from", "alice"), sdk.NewAttribute("to", "bob"), sdk.NewAttribute("amount", "2kts")),
+		sdk.NewEvent("unknown", sdk.NewAttribute("key", "value")),
+	})
+
+	res := sdk.TxResponse{Logs: sdk.ABCIMessageLogs{submitLog, transferLog}}
+	require.Equal(t, []string{
+		"Proposal 42 submitted by jack with 100kts deposit",
+		"Transferred 1kts from jack to alice",
+		"Transferred 2kts from alice to bob",
+	}, txutil.FormatTxEvents(res))
+
+	// the deposit to an existing proposal is formatted alone
+	depositLog := sdk.NewABCIMessageLog(0, "", sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeySender, "alice")),
+		sdk.NewEvent(govTypes.EventTypeProposalDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "100kts"), sdk.NewAttribute(govTypes.AttributeKeyProposalID, "42"),
+			sdk.NewAttribute(govTypes.AttributeKeyVotingPeriodStart, "42")),
+	})
+	require.Equal(t, []string{"Deposited 100kts to proposal 42 by alice, voting period started"},
+		txutil.FormatTxEvents(sdk.TxResponse{Logs: sdk.ABCIMessageLogs{depositLog}}))
+
+	// nothing formatted for the failed tx
+	require.Empty(t, txutil.FormatTxEvents(sdk.TxResponse{Code: 1, Logs: res.Logs}))
+
+	require.Panics(t, func() {
+		txutil.RegisterEventFormatter("transfer", func(sdk.StringEvent, sdk.StringEvents) []string { return nil })
+	})
+}
@@ -0,0 +1,72 @@
+package txutil
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+)
+
+// DeriveMemoKey derives the memo key pair of the key in the keybase, the same key always
+// derives the same memo key, so the memo key is not stored.
+func DeriveMemoKey(kb crkeys.Keybase, name string) (pubKey, privKey []byte, err error) {
+	seed, _, err := kb.Sign(name, keys.DefaultKeyPass, types.MemoKeyDomain())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey, privKey = types.DeriveMemoKey(seed)
+	return pubKey, privKey, nil
+}
+
+// QueryMemoKey queries the memo key of the account
+func QueryMemoKey(cliCtx KuCLIContext, name types.Name) ([]byte, error) {
+	bz, err := cliCtx.Codec.MarshalJSON(accountTypes.NewQueryMemoKeyParams(name))
+	if err != nil {
+		return nil, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", accountTypes.QuerierRoute, accountTypes.QueryMemoKey)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var memoKey []byte
+	if err := cliCtx.Codec.UnmarshalJSON(res, &memoKey); err != nil {
+		return nil, err
+	}
+
+	return memoKey, nil
+}
+
+// EncryptMemo encrypts the memo of the tx builder to the memo key of the recipient account
+func EncryptMemo(cliCtx KuCLIContext, txBldr TxBuilder, recipient string) (TxBuilder, error) {
+	if txBldr.Memo() == "" {
+		return txBldr, errors.New("no memo to encrypt")
+	}
+
+	if cliCtx.GenerateOnly {
+		return txBldr, errors.New("cannot query the memo key of the recipient in generate only mode")
+	}
+
+	name, err := types.NewName(recipient)
+	if err != nil {
+		return txBldr, fmt.Errorf("recipient should be an account name: %w", err)
+	}
+
+	memoKey, err := QueryMemoKey(cliCtx, name)
+	if err != nil {
+		return txBldr, fmt.Errorf("query memo key of %s: %w", name, err)
+	}
+
+	memo, err := types.EncryptMemo(types.NewAccountIDFromName(name), memoKey, txBldr.Memo())
+	if err != nil {
+		return txBldr, err
+	}
+
+	return txBldr.WithMemo(memo), nil
+}
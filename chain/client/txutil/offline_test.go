@@ -0,0 +1,38 @@
+package txutil_test
+
+import (
+	"testing"
+
+	chainFlags "github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryAccountAuthOffline(t *testing.T) {
+	viper.Set(chainFlags.FlagOffline, true)
+	viper.Set(flags.FlagKeyringBackend, "unknown")
+	defer viper.Reset()
+
+	auth := sdk.AccAddress([]byte("voter_auth__________"))
+	cliCtx := txutil.KuCLIContext{CLIContext: context.CLIContext{GenerateOnly: true}}
+
+	// the auth of the address account is itself
+	res, err := txutil.QueryAccountAuth(cliCtx, types.NewAccountIDFromAccAdd(auth))
+	require.NoError(t, err)
+	require.Equal(t, auth, res)
+
+	// the auth of the name account cannot be resolved without --from
+	_, err = txutil.QueryAccountAuth(cliCtx, types.MustAccountID("voter"))
+	require.Error(t, err)
+
+	// the auth of the name account is from --from
+	cliCtx.FromAddress = auth
+	res, err = txutil.QueryAccountAuth(cliCtx, types.MustAccountID("voter"))
+	require.NoError(t, err)
+	require.Equal(t, auth, res)
+}
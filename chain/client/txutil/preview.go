@@ -0,0 +1,142 @@
+package txutil
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SignPreview the payload to sign in a human-auditable form, the msgs data is decoded,
+// and the signers are resolved to the key names in the keybase.
+type SignPreview struct {
+	ChainID       string            `json:"chain_id" yaml:"chain_id"`
+	AccountNumber uint64            `json:"account_number" yaml:"account_number"`
+	Sequence      uint64            `json:"sequence" yaml:"sequence"`
+	Signers       []string          `json:"signers" yaml:"signers"`
+	Fee           types.StdFee      `json:"fee" yaml:"fee"`
+	Memo          string            `json:"memo" yaml:"memo"`
+	TimeoutHeight uint64            `json:"timeout_height" yaml:"timeout_height"`
+	Msgs          []json.RawMessage `json:"msgs" yaml:"msgs"`
+	SignBytesHash string            `json:"sign_bytes_sha256" yaml:"sign_bytes_sha256"` // to compare with the hash displayed by the signing device
+}
+
+// NewSignPreview creates the preview of the sign msg, the keybase can be nil if not to resolve the signers.
+func NewSignPreview(cliCtx KuCLIContext, keybase crkeys.Keybase, stdSignMsg types.StdSignMsg) (SignPreview, error) {
+	hash := sha256.Sum256(stdSignMsg.Bytes())
+
+	res := SignPreview{
+		ChainID:       stdSignMsg.ChainID,
+		AccountNumber: stdSignMsg.AccountNumber,
+		Sequence:      stdSignMsg.Sequence,
+		Signers:       make([]string, 0, len(stdSignMsg.Msg)),
+		Fee:           stdSignMsg.Fee,
+		Memo:          stdSignMsg.Memo,
+		TimeoutHeight: stdSignMsg.TimeoutHeight,
+		Msgs:          make([]json.RawMessage, 0, len(stdSignMsg.Msg)),
+		SignBytesHash: strings.ToUpper(hex.EncodeToString(hash[:])),
+	}
+
+	seen := make(map[string]bool)
+	for _, msg := range stdSignMsg.Msg {
+		for _, signer := range msg.GetSigners() {
+			if seen[signer.String()] {
+				continue
+			}
+			seen[signer.String()] = true
+			res.Signers = append(res.Signers, resolveSigner(keybase, signer))
+		}
+
+		raw, err := prettifyMsg(cliCtx, msg)
+		if err != nil {
+			return res, err
+		}
+		res.Msgs = append(res.Msgs, raw)
+	}
+
+	return res, nil
+}
+
+func resolveSigner(keybase crkeys.Keybase, signer sdk.AccAddress) string {
+	if keybase != nil {
+		if info, err := keybase.GetByAddress(signer); err == nil {
+			return fmt.Sprintf("%s (%s)", info.GetName(), signer)
+		}
+	}
+
+	return signer.String()
+}
+
+func prettifyMsg(cliCtx KuCLIContext, msg sdk.Msg) (json.RawMessage, error) {
+	if prettifier, ok := msg.(types.Prettifier); ok {
+		return prettifier.PrettifyJSON(cliCtx.Codec)
+	}
+
+	return cliCtx.Codec.MarshalJSON(msg)
+}
+
+// String implements stringer interface, renders the preview for human auditing
+func (p SignPreview) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "chain-id: %s\n", p.ChainID)
+	fmt.Fprintf(&sb, "account number: %d, sequence: %d\n", p.AccountNumber, p.Sequence)
+	fmt.Fprintf(&sb, "signers: %s\n", strings.Join(p.Signers, ", "))
+	fmt.Fprintf(&sb, "fee: %s, gas: %d, payer: %s\n", p.Fee.Amount, p.Fee.Gas, p.Fee.Payer)
+	if !p.Fee.Referrer.Empty() {
+		fmt.Fprintf(&sb, "referrer: %s\n", p.Fee.Referrer)
+	}
+	fmt.Fprintf(&sb, "memo: %q\n", p.Memo)
+	if p.TimeoutHeight > 0 {
+		fmt.Fprintf(&sb, "timeout height: %d\n", p.TimeoutHeight)
+	}
+
+	fmt.Fprintf(&sb, "msgs:\n")
+	for i, raw := range p.Msgs {
+		fmt.Fprintf(&sb, "  %d. %s\n", i+1, indentJSON(raw, "     "))
+	}
+
+	fmt.Fprintf(&sb, "sign bytes sha256: %s", p.SignBytesHash)
+
+	return sb.String()
+}
+
+func indentJSON(raw json.RawMessage, prefix string) string {
+	var out bytes.Buffer
+	if err := json.Indent(&out, raw, prefix, "  "); err != nil {
+		return string(raw)
+	}
+
+	return out.String()
+}
+
+// PrintSignPreview builds the payload to sign and prints its preview, it is not signed and broadcasted.
+func PrintSignPreview(txBldr TxBuilder, cliCtx KuCLIContext, msgs []sdk.Msg) error {
+	stdSignMsg, err := txBldr.BuildSignMsg(msgs)
+	if err != nil {
+		return err
+	}
+
+	preview, err := NewSignPreview(cliCtx, txBldr.Keybase(), stdSignMsg)
+	if err != nil {
+		return err
+	}
+
+	if cliCtx.OutputFormat == "json" {
+		out, err := json.MarshalIndent(preview, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(cliCtx.Output, "%s\n", out)
+		return err
+	}
+
+	_, err = fmt.Fprintf(cliCtx.Output, "%s\n", preview)
+	return err
+}
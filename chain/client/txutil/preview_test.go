@@ -0,0 +1,47 @@
+package txutil_test
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignPreview(t *testing.T) {
+	cliCtx := txutil.KuCLIContext{CLIContext: context.CLIContext{Codec: types.Cdc()}}
+
+	from := types.MustName("alice")
+	auth := sdk.AccAddress([]byte("alice_auth__________"))
+	msg := types.KuMsg{
+		Auth:   []sdk.AccAddress{auth},
+		From:   types.NewAccountIDFromName(from),
+		To:     types.NewAccountIDFromName(types.MustName("bob")),
+		Amount: types.NewCoins(types.NewInt64Coin("kratos/kts", 100)),
+		Router: types.MustName("account"),
+	}
+
+	stdSignMsg := types.StdSignMsg{
+		ChainID:       "testing",
+		AccountNumber: 3,
+		Sequence:      7,
+		Fee:           types.NewStdFee(20000, types.NewAccountIDFromName(from), types.NewCoins(types.NewInt64Coin("kratos/kts", 1))),
+		Msg:           []sdk.Msg{msg, msg},
+		Memo:          "for bob",
+	}
+
+	preview, err := txutil.NewSignPreview(cliCtx, nil, stdSignMsg)
+	require.NoError(t, err)
+	require.Equal(t, []string{auth.String()}, preview.Signers)
+	require.Len(t, preview.Msgs, 2)
+	require.Len(t, preview.SignBytesHash, 64)
+
+	out := preview.String()
+	require.Contains(t, out, "chain-id: testing")
+	require.Contains(t, out, "account number: 3, sequence: 7")
+	require.Contains(t, out, `memo: "for bob"`)
+	require.Contains(t, out, `"to": "bob"`)
+	require.Contains(t, out, preview.SignBytesHash)
+}
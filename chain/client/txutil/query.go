@@ -19,6 +19,12 @@ import (
 // concatenated with an 'AND' operand. It returns a slice of Info object
 // containing txs and metadata. An error is returned if the query fails.
 func QueryTxsByEvents(cliCtx context.CLIContext, events []string, page, limit int) (*sdk.SearchTxsResult, error) {
+	return QueryTxsByEventsOrderBy(cliCtx, events, page, limit, "")
+}
+
+// QueryTxsByEventsOrderBy performs a search for transactions for a given set of events like QueryTxsByEvents,
+// the txs are ordered by the height as the orderBy "asc" or "desc", by the indexer default if empty.
+func QueryTxsByEventsOrderBy(cliCtx context.CLIContext, events []string, page, limit int, orderBy string) (*sdk.SearchTxsResult, error) {
 	if len(events) == 0 {
 		return nil, errors.New("must declare at least one event to search")
 	}
@@ -41,7 +47,7 @@ func QueryTxsByEvents(cliCtx context.CLIContext, events []string, page, limit in
 
 	prove := !cliCtx.TrustNode
 
-	resTxs, err := node.TxSearch(query, prove, page, limit, "")
+	resTxs, err := node.TxSearch(query, prove, page, limit, orderBy)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,28 @@
+package txutil
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// SimulateTx simulates the tx against the latest state of the node without committing,
+// the tx can be unsigned, the response contains the gas used, events and the error of the tx.
+func SimulateTx(cliCtx context.CLIContext, tx types.StdTx) (types.SimulateResponse, error) {
+	var res types.SimulateResponse
+
+	txBytes, err := cliCtx.Codec.MarshalBinaryLengthPrefixed(tx)
+	if err != nil {
+		return res, err
+	}
+
+	bz, _, err := cliCtx.QueryWithData(types.QueryPathSimulate, txBytes)
+	if err != nil {
+		return res, err
+	}
+
+	if err := cliCtx.Codec.UnmarshalJSON(bz, &res); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
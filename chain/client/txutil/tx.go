@@ -1,18 +1,21 @@
 package txutil
 
 import (
-	"bufio"
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"os"
 
+	chainFlags "github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/watch"
 	"github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 )
@@ -31,6 +34,13 @@ func (gr GasEstimateResponse) String() string {
 // to STDOUT in a fully offline manner. Otherwise, the tx will be signed and
 // broadcasted.
 func GenerateOrBroadcastMsgs(cliCtx KuCLIContext, txBldr TxBuilder, msgs []sdk.Msg) error {
+	if recipient := viper.GetString(chainFlags.FlagEncryptMemo); recipient != "" {
+		var err error
+		if txBldr, err = EncryptMemo(cliCtx, txBldr, recipient); err != nil {
+			return err
+		}
+	}
+
 	if cliCtx.GenerateOnly {
 		return PrintUnsignedStdTx(txBldr, cliCtx, msgs)
 	}
@@ -63,28 +73,19 @@ func CompleteAndBroadcastTxCLI(txBldr TxBuilder, cliCtx KuCLIContext, msgs []sdk
 		return nil
 	}
 
-	if !cliCtx.SkipConfirm {
+	if viper.GetBool(chainFlags.FlagPreview) {
+		return PrintSignPreview(txBldr, cliCtx, msgs)
+	}
+
+	if !SkipConfirm(cliCtx.CLIContext) {
 		stdSignMsg, err := txBldr.BuildSignMsg(msgs)
 		if err != nil {
 			return err
 		}
 
-		var json []byte
-		if viper.GetBool(flags.FlagIndentResponse) {
-			json, err = cliCtx.Codec.MarshalJSONIndent(stdSignMsg, "", "  ")
-			if err != nil {
-				panic(err)
-			}
-		} else {
-			json = cliCtx.Codec.MustMarshalJSON(stdSignMsg)
-		}
-
-		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", json)
-
-		buf := bufio.NewReader(os.Stdin)
-		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf)
+		signer := fmt.Sprintf("%s (%s)", cliCtx.GetFromName(), cliCtx.GetFromAddress())
+		ok, err := ConfirmTx(cliCtx.CLIContext, []string{signer}, stdSignMsg.Fee, stdSignMsg.Msg, stdSignMsg.Memo)
 		if err != nil || !ok {
-			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "cancelled transaction")
 			return err
 		}
 	}
@@ -101,7 +102,53 @@ func CompleteAndBroadcastTxCLI(txBldr TxBuilder, cliCtx KuCLIContext, msgs []sdk
 		return err
 	}
 
-	return cliCtx.PrintOutput(res)
+	if err := cliCtx.PrintOutput(res); err != nil {
+		return err
+	}
+
+	printCreatedIDs(cliCtx, res)
+	printTxEvents(res)
+
+	return nil
+}
+
+// printCreatedIDs prints the ids of the resources created by the tx to stderr,
+// note the result data is only returned in the block broadcast mode.
+func printCreatedIDs(cliCtx KuCLIContext, res sdk.TxResponse) {
+	ids, err := CreatedIDs(cliCtx.Codec, res)
+	if err != nil {
+		return
+	}
+
+	for _, id := range ids {
+		_, _ = fmt.Fprintf(os.Stderr, "created id: %s\n", id)
+	}
+}
+
+// CreatedIDs returns the ids of the resources created by the msgs of the tx from its result data
+func CreatedIDs(cdc *codec.Codec, res sdk.TxResponse) ([]string, error) {
+	if res.Code != 0 || res.Data == "" {
+		return nil, nil
+	}
+
+	data, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	msgResponses, err := types.UnmarshalMsgResponses(cdc, data)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(msgResponses))
+	for _, r := range msgResponses {
+		if id := r.CreatedID(); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
 }
 
 // EnrichWithGas calculates the gas estimate that would be consumed by the
@@ -156,6 +203,29 @@ func PrintUnsignedStdTx(txBldr TxBuilder, cliCtx KuCLIContext, msgs []sdk.Msg) e
 	}
 
 	_, _ = fmt.Fprintf(cliCtx.Output, "%s\n", json)
+
+	if viper.GetBool(chainFlags.FlagOffline) {
+		return printOfflineSignInfo(txBldr, msgs)
+	}
+
+	return nil
+}
+
+// printOfflineSignInfo prints the account number, the sequence and the hash of the payload to sign of the tx
+// built offline to stderr, the airgapped signer signs the tx by 'tx sign --offline' with the same values,
+// and compares the hash with the one displayed by the signing device.
+func printOfflineSignInfo(txBldr TxBuilder, msgs []sdk.Msg) error {
+	stdSignMsg, err := txBldr.BuildSignMsg(msgs)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(stdSignMsg.Bytes())
+	_, _ = fmt.Fprintf(os.Stderr, "account number: %d, sequence: %d, sign bytes sha256: %X\n",
+		stdSignMsg.AccountNumber, stdSignMsg.Sequence, hash)
+	_, _ = fmt.Fprintf(os.Stderr, "sign it offline by: %s tx sign [file] --offline --chain-id %s --account-number %d --sequence %d --from [key]\n",
+		version.ClientName, stdSignMsg.ChainID, stdSignMsg.AccountNumber, stdSignMsg.Sequence)
+
 	return nil
 }
 
@@ -329,7 +399,12 @@ func PrepareTxBuilder(txBldr TxBuilder, cliCtx KuCLIContext) (TxBuilder, error)
 func QueryAccountAuth(cliCtx KuCLIContext, id types.AccountID) (types.AccAddress, error) {
 	if cliCtx.GenerateOnly {
 		// if just gen tx, cmd will not connect to node to get info, all auth is from --from params
-		return cliCtx.FromAddress, nil
+		auth := generateOnlyAuth(cliCtx, id)
+		if auth.Empty() && viper.GetBool(chainFlags.FlagOffline) {
+			return auth, fmt.Errorf("the auth of %s cannot be resolved offline, "+
+				"set --from to the auth or add the account to the keyring by 'keys add-watch'", id)
+		}
+		return auth, nil
 	}
 
 	if _, ok := id.ToName(); ok {
@@ -353,6 +428,54 @@ func QueryAccountAuth(cliCtx KuCLIContext, id types.AccountID) (types.AccAddress
 	return types.AccAddress{}, errors.New("accountID type no support")
 }
 
+// QueryAccountsAuth queries the auths of accounts in one query, the result is in the order of ids,
+// batch signers can use it to resolve many accounts efficiently.
+func QueryAccountsAuth(cliCtx KuCLIContext, ids []types.AccountID) ([]types.AccAddress, error) {
+	res := make([]types.AccAddress, 0, len(ids))
+
+	if cliCtx.GenerateOnly {
+		// if just gen tx, cmd will not connect to node to get info, all auth is from --from params
+		for _, id := range ids {
+			res = append(res, generateOnlyAuth(cliCtx, id))
+		}
+		return res, nil
+	}
+
+	auths, err := NewAccountRetriever(cliCtx).GetAccountsAuth(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, auth := range auths {
+		res = append(res, auth.Auth.GetAddress())
+	}
+
+	return res, nil
+}
+
+// generateOnlyAuth returns the auth of the account for the generate-only txs, which is from --from params,
+// or the address of the account itself, or from the watch-only account in the keyring if no --from.
+func generateOnlyAuth(cliCtx KuCLIContext, id types.AccountID) types.AccAddress {
+	if !cliCtx.FromAddress.Empty() {
+		return cliCtx.FromAddress
+	}
+
+	if auth, ok := id.ToAccAddress(); ok {
+		return auth
+	}
+
+	kb, err := watch.NewKeybase(cliCtx.Input)
+	if err != nil {
+		return cliCtx.FromAddress
+	}
+
+	if auth, ok := watch.Auth(kb, id); ok {
+		return auth
+	}
+
+	return cliCtx.FromAddress
+}
+
 func buildUnsignedStdTxOffline(txBldr TxBuilder, cliCtx KuCLIContext, msgs []sdk.Msg) (stdTx StdTx, err error) {
 	if txBldr.SimulateAndExecute() {
 		if cliCtx.GenerateOnly {
@@ -372,7 +495,7 @@ func buildUnsignedStdTxOffline(txBldr TxBuilder, cliCtx KuCLIContext, msgs []sdk
 		return stdTx, err
 	}
 
-	return NewStdTx(stdSignMsg.Msg, stdSignMsg.Fee, nil, stdSignMsg.Memo), nil
+	return NewStdTx(stdSignMsg.Msg, stdSignMsg.Fee, nil, stdSignMsg.Memo).WithTimeoutHeight(stdSignMsg.TimeoutHeight), nil
 }
 
 func isTxSigner(user sdk.AccAddress, signers []sdk.AccAddress) bool {
@@ -0,0 +1,44 @@
+package txutil_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	paychanTypes "github.com/KuChainNetwork/kuchain/x/paychan/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatedIDs(t *testing.T) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	govTypes.RegisterCodec(cdc)
+	paychanTypes.RegisterCodec(cdc)
+
+	// the tx data is the concatenation of the data of its msgs
+	data := append(govTypes.MarshalMsgResponse(govTypes.MsgSubmitProposalResponse{ProposalID: 12}),
+		govTypes.MarshalMsgResponse(govTypes.MsgVoteResponse{})...)
+	data = append(data, types.MarshalMsgResponse(paychanTypes.Cdc(), paychanTypes.MsgOpenChannelResponse{ChannelID: 3})...)
+
+	res := sdk.TxResponse{Data: hex.EncodeToString(data)}
+	ids, err := txutil.CreatedIDs(cdc, res)
+	require.NoError(t, err)
+	require.Equal(t, []string{"12", "3"}, ids)
+
+	// no ids if the tx failed or has no data
+	ids, err = txutil.CreatedIDs(cdc, sdk.TxResponse{Code: 1, Data: res.Data})
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	ids, err = txutil.CreatedIDs(cdc, sdk.TxResponse{})
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	// the data not encoded by the msg responses
+	_, err = txutil.CreatedIDs(cdc, sdk.TxResponse{Data: hex.EncodeToString(sdk.Uint64ToBigEndian(3))})
+	require.Error(t, err)
+}
@@ -0,0 +1,137 @@
+package watch
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+// NewKeybase opens the keyring selected by the flags in viper
+func NewKeybase(input io.Reader) (keys.Keybase, error) {
+	return keys.NewKeyring(sdk.KeyringServiceName(),
+		viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), input)
+}
+
+// Add adds the watch-only entry of the account to the keyring, a watch-only entry is an offline key
+// named by the account, with the public key of the account auth but no private key.
+func Add(kb keys.Keybase, account types.AccountID, pubKey string) (keys.Info, error) {
+	if _, ok := account.ToName(); !ok {
+		return nil, fmt.Errorf("watch-only account %s should be an account name", account)
+	}
+
+	pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key %s: %w", pubKey, err)
+	}
+
+	if _, err := kb.Get(account.String()); err == nil {
+		return nil, fmt.Errorf("key %s already exists in the keyring", account)
+	}
+
+	return kb.CreateOffline(account.String(), pk, keys.Secp256k1)
+}
+
+// Auth returns the auth of the watch-only account in the keyring,
+// returns false if the account is not watched.
+func Auth(kb keys.Keybase, account types.AccountID) (types.AccAddress, bool) {
+	info, err := kb.Get(account.String())
+	if err != nil || info.GetType() != keys.TypeOffline {
+		return nil, false
+	}
+
+	return info.GetAddress(), true
+}
+
+// ResolveFrom resolves the --from of the generate-only txs, which should be an address, the key
+// names in the keyring, including the watch-only accounts, are resolved to the address of the key.
+func ResolveFrom(kb keys.Keybase, from string) (string, error) {
+	if _, err := sdk.AccAddressFromBech32(from); err == nil {
+		return from, nil
+	}
+
+	info, err := kb.Get(from)
+	if err != nil {
+		return "", fmt.Errorf("key %s not found in the keyring: %w", from, err)
+	}
+
+	return info.GetAddress().String(), nil
+}
+
+// ApplyFrom sets the --from in viper to the address resolved by ResolveFrom if the command
+// generates the tx only, so the generate-only txs can use the key names like the signed txs.
+func ApplyFrom(input io.Reader) error {
+	from := viper.GetString(flags.FlagFrom)
+	if from == "" || !viper.GetBool(flags.FlagGenerateOnly) {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(from); err == nil {
+		return nil
+	}
+
+	kb, err := NewKeybase(input)
+	if err != nil {
+		return err
+	}
+
+	addr, err := ResolveFrom(kb, from)
+	if err != nil {
+		return err
+	}
+
+	viper.Set(flags.FlagFrom, addr)
+	return nil
+}
+
+// AddCommand returns the command to add a watch-only account to the keyring
+func AddCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-watch [account] [pubkey]",
+		Short: "Add a watch-only account to the keyring",
+		Long: `Add a watch-only account with the bech32 public key of its auth to the keyring, no private key is stored.
+
+The watch-only account is stored as an offline key named by the account, the generate-only txs of the account
+use its auth without --from, and --from can be the key name of a watch-only account in generate-only mode.
+It is useful for the custodians preparing the txs for the offline signers.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			account, err := types.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			kb, err := NewKeybase(cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+
+			info, err := Add(kb, account, args[1])
+			if err != nil {
+				return err
+			}
+
+			out, err := keys.Bech32KeyOutput(info)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	return cmd
+}
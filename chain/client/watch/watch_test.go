@@ -0,0 +1,62 @@
+package watch_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/watch"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+func TestWatchOnlyAccount(t *testing.T) {
+	kb := keys.NewInMemory()
+	pk := secp256k1.GenPrivKeySecp256k1([]byte("watch")).PubKey()
+	bech32PubKey := sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pk)
+	auth := sdk.AccAddress(pk.Address())
+
+	alice := types.MustAccountID("alice")
+
+	info, err := watch.Add(kb, alice, bech32PubKey)
+	require.NoError(t, err)
+	require.Equal(t, keys.TypeOffline, info.GetType())
+	require.Equal(t, auth, info.GetAddress())
+
+	// the account is watched once
+	_, err = watch.Add(kb, alice, bech32PubKey)
+	require.Error(t, err)
+
+	// only the account names can be watched
+	_, err = watch.Add(kb, types.NewAccountIDFromAccAdd(auth), bech32PubKey)
+	require.Error(t, err)
+
+	_, err = watch.Add(kb, types.MustAccountID("bob"), "invalid")
+	require.Error(t, err)
+
+	got, ok := watch.Auth(kb, alice)
+	require.True(t, ok)
+	require.Equal(t, auth, got)
+
+	_, ok = watch.Auth(kb, types.MustAccountID("bob"))
+	require.False(t, ok)
+
+	// the local keys are not watch-only accounts
+	_, _, err = kb.CreateMnemonic("jack", keys.English, "12345678", keys.Secp256k1)
+	require.NoError(t, err)
+	_, ok = watch.Auth(kb, types.MustAccountID("jack"))
+	require.False(t, ok)
+
+	from, err := watch.ResolveFrom(kb, "alice")
+	require.NoError(t, err)
+	require.Equal(t, auth.String(), from)
+
+	from, err = watch.ResolveFrom(kb, auth.String())
+	require.NoError(t, err)
+	require.Equal(t, auth.String(), from)
+
+	_, err = watch.ResolveFrom(kb, "bob")
+	require.Error(t, err)
+}
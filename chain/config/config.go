@@ -3,9 +3,11 @@ package config
 import (
 	"os"
 	"path/filepath"
-	"time"
 
+	"github.com/KuChainNetwork/kuchain/chain/chaos"
 	"github.com/KuChainNetwork/kuchain/chain/constants/keys"
+	"github.com/KuChainNetwork/kuchain/chain/querycache"
+	"github.com/KuChainNetwork/kuchain/chain/sigcache"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/viper"
@@ -14,9 +16,6 @@ import (
 )
 
 const (
-	// Will Set For https://github.com/satoshilabs/slips/blob/master/slip-0044.md
-	CoinType = 23808
-
 	// BIP44Prefix is the parts of the BIP44 HD path that are fixed by
 	// what we used during the fundraiser.
 	FullFundraiserPath = "44'/118'/0'/0/0"
@@ -37,8 +36,11 @@ const (
 )
 
 var (
-	// Bech32PrefixAccAddr defines the Bech32 prefix of an account's address
-	Bech32MainPrefix = keys.ChainMainNameStr
+	// CoinType the BIP44 coin type of the keys, it can be set at build time
+	CoinType = keys.GetCoinType()
+
+	// Bech32MainPrefix defines the main Bech32 prefix, it can be set at build time
+	Bech32MainPrefix = keys.GetBech32MainPrefix()
 
 	// Bech32PrefixAccAddr defines the Bech32 prefix of an account's address
 	Bech32PrefixAccAddr = Bech32MainPrefix
@@ -95,10 +97,9 @@ func interceptLoadConfig() (conf *cfg.Config, err error) {
 		conf.P2P.RecvRate = 5120000
 		conf.P2P.SendRate = 5120000
 		conf.TxIndex.IndexAllKeys = true
-		conf.Consensus.TimeoutPropose = 1500 * time.Millisecond
-		conf.Consensus.TimeoutPrecommit = 750 * time.Millisecond
-		conf.Consensus.TimeoutPrevote = 750 * time.Millisecond
-		conf.Consensus.TimeoutCommit = 3 * time.Second
+		if err := ApplyNodeProfile(conf, ProfileDefault); err != nil {
+			panic(err)
+		}
 		cfg.WriteConfigFile(configFilePath, conf)
 		// Fall through, just so that its parsed into memory.
 	}
@@ -114,10 +115,32 @@ func interceptLoadConfig() (conf *cfg.Config, err error) {
 	if _, err := os.Stat(appConfigFilePath); os.IsNotExist(err) {
 		appConf, _ := config.ParseConfig()
 		config.WriteConfigFile(appConfigFilePath, appConf)
+		appendConfigFile(appConfigFilePath, querycache.ConfigTemplate)
+		appendConfigFile(appConfigFilePath, sigcache.ConfigTemplate)
+		appendConfigFile(appConfigFilePath, NodeProfileConfigTemplate)
+		if chaos.Enabled {
+			appendConfigFile(appConfigFilePath, chaos.ConfigTemplate)
+		}
 	}
 
 	viper.SetConfigName("app")
-	err = viper.MergeInConfig()
+	if err := viper.MergeInConfig(); err != nil {
+		return conf, err
+	}
 
-	return conf, err
+	// the preset of the consensus timeouts and the mempool sizes in app.toml overrides config.toml
+	return conf, applyNodeProfileFromViper(conf)
+}
+
+// appendConfigFile appends the config sections of kuchain to the config file created by the sdk
+func appendConfigFile(configFilePath, template string) {
+	f, err := os.OpenFile(configFilePath, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(template); err != nil {
+		panic(err)
+	}
 }
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	cfg "github.com/tendermint/tendermint/config"
+)
+
+// FlagNodeProfile the key of the node profile preset in the [node-profile] section of app.toml
+const FlagNodeProfile = "node-profile.preset"
+
+// the names of the node profile presets
+const (
+	ProfileLowLatency = "low-latency"
+	ProfileDefault    = "default"
+	ProfileWideArea   = "wide-area"
+)
+
+// NodeProfile the consensus timeouts and the mempool sizes set together, the timeouts of the rounds
+// should be consistent with the commit timeout and the network latency of the validators.
+type NodeProfile struct {
+	TimeoutPropose        time.Duration
+	TimeoutProposeDelta   time.Duration
+	TimeoutPrevote        time.Duration
+	TimeoutPrevoteDelta   time.Duration
+	TimeoutPrecommit      time.Duration
+	TimeoutPrecommitDelta time.Duration
+	TimeoutCommit         time.Duration
+
+	MempoolSize      int
+	MempoolCacheSize int
+}
+
+// NodeProfiles the presets of the node profiles, low-latency for the validators in the same region,
+// such as the test networks, default for the main network, which is the values of the config.toml
+// created by the node, and wide-area for the validators across the continents, with larger mempools
+// for the slower blocks.
+var NodeProfiles = map[string]NodeProfile{
+	ProfileLowLatency: {
+		TimeoutPropose:        1000 * time.Millisecond,
+		TimeoutProposeDelta:   200 * time.Millisecond,
+		TimeoutPrevote:        500 * time.Millisecond,
+		TimeoutPrevoteDelta:   200 * time.Millisecond,
+		TimeoutPrecommit:      500 * time.Millisecond,
+		TimeoutPrecommitDelta: 200 * time.Millisecond,
+		TimeoutCommit:         1 * time.Second,
+		MempoolSize:           5000,
+		MempoolCacheSize:      10000,
+	},
+	ProfileDefault: {
+		TimeoutPropose:        1500 * time.Millisecond,
+		TimeoutProposeDelta:   500 * time.Millisecond,
+		TimeoutPrevote:        750 * time.Millisecond,
+		TimeoutPrevoteDelta:   500 * time.Millisecond,
+		TimeoutPrecommit:      750 * time.Millisecond,
+		TimeoutPrecommitDelta: 500 * time.Millisecond,
+		TimeoutCommit:         3 * time.Second,
+		MempoolSize:           5000,
+		MempoolCacheSize:      10000,
+	},
+	ProfileWideArea: {
+		TimeoutPropose:        3 * time.Second,
+		TimeoutProposeDelta:   1 * time.Second,
+		TimeoutPrevote:        1500 * time.Millisecond,
+		TimeoutPrevoteDelta:   1 * time.Second,
+		TimeoutPrecommit:      1500 * time.Millisecond,
+		TimeoutPrecommitDelta: 1 * time.Second,
+		TimeoutCommit:         5 * time.Second,
+		MempoolSize:           10000,
+		MempoolCacheSize:      20000,
+	},
+}
+
+// ApplyNodeProfile sets the consensus timeouts and the mempool sizes of the preset to the config,
+// the config is not changed if the name is empty.
+func ApplyNodeProfile(conf *cfg.Config, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	profile, ok := NodeProfiles[name]
+	if !ok {
+		return fmt.Errorf("unknown node profile %s, should be one of %s", name, strings.Join(nodeProfileNames(), ", "))
+	}
+
+	conf.Consensus.TimeoutPropose = profile.TimeoutPropose
+	conf.Consensus.TimeoutProposeDelta = profile.TimeoutProposeDelta
+	conf.Consensus.TimeoutPrevote = profile.TimeoutPrevote
+	conf.Consensus.TimeoutPrevoteDelta = profile.TimeoutPrevoteDelta
+	conf.Consensus.TimeoutPrecommit = profile.TimeoutPrecommit
+	conf.Consensus.TimeoutPrecommitDelta = profile.TimeoutPrecommitDelta
+	conf.Consensus.TimeoutCommit = profile.TimeoutCommit
+	conf.Mempool.Size = profile.MempoolSize
+	conf.Mempool.CacheSize = profile.MempoolCacheSize
+
+	return nil
+}
+
+// applyNodeProfileFromViper applies the preset in app.toml, which overrides the values in config.toml
+func applyNodeProfileFromViper(conf *cfg.Config) error {
+	return ApplyNodeProfile(conf, viper.GetString(FlagNodeProfile))
+}
+
+func nodeProfileNames() []string {
+	res := make([]string, 0, len(NodeProfiles))
+	for name := range NodeProfiles {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// NodeProfileConfigTemplate the node profile section appended to the app.toml created
+const NodeProfileConfigTemplate = `
+###############################################################################
+###                         Node Profile Configuration                      ###
+###############################################################################
+
+[node-profile]
+
+# The preset of the consensus timeouts and the mempool sizes, which overrides the values in config.toml:
+#  - "low-latency" for the validators in the same region, such as the test networks
+#  - "default" for the main network
+#  - "wide-area" for the validators across the continents
+# Leave it empty to use the values in config.toml.
+preset = ""
+`
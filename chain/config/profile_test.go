@@ -0,0 +1,37 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	cfg "github.com/tendermint/tendermint/config"
+)
+
+func TestApplyNodeProfile(t *testing.T) {
+	conf := cfg.DefaultConfig()
+	origin := *conf.Consensus
+
+	// the config not changed if no preset
+	require.NoError(t, ApplyNodeProfile(conf, ""))
+	require.Equal(t, origin, *conf.Consensus)
+
+	require.NoError(t, ApplyNodeProfile(conf, ProfileWideArea))
+	require.Equal(t, 5*time.Second, conf.Consensus.TimeoutCommit)
+	require.Equal(t, 3*time.Second, conf.Consensus.TimeoutPropose)
+	require.Equal(t, 10000, conf.Mempool.Size)
+
+	require.NoError(t, ApplyNodeProfile(conf, ProfileDefault))
+	require.Equal(t, 3*time.Second, conf.Consensus.TimeoutCommit)
+	require.Equal(t, 1500*time.Millisecond, conf.Consensus.TimeoutPropose)
+	require.Equal(t, 5000, conf.Mempool.Size)
+
+	require.Error(t, ApplyNodeProfile(conf, "fast"))
+
+	// the presets should be valid configs of tendermint
+	for name := range NodeProfiles {
+		conf := cfg.DefaultConfig()
+		require.NoError(t, ApplyNodeProfile(conf, name))
+		require.NoError(t, conf.ValidateBasic(), name)
+	}
+}
@@ -1,8 +1,52 @@
 package keys
 
+import (
+	"strconv"
+)
+
+// The branding of the chain, all of them are string vars so that the forks can rebrand
+// at build time by ldflags, such as `-X .../chain/constants/keys.ChainMainNameStr=mychain`,
+// see the ldflags in Makefile.
+var (
+	// ChainNameStr the name of the core coin symbol, also the suffix of the system accounts
+	ChainNameStr = "sys"
+
+	// ChainMainNameStr the main name of the chain, which is the creator of the core coin
+	ChainMainNameStr = "kuchain"
+
+	// Bech32MainPrefix the bech32 prefix of the addresses, the chain main name is used if empty
+	Bech32MainPrefix = ""
+
+	// CoinTypeStr the BIP44 coin type of the keys, see https://github.com/satoshilabs/slips/blob/master/slip-0044.md
+	CoinTypeStr = "23808"
+
+	// DaemonName the name of the node binary, also for its default home
+	DaemonName = "kucd"
+
+	// CLIName the name of the cli binary, also for its default home
+	CLIName = "kucli"
+)
+
 var (
-	ChainNameStr      = "sys"
-	ChainMainNameStr  = "kuchain"
 	DefaultBondSymbol = ChainNameStr
 	DefaultBondDenom  = ChainMainNameStr + "/" + DefaultBondSymbol
 )
+
+// GetBech32MainPrefix returns the bech32 prefix of the addresses
+func GetBech32MainPrefix() string {
+	if Bech32MainPrefix == "" {
+		return ChainMainNameStr
+	}
+
+	return Bech32MainPrefix
+}
+
+// GetCoinType returns the BIP44 coin type of the keys
+func GetCoinType() uint32 {
+	coinType, err := strconv.ParseUint(CoinTypeStr, 10, 32)
+	if err != nil {
+		panic(err)
+	}
+
+	return uint32(coinType)
+}
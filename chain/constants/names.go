@@ -12,6 +12,9 @@ var (
 	ChainMainNameStr  = keys.ChainMainNameStr
 	DefaultBondDenom  = keys.DefaultBondDenom
 	DefaultBondSymbol = keys.DefaultBondSymbol
+
+	DaemonName = keys.DaemonName
+	CLIName    = keys.CLIName
 )
 
 var (
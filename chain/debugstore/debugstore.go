@@ -0,0 +1,104 @@
+package debugstore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultLimit the default number of the pairs in a page
+	DefaultLimit = 100
+	// MaxLimit the max number of the pairs in a page
+	MaxLimit = 1000
+)
+
+// Source the state read by the debug store server, implemented by the app
+type Source interface {
+	// StateStoreNames returns the names of the kv stores
+	StateStoreNames() []string
+
+	// IterateCommittedStore iterates the pairs in [start, end) of the store at the last committed height,
+	// until the callback returns true, returns the height iterated.
+	IterateCommittedStore(storeName string, start, end []byte, cb func(key, value []byte) (stop bool)) (int64, error)
+
+	// DecodeStoreValue decodes the value by the codec of the module of the store, false if not decoded
+	DecodeStoreValue(storeName string, key, value []byte) (string, bool)
+}
+
+// Pair a kv pair in the store, the key and the value in hex
+type Pair struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Decoded string `json:"decoded,omitempty"`
+}
+
+// Page a page of the pairs with a prefix in the store
+type Page struct {
+	Store   string `json:"store"`
+	Height  int64  `json:"height"`
+	Pairs   []Pair `json:"pairs"`
+	NextKey string `json:"next_key,omitempty"` // the start key of the next page in hex, empty if no more pairs
+}
+
+// QueryPage returns at most limit pairs with the prefix not less than the start key in the store,
+// the start key is the next key of the last page, or empty for the first page.
+func QueryPage(source Source, storeName string, prefix, start []byte, limit int) (Page, error) {
+	if !hasStore(source, storeName) {
+		return Page{}, fmt.Errorf("store %s not found", storeName)
+	}
+
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		return Page{}, fmt.Errorf("limit should not be more than %d", MaxLimit)
+	}
+
+	if len(start) > 0 && !bytes.HasPrefix(start, prefix) {
+		return Page{}, fmt.Errorf("start key %X not has the prefix %X", start, prefix)
+	}
+	if len(start) == 0 {
+		start = prefix
+	}
+
+	page := Page{
+		Store: storeName,
+		Pairs: make([]Pair, 0, limit),
+	}
+
+	height, err := source.IterateCommittedStore(storeName, start, sdk.PrefixEndBytes(prefix), func(key, value []byte) bool {
+		if len(page.Pairs) >= limit {
+			page.NextKey = hex.EncodeToString(key)
+			return true
+		}
+
+		pair := Pair{
+			Key:   hex.EncodeToString(key),
+			Value: hex.EncodeToString(value),
+		}
+		if decoded, ok := source.DecodeStoreValue(storeName, key, value); ok {
+			pair.Decoded = decoded
+		}
+
+		page.Pairs = append(page.Pairs, pair)
+		return false
+	})
+	if err != nil {
+		return Page{}, err
+	}
+
+	page.Height = height
+	return page, nil
+}
+
+func hasStore(source Source, storeName string) bool {
+	for _, name := range source.StateStoreNames() {
+		if name == storeName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package debugstore
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type testSource struct {
+	store dbadapter.Store
+}
+
+func newTestSource() *testSource {
+	s := &testSource{store: dbadapter.Store{DB: dbm.NewMemDB()}}
+	for i := 0; i < 25; i++ {
+		s.store.Set([]byte(fmt.Sprintf("a/%02d", i)), []byte(fmt.Sprintf("value-%02d", i)))
+	}
+	s.store.Set([]byte("b/00"), []byte("other"))
+
+	return s
+}
+
+func (s *testSource) StateStoreNames() []string {
+	return []string{"test"}
+}
+
+func (s *testSource) IterateCommittedStore(_ string, start, end []byte, cb func(key, value []byte) bool) (int64, error) {
+	iter := s.store.Iterator(start, end)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key(), iter.Value()) {
+			break
+		}
+	}
+
+	return 10, nil
+}
+
+func (s *testSource) DecodeStoreValue(_ string, _, value []byte) (string, bool) {
+	return string(value), true
+}
+
+func TestQueryPage(t *testing.T) {
+	source := newTestSource()
+	prefix := []byte("a/")
+
+	var (
+		pairs []Pair
+		start []byte
+		pages int
+	)
+	for {
+		page, err := QueryPage(source, "test", prefix, start, 10)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), page.Height)
+
+		pairs = append(pairs, page.Pairs...)
+		pages++
+		if page.NextKey == "" {
+			break
+		}
+
+		start, err = hex.DecodeString(page.NextKey)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 3, pages)
+	require.Len(t, pairs, 25)
+	require.Equal(t, hex.EncodeToString([]byte("a/24")), pairs[24].Key)
+	require.Equal(t, "value-24", pairs[24].Decoded)
+
+	_, err := QueryPage(source, "unknown", prefix, nil, 10)
+	require.Error(t, err)
+
+	_, err = QueryPage(source, "test", prefix, []byte("b/00"), 10)
+	require.Error(t, err)
+
+	_, err = QueryPage(source, "test", prefix, nil, MaxLimit+1)
+	require.Error(t, err)
+}
+
+func TestHandler(t *testing.T) {
+	server := httptest.NewServer(NewHandler(newTestSource()))
+	defer server.Close()
+
+	res, err := http.Get(server.URL + PathPrefix + "test?prefix=" + hex.EncodeToString([]byte("b/")))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var page Page
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&page))
+	require.Len(t, page.Pairs, 1)
+	require.Equal(t, "other", page.Pairs[0].Decoded)
+
+	res, err = http.Get(server.URL + PathPrefix + "test?prefix=xx")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestValidateAddress(t *testing.T) {
+	require.NoError(t, ValidateAddress("localhost:26659"))
+	require.NoError(t, ValidateAddress("127.0.0.1:26659"))
+	require.NoError(t, ValidateAddress("[::1]:26659"))
+	require.Error(t, ValidateAddress("0.0.0.0:26659"))
+	require.Error(t, ValidateAddress("10.0.0.1:26659"))
+	require.Error(t, ValidateAddress("localhost"))
+}
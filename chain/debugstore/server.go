@@ -0,0 +1,140 @@
+package debugstore
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// PathPrefix the path of the store queries, as /store/<name>?prefix=<hex>&start=<hex>&limit=<n>
+const PathPrefix = "/store/"
+
+// ValidateAddress returns error if the address is not a loopback address, the raw state
+// should not be exposed out of the host of the node.
+func ValidateAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return errors.Wrapf(err, "invalid address %s", address)
+	}
+
+	if host == "localhost" {
+		return nil
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return errors.Errorf("address %s should be a loopback address, such as localhost:26659", address)
+	}
+
+	return nil
+}
+
+// Server the http server of the debug store queries, only listening on the loopback address
+type Server struct {
+	server   *http.Server
+	listener net.Listener
+	logger   log.Logger
+}
+
+// NewServer creates the server listening on the loopback address
+func NewServer(address string, source Source, logger log.Logger) (*Server, error) {
+	if err := ValidateAddress(address); err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "listen debug store on %s", address)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(PathPrefix, NewHandler(source))
+
+	return &Server{
+		server:   &http.Server{Handler: mux},
+		listener: listener,
+		logger:   logger,
+	}, nil
+}
+
+// Addr returns the address the server listening on
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Start serves the queries in background until stopped
+func (s *Server) Start() {
+	s.logger.Info("starting debug store server", "address", s.listener.Addr().String())
+
+	go func() {
+		if err := s.server.Serve(s.listener); err != nil && err != http.ErrServerClosed {
+			s.logger.Error("debug store server stopped", "err", err)
+		}
+	}()
+}
+
+// Stop stops the server
+func (s *Server) Stop() {
+	s.server.Close()
+}
+
+// NewHandler returns the http handler of the store queries of the source
+func NewHandler(source Source) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, errors.New("only GET allowed"))
+			return
+		}
+
+		storeName := strings.TrimPrefix(r.URL.Path, PathPrefix)
+		if storeName == "" {
+			writeJSON(w, http.StatusOK, source.StateStoreNames())
+			return
+		}
+
+		query := r.URL.Query()
+
+		prefix, err := hex.DecodeString(query.Get("prefix"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, errors.Wrap(err, "invalid prefix"))
+			return
+		}
+
+		start, err := hex.DecodeString(query.Get("start"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, errors.Wrap(err, "invalid start key"))
+			return
+		}
+
+		limit := 0
+		if l := query.Get("limit"); l != "" {
+			if limit, err = strconv.Atoi(l); err != nil {
+				writeError(w, http.StatusBadRequest, errors.Wrap(err, "invalid limit"))
+				return
+			}
+		}
+
+		page, err := QueryPage(source, storeName, prefix, start, limit)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, page)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
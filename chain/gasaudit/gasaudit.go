@@ -0,0 +1,193 @@
+// Package gasaudit records the gas consumed by the handlers of the msgs per msg type, the reports of
+// two versions replaying the same txs should be equal, or the gas metering is changed which breaks the consensus.
+package gasaudit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"sync"
+)
+
+// GasCount the number of the msgs consumed the gas
+type GasCount struct {
+	Gas   uint64 `json:"gas"`
+	Count uint64 `json:"count"`
+}
+
+// MsgGas the distribution of the gas consumed by the msgs of a type
+type MsgGas struct {
+	Type         string     `json:"type"`
+	Count        uint64     `json:"count"`
+	Min          uint64     `json:"min"`
+	Max          uint64     `json:"max"`
+	Mean         uint64     `json:"mean"`
+	P50          uint64     `json:"p50"`
+	P90          uint64     `json:"p90"`
+	P99          uint64     `json:"p99"`
+	Distribution []GasCount `json:"distribution"`
+}
+
+// Report the gas distributions of the msg types, sorted by the type
+type Report struct {
+	Msgs []MsgGas `json:"msgs"`
+}
+
+// Recorder records the gas consumed by the msgs, safe for concurrent use
+type Recorder struct {
+	mtx  sync.Mutex
+	msgs map[string]map[uint64]uint64 // msg type -> gas -> count
+}
+
+// NewRecorder creates an empty recorder
+func NewRecorder() *Recorder {
+	return &Recorder{
+		msgs: make(map[string]map[uint64]uint64),
+	}
+}
+
+// Record records a msg of the type consumed the gas
+func (r *Recorder) Record(msgType string, gas uint64) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	counts, ok := r.msgs[msgType]
+	if !ok {
+		counts = make(map[uint64]uint64)
+		r.msgs[msgType] = counts
+	}
+	counts[gas]++
+}
+
+// Report returns the report of the msgs recorded
+func (r *Recorder) Report() Report {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	res := Report{Msgs: make([]MsgGas, 0, len(r.msgs))}
+	for msgType, counts := range r.msgs {
+		res.Msgs = append(res.Msgs, newMsgGas(msgType, counts))
+	}
+
+	sort.Slice(res.Msgs, func(i, j int) bool {
+		return res.Msgs[i].Type < res.Msgs[j].Type
+	})
+
+	return res
+}
+
+// WriteFile writes the report of the msgs recorded to the file in json
+func (r *Recorder) WriteFile(path string) error {
+	bz, err := json.MarshalIndent(r.Report(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, bz, 0644)
+}
+
+func newMsgGas(msgType string, counts map[uint64]uint64) MsgGas {
+	res := MsgGas{
+		Type:         msgType,
+		Distribution: make([]GasCount, 0, len(counts)),
+	}
+
+	var total uint64
+	for gas, count := range counts {
+		res.Distribution = append(res.Distribution, GasCount{Gas: gas, Count: count})
+		res.Count += count
+		total += gas * count
+	}
+
+	sort.Slice(res.Distribution, func(i, j int) bool {
+		return res.Distribution[i].Gas < res.Distribution[j].Gas
+	})
+
+	res.Min = res.Distribution[0].Gas
+	res.Max = res.Distribution[len(res.Distribution)-1].Gas
+	res.Mean = total / res.Count
+	res.P50 = res.percentile(50)
+	res.P90 = res.percentile(90)
+	res.P99 = res.percentile(99)
+
+	return res
+}
+
+// percentile returns the least gas which not less than p percent of the msgs consumed
+func (m MsgGas) percentile(p uint64) uint64 {
+	rank := (m.Count*p + 99) / 100
+
+	var n uint64
+	for _, c := range m.Distribution {
+		n += c.Count
+		if n >= rank {
+			return c.Gas
+		}
+	}
+
+	return m.Max
+}
+
+// ReadReport reads the report from the json file
+func ReadReport(path string) (Report, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Report{}, err
+	}
+
+	var res Report
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return Report{}, fmt.Errorf("unmarshal gas report %s: %v", path, err)
+	}
+
+	return res, nil
+}
+
+// Diff a difference of the gas of a msg type between two reports
+type Diff struct {
+	Type  string
+	Field string
+	Base  uint64
+	Head  uint64
+}
+
+func (d Diff) String() string {
+	return fmt.Sprintf("%s: %s changed from %d to %d", d.Type, d.Field, d.Base, d.Head)
+}
+
+// Compare returns the differences of the gas of the msg types recorded by both reports, the types
+// only in one report are ignored, as they are not in the workload of the other one. The reports should
+// be recorded by the same txs, so the distributions are compared by the distinct gas values consumed.
+func Compare(base, head Report) []Diff {
+	heads := make(map[string]MsgGas, len(head.Msgs))
+	for _, m := range head.Msgs {
+		heads[m.Type] = m
+	}
+
+	var res []Diff
+	for _, b := range base.Msgs {
+		h, ok := heads[b.Type]
+		if !ok {
+			continue
+		}
+
+		fields := []struct {
+			name       string
+			base, head uint64
+		}{
+			{"min", b.Min, h.Min},
+			{"p50", b.P50, h.P50},
+			{"p90", b.P90, h.P90},
+			{"p99", b.P99, h.P99},
+			{"max", b.Max, h.Max},
+		}
+		for _, f := range fields {
+			if f.base != f.head {
+				res = append(res, Diff{Type: b.Type, Field: f.name, Base: f.base, Head: f.head})
+			}
+		}
+	}
+
+	return res
+}
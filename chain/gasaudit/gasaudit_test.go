@@ -0,0 +1,59 @@
+package gasaudit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorderReport(t *testing.T) {
+	r := NewRecorder()
+	for i := uint64(1); i <= 100; i++ {
+		r.Record("asset/transfer", 1000+i%2*100)
+	}
+	r.Record("account/create", 5000)
+
+	report := r.Report()
+	require.Len(t, report.Msgs, 2)
+	require.Equal(t, "account/create", report.Msgs[0].Type)
+
+	transfer := report.Msgs[1]
+	require.Equal(t, uint64(100), transfer.Count)
+	require.Equal(t, uint64(1000), transfer.Min)
+	require.Equal(t, uint64(1100), transfer.Max)
+	require.Equal(t, uint64(1050), transfer.Mean)
+	require.Equal(t, uint64(1000), transfer.P50)
+	require.Equal(t, uint64(1100), transfer.P90)
+	require.Equal(t, []GasCount{{1000, 50}, {1100, 50}}, transfer.Distribution)
+
+	dir, err := ioutil.TempDir("", "gas-audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "gas.json")
+	require.NoError(t, r.WriteFile(path))
+
+	read, err := ReadReport(path)
+	require.NoError(t, err)
+	require.Equal(t, report, read)
+}
+
+func TestCompare(t *testing.T) {
+	base := NewRecorder()
+	base.Record("asset/transfer", 1000)
+	base.Record("account/create", 5000)
+
+	head := NewRecorder()
+	head.Record("asset/transfer", 1000)
+	head.Record("account/create", 5100)
+	head.Record("account/new", 3000)
+
+	require.Empty(t, Compare(base.Report(), base.Report()))
+
+	diffs := Compare(base.Report(), head.Report())
+	require.Len(t, diffs, 5)
+	require.Equal(t, Diff{Type: "account/create", Field: "min", Base: 5000, Head: 5100}, diffs[0])
+}
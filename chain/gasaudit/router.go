@@ -0,0 +1,65 @@
+package gasaudit
+
+import (
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Router wraps the msg router of app, the gas consumed by the handlers of the msgs
+// delivered successfully is recorded, the msgs simulated or checked are ignored.
+type Router struct {
+	sdk.Router
+
+	recorder *Recorder
+}
+
+var _ sdk.Router = Router{}
+
+// NewRouter creates a router recording the gas to the recorder
+func NewRouter(router sdk.Router, recorder *Recorder) Router {
+	return Router{
+		Router:   router,
+		recorder: recorder,
+	}
+}
+
+// SetRecorder returns the option of the base app to record the gas of the msgs,
+// should be set before the routes added.
+func SetRecorder(recorder *Recorder) func(*bam.BaseApp) {
+	return func(app *bam.BaseApp) {
+		app.SetRouter(NewRouter(app.Router(), recorder))
+	}
+}
+
+// AddRoute adds a route path to the wrapped router
+func (r Router) AddRoute(path string, h sdk.Handler) sdk.Router {
+	r.Router.AddRoute(path, h)
+	return r
+}
+
+// Route returns the handler for the route path, which records the gas consumed by the handler
+func (r Router) Route(ctx sdk.Context, path string) sdk.Handler {
+	h := r.Router.Route(ctx, path)
+	if h == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if ctx.IsCheckTx() {
+			return h(ctx, msg)
+		}
+
+		before := ctx.GasMeter().GasConsumed()
+		res, err := h(ctx, msg)
+		if err == nil {
+			r.recorder.Record(MsgType(msg), ctx.GasMeter().GasConsumed()-before)
+		}
+
+		return res, err
+	}
+}
+
+// MsgType returns the type of the msg in the report, as <route>/<type>
+func MsgType(msg sdk.Msg) string {
+	return msg.Route() + "/" + msg.Type()
+}
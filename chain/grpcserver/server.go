@@ -0,0 +1,75 @@
+package grpcserver
+
+import (
+	"net"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+)
+
+// ABCIQuerier queries the app by the abci query, the queries are served at the last committed height
+// if no height in the request.
+type ABCIQuerier func(req abci.RequestQuery) (abci.ResponseQuery, error)
+
+// Module the module serving the gRPC services, implemented by the AppModuleBasic of the modules
+type Module interface {
+	// RegisterGRPCServices registers the gRPC services of the module, which query the state by the querier
+	RegisterGRPCServices(server *grpc.Server, querier ABCIQuerier)
+}
+
+// RegisterServices registers the gRPC services of all the modules which implement Module
+func RegisterServices(basics module.BasicManager, server *grpc.Server, querier ABCIQuerier) {
+	for _, b := range basics {
+		if m, ok := b.(Module); ok {
+			m.RegisterGRPCServices(server, querier)
+		}
+	}
+}
+
+// Server the gRPC server of the node
+type Server struct {
+	server   *grpc.Server
+	listener net.Listener
+	logger   log.Logger
+}
+
+// NewServer creates the gRPC server listening on the address, the services are registered by the register func.
+func NewServer(address string, logger log.Logger, register func(server *grpc.Server)) (*Server, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "listen gRPC on %s", address)
+	}
+
+	server := grpc.NewServer()
+	register(server)
+
+	return &Server{
+		server:   server,
+		listener: listener,
+		logger:   logger,
+	}, nil
+}
+
+// Addr returns the address the server listening on
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Start serves the gRPC requests in background until stopped
+func (s *Server) Start() {
+	s.logger.Info("starting gRPC server", "address", s.listener.Addr().String())
+
+	go func() {
+		if err := s.server.Serve(s.listener); err != nil {
+			s.logger.Error("gRPC server stopped", "err", err)
+		}
+	}()
+}
+
+// Stop stops the server, the pending requests are finished before stopped
+func (s *Server) Stop() {
+	s.server.GracefulStop()
+}
@@ -0,0 +1,158 @@
+package querycache
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+type entry struct {
+	value  []byte
+	expire time.Time
+}
+
+// Cache caches the results of the expensive custom queries, such as the proposals and the validators,
+// to protect the nodes serving the public explorers from the repeated heavy scans of the stores.
+// The results are cached by the query path, data and height for the TTL, and all the results
+// are dropped when a block is committed.
+type Cache struct {
+	mtx     sync.Mutex
+	config  Config
+	routes  map[string]bool
+	entries map[string]entry
+
+	now func() time.Time
+}
+
+// NewCache creates a cache by the config
+func NewCache(config Config) *Cache {
+	c := &Cache{now: time.Now}
+	c.SetConfig(config)
+	return c
+}
+
+// SetConfig sets the config of the cache, the cached results are dropped
+func (c *Cache) SetConfig(config Config) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.config = config
+	c.routes = make(map[string]bool, len(config.Routes))
+	for _, route := range config.Routes {
+		c.routes[strings.Trim(route, "/")] = true
+	}
+	c.entries = make(map[string]entry)
+}
+
+// Reset drops all the cached results, it is called when a block is committed
+func (c *Cache) Reset() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if len(c.entries) > 0 {
+		c.entries = make(map[string]entry)
+	}
+}
+
+// Len returns the number of the cached results
+func (c *Cache) Len() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return len(c.entries)
+}
+
+// Wrap wraps the querier of the route, the queries of the paths in the config are cached
+func (c *Cache) Wrap(route string, querier sdk.Querier) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		key, ok := c.key(route, path, req)
+		if !ok {
+			return querier(ctx, path, req)
+		}
+
+		if value, ok := c.get(key); ok {
+			return value, nil
+		}
+
+		value, err := querier(ctx, path, req)
+		if err == nil {
+			c.set(key, value)
+		}
+
+		return value, err
+	}
+}
+
+// key returns the key of the query, returns false if the query should not be cached
+func (c *Cache) key(route string, path []string, req abci.RequestQuery) (string, bool) {
+	if len(path) == 0 {
+		return "", false
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if !c.config.Enable || !c.routes[route+"/"+path[0]] {
+		return "", false
+	}
+
+	return fmt.Sprintf("%s/%s@%d:%s", route, strings.Join(path, "/"), req.Height, hex.EncodeToString(req.Data)), true
+}
+
+func (c *Cache) get(key string) ([]byte, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || !c.now().Before(e.expire) {
+		return nil, false
+	}
+
+	return e.value, true
+}
+
+func (c *Cache) set(key string, value []byte) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	now := c.now()
+	if c.config.MaxEntries > 0 && len(c.entries) >= c.config.MaxEntries {
+		for k, e := range c.entries {
+			if !now.Before(e.expire) {
+				delete(c.entries, k)
+			}
+		}
+
+		// the results are not cached if full, the cache is dropped in the next block
+		if len(c.entries) >= c.config.MaxEntries {
+			return
+		}
+	}
+
+	c.entries[key] = entry{value: value, expire: now.Add(c.config.TTL)}
+}
+
+// Router wraps the query router to cache the queriers added
+type Router struct {
+	sdk.QueryRouter
+	cache *Cache
+}
+
+// NewRouter creates a router adding the queriers wrapped by the cache to the query router
+func NewRouter(router sdk.QueryRouter, cache *Cache) Router {
+	return Router{
+		QueryRouter: router,
+		cache:       cache,
+	}
+}
+
+// AddRoute adds the querier wrapped by the cache
+func (r Router) AddRoute(route string, querier sdk.Querier) sdk.QueryRouter {
+	r.QueryRouter.AddRoute(route, r.cache.Wrap(route, querier))
+	return r
+}
@@ -0,0 +1,63 @@
+package querycache
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Now()
+	cache := NewCache(Config{Enable: true, TTL: time.Second, MaxEntries: 2, Routes: []string{"kugov/proposals"}})
+	cache.now = func() time.Time { return now }
+
+	calls := 0
+	querier := cache.Wrap("kugov", func(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		calls++
+		return req.Data, nil
+	})
+
+	query := func(path string, data string, height int64) {
+		res, err := querier(sdk.Context{}, []string{path}, abci.RequestQuery{Data: []byte(data), Height: height})
+		require.NoError(t, err)
+		require.Equal(t, data, string(res))
+	}
+
+	query("proposals", "a", 10)
+	query("proposals", "a", 10)
+	require.Equal(t, 1, calls)
+
+	// the queries of other paths, data or heights are not the same
+	query("proposal", "a", 10)
+	query("proposal", "a", 10)
+	require.Equal(t, 3, calls)
+	query("proposals", "b", 10)
+	query("proposals", "a", 11)
+	require.Equal(t, 5, calls)
+
+	// the results are not cached if full
+	require.Equal(t, 2, cache.Len())
+	query("proposals", "c", 10)
+	query("proposals", "c", 10)
+	require.Equal(t, 7, calls)
+
+	// the results expired
+	now = now.Add(time.Second)
+	query("proposals", "a", 10)
+	require.Equal(t, 8, calls)
+
+	// the results are dropped per block
+	cache.Reset()
+	require.Equal(t, 0, cache.Len())
+	query("proposals", "a", 10)
+	require.Equal(t, 9, calls)
+
+	// the cache is disabled
+	cache.SetConfig(DefaultConfig())
+	query("proposals", "a", 10)
+	query("proposals", "a", 10)
+	require.Equal(t, 11, calls)
+}
@@ -0,0 +1,84 @@
+package querycache
+
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// The keys of the query cache config in the [query-cache] section of app.toml
+const (
+	FlagEnable     = "query-cache.enable"
+	FlagTTL        = "query-cache.ttl"
+	FlagMaxEntries = "query-cache.max-entries"
+	FlagRoutes     = "query-cache.routes"
+)
+
+// DefaultRoutes the expensive queries cached by default, as the route and the first path of the querier
+var DefaultRoutes = []string{
+	"kugov/proposals",
+	"kustaking/validators",
+	"kustaking/validatorDelegations",
+	"supply/total_supply",
+}
+
+// Config the config of the query cache
+type Config struct {
+	Enable     bool          // Enable enables the cache
+	TTL        time.Duration // TTL the max duration to cache a result, the results are also dropped per block
+	MaxEntries int           // MaxEntries the max number of the cached results, no limit if 0
+	Routes     []string      // Routes the queries cached, as "route/path", such as "kugov/proposals"
+}
+
+// DefaultConfig returns the default config, the cache is disabled by default
+func DefaultConfig() Config {
+	return Config{
+		Enable:     false,
+		TTL:        5 * time.Second,
+		MaxEntries: 1024,
+		Routes:     DefaultRoutes,
+	}
+}
+
+// ReadConfig reads the config from viper, which has the app.toml merged in
+func ReadConfig() Config {
+	config := DefaultConfig()
+
+	if viper.IsSet(FlagEnable) {
+		config.Enable = viper.GetBool(FlagEnable)
+	}
+	if viper.IsSet(FlagTTL) {
+		config.TTL = viper.GetDuration(FlagTTL)
+	}
+	if viper.IsSet(FlagMaxEntries) {
+		config.MaxEntries = viper.GetInt(FlagMaxEntries)
+	}
+	if viper.IsSet(FlagRoutes) {
+		config.Routes = viper.GetStringSlice(FlagRoutes)
+	}
+
+	return config
+}
+
+// ConfigTemplate the query cache section appended to the app.toml created
+const ConfigTemplate = `
+###############################################################################
+###                           Query Cache Configuration                     ###
+###############################################################################
+
+[query-cache]
+
+# Cache the results of the expensive queries, such as the proposals, the validators and the supply,
+# to protect the nodes serving the public explorers from the repeated heavy scans.
+# The cached results are dropped when a block is committed.
+enable = false
+
+# The max duration to cache a result
+ttl = "5s"
+
+# The max number of the cached results, 0 for no limit
+max-entries = 1024
+
+# The queries cached, as "route/path" of the custom queries
+routes = ["kugov/proposals", "kustaking/validators", "kustaking/validatorDelegations", "supply/total_supply"]
+`
@@ -0,0 +1,21 @@
+package sdkcompat
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the msgs by the names of cosmos-sdk, so the txs built by the generic wallets can be decoded
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgSend{}, "cosmos-sdk/MsgSend", nil)
+	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
+	cdc.RegisterConcrete(MsgDelegate{}, "cosmos-sdk/MsgDelegate", nil)
+}
+
+// ModuleCdc the codec for the sign bytes of the msgs
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,245 @@
+package sdkcompat
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Msg the standard msg of cosmos-sdk accepted in the txs, it is translated to the equivalent KuMsg
+// when routed. The route and type of the msg are the ones of the KuMsg, so the ante handlers check
+// the msg as the KuMsg, such as the maintenance mode and the scopes of the sub accounts.
+type Msg interface {
+	sdk.Msg
+
+	// KuMsg returns the KuMsg the msg translated to
+	KuMsg() sdk.Msg
+}
+
+var (
+	_ Msg = MsgSend{}
+	_ Msg = MsgVote{}
+	_ Msg = MsgDelegate{}
+)
+
+// toCoins converts the coins of cosmos-sdk, the denoms are validated by the KuMsg
+func toCoins(coins sdk.Coins) types.Coins {
+	res := make(types.Coins, 0, len(coins))
+	for _, c := range coins {
+		res = append(res, toCoin(c))
+	}
+	return res
+}
+
+func toCoin(c sdk.Coin) types.Coin {
+	return types.Coin{Denom: c.Denom, Amount: c.Amount}
+}
+
+// MsgSend the bank send msg of cosmos-sdk, translated to the asset transfer between the address accounts
+type MsgSend struct {
+	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgSend creates a bank send msg
+func NewMsgSend(from, to sdk.AccAddress, amount sdk.Coins) MsgSend {
+	return MsgSend{FromAddress: from, ToAddress: to, Amount: amount}
+}
+
+// KuMsg implements Msg
+func (msg MsgSend) KuMsg() sdk.Msg {
+	res := assetTypes.NewMsgTransfer(msg.FromAddress,
+		types.NewAccountIDFromAccAdd(msg.FromAddress), types.NewAccountIDFromAccAdd(msg.ToAddress), toCoins(msg.Amount))
+	return &res
+}
+
+// Route implements sdk.Msg
+func (msg MsgSend) Route() string { return msg.KuMsg().Route() }
+
+// Type implements sdk.Msg
+func (msg MsgSend) Type() string { return msg.KuMsg().Type() }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSend) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if msg.ToAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+
+	amount := toCoins(msg.Amount)
+	if !amount.IsValid() || !amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return msg.KuMsg().ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// VoteOption the vote option of cosmos-sdk, encoded as a byte
+type VoteOption byte
+
+// the vote options of cosmos-sdk, the same values as the ones of KuChain
+const (
+	OptionEmpty      VoteOption = VoteOption(govTypes.OptionEmpty)
+	OptionYes        VoteOption = VoteOption(govTypes.OptionYes)
+	OptionAbstain    VoteOption = VoteOption(govTypes.OptionAbstain)
+	OptionNo         VoteOption = VoteOption(govTypes.OptionNo)
+	OptionNoWithVeto VoteOption = VoteOption(govTypes.OptionNoWithVeto)
+)
+
+// String implements the Stringer interface
+func (vo VoteOption) String() string {
+	return govTypes.VoteOption(vo).String()
+}
+
+// MarshalJSON encodes the option as the name, the same as cosmos-sdk
+func (vo VoteOption) MarshalJSON() ([]byte, error) {
+	return json.Marshal(vo.String())
+}
+
+// UnmarshalJSON decodes the option from the name
+func (vo *VoteOption) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	option, err := govTypes.VoteOptionFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*vo = VoteOption(option)
+	return nil
+}
+
+// MsgVote the gov vote msg of cosmos-sdk, translated to the vote of the address account
+type MsgVote struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	Option     VoteOption     `json:"option" yaml:"option"`
+}
+
+// NewMsgVote creates a gov vote msg
+func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption) MsgVote {
+	return MsgVote{ProposalID: proposalID, Voter: voter, Option: option}
+}
+
+func (msg MsgVote) data() govTypes.MsgVote {
+	return govTypes.NewMsgVote(types.NewAccountIDFromAccAdd(msg.Voter), msg.ProposalID, govTypes.VoteOption(msg.Option))
+}
+
+// KuMsg implements Msg
+func (msg MsgVote) KuMsg() sdk.Msg {
+	data := msg.data()
+	return govTypes.NewKuMsgVote(msg.Voter, data.Voter, data.ProposalID, data.Option)
+}
+
+// Route implements sdk.Msg
+func (msg MsgVote) Route() string { return msg.KuMsg().Route() }
+
+// Type implements sdk.Msg
+func (msg MsgVote) Type() string { return msg.KuMsg().Type() }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgVote) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing voter address")
+	}
+
+	if err := msg.data().ValidateBasic(); err != nil {
+		return err
+	}
+
+	return msg.KuMsg().ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgDelegate the staking delegate msg of cosmos-sdk, translated to the delegation of the address account,
+// the validator is the account of the operator address.
+type MsgDelegate struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgDelegate creates a staking delegate msg
+func NewMsgDelegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) MsgDelegate {
+	return MsgDelegate{DelegatorAddress: delAddr, ValidatorAddress: valAddr, Amount: amount}
+}
+
+func (msg MsgDelegate) data() stakingTypes.MsgDelegate {
+	return stakingTypes.NewMsgDelegate(types.NewAccountIDFromAccAdd(msg.DelegatorAddress),
+		types.NewAccountIDFromValAdd(msg.ValidatorAddress), toCoin(msg.Amount))
+}
+
+// KuMsg implements Msg
+func (msg MsgDelegate) KuMsg() sdk.Msg {
+	data := msg.data()
+	return stakingTypes.NewKuMsgDelegate(msg.DelegatorAddress, data.DelegatorAccount, data.ValidatorAccount, data.Amount)
+}
+
+// Route implements sdk.Msg
+func (msg MsgDelegate) Route() string { return msg.KuMsg().Route() }
+
+// Type implements sdk.Msg
+func (msg MsgDelegate) Type() string { return msg.KuMsg().Type() }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgDelegate) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing delegator address")
+	}
+
+	if msg.ValidatorAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing validator address")
+	}
+
+	if amount := toCoin(msg.Amount); !amount.IsValid() || !amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, fmt.Sprintf("invalid delegation amount %s", msg.Amount))
+	}
+
+	if err := msg.data().ValidateBasic(); err != nil {
+		return err
+	}
+
+	return msg.KuMsg().ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgDelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
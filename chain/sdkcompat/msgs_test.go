@@ -0,0 +1,112 @@
+package sdkcompat_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/sdkcompat"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+)
+
+var (
+	wallet   = simapp.NewWallet()
+	addr1    = wallet.NewAccAddress()
+	addr2    = wallet.NewAccAddress()
+	account1 = types.NewAccountIDFromAccAdd(addr1)
+	account2 = types.NewAccountIDFromAccAdd(addr2)
+)
+
+// newCoin creates the coin of the core denom, which is not valid in cosmos-sdk but accepted in the msgs
+func newCoin(amount int64) sdk.Coin {
+	return sdk.Coin{Denom: constants.DefaultBondDenom, Amount: sdk.NewInt(amount)}
+}
+
+func TestMsgTranslation(t *testing.T) {
+	Convey("test msgs translated to kumsgs", t, func() {
+		amount := sdk.Coins{newCoin(100)}
+
+		send := sdkcompat.NewMsgSend(addr1, addr2, amount)
+		So(send.ValidateBasic(), ShouldBeNil)
+		So(send.Route(), ShouldEqual, assetTypes.RouterKey)
+		So(send.Type(), ShouldEqual, "transfer")
+
+		transfer, ok := send.KuMsg().(*types.KuMsg)
+		So(ok, ShouldBeTrue)
+		So(transfer.GetFrom(), ShouldResemble, account1)
+		So(transfer.GetTo(), ShouldResemble, account2)
+		So(transfer.GetAmount(), ShouldResemble, types.NewInt64CoreCoins(100))
+		So(transfer.GetSigners(), ShouldResemble, send.GetSigners())
+
+		So(sdkcompat.NewMsgSend(addr1, addr2, sdk.Coins{}).ValidateBasic(), ShouldNotBeNil)
+		So(sdkcompat.NewMsgSend(addr1, nil, amount).ValidateBasic(), ShouldNotBeNil)
+
+		vote := sdkcompat.NewMsgVote(addr1, 1, sdkcompat.OptionYes)
+		So(vote.ValidateBasic(), ShouldBeNil)
+		So(vote.Route(), ShouldEqual, govTypes.RouterKey)
+
+		var voteData govTypes.MsgVote
+		So(vote.KuMsg().(govTypes.KuMsgVote).UnmarshalData(govTypes.Cdc(), &voteData), ShouldBeNil)
+		So(voteData, ShouldResemble, govTypes.NewMsgVote(account1, 1, govTypes.OptionYes))
+
+		So(sdkcompat.NewMsgVote(addr1, 1, sdkcompat.OptionEmpty).ValidateBasic(), ShouldNotBeNil)
+
+		valAddr := sdk.ValAddress(addr2)
+		delegate := sdkcompat.NewMsgDelegate(addr1, valAddr, newCoin(100))
+		So(delegate.ValidateBasic(), ShouldBeNil)
+		So(delegate.Route(), ShouldEqual, stakingTypes.RouterKey)
+
+		var delegateData stakingTypes.MsgDelegate
+		So(delegate.KuMsg().(stakingTypes.KuMsgDelegate).UnmarshalData(stakingTypes.Cdc(), &delegateData), ShouldBeNil)
+		So(delegateData.DelegatorAccount, ShouldResemble, account1)
+		So(delegateData.ValidatorAccount, ShouldResemble, types.NewAccountIDFromValAdd(valAddr))
+	})
+
+	Convey("test msgs in the format of cosmos-sdk", t, func() {
+		cdc := simapp.MakeCodec()
+
+		send := sdkcompat.NewMsgSend(addr1, addr2, sdk.Coins{newCoin(100)})
+		So(string(send.GetSignBytes()), ShouldStartWith, `{"type":"cosmos-sdk/MsgSend","value":{"amount":[{"amount":"100","denom":`)
+
+		bz := []byte(`{"type":"cosmos-sdk/MsgVote","value":{"option":"NoWithVeto","proposal_id":"2","voter":"` + addr1.String() + `"}}`)
+
+		var msg sdk.Msg
+		So(cdc.UnmarshalJSON(bz, &msg), ShouldBeNil)
+		So(msg, ShouldResemble, sdkcompat.NewMsgVote(addr1, 2, sdkcompat.OptionNoWithVeto))
+	})
+}
+
+func TestMsgSendDeliver(t *testing.T) {
+	Convey("test msg send delivered as transfer", t, func() {
+		assets := types.Coins{types.NewInt64Coin(constants.DefaultBondDenom, 10000000000)}
+		genAccs := simapp.NewGenesisAccounts(wallet.GetRootAuth(),
+			simapp.NewSimGenesisAccount(account1, addr1).WithAsset(assets))
+		app := simapp.SetupWithGenesisAccounts(genAccs)
+
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		seq, num, err := app.AccountKeeper().GetAuthSequence(ctx, addr1)
+		So(err, ShouldBeNil)
+
+		send := sdkcompat.NewMsgSend(addr1, addr2, sdk.Coins{newCoin(1000)})
+
+		fee := types.Coins{types.NewInt64Coin(constants.DefaultBondDenom, 100000)}
+		header := abci.Header{Height: app.LastBlockHeight() + 1}
+		_, _, err = simapp.SignCheckDeliver(t, app.Codec(), app.BaseApp,
+			header, account1, fee,
+			[]sdk.Msg{send}, []uint64{num}, []uint64{seq},
+			true, true, wallet.PrivKey(addr1))
+		So(err, ShouldBeNil)
+
+		ctx = app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		coins, err := app.AssetKeeper().GetCoins(ctx, account2)
+		So(err, ShouldBeNil)
+		So(coins, ShouldResemble, types.NewInt64CoreCoins(1000))
+	})
+}
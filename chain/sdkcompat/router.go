@@ -0,0 +1,42 @@
+package sdkcompat
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Router wraps the msg router of app, the msgs of cosmos-sdk are translated to the KuMsgs
+// before handled, the routes of the msgs are the ones of the KuMsgs.
+type Router struct {
+	sdk.Router
+}
+
+var _ sdk.Router = Router{}
+
+// NewRouter creates a router translating the msgs of cosmos-sdk
+func NewRouter(router sdk.Router) Router {
+	return Router{
+		Router: router,
+	}
+}
+
+// AddRoute adds a route path to the wrapped router
+func (r Router) AddRoute(path string, h sdk.Handler) sdk.Router {
+	r.Router.AddRoute(path, h)
+	return r
+}
+
+// Route returns the handler for the route path, which handles the msgs of cosmos-sdk as the KuMsgs
+func (r Router) Route(ctx sdk.Context, path string) sdk.Handler {
+	h := r.Router.Route(ctx, path)
+	if h == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if m, ok := msg.(Msg); ok {
+			return h(ctx, m.KuMsg())
+		}
+
+		return h(ctx, msg)
+	}
+}
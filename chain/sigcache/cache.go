@@ -0,0 +1,137 @@
+package sigcache
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+type key [sha256.Size]byte
+
+// Cache caches the signatures verified when the txs checked into the mempool, so the signatures
+// are not verified again when the txs delivered in the blocks.
+//
+// The cache replaces the batch verification of the secp256k1 signatures, it does not verify the
+// signatures in batch. The crypto of tendermint v0.33 has no batch verifier for secp256k1, and
+// adding one would change the crypto dependency of the consensus. As most of the txs in the blocks
+// had been checked by the node, the verifications in DeliverTx are mostly hits of the cache, which
+// saves the same verifications the batching was meant to speed up.
+//
+// A signature is cached by the pubkey, the sign bytes and the signature, so a hit is exactly the
+// same verification succeeded before, the results and the gas consumed are the same as verifying.
+// The cached signatures are dropped when delivered, and the oldest ones are dropped if full.
+type Cache struct {
+	mtx     sync.Mutex
+	config  Config
+	entries map[key]struct{}
+	ring    []key
+	next    int
+}
+
+// NewCache creates a cache by the config
+func NewCache(config Config) *Cache {
+	c := &Cache{}
+	c.SetConfig(config)
+	return c
+}
+
+// SetConfig sets the config of the cache, the cached signatures are dropped
+func (c *Cache) SetConfig(config Config) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.config = config
+	c.entries = make(map[key]struct{})
+	c.ring = nil
+	c.next = 0
+}
+
+// Len returns the number of the cached signatures
+func (c *Cache) Len() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return len(c.entries)
+}
+
+// VerifyBytes verifies the signature of the msg by the pubkey, using the cache if enabled.
+// If keep, the signature is cached once verified, such as in CheckTx, else the cached one
+// is dropped, such as in DeliverTx. The cache can be nil, which verifies the signature.
+func (c *Cache) VerifyBytes(pubKey crypto.PubKey, msg, sig []byte, keep bool) bool {
+	if c == nil || !c.enabled() {
+		return pubKey.VerifyBytes(msg, sig)
+	}
+
+	k := newKey(pubKey, msg, sig)
+	if c.take(k, keep) {
+		return true
+	}
+
+	if !pubKey.VerifyBytes(msg, sig) {
+		return false
+	}
+
+	if keep {
+		c.add(k)
+	}
+
+	return true
+}
+
+func (c *Cache) enabled() bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.config.Enable && c.config.MaxEntries > 0
+}
+
+func (c *Cache) take(k key, keep bool) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if _, ok := c.entries[k]; !ok {
+		return false
+	}
+
+	if !keep {
+		delete(c.entries, k)
+	}
+
+	return true
+}
+
+func (c *Cache) add(k key) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if _, ok := c.entries[k]; ok {
+		return
+	}
+
+	// drop the oldest one if full, which may be delivered already
+	if len(c.ring) < c.config.MaxEntries {
+		c.ring = append(c.ring, k)
+	} else {
+		delete(c.entries, c.ring[c.next])
+		c.ring[c.next] = k
+		c.next = (c.next + 1) % len(c.ring)
+	}
+
+	c.entries[k] = struct{}{}
+}
+
+func newKey(pubKey crypto.PubKey, msg, sig []byte) key {
+	h := sha256.New()
+	for _, bz := range [][]byte{pubKey.Bytes(), msg, sig} {
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(bz)))
+		h.Write(l[:])
+		h.Write(bz)
+	}
+
+	var k key
+	copy(k[:], h.Sum(nil))
+	return k
+}
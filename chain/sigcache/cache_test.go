@@ -0,0 +1,99 @@
+package sigcache
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+type signed struct {
+	pubKey secp256k1.PubKeySecp256k1
+	msg    []byte
+	sig    []byte
+}
+
+func newSigned(t testing.TB, n int) []signed {
+	res := make([]signed, 0, n)
+	for i := 0; i < n; i++ {
+		priv := secp256k1.GenPrivKey()
+		msg := []byte(fmt.Sprintf("transfer %d", i))
+
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+
+		res = append(res, signed{pubKey: priv.PubKey().(secp256k1.PubKeySecp256k1), msg: msg, sig: sig})
+	}
+	return res
+}
+
+func TestCache(t *testing.T) {
+	cache := NewCache(Config{Enable: true, MaxEntries: 2})
+	s := newSigned(t, 3)
+
+	// the invalid signatures are not cached
+	require.False(t, cache.VerifyBytes(s[0].pubKey, s[1].msg, s[0].sig, true))
+	require.False(t, cache.VerifyBytes(s[0].pubKey, s[0].msg, s[1].sig, true))
+	require.Equal(t, 0, cache.Len())
+
+	// cached when checked, dropped when delivered
+	require.True(t, cache.VerifyBytes(s[0].pubKey, s[0].msg, s[0].sig, true))
+	require.True(t, cache.VerifyBytes(s[0].pubKey, s[0].msg, s[0].sig, true))
+	require.Equal(t, 1, cache.Len())
+	require.True(t, cache.VerifyBytes(s[0].pubKey, s[0].msg, s[0].sig, false))
+	require.Equal(t, 0, cache.Len())
+
+	// a miss is verified and not cached when delivered
+	require.True(t, cache.VerifyBytes(s[0].pubKey, s[0].msg, s[0].sig, false))
+	require.Equal(t, 0, cache.Len())
+
+	// the oldest ones are dropped if full
+	for _, e := range s {
+		require.True(t, cache.VerifyBytes(e.pubKey, e.msg, e.sig, true))
+	}
+	require.Equal(t, 2, cache.Len())
+	require.False(t, cache.take(newKey(s[0].pubKey, s[0].msg, s[0].sig), true))
+	require.True(t, cache.take(newKey(s[2].pubKey, s[2].msg, s[2].sig), true))
+
+	// disabled
+	cache.SetConfig(Config{Enable: false, MaxEntries: 2})
+	require.True(t, cache.VerifyBytes(s[0].pubKey, s[0].msg, s[0].sig, true))
+	require.False(t, cache.VerifyBytes(s[0].pubKey, s[1].msg, s[0].sig, true))
+	require.Equal(t, 0, cache.Len())
+
+	// the nil cache verifies
+	var nilCache *Cache
+	require.True(t, nilCache.VerifyBytes(s[0].pubKey, s[0].msg, s[0].sig, false))
+	require.False(t, nilCache.VerifyBytes(s[0].pubKey, s[1].msg, s[0].sig, false))
+}
+
+// benchmarkDeliver verifies the signatures of a block of the transfers checked into the mempool before
+func benchmarkDeliver(b *testing.B, config Config) {
+	const blockSize = 1000
+	s := newSigned(b, blockSize)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		cache := NewCache(config)
+		for _, e := range s {
+			cache.VerifyBytes(e.pubKey, e.msg, e.sig, true)
+		}
+		b.StartTimer()
+
+		for _, e := range s {
+			if !cache.VerifyBytes(e.pubKey, e.msg, e.sig, false) {
+				b.Fatal("verify failed")
+			}
+		}
+	}
+}
+
+func BenchmarkDeliverWithoutCache(b *testing.B) {
+	benchmarkDeliver(b, Config{Enable: false})
+}
+
+func BenchmarkDeliverWithCache(b *testing.B) {
+	benchmarkDeliver(b, DefaultConfig())
+}
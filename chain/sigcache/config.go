@@ -0,0 +1,56 @@
+package sigcache
+
+import (
+	"github.com/spf13/viper"
+)
+
+// The keys of the signature cache config in the [sig-cache] section of app.toml
+const (
+	FlagEnable     = "sig-cache.enable"
+	FlagMaxEntries = "sig-cache.max-entries"
+)
+
+// Config the config of the signature verification cache
+type Config struct {
+	Enable     bool // Enable enables the cache
+	MaxEntries int  // MaxEntries the max number of the verified signatures cached, the oldest ones are dropped if full
+}
+
+// DefaultConfig returns the default config, the cache is enabled by default as it not changes the results
+func DefaultConfig() Config {
+	return Config{
+		Enable:     true,
+		MaxEntries: 20000,
+	}
+}
+
+// ReadConfig reads the config from viper, which has the app.toml merged in
+func ReadConfig() Config {
+	config := DefaultConfig()
+
+	if viper.IsSet(FlagEnable) {
+		config.Enable = viper.GetBool(FlagEnable)
+	}
+	if viper.IsSet(FlagMaxEntries) {
+		config.MaxEntries = viper.GetInt(FlagMaxEntries)
+	}
+
+	return config
+}
+
+// ConfigTemplate the signature cache section appended to the app.toml created
+const ConfigTemplate = `
+###############################################################################
+###                         Signature Cache Configuration                   ###
+###############################################################################
+
+[sig-cache]
+
+# Cache the signatures verified when the txs checked into the mempool, so the signatures
+# are not verified again when the txs delivered in the blocks, which reduces the cpu time
+# of the blocks full of the small transfers. The gas consumed is the same with the cache.
+enable = true
+
+# The max number of the verified signatures cached, the oldest ones are dropped if full
+max-entries = 20000
+`
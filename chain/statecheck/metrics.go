@@ -0,0 +1,54 @@
+package statecheck
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsSubsystem the subsystem of the metrics of the state check
+const MetricsSubsystem = "state_check"
+
+// Metrics the metrics of the state check, exposed by the prometheus server of tendermint
+type Metrics struct {
+	// Number of the leaves verified
+	LeavesVerified metrics.Counter
+	// Number of the leaves failed to verify, by the store
+	Corruptions metrics.Counter
+	// Height of the state last verified
+	LastHeight metrics.Gauge
+}
+
+// PrometheusMetrics returns the metrics by the prometheus client
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		LeavesVerified: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "leaves_verified",
+			Help:      "Number of the state leaves verified against the committed root.",
+		}, []string{}),
+		Corruptions: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "corruptions",
+			Help:      "Number of the state leaves failed to verify against the committed root.",
+		}, []string{"store"}),
+		LastHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "last_height",
+			Help:      "Height of the state last verified.",
+		}, []string{}),
+	}
+}
+
+// NopMetrics returns the metrics discarded
+func NopMetrics() *Metrics {
+	return &Metrics{
+		LeavesVerified: discard.NewCounter(),
+		Corruptions:    discard.NewCounter(),
+		LastHeight:     discard.NewGauge(),
+	}
+}
@@ -0,0 +1,225 @@
+package statecheck
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/proxy"
+)
+
+// sampleKeyLen the length of the random start keys to sample the leaves
+const sampleKeyLen = 8
+
+// Source the state verified by the checker, implemented by the app
+type Source interface {
+	abci.Application
+
+	// StateStoreNames returns the names of the stores to verify
+	StateStoreNames() []string
+
+	// SampleStateKeys returns at most limit keys not less than the start key in the store
+	SampleStateKeys(storeName string, start []byte, limit int) [][]byte
+}
+
+// Config the config of the state check
+type Config struct {
+	Interval time.Duration // Interval the interval between the checks, the check is disabled if 0
+	Samples  int           // Samples the number of the leaves verified in a check
+}
+
+// Enabled returns true if the check is enabled
+func (c Config) Enabled() bool {
+	return c.Interval > 0 && c.Samples > 0
+}
+
+// Failure a leaf failed to verify against the committed root
+type Failure struct {
+	Store string
+	Key   []byte
+	Err   error
+}
+
+// String implements fmt.Stringer
+func (f Failure) String() string {
+	return fmt.Sprintf("%s/%X: %s", f.Store, f.Key, f.Err)
+}
+
+// Result the result of a check
+type Result struct {
+	Height   int64
+	Verified int
+	Failures []Failure
+}
+
+// Checker verifies a random sample of the leaves in the stores against the committed app hash periodically,
+// the leaves are re-hashed from the disk by the proofs, so the corruptions of the state on disk are found
+// before they cause the app hash mismatch in consensus.
+//
+// The app is accessed with the lock shared with the abci clients of tendermint, created by ClientCreator.
+type Checker struct {
+	mtx     *sync.Mutex
+	source  Source
+	config  Config
+	logger  log.Logger
+	metrics *Metrics
+	rand    *rand.Rand
+}
+
+// NewChecker creates a checker of the app
+func NewChecker(source Source, config Config, logger log.Logger, metrics *Metrics) *Checker {
+	return &Checker{
+		mtx:     new(sync.Mutex),
+		source:  source,
+		config:  config,
+		logger:  logger,
+		metrics: metrics,
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())), // nolint:gosec
+	}
+}
+
+type localClientCreator struct {
+	mtx *sync.Mutex
+	app abci.Application
+}
+
+func (l localClientCreator) NewABCIClient() (abcicli.Client, error) {
+	return abcicli.NewLocalClient(l.mtx, l.app), nil
+}
+
+// ClientCreator returns the creator of the local abci clients of the app for tendermint,
+// which share the lock with the checker.
+func (c *Checker) ClientCreator() proxy.ClientCreator {
+	return localClientCreator{mtx: c.mtx, app: c.source}
+}
+
+// Run runs the checks periodically until quit
+func (c *Checker) Run(quit <-chan struct{}) {
+	ticker := time.NewTicker(c.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+			res := c.Check()
+			c.logger.Debug("state checked", "height", res.Height, "verified", res.Verified, "failures", len(res.Failures))
+		}
+	}
+}
+
+// Check verifies a random sample of the leaves against the last committed app hash,
+// the check stops early if a new block committed.
+func (c *Checker) Check() Result {
+	c.mtx.Lock()
+	info := c.source.Info(abci.RequestInfo{})
+	names := c.source.StateStoreNames()
+	c.mtx.Unlock()
+
+	res := Result{Height: info.LastBlockHeight}
+	if res.Height == 0 || len(names) == 0 {
+		return res
+	}
+
+	for i := 0; i < c.config.Samples; i++ {
+		name := names[c.rand.Intn(len(names))]
+		start := make([]byte, sampleKeyLen)
+		c.rand.Read(start) // nolint:gosec
+
+		key, resp, committed, err := c.sample(name, start, res.Height)
+		if !committed {
+			break
+		}
+		if key == nil && err == nil {
+			continue
+		}
+
+		if err == nil {
+			err = verify(name, key, resp, info.LastBlockAppHash)
+		}
+
+		if err != nil {
+			failure := Failure{Store: name, Key: key, Err: err}
+			res.Failures = append(res.Failures, failure)
+
+			c.metrics.Corruptions.With("store", name).Add(1)
+			c.logger.Error("state corruption detected", "height", res.Height, "failure", failure.String())
+			continue
+		}
+
+		res.Verified++
+		c.metrics.LeavesVerified.Add(1)
+	}
+
+	c.metrics.LastHeight.Set(float64(res.Height))
+
+	return res
+}
+
+// sample queries the proof of a leaf sampled from the start key in the store at the height,
+// returns false if the height is no longer the last committed, returns the error if the state
+// cannot be read, such as the nodes missing.
+func (c *Checker) sample(name string, start []byte, height int64) (key []byte, resp abci.ResponseQuery, committed bool, err error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.source.Info(abci.RequestInfo{}).LastBlockHeight != height {
+		return nil, resp, false, nil
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("read state panic: %v", r)
+		}
+	}()
+
+	keys := c.source.SampleStateKeys(name, start, 1)
+	if len(keys) == 0 {
+		// wrap to the first key of the store
+		keys = c.source.SampleStateKeys(name, nil, 1)
+	}
+	if len(keys) == 0 {
+		return nil, resp, true, nil
+	}
+
+	key = keys[0]
+	resp = c.source.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", name),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+
+	return key, resp, true, nil
+}
+
+// verify verifies the proof of the key in the response against the app hash
+func verify(name string, key []byte, resp abci.ResponseQuery, appHash []byte) error {
+	if !resp.IsOK() {
+		return fmt.Errorf("query proof failed: %s", resp.Log)
+	}
+
+	if resp.Proof == nil {
+		return fmt.Errorf("no proof")
+	}
+
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(name), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(key, merkle.KeyEncodingURL)
+
+	prt := rootmulti.DefaultProofRuntime()
+
+	// the key sampled may be created after the last commit
+	if resp.Value == nil {
+		return prt.VerifyAbsence(resp.Proof, appHash, kp.String())
+	}
+
+	return prt.VerifyValue(resp.Proof, appHash, kp.String(), resp.Value)
+}
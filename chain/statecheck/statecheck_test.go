@@ -0,0 +1,106 @@
+package statecheck
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+type testSource struct {
+	abci.BaseApplication
+
+	rs  *rootmulti.Store
+	key *storeTypes.KVStoreKey
+}
+
+func newTestSource(t *testing.T, db dbm.DB) *testSource {
+	key := storeTypes.NewKVStoreKey("test")
+	rs := rootmulti.NewStore(db)
+	rs.MountStoreWithDB(key, storeTypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	return &testSource{rs: rs, key: key}
+}
+
+func (s *testSource) Info(abci.RequestInfo) abci.ResponseInfo {
+	id := s.rs.LastCommitID()
+	return abci.ResponseInfo{LastBlockHeight: id.Version, LastBlockAppHash: id.Hash}
+}
+
+func (s *testSource) Query(req abci.RequestQuery) abci.ResponseQuery {
+	req.Path = strings.TrimPrefix(req.Path, "/store")
+	return s.rs.Query(req)
+}
+
+func (s *testSource) StateStoreNames() []string {
+	return []string{s.key.Name()}
+}
+
+func (s *testSource) SampleStateKeys(_ string, start []byte, limit int) [][]byte {
+	iter := s.rs.GetKVStore(s.key).Iterator(start, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid() && len(keys) < limit; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	return keys
+}
+
+func TestChecker(t *testing.T) {
+	db := dbm.NewMemDB()
+	source := newTestSource(t, db)
+
+	checker := NewChecker(source, Config{Interval: time.Second, Samples: 50}, log.NewNopLogger(), NopMetrics())
+
+	// nothing committed
+	res := checker.Check()
+	require.Equal(t, int64(0), res.Height)
+	require.Equal(t, 0, res.Verified)
+
+	store := source.rs.GetKVStore(source.key)
+	for i := 0; i < 100; i++ {
+		store.Set([]byte(fmt.Sprintf("key-%02d", i)), []byte(fmt.Sprintf("value-%02d", i)))
+	}
+	source.rs.Commit()
+
+	res = checker.Check()
+	require.Equal(t, int64(1), res.Height)
+	require.Equal(t, 50, res.Verified)
+	require.Empty(t, res.Failures)
+
+	// corrupt the leaves of the store on disk
+	iter, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	corrupted := make(map[string][]byte)
+	for ; iter.Valid(); iter.Next() {
+		if bytes.HasPrefix(iter.Key(), []byte("s/k:test/n")) && bytes.Contains(iter.Value(), []byte("value-")) {
+			corrupted[string(iter.Key())] = bytes.Replace(iter.Value(), []byte("value-"), []byte("valuE-"), 1)
+		}
+	}
+	iter.Close()
+	require.NotEmpty(t, corrupted)
+	for k, v := range corrupted {
+		require.NoError(t, db.Set([]byte(k), v))
+	}
+
+	// reload the store from the disk
+	source = newTestSource(t, db)
+	checker = NewChecker(source, Config{Interval: time.Second, Samples: 20}, log.NewNopLogger(), NopMetrics())
+
+	res = checker.Check()
+	require.Equal(t, int64(1), res.Height)
+	require.Equal(t, 0, res.Verified)
+	require.Len(t, res.Failures, 20)
+	require.Equal(t, "test", res.Failures[0].Store)
+}
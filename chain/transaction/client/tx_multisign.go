@@ -103,9 +103,9 @@ func makeMultiSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string)
 			}
 
 			// Validate each signature
-			sigBytes := types.StdSignBytes(
+			sigBytes := types.StdSignBytesWithTimeout(
 				txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(),
-				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
+				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(), stdTx.GetTimeoutHeight(),
 			)
 			if ok := stdSig.PubKey.VerifyBytes(sigBytes, stdSig.Signature); !ok {
 				return fmt.Errorf("couldn't verify signature")
@@ -116,7 +116,8 @@ func makeMultiSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string)
 		}
 
 		newStdSig := types.StdSignature{Signature: cdc.MustMarshalBinaryBare(multisigSig), PubKey: multisigPub}
-		newTx := types.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, []types.StdSignature{newStdSig}, stdTx.GetMemo())
+		newTx := types.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, []types.StdSignature{newStdSig}, stdTx.GetMemo()).
+			WithTimeoutHeight(stdTx.GetTimeoutHeight())
 
 		sigOnly := viper.GetBool(flagSigOnly)
 		var json []byte
@@ -233,9 +233,9 @@ func printAndValidateSigs(
 				return false
 			}
 
-			sigBytes := types.StdSignBytes(
+			sigBytes := types.StdSignBytesWithTimeout(
 				chainID, num, seq,
-				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
+				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(), stdTx.GetTimeoutHeight(),
 			)
 
 			if ok := sig.VerifyBytes(sigBytes, sig.Signature); !ok {
@@ -1,5 +1,8 @@
 package transaction
 
 const (
-	FlagPayer = "fee-payer"
+	FlagPayer    = "fee-payer"
+	FlagReferrer = "referrer"
+
+	FlagTimeoutHeight = "timeout-height"
 )
@@ -30,6 +30,8 @@ type TxBuilder struct {
 	fees               Coins
 	gasPrices          DecCoins
 	payer              string
+	referrer           string
+	timeoutHeight      uint64
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -69,11 +71,13 @@ func NewTxBuilderFromCLI(input io.Reader) TxBuilder {
 		simulateAndExecute: flags.GasFlagVar.Simulate,
 		chainID:            viper.GetString(flags.FlagChainID),
 		memo:               viper.GetString(flags.FlagMemo),
+		timeoutHeight:      viper.GetUint64(FlagTimeoutHeight),
 	}
 
 	txbldr = txbldr.WithFees(viper.GetString(flags.FlagFees))
 	txbldr = txbldr.WithGasPrices(viper.GetString(flags.FlagGasPrices))
 	txbldr = txbldr.WithPayer(viper.GetString(FlagPayer))
+	txbldr = txbldr.WithReferrer(viper.GetString(FlagReferrer))
 
 	return txbldr
 }
@@ -106,6 +110,9 @@ func (bldr TxBuilder) ChainID() string { return bldr.chainID }
 // Memo returns the memo message
 func (bldr TxBuilder) Memo() string { return bldr.memo }
 
+// TimeoutHeight returns the block height after which the transaction will not be included
+func (bldr TxBuilder) TimeoutHeight() uint64 { return bldr.timeoutHeight }
+
 // Fees returns the fees for the transaction
 func (bldr TxBuilder) Fees() Coins { return bldr.fees }
 
@@ -124,6 +131,18 @@ func (bldr TxBuilder) FeePayer() types.AccountID {
 	return res
 }
 
+// Referrer returns fee referrer, it will be empty if not set
+func (bldr TxBuilder) Referrer() types.AccountID {
+	if bldr.referrer == "" {
+		return types.AccountID{}
+	}
+	res, err := types.NewAccountIDFromStr(bldr.referrer)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -153,6 +172,12 @@ func (bldr TxBuilder) WithFees(fees string) TxBuilder {
 	return bldr
 }
 
+// WithReferrer return a copy of the context with a fee referrer
+func (bldr TxBuilder) WithReferrer(acc string) TxBuilder {
+	bldr.referrer = acc
+	return bldr
+}
+
 // WithPayer return a copy of the context with a payer
 func (bldr TxBuilder) WithPayer(acc string) TxBuilder {
 	bldr.payer = acc
@@ -192,6 +217,12 @@ func (bldr TxBuilder) WithMemo(memo string) TxBuilder {
 	return bldr
 }
 
+// WithTimeoutHeight returns a copy of the context with an updated timeout height.
+func (bldr TxBuilder) WithTimeoutHeight(height uint64) TxBuilder {
+	bldr.timeoutHeight = height
+	return bldr
+}
+
 // WithAccountNumber returns a copy of the context with an account number.
 func (bldr TxBuilder) WithAccountNumber(accnum uint64) TxBuilder {
 	bldr.accountNumber = accnum
@@ -229,7 +260,8 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		Sequence:      bldr.sequence,
 		Memo:          bldr.memo,
 		Msg:           msgs,
-		Fee:           NewStdFee(bldr.gas, bldr.FeePayer(), fees),
+		Fee:           NewStdFee(bldr.gas, bldr.FeePayer(), fees).WithReferrer(bldr.Referrer()),
+		TimeoutHeight: bldr.timeoutHeight,
 	}, nil
 }
 
@@ -241,7 +273,7 @@ func (bldr TxBuilder) Sign(name, passphrase string, msg StdSignMsg) ([]byte, err
 		return nil, err
 	}
 
-	return bldr.txEncoder(NewStdTx(msg.Msg, msg.Fee, []StdSignature{sig}, msg.Memo))
+	return bldr.txEncoder(NewStdTx(msg.Msg, msg.Fee, []StdSignature{sig}, msg.Memo).WithTimeoutHeight(msg.TimeoutHeight))
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -265,7 +297,7 @@ func (bldr TxBuilder) BuildTxForSim(msgs []sdk.Msg) ([]byte, error) {
 
 	// the ante handler will populate with a sentinel pubkey
 	sigs := []StdSignature{{}}
-	return bldr.txEncoder(NewStdTx(signMsg.Msg, signMsg.Fee, sigs, signMsg.Memo))
+	return bldr.txEncoder(NewStdTx(signMsg.Msg, signMsg.Fee, sigs, signMsg.Memo).WithTimeoutHeight(signMsg.TimeoutHeight))
 }
 
 // SignStdTx appends a signature to a StdTx and returns a copy of it. If append
@@ -282,6 +314,7 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx StdTx, appendSig
 		Fee:           stdTx.Fee,
 		Msg:           stdTx.GetMsgs(),
 		Memo:          stdTx.GetMemo(),
+		TimeoutHeight: stdTx.GetTimeoutHeight(),
 	})
 	if err != nil {
 		return
@@ -293,7 +326,7 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx StdTx, appendSig
 	} else {
 		sigs = append(sigs, stdSignature)
 	}
-	signedStdTx = NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo())
+	signedStdTx = NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo()).WithTimeoutHeight(stdTx.GetTimeoutHeight())
 	return
 }
 
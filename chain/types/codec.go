@@ -8,6 +8,7 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(StdTx{}, "kuchain/Tx", nil)
 	cdc.RegisterInterface((*KuMsgData)(nil), nil)
+	cdc.RegisterInterface((*MsgResponse)(nil), nil)
 }
 
 // module wide codec
@@ -54,4 +54,6 @@ var (
 	ErrNoSignatures    = sdkerrors.Register(KuCodeSpace, errorCode(txErrorCodeRoot, 3), "tx no signers")
 	ErrUnauthorized    = sdkerrors.Register(KuCodeSpace, errorCode(txErrorCodeRoot, 4), "tx wrong number of signers")
 	ErrTxDecode        = sdkerrors.Register(KuCodeSpace, errorCode(txErrorCodeRoot, 5), "tx error decoding")
+	ErrTxTimeoutHeight = sdkerrors.Register(KuCodeSpace, errorCode(txErrorCodeRoot, 6), "tx timeout height")
+	ErrEncryptedMemo   = sdkerrors.Register(KuCodeSpace, errorCode(txErrorCodeRoot, 7), "tx invalid encrypted memo")
 )
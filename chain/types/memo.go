@@ -0,0 +1,122 @@
+package types
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+)
+
+const (
+	// EncryptedMemoPrefix the prefix of the encrypted memos, an encrypted memo is
+	// "kuenc:<recipient>:<base64 of the X25519 sealed box of the plain memo>", the recipient
+	// is in plain text for the explorers and the wallets to know who can decrypt the memo.
+	EncryptedMemoPrefix = "kuenc:"
+
+	// MemoKeySize the size of the X25519 keys to encrypt the memos
+	MemoKeySize = 32
+
+	// memoKeyDomain the message signed by the account key to derive its memo key
+	memoKeyDomain = "kuchain memo key"
+)
+
+// MemoKeyDomain returns the bytes signed by the account key to derive its memo key by DeriveMemoKey,
+// the signatures of secp256k1 keys are deterministic, so the memo key can be derived again by the key.
+func MemoKeyDomain() []byte {
+	return []byte(memoKeyDomain)
+}
+
+// DeriveMemoKey derives the X25519 memo key pair from the seed
+func DeriveMemoKey(seed []byte) (pubKey, privKey []byte) {
+	priv := sha256.Sum256(seed)
+
+	pub, err := curve25519.X25519(priv[:], curve25519.Basepoint)
+	if err != nil {
+		// only fails by the low order points, never happens for the base point
+		panic(err)
+	}
+
+	return pub, priv[:]
+}
+
+// IsEncryptedMemo returns true if the memo is an encrypted memo
+func IsEncryptedMemo(memo string) bool {
+	return strings.HasPrefix(memo, EncryptedMemoPrefix)
+}
+
+// EncryptMemo encrypts the memo to the memo key of the recipient by the X25519 sealed box
+func EncryptMemo(recipient AccountID, memoKey []byte, memo string) (string, error) {
+	if len(memoKey) != MemoKeySize {
+		return "", sdkerrors.Wrapf(ErrEncryptedMemo, "memo key size should be %d", MemoKeySize)
+	}
+
+	var key [MemoKeySize]byte
+	copy(key[:], memoKey)
+
+	sealed, err := box.SealAnonymous(nil, []byte(memo), &key, rand.Reader)
+	if err != nil {
+		return "", sdkerrors.Wrap(ErrEncryptedMemo, err.Error())
+	}
+
+	return EncryptedMemoPrefix + recipient.String() + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// ParseEncryptedMemo parses the recipient and the sealed box of the encrypted memo
+func ParseEncryptedMemo(memo string) (AccountID, []byte, error) {
+	if !IsEncryptedMemo(memo) {
+		return AccountID{}, nil, sdkerrors.Wrap(ErrEncryptedMemo, "no encrypted memo prefix")
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(memo, EncryptedMemoPrefix), ":", 2)
+	if len(parts) != 2 {
+		return AccountID{}, nil, sdkerrors.Wrap(ErrEncryptedMemo, "no recipient")
+	}
+
+	recipient, err := NewAccountIDFromStr(parts[0])
+	if err != nil {
+		return AccountID{}, nil, sdkerrors.Wrapf(ErrEncryptedMemo, "recipient: %s", err)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return AccountID{}, nil, sdkerrors.Wrapf(ErrEncryptedMemo, "sealed box: %s", err)
+	}
+
+	if len(sealed) < box.AnonymousOverhead {
+		return AccountID{}, nil, sdkerrors.Wrap(ErrEncryptedMemo, "sealed box too short")
+	}
+
+	return recipient, sealed, nil
+}
+
+// DecryptMemo decrypts the encrypted memo by the private memo key of the recipient
+func DecryptMemo(memo string, privKey []byte) (string, error) {
+	_, sealed, err := ParseEncryptedMemo(memo)
+	if err != nil {
+		return "", err
+	}
+
+	if len(privKey) != MemoKeySize {
+		return "", sdkerrors.Wrapf(ErrEncryptedMemo, "memo key size should be %d", MemoKeySize)
+	}
+
+	pub, err := curve25519.X25519(privKey, curve25519.Basepoint)
+	if err != nil {
+		return "", sdkerrors.Wrap(ErrEncryptedMemo, err.Error())
+	}
+
+	var pubKey, priv [MemoKeySize]byte
+	copy(pubKey[:], pub)
+	copy(priv[:], privKey)
+
+	plain, ok := box.OpenAnonymous(nil, sealed, &pubKey, &priv)
+	if !ok {
+		return "", sdkerrors.Wrap(ErrEncryptedMemo, "cannot decrypt by the memo key")
+	}
+
+	return string(plain), nil
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+)
+
+func TestEncryptedMemo(t *testing.T) {
+	recipient := types.MustAccountID("bob@ok")
+
+	Convey("test encrypt and decrypt memo", t, func() {
+		pubKey, privKey := types.DeriveMemoKey([]byte("seed"))
+		So(pubKey, ShouldHaveLength, types.MemoKeySize)
+
+		// the same seed derives the same key
+		pubKey2, _ := types.DeriveMemoKey([]byte("seed"))
+		So(pubKey2, ShouldResemble, pubKey)
+
+		memo, err := types.EncryptMemo(recipient, pubKey, "invoice 42")
+		So(err, ShouldBeNil)
+		So(types.IsEncryptedMemo(memo), ShouldBeTrue)
+		So(memo, ShouldNotContainSubstring, "invoice 42")
+
+		to, _, err := types.ParseEncryptedMemo(memo)
+		So(err, ShouldBeNil)
+		So(to, simapp.ShouldEq, recipient)
+
+		plain, err := types.DecryptMemo(memo, privKey)
+		So(err, ShouldBeNil)
+		So(plain, ShouldEqual, "invoice 42")
+
+		// other keys cannot decrypt the memo
+		_, otherKey := types.DeriveMemoKey([]byte("other"))
+		_, err = types.DecryptMemo(memo, otherKey)
+		So(err, simapp.ShouldErrIs, types.ErrEncryptedMemo)
+	})
+
+	Convey("test malformed encrypted memo", t, func() {
+		So(types.IsEncryptedMemo("invoice 42"), ShouldBeFalse)
+
+		for _, memo := range []string{
+			types.EncryptedMemoPrefix + "bob@ok",
+			types.EncryptedMemoPrefix + "bob@ok:not base64",
+			types.EncryptedMemoPrefix + "bob@ok:" + "AAAA",
+			types.EncryptedMemoPrefix + strings.Repeat("a", 100) + ":AAAA",
+		} {
+			_, _, err := types.ParseEncryptedMemo(memo)
+			So(err, simapp.ShouldErrIs, types.ErrEncryptedMemo)
+		}
+
+		_, err := types.EncryptMemo(recipient, []byte("short"), "invoice 42")
+		So(err, simapp.ShouldErrIs, types.ErrEncryptedMemo)
+	})
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgResponse is the typed response of a msg, it is encoded to the data of the msg result,
+// the concrete types should be registered to the codec.
+type MsgResponse interface {
+	// CreatedID returns the id of the resource created by the msg, empty if nothing created
+	CreatedID() string
+}
+
+// MarshalMsgResponse encodes the msg response to the data of the msg result
+func MarshalMsgResponse(cdc *codec.Codec, res MsgResponse) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(res)
+}
+
+// NewMsgResult creates the msg result with the response encoded in the data
+func NewMsgResult(cdc *codec.Codec, res MsgResponse, events sdk.Events) *sdk.Result {
+	return &sdk.Result{
+		Data:   MarshalMsgResponse(cdc, res),
+		Events: events,
+	}
+}
+
+// UnmarshalMsgResponses decodes the msg responses from the data of the tx result,
+// which is the concatenation of the result data of its msgs.
+func UnmarshalMsgResponses(cdc *codec.Codec, data []byte) ([]MsgResponse, error) {
+	res := make([]MsgResponse, 0, 1)
+
+	r := bytes.NewReader(data)
+	for r.Len() > 0 {
+		var msgRes MsgResponse
+		if _, err := cdc.UnmarshalBinaryLengthPrefixedReader(r, &msgRes, int64(len(data))); err != nil {
+			return nil, sdkerrors.Wrap(err, "unmarshal msg response")
+		}
+		res = append(res, msgRes)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// QueryPathNodeInfo the app query path to get the app version and the msg routes of the node,
+// used by the clients to check if the client binary is compatible with the chain.
+const QueryPathNodeInfo = "/app/node_info"
+
+// ModuleRoute the msg route of a module registered in the app
+type ModuleRoute struct {
+	Module string `json:"module" yaml:"module"`
+	Route  string `json:"route" yaml:"route"`
+}
+
+// NodeInfo is the response of the node info query
+type NodeInfo struct {
+	AppVersion string        `json:"app_version" yaml:"app_version"`
+	Routes     []ModuleRoute `json:"routes" yaml:"routes"`
+}
+
+// NewNodeInfo creates the node info by the modules of the app, the modules without msg route are skipped
+func NewNodeInfo(appVersion string, modules map[string]module.AppModule) NodeInfo {
+	info := NodeInfo{
+		AppVersion: appVersion,
+		Routes:     []ModuleRoute{},
+	}
+
+	for name, m := range modules {
+		if route := m.Route(); route != "" {
+			info.Routes = append(info.Routes, ModuleRoute{Module: name, Route: route})
+		}
+	}
+
+	// the modules map is not ordered
+	sort.Slice(info.Routes, func(i, j int) bool {
+		return info.Routes[i].Module < info.Routes[j].Module
+	})
+
+	return info
+}
+
+// HandleNodeInfoQuery handles the node info query
+func HandleNodeInfoQuery(info NodeInfo, cdc *codec.Codec, req abci.RequestQuery) abci.ResponseQuery {
+	bz, err := codec.MarshalJSONIndent(cdc, info)
+	if err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+	}
+
+	return abci.ResponseQuery{
+		Codespace: sdkerrors.RootCodespace,
+		Height:    req.Height,
+		Value:     bz,
+	}
+}
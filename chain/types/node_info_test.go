@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+)
+
+func TestNodeInfoQuery(t *testing.T) {
+	Convey("test node info query", t, func() {
+		app := simapp.Setup(false)
+
+		resp := app.Query(abci.RequestQuery{Path: types.QueryPathNodeInfo})
+		So(resp.IsOK(), ShouldBeTrue)
+
+		var info types.NodeInfo
+		app.Codec().MustUnmarshalJSON(resp.Value, &info)
+		So(info.AppVersion, ShouldEqual, app.AppVersion())
+		So(info.Routes, ShouldNotBeEmpty)
+
+		for i, route := range info.Routes {
+			So(route.Route, ShouldNotBeEmpty)
+			if i > 0 {
+				So(info.Routes[i-1].Module, ShouldBeLessThan, route.Module)
+			}
+		}
+	})
+}
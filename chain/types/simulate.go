@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// QueryPathSimulate the app query path to simulate a tx, unlike the `/app/simulate`,
+// the response contains the gas used and the error even if the tx failed.
+const QueryPathSimulate = "/app/simulate_tx"
+
+// Simulator simulates the tx against the latest state without committing, implemented by the BaseApp
+type Simulator interface {
+	Simulate(txBytes []byte, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error)
+}
+
+// SimulateResponse is the response of the tx simulation
+type SimulateResponse struct {
+	GasWanted uint64           `json:"gas_wanted" yaml:"gas_wanted"`
+	GasUsed   uint64           `json:"gas_used" yaml:"gas_used"`
+	Codespace string           `json:"codespace,omitempty" yaml:"codespace,omitempty"`
+	Code      uint32           `json:"code" yaml:"code"`
+	Error     string           `json:"error,omitempty" yaml:"error,omitempty"`
+	Log       string           `json:"log,omitempty" yaml:"log,omitempty"`
+	Events    sdk.StringEvents `json:"events" yaml:"events"`
+}
+
+// NewSimulateResponse creates the simulate response by the result of the simulation
+func NewSimulateResponse(gInfo sdk.GasInfo, res *sdk.Result, err error) SimulateResponse {
+	resp := SimulateResponse{
+		GasWanted: gInfo.GasWanted,
+		GasUsed:   gInfo.GasUsed,
+		Events:    sdk.StringEvents{},
+	}
+
+	if err != nil {
+		resp.Codespace, resp.Code, resp.Error = sdkerrors.ABCIInfo(err, false)
+		return resp
+	}
+
+	if res != nil {
+		resp.Log = res.Log
+		resp.Events = sdk.StringifyEvents(res.Events.ToABCIEvents())
+	}
+
+	return resp
+}
+
+// IsOK returns true if the tx simulation succeeded
+func (r SimulateResponse) IsOK() bool {
+	return r.Code == 0
+}
+
+// String implements fmt.Stringer
+func (r SimulateResponse) String() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("GasWanted: %d\nGasUsed: %d\n", r.GasWanted, r.GasUsed))
+	if !r.IsOK() {
+		sb.WriteString(fmt.Sprintf("Error: %s (codespace: %s, code: %d)\n", r.Error, r.Codespace, r.Code))
+	}
+	sb.WriteString(r.Events.String())
+
+	return strings.TrimSpace(sb.String())
+}
+
+// HandleSimulateQuery handles the simulate query, the tx is decoded from the query data,
+// the tx can be unsigned as the signatures are not verified in the simulation.
+func HandleSimulateQuery(simulator Simulator, txDecoder sdk.TxDecoder, cdc *codec.Codec, req abci.RequestQuery) abci.ResponseQuery {
+	tx, err := txDecoder(req.Data)
+	if err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error()))
+	}
+
+	gInfo, res, err := simulator.Simulate(req.Data, tx)
+	resp := NewSimulateResponse(gInfo, res, err)
+
+	// the gas meter is infinite in simulation, so use the gas of the tx
+	if stdTx, ok := tx.(StdTx); ok {
+		resp.GasWanted = stdTx.GetGas()
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, resp)
+	if err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+	}
+
+	return abci.ResponseQuery{
+		Codespace: sdkerrors.RootCodespace,
+		Height:    req.Height,
+		Value:     bz,
+	}
+}
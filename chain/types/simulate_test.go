@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+)
+
+func TestSimulateQuery(t *testing.T) {
+	wallet := simapp.NewWallet()
+	addr1 := wallet.NewAccAddress()
+	account1 := types.MustAccountID("simulate@ok")
+	account2 := types.NewAccountIDFromAccAdd(wallet.NewAccAddress())
+
+	genAccs := simapp.NewGenesisAccounts(wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(types.NewInt64CoreCoins(10000000000)))
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	simulate := func(amt int64) (types.SimulateResponse, abci.ResponseQuery) {
+		msg := assetTypes.NewMsgTransfer(addr1, account1, account2, types.NewInt64CoreCoins(amt))
+		fee := types.NewStdFee(200000, account1, types.NewInt64CoreCoins(100000))
+
+		// the tx is not signed
+		tx := types.NewStdTx([]sdk.Msg{&msg}, fee, []types.StdSignature{{}}, "")
+		txBytes := app.Codec().MustMarshalBinaryLengthPrefixed(tx)
+
+		var res types.SimulateResponse
+		resp := app.Query(abci.RequestQuery{Path: types.QueryPathSimulate, Data: txBytes})
+		if resp.IsOK() {
+			app.Codec().MustUnmarshalJSON(resp.Value, &res)
+		}
+		return res, resp
+	}
+
+	Convey("test simulate succeeded tx", t, func() {
+		res, resp := simulate(100)
+		So(resp.IsOK(), ShouldBeTrue)
+		So(res.IsOK(), ShouldBeTrue)
+		So(res.GasUsed, ShouldBeGreaterThan, 0)
+		So(res.GasWanted, ShouldEqual, 200000)
+		So(len(res.Events), ShouldBeGreaterThan, 0)
+
+		// the state is not changed by the simulation
+		ctx := app.NewTestContext()
+		coins, err := app.AssetKeeper().GetCoins(ctx, account2)
+		So(err, ShouldBeNil)
+		So(coins.AmountOf(constants.DefaultBondDenom).IsZero(), ShouldBeTrue)
+	})
+
+	Convey("test simulate failed tx", t, func() {
+		res, resp := simulate(20000000000)
+		So(resp.IsOK(), ShouldBeTrue)
+		So(res.IsOK(), ShouldBeFalse)
+		So(res.Error, ShouldNotBeEmpty)
+		So(res.GasUsed, ShouldBeGreaterThan, 0)
+	})
+
+	Convey("test simulate invalid tx bytes", t, func() {
+		resp := app.Query(abci.RequestQuery{Path: types.QueryPathSimulate, Data: []byte("invalid")})
+		So(resp.IsOK(), ShouldBeFalse)
+	})
+}
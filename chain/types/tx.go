@@ -27,6 +27,9 @@ type StdTx struct {
 	Fee        StdFee         `json:"fee" yaml:"fee"`
 	Signatures []StdSignature `json:"signatures" yaml:"signatures"`
 	Memo       string         `json:"memo" yaml:"memo"`
+
+	// TimeoutHeight is the block height after which the tx will not be included, 0 means no timeout
+	TimeoutHeight uint64 `json:"timeout_height,omitempty" yaml:"timeout_height"`
 }
 
 func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdTx {
@@ -38,6 +41,12 @@ func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdT
 	}
 }
 
+// WithTimeoutHeight returns a copy of the tx with the timeout height set
+func (tx StdTx) WithTimeoutHeight(height uint64) StdTx {
+	tx.TimeoutHeight = height
+	return tx
+}
+
 // GetMsgs returns the all the transaction's messages.
 func (tx StdTx) GetMsgs() []sdk.Msg { return tx.Msgs }
 
@@ -106,6 +115,9 @@ func (tx StdTx) GetSigners() []sdk.AccAddress {
 // GetMemo returns the memo
 func (tx StdTx) GetMemo() string { return tx.Memo }
 
+// GetTimeoutHeight returns the timeout height, 0 means no timeout
+func (tx StdTx) GetTimeoutHeight() uint64 { return tx.TimeoutHeight }
+
 // GetSignatures returns the signature of signers who signed the Msg.
 // GetSignatures returns the signature of signers who signed the Msg.
 // CONTRACT: Length returned is same as length of
@@ -122,11 +134,14 @@ func (tx StdTx) PrettifyJSON(cdc *codec.Codec) ([]byte, error) {
 		Fee        StdFee            `json:"fee" yaml:"fee"`
 		Signatures []StdSignature    `json:"signatures" yaml:"signatures"`
 		Memo       string            `json:"memo" yaml:"memo"`
+
+		TimeoutHeight uint64 `json:"timeout_height,omitempty" yaml:"timeout_height"`
 	}{
-		Fee:        tx.Fee,
-		Signatures: tx.Signatures,
-		Memo:       tx.Memo,
-		Msgs:       make([]json.RawMessage, 0, len(tx.Msgs)),
+		Fee:           tx.Fee,
+		Signatures:    tx.Signatures,
+		Memo:          tx.Memo,
+		Msgs:          make([]json.RawMessage, 0, len(tx.Msgs)),
+		TimeoutHeight: tx.TimeoutHeight,
 	}
 
 	for _, msg := range tx.Msgs {
@@ -149,6 +164,9 @@ func (tx StdTx) GetGas() uint64 { return tx.Fee.Gas }
 // GetFee returns the FeeAmount in StdFee
 func (tx StdTx) GetFee() Coins { return tx.Fee.Amount }
 
+// FeeReferrer returns the referrer of the tx, it may be empty
+func (tx StdTx) FeeReferrer() AccountID { return tx.Fee.Referrer }
+
 func (tx StdTx) FeePayer() AccountID {
 	if !tx.Fee.Payer.Empty() {
 		return tx.Fee.Payer
@@ -171,6 +189,9 @@ type StdFee struct {
 	Amount Coins     `json:"amount" yaml:"amount"`
 	Gas    uint64    `json:"gas" yaml:"gas"`
 	Payer  AccountID `json:"payer" yaml:"payer"`
+
+	// Referrer is the account which will get a share of the fee, it is optional
+	Referrer AccountID `json:"referrer,omitempty" yaml:"referrer,omitempty"`
 }
 
 // NewStdFee returns a new instance of StdFee
@@ -182,6 +203,12 @@ func NewStdFee(gas uint64, payer AccountID, amount Coins) StdFee {
 	}
 }
 
+// WithReferrer returns a copy of the fee with the referrer set
+func (fee StdFee) WithReferrer(referrer AccountID) StdFee {
+	fee.Referrer = referrer
+	return fee
+}
+
 // Bytes for signing later
 func (fee StdFee) Bytes() []byte {
 	// normalize. XXX
@@ -221,10 +248,18 @@ type StdSignDoc struct {
 	Memo          string            `json:"memo" yaml:"memo"`
 	Msg           []json.RawMessage `json:"msg" yaml:"msg"`
 	Sequence      uint64            `json:"sequence" yaml:"sequence"`
+
+	// TimeoutHeight is omitted if not set, so the sign bytes of the txs without timeout are not changed
+	TimeoutHeight uint64 `json:"timeout_height,omitempty" yaml:"timeout_height"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+	return StdSignBytesWithTimeout(chainID, accnum, sequence, fee, msgs, memo, 0)
+}
+
+// StdSignBytesWithTimeout returns the bytes to sign for a transaction with the timeout height.
+func StdSignBytesWithTimeout(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string, timeoutHeight uint64) []byte {
 	var msgsBytes []json.RawMessage
 	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
@@ -236,6 +271,7 @@ func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, ms
 		Memo:          memo,
 		Msg:           msgsBytes,
 		Sequence:      sequence,
+		TimeoutHeight: timeoutHeight,
 	})
 	if err != nil {
 		panic(err)
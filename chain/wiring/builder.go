@@ -0,0 +1,95 @@
+package wiring
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/params"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Builder provides the store keys and params subspaces declared by the modules,
+// the modules create its keeper by the builder, so the app no need to know
+// which store keys and subspaces a module used.
+type Builder struct {
+	cdc *codec.Codec
+
+	keys  map[string]*sdk.KVStoreKey
+	tKeys map[string]*sdk.TransientStoreKey
+
+	paramsKeeper params.Keeper
+	subspaces    map[string]params.Subspace
+}
+
+// NewBuilder creates a builder with the params keeper, which all the subspaces created by
+func NewBuilder(cdc *codec.Codec) *Builder {
+	b := &Builder{
+		cdc:       cdc,
+		keys:      make(map[string]*sdk.KVStoreKey),
+		tKeys:     make(map[string]*sdk.TransientStoreKey),
+		subspaces: make(map[string]params.Subspace),
+	}
+
+	b.paramsKeeper = params.NewKeeper(cdc, b.KVStoreKey(params.StoreKey), b.TransientStoreKey(params.TStoreKey))
+
+	return b
+}
+
+// Codec returns the codec for the keepers
+func (b *Builder) Codec() *codec.Codec {
+	return b.cdc
+}
+
+// KVStoreKey returns the kv store key by name, the key will be created at first declared
+func (b *Builder) KVStoreKey(name string) *sdk.KVStoreKey {
+	if key, ok := b.keys[name]; ok {
+		return key
+	}
+
+	key := sdk.NewKVStoreKey(name)
+	b.keys[name] = key
+
+	return key
+}
+
+// TransientStoreKey returns the transient store key by name, the key will be created at first declared
+func (b *Builder) TransientStoreKey(name string) *sdk.TransientStoreKey {
+	if key, ok := b.tKeys[name]; ok {
+		return key
+	}
+
+	key := sdk.NewTransientStoreKey(name)
+	b.tKeys[name] = key
+
+	return key
+}
+
+// Subspace returns the params subspace by name, the subspace will be created at first declared
+func (b *Builder) Subspace(name string) params.Subspace {
+	if subspace, ok := b.subspaces[name]; ok {
+		return subspace
+	}
+
+	subspace := b.paramsKeeper.Subspace(name)
+	b.subspaces[name] = subspace
+
+	return subspace
+}
+
+// ParamsKeeper returns the params keeper
+func (b *Builder) ParamsKeeper() params.Keeper {
+	return b.paramsKeeper
+}
+
+// KVStoreKeys returns all kv store keys declared, for mounting to app
+func (b *Builder) KVStoreKeys() map[string]*sdk.KVStoreKey {
+	return b.keys
+}
+
+// TransientStoreKeys returns all transient store keys declared, for mounting to app
+func (b *Builder) TransientStoreKeys() map[string]*sdk.TransientStoreKey {
+	return b.tKeys
+}
+
+// Subspaces returns all params subspaces declared, by the names
+func (b *Builder) Subspaces() map[string]params.Subspace {
+	return b.subspaces
+}
@@ -0,0 +1,63 @@
+package wiring_test
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/app/keepers"
+	"github.com/KuChainNetwork/kuchain/chain/fee"
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/account"
+	distr "github.com/KuChainNetwork/kuchain/x/distribution"
+	"github.com/KuChainNetwork/kuchain/x/gov"
+	"github.com/KuChainNetwork/kuchain/x/insurance"
+	"github.com/KuChainNetwork/kuchain/x/lane"
+	"github.com/KuChainNetwork/kuchain/x/mint"
+	"github.com/KuChainNetwork/kuchain/x/params"
+	"github.com/KuChainNetwork/kuchain/x/paychan"
+	"github.com/KuChainNetwork/kuchain/x/staking"
+	"github.com/KuChainNetwork/kuchain/x/supply"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBuilder(t *testing.T) {
+	Convey("test builder declare", t, func() {
+		b := wiring.NewBuilder(simapp.MakeCodec())
+
+		So(b.KVStoreKeys(), ShouldContainKey, params.StoreKey)
+		So(b.TransientStoreKeys(), ShouldContainKey, params.TStoreKey)
+
+		key := b.KVStoreKey(staking.StoreKey)
+		So(b.KVStoreKey(staking.StoreKey), ShouldEqual, key)
+
+		tKey := b.TransientStoreKey(lane.TStoreKey)
+		So(b.TransientStoreKey(lane.TStoreKey), ShouldEqual, tKey)
+
+		// the subspace can be declared by more than one module
+		So(func() { b.Subspace(staking.DefaultParamspace) }, ShouldNotPanic)
+		So(func() { b.Subspace(staking.DefaultParamspace) }, ShouldNotPanic)
+		So(b.Subspaces(), ShouldContainKey, staking.DefaultParamspace)
+	})
+
+	Convey("test app keepers declare the stores", t, func() {
+		b := wiring.NewBuilder(simapp.MakeCodec())
+		keepers.NewAppKeepers(b, keepers.Options{
+			MaccPerms: map[string][]string{
+				account.ModuleName:        nil,
+				fee.CollectorName:         nil,
+				distr.ModuleName:          nil,
+				staking.BondedPoolName:    {supply.Burner, supply.Staking},
+				staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+				gov.ModuleName:            {supply.Burner},
+				mint.ModuleName:           {supply.Minter},
+				paychan.ModuleName:        nil,
+				insurance.ModuleName:      nil,
+			},
+		})
+
+		So(b.KVStoreKeys(), ShouldContainKey, staking.StoreKey)
+		So(b.KVStoreKeys(), ShouldContainKey, gov.StoreKey)
+		So(b.TransientStoreKeys(), ShouldContainKey, lane.TStoreKey)
+		So(b.Subspaces(), ShouldContainKey, gov.DefaultParamspace)
+	})
+}
@@ -0,0 +1,298 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/KuChainNetwork/kuchain/chain/debugstore"
+	"github.com/KuChainNetwork/kuchain/chain/gasaudit"
+	"github.com/KuChainNetwork/kuchain/x/account"
+)
+
+const (
+	flagNodeA  = "node-a"
+	flagNodeB  = "node-b"
+	flagModule = "module"
+	flagPrefix = "prefix"
+	flagHeight = "height"
+
+	flagDebugAddress = "debug-address"
+	flagStart        = "start"
+	flagLimit        = "limit"
+)
+
+// moduleStoreKeys the store keys of the modules which are not the module name
+var moduleStoreKeys = map[string]string{
+	account.ModuleName: account.StoreKey,
+}
+
+// debugCmd returns the debug command with the state diff and the store tools
+func debugCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := debug.Cmd(cdc)
+	cmd.AddCommand(diffStateCmd(cdc), storeCmd(), gasDiffCmd())
+	return cmd
+}
+
+// storeKeyOf returns the store key of the module
+func storeKeyOf(module string) string {
+	if key, ok := moduleStoreKeys[module]; ok {
+		return key
+	}
+	return module
+}
+
+func diffStateCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff-state",
+		Short: "Print the different keys and values of a module store between two nodes",
+		Long: strings.TrimSpace(`Iterate the store of the module on two nodes at the same height,
+and print the keys which values are different or only exist in one node,
+the height is the lower latest height of the two nodes by default.
+
+$ <appd> debug diff-state --node-a tcp://10.0.0.1:26657 --node-b tcp://10.0.0.2:26657 --module gov
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			module := viper.GetString(flagModule)
+			if module == "" {
+				return fmt.Errorf("the --%s flag is required", flagModule)
+			}
+
+			if viper.GetString(flagNodeB) == "" {
+				return fmt.Errorf("the --%s flag is required", flagNodeB)
+			}
+
+			storeKey := storeKeyOf(module)
+
+			prefix, err := hex.DecodeString(viper.GetString(flagPrefix))
+			if err != nil {
+				return fmt.Errorf("invalid key prefix: %w", err)
+			}
+
+			ctxA := context.NewCLIContext().WithCodec(cdc).WithNodeURI(viper.GetString(flagNodeA)).WithTrustNode(true)
+			ctxB := context.NewCLIContext().WithCodec(cdc).WithNodeURI(viper.GetString(flagNodeB)).WithTrustNode(true)
+
+			height := viper.GetInt64(flagHeight)
+			if height <= 0 {
+				if height, err = latestCommonHeight(ctxA, ctxB); err != nil {
+					return err
+				}
+			}
+
+			pairsA, _, err := ctxA.WithHeight(height).QuerySubspace(prefix, storeKey)
+			if err != nil {
+				return fmt.Errorf("query %s from node a error: %w", storeKey, err)
+			}
+
+			pairsB, _, err := ctxB.WithHeight(height).QuerySubspace(prefix, storeKey)
+			if err != nil {
+				return fmt.Errorf("query %s from node b error: %w", storeKey, err)
+			}
+
+			out := cmd.OutOrStdout()
+			diffs := diffKVPairs(pairsA, pairsB)
+			for _, d := range diffs {
+				fmt.Fprintln(out, d.String())
+			}
+
+			_, err = fmt.Fprintf(out, "store %s at height %d: %d keys in node a, %d keys in node b, %d different\n",
+				storeKey, height, len(pairsA), len(pairsB), len(diffs))
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagNodeA, "tcp://localhost:26657", "<host>:<port> to the first tendermint rpc interface")
+	cmd.Flags().String(flagNodeB, "", "<host>:<port> to the second tendermint rpc interface")
+	cmd.Flags().String(flagModule, "", "the name of the module to diff")
+	cmd.Flags().String(flagPrefix, "", "only diff the keys with the prefix in hex")
+	cmd.Flags().Int64(flagHeight, 0, "the height to diff the state, use the lower latest height of the two nodes if not set")
+
+	return cmd
+}
+
+func storeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store [module]",
+		Short: "Print a page of the keys and values with a prefix in the module store of the local node",
+		Long: strings.TrimSpace(`Iterate the keys with the prefix in the module store at the latest committed height
+of the local node, served by the node started with '--debug-store-address', the keys and values are in hex,
+with the values decoded by the module codec if possible. The next page starts from the 'next_key' of the
+page by '--start', the store names are listed if no module given.
+
+$ <appd> debug store gov --prefix 00 --limit 10
+$ <appd> debug store gov --prefix 00 --limit 10 --start 0000000000000000000b
+`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			storeKey := ""
+			if len(args) > 0 {
+				storeKey = storeKeyOf(args[0])
+			}
+
+			query := url.Values{}
+			query.Set("prefix", viper.GetString(flagPrefix))
+			query.Set("start", viper.GetString(flagStart))
+			query.Set("limit", fmt.Sprintf("%d", viper.GetInt(flagLimit)))
+
+			address := viper.GetString(flagDebugAddress)
+			if err := debugstore.ValidateAddress(address); err != nil {
+				return err
+			}
+
+			res, err := http.Get(fmt.Sprintf("http://%s%s%s?%s", address, debugstore.PathPrefix, storeKey, query.Encode()))
+			if err != nil {
+				return fmt.Errorf("query debug store error: %w", err)
+			}
+			defer res.Body.Close()
+
+			body, err := ioutil.ReadAll(res.Body)
+			if err != nil {
+				return err
+			}
+
+			if res.StatusCode != http.StatusOK {
+				return fmt.Errorf("query debug store error: %s", strings.TrimSpace(string(body)))
+			}
+
+			var out bytes.Buffer
+			if err := json.Indent(&out, body, "", "  "); err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), out.String())
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagDebugAddress, "localhost:26659", "<host>:<port> to the debug store server of the local node")
+	cmd.Flags().String(flagPrefix, "", "only iterate the keys with the prefix in hex")
+	cmd.Flags().String(flagStart, "", "the start key in hex of the page, the next_key of the last page")
+	cmd.Flags().Int(flagLimit, debugstore.DefaultLimit, "the max number of the keys in the page")
+
+	return cmd
+}
+
+func gasDiffCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "gas-diff [base-report] [head-report]",
+		Short: "Compare the gas consumed by the msgs in two gas audit reports",
+		Long: strings.TrimSpace(`Compare the gas distributions of the msg types in the reports written by the nodes
+started or the blocks replayed with '--gas-audit-report', the reports should be recorded by the same blocks,
+such as replaying the blocks by the last release and the new version. Exits with error if the gas consumed
+by any msg type changed, as an unintended gas change breaks the consensus.
+
+$ <appd> replay ~/.kucd-base --gas-audit-report base.json
+$ <appd> replay ~/.kucd-head --gas-audit-report head.json
+$ <appd> debug gas-diff base.json head.json
+`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			base, err := gasaudit.ReadReport(args[0])
+			if err != nil {
+				return err
+			}
+
+			head, err := gasaudit.ReadReport(args[1])
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			diffs := gasaudit.Compare(base, head)
+			for _, d := range diffs {
+				fmt.Fprintln(out, d.String())
+			}
+
+			if len(diffs) > 0 {
+				return fmt.Errorf("%d gas changes found", len(diffs))
+			}
+
+			_, err = fmt.Fprintln(out, "no gas changes")
+			return err
+		},
+	}
+}
+
+// latestCommonHeight returns the lower latest block height of the two nodes
+func latestCommonHeight(ctxA, ctxB context.CLIContext) (int64, error) {
+	statusA, err := ctxA.Client.Status()
+	if err != nil {
+		return 0, fmt.Errorf("get status of node a error: %w", err)
+	}
+
+	statusB, err := ctxB.Client.Status()
+	if err != nil {
+		return 0, fmt.Errorf("get status of node b error: %w", err)
+	}
+
+	height := statusA.SyncInfo.LatestBlockHeight
+	if statusB.SyncInfo.LatestBlockHeight < height {
+		height = statusB.SyncInfo.LatestBlockHeight
+	}
+
+	return height, nil
+}
+
+// kvDiff a different key between the two stores, the value is nil if the key not exist in the store
+type kvDiff struct {
+	Key    []byte
+	ValueA []byte
+	ValueB []byte
+}
+
+func (d kvDiff) String() string {
+	switch {
+	case d.ValueA == nil:
+		return fmt.Sprintf("+ %X\n  b: %X", d.Key, d.ValueB)
+	case d.ValueB == nil:
+		return fmt.Sprintf("- %X\n  a: %X", d.Key, d.ValueA)
+	default:
+		return fmt.Sprintf("~ %X\n  a: %X\n  b: %X", d.Key, d.ValueA, d.ValueB)
+	}
+}
+
+// diffKVPairs returns the different keys between the two stores, sorted by the key
+func diffKVPairs(pairsA, pairsB []sdk.KVPair) []kvDiff {
+	values := make(map[string][]byte, len(pairsA))
+	for _, p := range pairsA {
+		values[string(p.Key)] = p.Value
+	}
+
+	diffs := make([]kvDiff, 0)
+	for _, p := range pairsB {
+		valueA, ok := values[string(p.Key)]
+		if !ok {
+			diffs = append(diffs, kvDiff{Key: p.Key, ValueB: p.Value})
+			continue
+		}
+
+		delete(values, string(p.Key))
+		if !bytes.Equal(valueA, p.Value) {
+			diffs = append(diffs, kvDiff{Key: p.Key, ValueA: valueA, ValueB: p.Value})
+		}
+	}
+
+	for key, value := range values {
+		diffs = append(diffs, kvDiff{Key: []byte(key), ValueA: value})
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return bytes.Compare(diffs[i].Key, diffs[j].Key) < 0
+	})
+
+	return diffs
+}
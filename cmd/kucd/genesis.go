@@ -26,6 +26,7 @@ func AddGenesisCmds(
 	genCmd.AddCommand(
 		accountGen.GenGensisAccountCmd(ctx, cdc),
 		accountGen.GenGensisAddAccountCmd(ctx, cdc),
+		accountGen.GenGenesisAccountsJSONCmd(ctx, cdc),
 		assetGen.GenGensisCoinCmd(ctx, cdc),
 		assetGen.GenGensisAccountAssetCmd(ctx, cdc),
 	)
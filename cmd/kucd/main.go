@@ -10,8 +10,6 @@ import (
 	genutilcli "github.com/KuChainNetwork/kuchain/x/genutil/client/cli"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
-	"github.com/cosmos/cosmos-sdk/client/debug"
-	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,9 +24,14 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/KuChainNetwork/kuchain/app"
+	"github.com/KuChainNetwork/kuchain/chain/client/completion"
 	chainCfg "github.com/KuChainNetwork/kuchain/chain/config"
 	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/gasaudit"
+	"github.com/KuChainNetwork/kuchain/chain/querycache"
+	"github.com/KuChainNetwork/kuchain/chain/sigcache"
 	kuLog "github.com/KuChainNetwork/kuchain/utils/log"
+	accountGen "github.com/KuChainNetwork/kuchain/x/account/client/gen"
 	genTypes "github.com/KuChainNetwork/kuchain/x/genutil/types"
 )
 
@@ -45,8 +48,8 @@ func main() {
 	ctx := server.NewDefaultContext()
 	cobra.EnableCommandSorting = false
 	rootCmd := &cobra.Command{
-		Use:               "kucd",
-		Short:             "kuchain Daemon (server)",
+		Use:               constants.DaemonName,
+		Short:             constants.ChainMainNameStr + " Daemon (server)",
 		PersistentPreRunE: kuLog.PersistentPreRunEFn(ctx),
 	}
 
@@ -59,13 +62,14 @@ func main() {
 			app.DefaultNodeHome, app.DefaultCLIHome, staking.NewFuncManager(),
 		),
 	)
-	rootCmd.AddCommand(genutilcli.ValidateGenesisCmd(ctx, genCdc, app.ModuleBasics))
+	rootCmd.AddCommand(genutilcli.ValidateGenesisCmd(ctx, genCdc, app.ModuleBasics, app.GenesisChecks(cdc)...))
 
 	rootCmd.AddCommand(AddGenesisCmds(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome))
+	rootCmd.AddCommand(accountGen.GenGenesisAccountsCmd(ctx, cdc))
 
-	rootCmd.AddCommand(flags.NewCompletionCmd(rootCmd, true))
+	rootCmd.AddCommand(completion.Command(rootCmd))
 	rootCmd.AddCommand(replayCmd())
-	rootCmd.AddCommand(debug.Cmd(cdc))
+	rootCmd.AddCommand(debugCmd(cdc))
 
 	AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
 
@@ -96,14 +100,26 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 		miniGasPrice = constants.MinGasPriceString
 	}
 
-	return app.NewKuchainApp(
-		logger, db, traceStore, true, invCheckPeriod,
+	baseAppOptions := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))),
 		//baseapp.SetMinGasPrices(miniGasPrice), FIXME: min gas
 		baseapp.SetHaltHeight(viper.GetUint64(server.FlagHaltHeight)),
 		baseapp.SetHaltTime(viper.GetUint64(server.FlagHaltTime)),
 		baseapp.SetInterBlockCache(cache),
+	}
+
+	if viper.GetString(FlagGasAuditReport) != "" {
+		baseAppOptions = append(baseAppOptions, gasaudit.SetRecorder(gasRecorder))
+	}
+
+	kuApp := app.NewKuchainApp(
+		logger, db, traceStore, true, skipUpgradeHeights, viper.GetString(cli.HomeFlag), viper.GetBool(FlagMaintenanceMode), invCheckPeriod,
+		baseAppOptions...,
 	)
+	kuApp.SetQueryCacheConfig(querycache.ReadConfig())
+	kuApp.SetSigCacheConfig(sigcache.ReadConfig())
+
+	return kuApp
 }
 
 func exportAppStateAndTMValidators(
@@ -111,7 +127,7 @@ func exportAppStateAndTMValidators(
 ) (json.RawMessage, []tmtypes.GenesisValidator, error) {
 
 	if height != -1 {
-		kuApp := app.NewKuchainApp(logger, db, traceStore, false, uint(1))
+		kuApp := app.NewKuchainApp(logger, db, traceStore, false, map[int64]bool{}, viper.GetString(cli.HomeFlag), false, uint(1))
 		err := kuApp.LoadHeight(height)
 		if err != nil {
 			return nil, nil, err
@@ -120,6 +136,6 @@ func exportAppStateAndTMValidators(
 		return kuApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 	}
 
-	kuApp := app.NewKuchainApp(logger, db, traceStore, true, uint(1))
+	kuApp := app.NewKuchainApp(logger, db, traceStore, true, map[int64]bool{}, viper.GetString(cli.HomeFlag), false, uint(1))
 	return kuApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
 }
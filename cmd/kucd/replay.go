@@ -18,6 +18,7 @@ import (
 	tm "github.com/tendermint/tendermint/types"
 
 	"github.com/KuChainNetwork/kuchain/app"
+	"github.com/KuChainNetwork/kuchain/chain/gasaudit"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -26,17 +27,24 @@ import (
 )
 
 func replayCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "replay <root-dir>",
 		Short: "Replay Kuchain transactions",
-		RunE: func(_ *cobra.Command, args []string) error {
-			return replayTxs(args[0])
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gasReport, err := cmd.Flags().GetString(FlagGasAuditReport)
+			if err != nil {
+				return err
+			}
+			return replayTxs(args[0], gasReport)
 		},
 		Args: cobra.ExactArgs(1),
 	}
+
+	cmd.Flags().String(FlagGasAuditReport, "", "File to write the gas consumed by the msgs replayed per msg type, disabled if empty")
+	return cmd
 }
 
-func replayTxs(rootDir string) error {
+func replayTxs(rootDir, gasReport string) error {
 
 	if false {
 		// Copy the rootDir to a new directory, to preserve the old one.
@@ -94,9 +102,16 @@ func replayTxs(rootDir string) error {
 
 	// Application
 	fmt.Fprintln(os.Stderr, "Creating application")
-	kuApp := app.NewKuchainApp(
-		ctx.Logger, appDB, traceStoreWriter, true, uint(1),
+	baseAppOptions := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(store.PruneEverything), // nothing
+	}
+	if gasReport != "" {
+		baseAppOptions = append(baseAppOptions, gasaudit.SetRecorder(gasRecorder))
+	}
+
+	kuApp := app.NewKuchainApp(
+		ctx.Logger, appDB, traceStoreWriter, true, map[int64]bool{}, rootDir, false, uint(1),
+		baseAppOptions...,
 	)
 
 	// Genesis
@@ -171,6 +186,7 @@ func replayTxs(rootDir string) error {
 		blockmeta := blockStore.LoadBlockMeta(int64(i))
 		if blockmeta == nil {
 			fmt.Printf("Couldn't find block meta %d... done?\n", i)
+			writeGasAuditReport(ctx.Logger, gasReport)
 			return nil
 		}
 		block := blockStore.LoadBlock(int64(i))
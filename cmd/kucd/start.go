@@ -8,6 +8,11 @@ import (
 	"path/filepath"
 	"runtime/pprof"
 
+	"github.com/KuChainNetwork/kuchain/chain/chaos"
+	"github.com/KuChainNetwork/kuchain/chain/debugstore"
+	"github.com/KuChainNetwork/kuchain/chain/gasaudit"
+	"github.com/KuChainNetwork/kuchain/chain/grpcserver"
+	"github.com/KuChainNetwork/kuchain/chain/statecheck"
 	"github.com/KuChainNetwork/kuchain/plugins"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,13 +20,16 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	abciServer "github.com/tendermint/tendermint/abci/server"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
 )
 
 // Tendermint full-node start flags
@@ -36,11 +44,20 @@ const (
 	FlagMinGasPrices         = "minimum-gas-prices"
 	FlagHaltHeight           = "halt-height"
 	FlagHaltTime             = "halt-time"
+	FlagMaintenanceMode      = "maintenance-mode"
 	FlagInterBlockCache      = "inter-block-cache"
 	FlagUnsafeSkipUpgrades   = "unsafe-skip-upgrades"
 	FlagPluginCfgPath        = "plugin-cfg"
+	FlagStateCheckInterval   = "state-check-interval"
+	FlagStateCheckSamples    = "state-check-samples"
+	FlagGRPCAddress          = "grpc-address"
+	FlagDebugStoreAddress    = "debug-store-address"
+	FlagGasAuditReport       = "gas-audit-report"
 )
 
+// gasRecorder records the gas of the msgs delivered by the app if the gas audit report set
+var gasRecorder = gasaudit.NewRecorder()
+
 var (
 	errPruningWithGranularOptions = fmt.Errorf(
 		"'--%s' flag is not compatible with granular options  '--%s' or '--%s'",
@@ -75,6 +92,33 @@ the halt-height or if the current block time is greater than or equal to the hal
 node will attempt to gracefully shutdown and the block will not be committed. In addition, the node
 will not be able to commit subsequent blocks.
 
+The chain can also be halted by a governance halt proposal, all nodes will gracefully shutdown after
+the block of the halt height committed, and can be restarted to continue the chain after maintenance.
+
+With '--maintenance-mode', the node only accepts the txs of the msgs allowed in maintenance mode into
+its mempool, such as gov votes, unjail and evidence submissions. The chain-wide maintenance mode can be
+switched by the param change proposal of the feature module.
+
+When a software upgrade plan height is reached and the binary has no handler for it, the node writes
+the plan to 'data/upgrade-info.json' under the home and stops, so that cosmovisor-style supervisors
+can swap the binary. Use '--unsafe-skip-upgrades' to skip the upgrades at the heights given.
+
+With '--state-check-interval', the node verifies a random sample of '--state-check-samples' leaves of the
+committed state against the app hash periodically, the corruptions found are logged and counted by the
+'state_check_corruptions' metric, so the disk corruptions are caught before an app hash mismatch.
+
+With '--debug-store-address', the node serves the paginated iterations of the store prefixes of the committed
+state on the loopback address for debugging, with the keys and values in hex and the values decoded by the
+module codecs if possible, queried by '<appd> debug store'.
+
+With '--gas-audit-report', the node records the gas consumed by the handlers of the msgs delivered per msg
+type, and writes the distributions to the report file when stopped. The reports of two versions replaying
+the same blocks can be compared by '<appd> debug gas-diff' to catch the unintended gas changes.
+
+The binary built with the 'chaos' build tag can inject the random faults into the node for the testnets,
+such as the delays of the ABCI calls, the dropped peers and the slow disk, enabled by the [chaos] section
+of app.toml, the binary built without the tag ignores the section.
+
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
 `,
@@ -108,9 +152,15 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().Bool(FlagMaintenanceMode, false, "Only accept the txs of the msgs allowed in maintenance mode into the mempool")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().String(FlagPluginCfgPath, "", "Config file path for plugins")
+	cmd.Flags().Duration(FlagStateCheckInterval, 0, "Interval to verify a sample of the committed state against the app hash, disabled if 0")
+	cmd.Flags().Int(FlagStateCheckSamples, 100, "Number of the state leaves verified in each state check")
+	cmd.Flags().String(FlagGRPCAddress, "", "Listen address of the gRPC query services, such as 0.0.0.0:9090, disabled if empty")
+	cmd.Flags().String(FlagDebugStoreAddress, "", "Loopback listen address of the debug store queries, such as localhost:26659, disabled if empty")
+	cmd.Flags().String(FlagGasAuditReport, "", "File to write the gas consumed by the msgs per msg type when the node stopped, disabled if empty")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -210,6 +260,11 @@ func startInProcess(ctx *server.Context, appCreator server.AppCreator) (*node.No
 		tmos.Exit(err.Error())
 	}
 
+	injector, err := chaos.NewInjector(chaos.ReadConfig(), ctx.Logger.With("module", "chaos"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid chaos config")
+	}
+
 	traceWriterFile := viper.GetString(flagTraceStore)
 	db, err := openDB(home)
 	if err != nil {
@@ -221,7 +276,8 @@ func startInProcess(ctx *server.Context, appCreator server.AppCreator) (*node.No
 		return nil, err
 	}
 
-	app := appCreator(ctx.Logger, db, traceWriter)
+	app := appCreator(ctx.Logger, injector.WrapDB(db), traceWriter)
+	clientCreator, stopStateCheck := newStateCheck(ctx, injector.WrapApplication(app))
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
@@ -233,9 +289,9 @@ func startInProcess(ctx *server.Context, appCreator server.AppCreator) (*node.No
 		cfg,
 		pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
 		nodeKey,
-		proxy.NewLocalClientCreator(app),
+		clientCreator,
 		node.DefaultGenesisDocProviderFunc(cfg),
-		node.DefaultDBProvider,
+		injector.DBProvider(node.DefaultDBProvider),
 		node.DefaultMetricsProvider(cfg.Instrumentation),
 		ctx.Logger.With("module", "node"),
 	)
@@ -247,6 +303,18 @@ func startInProcess(ctx *server.Context, appCreator server.AppCreator) (*node.No
 		return nil, err
 	}
 
+	stopPeerDropper := injector.StartPeerDropper(tmNode.Switch())
+
+	grpcServer, err := startGRPCServer(ctx, app, tmNode)
+	if err != nil {
+		return nil, err
+	}
+
+	debugStoreServer, err := startDebugStoreServer(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
 	var cpuProfileCleanup func()
 
 	if cpuProfile := viper.GetString(flagCPUProfile); cpuProfile != "" {
@@ -268,6 +336,16 @@ func startInProcess(ctx *server.Context, appCreator server.AppCreator) (*node.No
 	}
 
 	server.TrapSignal(func() {
+		if grpcServer != nil {
+			grpcServer.Stop()
+		}
+
+		if debugStoreServer != nil {
+			debugStoreServer.Stop()
+		}
+
+		stopPeerDropper()
+
 		if tmNode.IsRunning() {
 			_ = tmNode.Stop()
 		}
@@ -276,6 +354,10 @@ func startInProcess(ctx *server.Context, appCreator server.AppCreator) (*node.No
 			cpuProfileCleanup()
 		}
 
+		stopStateCheck()
+
+		writeGasAuditReport(ctx.Logger, viper.GetString(FlagGasAuditReport))
+
 		plugins.StopPlugins(plugins.NewContext(ctx.Logger))
 
 		ctx.Logger.Info("exiting...")
@@ -292,6 +374,105 @@ func startInProcess(ctx *server.Context, appCreator server.AppCreator) (*node.No
 	select {}
 }
 
+// newStateCheck starts the state check of the app if enabled, returns the creator of the abci clients
+// sharing the lock of the app with the state check, and the func to stop the check.
+func newStateCheck(ctx *server.Context, app abci.Application) (proxy.ClientCreator, func()) {
+	config := statecheck.Config{
+		Interval: viper.GetDuration(FlagStateCheckInterval),
+		Samples:  viper.GetInt(FlagStateCheckSamples),
+	}
+
+	source, ok := app.(statecheck.Source)
+	if !ok || !config.Enabled() {
+		return proxy.NewLocalClientCreator(app), func() {}
+	}
+
+	metrics := statecheck.NopMetrics()
+	if ctx.Config.Instrumentation.Prometheus {
+		metrics = statecheck.PrometheusMetrics(ctx.Config.Instrumentation.Namespace)
+	}
+
+	checker := statecheck.NewChecker(source, config, ctx.Logger.With("module", "state-check"), metrics)
+	quit := make(chan struct{})
+	go checker.Run(quit)
+
+	ctx.Logger.Info("state check started", "interval", config.Interval, "samples", config.Samples)
+
+	return checker.ClientCreator(), func() { close(quit) }
+}
+
+// grpcRegistrar the app serving the gRPC services
+type grpcRegistrar interface {
+	RegisterGRPCServices(server *grpc.Server, querier grpcserver.ABCIQuerier)
+}
+
+// startGRPCServer starts the gRPC server if the address set, the services query the app
+// by the query connection of the node, which is serialized with the consensus.
+func startGRPCServer(ctx *server.Context, app abci.Application, tmNode *node.Node) (*grpcserver.Server, error) {
+	address := viper.GetString(FlagGRPCAddress)
+	if address == "" {
+		return nil, nil
+	}
+
+	registrar, ok := app.(grpcRegistrar)
+	if !ok {
+		return nil, errors.New("app not support gRPC services")
+	}
+
+	querier := func(req abci.RequestQuery) (abci.ResponseQuery, error) {
+		res, err := tmNode.ProxyApp().Query().QuerySync(req)
+		if err != nil {
+			return abci.ResponseQuery{}, err
+		}
+		return *res, nil
+	}
+
+	grpcServer, err := grpcserver.NewServer(address, ctx.Logger.With("module", "grpc-server"), func(s *grpc.Server) {
+		registrar.RegisterGRPCServices(s, querier)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	grpcServer.Start()
+	return grpcServer, nil
+}
+
+// startDebugStoreServer starts the debug store server on the loopback address if set
+func startDebugStoreServer(ctx *server.Context, app abci.Application) (*debugstore.Server, error) {
+	address := viper.GetString(FlagDebugStoreAddress)
+	if address == "" {
+		return nil, nil
+	}
+
+	source, ok := app.(debugstore.Source)
+	if !ok {
+		return nil, errors.New("app not support debug store queries")
+	}
+
+	debugServer, err := debugstore.NewServer(address, source, ctx.Logger.With("module", "debug-store"))
+	if err != nil {
+		return nil, err
+	}
+
+	debugServer.Start()
+	return debugServer, nil
+}
+
+// writeGasAuditReport writes the report of the gas recorder to the file if set
+func writeGasAuditReport(logger log.Logger, path string) {
+	if path == "" {
+		return
+	}
+
+	if err := gasRecorder.WriteFile(path); err != nil {
+		logger.Error("write gas audit report failed", "path", path, "err", err)
+		return
+	}
+
+	logger.Info("gas audit report written", "path", path)
+}
+
 func openDB(rootDir string) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	db, err := sdk.NewLevelDB("application", dataDir)
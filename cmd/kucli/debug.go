@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/KuChainNetwork/kuchain/test/vectors"
+)
+
+// debugCmd returns the debug commands of the cli
+func debugCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Tool for helping with debugging the client",
+	}
+
+	cmd.AddCommand(verifyVectorCmd(cdc))
+	return cmd
+}
+
+func verifyVectorCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-vector [vectors-file]",
+		Short: "Verify the sign doc test vectors in a json file",
+		Long: strings.TrimSpace(`Verify the sign doc and the signature of each test vector in the json file,
+the sign doc should be the canonical sign bytes of the inputs, and the signature should be
+signed on the sign doc by the public key. The golden vectors of all the msg types are in
+test/vectors/testdata/vectors.json, the vectors produced by the wallets and the SDKs in the
+same format can be verified to check the compatibility with the chain.
+
+$ <appcli> debug verify-vector vectors.json
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vs, err := vectors.Load(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			failed := 0
+			for _, v := range vs {
+				if err := v.Verify(); err != nil {
+					failed++
+					fmt.Fprintf(cmd.OutOrStdout(), "FAIL %s\n  %s\n", v.Name, err)
+					continue
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "OK   %s\n", v.Name)
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d vectors failed", failed, len(vs))
+			}
+
+			return nil
+		},
+	}
+}
@@ -19,10 +19,18 @@ import (
 	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/KuChainNetwork/kuchain/app"
+	"github.com/KuChainNetwork/kuchain/chain/client/alias"
+	blockcli "github.com/KuChainNetwork/kuchain/chain/client/blockutil/client/cli"
 	blockrest "github.com/KuChainNetwork/kuchain/chain/client/blockutil/client/rest"
+	"github.com/KuChainNetwork/kuchain/chain/client/completion"
+	chainFlags "github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/handshake"
+	"github.com/KuChainNetwork/kuchain/chain/client/profile"
 	txcmd "github.com/KuChainNetwork/kuchain/chain/client/txutil/client/cli"
 	txrest "github.com/KuChainNetwork/kuchain/chain/client/txutil/client/rest"
+	"github.com/KuChainNetwork/kuchain/chain/client/watch"
 	chainCfg "github.com/KuChainNetwork/kuchain/chain/config"
+	"github.com/KuChainNetwork/kuchain/chain/constants"
 	txCli "github.com/KuChainNetwork/kuchain/chain/transaction/client"
 )
 
@@ -42,14 +50,40 @@ func main() {
 	// with the cdc
 
 	rootCmd := &cobra.Command{
-		Use:   "kucli",
-		Short: "Command line interface for interacting with kucd",
+		Use:   constants.CLIName,
+		Short: "Command line interface for interacting with " + constants.DaemonName,
 	}
 
 	// Add --chain-id to persistent flags and mark it required
 	rootCmd.PersistentFlags().String(flags.FlagChainID, "", "Chain ID of tendermint node")
-	rootCmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
-		return initConfig(rootCmd)
+	// Add --no-prompt to persistent flags for automation, same as --yes for all tx commands
+	rootCmd.PersistentFlags().Bool(chainFlags.FlagNoPrompt, false, "Skip all tx broadcasting prompt confirmations, can also be set in config.toml")
+	// Add --profile to persistent flags to select the profile in config for the chain and node
+	rootCmd.PersistentFlags().String(profile.FlagProfile, "",
+		fmt.Sprintf("Profile in config to use, overrides the chain-id, node, keyring-backend and fees in config, also by %s", profile.EnvProfile))
+	// Add --strict to persistent flags to refuse the commands if the client is older than the chain
+	rootCmd.PersistentFlags().Bool(handshake.FlagStrict, false, "Refuse the query and tx commands if the client binary is older than the chain")
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+		if err := initConfig(rootCmd); err != nil {
+			return err
+		}
+
+		if err := profile.Apply(cmd, viper.GetViper(), profile.Name(cmd)); err != nil {
+			return err
+		}
+
+		// negotiate the version with the node only for the commands talking to the chain
+		if !isChainCommand(cmd) {
+			return nil
+		}
+
+		// the generate-only txs can use the key names of the keyring, including the watch-only accounts
+		if err := watch.ApplyFrom(cmd.InOrStdin()); err != nil {
+			return err
+		}
+
+		strict, _ := cmd.Flags().GetBool(handshake.FlagStrict)
+		return handshake.Check(cmd, cdc, version.Version, app.ModuleBasics, strict)
 	}
 
 	// Construct Root Command
@@ -61,18 +95,29 @@ func main() {
 		flags.LineBreak,
 		lcd.ServeCommand(cdc, registerRoutes),
 		flags.LineBreak,
-		keys.Commands(),
+		keysCmd(),
 		flags.LineBreak,
+		debugCmd(cdc),
 		version.Cmd,
-		flags.NewCompletionCmd(rootCmd, true),
+		completion.Command(rootCmd),
 	)
 
+	// complete the key names for --from flags
+	completion.RegisterFromFlag(rootCmd)
+
+	// expand the user-defined command aliases in the config file
+	aliases, err := alias.Load(alias.HomeFromArgs(os.Args[1:], "GA", app.DefaultCLIHome))
+	if err != nil {
+		rootCmd.PrintErrf("Failed loading command aliases: %s, exiting...\n", err)
+		os.Exit(1)
+	}
+	rootCmd.SetArgs(alias.Expand(rootCmd, aliases, os.Args[1:]))
+
 	// Add flags and prefix all env exposed with GA
 	executor := cli.PrepareMainCmd(rootCmd, "GA", app.DefaultCLIHome)
 
-	err := executor.Execute()
-	if err != nil {
-		fmt.Printf("Failed executing CLI command: %s, exiting...\n", err)
+	if err := executor.Execute(); err != nil {
+		rootCmd.PrintErrf("Failed executing CLI command: %s, exiting...\n", err)
 		os.Exit(1)
 	}
 }
@@ -88,8 +133,10 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 		flags.LineBreak,
 		rpc.ValidatorCommand(cdc),
 		rpc.BlockCommand(),
+		blockcli.BlockTimeCommand(cdc),
 		txcmd.QueryTxsByEventsCmd(cdc),
 		txcmd.QueryTxCmd(cdc),
+		txcmd.GetDecryptMemoCommand(cdc),
 		flags.LineBreak,
 	)
 
@@ -111,6 +158,7 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 		txCli.GetMultiSignCommand(cdc),
 		flags.LineBreak,
 		txcmd.GetBroadcastCommand(cdc),
+		txcmd.GetSimulateCommand(cdc),
 		txcmd.GetEncodeCommand(cdc),
 		txcmd.GetDecodeCommand(cdc),
 		flags.LineBreak,
@@ -122,6 +170,17 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 	return txCmd
 }
 
+func keysCmd() *cobra.Command {
+	keysCmd := keys.Commands()
+
+	keysCmd.AddCommand(
+		flags.LineBreak,
+		watch.AddCommand(),
+	)
+
+	return keysCmd
+}
+
 // registerRoutes registers the routes from the different modules for the LCD.
 // NOTE: details on the routes added for each module are in the module documentation
 // NOTE: If making updates here you also need to update the test helper in client/lcd/test_helper.go
@@ -132,6 +191,17 @@ func registerRoutes(rs *lcd.RestServer) {
 	app.ModuleBasics.RegisterRESTRoutes(rs.CliCtx, rs.Mux)
 }
 
+// isChainCommand returns true if the command is a query or tx subcommand
+func isChainCommand(cmd *cobra.Command) bool {
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		if c.Parent() == cmd.Root() {
+			return c.Name() == "query" || c.Name() == "tx"
+		}
+	}
+
+	return false
+}
+
 func initConfig(cmd *cobra.Command) error {
 	home, err := cmd.PersistentFlags().GetString(cli.HomeFlag)
 	if err != nil {
@@ -149,6 +219,9 @@ func initConfig(cmd *cobra.Command) error {
 	if err := viper.BindPFlag(flags.FlagChainID, cmd.PersistentFlags().Lookup(flags.FlagChainID)); err != nil {
 		return err
 	}
+	if err := viper.BindPFlag(chainFlags.FlagNoPrompt, cmd.PersistentFlags().Lookup(chainFlags.FlagNoPrompt)); err != nil {
+		return err
+	}
 	if err := viper.BindPFlag(cli.EncodingFlag, cmd.PersistentFlags().Lookup(cli.EncodingFlag)); err != nil {
 		return err
 	}
@@ -5,13 +5,16 @@ go 1.14
 require (
 	github.com/99designs/keyring v1.1.4 // indirect
 	github.com/cosmos/cosmos-sdk v0.38.5
+	github.com/ghodss/yaml v1.0.0
+	github.com/go-kit/kit v0.10.0
 	github.com/go-pg/pg/v10 v10.0.0-beta.1
 	github.com/gogo/protobuf v1.3.1
-	github.com/golang/mock v1.4.1 // indirect
+	github.com/golang/mock v1.4.1
 	github.com/gopherjs/gopherjs v0.0.0-20200217142428-fce0ec30dd00 // indirect
 	github.com/gorilla/mux v1.7.4
 	github.com/otiai10/copy v1.1.1
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.5.1
 	github.com/rakyll/statik v0.1.7 // indirect
 	github.com/smartystreets/assertions v1.0.1 // indirect
 	github.com/smartystreets/goconvey v1.6.4
@@ -23,5 +26,7 @@ require (
 	github.com/tendermint/tendermint v0.33.6
 	github.com/tendermint/tm-db v0.5.1
 	go.uber.org/zap v1.13.0
+	golang.org/x/crypto v0.0.0-20200510223506-06a226fb4e37
+	google.golang.org/grpc v1.29.1
 	gopkg.in/yaml.v2 v2.2.8
 )
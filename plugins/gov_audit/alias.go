@@ -0,0 +1,7 @@
+package govAudit
+
+import "github.com/KuChainNetwork/kuchain/plugins/gov_audit/types"
+
+const (
+	PluginName = types.PluginName
+)
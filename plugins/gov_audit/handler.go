@@ -0,0 +1,19 @@
+package govAudit
+
+import (
+	"github.com/KuChainNetwork/kuchain/plugins/gov_audit/types"
+)
+
+func (t *plugin) OnEvent(ctx types.Context, evt types.Event) {
+	if !t.events[evt.Type] {
+		return
+	}
+
+	record, err := t.log.Append(evt)
+	if err != nil {
+		// the audit log must not miss any record, so stop the node if the log cannot be written
+		panic(err)
+	}
+
+	t.logger.Debug("governance action logged", "seq", record.Seq, "type", evt.Type, "height", evt.Height)
+}
@@ -0,0 +1,286 @@
+package govAudit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/plugins/gov_audit/types"
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// Record a governance action in the audit log, the records are chained by the hash of the previous record,
+// and signed by the key of the plugin, so any record modified, removed or inserted can be found.
+type Record struct {
+	Seq        uint64            `json:"seq"`
+	Height     int64             `json:"height"`
+	Time       time.Time         `json:"time"`
+	Type       string            `json:"type"`
+	Attributes map[string]string `json:"attributes"`
+	PrevHash   string            `json:"prev_hash"`
+	Signature  []byte            `json:"signature,omitempty"`
+}
+
+// Hash returns the hash of the record without the signature, which is signed
+func (r Record) Hash() []byte {
+	r.Signature = nil
+	bz, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// auditLog the append-only log file of the records, rotated by the size
+type auditLog struct {
+	cfg  types.Config
+	key  crypto.PrivKey
+	file *os.File
+	size int64
+
+	seq      uint64
+	prevHash []byte
+}
+
+// openAuditLog opens the log file to append the records, the chain is continued from the last record
+// in the log file or the last rotated file.
+func openAuditLog(cfg types.Config, key crypto.PrivKey) (*auditLog, error) {
+	if err := os.MkdirAll(filepath.Dir(cfg.Path), 0700); err != nil {
+		return nil, errors.Wrapf(err, "create dir of %s", cfg.Path)
+	}
+
+	last, err := lastRecord(cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if last == nil {
+		rotated, err := rotatedFiles(cfg.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rotated) > 0 {
+			if last, err = lastRecord(rotated[len(rotated)-1]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	file, err := os.OpenFile(cfg.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open %s", cfg.Path)
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	l := &auditLog{
+		cfg:  cfg,
+		key:  key,
+		file: file,
+		size: stat.Size(),
+	}
+
+	if last != nil {
+		l.seq = last.Seq
+		l.prevHash = last.Hash()
+	}
+
+	return l, nil
+}
+
+// Append signs the record of the event and appends it to the log file, the file is synced for each record.
+func (l *auditLog) Append(evt types.Event) (Record, error) {
+	record := Record{
+		Seq:        l.seq + 1,
+		Height:     evt.Height,
+		Time:       evt.Time.UTC(),
+		Type:       evt.Type,
+		Attributes: evt.Attributes,
+		PrevHash:   hex.EncodeToString(l.prevHash),
+	}
+
+	hash := record.Hash()
+	sig, err := l.key.Sign(hash)
+	if err != nil {
+		return record, errors.Wrapf(err, "sign record %d", record.Seq)
+	}
+	record.Signature = sig
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return record, err
+	}
+	line = append(line, '\n')
+
+	if l.cfg.MaxSize > 0 && l.size > 0 && l.size+int64(len(line)) > l.cfg.MaxSize {
+		if err := l.rotate(); err != nil {
+			return record, err
+		}
+	}
+
+	n, err := l.file.Write(line)
+	l.size += int64(n)
+	if err != nil {
+		return record, errors.Wrapf(err, "write record %d", record.Seq)
+	}
+
+	if err := l.file.Sync(); err != nil {
+		return record, errors.Wrapf(err, "sync record %d", record.Seq)
+	}
+
+	l.seq = record.Seq
+	l.prevHash = hash
+
+	return record, nil
+}
+
+// rotate renames the log file by the seq of its last record, and removes the oldest rotated files
+// if more than max files.
+func (l *auditLog) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(l.cfg.Path, rotatedPath(l.cfg.Path, l.seq)); err != nil {
+		return errors.Wrapf(err, "rotate %s", l.cfg.Path)
+	}
+
+	file, err := os.OpenFile(l.cfg.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "open %s", l.cfg.Path)
+	}
+
+	l.file = file
+	l.size = 0
+
+	if l.cfg.MaxFiles <= 0 {
+		return nil
+	}
+
+	rotated, err := rotatedFiles(l.cfg.Path)
+	if err != nil {
+		return err
+	}
+
+	for len(rotated) > l.cfg.MaxFiles {
+		if err := os.Remove(rotated[0]); err != nil {
+			return err
+		}
+		rotated = rotated[1:]
+	}
+
+	return nil
+}
+
+// Close closes the log file
+func (l *auditLog) Close() error {
+	return l.file.Close()
+}
+
+// rotatedPath returns the path of the rotated file, the seq is padded so the files are sorted by the names
+func rotatedPath(path string, seq uint64) string {
+	return fmt.Sprintf("%s.%020d", path, seq)
+}
+
+// rotatedFiles returns the rotated files of the log file, from the oldest to the newest
+func rotatedFiles(path string) ([]string, error) {
+	files, err := filepath.Glob(path + ".*")
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+// readRecords reads the records in the log file, the handler stops the reading if returns an error
+func readRecords(path string, handler func(Record) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var seq uint64
+
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			var record Record
+			if err := json.Unmarshal(line, &record); err != nil {
+				return errors.Wrapf(err, "the record after %d in %s is broken", seq, path)
+			}
+			seq = record.Seq
+
+			if err := handler(record); err != nil {
+				return err
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// lastRecord returns the last record in the log file, nil if no record
+func lastRecord(path string) (*Record, error) {
+	var last *Record
+
+	err := readRecords(path, func(record Record) error {
+		last = &record
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return last, nil
+}
+
+// VerifyFile verifies the signatures and the chain of the records in the log file by the public key of the plugin,
+// the prev is the last record of the previous rotated file, nil for the first file, returns the last record verified.
+func VerifyFile(path string, pubKey crypto.PubKey, prev *Record) (*Record, error) {
+	err := readRecords(path, func(record Record) error {
+		hash := record.Hash()
+		if !pubKey.VerifyBytes(hash, record.Signature) {
+			return fmt.Errorf("invalid signature of record %d", record.Seq)
+		}
+
+		if prev != nil {
+			if record.Seq != prev.Seq+1 {
+				return fmt.Errorf("record %d is missing before record %d", prev.Seq+1, record.Seq)
+			}
+
+			if record.PrevHash != hex.EncodeToString(prev.Hash()) {
+				return fmt.Errorf("the prev hash of record %d mismatched", record.Seq)
+			}
+		}
+
+		prev = &record
+		return nil
+	})
+
+	return prev, err
+}
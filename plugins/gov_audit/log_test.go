@@ -0,0 +1,75 @@
+package govAudit
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/plugins/gov_audit/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestAuditLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gov-audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	key := ed25519.GenPrivKey()
+	cfg := types.Config{Path: filepath.Join(dir, "gov-audit.log"), MaxSize: 1024, MaxFiles: 2}
+
+	appendEvents := func(l *auditLog, from, to int) {
+		for i := from; i < to; i++ {
+			record, err := l.Append(types.Event{
+				Height:     int64(i),
+				Time:       time.Now(),
+				Type:       "proposal_vote",
+				Attributes: map[string]string{"proposal_id": fmt.Sprintf("%d", i), "option": "yes"},
+			})
+			require.NoError(t, err)
+			require.Equal(t, uint64(i), record.Seq)
+		}
+	}
+
+	l, err := openAuditLog(cfg, key)
+	require.NoError(t, err)
+	appendEvents(l, 1, 10)
+	require.NoError(t, l.Close())
+
+	// the chain is continued after reopened
+	l, err = openAuditLog(cfg, key)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), l.seq)
+	appendEvents(l, 10, 30)
+	require.NoError(t, l.Close())
+
+	// the oldest rotated files are removed
+	rotated, err := rotatedFiles(cfg.Path)
+	require.NoError(t, err)
+	require.Len(t, rotated, 2)
+
+	var prev *Record
+	for _, path := range append(rotated, cfg.Path) {
+		last, err := VerifyFile(path, key.PubKey(), prev)
+		require.NoError(t, err)
+		prev = last
+	}
+	require.Equal(t, uint64(29), prev.Seq)
+
+	// the modified record cannot be verified
+	bz, err := ioutil.ReadFile(cfg.Path)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(cfg.Path, []byte(strings.Replace(string(bz), `"option":"yes"`, `"option":"no"`, 1)), 0600))
+	_, err = VerifyFile(cfg.Path, key.PubKey(), nil)
+	require.Error(t, err)
+
+	// the records removed are found by the chain
+	_, err = VerifyFile(rotated[1], key.PubKey(), nil)
+	require.NoError(t, err)
+	_, err = VerifyFile(rotated[1], key.PubKey(), &Record{Seq: 1})
+	require.Error(t, err)
+}
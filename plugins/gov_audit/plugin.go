@@ -0,0 +1,100 @@
+package govAudit
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/plugins/gov_audit/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+)
+
+// plugin appends the governance related state changes to the signed audit log
+type plugin struct {
+	logger log.Logger
+
+	cfg    types.Config
+	events map[string]bool
+	log    *auditLog
+}
+
+func (t *plugin) Init(ctx types.Context) error {
+	t.logger.Info("plugin init", "name", types.PluginName)
+
+	// the key file is in the same format as the node key
+	key, err := p2p.LoadOrGenNodeKey(t.cfg.KeyFile)
+	if err != nil {
+		return err
+	}
+
+	if t.log, err = openAuditLog(t.cfg, key.PrivKey); err != nil {
+		return err
+	}
+
+	t.logger.Info("audit log opened", "path", t.cfg.Path, "seq", t.log.seq, "pubkey", fmt.Sprintf("%X", key.PubKey().Bytes()))
+
+	return nil
+}
+
+func (t *plugin) Start(ctx types.Context) error {
+	t.logger.Info("plugin start", "name", types.PluginName)
+	return nil
+}
+
+func (t *plugin) Stop(ctx types.Context) error {
+	t.logger.Info("plugin stop", "name", types.PluginName)
+	return t.log.Close()
+}
+
+func (t *plugin) MsgHandler() types.PluginMsgHandler {
+	return nil
+}
+
+func (t *plugin) TxHandler() types.PluginTxHandler {
+	return nil
+}
+
+func (t *plugin) EvtHandler() types.PluginEvtHandler {
+	return func(ctx types.Context, evt types.Event) {
+		t.OnEvent(ctx, evt)
+	}
+}
+
+func (t *plugin) Logger() log.Logger {
+	return t.logger
+}
+
+func (t *plugin) Name() string {
+	return types.PluginName
+}
+
+// New new plugin
+func New(ctx types.Context, cfg types.BaseCfg) *plugin {
+	logger := types.Logger(ctx)
+
+	res := &plugin{
+		logger: logger,
+	}
+
+	if err := json.Unmarshal(cfg.CfgRaw, &res.cfg); err != nil {
+		panic(err)
+	}
+
+	if res.cfg.Path == "" || res.cfg.KeyFile == "" {
+		panic(fmt.Errorf("plugin %s requires the path and the key file", types.PluginName))
+	}
+
+	events := res.cfg.Events
+	if len(events) == 0 {
+		events = types.DefaultEvents
+	}
+
+	res.events = make(map[string]bool, len(events))
+	for _, evt := range events {
+		res.events[evt] = true
+	}
+
+	logger.Info("new plugin", "name", types.PluginName, "cfg", res.cfg)
+
+	return res
+}
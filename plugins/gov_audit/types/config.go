@@ -0,0 +1,26 @@
+package types
+
+// DefaultEvents the governance related events logged by default, the proposal lifecycle, the deposits,
+// the votes from kugov and the param changes from params, the plugin cannot import the modules,
+// so the event types are listed by value.
+var DefaultEvents = []string{
+	"submit_proposal",
+	"proposal_deposit",
+	"proposal_vote",
+	"cancel_proposal",
+	"inactive_proposal",
+	"active_proposal",
+	"proposal_deposit_refund",
+	"proposal_deposit_burn",
+	"proposal_veto_slash",
+	"param_change",
+}
+
+// Config the config of the gov audit plugin
+type Config struct {
+	Path     string   `json:"path" yaml:"path"`           // Path the path of the log file
+	KeyFile  string   `json:"key_file" yaml:"key_file"`   // KeyFile the ed25519 key to sign the records, created if not exists
+	MaxSize  int64    `json:"max_size" yaml:"max_size"`   // MaxSize the size in bytes to rotate the log file, no rotation if 0
+	MaxFiles int      `json:"max_files" yaml:"max_files"` // MaxFiles the max number of the rotated files kept, all kept if 0
+	Events   []string `json:"events" yaml:"events"`       // Events the event types logged, DefaultEvents if empty
+}
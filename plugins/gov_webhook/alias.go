@@ -0,0 +1,7 @@
+package govWebhook
+
+import "github.com/KuChainNetwork/kuchain/plugins/gov_webhook/types"
+
+const (
+	PluginName = types.PluginName
+)
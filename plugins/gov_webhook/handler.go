@@ -0,0 +1,13 @@
+package govWebhook
+
+import (
+	"github.com/KuChainNetwork/kuchain/plugins/gov_webhook/types"
+)
+
+func (t *plugin) OnEvent(ctx types.Context, evt types.Event) {
+	if !t.events[evt.Type] {
+		return
+	}
+
+	t.webhook.Push(NewNotification(evt))
+}
@@ -0,0 +1,86 @@
+package govWebhook
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/plugins/gov_webhook/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// plugin forwards the proposal status events to the webhook, so the explorers need not poll the proposals
+type plugin struct {
+	logger log.Logger
+
+	cfg     types.Config
+	events  map[string]bool
+	webhook *webhook
+}
+
+func (t *plugin) Init(ctx types.Context) error {
+	t.logger.Info("plugin init", "name", types.PluginName)
+	t.webhook = newWebhook(t.cfg, t.logger)
+	return nil
+}
+
+func (t *plugin) Start(ctx types.Context) error {
+	t.logger.Info("plugin start", "name", types.PluginName)
+	t.webhook.Start()
+	return nil
+}
+
+func (t *plugin) Stop(ctx types.Context) error {
+	t.logger.Info("plugin stop", "name", types.PluginName)
+	t.webhook.Stop()
+	return nil
+}
+
+func (t *plugin) MsgHandler() types.PluginMsgHandler {
+	return nil
+}
+
+func (t *plugin) TxHandler() types.PluginTxHandler {
+	return nil
+}
+
+func (t *plugin) EvtHandler() types.PluginEvtHandler {
+	return func(ctx types.Context, evt types.Event) {
+		t.OnEvent(ctx, evt)
+	}
+}
+
+func (t *plugin) Logger() log.Logger {
+	return t.logger
+}
+
+func (t *plugin) Name() string {
+	return types.PluginName
+}
+
+// New new plugin
+func New(ctx types.Context, cfg types.BaseCfg) *plugin {
+	logger := types.Logger(ctx)
+
+	res := &plugin{
+		logger: logger,
+	}
+
+	if err := json.Unmarshal(cfg.CfgRaw, &res.cfg); err != nil {
+		panic(err)
+	}
+
+	if res.cfg.URL == "" {
+		panic(fmt.Errorf("plugin %s requires the url", types.PluginName))
+	}
+
+	res.cfg = res.cfg.WithDefaults()
+
+	res.events = make(map[string]bool, len(res.cfg.Events))
+	for _, evt := range res.cfg.Events {
+		res.events[evt] = true
+	}
+
+	logger.Info("new plugin", "name", types.PluginName, "url", res.cfg.URL, "events", res.cfg.Events)
+
+	return res
+}
@@ -0,0 +1,45 @@
+package types
+
+// DefaultEvents the proposal status events from kugov forwarded by default, the plugin cannot import
+// the modules, so the event types are listed by value.
+var DefaultEvents = []string{
+	"proposal_deposit_period_ended",
+	"proposal_voting_started",
+	"proposal_passed",
+	"proposal_rejected",
+	"proposal_failed",
+}
+
+const (
+	DefaultTimeoutSec = 5
+	DefaultMaxRetries = 3
+	DefaultQueueSize  = 256
+)
+
+// Config the config of the gov webhook plugin
+type Config struct {
+	URL        string            `json:"url" yaml:"url"`                 // URL the webhook the events posted to
+	Headers    map[string]string `json:"headers" yaml:"headers"`         // Headers the extra headers of the requests, such as the auth token
+	Events     []string          `json:"events" yaml:"events"`           // Events the event types forwarded, DefaultEvents if empty
+	TimeoutSec int               `json:"timeout_sec" yaml:"timeout_sec"` // TimeoutSec the timeout of a request, DefaultTimeoutSec if 0
+	MaxRetries int               `json:"max_retries" yaml:"max_retries"` // MaxRetries the retries of a failed request, DefaultMaxRetries if 0
+	QueueSize  int               `json:"queue_size" yaml:"queue_size"`   // QueueSize the events waiting to post, DefaultQueueSize if 0
+}
+
+// WithDefaults returns the config with the defaults for the fields not set
+func (c Config) WithDefaults() Config {
+	if len(c.Events) == 0 {
+		c.Events = DefaultEvents
+	}
+	if c.TimeoutSec <= 0 {
+		c.TimeoutSec = DefaultTimeoutSec
+	}
+	if c.MaxRetries <= 0 {
+		c.MaxRetries = DefaultMaxRetries
+	}
+	if c.QueueSize <= 0 {
+		c.QueueSize = DefaultQueueSize
+	}
+
+	return c
+}
@@ -0,0 +1,151 @@
+package govWebhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/plugins/gov_webhook/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Notification the body posted to the webhook for an event
+type Notification struct {
+	Type       string            `json:"type"`
+	Height     int64             `json:"height"`
+	Time       time.Time         `json:"time"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// NewNotification creates the notification of the event
+func NewNotification(evt types.Event) Notification {
+	return Notification{
+		Type:       evt.Type,
+		Height:     evt.Height,
+		Time:       evt.Time.UTC(),
+		Attributes: evt.Attributes,
+	}
+}
+
+// webhook posts the notifications to the url in background, so the slow or unreachable webhook
+// never blocks the plugins, the notifications are dropped if the queue is full.
+type webhook struct {
+	cfg    types.Config
+	client *http.Client
+	logger log.Logger
+
+	queue chan Notification
+	quit  chan struct{}
+	wg    sync.WaitGroup
+
+	retryInterval time.Duration
+}
+
+func newWebhook(cfg types.Config, logger log.Logger) *webhook {
+	return &webhook{
+		cfg:           cfg,
+		client:        &http.Client{Timeout: time.Duration(cfg.TimeoutSec) * time.Second},
+		logger:        logger,
+		queue:         make(chan Notification, cfg.QueueSize),
+		quit:          make(chan struct{}),
+		retryInterval: time.Second,
+	}
+}
+
+// Start starts the worker posting the notifications
+func (w *webhook) Start() {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		for {
+			select {
+			case <-w.quit:
+				return
+			case n := <-w.queue:
+				w.deliver(n)
+			}
+		}
+	}()
+}
+
+// Stop stops the worker, the notifications not posted are dropped
+func (w *webhook) Stop() {
+	close(w.quit)
+	w.wg.Wait()
+
+	if n := len(w.queue); n > 0 {
+		w.logger.Error("webhook stopped with notifications not posted", "dropped", n)
+	}
+}
+
+// Push queues the notification to post, returns false if the queue is full
+func (w *webhook) Push(n Notification) bool {
+	select {
+	case w.queue <- n:
+		return true
+	default:
+		w.logger.Error("webhook queue full, notification dropped", "type", n.Type, "height", n.Height)
+		return false
+	}
+}
+
+// deliver posts the notification, retried with the interval increased if failed
+func (w *webhook) deliver(n Notification) {
+	body, err := json.Marshal(n)
+	if err != nil {
+		w.logger.Error("marshal notification failed", "type", n.Type, "err", err)
+		return
+	}
+
+	for attempt := 0; attempt <= w.cfg.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-w.quit:
+				return
+			case <-time.After(time.Duration(attempt) * w.retryInterval):
+			}
+		}
+
+		if err = w.post(body); err == nil {
+			w.logger.Debug("notification posted", "type", n.Type, "height", n.Height)
+			return
+		}
+
+		w.logger.Info("post notification failed", "type", n.Type, "attempt", attempt+1, "err", err)
+	}
+
+	w.logger.Error("notification dropped after retries", "type", n.Type, "height", n.Height, "err", err)
+}
+
+func (w *webhook) post(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, w.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.cfg.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// drain the body to reuse the connection
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package govWebhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/plugins/gov_webhook/types"
+	pluginTypes "github.com/KuChainNetwork/kuchain/plugins/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestWebhook(t *testing.T) {
+	var failures int32 = 2
+	received := make(chan Notification, 4)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "token", r.Header.Get("Authorization"))
+
+		// fail the first requests to test the retries
+		if atomic.AddInt32(&failures, -1) >= 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var n Notification
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&n))
+		received <- n
+	}))
+	defer server.Close()
+
+	cfg := types.Config{URL: server.URL, Headers: map[string]string{"Authorization": "token"}}.WithDefaults()
+	p := &plugin{
+		logger:  log.NewNopLogger(),
+		cfg:     cfg,
+		events:  map[string]bool{"proposal_passed": true},
+		webhook: newWebhook(cfg, log.NewNopLogger()),
+	}
+	p.webhook.retryInterval = time.Millisecond
+
+	p.webhook.Start()
+	defer p.webhook.Stop()
+
+	ctx := pluginTypes.NewContext(log.NewNopLogger())
+	blockTime := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	p.OnEvent(ctx, types.Event{Height: 9, Time: blockTime, Type: "proposal_vote"})
+	p.OnEvent(ctx, types.Event{
+		Height:     10,
+		Time:       blockTime,
+		Type:       "proposal_passed",
+		Attributes: map[string]string{"proposal_id": "1", "proposal_result": "proposal_passed"},
+	})
+
+	select {
+	case n := <-received:
+		require.Equal(t, "proposal_passed", n.Type)
+		require.Equal(t, int64(10), n.Height)
+		require.Equal(t, blockTime, n.Time)
+		require.Equal(t, "1", n.Attributes["proposal_id"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification not received")
+	}
+
+	// the filtered event is not posted
+	select {
+	case n := <-received:
+		t.Fatalf("unexpected notification %s", n.Type)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWebhookQueueFull(t *testing.T) {
+	cfg := types.Config{URL: "http://127.0.0.1:0", QueueSize: 1}.WithDefaults()
+	w := newWebhook(cfg, log.NewNopLogger())
+
+	// not started, so the queue is not consumed
+	require.True(t, w.Push(Notification{Type: "proposal_passed"}))
+	require.False(t, w.Push(Notification{Type: "proposal_rejected"}))
+}
@@ -114,8 +114,8 @@ func (p *Plugins) Start() {
 	}()
 }
 
-func (p *Plugins) EmitEvent(evt sdk.Event) {
-	p.msgChan <- types.NewMsgEvent(evt)
+func (p *Plugins) EmitEvent(ctx sdk.Context, evt sdk.Event) {
+	p.msgChan <- types.NewMsgEvent(ctx, evt)
 }
 
 func (p *Plugins) EmitTx(tx StdTx) {
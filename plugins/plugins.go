@@ -3,8 +3,11 @@ package plugins
 import (
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	dbHistory "github.com/KuChainNetwork/kuchain/plugins/db_history"
+	govAudit "github.com/KuChainNetwork/kuchain/plugins/gov_audit"
+	govWebhook "github.com/KuChainNetwork/kuchain/plugins/gov_webhook"
 	"github.com/KuChainNetwork/kuchain/plugins/test"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 // TODO: use a goroutine
@@ -35,17 +38,32 @@ func initPlugin(ctx Context, cfg BaseCfg, plugins *Plugins) {
 		plugins.RegPlugin(ctx, test.NewTestPlugin(ctx, cfg))
 	case dbHistory.PluginName:
 		plugins.RegPlugin(ctx, dbHistory.New(ctx, cfg))
+	case govAudit.PluginName:
+		plugins.RegPlugin(ctx, govAudit.New(ctx, cfg))
+	case govWebhook.PluginName:
+		plugins.RegPlugin(ctx, govWebhook.New(ctx, cfg))
 	}
 }
 
-// HandleEvent plugins handler Events
+// HandleEvent plugins handler Events, the events in check tx and simulation are ignored
 func HandleEvent(ctx sdk.Context, evts sdk.Events) {
+	if plugins == nil || ctx.IsCheckTx() {
+		return
+	}
+
+	for _, evt := range evts {
+		plugins.EmitEvent(ctx, evt)
+	}
+}
+
+// HandleBlockEvents plugins handler the Events emitted in begin and end block
+func HandleBlockEvents(ctx sdk.Context, evts []abci.Event) {
 	if plugins == nil {
 		return
 	}
 
 	for _, evt := range evts {
-		plugins.EmitEvent(evt)
+		plugins.EmitEvent(ctx, sdk.Event(evt))
 	}
 }
 
@@ -1,16 +1,22 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type Event struct {
+	Height     int64
+	Time       time.Time
 	Type       string
 	Attributes map[string]string
 }
 
-func FromSdkEvent(evt sdk.Event) Event {
+func FromSdkEvent(ctx sdk.Context, evt sdk.Event) Event {
 	res := Event{
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockHeader().Time,
 		Type:       evt.Type,
 		Attributes: make(map[string]string, len(evt.Attributes)),
 	}
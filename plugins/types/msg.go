@@ -11,9 +11,9 @@ type MsgEvent struct {
 }
 
 // NewMsgEvent new msg event
-func NewMsgEvent(evt sdk.Event) *MsgEvent {
+func NewMsgEvent(ctx sdk.Context, evt sdk.Event) *MsgEvent {
 	return &MsgEvent{
-		Evt: FromSdkEvent(evt),
+		Evt: FromSdkEvent(ctx, evt),
 	}
 }
 
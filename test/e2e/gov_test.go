@@ -0,0 +1,152 @@
+package e2e_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/app"
+	"github.com/KuChainNetwork/kuchain/chain/config"
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/network"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	paramproposal "github.com/KuChainNetwork/kuchain/x/params/types/proposal"
+	"github.com/KuChainNetwork/kuchain/x/staking"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+)
+
+const (
+	testDepositPeriod = 30 * time.Second
+	testVotingPeriod  = 30 * time.Second
+)
+
+// the nodes use the bech32 prefixes of the chain
+func init() {
+	config.SealChainConfig()
+}
+
+func newGovNetwork(t *testing.T) *network.Network {
+	cfg := network.DefaultConfig()
+	cdc := app.MakeCodec()
+
+	// shorten the periods of the proposals for test
+	var govGenesis govTypes.GenesisState
+	cdc.MustUnmarshalJSON(cfg.GenesisState[govTypes.ModuleName], &govGenesis)
+	govGenesis.DepositParams.MaxDepositPeriod = testDepositPeriod
+	govGenesis.VotingParams.VotingPeriod = testVotingPeriod
+	cfg.GenesisState[govTypes.ModuleName] = cdc.MustMarshalJSON(govGenesis)
+
+	return network.New(t, cfg)
+}
+
+func queryProposal(val *network.Validator, id uint64) govTypes.Proposal {
+	var proposal govTypes.Proposal
+	So(val.Query(fmt.Sprintf("custom/%s/%s", govTypes.QuerierRoute, govTypes.QueryProposal),
+		govTypes.NewQueryProposalParams(id), &proposal), ShouldBeNil)
+	return proposal
+}
+
+func queryStakingParams(val *network.Validator) stakingTypes.Params {
+	var params stakingTypes.Params
+	So(val.Query(fmt.Sprintf("custom/%s/%s", stakingTypes.QuerierRoute, stakingTypes.QueryParameters),
+		nil, &params), ShouldBeNil)
+	return params
+}
+
+// shouldAllNodes asserts the proposal on each node of the network
+func shouldAllNodes(n *network.Network, id uint64, assert func(val *network.Validator, proposal govTypes.Proposal)) {
+	for _, val := range n.Validators {
+		assert(val, queryProposal(val, id))
+	}
+}
+
+func maxValidatorsChange(maxValidators uint32) govTypes.Content {
+	return paramproposal.NewParameterChangeProposal("max validators", fmt.Sprintf("set max validators to %d", maxValidators),
+		[]paramproposal.ParamChange{
+			paramproposal.NewParamChange(staking.DefaultParamspace, string(stakingTypes.KeyMaxValidators), fmt.Sprintf("%d", maxValidators)),
+		})
+}
+
+func TestGovProposalLifecycle(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the network test in short mode")
+	}
+
+	n := newGovNetwork(t)
+	defer n.Cleanup()
+
+	vals := n.Validators
+	minDeposit := govTypes.DefaultMinDepositTokens
+	halfDeposit := types.NewCoins(types.NewCoin(constants.DefaultBondDenom, minDeposit.QuoRaw(2)))
+
+	Convey("test param change proposals over the network", t, func() {
+		origin := queryStakingParams(vals[0])
+		So(origin.MaxValidators, ShouldBeLessThan, uint32(50))
+
+		// proposal 1 passes in emergency, proposal 2 passes at the end of voting period and proposal 3 is rejected
+		for _, maxValidators := range []uint32{50, 60, 70} {
+			submit := govTypes.NewKuMsgSubmitProposal(vals[0].Auth, maxValidatorsChange(maxValidators), halfDeposit, vals[0].ID)
+			So(n.BroadcastTx(vals[0], submit), ShouldBeNil)
+		}
+
+		shouldAllNodes(n, 1, func(val *network.Validator, proposal govTypes.Proposal) {
+			So(proposal.Status, ShouldEqual, govTypes.StatusDepositPeriod)
+			So(proposal.TotalDeposit, simapp.ShouldEq, halfDeposit)
+		})
+
+		// the proposals enter the voting period when the min deposit reached
+		for _, id := range []uint64{1, 2, 3} {
+			So(n.BroadcastTx(vals[1], govTypes.NewKuMsgDeposit(vals[1].Auth, vals[1].ID, id, halfDeposit)), ShouldBeNil)
+		}
+
+		shouldAllNodes(n, 1, func(val *network.Validator, proposal govTypes.Proposal) {
+			So(proposal.Status, ShouldEqual, govTypes.StatusVotingPeriod)
+			So(proposal.TotalDeposit, simapp.ShouldEq, halfDeposit.Add(halfDeposit...))
+		})
+
+		options := map[uint64][]govTypes.VoteOption{
+			2: {govTypes.OptionYes, govTypes.OptionYes, govTypes.OptionNo, govTypes.OptionAbstain},
+			3: {govTypes.OptionYes, govTypes.OptionNo, govTypes.OptionNo, govTypes.OptionNo},
+		}
+		for id := uint64(2); id <= 3; id++ {
+			for i, val := range vals {
+				So(n.BroadcastTx(val, govTypes.NewKuMsgVote(val.Auth, val.ID, id, options[id][i])), ShouldBeNil)
+			}
+		}
+
+		// the voting period ends once the yes votes of proposal 1 are more than the emergency ratio
+		for _, val := range vals[:3] {
+			So(n.BroadcastTx(val, govTypes.NewKuMsgVote(val.Auth, val.ID, 1, govTypes.OptionYes)), ShouldBeNil)
+		}
+
+		err := n.BroadcastTx(vals[3], govTypes.NewKuMsgVote(vals[3].Auth, vals[3].ID, 1, govTypes.OptionYes))
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, govTypes.ErrInactiveProposal.Error())
+
+		shouldAllNodes(n, 1, func(val *network.Validator, proposal govTypes.Proposal) {
+			So(proposal.Status, ShouldEqual, govTypes.StatusPassed)
+			So(queryStakingParams(val).MaxValidators, ShouldEqual, uint32(50))
+		})
+
+		shouldAllNodes(n, 2, func(val *network.Validator, proposal govTypes.Proposal) {
+			So(proposal.Status, ShouldEqual, govTypes.StatusVotingPeriod)
+		})
+
+		_, err = n.WaitForTime(queryProposal(vals[0], 3).VotingEndTime, testVotingPeriod)
+		So(err, ShouldBeNil)
+
+		shouldAllNodes(n, 2, func(val *network.Validator, proposal govTypes.Proposal) {
+			So(proposal.Status, ShouldEqual, govTypes.StatusPassed)
+			So(queryStakingParams(val).MaxValidators, ShouldEqual, uint32(60))
+		})
+
+		shouldAllNodes(n, 3, func(val *network.Validator, proposal govTypes.Proposal) {
+			So(proposal.Status, ShouldEqual, govTypes.StatusRejected)
+			So(proposal.FinalTallyResult.No.GT(proposal.FinalTallyResult.Yes), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,98 @@
+package gasaudit_test
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/gasaudit"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+)
+
+const goldenFile = "testdata/gas_report.json"
+
+var update = flag.Bool("update", false, "update the golden gas report file")
+
+var (
+	wallet   = simapp.NewWallet()
+	name1    = types.MustName("gasaudit01")
+	name2    = types.MustName("gasaudit02")
+	name3    = types.MustName("gasauditcccc")
+	addr1    = wallet.NewAccAddressByName(name1)
+	addr2    = wallet.NewAccAddressByName(name2)
+	account1 = types.NewAccountIDFromName(name1)
+	account2 = types.NewAccountIDFromName(name2)
+	account3 = types.NewAccountIDFromName(name3)
+)
+
+func deliver(t *testing.T, app *simapp.SimApp, payer types.AccountID, auth types.AccAddress, msg sdk.Msg) {
+	ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+	seq, num, err := app.AccountKeeper().GetAuthSequence(ctx, auth)
+	So(err, ShouldBeNil)
+
+	fee := types.Coins{types.NewInt64Coin(constants.DefaultBondDenom, 100000)}
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = simapp.SignCheckDeliver(
+		t, app.Codec(), app.BaseApp,
+		header, payer, fee,
+		[]sdk.Msg{msg}, []uint64{num}, []uint64{seq},
+		true, true, wallet.PrivKey(auth))
+	So(err, ShouldBeNil)
+}
+
+// TestGasReport delivers a fixed workload of msgs and compares the gas consumed with the golden report,
+// the report should only be updated by `go test ./test/gasaudit/... -update` for the intended gas changes.
+func TestGasReport(t *testing.T) {
+	Convey("test gas report of the workload", t, func() {
+		assets := types.Coins{types.NewInt64Coin(constants.DefaultBondDenom, 10000000000)}
+		genAccs := simapp.NewGenesisAccounts(
+			wallet.GetRootAuth(),
+			simapp.NewSimGenesisAccount(account1, addr1).WithAsset(assets),
+			simapp.NewSimGenesisAccount(account2, addr2).WithAsset(assets))
+
+		recorder := gasaudit.NewRecorder()
+		app := simapp.SetupWithGenesisAccounts(genAccs, gasaudit.SetRecorder(recorder))
+
+		for i := int64(1); i <= 3; i++ {
+			transfer := assetTypes.NewMsgTransfer(addr1, account1, account2, types.NewInt64CoreCoins(i*1000))
+			deliver(t, app, account1, addr1, &transfer)
+		}
+
+		create := accountTypes.NewMsgCreateAccount(addr1, account1, name3, wallet.NewAccAddressByName(name3))
+		deliver(t, app, account1, addr1, &create)
+
+		transfer := assetTypes.NewMsgTransfer(addr2, account2, account3, types.NewInt64CoreCoins(1000))
+		deliver(t, app, account2, addr2, &transfer)
+
+		guardians := accountTypes.NewMsgSetGuardians(addr2, name2, []types.AccountID{account1, account3}, 1)
+		deliver(t, app, account2, addr2, &guardians)
+
+		updateAuth := accountTypes.NewMsgUpdateAccountAuth(addr1, name1, wallet.NewAccAddress())
+		deliver(t, app, account1, addr1, &updateAuth)
+
+		report := recorder.Report()
+		So(report.Msgs, ShouldNotBeEmpty)
+
+		bz, err := json.MarshalIndent(report, "", "  ")
+		So(err, ShouldBeNil)
+
+		if *update {
+			So(ioutil.WriteFile(goldenFile, bz, 0644), ShouldBeNil)
+		}
+
+		// the gas consumed by the msgs should not be changed, or the consensus will be broken
+		golden, err := gasaudit.ReadReport(goldenFile)
+		So(err, ShouldBeNil)
+		So(gasaudit.Compare(golden, report), ShouldBeEmpty)
+		So(len(report.Msgs), ShouldEqual, len(golden.Msgs))
+	})
+}
@@ -0,0 +1,422 @@
+// Package network implements an in-process network of the validators running the kuchain app for the
+// end-to-end tests, each validator runs a full tendermint node connected to the others by p2p, so the
+// txs go through the consensus and the state can be checked on every node.
+//
+// NOTE: the tendermint RPC keeps its environment in the package singletons, so only the first validator
+// starts the RPC server, the txs are broadcast by it and the state of each node is queried by ABCI.
+package network
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	pvm "github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/client/local"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/KuChainNetwork/kuchain/app"
+	"github.com/KuChainNetwork/kuchain/chain/chaos"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/test/simapp/helpers"
+	"github.com/KuChainNetwork/kuchain/x/genutil"
+	stakingexport "github.com/KuChainNetwork/kuchain/x/staking/exported"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+)
+
+// Config the config of the network
+type Config struct {
+	ChainID       string
+	NumValidators int
+	AccountTokens sdk.Int       // the tokens of each validator account in genesis
+	StakingTokens sdk.Int       // the self delegation of each validator in genesis
+	TimeoutCommit time.Duration // the interval between the blocks
+
+	// GenesisState the genesis state of the app, the accounts, assets and gentxs of the validators are set by the network
+	GenesisState simapp.GenesisState
+
+	// Chaos the faults injected into each validator, only works with the chaos build tag
+	Chaos chaos.Config
+
+	// EnableLogging logs the nodes to stdout
+	EnableLogging bool
+}
+
+// DefaultConfig returns a config of the network with 4 validators holding the equal staking power
+func DefaultConfig() Config {
+	return Config{
+		ChainID:       "kuchain-network",
+		NumValidators: 4,
+		AccountTokens: stakingexport.TokensFromConsensusPower(100000),
+		StakingTokens: stakingexport.TokensFromConsensusPower(100),
+		TimeoutCommit: 500 * time.Millisecond,
+		GenesisState:  app.ModuleBasics.DefaultGenesis(),
+		Chaos:         chaos.DefaultConfig(),
+	}
+}
+
+// Validator a validator of the network running a tendermint node and the kuchain app
+type Validator struct {
+	Name    types.Name
+	ID      types.AccountID
+	Auth    types.AccAddress
+	PrivKey crypto.PrivKey
+
+	Dir    string
+	NodeID string
+	PubKey crypto.PubKey // the consensus pubkey
+
+	// RPCClient the client of the RPC, only the first validator has it
+	RPCClient client.Client
+
+	App *app.KuchainApp
+
+	tmCfg     *tmcfg.Config
+	tmNode    *node.Node
+	stopChaos func()
+}
+
+// Network the in-process network of the validators
+type Network struct {
+	T          *testing.T
+	BaseDir    string
+	Config     Config
+	Cdc        *codec.Codec
+	Validators []*Validator
+}
+
+// New creates the network by the config and starts the validators, which is cleaned up by the Cleanup.
+func New(t *testing.T, cfg Config) *Network {
+	baseDir, err := ioutil.TempDir("", "kuchain-network-")
+	if err != nil {
+		t.Fatalf("create base dir error: %s", err)
+	}
+
+	network := &Network{
+		T:          t,
+		BaseDir:    baseDir,
+		Config:     cfg,
+		Cdc:        app.MakeCodec(),
+		Validators: make([]*Validator, 0, cfg.NumValidators),
+	}
+
+	t.Log("starting the network in", baseDir)
+
+	if err := network.init(); err != nil {
+		network.Cleanup()
+		t.Fatalf("init network error: %s", err)
+	}
+
+	if err := network.start(); err != nil {
+		network.Cleanup()
+		t.Fatalf("start network error: %s", err)
+	}
+
+	if _, err := network.WaitForHeight(1); err != nil {
+		network.Cleanup()
+		t.Fatalf("wait for the first block error: %s", err)
+	}
+
+	t.Log("started the network")
+
+	return network
+}
+
+// init creates the files of the validators and the genesis of the network
+func (n *Network) init() error {
+	wallet := simapp.NewWallet()
+	genAccounts := make([]simapp.SimGenesisAccount, 0, n.Config.NumValidators)
+	peers := make([]string, 0, n.Config.NumValidators)
+
+	for i := 0; i < n.Config.NumValidators; i++ {
+		name := types.MustName(fmt.Sprintf("validator%d", i))
+		val := &Validator{
+			Name: name,
+			ID:   types.NewAccountIDFromName(name),
+			Auth: wallet.NewAccAddressByName(name),
+			Dir:  filepath.Join(n.BaseDir, name.String()),
+		}
+		val.PrivKey = wallet.PrivKey(val.Auth)
+
+		tmCfg, err := n.newTendermintConfig(val.Dir, i == 0)
+		if err != nil {
+			return err
+		}
+		val.tmCfg = tmCfg
+
+		if val.NodeID, val.PubKey, err = genutil.InitializeNodeValidatorFiles(tmCfg); err != nil {
+			return errors.Wrapf(err, "init validator files of %s", name)
+		}
+
+		peers = append(peers, fmt.Sprintf("%s@%s", val.NodeID, strings.TrimPrefix(tmCfg.P2P.ListenAddress, "tcp://")))
+		genAccounts = append(genAccounts, simapp.NewSimGenesisAccount(val.ID, val.Auth).
+			WithAsset(types.NewCoins(types.NewCoin(constants.DefaultBondDenom, n.Config.AccountTokens))))
+
+		n.Validators = append(n.Validators, val)
+	}
+
+	for i, val := range n.Validators {
+		others := make([]string, 0, len(peers)-1)
+		others = append(others, peers[:i]...)
+		others = append(others, peers[i+1:]...)
+		val.tmCfg.P2P.PersistentPeers = strings.Join(others, ",")
+
+		tmcfg.WriteConfigFile(filepath.Join(val.tmCfg.RootDir, "config", "config.toml"), val.tmCfg)
+	}
+
+	return n.initGenesis(simapp.NewGenesisAccounts(wallet.GetRootAuth(), genAccounts...))
+}
+
+// newTendermintConfig creates the tendermint config of the validator with the free local ports
+func (n *Network) newTendermintConfig(dir string, enableRPC bool) (*tmcfg.Config, error) {
+	tmCfg := tmcfg.TestConfig()
+	tmCfg.SetRoot(dir)
+	tmCfg.Moniker = filepath.Base(dir)
+
+	for _, sub := range []string{"config", "data"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0755); err != nil {
+			return nil, errors.Wrapf(err, "create dir of %s", tmCfg.Moniker)
+		}
+	}
+
+	p2pAddr, err := freeTCPAddr()
+	if err != nil {
+		return nil, err
+	}
+	tmCfg.P2P.ListenAddress = p2pAddr
+	tmCfg.P2P.AddrBookStrict = false
+	tmCfg.P2P.AllowDuplicateIP = true
+
+	tmCfg.RPC.ListenAddress = ""
+	if enableRPC {
+		rpcAddr, err := freeTCPAddr()
+		if err != nil {
+			return nil, err
+		}
+		tmCfg.RPC.ListenAddress = rpcAddr
+	}
+
+	tmCfg.Consensus.TimeoutCommit = n.Config.TimeoutCommit
+	tmCfg.Consensus.SkipTimeoutCommit = false
+
+	return tmCfg, nil
+}
+
+// initGenesis creates the genesis with the gentxs of the validators and saves it to each validator
+func (n *Network) initGenesis(genAccounts *simapp.GenesisAccounts) error {
+	genesisState := make(simapp.GenesisState, len(n.Config.GenesisState))
+	for module, state := range n.Config.GenesisState {
+		genesisState[module] = state
+	}
+
+	genAccounts.SetGenesisState(n.Cdc, genesisState)
+
+	genTxs := make([]txutil.StdTx, 0, len(n.Validators))
+	for _, val := range n.Validators {
+		createValidator := stakingTypes.NewKuMsgCreateValidator(val.Auth, val.ID, val.PubKey,
+			stakingTypes.NewDescription(val.Name.String(), "", "", "", ""), sdk.NewDecWithPrec(1, 1), val.ID)
+		delegate := stakingTypes.NewKuMsgDelegate(val.Auth, val.ID, val.ID,
+			types.NewCoin(constants.DefaultBondDenom, n.Config.StakingTokens))
+
+		// the txs in genesis are signed with the account number and the sequence of zero
+		genTxs = append(genTxs, helpers.GenTx([]sdk.Msg{createValidator, delegate}, types.Coins{},
+			helpers.DefaultGenTxGas, val.ID, n.Config.ChainID, []uint64{0}, []uint64{0}, val.PrivKey))
+	}
+
+	genesisState, err := genutil.SetGenTxsInAppGenesisState(n.Cdc, genesisState, genTxs)
+	if err != nil {
+		return errors.Wrap(err, "set gentxs to genesis")
+	}
+
+	appState, err := codec.MarshalJSONIndent(n.Cdc, genesisState)
+	if err != nil {
+		return errors.Wrap(err, "marshal genesis state")
+	}
+
+	genDoc := &tmtypes.GenesisDoc{
+		ChainID:     n.Config.ChainID,
+		GenesisTime: tmtime.Now(),
+		AppState:    appState,
+	}
+
+	for _, val := range n.Validators {
+		if err := genDoc.SaveAs(val.tmCfg.GenesisFile()); err != nil {
+			return errors.Wrapf(err, "save genesis of %s", val.Name)
+		}
+	}
+
+	return nil
+}
+
+// start starts the nodes of the validators
+func (n *Network) start() error {
+	for _, val := range n.Validators {
+		logger := log.NewNopLogger()
+		if n.Config.EnableLogging {
+			logger = log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("validator", val.Name.String())
+		}
+
+		injector, err := chaos.NewInjector(n.Config.Chaos, logger.With("module", "chaos"))
+		if err != nil {
+			return errors.Wrap(err, "create chaos injector")
+		}
+
+		val.App = app.NewKuchainApp(logger, injector.WrapDB(dbm.NewMemDB()), nil, true, map[int64]bool{}, val.Dir, false, 0)
+
+		nodeKey, err := p2p.LoadOrGenNodeKey(val.tmCfg.NodeKeyFile())
+		if err != nil {
+			return errors.Wrapf(err, "load node key of %s", val.Name)
+		}
+
+		tmNode, err := node.NewNode(
+			val.tmCfg,
+			pvm.LoadOrGenFilePV(val.tmCfg.PrivValidatorKeyFile(), val.tmCfg.PrivValidatorStateFile()),
+			nodeKey,
+			proxy.NewLocalClientCreator(injector.WrapApplication(val.App)),
+			node.DefaultGenesisDocProviderFunc(val.tmCfg),
+			injector.DBProvider(node.DefaultDBProvider),
+			node.DefaultMetricsProvider(val.tmCfg.Instrumentation),
+			logger.With("module", "node"),
+		)
+		if err != nil {
+			return errors.Wrapf(err, "create node of %s", val.Name)
+		}
+
+		if err := tmNode.Start(); err != nil {
+			return errors.Wrapf(err, "start node of %s", val.Name)
+		}
+
+		val.tmNode = tmNode
+		val.stopChaos = injector.StartPeerDropper(tmNode.Switch())
+
+		if val.tmCfg.RPC.ListenAddress != "" {
+			val.RPCClient = local.New(tmNode)
+		}
+	}
+
+	return nil
+}
+
+// LatestHeight returns the latest height of the network, which is the lowest height of the validators
+func (n *Network) LatestHeight() int64 {
+	var height int64 = -1
+	for _, val := range n.Validators {
+		if h := val.LatestHeight(); height < 0 || h < height {
+			height = h
+		}
+	}
+
+	return height
+}
+
+// WaitForHeight waits until all the validators committed the height, returns the latest height.
+func (n *Network) WaitForHeight(height int64) (int64, error) {
+	return n.WaitForHeightWithTimeout(height, 10*time.Second+time.Duration(height-n.LatestHeight())*5*n.Config.TimeoutCommit)
+}
+
+// WaitForHeightWithTimeout waits until all the validators committed the height in timeout, returns the latest height.
+func (n *Network) WaitForHeightWithTimeout(height int64, timeout time.Duration) (int64, error) {
+	ticker := time.NewTicker(n.Config.TimeoutCommit / 5)
+	defer ticker.Stop()
+
+	deadline := time.After(timeout)
+	for {
+		select {
+		case <-deadline:
+			return n.LatestHeight(), fmt.Errorf("timeout exceeded waiting for height %d", height)
+		case <-ticker.C:
+			if latest := n.LatestHeight(); latest >= height {
+				return latest, nil
+			}
+		}
+	}
+}
+
+// WaitForNextBlock waits until the next block committed by all the validators
+func (n *Network) WaitForNextBlock() error {
+	_, err := n.WaitForHeight(n.LatestHeight() + 1)
+	return err
+}
+
+// WaitForTime waits until the time of the latest block is after t, returns the latest height.
+func (n *Network) WaitForTime(t time.Time, timeout time.Duration) (int64, error) {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		height := n.LatestHeight()
+		if block := n.Validators[0].tmNode.BlockStore().LoadBlockMeta(height); block != nil && block.Header.Time.After(t) {
+			// wait for the other validators to commit the block
+			return n.WaitForHeight(height)
+		}
+
+		time.Sleep(n.Config.TimeoutCommit / 5)
+	}
+
+	return n.LatestHeight(), fmt.Errorf("timeout exceeded waiting for block time %s", t)
+}
+
+// Cleanup stops the validators and removes the files of the network
+func (n *Network) Cleanup() {
+	n.T.Log("cleaning up the network")
+
+	for _, val := range n.Validators {
+		if val.stopChaos != nil {
+			val.stopChaos()
+		}
+
+		if val.tmNode != nil && val.tmNode.IsRunning() {
+			if err := val.tmNode.Stop(); err != nil {
+				n.T.Logf("stop node of %s error: %s", val.Name, err)
+			}
+			val.tmNode.Wait()
+		}
+	}
+
+	if err := os.RemoveAll(n.BaseDir); err != nil {
+		n.T.Logf("remove base dir error: %s", err)
+	}
+}
+
+// LatestHeight returns the latest height committed by the app of the validator
+func (v *Validator) LatestHeight() int64 {
+	if v.tmNode == nil {
+		return 0
+	}
+
+	res, err := v.tmNode.ProxyApp().Query().InfoSync(proxy.RequestInfo)
+	if err != nil {
+		return 0
+	}
+
+	return res.LastBlockHeight
+}
+
+// freeTCPAddr returns a free local tcp address
+func freeTCPAddr() (string, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", errors.Wrap(err, "listen free port")
+	}
+	defer l.Close()
+
+	return fmt.Sprintf("tcp://%s", l.Addr().String()), nil
+}
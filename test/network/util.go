@@ -0,0 +1,88 @@
+package network
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp/helpers"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+	stakingexport "github.com/KuChainNetwork/kuchain/x/staking/exported"
+)
+
+// DefaultFee the fee of the txs broadcast by the network
+var DefaultFee = types.NewCoins(types.NewCoin(constants.DefaultBondDenom, stakingexport.TokensFromConsensusPower(1)))
+
+// BroadcastTx signs the msgs by the validator who pays the fee, and broadcasts the tx by the RPC of the first validator,
+// returns error if the tx is failed in check or deliver.
+func (n *Network) BroadcastTx(val *Validator, msgs ...sdk.Msg) error {
+	auth, err := val.QueryAuth(val.Auth)
+	if err != nil {
+		return err
+	}
+
+	tx := helpers.GenTx(msgs, DefaultFee, helpers.DefaultGenTxGas, val.ID, n.Config.ChainID,
+		[]uint64{auth.GetNumber()}, []uint64{auth.GetSequence()}, val.PrivKey)
+
+	bz, err := n.Cdc.MarshalBinaryLengthPrefixed(tx)
+	if err != nil {
+		return errors.Wrap(err, "marshal tx")
+	}
+
+	res, err := n.Validators[0].RPCClient.BroadcastTxCommit(bz)
+	if err != nil {
+		return errors.Wrap(err, "broadcast tx")
+	}
+
+	if res.CheckTx.IsErr() {
+		return fmt.Errorf("check tx failed: %s", res.CheckTx.Log)
+	}
+
+	if res.DeliverTx.IsErr() {
+		return fmt.Errorf("deliver tx failed: %s", res.DeliverTx.Log)
+	}
+
+	// wait for the other validators to commit the tx
+	_, err = n.WaitForHeight(res.Height)
+	return err
+}
+
+// Query queries the latest state of the validator by the ABCI query, the params and the result are in json.
+func (v *Validator) Query(path string, params, res interface{}) error {
+	var data []byte
+	if params != nil {
+		bz, err := v.App.Codec().MarshalJSON(params)
+		if err != nil {
+			return errors.Wrapf(err, "marshal params of %s", path)
+		}
+		data = bz
+	}
+
+	resp, err := v.tmNode.ProxyApp().Query().QuerySync(abci.RequestQuery{Path: path, Data: data})
+	if err != nil {
+		return errors.Wrapf(err, "query %s", path)
+	}
+
+	if !resp.IsOK() {
+		return fmt.Errorf("query %s failed: %s", path, resp.Log)
+	}
+
+	if res == nil {
+		return nil
+	}
+
+	return errors.Wrapf(v.App.Codec().UnmarshalJSON(resp.Value, res), "unmarshal result of %s", path)
+}
+
+// QueryAuth queries the auth data of the address on the validator
+func (v *Validator) QueryAuth(auth types.AccAddress) (accountTypes.Auth, error) {
+	var res accountTypes.Auth
+	err := v.Query(fmt.Sprintf("custom/%s/%s", accountTypes.QuerierRoute, accountTypes.QueryAuthByAddress),
+		accountTypes.NewQueryAddAuthParams(auth), &res)
+
+	return res, err
+}
@@ -14,25 +14,37 @@ import (
 	tmos "github.com/tendermint/tendermint/libs/os"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/KuChainNetwork/kuchain/app/keepers"
 	"github.com/KuChainNetwork/kuchain/chain/ante"
 	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
 	"github.com/KuChainNetwork/kuchain/chain/constants"
 	"github.com/KuChainNetwork/kuchain/chain/fee"
+	"github.com/KuChainNetwork/kuchain/chain/sdkcompat"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
 	"github.com/KuChainNetwork/kuchain/x/account"
 	"github.com/KuChainNetwork/kuchain/x/asset"
+	"github.com/KuChainNetwork/kuchain/x/attestation"
+	"github.com/KuChainNetwork/kuchain/x/conversion"
 	distr "github.com/KuChainNetwork/kuchain/x/distribution"
 	"github.com/KuChainNetwork/kuchain/x/evidence"
+	"github.com/KuChainNetwork/kuchain/x/feature"
+	"github.com/KuChainNetwork/kuchain/x/feemarket"
 	"github.com/KuChainNetwork/kuchain/x/genutil"
 	"github.com/KuChainNetwork/kuchain/x/gov"
+	"github.com/KuChainNetwork/kuchain/x/insurance"
+	"github.com/KuChainNetwork/kuchain/x/lane"
+	"github.com/KuChainNetwork/kuchain/x/liquidstake"
 	"github.com/KuChainNetwork/kuchain/x/mint"
 	"github.com/KuChainNetwork/kuchain/x/params"
 	paramsclient "github.com/KuChainNetwork/kuchain/x/params/client"
-	paramproposal "github.com/KuChainNetwork/kuchain/x/params/types/proposal"
+	"github.com/KuChainNetwork/kuchain/x/paychan"
 	"github.com/KuChainNetwork/kuchain/x/plugin"
 	"github.com/KuChainNetwork/kuchain/x/slashing"
 	"github.com/KuChainNetwork/kuchain/x/staking"
 	"github.com/KuChainNetwork/kuchain/x/supply"
+	"github.com/KuChainNetwork/kuchain/x/upgrade"
+	upgradeclient "github.com/KuChainNetwork/kuchain/x/upgrade/client"
 )
 
 const appName = "SimApp"
@@ -57,14 +69,27 @@ var (
 		staking.NewAppModuleBasic(),
 		slashing.NewAppModuleBasic(),
 		evidence.NewAppModuleBasic(),
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler),
+		gov.NewAppModuleBasic(
+			paramsclient.ProposalHandler, distr.ProposalHandler, upgradeclient.HaltProposalHandler,
+			upgradeclient.UpgradeProposalHandler, upgradeclient.CancelUpgradeProposalHandler,
+		),
 		mint.NewAppModuleBasic(),
+		paychan.NewAppModuleBasic(),
+		lane.NewAppModuleBasic(),
+		feemarket.NewAppModuleBasic(),
+		feature.NewAppModuleBasic(),
+		attestation.NewAppModuleBasic(),
+		conversion.NewAppModuleBasic(),
+		liquidstake.NewAppModuleBasic(),
+		insurance.NewAppModuleBasic(),
+		upgrade.NewAppModuleBasic(),
 		params.NewAppModuleBasic(),
 		plugin.NewAppModuleBasic(),
 	)
 
 	// maccPerms module account permissions
 	maccPerms = map[string][]string{
+		account.ModuleName:        nil,
 		fee.CollectorName:         nil,
 		distr.ModuleName:          nil,
 		supply.BlackHole:          nil,
@@ -72,6 +97,9 @@ var (
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
 		mint.ModuleName:           {supply.Minter},
+		paychan.ModuleName:        nil,
+		liquidstake.ModuleName:    nil,
+		insurance.ModuleName:      nil,
 	}
 	allowedReceivingModAcc = map[string]bool{
 		distr.ModuleName: true,
@@ -83,6 +111,7 @@ func MakeCodec() *codec.Codec {
 	var cdc = codec.New()
 
 	chainTypes.RegisterCodec(cdc)
+	sdkcompat.RegisterCodec(cdc)
 	ModuleBasics.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
@@ -113,16 +142,7 @@ type SimApp struct {
 	subspaces map[string]params.Subspace
 
 	// keepers
-	accountKeeper  account.Keeper
-	assetKeeper    asset.Keeper
-	supplyKeeper   supply.Keeper
-	distrKeeper    distr.Keeper
-	mintKeeper     mint.Keeper
-	paramsKeeper   params.Keeper
-	stakingKeeper  staking.Keeper
-	slashingKeeper slashing.Keeper
-	evidenceKeeper evidence.Keeper
-	govKeeper      gov.Keeper
+	keepers *keepers.AppKeepers
 
 	// the module manager
 	mm *module.Manager
@@ -130,8 +150,8 @@ type SimApp struct {
 	// simulation manager
 	sm *module.SimulationManager
 
-	// function manager
-	stakingFuncManager staking.FuncManager
+	// the invariants of the modules, asserted every invCheckPeriod blocks
+	invars invarRegistry
 }
 
 // NewSimApp returns a reference to an initialized SimApp.
@@ -146,145 +166,56 @@ func NewSimApp(
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
 
-	keys := sdk.NewKVStoreKeys(
-		bam.MainStoreKey, staking.StoreKey, slashing.StoreKey, evidence.StoreKey, gov.StoreKey,
-		account.StoreKey, asset.StoreKey, supply.StoreKey, params.StoreKey, mint.StoreKey, distr.StoreKey, params.StoreKey,
-	)
-	tKeys := sdk.NewTransientStoreKeys(params.TStoreKey, staking.TStoreKey, params.TStoreKey)
+	b := wiring.NewBuilder(cdc)
+	b.KVStoreKey(bam.MainStoreKey)
 
 	app := &SimApp{
 		BaseApp:        bApp,
 		cdc:            cdc,
 		invCheckPeriod: invCheckPeriod,
-		keys:           keys,
-		tKeys:          tKeys,
-		subspaces:      make(map[string]params.Subspace),
 	}
 
-	// init params keeper and subspaces
-	app.paramsKeeper = params.NewKeeper(cdc, keys[params.StoreKey], tKeys[params.TStoreKey])
-	app.subspaces[account.ModuleName] = app.paramsKeeper.Subspace(account.DefaultParamspace)
-	app.subspaces[distr.ModuleName] = app.paramsKeeper.Subspace(distr.DefaultParamspace)
-	app.subspaces[staking.ModuleName] = app.paramsKeeper.Subspace(staking.DefaultParamspace)
-	app.subspaces[slashing.ModuleName] = app.paramsKeeper.Subspace(slashing.DefaultParamspace)
-	app.subspaces[evidence.ModuleName] = app.paramsKeeper.Subspace(evidence.DefaultParamspace)
-	app.subspaces[mint.ModuleName] = app.paramsKeeper.Subspace(mint.DefaultParamspace)
-	app.subspaces[gov.ModuleName] = app.paramsKeeper.Subspace(gov.DefaultParamspace).WithKeyTable(gov.ParamKeyTable())
-	// add keepers
-	app.accountKeeper = account.NewAccountKeeper(cdc, keys[account.StoreKey])
-	app.assetKeeper = asset.NewAssetKeeper(cdc, keys[asset.StoreKey], app.accountKeeper)
-	app.supplyKeeper = supply.NewKeeper(
-		cdc, keys[supply.StoreKey], app.accountKeeper, app.assetKeeper, maccPerms,
-	)
-
-	stakingKeeper := staking.NewKeeper(
-		app.cdc, keys[staking.StoreKey], app.assetKeeper, app.supplyKeeper, app.subspaces[staking.ModuleName], app.accountKeeper,
-	)
-	app.stakingFuncManager = staking.NewFuncManager()
-
-	app.distrKeeper = distr.NewKeeper(
-		cdc, keys[distr.StoreKey], app.subspaces[distr.ModuleName],
-		app.assetKeeper,
-		&app.stakingKeeper,
-		app.supplyKeeper,
-		app.accountKeeper,
-		fee.CollectorName,
-		app.ModuleAccountAddrs())
-
-	app.slashingKeeper = slashing.NewKeeper(
-		cdc, keys[slashing.StoreKey], &stakingKeeper, app.subspaces[slashing.ModuleName],
-	)
-
-	// create evidence keeper with evidence router
-	evidenceKeeper := evidence.NewKeeper(
-		keys[evidence.StoreKey], app.subspaces[evidence.ModuleName], &stakingKeeper, app.slashingKeeper,
-	)
-	evidenceRouter := evidence.NewRouter()
-
-	app.evidenceKeeper = *evidenceKeeper
-
-	// register the proposal types
-	govRouter := gov.NewRouter()
-	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper))
-	app.govKeeper = gov.NewKeeper(cdc,
-		keys[gov.StoreKey], app.subspaces[gov.ModuleName],
-		app.supplyKeeper, &stakingKeeper, app.distrKeeper, govRouter,
-	)
-
-	// register the staking hooks
-	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
-	app.stakingKeeper = *stakingKeeper.SetHooks(
-		staking.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()),
-	)
-
-	// TODO: register evidence routes
-	evidenceKeeper.SetRouter(evidenceRouter)
-	app.mintKeeper = mint.NewKeeper(
-		cdc, keys[mint.StoreKey], app.subspaces[mint.ModuleName], &app.stakingKeeper,
-		app.supplyKeeper, constants.FeeSystemAccountStr,
-	)
+	// the modules declare the store keys and params subspaces to the builder by creating keepers
+	app.keepers = keepers.NewAppKeepers(b, keepers.Options{
+		MaccPerms:          maccPerms,
+		SkipUpgradeHeights: skipUpgradeHeights,
+		HomePath:           DefaultNodeHome,
+	})
+	app.keys = b.KVStoreKeys()
+	app.tKeys = b.TransientStoreKeys()
+	app.subspaces = b.Subspaces()
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
-	app.mm = module.NewManager(
-		account.NewAppModule(app.accountKeeper, app.assetKeeper),
-		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx, app.stakingFuncManager),
-		asset.NewAppModule(app.accountKeeper, app.assetKeeper),
-		supply.NewAppModule(app.supplyKeeper, app.assetKeeper, app.accountKeeper),
-		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper, app.stakingKeeper),
-		slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.assetKeeper, app.stakingKeeper),
-		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper),
-		mint.NewAppModule(app.mintKeeper, app.supplyKeeper),
-		evidence.NewAppModule(app.evidenceKeeper, app.accountKeeper, app.assetKeeper),
-		gov.NewAppModule(app.govKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper),
-		plugin.NewAppModule(),
-	)
+	app.mm = module.NewManager(app.keepers.AppModules(app.BaseApp.DeliverTx)...)
 
-	// plugin.ModuleName MUST be the last
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName, evidence.ModuleName, plugin.ModuleName)
-	app.mm.SetOrderEndBlockers(staking.ModuleName, gov.ModuleName, plugin.ModuleName)
-
-	// NOTE: The genutils module must occur after staking so that pools are
-	// properly initialized with tokens from genesis accounts.
-	app.mm.SetOrderInitGenesis(
-		account.ModuleName,
-		asset.ModuleName,
-		distr.ModuleName,
-		staking.ModuleName,
-		slashing.ModuleName, evidence.ModuleName, gov.ModuleName,
-		supply.ModuleName,
-		genutil.ModuleName,
-		mint.ModuleName,
-	)
+	app.mm.SetOrderBeginBlockers(keepers.OrderBeginBlockers...)
+	app.mm.SetOrderEndBlockers(keepers.OrderEndBlockers...)
+	app.mm.SetOrderInitGenesis(keepers.OrderInitGenesis...)
 
+	// the msg routes can be disabled by governance, except the gov route itself,
+	// the standard msgs of cosmos-sdk are handled as the KuMsgs they translated to
+	app.SetRouter(sdkcompat.NewRouter(feature.NewRouter(app.Router(), app.keepers.FeatureKeeper, gov.RouterKey)))
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	app.mm.RegisterInvariants(&app.invars)
 
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
 	// NOTE: This is not required for apps that don't use the simulator for fuzz testing
 	// transactions.
-	app.sm = module.NewSimulationManager(
-		account.NewAppModule(app.accountKeeper, app.assetKeeper),
-		supply.NewAppModule(app.supplyKeeper, app.assetKeeper, app.accountKeeper),
-		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper, app.stakingKeeper),
-		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper),
-		slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.assetKeeper, app.stakingKeeper),
-		mint.NewAppModule(app.mintKeeper, app.supplyKeeper),
-		gov.NewAppModule(app.govKeeper, app.accountKeeper, app.assetKeeper, app.supplyKeeper),
-	)
+	app.sm = module.NewSimulationManager(app.keepers.SimulationModules()...)
 
 	app.sm.RegisterStoreDecoders()
 
 	// initialize stores
-	app.MountKVStores(keys)
-	app.MountTransientStores(tKeys)
+	app.MountKVStores(app.keys)
+	app.MountTransientStores(app.tKeys)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
-	app.SetAnteHandler(ante.NewHandler(app.accountKeeper, app.assetKeeper))
+	app.SetAnteHandler(ante.NewHandler(app.keepers.AccountKeeper, app.keepers.AssetKeeper, app.keepers.DistrKeeper, app.keepers.LaneKeeper, app.keepers.FeemarketKeeper, app.keepers.FeatureKeeper, nil))
 
 	app.SetEndBlocker(app.EndBlocker)
 
@@ -310,13 +241,38 @@ func (app *SimApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abc
 
 // EndBlocker application updates every end block
 func (app *SimApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	return app.mm.EndBlock(ctx, req)
+	res := app.mm.EndBlock(ctx, req)
+	res.ConsensusParamUpdates = app.keepers.FeemarketKeeper.BlockParamsUpdate(ctx)
+
+	if app.invCheckPeriod != 0 && ctx.BlockHeight()%int64(app.invCheckPeriod) == 0 {
+		app.AssertInvariants(ctx)
+	}
+
+	return res
+}
+
+// Query handles the tx simulate and the node info queries, other queries are handled by the BaseApp.
+func (app *SimApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+	switch req.Path {
+	case chainTypes.QueryPathSimulate:
+		return chainTypes.HandleSimulateQuery(app.BaseApp, txutil.DefaultTxDecoder(app.cdc), app.cdc, req)
+	case chainTypes.QueryPathNodeInfo:
+		return chainTypes.HandleNodeInfoQuery(chainTypes.NewNodeInfo(app.AppVersion(), app.mm.Modules), app.cdc, req)
+	}
+
+	return app.BaseApp.Query(req)
 }
 
 // InitChainer application update at chain initialization
 func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState GenesisState
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
+
+	// the block max bytes is needed by the block gas limit update to tendermint
+	if req.ConsensusParams != nil && req.ConsensusParams.Block != nil {
+		app.keepers.FeemarketKeeper.SetBlockMaxBytes(ctx, uint64(req.ConsensusParams.Block.MaxBytes))
+	}
+
 	return app.mm.InitGenesis(ctx, genesisState)
 }
 
@@ -327,12 +283,7 @@ func (app *SimApp) LoadHeight(height int64) error {
 
 // ModuleAccountAddrs returns all the app's module account addresses.
 func (app *SimApp) ModuleAccountAddrs() map[string]bool {
-	modAccAddrs := make(map[string]bool)
-	for acc := range maccPerms {
-		modAccAddrs[supply.NewModuleAddress(acc).String()] = true
-	}
-
-	return modAccAddrs
+	return keepers.ModuleAccountAddrs(maccPerms)
 }
 
 // BlacklistedAccAddrs returns all the app's module account addresses black listed for receiving tokens.
@@ -381,38 +332,88 @@ func (app *SimApp) SimulationManager() *module.SimulationManager {
 
 // AccountKeeper get account keeper
 func (app *SimApp) AccountKeeper() *account.Keeper {
-	return &app.accountKeeper
+	return &app.keepers.AccountKeeper
+}
+
+// AuctionKeeper get the keeper of the premium account name auctions
+func (app *SimApp) AuctionKeeper() *account.AuctionKeeper {
+	return &app.keepers.AuctionKeeper
+}
+
+// RecoveryKeeper get the keeper of the account recoveries by guardians
+func (app *SimApp) RecoveryKeeper() *account.RecoveryKeeper {
+	return &app.keepers.RecoveryKeeper
 }
 
 // AccountKeeper get account keeper
 func (app *SimApp) AssetKeeper() *asset.Keeper {
-	return &app.assetKeeper
+	return &app.keepers.AssetKeeper
 }
 
 // SupplyKeeper get account keeper
 func (app *SimApp) SupplyKeeper() *supply.Keeper {
-	return &app.supplyKeeper
+	return &app.keepers.SupplyKeeper
 }
 
 func (app *SimApp) SetSupplyKeeper(sup supply.Keeper) {
-	app.supplyKeeper = sup
+	app.keepers.SupplyKeeper = sup
 }
 
 // MintKeeper get account keeper
 func (app *SimApp) MintKeeper() *mint.Keeper {
-	return &app.mintKeeper
+	return &app.keepers.MintKeeper
 }
 
 func (app *SimApp) StakeKeeper() *staking.Keeper {
-	return &app.stakingKeeper
+	return &app.keepers.StakingKeeper
 }
 
 func (app *SimApp) SlashKeeper() *slashing.Keeper {
-	return &app.slashingKeeper
+	return &app.keepers.SlashingKeeper
+}
+
+func (app *SimApp) DistrKeeper() *distr.Keeper {
+	return &app.keepers.DistrKeeper
 }
 
 func (app *SimApp) GovKeeper() *gov.Keeper {
-	return &app.govKeeper
+	return &app.keepers.GovKeeper
+}
+
+func (app *SimApp) PaychanKeeper() *paychan.Keeper {
+	return &app.keepers.PaychanKeeper
+}
+
+func (app *SimApp) LaneKeeper() *lane.Keeper {
+	return &app.keepers.LaneKeeper
+}
+
+func (app *SimApp) FeeMarketKeeper() *feemarket.Keeper {
+	return &app.keepers.FeemarketKeeper
+}
+
+func (app *SimApp) FeatureKeeper() *feature.Keeper {
+	return &app.keepers.FeatureKeeper
+}
+
+func (app *SimApp) UpgradeKeeper() *upgrade.Keeper {
+	return &app.keepers.UpgradeKeeper
+}
+
+func (app *SimApp) AttestationKeeper() *attestation.Keeper {
+	return &app.keepers.AttestationKeeper
+}
+
+func (app *SimApp) ConversionKeeper() *conversion.Keeper {
+	return &app.keepers.ConversionKeeper
+}
+
+func (app *SimApp) LiquidStakeKeeper() *liquidstake.Keeper {
+	return &app.keepers.LiquidStakeKeeper
+}
+
+func (app *SimApp) InsuranceKeeper() *insurance.Keeper {
+	return &app.keepers.InsuranceKeeper
 }
 
 // GetMaccPerms returns a copy of the module account permissions
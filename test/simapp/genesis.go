@@ -6,8 +6,11 @@ import (
 
 	"github.com/KuChainNetwork/kuchain/chain/constants"
 	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account"
 	accountExported "github.com/KuChainNetwork/kuchain/x/account/exported"
+	"github.com/KuChainNetwork/kuchain/x/asset"
 	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/pkg/errors"
 )
 
@@ -75,3 +78,12 @@ func NewGenesisAccounts(rootAuth types.AccAddress, accounts ...SimGenesisAccount
 
 	return res
 }
+
+// SetGenesisState sets the genesis states of the account and the asset module by the genesis accounts
+func (g *GenesisAccounts) SetGenesisState(cdc *codec.Codec, genesisState GenesisState) {
+	genesisState[account.ModuleName] = cdc.MustMarshalJSON(account.NewGenesisState(g.accounts))
+	genesisState[asset.ModuleName] = cdc.MustMarshalJSON(asset.GenesisState{
+		GenesisAssets: g.assets,
+		GenesisCoins:  g.coins,
+	})
+}
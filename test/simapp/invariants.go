@@ -0,0 +1,39 @@
+package simapp
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// invarRoute is an invariant registered by a module
+type invarRoute struct {
+	ModuleName string
+	Route      string
+	Invar      sdk.Invariant
+}
+
+// invarRegistry collects the invariants of the modules, as the simapp has no crisis module
+type invarRegistry struct {
+	routes []invarRoute
+}
+
+var _ sdk.InvariantRegistry = (*invarRegistry)(nil)
+
+// RegisterRoute implements sdk.InvariantRegistry
+func (ir *invarRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	ir.routes = append(ir.routes, invarRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invar:      invar,
+	})
+}
+
+// AssertInvariants asserts all the invariants registered by the modules, panics if any is broken
+func (app *SimApp) AssertInvariants(ctx sdk.Context) {
+	for _, r := range app.invars.routes {
+		if res, stop := r.Invar(ctx); stop {
+			panic(fmt.Errorf("invariant %s/%s broken: %s", r.ModuleName, r.Route, res))
+		}
+	}
+}
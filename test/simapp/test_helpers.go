@@ -13,8 +13,6 @@ import (
 	"github.com/KuChainNetwork/kuchain/chain/constants"
 	"github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/test/simapp/helpers"
-	"github.com/KuChainNetwork/kuchain/x/account"
-	"github.com/KuChainNetwork/kuchain/x/asset"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -47,24 +45,15 @@ func Setup(isCheckTx bool) *SimApp {
 }
 
 // SetupWithGenesisAccounts initializes a new SimApp with the passed in
-// genesis accounts.
-func SetupWithGenesisAccounts(genAccs *GenesisAccounts) *SimApp {
+// genesis accounts and the options of the base app.
+func SetupWithGenesisAccounts(genAccs *GenesisAccounts, baseAppOptions ...func(*bam.BaseApp)) *SimApp {
 	db := dbm.NewMemDB()
-	app := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0)
+	app := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0, baseAppOptions...)
 
 	// initialize the chain with the passed in genesis accounts
 	genesisState := NewDefaultGenesisState()
 
-	accountGenesis := account.NewGenesisState(genAccs.accounts)
-	accountGenesisStateBz := app.Codec().MustMarshalJSON(accountGenesis)
-	genesisState[account.ModuleName] = accountGenesisStateBz
-
-	assetGenesis := asset.GenesisState{
-		GenesisAssets: genAccs.assets,
-		GenesisCoins:  genAccs.coins,
-	}
-	assetGenesisStateBz := app.Codec().MustMarshalJSON(assetGenesis)
-	genesisState[asset.ModuleName] = assetGenesisStateBz
+	genAccs.SetGenesisState(app.Codec(), genesisState)
 
 	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), genesisState)
 	if err != nil {
@@ -99,14 +88,14 @@ func AddTestAddress(app *SimApp, ctx sdk.Context, accNum int, accAmt sdk.Int) []
 	initCoins := types.NewCoins(types.NewCoin(constants.DefaultBondDenom, accAmt))
 	totalSupply := types.NewCoin(constants.DefaultBondDenom, accAmt.MulRaw(int64(len(testAddrs))))
 
-	if err := app.assetKeeper.Issue(ctx, constants.SystemAccount, constants.DefaultBondSymbolName, totalSupply); err != nil {
+	if err := app.keepers.AssetKeeper.Issue(ctx, constants.SystemAccount, constants.DefaultBondSymbolName, totalSupply); err != nil {
 		panic(err)
 	}
 
 	// fill all the addresses with some coins, set the loose pool tokens simultaneously
 	for _, addr := range testAddrs {
 		addrID := types.NewAccountIDFromAccAdd(addr)
-		err := app.assetKeeper.Transfer(ctx, constants.SystemAccountID, addrID, initCoins)
+		err := app.keepers.AssetKeeper.Transfer(ctx, constants.SystemAccountID, addrID, initCoins)
 		if err != nil {
 			panic(err)
 		}
@@ -116,7 +105,7 @@ func AddTestAddress(app *SimApp, ctx sdk.Context, accNum int, accAmt sdk.Int) []
 
 // CheckBalance checks the balance of an account.
 func CheckBalance(t *testing.T, app *SimApp, id types.AccountID, exp types.Coins) {
-	res, err := app.assetKeeper.GetCoins(app.NewTestContext(), id)
+	res, err := app.keepers.AssetKeeper.GetCoins(app.NewTestContext(), id)
 
 	if err != nil {
 		panic(err)
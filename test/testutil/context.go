@@ -0,0 +1,33 @@
+package testutil
+
+import (
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// NewContext creates a context with only the stores of keys mounted,
+// for the keeper unit tests with the mocked keepers, which no need the app.
+func NewContext(header abci.Header, keys ...sdk.StoreKey) sdk.Context {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+
+	for _, key := range keys {
+		switch key.(type) {
+		case *sdk.KVStoreKey:
+			ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+		case *sdk.TransientStoreKey:
+			ms.MountStoreWithDB(key, sdk.StoreTypeTransient, db)
+		default:
+			panic("unknown store key type")
+		}
+	}
+
+	if err := ms.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	return sdk.NewContext(ms, header, false, log.NewNopLogger())
+}
@@ -0,0 +1,124 @@
+package vectors
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	attestationTypes "github.com/KuChainNetwork/kuchain/x/attestation/types"
+	conversionTypes "github.com/KuChainNetwork/kuchain/x/conversion/types"
+	distrTypes "github.com/KuChainNetwork/kuchain/x/distribution/types"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	insuranceTypes "github.com/KuChainNetwork/kuchain/x/insurance/types"
+	liquidstakeTypes "github.com/KuChainNetwork/kuchain/x/liquidstake/types"
+	paychanTypes "github.com/KuChainNetwork/kuchain/x/paychan/types"
+	slashingTypes "github.com/KuChainNetwork/kuchain/x/slashing/types"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+)
+
+// the accounts and coins used in the msgs of the vectors
+var (
+	signer    = types.MustAccountID("alice@ok")
+	receiver  = types.MustAccountID("bob@ok")
+	validator = types.MustAccountID("validator@ok")
+
+	creator = types.MustName("foo")
+	symbol  = types.MustName("coin")
+
+	coreCoin  = types.NewInt64CoreCoin(1000)
+	coreCoins = types.NewInt64CoreCoins(1000)
+	fooCoin   = types.NewCoin(types.CoinDenom(creator, symbol), sdk.NewInt(1000))
+)
+
+type msgCase struct {
+	name string
+	msg  sdk.Msg
+}
+
+// msgCases returns a msg of each KuMsg type, the order of the cases should not be changed,
+// as the sequence of the vectors is the index of the case.
+func msgCases(auth sdk.AccAddress) []msgCase {
+	consPubKey := ed25519.GenPrivKeyFromSecret([]byte(keySecret)).PubKey()
+	commission := sdk.NewDecWithPrec(1, 1)
+	weightedOptions := govTypes.WeightedVoteOptions{
+		govTypes.NewWeightedVoteOption(govTypes.OptionYes, sdk.NewDecWithPrec(7, 1)),
+		govTypes.NewWeightedVoteOption(govTypes.OptionNo, sdk.NewDecWithPrec(3, 1)),
+	}
+	textProposal := govTypes.NewTextProposal("title", "description")
+	memoKey, _ := types.DeriveMemoKey([]byte(keySecret))
+
+	return []msgCase{
+		// account
+		{"account/create", accountTypes.NewMsgCreateAccount(auth, signer, types.MustName("carol"), auth)},
+		{"account/update-auth", accountTypes.NewMsgUpdateAccountAuth(auth, types.MustName("alice"), auth)},
+		{"account/deactivate", accountTypes.NewMsgDeactivateAccount(auth, types.MustName("bob"), signer, "lost key")},
+		{"account/reactivate", accountTypes.NewMsgReactivateAccount(auth, signer, types.MustName("bob"), auth)},
+
+		// asset
+		{"asset/transfer", assetTypes.NewMsgTransfer(auth, signer, receiver, coreCoins)},
+		{"asset/create", assetTypes.NewMsgCreate(auth, creator, symbol, types.NewCoin(fooCoin.Denom, sdk.NewInt(1000000)),
+			true, true, 0, fooCoin, []byte("test coin"))},
+		{"asset/issue", assetTypes.NewMsgIssue(auth, creator, symbol, fooCoin)},
+		{"asset/burn", assetTypes.NewMsgBurn(auth, signer, fooCoin)},
+		{"asset/lock", assetTypes.NewMsgLockCoin(auth, signer, coreCoins, 100)},
+		{"asset/unlock", assetTypes.NewMsgUnlockCoin(auth, signer, coreCoins)},
+		{"asset/approve-issuance", assetTypes.NewMsgApproveIssuance(auth, signer, 1)},
+		{"asset/reject-issuance", assetTypes.NewMsgRejectIssuance(auth, signer, 1)},
+		{"asset/set-allow-list-only", assetTypes.NewMsgSetAllowListOnly(auth, creator, symbol, true)},
+		{"asset/add-to-allow-list", assetTypes.NewMsgAddToAllowList(auth, creator, symbol, receiver)},
+		{"asset/remove-from-allow-list", assetTypes.NewMsgRemoveFromAllowList(auth, creator, symbol, receiver)},
+		{"asset/create-clawback-grant", assetTypes.NewMsgCreateClawbackGrant(auth, auth, signer, receiver, coreCoins, 100)},
+		{"asset/clawback", assetTypes.NewMsgClawback(auth, signer, receiver)},
+
+		// attestation
+		{"attestation/attest", attestationTypes.NewKuMsgAttest(auth, signer, receiver, types.MustName("kyc"), "passed", 100)},
+		{"attestation/revoke", attestationTypes.NewKuMsgRevokeAttestation(auth, signer, receiver, types.MustName("kyc"))},
+
+		// conversion
+		{"conversion/convert", conversionTypes.NewKuMsgConvert(auth, signer, fooCoin)},
+
+		// distribution
+		{"distribution/set-withdraw-account", distrTypes.NewMsgSetWithdrawAccountId(auth, signer, receiver)},
+		{"distribution/withdraw-delegator-reward", distrTypes.NewMsgWithdrawDelegatorReward(auth, signer, validator)},
+		{"distribution/withdraw-validator-commission", distrTypes.NewMsgWithdrawValidatorCommission(auth, validator)},
+		{"distribution/fund-community-pool", distrTypes.NewMsgFundCommunityPool(auth, coreCoins, signer)},
+
+		// gov
+		{"gov/submit-proposal", govTypes.NewKuMsgSubmitProposal(auth, textProposal, coreCoins, signer)},
+		{"gov/submit-expedited-proposal", govTypes.NewKuMsgSubmitExpeditedProposal(auth, textProposal, coreCoins, signer)},
+		{"gov/deposit", govTypes.NewKuMsgDeposit(auth, signer, 1, coreCoins)},
+		{"gov/vote", govTypes.NewKuMsgVote(auth, signer, 1, govTypes.OptionYes)},
+		{"gov/vote-weighted", govTypes.NewKuMsgVoteWeighted(auth, signer, 1, weightedOptions)},
+		{"gov/cancel-proposal", govTypes.NewKuMsgCancelProposal(auth, signer, 1)},
+		{"gov/unjail", govTypes.NewMsgGovUnjail(auth, validator)},
+
+		// insurance
+		{"insurance/buy-policy", insuranceTypes.NewKuMsgBuyPolicy(auth, signer, validator, coreCoin)},
+		{"insurance/claim", insuranceTypes.NewKuMsgClaim(auth, signer, 1)},
+
+		// liquidstake
+		{"liquidstake/stake", liquidstakeTypes.NewKuMsgLiquidStake(auth, signer, validator, coreCoin)},
+		{"liquidstake/redeem", liquidstakeTypes.NewKuMsgRedeem(auth, signer, fooCoin)},
+
+		// paychan
+		{"paychan/open", paychanTypes.NewKuMsgOpenChannel(auth, signer, receiver, coreCoins, 100)},
+		{"paychan/close", paychanTypes.NewKuMsgCloseChannel(auth, signer, 1, nil)},
+
+		// slashing
+		{"slashing/unjail", slashingTypes.NewKuMsgUnjail(auth, validator)},
+
+		// staking
+		{"staking/create-validator", stakingTypes.NewKuMsgCreateValidator(auth, validator, consPubKey,
+			stakingTypes.NewDescription("validator", "", "", "", ""), commission, signer)},
+		{"staking/edit-validator", stakingTypes.NewKuMsgEditValidator(auth, validator,
+			stakingTypes.NewDescription("validator", "", "", "", ""), &commission, nil, nil)},
+		{"staking/delegate", stakingTypes.NewKuMsgDelegate(auth, signer, validator, coreCoin)},
+		{"staking/redelegate", stakingTypes.NewKuMsgRedelegate(auth, signer, validator, receiver, coreCoin)},
+		{"staking/unbond", stakingTypes.NewKuMsgUnbond(auth, signer, validator, coreCoin)},
+
+		// the cases added later are appended to keep the sequences of the former vectors
+		{"account/set-memo-key", accountTypes.NewMsgSetMemoKey(auth, types.MustName("alice"), memoKey)},
+	}
+}
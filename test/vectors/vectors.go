@@ -0,0 +1,137 @@
+// Package vectors contains the canonical sign doc test vectors of the KuMsg types,
+// for the hardware wallets and the third-party SDKs to check the sign bytes and the
+// signatures they produce are compatible with the chain.
+package vectors
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+const (
+	// ChainID the chain id of the test vectors
+	ChainID = "kuchain-vectors"
+
+	// keySecret the secret to derive the private key signing the test vectors,
+	// the secp256k1 signatures are deterministic (RFC6979), so the vectors are reproducible
+	keySecret = "kuchain canonical test vectors"
+)
+
+// PrivKey returns the private key signing the test vectors, never use it for real funds
+func PrivKey() crypto.PrivKey {
+	return secp256k1.GenPrivKeySecp256k1([]byte(keySecret))
+}
+
+// Vector is a golden sign doc test vector, the inputs of the tx, the canonical json bytes
+// to sign and the signature of the bytes by the key of the vectors.
+type Vector struct {
+	Name          string        `json:"name" yaml:"name"`
+	ChainID       string        `json:"chain_id" yaml:"chain_id"`
+	AccountNumber uint64        `json:"account_number" yaml:"account_number"`
+	Sequence      uint64        `json:"sequence" yaml:"sequence"`
+	Fee           types.StdFee  `json:"fee" yaml:"fee"`
+	Memo          string        `json:"memo" yaml:"memo"`
+	Msgs          []sdk.Msg     `json:"msgs" yaml:"msgs"`
+	SignDoc       string        `json:"sign_doc" yaml:"sign_doc"`
+	PubKey        crypto.PubKey `json:"pub_key" yaml:"pub_key"`
+	Signature     []byte        `json:"signature" yaml:"signature"`
+}
+
+// NewVector creates the vector of the msgs, the sign doc is signed by the key
+func NewVector(name string, accountNumber, sequence uint64, fee types.StdFee, memo string, msgs []sdk.Msg, key crypto.PrivKey) (Vector, error) {
+	signDoc := types.StdSignBytes(ChainID, accountNumber, sequence, fee, msgs, memo)
+
+	sig, err := key.Sign(signDoc)
+	if err != nil {
+		return Vector{}, err
+	}
+
+	return Vector{
+		Name:          name,
+		ChainID:       ChainID,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+		Fee:           fee,
+		Memo:          memo,
+		Msgs:          msgs,
+		SignDoc:       string(signDoc),
+		PubKey:        key.PubKey(),
+		Signature:     sig,
+	}, nil
+}
+
+// Verify checks the sign doc of the vector is the canonical sign bytes of the inputs,
+// and the signature is signed on the sign doc by the public key.
+func (v Vector) Verify() error {
+	signDoc := types.StdSignBytes(v.ChainID, v.AccountNumber, v.Sequence, v.Fee, v.Msgs, v.Memo)
+	if !bytes.Equal(signDoc, []byte(v.SignDoc)) {
+		return fmt.Errorf("vector %s: sign doc mismatch\nexpected: %s\ngot:      %s", v.Name, signDoc, v.SignDoc)
+	}
+
+	if v.PubKey == nil {
+		return fmt.Errorf("vector %s: no public key", v.Name)
+	}
+
+	if !v.PubKey.VerifyBytes(signDoc, v.Signature) {
+		return fmt.Errorf("vector %s: invalid signature", v.Name)
+	}
+
+	return nil
+}
+
+// Vectors is a collection of the test vectors
+type Vectors []Vector
+
+// Verify verifies all the vectors, returns the first error
+func (vs Vectors) Verify() error {
+	for _, v := range vs {
+		if err := v.Verify(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Generate generates the vectors of all the KuMsg types, signed by the key of the vectors,
+// the chain config should be sealed before, as the addresses are encoded by the bech32 prefixes.
+func Generate() (Vectors, error) {
+	key := PrivKey()
+	auth := sdk.AccAddress(key.PubKey().Address())
+	fee := types.NewStdFee(200000, signer, types.NewInt64CoreCoins(100000))
+
+	cases := msgCases(auth)
+	vectors := make(Vectors, 0, len(cases))
+	for i, c := range cases {
+		v, err := NewVector(c.name, 1, uint64(i), fee, "", []sdk.Msg{c.msg}, key)
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, v)
+	}
+
+	return vectors, nil
+}
+
+// Load loads the vectors from the json file
+func Load(cdc *codec.Codec, path string) (Vectors, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var vectors Vectors
+	if err := cdc.UnmarshalJSON(bz, &vectors); err != nil {
+		return nil, fmt.Errorf("unmarshal vectors from %s: %w", path, err)
+	}
+
+	return vectors, nil
+}
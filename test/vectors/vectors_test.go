@@ -0,0 +1,59 @@
+package vectors_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/app"
+	"github.com/KuChainNetwork/kuchain/chain/config"
+	"github.com/KuChainNetwork/kuchain/test/vectors"
+)
+
+const goldenFile = "testdata/vectors.json"
+
+var update = flag.Bool("update", false, "update the golden vectors file")
+
+// the addresses in the vectors use the bech32 prefixes of the chain
+func init() {
+	config.SealChainConfig()
+}
+
+func TestVectors(t *testing.T) {
+	cdc := app.MakeCodec()
+
+	Convey("test generated vectors", t, func() {
+		generated, err := vectors.Generate()
+		So(err, ShouldBeNil)
+		So(generated.Verify(), ShouldBeNil)
+
+		bz, err := cdc.MarshalJSONIndent(generated, "", "  ")
+		So(err, ShouldBeNil)
+
+		if *update {
+			So(ioutil.WriteFile(goldenFile, bz, 0644), ShouldBeNil)
+		}
+
+		// the sign bytes of the msgs should not be changed, or the wallets will be broken
+		golden, err := ioutil.ReadFile(goldenFile)
+		So(err, ShouldBeNil)
+		So(string(bz), ShouldEqual, string(golden))
+	})
+
+	Convey("test golden vectors", t, func() {
+		golden, err := vectors.Load(cdc, goldenFile)
+		So(err, ShouldBeNil)
+		So(golden, ShouldNotBeEmpty)
+		So(golden.Verify(), ShouldBeNil)
+
+		tampered := golden[0]
+		tampered.Memo = "tampered"
+		So(tampered.Verify(), ShouldNotBeNil)
+
+		tampered = golden[0]
+		tampered.Signature = golden[1].Signature
+		So(tampered.Verify(), ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,44 @@
+package account
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker rotates the auths of the accounts whose recoveries reach the unlock height,
+// and drops the recoveries expired without the approvals, then activates the pending auths
+// which reach the active height.
+func BeginBlocker(ctx sdk.Context, ak Keeper, rk RecoveryKeeper) {
+	logger := ak.Logger(ctx)
+
+	ak.IterateRecoveryQueue(ctx, ctx.BlockHeight(), func(recovery Recovery) bool {
+		if err := rk.ProcessRecovery(ctx, recovery); err != nil {
+			panic(err)
+		}
+
+		logger.Info("account recovery processed", "name", recovery.Account, "approved", recovery.IsApproved())
+		return false
+	})
+
+	ak.IteratePendingAuthQueue(ctx, ctx.BlockHeight(), func(pending PendingAuth) bool {
+		if err := rk.ProcessAuthRotation(ctx, pending); err != nil {
+			panic(err)
+		}
+
+		logger.Info("account auth rotation processed", "name", pending.Account, "auth", pending.Auth)
+		return false
+	})
+}
+
+// EndBlocker settles the auctions of the premium names which reach the end height
+func EndBlocker(ctx sdk.Context, ak Keeper, auk AuctionKeeper) {
+	logger := ak.Logger(ctx)
+
+	ak.IterateAuctionQueue(ctx, ctx.BlockHeight(), func(auction NameAuction) bool {
+		if err := auk.SettleAuction(ctx, auction); err != nil {
+			panic(err)
+		}
+
+		logger.Info("name auction settled", "name", auction.Name, "type", auction.Type)
+		return false
+	})
+}
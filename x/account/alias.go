@@ -6,23 +6,34 @@ import (
 )
 
 const (
-	ModuleName        = types.ModuleName
-	StoreKey          = types.StoreKey
-	QuerierRoute      = types.QuerierRoute
-	DefaultParamspace = types.DefaultParamspace
-	RouterKey         = types.RouterKey
+	ModuleName         = types.ModuleName
+	StoreKey           = types.StoreKey
+	QuerierRoute       = types.QuerierRoute
+	DefaultParamspace  = types.DefaultParamspace
+	RecoveryParamspace = types.RecoveryParamspace
+	RouterKey          = types.RouterKey
 )
 
 type (
-	Keeper       = keeper.AccountKeeper
-	GenesisState = types.GenesisState
+	Keeper         = keeper.AccountKeeper
+	AuctionKeeper  = keeper.AuctionKeeper
+	RecoveryKeeper = keeper.RecoveryKeeper
+	GenesisState   = types.GenesisState
+	NameAuction    = types.NameAuction
+	Recovery       = types.Recovery
+	PendingAuth    = types.PendingAuth
+	NameOffer      = types.NameOffer
 )
 
 var (
 	NewAccountKeeper    = keeper.NewAccountKeeper
 	NewQuerier          = keeper.NewQuerier
+	NewAuctionKeeper    = keeper.NewAuctionKeeper
+	NewAuctionQuerier   = keeper.NewAuctionQuerier
+	NewRecoveryKeeper   = keeper.NewRecoveryKeeper
 	NewKuAccount        = types.NewKuAccount
 	DefaultGenesisState = types.DefaultGenesisState
 	NewGenesisState     = types.NewGenesisState
 	ModuleCdc           = types.ModuleCdc
+	ModuleAccountID     = types.ModuleAccountID
 )
@@ -0,0 +1,247 @@
+package account_test
+
+import (
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+)
+
+const (
+	testAuctionDuration       = 10
+	testAuctionRevealDuration = 10
+)
+
+func createAppForAuctionTest() *simapp.SimApp {
+	assets := types.Coins{
+		types.NewInt64Coin(constants.DefaultBondDenom, 10000000000)}
+	genAccs := simapp.NewGenesisAccounts(
+		wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(assets),
+		simapp.NewSimGenesisAccount(account2, addr2).WithAsset(assets))
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	// shorten the auction duration in a block for test
+	header := abci.Header{Height: app.LastBlockHeight() + 1, Time: time.Now()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.AuctionKeeper().SetAuctionParams(app.BaseApp.NewContext(false, header), accountTypes.NewAuctionParams(
+		testAuctionDuration, testAuctionRevealDuration, bidCoin(1000), accountTypes.DefaultAuctionMinIncrement))
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	return app
+}
+
+// endAuctionBids commits the blocks until the reveals of the sealed auction of the name started
+func endAuctionBids(app *simapp.SimApp, name types.Name) {
+	auction, ok := app.AccountKeeper().GetAuction(app.NewTestContext(), name)
+	So(ok, ShouldBeTrue)
+	simapp.AfterBlockCommitted(app, int(auction.EndHeight+1-app.LastBlockHeight()))
+}
+
+// settleAuction commits the blocks until the auction of the name settled
+func settleAuction(app *simapp.SimApp, name types.Name) {
+	auction, ok := app.AccountKeeper().GetAuction(app.NewTestContext(), name)
+	So(ok, ShouldBeTrue)
+	simapp.AfterBlockCommitted(app, int(auction.SettleHeight()-app.LastBlockHeight()))
+}
+
+// sealedBid returns the msg to bid in the sealed auction of the name by the commitment of the amount
+func sealedBid(auth types.AccAddress, bidder types.AccountID, name types.Name, deposit, amount int64, salt string) accountTypes.MsgAuctionBid {
+	commitment := accountTypes.AuctionBidCommitment(name, bidder, bidCoin(amount), salt)
+	return accountTypes.NewMsgSealedAuctionBid(auth, bidder, name, bidCoin(deposit), commitment)
+}
+
+func bidCoin(amount int64) types.Coin {
+	return types.NewInt64Coin(constants.DefaultBondDenom, amount)
+}
+
+func TestNameAuction(t *testing.T) {
+	name := types.MustName("vip")
+	newAuth := wallet.NewAccAddress()
+
+	Convey("only premium names can be auctioned", t, func() {
+		msg := accountTypes.NewMsgStartAuction(addr1, account1, name2, accountTypes.AuctionTypeSealed)
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrAuctionNameNotPremium)
+
+		msg = accountTypes.NewMsgStartAuction(addr1, account1, name, "dutch")
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrAuctionTypeInvalid)
+	})
+
+	Convey("sealed auction settled by the second highest bid", t, func() {
+		app := createAppForAuctionTest()
+
+		start := accountTypes.NewMsgStartAuction(addr1, account1, name, accountTypes.AuctionTypeSealed)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &start), ShouldBeNil)
+		So(deliverAccountMsg(t, app, account1, addr1, false, &start), simapp.ShouldErrIs, accountTypes.ErrAuctionHasStarted)
+
+		create := accountTypes.NewMsgCreateAccount(wallet.GetRootAuth(), constants.SystemAccountID, name, newAuth)
+		So(deliverAccountMsg(t, app, constants.SystemAccountID, wallet.GetRootAuth(), false, &create),
+			simapp.ShouldErrIs, accountTypes.ErrAccountNameReserved)
+
+		tooLow := sealedBid(addr1, account1, name, 999, 999, "salt1")
+		So(deliverAccountMsg(t, app, account1, addr1, false, &tooLow), simapp.ShouldErrIs, accountTypes.ErrAuctionBidTooLow)
+
+		// the bids of the sealed auction should be committed
+		public := accountTypes.NewMsgAuctionBid(addr1, account1, name, bidCoin(5000))
+		So(deliverAccountMsg(t, app, account1, addr1, false, &public), simapp.ShouldErrIs, accountTypes.ErrAuctionBidCommitmentInvalid)
+
+		bid1 := sealedBid(addr1, account1, name, 6000, 5000, "salt1")
+		So(deliverAccountMsg(t, app, account1, addr1, true, &bid1), ShouldBeNil)
+		So(deliverAccountMsg(t, app, account1, addr1, false, &bid1), simapp.ShouldErrIs, accountTypes.ErrAuctionHasBid)
+
+		bid2 := sealedBid(addr2, account2, name, 3000, 3000, "salt2")
+		So(deliverAccountMsg(t, app, account2, addr2, true, &bid2), ShouldBeNil)
+
+		// only the commitments and the deposits are public before revealed
+		ctx := app.NewTestContext()
+		bids := app.AccountKeeper().GetAuctionBids(ctx, name)
+		So(bids, ShouldHaveLength, 2)
+		for _, bid := range bids {
+			So(bid.Revealed, ShouldBeFalse)
+			So(bid.Amount.IsZero(), ShouldBeTrue)
+		}
+		So(app.AssetKeeper().GetCoinPowers(ctx, accountTypes.ModuleAccountID).IsEqual(types.NewCoins(bidCoin(9000))), ShouldBeTrue)
+
+		reveal1 := accountTypes.NewMsgRevealAuctionBid(addr1, account1, name, bidCoin(5000), "salt1")
+		So(deliverAccountMsg(t, app, account1, addr1, false, &reveal1), simapp.ShouldErrIs, accountTypes.ErrAuctionNotRevealing)
+
+		endAuctionBids(app, name)
+
+		late := sealedBid(addr2, account2, name, 8000, 8000, "salt3")
+		So(deliverAccountMsg(t, app, account2, addr2, false, &late), simapp.ShouldErrIs, accountTypes.ErrAuctionEnded)
+
+		wrong := accountTypes.NewMsgRevealAuctionBid(addr1, account1, name, bidCoin(5000), "salt2")
+		So(deliverAccountMsg(t, app, account1, addr1, false, &wrong), simapp.ShouldErrIs, accountTypes.ErrAuctionBidCommitmentInvalid)
+
+		So(deliverAccountMsg(t, app, account1, addr1, true, &reveal1), ShouldBeNil)
+		So(deliverAccountMsg(t, app, account1, addr1, false, &reveal1), simapp.ShouldErrIs, accountTypes.ErrAuctionBidRevealed)
+
+		reveal2 := accountTypes.NewMsgRevealAuctionBid(addr2, account2, name, bidCoin(3000), "salt2")
+		So(deliverAccountMsg(t, app, account2, addr2, true, &reveal2), ShouldBeNil)
+
+		claim := accountTypes.NewMsgClaimAuction(addr1, account1, name, newAuth)
+		So(deliverAccountMsg(t, app, account1, addr1, false, &claim), simapp.ShouldErrIs, accountTypes.ErrAuctionNotSettled)
+
+		ctx = app.NewTestContext()
+		powers1 := app.AssetKeeper().GetCoinPowers(ctx, account1)
+		powers2 := app.AssetKeeper().GetCoinPowers(ctx, account2)
+		pool := app.DistrKeeper().GetFeePoolCommunityCoins(ctx)
+		settleAuction(app, name)
+
+		ctx = app.NewTestContext()
+		auction, ok := app.AccountKeeper().GetAuction(ctx, name)
+		So(ok, ShouldBeTrue)
+		So(auction.Settled, ShouldBeTrue)
+		So(auction.Bidder, simapp.ShouldEq, account1)
+		So(auction.Price.IsEqual(bidCoin(3000)), ShouldBeTrue)
+		So(app.AccountKeeper().GetAuctionBids(ctx, name), ShouldBeEmpty)
+
+		// the loser and the excess of the winner's deposit are refunded, the price goes to the community pool
+		So(app.AssetKeeper().GetCoinPowers(ctx, accountTypes.ModuleAccountID).IsZero(), ShouldBeTrue)
+		So(app.AssetKeeper().GetCoinPowers(ctx, account1).IsEqual(powers1.Add(bidCoin(3000))), ShouldBeTrue)
+		So(app.AssetKeeper().GetCoinPowers(ctx, account2).IsEqual(powers2.Add(bidCoin(3000))), ShouldBeTrue)
+		So(app.DistrKeeper().GetFeePoolCommunityCoins(ctx).AmountOf(constants.DefaultBondDenom).
+			Sub(pool.AmountOf(constants.DefaultBondDenom)).GTE(types.NewDec(3000)), ShouldBeTrue)
+
+		claim = accountTypes.NewMsgClaimAuction(addr2, account2, name, newAuth)
+		So(deliverAccountMsg(t, app, account2, addr2, false, &claim), simapp.ShouldErrIs, accountTypes.ErrAuctionNotWinner)
+
+		claim = accountTypes.NewMsgClaimAuction(addr1, account1, name, newAuth)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &claim), ShouldBeNil)
+
+		ctx = app.NewTestContext()
+		auth, err := app.AccountKeeper().GetAuth(ctx, name)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, newAuth)
+		So(app.AccountKeeper().IsNameReserved(ctx, name), ShouldBeFalse)
+	})
+
+	Convey("sealed auction forfeits the deposits not revealed", t, func() {
+		app := createAppForAuctionTest()
+
+		start := accountTypes.NewMsgStartAuction(addr1, account1, name, accountTypes.AuctionTypeSealed)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &start), ShouldBeNil)
+
+		bid1 := sealedBid(addr1, account1, name, 2000, 2000, "salt1")
+		So(deliverAccountMsg(t, app, account1, addr1, true, &bid1), ShouldBeNil)
+		bid2 := sealedBid(addr2, account2, name, 5000, 6000, "salt2")
+		So(deliverAccountMsg(t, app, account2, addr2, true, &bid2), ShouldBeNil)
+
+		endAuctionBids(app, name)
+
+		reveal := accountTypes.NewMsgRevealAuctionBid(addr1, account1, name, bidCoin(2000), "salt1")
+		So(deliverAccountMsg(t, app, account1, addr1, true, &reveal), ShouldBeNil)
+
+		// the bid should not exceed the deposit
+		reveal = accountTypes.NewMsgRevealAuctionBid(addr2, account2, name, bidCoin(6000), "salt2")
+		So(deliverAccountMsg(t, app, account2, addr2, false, &reveal), simapp.ShouldErrIs, sdkerrors.ErrInsufficientFunds)
+
+		ctx := app.NewTestContext()
+		powers1 := app.AssetKeeper().GetCoinPowers(ctx, account1)
+		powers2 := app.AssetKeeper().GetCoinPowers(ctx, account2)
+		pool := app.DistrKeeper().GetFeePoolCommunityCoins(ctx)
+		settleAuction(app, name)
+
+		// the only revealed bid wins by the min bid, the deposit not revealed goes to the community pool
+		ctx = app.NewTestContext()
+		auction, ok := app.AccountKeeper().GetAuction(ctx, name)
+		So(ok, ShouldBeTrue)
+		So(auction.Bidder, simapp.ShouldEq, account1)
+		So(auction.Price.IsEqual(bidCoin(1000)), ShouldBeTrue)
+		So(app.AssetKeeper().GetCoinPowers(ctx, accountTypes.ModuleAccountID).IsZero(), ShouldBeTrue)
+		So(app.AssetKeeper().GetCoinPowers(ctx, account1).IsEqual(powers1.Add(bidCoin(1000))), ShouldBeTrue)
+		So(app.AssetKeeper().GetCoinPowers(ctx, account2).IsEqual(powers2), ShouldBeTrue)
+		So(app.DistrKeeper().GetFeePoolCommunityCoins(ctx).AmountOf(constants.DefaultBondDenom).
+			Sub(pool.AmountOf(constants.DefaultBondDenom)).GTE(types.NewDec(6000)), ShouldBeTrue)
+	})
+
+	Convey("ascending auction refunds the outbid bidder", t, func() {
+		app := createAppForAuctionTest()
+
+		start := accountTypes.NewMsgStartAuction(addr2, account2, name, accountTypes.AuctionTypeAscending)
+		So(deliverAccountMsg(t, app, account2, addr2, true, &start), ShouldBeNil)
+
+		bid1 := accountTypes.NewMsgAuctionBid(addr1, account1, name, bidCoin(1000))
+		So(deliverAccountMsg(t, app, account1, addr1, true, &bid1), ShouldBeNil)
+
+		// the bid should exceed the highest bid by the min increment
+		bid2 := accountTypes.NewMsgAuctionBid(addr2, account2, name, bidCoin(1040))
+		So(deliverAccountMsg(t, app, account2, addr2, false, &bid2), simapp.ShouldErrIs, accountTypes.ErrAuctionBidTooLow)
+
+		bid2 = accountTypes.NewMsgAuctionBid(addr2, account2, name, bidCoin(1050))
+		So(deliverAccountMsg(t, app, account2, addr2, true, &bid2), ShouldBeNil)
+
+		ctx := app.NewTestContext()
+		So(app.AssetKeeper().GetCoinPowers(ctx, accountTypes.ModuleAccountID).IsEqual(types.NewCoins(bidCoin(1050))), ShouldBeTrue)
+
+		settleAuction(app, name)
+
+		ctx = app.NewTestContext()
+		auction, ok := app.AccountKeeper().GetAuction(ctx, name)
+		So(ok, ShouldBeTrue)
+		So(auction.Settled, ShouldBeTrue)
+		So(auction.Bidder, simapp.ShouldEq, account2)
+		So(auction.Price.IsEqual(bidCoin(1050)), ShouldBeTrue)
+		So(app.AssetKeeper().GetCoinPowers(ctx, accountTypes.ModuleAccountID).IsZero(), ShouldBeTrue)
+	})
+
+	Convey("name released if auction ended with no bid", t, func() {
+		app := createAppForAuctionTest()
+
+		start := accountTypes.NewMsgStartAuction(addr1, account1, name, accountTypes.AuctionTypeAscending)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &start), ShouldBeNil)
+
+		settleAuction(app, name)
+
+		ctx := app.NewTestContext()
+		So(app.AccountKeeper().IsNameReserved(ctx, name), ShouldBeFalse)
+	})
+}
@@ -0,0 +1,24 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func init() {
+	txutil.RegisterEventFormatter(types.EventTypeCreateAccount, formatCreateAccountEvent)
+	txutil.RegisterEventFormatter(types.EventTypeUpdateAccountAuth, formatUpdateAccountAuthEvent)
+}
+
+func formatCreateAccountEvent(event sdk.StringEvent, _ sdk.StringEvents) []string {
+	return []string{fmt.Sprintf("Account %s created by %s with auth %s", txutil.EventAttribute(event, types.AttributeKeyAccount),
+		txutil.EventAttribute(event, types.AttributeKeyCreator), txutil.EventAttribute(event, types.AttributeKeyAuth))}
+}
+
+func formatUpdateAccountAuthEvent(event sdk.StringEvent, _ sdk.StringEvents) []string {
+	return []string{fmt.Sprintf("Account %s auth updated to %s",
+		txutil.EventAttribute(event, types.AttributeKeyAccount), txutil.EventAttribute(event, types.AttributeKeyAuth))}
+}
@@ -1,15 +1,25 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/client/utils"
 	"github.com/KuChainNetwork/kuchain/x/account/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagLast = "last"
 )
 
 // GetQueryCmd returns the transaction commands for this module
@@ -25,7 +35,22 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.AddCommand(
 		GetAccountCmd(cdc),
 		GetAuthCmd(cdc),
+		GetAccountsAuthCmd(cdc),
 		GetAccountsCmd(cdc),
+		GetAccountsByAddressCmd(cdc),
+		GetDeactivationCmd(cdc),
+		GetMemoKeyCmd(cdc),
+		GetAuctionCmd(cdc),
+		GetAuctionsCmd(cdc),
+		GetAuctionBidsCmd(cdc),
+		GetAuctionParamsCmd(cdc),
+		GetGuardiansCmd(cdc),
+		GetRecoveryCmd(cdc),
+		GetPendingAuthCmd(cdc),
+		GetSubAccountCmd(cdc),
+		GetSubAccountsCmd(cdc),
+		GetNameOffersCmd(cdc),
+		GetActivityCmd(cdc),
 	)
 
 	return cmd
@@ -88,6 +113,37 @@ func GetAuthCmd(cdc *codec.Codec) *cobra.Command {
 	return flags.GetCommands(cmd)[0]
 }
 
+// GetAccountsAuthCmd returns a query the auths of accounts in one query
+func GetAccountsAuthCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auths [account-id]...",
+		Short: "Query auth data of accounts",
+		Args:  cobra.RangeArgs(1, types.MaxQueryAccountsAuthNum),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			accGetter := types.NewAccountRetriever(cliCtx)
+
+			ids := make([]chainTypes.AccountID, 0, len(args))
+			for _, arg := range args {
+				id, err := chainTypes.NewAccountIDFromStr(arg)
+				if err != nil {
+					return fmt.Errorf("new account id %s error: %w", arg, err)
+				}
+				ids = append(ids, id)
+			}
+
+			data, err := accGetter.GetAccountsAuth(ids)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(data)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
 func GetAccountsCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "accounts [auth]",
@@ -119,3 +175,489 @@ func GetAccountsCmd(cdc *codec.Codec) *cobra.Command {
 
 	return flags.GetCommands(cmd)[0]
 }
+
+// GetAccountsByAddressCmd returns a query the names of the accounts owned by the auth of a bech32 address
+func GetAccountsByAddressCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "by-address [bech32]",
+		Short: "Query the names of the accounts owned by the auth of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := chainTypes.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			accounts, err := types.NewAccountRetriever(cliCtx).GetAccountsByAddress(address)
+			if err != nil {
+				return err
+			}
+
+			if accounts == nil {
+				accounts = types.Accounts{}
+			}
+
+			return cliCtx.PrintOutput(accounts)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetDeactivationCmd returns a query the deactivation record of a account
+func GetDeactivationCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deactivation [name]",
+		Short: "Query the deactivation record of a account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			name, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDeactivationParams(name))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDeactivation)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.Deactivation
+			if err = cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetMemoKeyCmd returns a query the memo key of a account
+func GetMemoKeyCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "memo-key [name]",
+		Short: "Query the memo key of a account, which the memos to the account are encrypted to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			name, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			memoKey, err := txutil.QueryMemoKey(txutil.NewKuCLICtx(cliCtx), name)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), hex.EncodeToString(memoKey))
+			return err
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetAuctionCmd returns a query the auction of a premium account name
+func GetAuctionCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction [name]",
+		Short: "Query the auction of a premium account name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			name, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryNameAuctionParams(name))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuction)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.NameAuction
+			if err = cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetAuctionsCmd returns a query all the auctions of premium account names
+func GetAuctionsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auctions",
+		Short: "Query all the auctions of premium account names",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuctions)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var result []types.NameAuction
+			if err = cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetAuctionBidsCmd returns a query the bids of the sealed auction of a premium account name
+func GetAuctionBidsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction-bids [name]",
+		Short: "Query the bids of the sealed auction of a premium account name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			name, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryNameAuctionParams(name))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuctionBids)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result []types.NameAuctionBid
+			if err = cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetAuctionParamsCmd returns a query the params of the auctions of premium account names
+func GetAuctionParamsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction-params",
+		Short: "Query the params of the auctions of premium account names",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuctionParams)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var result types.AuctionParams
+			if err = cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetGuardiansCmd returns a query the guardians of a account
+func GetGuardiansCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "guardians [name]",
+		Short: "Query the guardians of a account, which can recover the account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			name, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryGuardiansParams(name))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryGuardians)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.Guardians
+			if err = cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetRecoveryCmd returns a query the pending recovery of a account
+func GetRecoveryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recovery [name]",
+		Short: "Query the pending recovery of a account by the guardians",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			name, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAccountRecoveryParams(name))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRecovery)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.Recovery
+			if err = cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetPendingAuthCmd returns a query the pending auth rotation of a account
+func GetPendingAuthCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-auth [name]",
+		Short: "Query the pending auth rotation of a account, which the old auth can cancel before the new auth active",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			name, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryPendingAuthParams(name))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingAuth)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.PendingAuth
+			if err = cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetSubAccountCmd returns a query the sub-account with the permissions
+func GetSubAccountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sub-account [name]",
+		Short: "Query the sub-account with the permissions of its auth",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			name, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySubAccountParams(name))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubAccount)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.SubAccount
+			if err = cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetSubAccountsCmd returns a query the sub-accounts of a account
+func GetSubAccountsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sub-accounts [parent]",
+		Short: "Query the sub-accounts of a account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			parent, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySubAccountParams(parent))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubAccounts)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result []types.SubAccount
+			if err = cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetNameOffersCmd returns a query the pending offers for an account name, or all the offers
+func GetNameOffersCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "name-offers [name]",
+		Short: "Query the pending offers for an account name, or all the offers if no name",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var name chainTypes.Name
+			if len(args) > 0 {
+				n, err := chainTypes.NewName(args[0])
+				if err != nil {
+					return err
+				}
+				name = n
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryNameOffersParams(name))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNameOffers)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result []types.NameOffer
+			if err = cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetActivityCmd returns a query the activity summary of a account over the recent txs in the tx indexer
+func GetActivityCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "activity [name]",
+		Short: "Query the activity summary of a account over the recent indexed txs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the counts and totals of the activities of a account by msg type, includes the coins sent
+and received, the votes and the delegations, over the last txs the account involved in from the tx indexer.
+
+Example:
+$ %s query account activity alice --last 1000
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			account, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			activity, err := utils.QueryAccountActivity(cliCtx, account, viper.GetInt(flagLast))
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(activity)
+		},
+	}
+
+	cmd.Flags().Int(flagLast, 1000, "the number of the last txs of the account to summarize")
+
+	return flags.GetCommands(cmd)[0]
+}
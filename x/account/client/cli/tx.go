@@ -2,6 +2,11 @@ package cli
 
 import (
 	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
 
 	"github.com/KuChainNetwork/kuchain/chain/client/flags"
 	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
@@ -27,7 +32,20 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	txCmd.AddCommand(
 		CreateAccount(cdc),
+		CreateAccounts(cdc),
 		UpdateAccountAuth(cdc),
+		CancelAuthRotation(cdc),
+		DeactivateAccount(cdc),
+		ReactivateAccount(cdc),
+		SetMemoKey(cdc),
+		StartAuction(cdc),
+		AuctionBid(cdc),
+		SealedAuctionBid(cdc),
+		RevealAuctionBid(cdc),
+		ClaimAuction(cdc),
+		RecoverCmd(cdc),
+		SubAccountCmd(cdc),
+		NameMarketCmd(cdc),
 	)
 
 	return txCmd
@@ -75,6 +93,59 @@ func CreateAccount(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// CreateAccounts will create the accounts in batch with the initial coins from the creator
+func CreateAccounts(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-batch [creator] [accounts_file]",
+		Short: "Create the accounts in a json file in one trx, with the initial coins from the creator",
+		Long: `Create the accounts in a json file in one trx, all the accounts are created or none of them, such as:
+
+[{"name": "alice", "address": "kuchain1...", "coins": "100kuchain/sys"}]
+
+The coins are optional, which are transferred from the creator to the account.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			creator, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(creator)
+			auth, err := txutil.QueryAccountAuth(ctx, creator)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", creator)
+			}
+
+			file, err := os.Open(args[1])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			accounts, err := parseCreateAccountsJSON(file)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateAccounts(auth, creator, accounts)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
 // UpdateAccountAuth will update auth for a account
 func UpdateAccountAuth(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -112,3 +183,864 @@ func UpdateAccountAuth(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// CancelAuthRotation will cancel the pending auth rotation of a account by the old auth
+func CancelAuthRotation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-auth-rotation [account_name]",
+		Short: "cancel the pending auth rotation of a account before the new auth active",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			accountName, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			id := chainTypes.NewAccountIDFromName(accountName)
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(id)
+			auth, err := txutil.QueryAccountAuth(ctx, id)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", id)
+			}
+
+			msg := types.NewMsgCancelAuthRotation(auth, accountName)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// DeactivateAccount will deactivate a account, the account cannot send txs until reactivated by the guardian
+func DeactivateAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deactivate [account_name] [guardian] [reason]",
+		Short: "deactivate a account, which can only be reactivated by the guardian",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			accountName, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			guardian, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			id := chainTypes.NewAccountIDFromName(accountName)
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(id)
+			auth, err := txutil.QueryAccountAuth(ctx, id)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", id)
+			}
+
+			msg := types.NewMsgDeactivateAccount(auth, accountName, guardian, args[2])
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// ReactivateAccount will reactivate a deactivated account by its guardian, and reset the auth if given
+func ReactivateAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reactivate [guardian] [account_name] [new_account_owner_auth]",
+		Short: "reactivate a deactivated account by its guardian, reset the auth if new auth given",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			guardian, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			accountName, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return err
+			}
+
+			var accountAuth sdk.AccAddress
+			if len(args) > 2 {
+				accountAuth, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(guardian)
+			auth, err := txutil.QueryAccountAuth(ctx, guardian)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", guardian)
+			}
+
+			msg := types.NewMsgReactivateAccount(auth, guardian, accountName, accountAuth)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// SetMemoKey will set the memo key of a account derived from the --from key, the memos to the account
+// encrypted by --encrypt-memo can be decrypted by the same key.
+func SetMemoKey(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-memo-key [account_name]",
+		Short: "set the memo key of a account, derived from the --from key, to receive encrypted memos",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			accountName, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			memoKey, _, err := txutil.DeriveMemoKey(txBldr.Keybase(), cliCtx.GetFromName())
+			if err != nil {
+				return sdkerrors.Wrap(err, "derive memo key error")
+			}
+
+			id := chainTypes.NewAccountIDFromName(accountName)
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(id)
+			auth, err := txutil.QueryAccountAuth(ctx, id)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", id)
+			}
+
+			msg := types.NewMsgSetMemoKey(auth, accountName, memoKey)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// StartAuction will start the auction of a premium account name, which is shorter than the normal names
+func StartAuction(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start-auction [starter] [name] [ascending|sealed]",
+		Short: "start the auction of a premium account name",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			starter, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			name, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return err
+			}
+
+			auctionType := types.AuctionType(args[2])
+			if err := auctionType.Validate(); err != nil {
+				return err
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(starter)
+			auth, err := txutil.QueryAccountAuth(ctx, starter)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", starter)
+			}
+
+			msg := types.NewMsgStartAuction(auth, starter, name, auctionType)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// AuctionBid will bid in the ascending auction of a premium account name, the amount is held until outbid or settled
+func AuctionBid(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid [bidder] [name] [amount]",
+		Short: "bid in the ascending auction of a premium account name",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			bidder, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			name, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := chainTypes.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(bidder)
+			auth, err := txutil.QueryAccountAuth(ctx, bidder)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", bidder)
+			}
+
+			msg := types.NewMsgAuctionBid(auth, bidder, name, amount)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// SealedAuctionBid will bid in the sealed auction of a premium account name by the commitment of the bid,
+// the deposit is held until the auction settled, the bid should be revealed by the salt after the auction ended
+func SealedAuctionBid(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sealed-bid [bidder] [name] [deposit] [amount] [salt]",
+		Short: "bid in the sealed auction of a premium account name by the commitment of the amount and salt",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			bidder, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			name, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := chainTypes.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+
+			amount, err := chainTypes.ParseCoin(args[3])
+			if err != nil {
+				return err
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(bidder)
+			auth, err := txutil.QueryAccountAuth(ctx, bidder)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", bidder)
+			}
+
+			commitment := types.AuctionBidCommitment(name, bidder, amount, args[4])
+			msg := types.NewMsgSealedAuctionBid(auth, bidder, name, deposit, commitment)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// RevealAuctionBid will reveal the bid committed in the sealed auction of a premium account name
+func RevealAuctionBid(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-bid [bidder] [name] [amount] [salt]",
+		Short: "reveal the bid in the sealed auction of a premium account name",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			bidder, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			name, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := chainTypes.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(bidder)
+			auth, err := txutil.QueryAccountAuth(ctx, bidder)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", bidder)
+			}
+
+			msg := types.NewMsgRevealAuctionBid(auth, bidder, name, amount, args[3])
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// ClaimAuction will create the account of the name won in the auction, with the owner auth
+func ClaimAuction(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [winner] [name] [new_account_owner_auth]",
+		Short: "claim the account name won in the auction",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			winner, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			name, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return err
+			}
+
+			accountAuth, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(winner)
+			auth, err := txutil.QueryAccountAuth(ctx, winner)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", winner)
+			}
+
+			msg := types.NewMsgClaimAuction(auth, winner, name, accountAuth)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// RecoverCmd returns the commands of the account recoveries by guardians
+func RecoverCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "recover",
+		Short:                      "Recover a account by the approvals of its guardians",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		SetGuardians(cdc),
+		InitiateRecovery(cdc),
+		ApproveRecovery(cdc),
+		CancelRecovery(cdc),
+	)
+
+	return cmd
+}
+
+// SetGuardians will set the guardians of a account, the threshold of them can rotate the auth of the account
+func SetGuardians(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-guardians [account_name] [threshold] [guardian]...",
+		Short: "set the guardians of a account, the threshold of them can rotate the auth, zero threshold to remove them",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			accountName, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			threshold, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return sdkerrors.Wrapf(types.ErrRecoveryThresholdInvalid, "parse threshold %s", args[1])
+			}
+
+			guardians := make([]chainTypes.AccountID, 0, len(args)-2)
+			for _, arg := range args[2:] {
+				guardian, err := chainTypes.NewAccountIDFromStr(arg)
+				if err != nil {
+					return err
+				}
+				guardians = append(guardians, guardian)
+			}
+
+			id := chainTypes.NewAccountIDFromName(accountName)
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(id)
+			auth, err := txutil.QueryAccountAuth(ctx, id)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", id)
+			}
+
+			msg := types.NewMsgSetGuardians(auth, accountName, guardians, uint32(threshold))
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// InitiateRecovery will initiate the recovery of a account to the new auth by a guardian
+func InitiateRecovery(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "initiate [guardian] [account_name] [new_account_owner_auth]",
+		Short: "initiate the recovery of a account to the new auth by its guardian",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			guardian, accountName, accountAuth, err := parseRecoveryArgs(args)
+			if err != nil {
+				return err
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(guardian)
+			auth, err := txutil.QueryAccountAuth(ctx, guardian)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", guardian)
+			}
+
+			msg := types.NewMsgInitiateRecovery(auth, guardian, accountName, accountAuth)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// ApproveRecovery will approve the pending recovery of a account by a guardian
+func ApproveRecovery(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [guardian] [account_name] [new_account_owner_auth]",
+		Short: "approve the pending recovery of a account to the new auth by its guardian",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			guardian, accountName, accountAuth, err := parseRecoveryArgs(args)
+			if err != nil {
+				return err
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(guardian)
+			auth, err := txutil.QueryAccountAuth(ctx, guardian)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", guardian)
+			}
+
+			msg := types.NewMsgApproveRecovery(auth, guardian, accountName, accountAuth)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// CancelRecovery will cancel the pending recovery of a account by the account self
+func CancelRecovery(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [account_name]",
+		Short: "cancel the pending recovery of a account before the time lock ends",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			accountName, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			id := chainTypes.NewAccountIDFromName(accountName)
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(id)
+			auth, err := txutil.QueryAccountAuth(ctx, id)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", id)
+			}
+
+			msg := types.NewMsgCancelRecovery(auth, accountName)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// SubAccountCmd returns the commands to manage the sub-accounts by the parent accounts
+func SubAccountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "sub-account",
+		Short:                      "Manage the sub-accounts, such as corp.treasury of corp, with the permissions scoped",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CreateSubAccount(cdc),
+		SetSubAccountPermissions(cdc),
+	)
+
+	return cmd
+}
+
+// CreateSubAccount will create a sub-account by the parent account
+func CreateSubAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [parent] [sub_account_name] [sub_account_owner_auth] [permission]...",
+		Short: "create a sub-account by the parent, its auth can only sign the msgs in the permissions",
+		Long: `Create a sub-account such as corp.treasury by the parent account corp, the auth of the
+sub-account can only sign the msgs in the permissions, each permission is a route/type for a msg type,
+or a route for all the msgs of the route, such as:
+
+$ kucli tx account sub-account create corp corp.treasury [auth] asset/transfer kugov/vote
+
+The auth should be a new key not used by any other account.`,
+		Args: cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			parent, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			accountName, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return err
+			}
+
+			accountAuth, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			id := chainTypes.NewAccountIDFromName(parent)
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(id)
+			auth, err := txutil.QueryAccountAuth(ctx, id)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", id)
+			}
+
+			msg := types.NewMsgCreateSubAccount(auth, parent, accountName, accountAuth, args[3:])
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// SetSubAccountPermissions will set the permissions of a sub-account by the parent account
+func SetSubAccountPermissions(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-permissions [sub_account_name] [permission]...",
+		Short: "set the permissions of a sub-account by its parent, each as a route/type or a route",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			accountName, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			parent, ok := types.SubAccountParent(accountName)
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrSubAccountNameInvalid, "%s is not a sub-account name", accountName)
+			}
+
+			id := chainTypes.NewAccountIDFromName(parent)
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(id)
+			auth, err := txutil.QueryAccountAuth(ctx, id)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", id)
+			}
+
+			msg := types.NewMsgSetSubAccountPermissions(auth, accountName, args[1:])
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// NameMarketCmd returns the commands of the account name trades escrowed by the module account
+func NameMarketCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "name-market",
+		Short:                      "Trade the account names, the price of an offer is escrowed until the owner transfers the name",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		OfferAccountName(cdc),
+		CancelNameOffer(cdc),
+		TransferAccountName(cdc),
+	)
+
+	return cmd
+}
+
+// OfferAccountName will offer to buy an account name, the price is held by the module account until the offer closed
+func OfferAccountName(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offer [buyer] [name] [new_account_owner_auth] [price]",
+		Short: "offer to buy an account name for the price, the account is transferred to the auth if the owner accepts",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			buyer, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			name, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return err
+			}
+
+			accountAuth, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			price, err := chainTypes.ParseCoins(args[3])
+			if err != nil {
+				return err
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(buyer)
+			auth, err := txutil.QueryAccountAuth(ctx, buyer)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", buyer)
+			}
+
+			msg := types.NewMsgOfferAccountName(auth, buyer, name, accountAuth, price)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// CancelNameOffer will cancel the offer for an account name, the price is refunded to the buyer
+func CancelNameOffer(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [buyer] [name]",
+		Short: "cancel the offer for an account name, the price is refunded",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			buyer, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			name, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return err
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(buyer)
+			auth, err := txutil.QueryAccountAuth(ctx, buyer)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", buyer)
+			}
+
+			msg := types.NewMsgCancelNameOffer(auth, buyer, name)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// TransferAccountName will transfer the account name to the buyer of an offer, the price is paid to the receiver
+func TransferAccountName(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [name] [buyer] [receiver] [price]",
+		Short: "accept the offer of the buyer, transfer the account name to its auth and receive the price",
+		Long: `Accept the offer of the buyer for the account name, in the same msg the auth of the account is set
+to the auth of the offer at once, and the price held by the module account is paid to the receiver.
+The coins of the account are transferred with the name, the guardians and the memo key of the account are removed.
+The price should be the price of the offer, the transfer fails if the buyer has re-offered at another price.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			name, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return err
+			}
+
+			buyer, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			receiver, err := chainTypes.NewAccountIDFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			price, err := chainTypes.ParseCoins(args[3])
+			if err != nil {
+				return err
+			}
+
+			id := chainTypes.NewAccountIDFromName(name)
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(id)
+			auth, err := txutil.QueryAccountAuth(ctx, id)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", id)
+			}
+
+			msg := types.NewMsgTransferAccountName(auth, name, buyer, receiver, price)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+func parseRecoveryArgs(args []string) (chainTypes.AccountID, chainTypes.Name, sdk.AccAddress, error) {
+	guardian, err := chainTypes.NewAccountIDFromStr(args[0])
+	if err != nil {
+		return chainTypes.AccountID{}, chainTypes.Name{}, nil, err
+	}
+
+	accountName, err := chainTypes.NewName(args[1])
+	if err != nil {
+		return chainTypes.AccountID{}, chainTypes.Name{}, nil, err
+	}
+
+	accountAuth, err := sdk.AccAddressFromBech32(args[2])
+	if err != nil {
+		return chainTypes.AccountID{}, chainTypes.Name{}, nil, err
+	}
+
+	return guardian, accountName, accountAuth, nil
+}
+
+// parseCreateAccountsJSON parses the accounts to create in batch from json
+func parseCreateAccountsJSON(r io.Reader) ([]types.CreateAccountParam, error) {
+	var accounts []struct {
+		Name    string `json:"name"`
+		Address string `json:"address"`
+		Coins   string `json:"coins"`
+	}
+
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&accounts); err != nil {
+		return nil, fmt.Errorf("decode json error: %w", err)
+	}
+
+	res := make([]types.CreateAccountParam, 0, len(accounts))
+	for i, a := range accounts {
+		name, err := chainTypes.NewName(a.Name)
+		if err != nil {
+			return nil, fmt.Errorf("account %d: invalid name %s: %w", i+1, a.Name, err)
+		}
+
+		auth, err := sdk.AccAddressFromBech32(a.Address)
+		if err != nil {
+			return nil, fmt.Errorf("account %d: invalid address %s: %w", i+1, a.Address, err)
+		}
+
+		coins, err := chainTypes.ParseCoins(a.Coins)
+		if err != nil {
+			return nil, fmt.Errorf("account %d: invalid coins %s: %w", i+1, a.Coins, err)
+		}
+
+		res = append(res, types.NewCreateAccountParam(name, auth, coins))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,517 @@
+package gen
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/app"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account"
+	"github.com/KuChainNetwork/kuchain/x/asset"
+	"github.com/KuChainNetwork/kuchain/x/staking"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+)
+
+const (
+	flagTotal = "total"
+	flagFile  = "file"
+)
+
+// genesisAccountsCSVHeader the columns of the genesis accounts csv file
+var genesisAccountsCSVHeader = []string{"name", "address", "coins", "vesting", "validator", "delegation"}
+
+// GenesisVesting the coins locked until the unlock height for a genesis account
+type GenesisVesting struct {
+	UnlockBlockHeight int64
+	Coins             types.Coins
+}
+
+// GenesisAccountRecord a genesis account parsed from csv file
+type GenesisAccountRecord struct {
+	Line       int
+	Name       string
+	Auth       types.AccAddress
+	Coins      types.Coins
+	Vesting    []GenesisVesting
+	Validator  types.AccountID
+	Delegation types.Coin
+}
+
+// ID returns the account id of the record, if no name, use the address as id
+func (r GenesisAccountRecord) ID() types.AccountID {
+	if r.Name == "" {
+		return types.NewAccountIDFromAccAdd(r.Auth)
+	}
+
+	return types.NewAccountIDFromName(types.MustName(r.Name))
+}
+
+// HasDelegation returns true if the record delegate to a validator
+func (r GenesisAccountRecord) HasDelegation() bool {
+	return !r.Validator.Empty()
+}
+
+// LockedCoins returns the total coins locked by vesting schedules
+func (r GenesisAccountRecord) LockedCoins() types.Coins {
+	res := types.Coins{}
+	for _, v := range r.Vesting {
+		res = res.Add(v.Coins...)
+	}
+
+	return res
+}
+
+// GenGenesisAccountsCmd builds the command to import genesis accounts from a csv file
+func GenGenesisAccountsCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-accounts [csv-file]",
+		Short: "Add genesis accounts to chain from a csv file",
+		Args:  cobra.ExactArgs(1),
+		Long: `This command add genesis accounts to chain from a csv file.
+
+		The csv file should have a header of 'name,address,coins,vesting,validator,delegation',
+		vesting is a list of 'height:coins' split by ';', the coins will be locked until the height,
+		validator and delegation is optional, the delegation coins will be delegated to the validator in genesis.
+		If the name is empty, the account will use the address as id.
+	`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			records, err := ParseGenesisAccountsCSV(file)
+			if err != nil {
+				return err
+			}
+
+			return addGenesisAccountsToFile(cmd, ctx, cdc, records)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, app.DefaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagClientHome, app.DefaultCLIHome, "client's home directory")
+	cmd.Flags().String(flagTotal, "", "if set, the total coins in csv file must equal to it")
+	return cmd
+}
+
+// GenGenesisAccountsJSONCmd builds the command to import genesis accounts from a json file
+func GenGenesisAccountsJSONCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-accounts",
+		Short: "Add genesis accounts to chain from a json file",
+		Args:  cobra.NoArgs,
+		Long: `This command add genesis accounts to chain from a json file, such as:
+
+		[{"name": "alice", "address": "kuchain1...", "coins": "100kuchain/sys", "vesting": "10:50kuchain/sys"}]
+
+		The fields of each account are the same as the columns of the csv file of add-genesis-accounts,
+		the vesting, validator and delegation are optional.
+	`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := os.Open(viper.GetString(flagFile))
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			records, err := ParseGenesisAccountsJSON(file)
+			if err != nil {
+				return err
+			}
+
+			return addGenesisAccountsToFile(cmd, ctx, cdc, records)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, app.DefaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagClientHome, app.DefaultCLIHome, "client's home directory")
+	cmd.Flags().String(flagFile, "", "the json file of the genesis accounts")
+	cmd.Flags().String(flagTotal, "", "if set, the total coins in json file must equal to it")
+	_ = cmd.MarkFlagRequired(flagFile)
+	return cmd
+}
+
+// addGenesisAccountsToFile adds the genesis accounts to the genesis file in the home directory
+func addGenesisAccountsToFile(cmd *cobra.Command, ctx *server.Context, cdc *codec.Codec, records []GenesisAccountRecord) error {
+	config := ctx.Config
+	config.SetRoot(viper.GetString(cli.HomeFlag))
+
+	var total types.Coins
+	if totalStr := viper.GetString(flagTotal); totalStr != "" {
+		coins, err := types.ParseCoins(totalStr)
+		if err != nil {
+			return fmt.Errorf("invalid total coins: %w", err)
+		}
+		total = coins
+	}
+
+	genFile := config.GenesisFile()
+	doc, err := types.LoadGenesisFile(cdc, genFile)
+	if err != nil {
+		return err
+	}
+
+	var appState types.AppGenesisState
+	if err := cdc.UnmarshalJSON(doc.AppState, &appState); err != nil {
+		return err
+	}
+
+	sum, err := AddGenesisAccounts(cdc, appState, records, total)
+	if err != nil {
+		return err
+	}
+
+	appStateJSON, err := cdc.MarshalJSON(appState)
+	if err != nil {
+		return err
+	}
+
+	doc.AppState = appStateJSON
+	if err := doc.ValidateAndComplete(); err != nil {
+		return err
+	}
+
+	if err := doc.SaveAs(genFile); err != nil {
+		return err
+	}
+
+	delegations := 0
+	for _, r := range records {
+		if r.HasDelegation() {
+			delegations++
+		}
+	}
+
+	_, err = fmt.Fprintf(cmd.OutOrStdout(), "added %d genesis accounts with %d delegations, total coins: %s\n",
+		len(records), delegations, sum)
+	return err
+}
+
+// ParseGenesisAccountsCSV parses the genesis accounts from csv
+func ParseGenesisAccountsCSV(r io.Reader) ([]GenesisAccountRecord, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header error: %w", err)
+	}
+
+	if len(header) != len(genesisAccountsCSVHeader) {
+		return nil, fmt.Errorf("csv header should be %s", strings.Join(genesisAccountsCSVHeader, ","))
+	}
+
+	for i, h := range header {
+		if strings.TrimSpace(h) != genesisAccountsCSVHeader[i] {
+			return nil, fmt.Errorf("csv header should be %s", strings.Join(genesisAccountsCSVHeader, ","))
+		}
+	}
+
+	res := make([]GenesisAccountRecord, 0)
+	for line := 2; ; line++ {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		record, err := parseGenesisAccountRecord(fields)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		record.Line = line
+		res = append(res, record)
+	}
+
+	return res, nil
+}
+
+// genesisAccountJSON a genesis account in json file, the fields are the same as the columns of csv file
+type genesisAccountJSON struct {
+	Name       string `json:"name"`
+	Address    string `json:"address"`
+	Coins      string `json:"coins"`
+	Vesting    string `json:"vesting"`
+	Validator  string `json:"validator"`
+	Delegation string `json:"delegation"`
+}
+
+// ParseGenesisAccountsJSON parses the genesis accounts from json, the line of a record is its index from 1
+func ParseGenesisAccountsJSON(r io.Reader) ([]GenesisAccountRecord, error) {
+	var accounts []genesisAccountJSON
+
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&accounts); err != nil {
+		return nil, fmt.Errorf("decode json error: %w", err)
+	}
+
+	res := make([]GenesisAccountRecord, 0, len(accounts))
+	for i, a := range accounts {
+		record, err := parseGenesisAccountRecord([]string{a.Name, a.Address, a.Coins, a.Vesting, a.Validator, a.Delegation})
+		if err != nil {
+			return nil, fmt.Errorf("account %d: %w", i+1, err)
+		}
+
+		record.Line = i + 1
+		res = append(res, record)
+	}
+
+	return res, nil
+}
+
+func parseGenesisAccountRecord(fields []string) (GenesisAccountRecord, error) {
+	var (
+		res = GenesisAccountRecord{}
+		err error
+	)
+
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+
+	res.Name = fields[0]
+	if res.Name != "" && !types.VerifyNameString(res.Name) {
+		return res, fmt.Errorf("invalid account name %s", res.Name)
+	}
+
+	if res.Auth, err = types.AccAddressFromBech32(fields[1]); err != nil {
+		return res, fmt.Errorf("invalid address %s: %w", fields[1], err)
+	}
+
+	if res.Coins, err = types.ParseCoins(fields[2]); err != nil {
+		return res, fmt.Errorf("invalid coins %s: %w", fields[2], err)
+	}
+
+	if fields[3] != "" {
+		for _, v := range strings.Split(fields[3], ";") {
+			vesting, err := parseGenesisVesting(v)
+			if err != nil {
+				return res, err
+			}
+			res.Vesting = append(res.Vesting, vesting)
+		}
+	}
+
+	if (fields[4] == "") != (fields[5] == "") {
+		return res, fmt.Errorf("validator and delegation should be set together")
+	}
+
+	if fields[4] != "" {
+		if res.Validator, err = types.NewAccountIDFromStr(fields[4]); err != nil {
+			return res, fmt.Errorf("invalid validator %s: %w", fields[4], err)
+		}
+
+		if res.Delegation, err = types.ParseCoin(fields[5]); err != nil {
+			return res, fmt.Errorf("invalid delegation %s: %w", fields[5], err)
+		}
+
+		if !res.Delegation.IsPositive() {
+			return res, fmt.Errorf("delegation should be positive")
+		}
+	}
+
+	if !res.Coins.IsAllGTE(res.LockedCoins()) {
+		return res, fmt.Errorf("vesting coins %s more than coins %s", res.LockedCoins(), res.Coins)
+	}
+
+	return res, nil
+}
+
+func parseGenesisVesting(str string) (GenesisVesting, error) {
+	parts := strings.SplitN(strings.TrimSpace(str), ":", 2)
+	if len(parts) != 2 {
+		return GenesisVesting{}, fmt.Errorf("invalid vesting %s, should be height:coins", str)
+	}
+
+	height, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || height <= 0 {
+		return GenesisVesting{}, fmt.Errorf("invalid vesting height %s", parts[0])
+	}
+
+	coins, err := types.ParseCoins(parts[1])
+	if err != nil || coins.IsZero() {
+		return GenesisVesting{}, fmt.Errorf("invalid vesting coins %s", parts[1])
+	}
+
+	return GenesisVesting{
+		UnlockBlockHeight: height,
+		Coins:             coins,
+	}, nil
+}
+
+// AddGenesisAccounts add the genesis accounts to app genesis state, returns the total coins of accounts,
+// if total is not empty, the total coins of accounts must equal to it.
+func AddGenesisAccounts(
+	cdc *codec.Codec, appState types.AppGenesisState, records []GenesisAccountRecord, total types.Coins,
+) (types.Coins, error) {
+	var (
+		accountGenesis account.GenesisState
+		assetGenesis   asset.GenesisState
+		stakingGenesis staking.GenesisState
+	)
+
+	if err := types.LoadGenesisStateFromBytes(cdc, appState, account.ModuleName, &accountGenesis); err != nil {
+		return nil, err
+	}
+
+	if err := types.LoadGenesisStateFromBytes(cdc, appState, asset.ModuleName, &assetGenesis); err != nil {
+		return nil, err
+	}
+
+	if err := types.LoadGenesisStateFromBytes(cdc, appState, staking.ModuleName, &stakingGenesis); err != nil {
+		return nil, err
+	}
+
+	sum := types.Coins{}
+	for _, r := range records {
+		if err := addGenesisAccountRecord(cdc, &accountGenesis, &assetGenesis, &stakingGenesis, r); err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.Line, err)
+		}
+		sum = sum.Add(r.Coins...)
+	}
+
+	if !total.Empty() && !total.IsEqual(sum) {
+		return nil, fmt.Errorf("total coins in csv %s not equal to %s", sum, total)
+	}
+
+	if err := appState.MarshalGenesis(cdc, account.ModuleName, accountGenesis); err != nil {
+		return nil, err
+	}
+
+	if err := appState.MarshalGenesis(cdc, asset.ModuleName, assetGenesis); err != nil {
+		return nil, err
+	}
+
+	if err := appState.MarshalGenesis(cdc, staking.ModuleName, stakingGenesis); err != nil {
+		return nil, err
+	}
+
+	return sum, nil
+}
+
+func addGenesisAccountRecord(
+	cdc *codec.Codec,
+	accountGenesis *account.GenesisState, assetGenesis *asset.GenesisState, stakingGenesis *staking.GenesisState,
+	r GenesisAccountRecord,
+) error {
+	id := r.ID()
+
+	for _, a := range assetGenesis.GenesisAssets {
+		if a.GetID().Eq(id) {
+			return fmt.Errorf("the application state already contains account coins for %s", id)
+		}
+	}
+
+	if r.Name == "" {
+		if err := addGenesisAddAccount(cdc, accountGenesis, r.Auth); err != nil {
+			return err
+		}
+	} else {
+		if err := addGenesisAccount(cdc, accountGenesis, types.MustName(r.Name), r.Auth); err != nil {
+			return err
+		}
+	}
+
+	balance := r.Coins
+	if r.HasDelegation() {
+		if err := addGenesisDelegation(assetGenesis, stakingGenesis, id, r.Validator, r.Delegation); err != nil {
+			return err
+		}
+
+		var isNegative bool
+		if balance, isNegative = r.Coins.SafeSub(types.NewCoins(r.Delegation)); isNegative {
+			return fmt.Errorf("delegation %s more than coins %s", r.Delegation, r.Coins)
+		}
+	}
+
+	if locked := r.LockedCoins(); !balance.IsAllGTE(locked) {
+		return fmt.Errorf("vesting coins %s more than coins %s after delegation", locked, balance)
+	}
+
+	if !balance.IsZero() {
+		assetGenesis.GenesisAssets = append(assetGenesis.GenesisAssets, asset.NewGenesisAsset(id, balance...))
+	}
+
+	for _, v := range r.Vesting {
+		assetGenesis.GenesisLockedCoins = append(assetGenesis.GenesisLockedCoins,
+			asset.NewGenesisLockedCoins(id, v.UnlockBlockHeight, v.Coins))
+	}
+
+	return nil
+}
+
+func addGenesisDelegation(
+	assetGenesis *asset.GenesisState, stakingGenesis *staking.GenesisState,
+	delegator, validator types.AccountID, amount types.Coin,
+) error {
+	if stakingGenesis.Exported {
+		return fmt.Errorf("cannot add delegations to an exported staking genesis")
+	}
+
+	if amount.Denom != stakingGenesis.Params.BondDenom {
+		return fmt.Errorf("delegation denom %s should be %s", amount.Denom, stakingGenesis.Params.BondDenom)
+	}
+
+	valIdx := -1
+	for i, val := range stakingGenesis.Validators {
+		if val.OperatorAccount.Eq(validator) {
+			valIdx = i
+			break
+		}
+	}
+
+	if valIdx < 0 {
+		return fmt.Errorf("validator %s not found in staking genesis", validator)
+	}
+
+	val, shares := stakingGenesis.Validators[valIdx].AddTokensFromDel(amount.Amount)
+	stakingGenesis.Validators[valIdx] = val
+
+	delegationIdx := -1
+	for i, del := range stakingGenesis.Delegations {
+		if del.DelegatorAccount.Eq(delegator) && del.ValidatorAccount.Eq(validator) {
+			delegationIdx = i
+			break
+		}
+	}
+
+	if delegationIdx < 0 {
+		stakingGenesis.Delegations = append(stakingGenesis.Delegations, staking.NewDelegation(delegator, validator, shares))
+	} else {
+		stakingGenesis.Delegations[delegationIdx].Shares = stakingGenesis.Delegations[delegationIdx].Shares.Add(shares)
+	}
+
+	pool := stakingTypes.NotBondedPoolAccountID
+	if val.IsBonded() {
+		pool = stakingTypes.BondedPoolAccountID
+	}
+
+	for i, a := range assetGenesis.GenesisAssets {
+		if a.GetID().Eq(pool) {
+			assetGenesis.GenesisAssets[i] = asset.NewGenesisAsset(pool, a.GetCoins().Add(amount)...)
+			return nil
+		}
+	}
+
+	assetGenesis.GenesisAssets = append(assetGenesis.GenesisAssets, asset.NewGenesisAsset(pool, amount))
+	return nil
+}
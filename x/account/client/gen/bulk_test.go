@@ -0,0 +1,189 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/app"
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account"
+	"github.com/KuChainNetwork/kuchain/x/asset"
+	"github.com/KuChainNetwork/kuchain/x/staking"
+	stakingexport "github.com/KuChainNetwork/kuchain/x/staking/exported"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func newTestAddress() types.AccAddress {
+	return types.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+}
+
+func TestParseGenesisAccountsCSV(t *testing.T) {
+	addr1, addr2 := newTestAddress(), newTestAddress()
+	coins := types.NewInt64CoreCoins(100)
+
+	csv := fmt.Sprintf(`name,address,coins,vesting,validator,delegation
+alice,%s,%s,"10:%s;20:%s",validator1,%s
+,%s,%s,,,
+`, addr1, coins, types.NewInt64CoreCoins(10), types.NewInt64CoreCoins(20), types.NewInt64CoreCoin(30), addr2, coins)
+
+	records, err := ParseGenesisAccountsCSV(strings.NewReader(csv))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	require.Equal(t, 2, records[0].Line)
+	require.Equal(t, types.MustAccountID("alice"), records[0].ID())
+	require.Len(t, records[0].Vesting, 2)
+	require.Equal(t, int64(20), records[0].Vesting[1].UnlockBlockHeight)
+	require.True(t, records[0].LockedCoins().IsEqual(types.NewInt64CoreCoins(30)))
+	require.True(t, records[0].HasDelegation())
+
+	require.Equal(t, types.NewAccountIDFromAccAdd(addr2), records[1].ID())
+	require.False(t, records[1].HasDelegation())
+
+	// errors with line number
+	_, err = ParseGenesisAccountsCSV(strings.NewReader("name,address\n"))
+	require.Error(t, err)
+
+	_, err = ParseGenesisAccountsCSV(strings.NewReader(fmt.Sprintf(`name,address,coins,vesting,validator,delegation
+alice,%s,%s,,,
+Bad-Name,%s,%s,,,
+`, addr1, coins, addr2, coins)))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "line 3")
+
+	_, err = ParseGenesisAccountsCSV(strings.NewReader(fmt.Sprintf(`name,address,coins,vesting,validator,delegation
+alice,%s,%s,10:%s,,
+`, addr1, coins, types.NewInt64CoreCoins(200))))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "line 2")
+}
+
+func TestParseGenesisAccountsJSON(t *testing.T) {
+	addr1, addr2 := newTestAddress(), newTestAddress()
+	coins := types.NewInt64CoreCoins(100)
+
+	records, err := ParseGenesisAccountsJSON(strings.NewReader(fmt.Sprintf(`[
+	{"name": "alice", "address": "%s", "coins": "%s", "vesting": "10:%s", "validator": "validator1", "delegation": "%s"},
+	{"address": "%s", "coins": "%s"}
+]`, addr1, coins, types.NewInt64CoreCoins(10), types.NewInt64CoreCoin(30), addr2, coins)))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	require.Equal(t, 1, records[0].Line)
+	require.Equal(t, types.MustAccountID("alice"), records[0].ID())
+	require.Len(t, records[0].Vesting, 1)
+	require.True(t, records[0].HasDelegation())
+	require.Equal(t, types.NewAccountIDFromAccAdd(addr2), records[1].ID())
+
+	// unknown fields
+	_, err = ParseGenesisAccountsJSON(strings.NewReader(fmt.Sprintf(`[{"name": "alice", "auth": "%s"}]`, addr1)))
+	require.Error(t, err)
+
+	_, err = ParseGenesisAccountsJSON(strings.NewReader(fmt.Sprintf(`[
+	{"name": "alice", "address": "%s", "coins": "%s"},
+	{"name": "Bad-Name", "address": "%s", "coins": "%s"}
+]`, addr1, coins, addr2, coins)))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "account 2")
+}
+
+func TestAddGenesisAccounts(t *testing.T) {
+	cdc := app.MakeCodec()
+	appState := types.AppGenesisState(app.ModuleBasics.DefaultGenesis())
+
+	val := staking.NewValidator(types.MustAccountID("validator1"), ed25519.GenPrivKey().PubKey(), stakingTypes.Description{})
+	val.Status = stakingexport.Bonded
+
+	stakingGenesis := staking.DefaultGenesisState()
+	stakingGenesis.Params.BondDenom = constants.DefaultBondDenom
+	stakingGenesis.Validators = staking.Validators{val}
+	require.NoError(t, appState.MarshalGenesis(cdc, staking.ModuleName, stakingGenesis))
+
+	addr1, addr2 := newTestAddress(), newTestAddress()
+	records := []GenesisAccountRecord{
+		{
+			Line:  2,
+			Name:  "alice",
+			Auth:  addr1,
+			Coins: types.NewInt64CoreCoins(100),
+			Vesting: []GenesisVesting{
+				{UnlockBlockHeight: 10, Coins: types.NewInt64CoreCoins(50)},
+			},
+			Validator:  types.MustAccountID("validator1"),
+			Delegation: types.NewInt64CoreCoin(30),
+		},
+		{
+			Line:  3,
+			Auth:  addr2,
+			Coins: types.NewInt64CoreCoins(200),
+		},
+	}
+
+	// total not match
+	_, err := AddGenesisAccounts(cdc, appState, records, types.NewInt64CoreCoins(1))
+	require.Error(t, err)
+
+	sum, err := AddGenesisAccounts(cdc, appState, records, types.NewInt64CoreCoins(300))
+	require.NoError(t, err)
+	require.True(t, sum.IsEqual(types.NewInt64CoreCoins(300)))
+
+	var (
+		accountGenesis account.GenesisState
+		assetGenesis   asset.GenesisState
+	)
+
+	require.NoError(t, types.LoadGenesisStateFromBytes(cdc, appState, account.ModuleName, &accountGenesis))
+	require.NoError(t, types.LoadGenesisStateFromBytes(cdc, appState, asset.ModuleName, &assetGenesis))
+	require.NoError(t, types.LoadGenesisStateFromBytes(cdc, appState, staking.ModuleName, &stakingGenesis))
+
+	require.Len(t, accountGenesis.Accounts, 2)
+
+	balances := make(map[string]types.Coins)
+	for _, a := range assetGenesis.GenesisAssets {
+		balances[a.GetID().String()] = a.GetCoins()
+	}
+
+	require.True(t, balances["alice"].IsEqual(types.NewInt64CoreCoins(70)))
+	require.True(t, balances[types.NewAccountIDFromAccAdd(addr2).String()].IsEqual(types.NewInt64CoreCoins(200)))
+	require.True(t, balances[stakingTypes.BondedPoolAccountID.String()].IsEqual(types.NewInt64CoreCoins(30)))
+
+	require.Len(t, assetGenesis.GenesisLockedCoins, 1)
+	require.Equal(t, int64(10), assetGenesis.GenesisLockedCoins[0].UnlockBlockHeight)
+
+	require.Equal(t, sdk.NewInt(30), stakingGenesis.Validators[0].Tokens)
+	require.Len(t, stakingGenesis.Delegations, 1)
+	require.Equal(t, types.MustAccountID("alice"), stakingGenesis.Delegations[0].DelegatorAccount)
+
+	// duplicate names
+	_, err = AddGenesisAccounts(cdc, appState, records[:1], nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "line 2")
+
+	// vesting more than balance after delegation
+	_, err = AddGenesisAccounts(cdc, appState, []GenesisAccountRecord{{
+		Line:       2,
+		Name:       "bob",
+		Auth:       newTestAddress(),
+		Coins:      types.NewInt64CoreCoins(100),
+		Vesting:    []GenesisVesting{{UnlockBlockHeight: 10, Coins: types.NewInt64CoreCoins(80)}},
+		Validator:  types.MustAccountID("validator1"),
+		Delegation: types.NewInt64CoreCoin(30),
+	}}, nil)
+	require.Error(t, err)
+
+	// unknown validator
+	_, err = AddGenesisAccounts(cdc, appState, []GenesisAccountRecord{{
+		Line:       2,
+		Name:       "bob",
+		Auth:       newTestAddress(),
+		Coins:      types.NewInt64CoreCoins(100),
+		Validator:  types.MustAccountID("validator2"),
+		Delegation: types.NewInt64CoreCoin(30),
+	}}, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,70 @@
+package grpcquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/grpcserver"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// queryServer the gRPC query service of the account module, served by the legacy querier of the module
+type queryServer struct {
+	cdc     *codec.Codec
+	querier grpcserver.ABCIQuerier
+}
+
+// NewQueryServer creates the gRPC query service of the account module
+func NewQueryServer(cdc *codec.Codec, querier grpcserver.ABCIQuerier) types.QueryServer {
+	return queryServer{
+		cdc:     cdc,
+		querier: querier,
+	}
+}
+
+// query queries the legacy querier by the path, the params and the result are in amino JSON
+func (s queryServer) query(path string, params, res interface{}) error {
+	bz, err := s.cdc.MarshalJSON(params)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp, err := s.querier(abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path),
+		Data: bz,
+	})
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	if !resp.IsOK() {
+		return status.Error(codes.InvalidArgument, resp.Log)
+	}
+
+	if err := s.cdc.UnmarshalJSON(resp.Value, res); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func (s queryServer) AccountsByAddress(_ context.Context, req *types.QueryAccountsByAddressRequest) (*types.QueryAccountsByAddressResponse, error) {
+	address, err := chainTypes.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", req.Address, err.Error())
+	}
+
+	var accounts types.Accounts
+	if err := s.query(types.QueryAccountsByAuth, types.NewQueryAccountsByAddressParams(address), &accounts); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountsByAddressResponse{Accounts: accounts}, nil
+}
@@ -0,0 +1,71 @@
+package grpcquery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testQuerier serves the legacy queries by the accounts of the auths in memory
+type testQuerier map[string]types.Accounts
+
+func (q testQuerier) query(req abci.RequestQuery) (abci.ResponseQuery, error) {
+	cdc := types.Cdc()
+	path := strings.TrimPrefix(req.Path, fmt.Sprintf("custom/%s/", types.QuerierRoute))
+
+	if path != types.QueryAccountsByAuth {
+		return abci.ResponseQuery{Code: 1, Log: "unknown path " + path}, nil
+	}
+
+	var params types.QueryAccountsByAuthParams
+	cdc.MustUnmarshalJSON(req.Data, &params)
+
+	return abci.ResponseQuery{Value: cdc.MustMarshalJSON(q[params.Auth.String()])}, nil
+}
+
+func startTestServer(t *testing.T, querier testQuerier) types.QueryClient {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	types.RegisterQueryServer(server, NewQueryServer(types.Cdc(), querier.query))
+	go server.Serve(listener) // nolint:errcheck
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return types.NewQueryClient(conn)
+}
+
+func TestQueryServer(t *testing.T) {
+	addr1 := chainTypes.AccAddress([]byte("test-address-0000001"))
+	addr2 := chainTypes.AccAddress([]byte("test-address-0000002"))
+
+	client := startTestServer(t, testQuerier{
+		addr1.String(): types.Accounts{"testacc1", "testacc2"},
+	})
+	ctx := context.Background()
+
+	res, err := client.AccountsByAddress(ctx, &types.QueryAccountsByAddressRequest{Address: addr1.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{"testacc1", "testacc2"}, res.Accounts)
+
+	res, err = client.AccountsByAddress(ctx, &types.QueryAccountsByAddressRequest{Address: addr2.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Accounts)
+
+	_, err = client.AccountsByAddress(ctx, &types.QueryAccountsByAddressRequest{Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
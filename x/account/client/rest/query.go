@@ -8,9 +8,35 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 	"net/http"
+	"strings"
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// the auction and name offer routes should be registered before `/account/{name}`
+	r.HandleFunc(
+		"/account/auctions",
+		getAuctionsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/account/auction_params",
+		getAuctionParamsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/account/auction/{name}",
+		getAuctionHandlerFn(cliCtx, types.QueryAuction),
+	).Methods("GET")
+	r.HandleFunc(
+		"/account/auction/{name}/bids",
+		getAuctionHandlerFn(cliCtx, types.QueryAuctionBids),
+	).Methods("GET")
+	r.HandleFunc(
+		"/account/name_offers",
+		getNameOffersHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/account/name_offers/{name}",
+		getNameOffersHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/account/{name}",
 		getAccountHandlerFn(cliCtx),
@@ -19,6 +45,10 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/account/auth/{auth}",
 		getAuthHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/accounts/auths",
+		getAccountsAuthHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/accounts/{auth}",
 		getAccountsByAuthHandlerFn(cliCtx),
@@ -117,3 +147,150 @@ func getAccountsByAuthHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, result)
 	}
 }
+
+// getAccountsAuthHandlerFn query auths of accounts by ids, split by ',', as `/accounts/auths?ids=a,b`
+func getAccountsAuthHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		strIds := r.URL.Query().Get("ids")
+		if len(strIds) == 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "ids required but not specified")
+			return
+		}
+
+		var ids []chainTypes.AccountID
+		for _, str := range strings.Split(strIds, ",") {
+			id, err := chainTypes.NewAccountIDFromStr(str)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			ids = append(ids, id)
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAccountsAuthParams(ids...))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccountsAuth)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// getAuctionHandlerFn query the auction or the bids of the sealed auction of the name by the query path
+func getAuctionHandlerFn(cliCtx context.CLIContext, queryPath string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name, err := chainTypes.NewName(mux.Vars(r)["name"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryNameAuctionParams(name))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, queryPath)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getAuctionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuctions)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getAuctionParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuctionParams)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// getNameOffersHandlerFn query the pending offers for the name, or all the offers if no name
+func getNameOffersHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var name chainTypes.Name
+		if n, ok := mux.Vars(r)["name"]; ok {
+			var err error
+			if name, err = chainTypes.NewName(n); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryNameOffersParams(name))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNameOffers)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
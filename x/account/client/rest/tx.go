@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"encoding/hex"
 	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	rest "github.com/KuChainNetwork/kuchain/chain/types"
@@ -25,6 +26,36 @@ type UpdateAuthReq struct {
 	NewAccountAuth string       `json:"new_account_auth" yaml:"new_account_auth"`
 }
 
+type StartAuctionReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Starter     string       `json:"starter" yaml:"starter"`
+	Name        string       `json:"name" yaml:"name"`
+	AuctionType string       `json:"auction_type" yaml:"auction_type"`
+}
+
+type AuctionBidReq struct {
+	BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Bidder     string       `json:"bidder" yaml:"bidder"`
+	Name       string       `json:"name" yaml:"name"`
+	Amount     string       `json:"amount" yaml:"amount"`
+	Commitment string       `json:"commitment,omitempty" yaml:"commitment,omitempty"` // hex of the commitment for sealed auctions
+}
+
+type RevealAuctionBidReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Bidder  string       `json:"bidder" yaml:"bidder"`
+	Name    string       `json:"name" yaml:"name"`
+	Amount  string       `json:"amount" yaml:"amount"`
+	Salt    string       `json:"salt" yaml:"salt"`
+}
+
+type ClaimAuctionReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Winner      string       `json:"winner" yaml:"winner"`
+	Name        string       `json:"name" yaml:"name"`
+	AccountAuth string       `json:"account_auth" yaml:"account_auth"`
+}
+
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(
 		"/account/create",
@@ -34,6 +65,22 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/account/update_auth",
 		updateAuthHandlerFn(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/account/start_auction",
+		startAuctionHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/account/auction_bid",
+		auctionBidHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/account/reveal_auction_bid",
+		revealAuctionBidHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/account/claim_auction",
+		claimAuctionHandlerFn(cliCtx),
+	).Methods("POST")
 }
 
 func createAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -127,3 +174,201 @@ func updateAuthHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		txutil.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func startAuctionHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req StartAuctionReq
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = cliCtx.Codec.UnmarshalJSON(body, &req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+
+		starter, err := chainTypes.NewAccountIDFromStr(req.Starter)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		name, err := chainTypes.NewName(req.Name)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		auctionType := types.AuctionType(req.AuctionType)
+		if err := auctionType.Validate(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(starter)
+		auth, err := txutil.QueryAccountAuth(ctx, starter)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgStartAuction(auth, starter, name, auctionType)
+		txutil.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func auctionBidHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AuctionBidReq
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = cliCtx.Codec.UnmarshalJSON(body, &req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+
+		bidder, err := chainTypes.NewAccountIDFromStr(req.Bidder)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		name, err := chainTypes.NewName(req.Name)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		amount, err := chainTypes.ParseCoin(req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		commitment, err := hex.DecodeString(req.Commitment)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(bidder)
+		auth, err := txutil.QueryAccountAuth(ctx, bidder)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSealedAuctionBid(auth, bidder, name, amount, commitment)
+		txutil.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func revealAuctionBidHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RevealAuctionBidReq
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = cliCtx.Codec.UnmarshalJSON(body, &req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+
+		bidder, err := chainTypes.NewAccountIDFromStr(req.Bidder)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		name, err := chainTypes.NewName(req.Name)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		amount, err := chainTypes.ParseCoin(req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(bidder)
+		auth, err := txutil.QueryAccountAuth(ctx, bidder)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRevealAuctionBid(auth, bidder, name, amount, req.Salt)
+		txutil.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func claimAuctionHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ClaimAuctionReq
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		err = cliCtx.Codec.UnmarshalJSON(body, &req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+
+		winner, err := chainTypes.NewAccountIDFromStr(req.Winner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		name, err := chainTypes.NewName(req.Name)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		accountAuth, err := sdk.AccAddressFromBech32(req.AccountAuth)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(winner)
+		auth, err := txutil.QueryAccountAuth(ctx, winner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgClaimAuction(auth, winner, name, accountAuth)
+		txutil.WriteGenerateStdTxResponse(w, ctx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,251 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+)
+
+// maxActivityPageLimit the max limit of a page of the tx search by the indexer
+const maxActivityPageLimit = 100
+
+// ActivityTotal the count and the total amount of a kind of the activities
+type ActivityTotal struct {
+	Count  int              `json:"count" yaml:"count"`
+	Amount chainTypes.Coins `json:"amount" yaml:"amount"`
+}
+
+func (t *ActivityTotal) add(amount chainTypes.Coins) {
+	t.Count++
+	t.Amount = t.Amount.Add(amount...)
+}
+
+// MsgActivity the count of the msgs of a type, as "route/type", the account involved in
+type MsgActivity struct {
+	Type  string `json:"type" yaml:"type"`
+	Count int    `json:"count" yaml:"count"`
+}
+
+// AccountActivity the activity summary of the account over the recent txs in the tx indexer
+type AccountActivity struct {
+	Account     chainTypes.AccountID `json:"account" yaml:"account"`
+	Txs         int                  `json:"txs" yaml:"txs"`                 // the txs the account involved in
+	FromHeight  int64                `json:"from_height" yaml:"from_height"` // the height of the earliest tx
+	ToHeight    int64                `json:"to_height" yaml:"to_height"`     // the height of the latest tx
+	Sent        ActivityTotal        `json:"sent" yaml:"sent"`
+	Received    ActivityTotal        `json:"received" yaml:"received"`
+	Votes       int                  `json:"votes" yaml:"votes"`
+	Delegations ActivityTotal        `json:"delegations" yaml:"delegations"`
+	Msgs        []MsgActivity        `json:"msgs" yaml:"msgs"`
+}
+
+func (a AccountActivity) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
+
+// NewAccountActivity aggregates the activities of the account in the txs, the failed txs are skipped
+func NewAccountActivity(account chainTypes.AccountID, txs []sdk.TxResponse) AccountActivity {
+	res := AccountActivity{
+		Account: account,
+		Msgs:    make([]MsgActivity, 0),
+	}
+
+	msgCounts := make(map[string]int)
+	for _, tx := range txs {
+		if tx.Code != 0 || len(tx.Logs) == 0 {
+			continue
+		}
+
+		involved := false
+		for _, log := range tx.Logs {
+			if !res.addMsgEvents(log.Events) {
+				continue
+			}
+
+			involved = true
+			if tx.Tx != nil && int(log.MsgIndex) < len(tx.Tx.GetMsgs()) {
+				m := tx.Tx.GetMsgs()[log.MsgIndex]
+				msgCounts[m.Route()+"/"+m.Type()]++
+			}
+		}
+
+		if !involved {
+			continue
+		}
+
+		res.Txs++
+		if res.FromHeight == 0 || tx.Height < res.FromHeight {
+			res.FromHeight = tx.Height
+		}
+		if tx.Height > res.ToHeight {
+			res.ToHeight = tx.Height
+		}
+	}
+
+	for typ, count := range msgCounts {
+		res.Msgs = append(res.Msgs, MsgActivity{Type: typ, Count: count})
+	}
+
+	sort.Slice(res.Msgs, func(i, j int) bool { return res.Msgs[i].Type < res.Msgs[j].Type })
+
+	return res
+}
+
+// addMsgEvents adds the activities in the events of a msg, returns true if the account involved in the msg
+func (a *AccountActivity) addMsgEvents(events sdk.StringEvents) bool {
+	account := a.Account.String()
+
+	involved, isSender := false, false
+	for _, event := range events {
+		for _, attr := range event.Attributes {
+			if attr.Value != account {
+				continue
+			}
+
+			involved = true
+			if event.Type == sdk.EventTypeMessage && attr.Key == sdk.AttributeKeySender {
+				isSender = true
+			}
+		}
+	}
+
+	if !involved {
+		return false
+	}
+
+	for _, event := range events {
+		switch event.Type {
+		case msg.EventTypeTransfer:
+			a.addTransfers(event)
+		case govTypes.EventTypeProposalVote:
+			for _, attr := range event.Attributes {
+				if attr.Key == govTypes.AttributeKeyVoter && attr.Value == account {
+					a.Votes++
+				}
+			}
+		case stakingTypes.EventTypeDelegate:
+			if !isSender {
+				continue
+			}
+
+			for _, attr := range event.Attributes {
+				if amount, ok := sdk.NewIntFromString(attr.Value); ok && attr.Key == sdk.AttributeKeyAmount {
+					a.Delegations.add(chainTypes.NewCoins(chainTypes.NewCoin(constants.DefaultBondDenom, amount)))
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// addTransfers adds the transfers in the event, the events of the same type in a msg are merged,
+// so the attributes are the sequence of from, to and amount of each transfer.
+func (a *AccountActivity) addTransfers(event sdk.StringEvent) {
+	account := a.Account.String()
+
+	var from, to string
+	for _, attr := range event.Attributes {
+		switch attr.Key {
+		case msg.AttributeKeyFrom:
+			from = attr.Value
+		case msg.AttributeKeyTo:
+			to = attr.Value
+		case msg.AttributeKeyAmount:
+			amount, err := chainTypes.ParseCoins(attr.Value)
+			if err != nil {
+				continue
+			}
+
+			if from == account {
+				a.Sent.add(amount)
+			}
+			if to == account {
+				a.Received.add(amount)
+			}
+		}
+	}
+}
+
+// QueryAccountActivity queries the last txs the account involved in from the tx indexer, by the transfers,
+// the msgs sent and the votes of the account, and aggregates the activities in them.
+func QueryAccountActivity(cliCtx context.CLIContext, account chainTypes.AccountID, last int) (AccountActivity, error) {
+	if last <= 0 {
+		return AccountActivity{}, fmt.Errorf("the number of the last txs should be positive")
+	}
+
+	queries := [][]string{
+		{fmt.Sprintf("%s.%s='%s'", msg.EventTypeTransfer, msg.AttributeKeyFrom, account)},
+		{fmt.Sprintf("%s.%s='%s'", msg.EventTypeTransfer, msg.AttributeKeyTo, account)},
+		{fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, account)},
+		{fmt.Sprintf("%s.%s='%s'", govTypes.EventTypeProposalVote, govTypes.AttributeKeyVoter, account)},
+	}
+
+	txs := make(map[string]sdk.TxResponse)
+	for _, events := range queries {
+		found, err := queryLastTxs(cliCtx, events, last)
+		if err != nil {
+			return AccountActivity{}, err
+		}
+
+		for _, tx := range found {
+			txs[tx.TxHash] = tx
+		}
+	}
+
+	res := make([]sdk.TxResponse, 0, len(txs))
+	for _, tx := range txs {
+		res = append(res, tx)
+	}
+
+	// the last txs of all the queries
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Height != res[j].Height {
+			return res[i].Height > res[j].Height
+		}
+		return res[i].TxHash < res[j].TxHash
+	})
+	if len(res) > last {
+		res = res[:last]
+	}
+
+	return NewAccountActivity(account, res), nil
+}
+
+// queryLastTxs queries the last txs of the events, the latest first
+func queryLastTxs(cliCtx context.CLIContext, events []string, last int) ([]sdk.TxResponse, error) {
+	limit := last
+	if limit > maxActivityPageLimit {
+		limit = maxActivityPageLimit
+	}
+
+	res := make([]sdk.TxResponse, 0, limit)
+	for page := 1; len(res) < last; page++ {
+		searchResult, err := txutil.QueryTxsByEventsOrderBy(cliCtx, events, page, limit, "desc")
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, searchResult.Txs...)
+		if len(searchResult.Txs) < limit || page >= searchResult.PageTotal {
+			break
+		}
+	}
+
+	if len(res) > last {
+		res = res[:last]
+	}
+
+	return res, nil
+}
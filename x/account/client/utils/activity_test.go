@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+)
+
+func newTestTxResponse(height int64, hash string, code uint32, msgs []sdk.Msg, events ...sdk.StringEvents) sdk.TxResponse {
+	logs := make(sdk.ABCIMessageLogs, 0, len(events))
+	for i, e := range events {
+		logs = append(logs, sdk.NewABCIMessageLog(uint16(i), "", sdk.Events{}))
+		logs[i].Events = e
+	}
+
+	return sdk.TxResponse{
+		Height: height,
+		TxHash: hash,
+		Code:   code,
+		Logs:   logs,
+		Tx:     chainTypes.NewStdTx(msgs, chainTypes.StdFee{}, nil, ""),
+	}
+}
+
+func newTestEvent(typ string, kvs ...string) sdk.StringEvent {
+	res := sdk.StringEvent{Type: typ}
+	for i := 0; i+1 < len(kvs); i += 2 {
+		res.Attributes = append(res.Attributes, sdk.Attribute{Key: kvs[i], Value: kvs[i+1]})
+	}
+	return res
+}
+
+func TestNewAccountActivity(t *testing.T) {
+	alice := chainTypes.MustAccountID("alice")
+	bob := chainTypes.MustAccountID("bob")
+	coins := func(amt int64) string {
+		return chainTypes.NewInt64Coin(constants.DefaultBondDenom, amt).String()
+	}
+
+	transfer := assetTypes.NewMsgTransfer(sdk.AccAddress{}, alice, bob, chainTypes.Coins{})
+
+	Convey("test aggregate the activities of the account", t, func() {
+		txs := []sdk.TxResponse{
+			// alice send to bob, with the fee to the fee account
+			newTestTxResponse(10, "A", 0, []sdk.Msg{&transfer}, sdk.StringEvents{
+				newTestEvent(sdk.EventTypeMessage, sdk.AttributeKeySender, "alice"),
+				newTestEvent(msg.EventTypeTransfer,
+					msg.AttributeKeyFrom, "alice", msg.AttributeKeyTo, "fee", msg.AttributeKeyAmount, coins(1),
+					msg.AttributeKeyFrom, "alice", msg.AttributeKeyTo, "bob", msg.AttributeKeyAmount, coins(100)),
+			}),
+			// bob send to alice
+			newTestTxResponse(12, "B", 0, []sdk.Msg{&transfer}, sdk.StringEvents{
+				newTestEvent(sdk.EventTypeMessage, sdk.AttributeKeySender, "bob"),
+				newTestEvent(msg.EventTypeTransfer,
+					msg.AttributeKeyFrom, "bob", msg.AttributeKeyTo, "alice", msg.AttributeKeyAmount, coins(30)),
+			}),
+			// alice votes and delegates in one tx
+			newTestTxResponse(15, "C", 0, []sdk.Msg{&transfer, &transfer}, sdk.StringEvents{
+				newTestEvent(govTypes.EventTypeProposalVote, govTypes.AttributeKeyVoter, "alice"),
+			}, sdk.StringEvents{
+				newTestEvent(sdk.EventTypeMessage, sdk.AttributeKeySender, "alice"),
+				newTestEvent(stakingTypes.EventTypeDelegate, "validator", "val", sdk.AttributeKeyAmount, "50"),
+			}),
+			// bob delegates, not involved alice
+			newTestTxResponse(16, "D", 0, []sdk.Msg{&transfer}, sdk.StringEvents{
+				newTestEvent(sdk.EventTypeMessage, sdk.AttributeKeySender, "bob"),
+				newTestEvent(stakingTypes.EventTypeDelegate, "validator", "val", sdk.AttributeKeyAmount, "70"),
+			}),
+			// failed tx skipped
+			newTestTxResponse(8, "E", 5, []sdk.Msg{&transfer}, sdk.StringEvents{
+				newTestEvent(sdk.EventTypeMessage, sdk.AttributeKeySender, "alice"),
+			}),
+		}
+
+		res := NewAccountActivity(alice, txs)
+		So(res.Txs, ShouldEqual, 3)
+		So(res.FromHeight, ShouldEqual, 10)
+		So(res.ToHeight, ShouldEqual, 15)
+
+		So(res.Sent.Count, ShouldEqual, 2)
+		So(res.Sent.Amount.String(), ShouldEqual, coins(101))
+		So(res.Received.Count, ShouldEqual, 1)
+		So(res.Received.Amount.String(), ShouldEqual, coins(30))
+		So(res.Votes, ShouldEqual, 1)
+		So(res.Delegations.Count, ShouldEqual, 1)
+		So(res.Delegations.Amount.String(), ShouldEqual, coins(50))
+
+		So(res.Msgs, ShouldResemble, []MsgActivity{{Type: transfer.Route() + "/" + transfer.Type(), Count: 4}})
+	})
+
+	Convey("test no activities", t, func() {
+		res := NewAccountActivity(alice, nil)
+		So(res.Txs, ShouldEqual, 0)
+		So(res.Msgs, ShouldBeEmpty)
+		So(res.Sent.Amount.IsZero(), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,92 @@
+package account_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+)
+
+func TestCreateAccounts(t *testing.T) {
+	assets := types.Coins{
+		types.NewInt64Coin(constants.DefaultBondDenom, 10000000000)}
+	coins := types.Coins{
+		types.NewInt64Coin(constants.DefaultBondDenom, 1000000)}
+	batchName1, batchName2 := types.MustName("batchuser001"), types.MustName("batchuser002")
+
+	Convey("accounts should be valid", t, func() {
+		msg := accountTypes.NewMsgCreateAccounts(addr2, account2, nil)
+		So(msg.ValidateBasic(), ShouldNotBeNil)
+
+		msg = accountTypes.NewMsgCreateAccounts(addr2, account2, []accountTypes.CreateAccountParam{
+			accountTypes.NewCreateAccountParam(batchName1, wallet.NewAccAddress(), coins),
+			accountTypes.NewCreateAccountParam(batchName1, wallet.NewAccAddress(), nil),
+		})
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrAccountHasCreated)
+
+		msg = accountTypes.NewMsgCreateAccounts(addr2, account2, []accountTypes.CreateAccountParam{
+			accountTypes.NewCreateAccountParam(batchName1, wallet.NewAccAddress(), coins),
+			accountTypes.NewCreateAccountParam(batchName2, wallet.NewAccAddress(), nil),
+		})
+		So(msg.ValidateBasic(), ShouldBeNil)
+	})
+
+	Convey("accounts created with the coins from the creator", t, func() {
+		genAccs := simapp.NewGenesisAccounts(
+			wallet.GetRootAuth(),
+			simapp.NewSimGenesisAccount(account2, addr2).WithAsset(assets))
+		app := simapp.SetupWithGenesisAccounts(genAccs)
+
+		auth1, auth2 := wallet.NewAccAddress(), wallet.NewAccAddress()
+		msg := accountTypes.NewMsgCreateAccounts(addr2, account2, []accountTypes.CreateAccountParam{
+			accountTypes.NewCreateAccountParam(batchName1, auth1, coins),
+			accountTypes.NewCreateAccountParam(batchName2, auth2, nil),
+		})
+		So(deliverAccountMsg(t, app, account2, addr2, true, &msg), ShouldBeNil)
+
+		ctx := app.NewTestContext()
+		auth, err := app.AccountKeeper().GetAuth(ctx, batchName1)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, auth1)
+
+		auth, err = app.AccountKeeper().GetAuth(ctx, batchName2)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, auth2)
+
+		balance, err := app.AssetKeeper().GetCoins(ctx, types.NewAccountIDFromName(batchName1))
+		So(err, ShouldBeNil)
+		So(balance, simapp.ShouldEq, coins)
+	})
+
+	Convey("no account created if any failed", t, func() {
+		genAccs := simapp.NewGenesisAccounts(
+			wallet.GetRootAuth(),
+			simapp.NewSimGenesisAccount(account2, addr2).WithAsset(assets))
+		app := simapp.SetupWithGenesisAccounts(genAccs)
+
+		// the creator has not enough coins for the second account
+		msg := accountTypes.NewMsgCreateAccounts(addr2, account2, []accountTypes.CreateAccountParam{
+			accountTypes.NewCreateAccountParam(batchName1, wallet.NewAccAddress(), coins),
+			accountTypes.NewCreateAccountParam(batchName2, wallet.NewAccAddress(), assets),
+		})
+		So(deliverAccountMsg(t, app, account2, addr2, false, &msg), ShouldNotBeNil)
+
+		// the name of the second account is invalid for a user creator
+		msg = accountTypes.NewMsgCreateAccounts(addr2, account2, []accountTypes.CreateAccountParam{
+			accountTypes.NewCreateAccountParam(batchName1, wallet.NewAccAddress(), coins),
+			accountTypes.NewCreateAccountParam(types.MustName("short"), wallet.NewAccAddress(), nil),
+		})
+		So(deliverAccountMsg(t, app, account2, addr2, false, &msg), simapp.ShouldErrIs, accountTypes.ErrAccountNameLenInvalid)
+
+		ctx := app.NewTestContext()
+		So(app.AccountKeeper().GetAccountByName(ctx, batchName1), ShouldBeNil)
+
+		balance, err := app.AssetKeeper().GetCoins(ctx, types.NewAccountIDFromName(batchName1))
+		So(err, ShouldBeNil)
+		So(balance.IsZero(), ShouldBeTrue)
+	})
+}
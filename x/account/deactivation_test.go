@@ -0,0 +1,116 @@
+package account_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+)
+
+func deliverAccountMsg(t *testing.T, app *simapp.SimApp, payer types.AccountID, auth types.AccAddress, shouldBeSuccess bool, msg sdk.Msg) error {
+	ctxCheck := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+	origAuthSeq, origAuthNum, err := app.AccountKeeper().GetAuthSequence(ctxCheck, auth)
+	So(err, ShouldBeNil)
+
+	fee := types.Coins{types.NewInt64Coin(constants.DefaultBondDenom, 100000)}
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = simapp.SignCheckDeliver(
+		t, app.Codec(), app.BaseApp,
+		header, payer, fee,
+		[]sdk.Msg{msg}, []uint64{origAuthNum}, []uint64{origAuthSeq},
+		shouldBeSuccess, shouldBeSuccess, wallet.PrivKey(auth))
+
+	return err
+}
+
+func TestDeactivateAccount(t *testing.T) {
+	assets := types.Coins{
+		types.NewInt64Coin(constants.DefaultBondDenom, 10000000000)}
+	genAccs := simapp.NewGenesisAccounts(
+		wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(assets),
+		simapp.NewSimGenesisAccount(account2, addr2).WithAsset(assets))
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	newAuth := wallet.NewAccAddress()
+
+	Convey("guardian cannot be the account self", t, func() {
+		msg := accountTypes.NewMsgDeactivateAccount(addr1, name1, account1, "lost key")
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrAccountGuardianInvalid)
+	})
+
+	Convey("deactivate account", t, func() {
+		msg := accountTypes.NewMsgDeactivateAccount(addr1, name1, account2, "lost key")
+		So(deliverAccountMsg(t, app, account1, addr1, true, &msg), ShouldBeNil)
+
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+		deactivation, ok := app.AccountKeeper().GetDeactivation(ctx, name1)
+		So(ok, ShouldBeTrue)
+		So(deactivation.Guardian, simapp.ShouldEq, account2)
+		So(deactivation.Reason, ShouldEqual, "lost key")
+		So(deactivation.Height, ShouldEqual, app.LastBlockHeight())
+
+		_, err := app.AccountKeeper().GetAuth(ctx, name1)
+		So(err, simapp.ShouldErrIs, accountTypes.ErrAccountDeactivated)
+	})
+
+	Convey("deactivated account cannot send tx", t, func() {
+		msg := accountTypes.NewMsgUpdateAccountAuth(addr1, name1, newAuth)
+		err := deliverAccountMsg(t, app, account1, addr1, false, &msg)
+		So(err, simapp.ShouldErrIs, accountTypes.ErrAccountDeactivated)
+	})
+
+	Convey("deactivated account cannot send tx with the fee paid by other account", t, func() {
+		ctxCheck := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		seq1, num1, err := app.AccountKeeper().GetAuthSequence(ctxCheck, addr1)
+		So(err, ShouldBeNil)
+		seq2, num2, err := app.AccountKeeper().GetAuthSequence(ctxCheck, addr2)
+		So(err, ShouldBeNil)
+
+		amount := types.Coins{types.NewInt64Coin(constants.DefaultBondDenom, 100)}
+		msgs := []sdk.Msg{
+			assetTypes.NewMsgTransfer(addr1, account1, account2, amount),
+			assetTypes.NewMsgTransfer(addr2, account2, account1, amount),
+		}
+
+		fee := types.Coins{types.NewInt64Coin(constants.DefaultBondDenom, 100000)}
+		header := abci.Header{Height: app.LastBlockHeight() + 1}
+		_, _, err = simapp.SignCheckDeliver(
+			t, app.Codec(), app.BaseApp,
+			header, account2, fee,
+			msgs, []uint64{num1, num2}, []uint64{seq1, seq2},
+			false, false, wallet.PrivKey(addr1), wallet.PrivKey(addr2))
+		So(err, simapp.ShouldErrIs, accountTypes.ErrAccountDeactivated)
+	})
+
+	Convey("only guardian can reactivate account", t, func() {
+		msg := accountTypes.NewMsgReactivateAccount(addr1, account1, name1, newAuth)
+		err := deliverAccountMsg(t, app, account1, addr1, false, &msg)
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("reactivate account by guardian with new auth", t, func() {
+		msg := accountTypes.NewMsgReactivateAccount(addr2, account2, name1, newAuth)
+		So(deliverAccountMsg(t, app, account2, addr2, true, &msg), ShouldBeNil)
+
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+		So(app.AccountKeeper().IsAccountDeactivated(ctx, name1), ShouldBeFalse)
+
+		auth, err := app.AccountKeeper().GetAuth(ctx, name1)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, newAuth)
+	})
+
+	Convey("reactivate account not deactivated", t, func() {
+		msg := accountTypes.NewMsgReactivateAccount(addr2, account2, name1, types.AccAddress{})
+		err := deliverAccountMsg(t, app, account2, addr2, false, &msg)
+		So(err, simapp.ShouldErrIs, accountTypes.ErrAccountNotDeactivated)
+	})
+}
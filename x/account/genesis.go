@@ -8,10 +8,11 @@ import (
 )
 
 // InitGenesis account genesis init
-func InitGenesis(ctx sdk.Context, ak Keeper, data json.RawMessage) {
+func InitGenesis(ctx sdk.Context, ak Keeper, auk AuctionKeeper, rk RecoveryKeeper, data json.RawMessage) {
 	logger := ak.Logger(ctx)
 
-	var genesisState GenesisState
+	// the genesis exported before the auctions and recoveries has no their params
+	genesisState := DefaultGenesisState()
 	ModuleCdc.MustUnmarshalJSON(data, &genesisState)
 
 	for _, a := range genesisState.Accounts {
@@ -24,10 +25,51 @@ func InitGenesis(ctx sdk.Context, ak Keeper, data json.RawMessage) {
 			ak.AddAccountByAuth(ctx, a.GetAuth(), a.GetName().String())
 		}
 	}
+
+	for _, d := range genesisState.Deactivations {
+		ak.SetDeactivation(ctx, d)
+	}
+
+	auk.SetAuctionParams(ctx, genesisState.AuctionParams)
+	for _, a := range genesisState.Auctions {
+		ak.SetAuction(ctx, a)
+		if !a.Settled {
+			ak.InsertAuctionQueue(ctx, a.Name, a.SettleHeight())
+		}
+	}
+
+	for _, b := range genesisState.AuctionBids {
+		ak.SetAuctionBid(ctx, b)
+	}
+
+	for _, o := range genesisState.NameOffers {
+		ak.SetNameOffer(ctx, o)
+	}
+
+	auk.EnsureModuleAccount(ctx)
+
+	rk.SetRecoveryParams(ctx, genesisState.RecoveryParams)
+	for _, g := range genesisState.Guardians {
+		ak.SetGuardians(ctx, g)
+	}
+
+	for _, r := range genesisState.Recoveries {
+		ak.SetRecovery(ctx, r)
+		ak.InsertRecoveryQueue(ctx, r.Account, r.QueueHeight())
+	}
+
+	for _, s := range genesisState.SubAccounts {
+		ak.SetSubAccount(ctx, s)
+	}
+
+	for _, p := range genesisState.PendingAuths {
+		ak.SetPendingAuth(ctx, p)
+		ak.InsertPendingAuthQueue(ctx, p.Account, p.ActiveHeight)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
-func ExportGenesis(ctx sdk.Context, ak Keeper) GenesisState {
+func ExportGenesis(ctx sdk.Context, ak Keeper, auk AuctionKeeper, rk RecoveryKeeper) GenesisState {
 	var genAccounts exported.GenesisAccounts
 	ak.IterateAccounts(ctx, func(account exported.Account) bool {
 		genAccounts = append(genAccounts, account.(exported.GenesisAccount))
@@ -35,6 +77,16 @@ func ExportGenesis(ctx sdk.Context, ak Keeper) GenesisState {
 	})
 
 	return GenesisState{
-		Accounts: genAccounts,
+		Accounts:       genAccounts,
+		Deactivations:  ak.GetDeactivations(ctx),
+		AuctionParams:  auk.GetAuctionParams(ctx),
+		Auctions:       ak.GetAuctions(ctx),
+		AuctionBids:    ak.GetAllAuctionBids(ctx),
+		RecoveryParams: rk.GetRecoveryParams(ctx),
+		Guardians:      ak.GetAllGuardians(ctx),
+		Recoveries:     ak.GetRecoveries(ctx),
+		SubAccounts:    ak.GetAllSubAccounts(ctx),
+		PendingAuths:   ak.GetPendingAuths(ctx),
+		NameOffers:     ak.GetAllNameOffers(ctx),
 	}
 }
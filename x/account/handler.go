@@ -1,6 +1,10 @@
 package account
 
 import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
 	"github.com/KuChainNetwork/kuchain/chain/constants"
 	"github.com/KuChainNetwork/kuchain/chain/msg"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
@@ -10,13 +14,49 @@ import (
 )
 
 // NewHandler returns a handler for "bank" type messages.
-func NewHandler(k Keeper) msg.Handler {
+func NewHandler(k Keeper, auk AuctionKeeper, rk RecoveryKeeper, transfer chainTypes.AssetTransfer) msg.Handler {
 	return func(ctx chainTypes.Context, msg sdk.Msg) (*sdk.Result, error) {
 		switch msg := msg.(type) {
 		case *types.MsgCreateAccount:
 			return handleMsgCreateAccount(ctx, k, msg)
+		case *types.MsgCreateAccounts:
+			return handleMsgCreateAccounts(ctx, k, transfer, msg)
 		case *types.MsgUpdateAccountAuth:
-			return handleMsgUpdateAccountAuth(ctx, k, msg)
+			return handleMsgUpdateAccountAuth(ctx, k, rk, msg)
+		case *types.MsgDeactivateAccount:
+			return handleMsgDeactivateAccount(ctx, k, msg)
+		case *types.MsgReactivateAccount:
+			return handleMsgReactivateAccount(ctx, k, msg)
+		case *types.MsgSetMemoKey:
+			return handleMsgSetMemoKey(ctx, k, msg)
+		case *types.MsgStartAuction:
+			return handleMsgStartAuction(ctx, auk, msg)
+		case *types.MsgAuctionBid:
+			return handleMsgAuctionBid(ctx, auk, msg)
+		case *types.MsgRevealAuctionBid:
+			return handleMsgRevealAuctionBid(ctx, auk, msg)
+		case *types.MsgClaimAuction:
+			return handleMsgClaimAuction(ctx, auk, msg)
+		case *types.MsgSetGuardians:
+			return handleMsgSetGuardians(ctx, k, rk, msg)
+		case *types.MsgInitiateRecovery:
+			return handleMsgInitiateRecovery(ctx, rk, msg)
+		case *types.MsgApproveRecovery:
+			return handleMsgApproveRecovery(ctx, rk, msg)
+		case *types.MsgCancelRecovery:
+			return handleMsgCancelRecovery(ctx, k, rk, msg)
+		case *types.MsgCreateSubAccount:
+			return handleMsgCreateSubAccount(ctx, k, msg)
+		case *types.MsgSetSubAccountPermissions:
+			return handleMsgSetSubAccountPermissions(ctx, k, msg)
+		case *types.MsgCancelAuthRotation:
+			return handleMsgCancelAuthRotation(ctx, k, rk, msg)
+		case *types.MsgOfferAccountName:
+			return handleMsgOfferAccountName(ctx, auk, msg)
+		case *types.MsgCancelNameOffer:
+			return handleMsgCancelNameOffer(ctx, auk, msg)
+		case *types.MsgTransferAccountName:
+			return handleMsgTransferAccountName(ctx, k, auk, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized account message type: %T", msg)
 		}
@@ -25,8 +65,6 @@ func NewHandler(k Keeper) msg.Handler {
 
 // handleMsgCreateAccount handler msg create account
 func handleMsgCreateAccount(ctx chainTypes.Context, k Keeper, msg *types.MsgCreateAccount) (*sdk.Result, error) {
-	logger := ctx.Logger()
-
 	msgData, err := msg.GetData()
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "msg create account data unmarshal error")
@@ -34,57 +72,117 @@ func handleMsgCreateAccount(ctx chainTypes.Context, k Keeper, msg *types.MsgCrea
 
 	ctx.RequireAuth(msgData.Creator)
 
-	if constants.IsSystemAccount(msgData.Name) {
-		return nil, types.ErrAccountCannotCreateSysAccount
+	if err := createAccount(ctx, k, msgData.Creator, msgData.Name, msgData.Auth); err != nil {
+		return nil, err
+	}
+
+	res := types.MsgCreateAccountResponse{Name: msgData.Name}
+	return chainTypes.NewMsgResult(types.Cdc(), res, ctx.EventManager().Events()), nil
+}
+
+// handleMsgCreateAccounts handler msg create accounts in batch, with the initial coins from the creator
+func handleMsgCreateAccounts(ctx chainTypes.Context, k Keeper, transfer chainTypes.AssetTransfer, m *types.MsgCreateAccounts) (*sdk.Result, error) {
+	msgData, err := m.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg create accounts data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg create accounts", "creator", msgData.Creator, "num", len(msgData.Accounts))
+
+	ctx.RequireAuth(msgData.Creator)
+
+	// the state is reverted if any account failed, as the msg failed
+	names := make([]chainTypes.Name, 0, len(msgData.Accounts))
+	for _, a := range msgData.Accounts {
+		if err := createAccount(ctx, k, msgData.Creator, a.Name, a.Auth); err != nil {
+			return nil, err
+		}
+
+		if !a.Coins.IsZero() {
+			to := chainTypes.NewAccountIDFromName(a.Name)
+			if err := transfer.Transfer(ctx.Context(), msgData.Creator, to, a.Coins); err != nil {
+				return nil, sdkerrors.Wrapf(err, "transfer to %s", a.Name)
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					msg.EventTypeTransfer,
+					sdk.NewAttribute(sdk.AttributeKeyModule, chainTypes.KuCodeSpace),
+					sdk.NewAttribute(msg.AttributeKeyFrom, msgData.Creator.String()),
+					sdk.NewAttribute(msg.AttributeKeyTo, to.String()),
+					sdk.NewAttribute(msg.AttributeKeyAmount, a.Coins.String()),
+				),
+			)
+		}
+
+		names = append(names, a.Name)
+	}
+
+	res := types.MsgCreateAccountsResponse{Names: names}
+	return chainTypes.NewMsgResult(types.Cdc(), res, ctx.EventManager().Events()), nil
+}
+
+// createAccount creates the account by the creator with the auth
+func createAccount(ctx chainTypes.Context, k Keeper, creatorID chainTypes.AccountID, name chainTypes.Name, auth chainTypes.AccAddress) error {
+	logger := ctx.Logger()
+
+	if constants.IsSystemAccount(name) {
+		return types.ErrAccountCannotCreateSysAccount
 	}
 
 	// user only can create 12-length account
-	if creator, ok := msgData.Creator.ToName(); ok && constants.IsSystemAccount(creator) {
+	if creator, ok := creatorID.ToName(); ok && constants.IsSystemAccount(creator) {
 		// system account can create accounts
 	} else {
-		if msgData.Name.Len() != 12 {
-			return nil, types.ErrAccountNameLenInvalid
+		if name.Len() != 12 {
+			return types.ErrAccountNameLenInvalid
 		}
 
 		// TODO: should use name
-		if !chainTypes.VerifyNameString(msgData.Name.String()) {
-			return nil, types.ErrAccountNameInvalid
+		if !chainTypes.VerifyNameString(name.String()) {
+			return types.ErrAccountNameInvalid
 		}
 	}
 
-	logger.Debug("msg create account", "name", msgData.Name, "creator", msgData.Creator)
+	logger.Debug("msg create account", "name", name, "creator", creatorID)
 
-	if a := k.GetAccountByName(ctx.Context(), msgData.Name); a != nil {
-		logger.Debug("account has already created", "name", msgData.Name)
-		return nil, sdkerrors.Wrapf(types.ErrAccountHasCreated, "name %s", msgData.Name)
+	if a := k.GetAccountByName(ctx.Context(), name); a != nil {
+		logger.Debug("account has already created", "name", name)
+		return sdkerrors.Wrapf(types.ErrAccountHasCreated, "name %s", name)
 	}
 
-	newAccount := k.NewAccountByName(ctx.Context(), msgData.Name)
-	if err := newAccount.SetAuth(msgData.Auth); err != nil {
-		return nil, sdkerrors.Wrapf(err, "set auth to account error")
+	// the name in auction can only be claimed by the winner
+	if k.IsNameReserved(ctx.Context(), name) {
+		return sdkerrors.Wrapf(types.ErrAccountNameReserved, "name %s", name)
+	}
+
+	newAccount := k.NewAccountByName(ctx.Context(), name)
+	if err := newAccount.SetAuth(auth); err != nil {
+		return sdkerrors.Wrapf(err, "set auth to account error")
 	}
 
 	// set account
 	k.SetAccount(ctx.Context(), newAccount)
+	k.SetAccountCreatedHeight(ctx.Context(), name, ctx.BlockHeight())
 
 	// add auth
-	k.EnsureAuthInited(ctx.Context(), msgData.Auth)
-	k.AddAccountByAuth(ctx.Context(), msgData.Auth, newAccount.GetName().String())
+	k.EnsureAuthInited(ctx.Context(), auth)
+	k.AddAccountByAuth(ctx.Context(), auth, newAccount.GetName().String())
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateAccount,
-			sdk.NewAttribute(types.AttributeKeyCreator, msg.From.String()),
-			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
-			sdk.NewAttribute(types.AttributeKeyAuth, msgData.Auth.String()),
+			sdk.NewAttribute(types.AttributeKeyCreator, creatorID.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, name.String()),
+			sdk.NewAttribute(types.AttributeKeyAuth, auth.String()),
 		),
 	})
 
-	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+	return nil
 }
 
 // handleMsgUpdateAccountAuth handler msg update account auth
-func handleMsgUpdateAccountAuth(ctx chainTypes.Context, k Keeper, msg *types.MsgUpdateAccountAuth) (*sdk.Result, error) {
+func handleMsgUpdateAccountAuth(ctx chainTypes.Context, k Keeper, rk RecoveryKeeper, msg *types.MsgUpdateAccountAuth) (*sdk.Result, error) {
 	logger := ctx.Logger()
 
 	msgData := types.MsgUpdateAccountAuthData{}
@@ -104,23 +202,622 @@ func handleMsgUpdateAccountAuth(ctx chainTypes.Context, k Keeper, msg *types.Msg
 	oldAuth := accountStat.GetAuth()
 	ctx.RequireAccountAuth(oldAuth)
 
-	if err := accountStat.SetAuth(msgData.Auth); err != nil {
+	pending, isPending, err := rk.UpdateAuth(ctx.Context(), accountStat, msgData.Auth)
+	if err != nil {
+		return nil, err
+	}
+
+	// the new auth becomes active after the delay, the old auth can cancel it before that
+	if isPending {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeScheduleAuth,
+				sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+				sdk.NewAttribute(types.AttributeKeyAuth, msgData.Auth.String()),
+				sdk.NewAttribute(types.AttributeKeyOldAuth, oldAuth.String()),
+				sdk.NewAttribute(types.AttributeKeyActiveHeight, strconv.FormatInt(pending.ActiveHeight, 10)),
+			),
+		})
+
+		return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateAccountAuth,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyAuth, msgData.Auth.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgDeactivateAccount handler msg deactivate account
+func handleMsgDeactivateAccount(ctx chainTypes.Context, k Keeper, msg *types.MsgDeactivateAccount) (*sdk.Result, error) {
+	logger := ctx.Logger()
+
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg deactivate account data unmarshal error")
+	}
+
+	logger.Debug("msg deactivate account", "name", msgData.Name, "guardian", msgData.Guardian)
+
+	accountStat := k.GetAccountByName(ctx.Context(), msgData.Name)
+	if accountStat == nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", msgData.Name)
+	}
+
+	if k.IsAccountDeactivated(ctx.Context(), msgData.Name) {
+		return nil, sdkerrors.Wrapf(types.ErrAccountDeactivated, "name %s", msgData.Name)
+	}
+
+	ctx.RequireAccountAuth(accountStat.GetAuth())
+
+	// guardian should exist to reactivate the account
+	if name, ok := msgData.Guardian.ToName(); ok {
+		if k.GetAccountByName(ctx.Context(), name) == nil {
+			return nil, sdkerrors.Wrapf(types.ErrAccountGuardianInvalid, "guardian %s no found", msgData.Guardian)
+		}
+	}
+
+	k.SetDeactivation(ctx.Context(),
+		types.NewDeactivation(msgData.Name, msgData.Guardian, msgData.Reason, ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeactivateAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyGuardian, msgData.Guardian.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, msgData.Reason),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgReactivateAccount handler msg reactivate account, by the guardian
+func handleMsgReactivateAccount(ctx chainTypes.Context, k Keeper, msg *types.MsgReactivateAccount) (*sdk.Result, error) {
+	logger := ctx.Logger()
+
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg reactivate account data unmarshal error")
+	}
+
+	logger.Debug("msg reactivate account", "name", msgData.Name, "guardian", msgData.Guardian, "auth", msgData.Auth)
+
+	deactivation, ok := k.GetDeactivation(ctx.Context(), msgData.Name)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNotDeactivated, "name %s", msgData.Name)
+	}
+
+	if !deactivation.Guardian.Eq(msgData.Guardian) {
+		return nil, sdkerrors.Wrapf(types.ErrAccountGuardianInvalid, "guardian of %s is %s", msgData.Name, deactivation.Guardian)
+	}
+
+	ctx.RequireAuth(msgData.Guardian)
+
+	accountStat := k.GetAccountByName(ctx.Context(), msgData.Name)
+	if accountStat == nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", msgData.Name)
+	}
+
+	// reset auth if the old key is compromised
+	if !msgData.Auth.Empty() && !msgData.Auth.Equals(accountStat.GetAuth()) {
+		if err := k.ValidateSubAccountAuth(ctx.Context(), msgData.Name, msgData.Auth); err != nil {
+			return nil, err
+		}
+
+		if err := k.RotateAuth(ctx.Context(), accountStat, msgData.Auth); err != nil {
+			return nil, err
+		}
+	}
+
+	k.DeleteDeactivation(ctx.Context(), msgData.Name)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReactivateAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyGuardian, msgData.Guardian.String()),
+			sdk.NewAttribute(types.AttributeKeyAuth, accountStat.GetAuth().String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgSetMemoKey handler msg set the memo key of account
+func handleMsgSetMemoKey(ctx chainTypes.Context, k Keeper, msg *types.MsgSetMemoKey) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg set memo key data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg set memo key", "name", msgData.Name)
+
+	accountStat := k.GetAccountByName(ctx.Context(), msgData.Name)
+	if accountStat == nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", msgData.Name)
+	}
+
+	ctx.RequireAccountAuth(accountStat.GetAuth())
+
+	k.SetMemoKey(ctx.Context(), msgData.Name, msgData.MemoKey)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetMemoKey,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyMemoKey, hex.EncodeToString(msgData.MemoKey)),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgStartAuction handler msg start the auction of a premium name
+func handleMsgStartAuction(ctx chainTypes.Context, k AuctionKeeper, msg *types.MsgStartAuction) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg start auction data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg start auction", "name", msgData.Name, "starter", msgData.Starter, "type", msgData.AuctionType)
+
+	ctx.RequireAuth(msgData.Starter)
+
+	auction, err := k.StartAuction(ctx.Context(), msgData.Starter, msgData.Name, msgData.AuctionType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStartAuction,
+			sdk.NewAttribute(types.AttributeKeyCreator, msgData.Starter.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, string(auction.Type)),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(auction.EndHeight, 10)),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgAuctionBid handler msg bid in the auction of a premium name
+func handleMsgAuctionBid(ctx chainTypes.Context, k AuctionKeeper, msg *types.MsgAuctionBid) (*sdk.Result, error) {
+	msgData := types.MsgAuctionBidData{}
+	if err := msg.UnmarshalData(types.Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg auction bid data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg auction bid", "name", msgData.Name, "bidder", msgData.Bidder, "amount", msgData.Amount)
+
+	// the bid should be transferred to module account by the msg
+	if !msg.GetTo().Eq(types.ModuleAccountID) || !msg.GetAmount().IsEqual(chainTypes.NewCoins(msgData.Amount)) {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionBidNotTransferred, "bid %s", msgData.Amount)
+	}
+
+	ctx.RequireAuth(msgData.Bidder)
+
+	if err := k.Bid(ctx.Context(), msgData.Bidder, msgData.Name, msgData.Amount, msgData.Commitment); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAuctionBid,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyBidder, msgData.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msgData.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCommitment, hex.EncodeToString(msgData.Commitment)),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgRevealAuctionBid handler msg reveal the bid in the sealed auction of a premium name
+func handleMsgRevealAuctionBid(ctx chainTypes.Context, k AuctionKeeper, msg *types.MsgRevealAuctionBid) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg reveal auction bid data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg reveal auction bid", "name", msgData.Name, "bidder", msgData.Bidder, "amount", msgData.Amount)
+
+	ctx.RequireAuth(msgData.Bidder)
+
+	if err := k.RevealBid(ctx.Context(), msgData.Bidder, msgData.Name, msgData.Amount, msgData.Salt); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevealAuctionBid,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyBidder, msgData.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msgData.Amount.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgClaimAuction handler msg claim the name won in the auction
+func handleMsgClaimAuction(ctx chainTypes.Context, k AuctionKeeper, msg *types.MsgClaimAuction) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg claim auction data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg claim auction", "name", msgData.Name, "winner", msgData.Winner, "auth", msgData.Auth)
+
+	ctx.RequireAuth(msgData.Winner)
+
+	if _, err := k.ClaimAuction(ctx.Context(), msgData.Winner, msgData.Name, msgData.Auth); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimAuction,
+			sdk.NewAttribute(types.AttributeKeyWinner, msgData.Winner.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyAuth, msgData.Auth.String()),
+		),
+	})
+
+	res := types.MsgCreateAccountResponse{Name: msgData.Name}
+	return chainTypes.NewMsgResult(types.Cdc(), res, ctx.EventManager().Events()), nil
+}
+
+// handleMsgSetGuardians handler msg set the guardians of account
+func handleMsgSetGuardians(ctx chainTypes.Context, k Keeper, rk RecoveryKeeper, msg *types.MsgSetGuardians) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg set guardians data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg set guardians", "name", msgData.Name, "guardians", msgData.Guardians, "threshold", msgData.Threshold)
+
+	accountStat := k.GetAccountByName(ctx.Context(), msgData.Name)
+	if accountStat == nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", msgData.Name)
+	}
+
+	ctx.RequireAccountAuth(accountStat.GetAuth())
+
+	if err := rk.SetGuardians(ctx.Context(),
+		types.NewGuardians(msgData.Name, msgData.Guardians, msgData.Threshold)); err != nil {
+		return nil, err
+	}
+
+	guardians := make([]string, 0, len(msgData.Guardians))
+	for _, g := range msgData.Guardians {
+		guardians = append(guardians, g.String())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetGuardians,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyGuardians, strings.Join(guardians, ",")),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(msgData.Threshold), 10)),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgInitiateRecovery handler msg initiate the recovery of account, by a guardian
+func handleMsgInitiateRecovery(ctx chainTypes.Context, rk RecoveryKeeper, msg *types.MsgInitiateRecovery) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg initiate recovery data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg initiate recovery", "name", msgData.Name, "guardian", msgData.Guardian, "auth", msgData.Auth)
+
+	ctx.RequireAuth(msgData.Guardian)
+
+	recovery, err := rk.InitiateRecovery(ctx.Context(), msgData.Guardian, msgData.Name, msgData.Auth)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeInitiateRecovery,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyGuardian, msgData.Guardian.String()),
+			sdk.NewAttribute(types.AttributeKeyAuth, msgData.Auth.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockHeight, strconv.FormatInt(recovery.UnlockHeight, 10)),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgApproveRecovery handler msg approve the recovery of account, by a guardian
+func handleMsgApproveRecovery(ctx chainTypes.Context, rk RecoveryKeeper, msg *types.MsgApproveRecovery) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg approve recovery data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg approve recovery", "name", msgData.Name, "guardian", msgData.Guardian, "auth", msgData.Auth)
+
+	ctx.RequireAuth(msgData.Guardian)
+
+	recovery, err := rk.ApproveRecovery(ctx.Context(), msgData.Guardian, msgData.Name, msgData.Auth)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApproveRecovery,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyGuardian, msgData.Guardian.String()),
+			sdk.NewAttribute(types.AttributeKeyApprovals, strconv.Itoa(len(recovery.Approvals))),
+			sdk.NewAttribute(types.AttributeKeyUnlockHeight, strconv.FormatInt(recovery.UnlockHeight, 10)),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgCancelRecovery handler msg cancel the recovery of account, by the account self
+func handleMsgCancelRecovery(ctx chainTypes.Context, k Keeper, rk RecoveryKeeper, msg *types.MsgCancelRecovery) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg cancel recovery data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg cancel recovery", "name", msgData.Name)
+
+	accountStat := k.GetAccountByName(ctx.Context(), msgData.Name)
+	if accountStat == nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", msgData.Name)
+	}
+
+	ctx.RequireAccountAuth(accountStat.GetAuth())
+
+	if err := rk.CancelRecovery(ctx.Context(), msgData.Name); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelRecovery,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgCreateSubAccount handler msg create the sub-account, by the parent account
+func handleMsgCreateSubAccount(ctx chainTypes.Context, k Keeper, msg *types.MsgCreateSubAccount) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg create sub-account data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg create sub-account", "name", msgData.Name, "parent", msgData.Parent, "auth", msgData.Auth)
+
+	ctx.RequireAuth(chainTypes.NewAccountIDFromName(msgData.Parent))
+
+	subAccount := types.NewSubAccount(msgData.Name, msgData.Parent, msgData.Permissions)
+	if err := subAccount.Validate(); err != nil {
+		return nil, err
+	}
+
+	if k.GetAccountByName(ctx.Context(), msgData.Parent) == nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNoFound, "parent %s", msgData.Parent)
+	}
+
+	if a := k.GetAccountByName(ctx.Context(), msgData.Name); a != nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountHasCreated, "name %s", msgData.Name)
+	}
+
+	if k.IsNameReserved(ctx.Context(), msgData.Name) {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNameReserved, "name %s", msgData.Name)
+	}
+
+	if err := k.ValidateAuthNotUsedByOthers(ctx.Context(), msgData.Name, msgData.Auth); err != nil {
+		return nil, err
+	}
+
+	newAccount := k.NewAccountByName(ctx.Context(), msgData.Name)
+	if err := newAccount.SetAuth(msgData.Auth); err != nil {
 		return nil, sdkerrors.Wrapf(err, "set auth to account error")
 	}
 
-	// set account
-	k.SetAccount(ctx.Context(), accountStat)
+	k.SetAccount(ctx.Context(), newAccount)
+	k.SetSubAccount(ctx.Context(), subAccount)
 
-	// add auth
 	k.EnsureAuthInited(ctx.Context(), msgData.Auth)
-	k.AddAccountByAuth(ctx.Context(), msgData.Auth, accountStat.GetName().String())
-	k.DeleteAccountByAuth(ctx.Context(), oldAuth, accountStat.GetName().String())
+	k.AddAccountByAuth(ctx.Context(), msgData.Auth, newAccount.GetName().String())
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeUpdateAccountAuth,
+			types.EventTypeCreateSubAccount,
+			sdk.NewAttribute(types.AttributeKeyParent, msgData.Parent.String()),
 			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
 			sdk.NewAttribute(types.AttributeKeyAuth, msgData.Auth.String()),
+			sdk.NewAttribute(types.AttributeKeyPermissions, strings.Join(msgData.Permissions, ",")),
+		),
+	})
+
+	res := types.MsgCreateAccountResponse{Name: msgData.Name}
+	return chainTypes.NewMsgResult(types.Cdc(), res, ctx.EventManager().Events()), nil
+}
+
+// handleMsgSetSubAccountPermissions handler msg set the permissions of the sub-account, by the parent account
+func handleMsgSetSubAccountPermissions(ctx chainTypes.Context, k Keeper, msg *types.MsgSetSubAccountPermissions) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg set sub-account permissions data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg set sub-account permissions", "name", msgData.Name, "permissions", msgData.Permissions)
+
+	subAccount, ok := k.GetSubAccount(ctx.Context(), msgData.Name)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrSubAccountNoFound, "name %s", msgData.Name)
+	}
+
+	ctx.RequireAuth(chainTypes.NewAccountIDFromName(subAccount.Parent))
+
+	subAccount.Permissions = msgData.Permissions
+	if err := subAccount.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetSubAccount(ctx.Context(), subAccount)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetSubAccountPerm,
+			sdk.NewAttribute(types.AttributeKeyParent, subAccount.Parent.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyPermissions, strings.Join(msgData.Permissions, ",")),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgCancelAuthRotation handler msg cancel the pending auth rotation of account, by the old auth
+func handleMsgCancelAuthRotation(ctx chainTypes.Context, k Keeper, rk RecoveryKeeper, msg *types.MsgCancelAuthRotation) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg cancel auth rotation data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg cancel auth rotation", "name", msgData.Name)
+
+	accountStat := k.GetAccountByName(ctx.Context(), msgData.Name)
+	if accountStat == nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", msgData.Name)
+	}
+
+	// the auth of the account is still the old auth before the new auth active
+	ctx.RequireAccountAuth(accountStat.GetAuth())
+
+	pending, err := rk.CancelAuthRotation(ctx.Context(), msgData.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelAuth,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyAuth, pending.Auth.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgOfferAccountName handler msg offer to buy the account name, the price is held by the module account
+func handleMsgOfferAccountName(ctx chainTypes.Context, k AuctionKeeper, msg *types.MsgOfferAccountName) (*sdk.Result, error) {
+	msgData := types.MsgOfferAccountNameData{}
+	if err := msg.UnmarshalData(types.Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg offer account name data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg offer account name", "name", msgData.Name, "buyer", msgData.Buyer, "price", msgData.Price)
+
+	// the price should be transferred to module account by the msg
+	if !msgData.Price.IsZero() &&
+		(!msg.GetTo().Eq(types.ModuleAccountID) || !msg.GetAmount().IsEqual(msgData.Price)) {
+		return nil, sdkerrors.Wrapf(types.ErrNameOfferNotTransferred, "price %s", msgData.Price)
+	}
+
+	ctx.RequireAuth(msgData.Buyer)
+
+	offer := types.NewNameOffer(msgData.Name, msgData.Buyer, msgData.Auth, msgData.Price, ctx.BlockHeight())
+	if err := k.OfferName(ctx.Context(), offer); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeOfferName,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyBuyer, msgData.Buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyAuth, msgData.Auth.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, msgData.Price.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgCancelNameOffer handler msg cancel the offer for the account name, by the buyer
+func handleMsgCancelNameOffer(ctx chainTypes.Context, k AuctionKeeper, msg *types.MsgCancelNameOffer) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg cancel name offer data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg cancel name offer", "name", msgData.Name, "buyer", msgData.Buyer)
+
+	ctx.RequireAuth(msgData.Buyer)
+
+	offer, err := k.CancelNameOffer(ctx.Context(), msgData.Name, msgData.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelNameOffer,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyBuyer, msgData.Buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, offer.Price.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgTransferAccountName handler msg transfer the account name to the buyer of the offer, by the owner
+func handleMsgTransferAccountName(ctx chainTypes.Context, k Keeper, auk AuctionKeeper, msg *types.MsgTransferAccountName) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg transfer account name data unmarshal error")
+	}
+
+	ctx.Logger().Debug("msg transfer account name", "name", msgData.Name, "buyer", msgData.Buyer, "receiver", msgData.Receiver)
+
+	accountStat := k.GetAccountByName(ctx.Context(), msgData.Name)
+	if accountStat == nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", msgData.Name)
+	}
+
+	ctx.RequireAccountAuth(accountStat.GetAuth())
+
+	offer, err := auk.TransferName(ctx.Context(), msgData.Name, msgData.Buyer, msgData.Receiver, msgData.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferName,
+			sdk.NewAttribute(types.AttributeKeyAccount, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyBuyer, msgData.Buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyAuth, offer.Auth.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msgData.Receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, offer.Price.String()),
 		),
 	})
 
@@ -72,6 +72,13 @@ func TestCreateNormalAccount(t *testing.T) {
 			So(accCreated.GetName().Eq(name1), ShouldBeTrue)
 			So(accCreated.GetAccountNumber() == 0, ShouldBeTrue) // we no use this now
 
+			createdHeight, ok := app.AccountKeeper().GetAccountCreatedHeight(ctxCheck, account1)
+			So(ok, ShouldBeTrue)
+			So(createdHeight, ShouldEqual, header.Height)
+
+			_, ok = app.AccountKeeper().GetAccountCreatedHeight(ctxCheck, constants.SystemAccountID)
+			So(ok, ShouldBeFalse)
+
 			// Check new account auth
 			addr1Seq, addr1Num, err := app.AccountKeeper().GetAuthSequence(ctxCheck, addr1)
 			So(err, ShouldBeNil)
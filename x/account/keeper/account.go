@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/account/exported"
 	"github.com/KuChainNetwork/kuchain/x/account/types"
@@ -53,6 +55,29 @@ func (ak AccountKeeper) SetAccount(ctx sdk.Context, acc exported.Account) {
 	store.Set(types.AccountIDStoreKey(n), bz)
 }
 
+// SetAccountCreatedHeight sets the height the account created by the msg
+func (ak AccountKeeper) SetAccountCreatedHeight(ctx sdk.Context, name Name, height int64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	ctx.KVStore(ak.key).Set(types.CreatedHeightStoreKey(name), bz)
+}
+
+// GetAccountCreatedHeight returns the height the account created, the accounts in genesis
+// and the accounts not named have no created height.
+func (ak AccountKeeper) GetAccountCreatedHeight(ctx sdk.Context, id AccountID) (int64, bool) {
+	name, ok := id.ToName()
+	if !ok {
+		return 0, false
+	}
+
+	bz := ctx.KVStore(ak.key).Get(types.CreatedHeightStoreKey(name))
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
 // EnsureAccount ensure account is exist, if not create a account with init data
 func (ak AccountKeeper) EnsureAccount(ctx sdk.Context, id AccountID) error {
 	if accAddress, ok := id.ToAccAddress(); ok {
@@ -99,9 +99,9 @@ func TestIterateAccount(t *testing.T) {
 			return false
 		})
 
-		So(len(names), ShouldEqual, (1 + 6 + 4)) // kuchain, 6 module account, and 4 genesis account
+		So(len(names), ShouldEqual, (1 + 10 + 4)) // kuchain, 10 module account, and 4 genesis account
 		ids := []string{constants.SystemAccountID.String(),
-			"mint", "kugov", "kustaking", "kubondedpool", "kudistribution", "kunotbondedpool",
+			"mint", "kugov", "kustaking", "kubondedpool", "kudistribution", "kunotbondedpool", "paychan", "liquidstake", "insurance", "account",
 			account1.String(), account2.String(), addr1.String(), acc3.GetID().String()}
 
 		for _, id := range ids {
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetAuction get the auction of the name, return false if the name is not in auction
+func (ak AccountKeeper) GetAuction(ctx sdk.Context, name Name) (types.NameAuction, bool) {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.AuctionStoreKey(name))
+	if bz == nil {
+		return types.NameAuction{}, false
+	}
+
+	var res types.NameAuction
+	ak.cdc.MustUnmarshalBinaryBare(bz, &res)
+
+	return res, true
+}
+
+// SetAuction set the auction of the name
+func (ak AccountKeeper) SetAuction(ctx sdk.Context, auction types.NameAuction) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.AuctionStoreKey(auction.Name), ak.cdc.MustMarshalBinaryBare(auction))
+}
+
+// DeleteAuction delete the auction of the name, the name is released
+func (ak AccountKeeper) DeleteAuction(ctx sdk.Context, name Name) {
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.AuctionStoreKey(name))
+}
+
+// IsNameReserved return true if the name is reserved by an auction, which can only be claimed by the winner
+func (ak AccountKeeper) IsNameReserved(ctx sdk.Context, name Name) bool {
+	store := ctx.KVStore(ak.key)
+	return store.Has(types.AuctionStoreKey(name))
+}
+
+// IterateAuctions iterates over all the auctions and performs a callback function
+func (ak AccountKeeper) IterateAuctions(ctx sdk.Context, cb func(auction types.NameAuction) (stop bool)) {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.AuctionStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.NameAuction
+		ak.cdc.MustUnmarshalBinaryBare(iterator.Value(), &auction)
+
+		if cb(auction) {
+			break
+		}
+	}
+}
+
+// GetAuctions get all the auctions
+func (ak AccountKeeper) GetAuctions(ctx sdk.Context) []types.NameAuction {
+	res := make([]types.NameAuction, 0)
+	ak.IterateAuctions(ctx, func(auction types.NameAuction) bool {
+		res = append(res, auction)
+		return false
+	})
+
+	return res
+}
+
+// GetAuctionBid get the bid of the bidder in the sealed auction of the name
+func (ak AccountKeeper) GetAuctionBid(ctx sdk.Context, name Name, bidder AccountID) (types.NameAuctionBid, bool) {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.AuctionBidStoreKey(name, bidder))
+	if bz == nil {
+		return types.NameAuctionBid{}, false
+	}
+
+	var res types.NameAuctionBid
+	ak.cdc.MustUnmarshalBinaryBare(bz, &res)
+
+	return res, true
+}
+
+// SetAuctionBid set the bid of the sealed auction
+func (ak AccountKeeper) SetAuctionBid(ctx sdk.Context, bid types.NameAuctionBid) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.AuctionBidStoreKey(bid.Name, bid.Bidder), ak.cdc.MustMarshalBinaryBare(bid))
+}
+
+// DeleteAuctionBid delete the bid of the bidder in the sealed auction of the name
+func (ak AccountKeeper) DeleteAuctionBid(ctx sdk.Context, name Name, bidder AccountID) {
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.AuctionBidStoreKey(name, bidder))
+}
+
+// GetAuctionBids get the bids of the sealed auction of the name
+func (ak AccountKeeper) GetAuctionBids(ctx sdk.Context, name Name) []types.NameAuctionBid {
+	return ak.getAuctionBidsByPrefix(ctx, types.AuctionBidsStorePrefix(name))
+}
+
+// GetAllAuctionBids get the bids of all the sealed auctions
+func (ak AccountKeeper) GetAllAuctionBids(ctx sdk.Context) []types.NameAuctionBid {
+	return ak.getAuctionBidsByPrefix(ctx, types.AuctionBidStoreKeyPrefix)
+}
+
+func (ak AccountKeeper) getAuctionBidsByPrefix(ctx sdk.Context, prefix []byte) []types.NameAuctionBid {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	res := make([]types.NameAuctionBid, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.NameAuctionBid
+		ak.cdc.MustUnmarshalBinaryBare(iterator.Value(), &bid)
+		res = append(res, bid)
+	}
+
+	return res
+}
+
+// InsertAuctionQueue inserts the auction of the name to the settle queue at the end height
+func (ak AccountKeeper) InsertAuctionQueue(ctx sdk.Context, name Name, endHeight int64) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.AuctionQueueStoreKey(endHeight, name), name.Bytes())
+}
+
+// RemoveFromAuctionQueue removes the auction of the name from the settle queue
+func (ak AccountKeeper) RemoveFromAuctionQueue(ctx sdk.Context, name Name, endHeight int64) {
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.AuctionQueueStoreKey(endHeight, name))
+}
+
+// IterateAuctionQueue iterates over the auctions which need to be settled before endHeight
+func (ak AccountKeeper) IterateAuctionQueue(ctx sdk.Context, endHeight int64, cb func(auction types.NameAuction) (stop bool)) {
+	store := ctx.KVStore(ak.key)
+
+	iterator := store.Iterator(types.AuctionQueueStoreKeyPrefix, sdk.PrefixEndBytes(types.AuctionQueuePrefix(endHeight)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		name := chainTypes.NewNameFromBytes(iterator.Value())
+		auction, found := ak.GetAuction(ctx, name)
+		if !found {
+			panic(sdkerrors.Wrapf(types.ErrAuctionNoFound, "auction of %s does not exist", name))
+		}
+
+		if cb(auction) {
+			break
+		}
+	}
+}
@@ -0,0 +1,350 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/exported"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	params "github.com/KuChainNetwork/kuchain/x/params/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AuctionKeeper keeper for the auctions of the premium account names,
+// the bids are held by the account module account until the auction is settled,
+// and the price paid by the winner goes to the community pool.
+type AuctionKeeper struct {
+	ak           AccountKeeper
+	paramSpace   params.Subspace
+	supplyKeeper types.SupplyKeeper
+	distrKeeper  types.DistributionKeeper
+}
+
+// NewAuctionKeeper creates a new AuctionKeeper instance
+func NewAuctionKeeper(
+	ak AccountKeeper, paramSpace params.Subspace,
+	supplyKeeper types.SupplyKeeper, distrKeeper types.DistributionKeeper,
+) AuctionKeeper {
+
+	// ensure account module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	return AuctionKeeper{
+		ak:           ak,
+		paramSpace:   paramSpace.WithKeyTable(types.ParamKeyTable()),
+		supplyKeeper: supplyKeeper,
+		distrKeeper:  distrKeeper,
+	}
+}
+
+// GetAuctionParams returns the total set of auction parameters.
+func (k AuctionKeeper) GetAuctionParams(ctx sdk.Context) (params types.AuctionParams) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetAuctionParams sets the total set of auction parameters.
+func (k AuctionKeeper) SetAuctionParams(ctx sdk.Context, params types.AuctionParams) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// EnsureModuleAccount creates the module account holding the bids if not exist
+func (k AuctionKeeper) EnsureModuleAccount(ctx sdk.Context) {
+	k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// StartAuction starts an auction of the premium name, the name is reserved until the auction is settled with no bid
+// or the winner claims it.
+func (k AuctionKeeper) StartAuction(ctx sdk.Context, starter AccountID, name Name, auctionType types.AuctionType) (types.NameAuction, error) {
+	if err := types.ValidateAuctionName(name); err != nil {
+		return types.NameAuction{}, err
+	}
+
+	if err := auctionType.Validate(); err != nil {
+		return types.NameAuction{}, err
+	}
+
+	if k.ak.GetAccountByName(ctx, name) != nil {
+		return types.NameAuction{}, sdkerrors.Wrapf(types.ErrAccountHasCreated, "name %s", name)
+	}
+
+	if k.ak.IsNameReserved(ctx, name) {
+		return types.NameAuction{}, sdkerrors.Wrapf(types.ErrAuctionHasStarted, "name %s", name)
+	}
+
+	params := k.GetAuctionParams(ctx)
+	auction := types.NewNameAuction(name, auctionType, starter,
+		ctx.BlockHeight(), ctx.BlockHeight()+params.Duration, params.MinBid)
+	if auctionType == types.AuctionTypeSealed {
+		auction.RevealEndHeight = auction.EndHeight + params.RevealDuration
+	}
+
+	k.ak.SetAuction(ctx, auction)
+	k.ak.InsertAuctionQueue(ctx, name, auction.SettleHeight())
+
+	return auction, nil
+}
+
+// Bid bids the auction of the name, the amount should have been transferred to the module account,
+// for the sealed auctions the amount is the deposit of the bid committed by the commitment.
+func (k AuctionKeeper) Bid(ctx sdk.Context, bidder AccountID, name Name, amount Coin, commitment []byte) error {
+	auction, found := k.ak.GetAuction(ctx, name)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAuctionNoFound, "name %s", name)
+	}
+
+	if auction.Settled || ctx.BlockHeight() > auction.EndHeight {
+		return sdkerrors.Wrapf(types.ErrAuctionEnded, "auction of %s ended at %d", name, auction.EndHeight)
+	}
+
+	if amount.Denom != auction.MinBid.Denom || amount.IsLT(auction.MinBid) {
+		return sdkerrors.Wrapf(types.ErrAuctionBidTooLow, "bid %s, min bid %s", amount, auction.MinBid)
+	}
+
+	if err := k.supplyKeeper.ModuleCoinsToPower(ctx, types.ModuleName, chainTypes.NewCoins(amount)); err != nil {
+		return sdkerrors.Wrapf(err, "bid of %s to power", name)
+	}
+
+	switch auction.Type {
+	case types.AuctionTypeAscending:
+		if len(commitment) != 0 {
+			return sdkerrors.Wrapf(types.ErrAuctionBidCommitmentInvalid, "the bids of the ascending auction of %s are public", name)
+		}
+
+		if auction.HasBid() {
+			minBid := auction.Bid.Add(chainTypes.NewCoin(auction.Bid.Denom,
+				k.GetAuctionParams(ctx).MinIncrement.MulInt(auction.Bid.Amount).Ceil().TruncateInt()))
+			if amount.IsLT(minBid) || !auction.Bid.IsLT(amount) {
+				return sdkerrors.Wrapf(types.ErrAuctionBidTooLow, "bid %s, highest bid %s", amount, auction.Bid)
+			}
+
+			// the outbid bidder is refunded at once
+			if err := k.refund(ctx, name, auction.Bidder, auction.Bid); err != nil {
+				return err
+			}
+		}
+
+		auction.Bidder = bidder
+		auction.Bid = amount
+		k.ak.SetAuction(ctx, auction)
+
+	case types.AuctionTypeSealed:
+		if len(commitment) == 0 {
+			return sdkerrors.Wrapf(types.ErrAuctionBidCommitmentInvalid, "the bids of the sealed auction of %s should be committed", name)
+		}
+
+		if _, ok := k.ak.GetAuctionBid(ctx, name, bidder); ok {
+			return sdkerrors.Wrapf(types.ErrAuctionHasBid, "bidder %s", bidder)
+		}
+
+		k.ak.SetAuctionBid(ctx, types.NewNameAuctionBid(name, bidder, amount, commitment))
+
+	default:
+		return types.ErrAuctionTypeInvalid
+	}
+
+	return nil
+}
+
+// RevealBid reveals the bid committed in the sealed auction of the name, the bid should match the commitment
+// and not exceed the deposit.
+func (k AuctionKeeper) RevealBid(ctx sdk.Context, bidder AccountID, name Name, amount Coin, salt string) error {
+	auction, found := k.ak.GetAuction(ctx, name)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAuctionNoFound, "name %s", name)
+	}
+
+	if !auction.IsRevealing(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(types.ErrAuctionNotRevealing, "auction of %s ends at %d, reveals end at %d",
+			name, auction.EndHeight, auction.RevealEndHeight)
+	}
+
+	bid, found := k.ak.GetAuctionBid(ctx, name, bidder)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAuctionNoFound, "bid of %s in auction of %s", bidder, name)
+	}
+
+	if bid.Revealed {
+		return sdkerrors.Wrapf(types.ErrAuctionBidRevealed, "bid of %s", bidder)
+	}
+
+	if !bytes.Equal(types.AuctionBidCommitment(name, bidder, amount, salt), bid.Commitment) {
+		return sdkerrors.Wrapf(types.ErrAuctionBidCommitmentInvalid, "bid %s not match the commitment %X", amount, bid.Commitment)
+	}
+
+	if amount.Denom != auction.MinBid.Denom || amount.IsLT(auction.MinBid) {
+		return sdkerrors.Wrapf(types.ErrAuctionBidTooLow, "bid %s, min bid %s", amount, auction.MinBid)
+	}
+
+	if bid.Deposit.IsLT(amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "bid %s exceeds the deposit %s", amount, bid.Deposit)
+	}
+
+	bid.Revealed = true
+	bid.Amount = amount
+	k.ak.SetAuctionBid(ctx, bid)
+
+	return nil
+}
+
+// SettleAuction settles the auction at the settle height, the price paid by the winner goes to the community pool,
+// the name is released if there is no bid.
+func (k AuctionKeeper) SettleAuction(ctx sdk.Context, auction types.NameAuction) error {
+	k.ak.RemoveFromAuctionQueue(ctx, auction.Name, auction.SettleHeight())
+
+	if auction.Type == types.AuctionTypeSealed {
+		if err := k.settleSealedBids(ctx, &auction); err != nil {
+			return err
+		}
+	} else if auction.HasBid() {
+		auction.Price = auction.Bid
+	}
+
+	if !auction.HasBid() {
+		k.ak.DeleteAuction(ctx, auction.Name)
+	} else {
+		if err := k.distrKeeper.FundCommunityPoolFromModule(ctx, types.ModuleName, chainTypes.NewCoins(auction.Price)); err != nil {
+			return sdkerrors.Wrapf(err, "fund community pool by auction of %s", auction.Name)
+		}
+
+		auction.Settled = true
+		k.ak.SetAuction(ctx, auction)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettleAuction,
+			sdk.NewAttribute(types.AttributeKeyAccount, auction.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyWinner, auction.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, auction.Price.String()),
+		),
+	)
+
+	return nil
+}
+
+// settleSealedBids picks the highest revealed bid as the winner, who pays the second highest revealed bid
+// or the min bid, the other bidders and the excess of the winner's deposit are refunded, the deposits
+// not revealed go to the community pool.
+func (k AuctionKeeper) settleSealedBids(ctx sdk.Context, auction *types.NameAuction) error {
+	var (
+		bids      []types.NameAuctionBid
+		forfeited = chainTypes.NewCoins()
+	)
+
+	for _, bid := range k.ak.GetAuctionBids(ctx, auction.Name) {
+		if bid.Revealed {
+			bids = append(bids, bid)
+		} else {
+			forfeited = forfeited.Add(bid.Deposit)
+		}
+
+		k.ak.DeleteAuctionBid(ctx, bid.Name, bid.Bidder)
+	}
+
+	if !forfeited.IsZero() {
+		if err := k.distrKeeper.FundCommunityPoolFromModule(ctx, types.ModuleName, forfeited); err != nil {
+			return sdkerrors.Wrapf(err, "fund community pool by unrevealed bids of %s", auction.Name)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSettleAuction,
+				sdk.NewAttribute(types.AttributeKeyAccount, auction.Name.String()),
+				sdk.NewAttribute(types.AttributeKeyForfeited, forfeited.String()),
+			),
+		)
+	}
+
+	if len(bids) == 0 {
+		return nil
+	}
+
+	highest, price := 0, auction.MinBid
+	for i, bid := range bids {
+		if i == highest {
+			continue
+		}
+
+		if bids[highest].Amount.IsLT(bid.Amount) {
+			price = bids[highest].Amount
+			highest = i
+		} else if price.IsLT(bid.Amount) {
+			price = bid.Amount
+		}
+	}
+
+	for i, bid := range bids {
+		refund := bid.Deposit
+		if i == highest {
+			refund = bid.Deposit.Sub(price)
+		}
+
+		if refund.IsPositive() {
+			if err := k.refund(ctx, auction.Name, bid.Bidder, refund); err != nil {
+				return err
+			}
+		}
+	}
+
+	auction.Bidder = bids[highest].Bidder
+	auction.Bid = bids[highest].Amount
+	auction.Price = price
+
+	return nil
+}
+
+// ClaimAuction creates the account of the name for the winner of the settled auction, with the auth.
+func (k AuctionKeeper) ClaimAuction(ctx sdk.Context, winner AccountID, name Name, auth AccAddress) (exported.Account, error) {
+	auction, found := k.ak.GetAuction(ctx, name)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionNoFound, "name %s", name)
+	}
+
+	if !auction.Settled {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionNotSettled, "auction of %s ends at %d", name, auction.EndHeight)
+	}
+
+	if !auction.Bidder.Eq(winner) {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionNotWinner, "winner of %s is %s", name, auction.Bidder)
+	}
+
+	if k.ak.GetAccountByName(ctx, name) != nil {
+		return nil, sdkerrors.Wrapf(types.ErrAccountHasCreated, "name %s", name)
+	}
+
+	newAccount := k.ak.NewAccountByName(ctx, name)
+	if err := newAccount.SetAuth(auth); err != nil {
+		return nil, sdkerrors.Wrapf(err, "set auth to account error")
+	}
+
+	k.ak.SetAccount(ctx, newAccount)
+
+	k.ak.EnsureAuthInited(ctx, auth)
+	k.ak.AddAccountByAuth(ctx, auth, newAccount.GetName().String())
+
+	k.ak.DeleteAuction(ctx, name)
+
+	return newAccount, nil
+}
+
+func (k AuctionKeeper) refund(ctx sdk.Context, name Name, bidder AccountID, amount Coin) error {
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, chainTypes.NewCoins(amount)); err != nil {
+		return sdkerrors.Wrapf(err, "refund bid of %s to %s", name, bidder)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionRefund,
+			sdk.NewAttribute(types.AttributeKeyAccount, name.String()),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
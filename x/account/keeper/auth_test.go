@@ -46,6 +46,23 @@ func TestAuthGetAccount(t *testing.T) {
 		// no exist auth to auth
 		app.AccountKeeper().DeleteAccountByAuth(ctx, wallet.NewAccAddress(), "testacc2")
 	})
+
+	Convey("TestAuth2AccountAddTwice", t, func() {
+		addr := wallet.NewAccAddress()
+
+		// the account added twice, such as the auth rotated to the same auth
+		app.AccountKeeper().AddAccountByAuth(ctx, addr, "testacc1")
+		app.AccountKeeper().AddAccountByAuth(ctx, addr, "testacc2")
+		app.AccountKeeper().AddAccountByAuth(ctx, addr, "testacc1")
+
+		res := app.AccountKeeper().GetAccountsByAuth(ctx, addr)
+		So(res, ShouldResemble, []string{"testacc1", "testacc2"})
+
+		app.AccountKeeper().DeleteAccountByAuth(ctx, addr, "testacc1")
+
+		res = app.AccountKeeper().GetAccountsByAuth(ctx, addr)
+		So(res, ShouldResemble, []string{"testacc2"})
+	})
 }
 
 func TestAuthSeq(t *testing.T) {
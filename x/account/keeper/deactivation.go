@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetDeactivation get the deactivation record of the account, return false if the account is active
+func (ak AccountKeeper) GetDeactivation(ctx sdk.Context, name Name) (types.Deactivation, bool) {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.DeactivationStoreKey(name))
+	if bz == nil {
+		return types.Deactivation{}, false
+	}
+
+	var res types.Deactivation
+	ak.cdc.MustUnmarshalBinaryBare(bz, &res)
+
+	return res, true
+}
+
+// SetDeactivation set the deactivation record of the account
+func (ak AccountKeeper) SetDeactivation(ctx sdk.Context, deactivation types.Deactivation) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.DeactivationStoreKey(deactivation.Account), ak.cdc.MustMarshalBinaryBare(deactivation))
+}
+
+// DeleteDeactivation delete the deactivation record of the account
+func (ak AccountKeeper) DeleteDeactivation(ctx sdk.Context, name Name) {
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.DeactivationStoreKey(name))
+}
+
+// IsAccountDeactivated return true if the account is deactivated
+func (ak AccountKeeper) IsAccountDeactivated(ctx sdk.Context, name Name) bool {
+	store := ctx.KVStore(ak.key)
+	return store.Has(types.DeactivationStoreKey(name))
+}
+
+// GetDeactivations get all deactivation records
+func (ak AccountKeeper) GetDeactivations(ctx sdk.Context) []types.Deactivation {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.DeactivationStoreKeyPrefix)
+	defer iterator.Close()
+
+	res := make([]types.Deactivation, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var d types.Deactivation
+		ak.cdc.MustUnmarshalBinaryBare(iterator.Value(), &d)
+		res = append(res, d)
+	}
+
+	return res
+}
+
+// ValidateMsgsActive returns error if any msg is from a deactivated account or requires the auth of a deactivated
+// account, the auth of a deactivated account is treated as compromised until the guardian reactivates the account.
+func (ak AccountKeeper) ValidateMsgsActive(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if transfMsg, ok := msg.(chainTypes.KuTransfMsg); ok {
+			if name, ok := transfMsg.GetFrom().ToName(); ok && ak.IsAccountDeactivated(ctx, name) {
+				return sdkerrors.Wrapf(types.ErrAccountDeactivated, "msg from %s", name)
+			}
+		}
+
+		for _, signer := range msg.GetSigners() {
+			for _, n := range ak.GetAccountsByAuth(ctx, signer) {
+				name, err := chainTypes.NewName(n)
+				if err != nil {
+					continue
+				}
+
+				if ak.IsAccountDeactivated(ctx, name) {
+					return sdkerrors.Wrapf(types.ErrAccountDeactivated, "msg %s/%s signed by the auth of %s",
+						msg.Route(), msg.Type(), name)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -70,6 +70,10 @@ func (ak AccountKeeper) GetAuth(ctx sdk.Context, account Name) (sdk.AccAddress,
 		return sdk.AccAddress{}, types.ErrAccountNoFound
 	}
 
+	if ak.IsAccountDeactivated(ctx, account) {
+		return sdk.AccAddress{}, types.ErrAccountDeactivated
+	}
+
 	return acc.GetAuth(), nil
 }
 
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetMemoKey get the memo key of the account, return false if the account has not set it
+func (ak AccountKeeper) GetMemoKey(ctx sdk.Context, name Name) ([]byte, bool) {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.MemoKeyStoreKey(name))
+	if bz == nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+// SetMemoKey set the memo key of the account
+func (ak AccountKeeper) SetMemoKey(ctx sdk.Context, name Name, memoKey []byte) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.MemoKeyStoreKey(name), memoKey)
+}
+
+// DeleteMemoKey delete the memo key of the account
+func (ak AccountKeeper) DeleteMemoKey(ctx sdk.Context, name Name) {
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.MemoKeyStoreKey(name))
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetNameOffer get the offer of the buyer for the account name, return false if not offered
+func (ak AccountKeeper) GetNameOffer(ctx sdk.Context, name Name, buyer AccountID) (types.NameOffer, bool) {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.NameOfferStoreKey(name, buyer))
+	if bz == nil {
+		return types.NameOffer{}, false
+	}
+
+	var res types.NameOffer
+	ak.cdc.MustUnmarshalBinaryBare(bz, &res)
+
+	return res, true
+}
+
+// SetNameOffer set the offer for the account name
+func (ak AccountKeeper) SetNameOffer(ctx sdk.Context, offer types.NameOffer) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.NameOfferStoreKey(offer.Name, offer.Buyer), ak.cdc.MustMarshalBinaryBare(offer))
+}
+
+// DeleteNameOffer delete the offer of the buyer for the account name
+func (ak AccountKeeper) DeleteNameOffer(ctx sdk.Context, name Name, buyer AccountID) {
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.NameOfferStoreKey(name, buyer))
+}
+
+// GetNameOffers get the offers for the account name
+func (ak AccountKeeper) GetNameOffers(ctx sdk.Context, name Name) []types.NameOffer {
+	return ak.getNameOffersByPrefix(ctx, types.NameOffersStorePrefix(name))
+}
+
+// GetAllNameOffers get the offers for all the account names
+func (ak AccountKeeper) GetAllNameOffers(ctx sdk.Context) []types.NameOffer {
+	return ak.getNameOffersByPrefix(ctx, types.NameOfferStoreKeyPrefix)
+}
+
+func (ak AccountKeeper) getNameOffersByPrefix(ctx sdk.Context, prefix []byte) []types.NameOffer {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	res := make([]types.NameOffer, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var offer types.NameOffer
+		ak.cdc.MustUnmarshalBinaryBare(iterator.Value(), &offer)
+		res = append(res, offer)
+	}
+
+	return res
+}
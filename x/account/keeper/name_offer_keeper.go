@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// OfferName adds the offer of the buyer for the account name, the price should have been transferred
+// to the module account, and is held until the offer accepted or canceled.
+func (k AuctionKeeper) OfferName(ctx sdk.Context, offer types.NameOffer) error {
+	if err := k.validateNameTransfer(ctx, offer.Name); err != nil {
+		return err
+	}
+
+	if _, ok := k.ak.GetNameOffer(ctx, offer.Name, offer.Buyer); ok {
+		return sdkerrors.Wrapf(types.ErrNameOfferExists, "buyer %s", offer.Buyer)
+	}
+
+	if !offer.Price.IsZero() {
+		if err := k.supplyKeeper.ModuleCoinsToPower(ctx, types.ModuleName, offer.Price); err != nil {
+			return sdkerrors.Wrapf(err, "offer of %s to power", offer.Name)
+		}
+	}
+
+	k.ak.SetNameOffer(ctx, offer)
+
+	return nil
+}
+
+// CancelNameOffer removes the offer of the buyer for the account name, the price is refunded to the buyer
+func (k AuctionKeeper) CancelNameOffer(ctx sdk.Context, name Name, buyer AccountID) (types.NameOffer, error) {
+	offer, ok := k.ak.GetNameOffer(ctx, name, buyer)
+	if !ok {
+		return types.NameOffer{}, sdkerrors.Wrapf(types.ErrNameOfferNoFound, "offer of %s for %s", buyer, name)
+	}
+
+	if !offer.Price.IsZero() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyer, offer.Price); err != nil {
+			return types.NameOffer{}, sdkerrors.Wrapf(err, "refund offer of %s to %s", name, buyer)
+		}
+	}
+
+	k.ak.DeleteNameOffer(ctx, name, buyer)
+
+	return offer, nil
+}
+
+// TransferName accepts the offer of the buyer, the auth of the account is set to the auth of the offer at once,
+// and the price is paid to the receiver. The guardians and the memo key of the old owner are removed,
+// the coins of the account are transferred with the name, the other offers are kept until canceled.
+// The price of the offer should be the price expected by the owner, as the buyer may re-offer at any price.
+func (k AuctionKeeper) TransferName(ctx sdk.Context, name Name, buyer, receiver AccountID, price types.Coins) (types.NameOffer, error) {
+	offer, ok := k.ak.GetNameOffer(ctx, name, buyer)
+	if !ok {
+		return types.NameOffer{}, sdkerrors.Wrapf(types.ErrNameOfferNoFound, "offer of %s for %s", buyer, name)
+	}
+
+	if !offer.Price.IsEqual(price) {
+		return types.NameOffer{}, sdkerrors.Wrapf(types.ErrNameOfferPriceMismatch, "offer of %s for %s is %s, expected %s", buyer, name, offer.Price, price)
+	}
+
+	if err := k.validateNameTransfer(ctx, name); err != nil {
+		return types.NameOffer{}, err
+	}
+
+	if _, ok := k.ak.GetRecovery(ctx, name); ok {
+		return types.NameOffer{}, sdkerrors.Wrapf(types.ErrRecoveryPending, "account %s", name)
+	}
+
+	accountStat := k.ak.GetAccountByName(ctx, name)
+	if err := k.ak.RotateAuth(ctx, accountStat, offer.Auth); err != nil {
+		return types.NameOffer{}, err
+	}
+
+	k.ak.DeleteGuardians(ctx, name)
+	k.ak.DeleteMemoKey(ctx, name)
+	k.ak.DeleteNameOffer(ctx, name, buyer)
+
+	if !offer.Price.IsZero() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, offer.Price); err != nil {
+			return types.NameOffer{}, sdkerrors.Wrapf(err, "pay offer of %s to %s", name, receiver)
+		}
+	}
+
+	return offer, nil
+}
+
+// validateNameTransfer returns error if the account of the name cannot be transferred,
+// the sub-accounts are controlled by the parents and the deactivated accounts are frozen.
+func (k AuctionKeeper) validateNameTransfer(ctx sdk.Context, name Name) error {
+	if constants.IsSystemAccount(name) {
+		return sdkerrors.Wrapf(types.ErrNameTransferNotAllowed, "%s is a system account", name)
+	}
+
+	if k.ak.GetAccountByName(ctx, name) == nil {
+		return sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", name)
+	}
+
+	if _, ok := k.ak.GetSubAccount(ctx, name); ok {
+		return sdkerrors.Wrapf(types.ErrNameTransferNotAllowed, "%s is a sub-account", name)
+	}
+
+	if k.ak.IsAccountDeactivated(ctx, name) {
+		return sdkerrors.Wrapf(types.ErrAccountDeactivated, "name %s", name)
+	}
+
+	return nil
+}
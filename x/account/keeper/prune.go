@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountInUse returns true if the account still has states in other modules,
+// such as balances, delegations or gov activities, so it cannot be pruned.
+type AccountInUse func(ctx sdk.Context, id AccountID) bool
+
+// IterateAuths iterates over all the auth data stored
+func (ak AccountKeeper) IterateAuths(ctx sdk.Context, cb func(auth types.Auth) (stop bool)) {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.AuthSeqStoreKeyPerfix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var auth types.Auth
+		ak.cdc.MustUnmarshalBinaryBare(iterator.Value(), &auth)
+
+		if cb(auth) {
+			break
+		}
+	}
+}
+
+// IsAuthPrunable returns true if the address account can be pruned, the auth should
+// not own any named accounts and its address account should not be in use.
+func (ak AccountKeeper) IsAuthPrunable(ctx sdk.Context, auth AccAddress, inUse AccountInUse) bool {
+	if len(ak.GetAccountsByAuth(ctx, auth)) > 0 {
+		return false
+	}
+
+	id := chainTypes.NewAccountIDFromAccAdd(auth)
+	if ak.isAccountExist(ctx, id) {
+		return false
+	}
+
+	return inUse == nil || !inUse(ctx, id)
+}
+
+// PruneEmptyAuths removes the auth data of the empty address accounts, at most limit auths are pruned
+// if limit is positive, returns the addresses pruned. The auth data will be inited again with a new
+// account number when the address is used, so the txs signed before cannot be replayed.
+func (ak AccountKeeper) PruneEmptyAuths(ctx sdk.Context, limit int, inUse AccountInUse) []AccAddress {
+	pruned := make([]AccAddress, 0)
+
+	ak.IterateAuths(ctx, func(auth types.Auth) bool {
+		if ak.IsAuthPrunable(ctx, auth.GetAddress(), inUse) {
+			pruned = append(pruned, auth.GetAddress())
+		}
+		return limit > 0 && len(pruned) >= limit
+	})
+
+	store := ctx.KVStore(ak.key)
+	for _, auth := range pruned {
+		store.Delete(types.AuthSeqStoreKey(auth))
+		store.Delete(types.AuthAccountsStoreKey(auth))
+	}
+
+	if len(pruned) > 0 {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePruneAuths,
+				sdk.NewAttribute(types.AttributeKeyPruned, sdk.NewInt(int64(len(pruned))).String()),
+			),
+		)
+	}
+
+	ak.Logger(ctx).Info("prune empty auths", "pruned", len(pruned))
+
+	return pruned
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPruneEmptyAuths(t *testing.T) {
+	app, ctx := createTestApp()
+	ak := app.AccountKeeper()
+
+	empty := wallet.NewAccAddress()
+	inUse := wallet.NewAccAddress()
+	ak.EnsureAuthInited(ctx, empty)
+	ak.EnsureAuthInited(ctx, inUse)
+
+	_, oldNum, err := ak.GetAuthSequence(ctx, empty)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	isInUse := func(ctx sdk.Context, id types.AccountID) bool {
+		return id.Eq(types.NewAccountIDFromAccAdd(inUse))
+	}
+
+	Convey("test prune empty auths", t, func() {
+		So(ak.IsAuthPrunable(ctx, empty, isInUse), ShouldBeTrue)
+		So(ak.IsAuthPrunable(ctx, inUse, isInUse), ShouldBeFalse)
+
+		// the auth of named accounts cannot be pruned
+		So(ak.IsAuthPrunable(ctx, addr1, isInUse), ShouldBeFalse)
+
+		pruned := ak.PruneEmptyAuths(ctx, 0, isInUse)
+		So(pruned, ShouldContain, empty)
+		So(pruned, ShouldNotContain, inUse)
+		So(pruned, ShouldNotContain, addr1)
+		So(len(ak.GetAccountsByAuth(ctx, addr1)), ShouldEqual, 1)
+
+		// the auth is inited with a new account number when used again
+		ak.EnsureAuthInited(ctx, empty)
+		_, num, err := ak.GetAuthSequence(ctx, empty)
+		So(err, ShouldBeNil)
+		So(num, ShouldBeGreaterThan, oldNum)
+	})
+
+	Convey("test prune empty auths with limit", t, func() {
+		ctx, _ := ctx.CacheContext()
+		ak.EnsureAuthInited(ctx, wallet.NewAccAddress())
+		ak.EnsureAuthInited(ctx, wallet.NewAccAddress())
+
+		So(len(ak.PruneEmptyAuths(ctx, 1, isInUse)), ShouldEqual, 1)
+	})
+}
@@ -21,6 +21,22 @@ func NewQuerier(keeper AccountKeeper) sdk.Querier {
 			return queryAuthByAddress(ctx, req, keeper)
 		case types.QueryAccountsByAuth:
 			return queryAccountsByAuth(ctx, req, keeper)
+		case types.QueryAccountsAuth:
+			return queryAccountsAuth(ctx, req, keeper)
+		case types.QueryDeactivation:
+			return queryDeactivation(ctx, req, keeper)
+		case types.QueryMemoKey:
+			return queryMemoKey(ctx, req, keeper)
+		case types.QueryGuardians:
+			return queryGuardians(ctx, req, keeper)
+		case types.QueryRecovery:
+			return queryRecovery(ctx, req, keeper)
+		case types.QuerySubAccount:
+			return querySubAccount(ctx, req, keeper)
+		case types.QuerySubAccounts:
+			return querySubAccounts(ctx, req, keeper)
+		case types.QueryPendingAuth:
+			return queryPendingAuth(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -90,3 +106,282 @@ func queryAccountsByAuth(ctx sdk.Context, req abci.RequestQuery, ak AccountKeepe
 
 	return bz, nil
 }
+
+// queryAccountsAuth query the auths of accounts in one response, for batch signers
+func queryAccountsAuth(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryAccountsAuthParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if len(params.Ids) > types.MaxQueryAccountsAuthNum {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+			"too many accounts to query %d, max %d", len(params.Ids), types.MaxQueryAccountsAuthNum)
+	}
+
+	store := ctx.KVStore(ak.key)
+	res := make([]types.AccountAuthData, 0, len(params.Ids))
+
+	for _, id := range params.Ids {
+		auth, ok := id.ToAccAddress()
+		if !ok {
+			account := ak.GetAccount(ctx, id)
+			if account == nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", id)
+			}
+			auth = account.GetAuth()
+		}
+
+		// the auth data of a address not used yet is no inited, just return the address
+		an := types.Auth{Address: auth}
+		if bz := store.Get(types.AuthSeqStoreKey(auth)); bz != nil {
+			if err := ak.cdc.UnmarshalBinaryBare(bz, &an); err != nil {
+				return nil, errors.Wrapf(chainTypes.ErrKuMsgDataUnmarshal, "query auth data unmarshal by %s by %s", auth, err.Error())
+			}
+		}
+
+		res = append(res, types.NewAccountAuthData(id, an))
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryDeactivation query the deactivation record of account
+func queryDeactivation(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryDeactivationParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	deactivation, ok := ak.GetDeactivation(ctx, params.Name)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNotDeactivated, "account %s", params.Name)
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, deactivation)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryGuardians query the guardians of account
+func queryGuardians(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryGuardiansParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	guardians, ok := ak.GetGuardians(ctx, params.Name)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrGuardiansNoFound, "account %s", params.Name)
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, guardians)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryRecovery query the pending recovery of account
+func queryRecovery(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryAccountRecoveryParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	recovery, ok := ak.GetRecovery(ctx, params.Name)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrRecoveryNoFound, "account %s", params.Name)
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, recovery)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryPendingAuth query the pending auth rotation of account
+func queryPendingAuth(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryPendingAuthParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	pending, ok := ak.GetPendingAuth(ctx, params.Name)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrAuthRotationNoFound, "account %s", params.Name)
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, pending)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// querySubAccount query the sub-account with the permissions
+func querySubAccount(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QuerySubAccountParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	subAccount, ok := ak.GetSubAccount(ctx, params.Name)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrSubAccountNoFound, "name %s", params.Name)
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, subAccount)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// querySubAccounts query the sub-accounts of the parent
+func querySubAccounts(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QuerySubAccountParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, ak.GetSubAccountsOf(ctx, params.Name))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryMemoKey query the memo key of account
+func queryMemoKey(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryMemoKeyParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	memoKey, ok := ak.GetMemoKey(ctx, params.Name)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrAccountMemoKeyNoFound, "account %s", params.Name)
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, memoKey)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// NewAuctionQuerier creates a querier for the auctions of the premium names,
+// the other queries are handled by the account querier
+func NewAuctionQuerier(k AuctionKeeper) sdk.Querier {
+	accountQuerier := NewQuerier(k.ak)
+
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryAuction:
+			return queryAuction(ctx, req, k.ak)
+		case types.QueryAuctions:
+			return queryAuctions(ctx, k.ak)
+		case types.QueryAuctionBids:
+			return queryAuctionBids(ctx, req, k.ak)
+		case types.QueryAuctionParams:
+			return queryAuctionParams(ctx, k)
+		case types.QueryNameOffers:
+			return queryNameOffers(ctx, req, k.ak)
+		default:
+			return accountQuerier(ctx, path, req)
+		}
+	}
+}
+
+// queryAuction query the auction of the name
+func queryAuction(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryNameAuctionParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	auction, ok := ak.GetAuction(ctx, params.Name)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionNoFound, "name %s", params.Name)
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, auction)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryAuctions query all the auctions
+func queryAuctions(ctx sdk.Context, ak AccountKeeper) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(ak.cdc, ak.GetAuctions(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryAuctionBids query the bids of the sealed auction of the name
+func queryAuctionBids(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryNameAuctionParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, ak.GetAuctionBids(ctx, params.Name))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryAuctionParams query the params of the auctions
+func queryAuctionParams(ctx sdk.Context, k AuctionKeeper) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(k.ak.cdc, k.GetAuctionParams(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryNameOffers query the offers for the account name, or all the offers if no name
+func queryNameOffers(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryNameOffersParams
+	if err := ak.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var offers []types.NameOffer
+	if params.Name.Empty() {
+		offers = ak.GetAllNameOffers(ctx)
+	} else {
+		offers = ak.GetNameOffers(ctx, params.Name)
+	}
+
+	bz, err := codec.MarshalJSONIndent(ak.cdc, offers)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -333,3 +333,79 @@ func TestQueryAccountsByAuth(t *testing.T) {
 		})
 	})
 }
+
+func TestQueryAccountsAuth(t *testing.T) {
+	asset1 := types.Coins{
+		types.NewInt64Coin(constants.DefaultBondDenom, 10000000000)}
+	genAcc1 := simapp.NewSimGenesisAccount(account1, addr1).WithAsset(asset1)
+	genAcc2 := simapp.NewSimGenesisAccount(types.NewAccountIDFromAccAdd(addr2), addr2).WithAsset(asset1)
+	genAccs := simapp.NewGenesisAccounts(wallet.GetRootAuth(), genAcc1, genAcc2)
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	ctx := app.BaseApp.NewContext(true,
+		abci.Header{
+			Time:   time.Now(),
+			Height: app.LastBlockHeight() + 1,
+		})
+
+	Convey("TestQueryAccountsAuth", t, func() {
+		querier := keeper.NewQuerier(*app.AccountKeeper())
+		path := []string{accountTypes.QueryAccountsAuth}
+		req := abci.RequestQuery{
+			Path: fmt.Sprintf("custom/%s/%s", accountTypes.QuerierRoute, accountTypes.QueryAccountsAuth),
+			Data: []byte{},
+		}
+
+		Convey("query auths no params", func() {
+			res, err := querier(ctx, path, req)
+			So(err, simapp.ShouldErrIs, sdkerrors.ErrJSONUnmarshal)
+			So(res, ShouldBeNil)
+		})
+
+		Convey("query auths", func() {
+			newAddr := wallet.NewAccAddress()
+			req.Data = app.Codec().MustMarshalJSON(accountTypes.NewQueryAccountsAuthParams(
+				account1, types.NewAccountIDFromAccAdd(addr2), types.NewAccountIDFromAccAdd(newAddr)))
+
+			res, err := querier(ctx, path, req)
+			So(err, ShouldBeNil)
+
+			var auths []accountTypes.AccountAuthData
+			err = app.Codec().UnmarshalJSON(res, &auths)
+			So(err, ShouldBeNil)
+			So(len(auths), ShouldEqual, 3)
+
+			So(auths[0].Id, simapp.ShouldEq, account1)
+			So(auths[0].Auth.GetAddress(), simapp.ShouldEq, addr1)
+			So(auths[0].Auth.GetNumber(), ShouldEqual, 1)
+			So(auths[1].Id, simapp.ShouldEq, types.NewAccountIDFromAccAdd(addr2))
+			So(auths[1].Auth.GetAddress(), simapp.ShouldEq, addr2)
+			So(auths[1].Auth.GetNumber(), ShouldEqual, 2)
+
+			// the address not used yet has no auth data inited
+			So(auths[2].Auth.GetAddress(), simapp.ShouldEq, newAddr)
+			So(auths[2].Auth.GetNumber(), ShouldEqual, 0)
+		})
+
+		Convey("query auths with no existing account", func() {
+			req.Data = app.Codec().MustMarshalJSON(accountTypes.NewQueryAccountsAuthParams(
+				account1, types.MustAccountID("aabbccdd")))
+
+			res, err := querier(ctx, path, req)
+			So(err, simapp.ShouldErrIs, sdkerrors.ErrUnknownAddress)
+			So(res, ShouldBeNil)
+		})
+
+		Convey("query too many auths", func() {
+			ids := make([]types.AccountID, accountTypes.MaxQueryAccountsAuthNum+1)
+			for i := range ids {
+				ids[i] = account1
+			}
+			req.Data = app.Codec().MustMarshalJSON(accountTypes.NewQueryAccountsAuthParams(ids...))
+
+			res, err := querier(ctx, path, req)
+			So(err, simapp.ShouldErrIs, sdkerrors.ErrInvalidRequest)
+			So(res, ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetGuardians get the guardians of the account, return false if the account has no guardians
+func (ak AccountKeeper) GetGuardians(ctx sdk.Context, name Name) (types.Guardians, bool) {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.GuardiansStoreKey(name))
+	if bz == nil {
+		return types.Guardians{}, false
+	}
+
+	var res types.Guardians
+	ak.cdc.MustUnmarshalBinaryBare(bz, &res)
+
+	return res, true
+}
+
+// SetGuardians set the guardians of the account
+func (ak AccountKeeper) SetGuardians(ctx sdk.Context, guardians types.Guardians) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.GuardiansStoreKey(guardians.Account), ak.cdc.MustMarshalBinaryBare(guardians))
+}
+
+// DeleteGuardians delete the guardians of the account
+func (ak AccountKeeper) DeleteGuardians(ctx sdk.Context, name Name) {
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.GuardiansStoreKey(name))
+}
+
+// GetAllGuardians get the guardians of all the accounts
+func (ak AccountKeeper) GetAllGuardians(ctx sdk.Context) []types.Guardians {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.GuardiansStoreKeyPrefix)
+	defer iterator.Close()
+
+	res := make([]types.Guardians, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var g types.Guardians
+		ak.cdc.MustUnmarshalBinaryBare(iterator.Value(), &g)
+		res = append(res, g)
+	}
+
+	return res
+}
+
+// GetRecovery get the pending recovery of the account, return false if no recovery
+func (ak AccountKeeper) GetRecovery(ctx sdk.Context, name Name) (types.Recovery, bool) {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.RecoveryStoreKey(name))
+	if bz == nil {
+		return types.Recovery{}, false
+	}
+
+	var res types.Recovery
+	ak.cdc.MustUnmarshalBinaryBare(bz, &res)
+
+	return res, true
+}
+
+// SetRecovery set the pending recovery of the account
+func (ak AccountKeeper) SetRecovery(ctx sdk.Context, recovery types.Recovery) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.RecoveryStoreKey(recovery.Account), ak.cdc.MustMarshalBinaryBare(recovery))
+}
+
+// DeleteRecovery delete the pending recovery of the account
+func (ak AccountKeeper) DeleteRecovery(ctx sdk.Context, name Name) {
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.RecoveryStoreKey(name))
+}
+
+// GetRecoveries get all the pending recoveries
+func (ak AccountKeeper) GetRecoveries(ctx sdk.Context) []types.Recovery {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.RecoveryStoreKeyPrefix)
+	defer iterator.Close()
+
+	res := make([]types.Recovery, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var r types.Recovery
+		ak.cdc.MustUnmarshalBinaryBare(iterator.Value(), &r)
+		res = append(res, r)
+	}
+
+	return res
+}
+
+// InsertRecoveryQueue inserts the recovery of the account to the queue at height
+func (ak AccountKeeper) InsertRecoveryQueue(ctx sdk.Context, name Name, height int64) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.RecoveryQueueStoreKey(height, name), name.Bytes())
+}
+
+// RemoveFromRecoveryQueue removes the recovery of the account from the queue
+func (ak AccountKeeper) RemoveFromRecoveryQueue(ctx sdk.Context, name Name, height int64) {
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.RecoveryQueueStoreKey(height, name))
+}
+
+// IterateRecoveryQueue iterates over the recoveries which need to be processed before height
+func (ak AccountKeeper) IterateRecoveryQueue(ctx sdk.Context, height int64, cb func(recovery types.Recovery) (stop bool)) {
+	store := ctx.KVStore(ak.key)
+
+	iterator := store.Iterator(types.RecoveryQueueStoreKeyPrefix, sdk.PrefixEndBytes(types.RecoveryQueuePrefix(height)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		name := chainTypes.NewNameFromBytes(iterator.Value())
+		recovery, found := ak.GetRecovery(ctx, name)
+		if !found {
+			panic(sdkerrors.Wrapf(types.ErrRecoveryNoFound, "recovery of %s does not exist", name))
+		}
+
+		if cb(recovery) {
+			break
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	params "github.com/KuChainNetwork/kuchain/x/params/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RecoveryKeeper keeper for the recoveries of the accounts by guardians,
+// the threshold of the guardians of an account can rotate the auth of the account after a time lock.
+type RecoveryKeeper struct {
+	ak         AccountKeeper
+	paramSpace params.Subspace
+}
+
+// NewRecoveryKeeper creates a new RecoveryKeeper instance
+func NewRecoveryKeeper(ak AccountKeeper, paramSpace params.Subspace) RecoveryKeeper {
+	return RecoveryKeeper{
+		ak:         ak,
+		paramSpace: paramSpace.WithKeyTable(types.RecoveryParamKeyTable()),
+	}
+}
+
+// GetRecoveryParams returns the total set of recovery parameters.
+func (k RecoveryKeeper) GetRecoveryParams(ctx sdk.Context) (params types.RecoveryParams) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetRecoveryParams sets the total set of recovery parameters.
+func (k RecoveryKeeper) SetRecoveryParams(ctx sdk.Context, params types.RecoveryParams) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// SetGuardians sets the guardians of the account, removes them if the guardians is empty,
+// the guardians cannot be changed while a recovery is pending.
+func (k RecoveryKeeper) SetGuardians(ctx sdk.Context, guardians types.Guardians) error {
+	if _, ok := k.ak.GetRecovery(ctx, guardians.Account); ok {
+		return sdkerrors.Wrapf(types.ErrRecoveryPending, "account %s", guardians.Account)
+	}
+
+	if len(guardians.Guardians) == 0 {
+		k.ak.DeleteGuardians(ctx, guardians.Account)
+		return nil
+	}
+
+	if err := guardians.Validate(); err != nil {
+		return err
+	}
+
+	for _, guardian := range guardians.Guardians {
+		if name, ok := guardian.ToName(); ok && k.ak.GetAccountByName(ctx, name) == nil {
+			return sdkerrors.Wrapf(types.ErrAccountGuardianInvalid, "guardian %s no found", guardian)
+		}
+	}
+
+	k.ak.SetGuardians(ctx, guardians)
+
+	return nil
+}
+
+// InitiateRecovery starts the recovery of the account to the new auth by the guardian,
+// which is dropped if not approved by the threshold of the guardians before it expires.
+func (k RecoveryKeeper) InitiateRecovery(ctx sdk.Context, guardian AccountID, name Name, auth AccAddress) (types.Recovery, error) {
+	guardians, err := k.getGuardian(ctx, guardian, name)
+	if err != nil {
+		return types.Recovery{}, err
+	}
+
+	if _, ok := k.ak.GetRecovery(ctx, name); ok {
+		return types.Recovery{}, sdkerrors.Wrapf(types.ErrRecoveryPending, "account %s", name)
+	}
+
+	if err := k.ak.ValidateSubAccountAuth(ctx, name, auth); err != nil {
+		return types.Recovery{}, err
+	}
+
+	params := k.GetRecoveryParams(ctx)
+	recovery := types.NewRecovery(name, auth, guardian, ctx.BlockHeight(), ctx.BlockHeight()+params.Expiry)
+	k.checkApprovals(ctx, guardians, &recovery)
+
+	k.ak.SetRecovery(ctx, recovery)
+	k.ak.InsertRecoveryQueue(ctx, name, recovery.QueueHeight())
+
+	return recovery, nil
+}
+
+// ApproveRecovery approves the pending recovery of the account by the guardian,
+// the time lock starts when the threshold of the guardians approved.
+func (k RecoveryKeeper) ApproveRecovery(ctx sdk.Context, guardian AccountID, name Name, auth AccAddress) (types.Recovery, error) {
+	guardians, err := k.getGuardian(ctx, guardian, name)
+	if err != nil {
+		return types.Recovery{}, err
+	}
+
+	recovery, ok := k.ak.GetRecovery(ctx, name)
+	if !ok {
+		return types.Recovery{}, sdkerrors.Wrapf(types.ErrRecoveryNoFound, "account %s", name)
+	}
+
+	if !recovery.Auth.Equals(auth) {
+		return types.Recovery{}, sdkerrors.Wrapf(types.ErrRecoveryAuthMismatch, "recovery to %s", recovery.Auth)
+	}
+
+	if recovery.HasApproved(guardian) {
+		return types.Recovery{}, sdkerrors.Wrapf(types.ErrRecoveryHasApproved, "guardian %s", guardian)
+	}
+
+	k.ak.RemoveFromRecoveryQueue(ctx, name, recovery.QueueHeight())
+
+	recovery.Approvals = append(recovery.Approvals, guardian)
+	k.checkApprovals(ctx, guardians, &recovery)
+
+	k.ak.SetRecovery(ctx, recovery)
+	k.ak.InsertRecoveryQueue(ctx, name, recovery.QueueHeight())
+
+	return recovery, nil
+}
+
+// CancelRecovery cancels the pending recovery of the account
+func (k RecoveryKeeper) CancelRecovery(ctx sdk.Context, name Name) error {
+	recovery, ok := k.ak.GetRecovery(ctx, name)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrRecoveryNoFound, "account %s", name)
+	}
+
+	k.removeRecovery(ctx, recovery)
+
+	return nil
+}
+
+// ProcessRecovery rotates the auth of the account if the recovery is approved and the time lock ends,
+// or drops the recovery which is not approved before it expires.
+func (k RecoveryKeeper) ProcessRecovery(ctx sdk.Context, recovery types.Recovery) error {
+	k.removeRecovery(ctx, recovery)
+
+	if !recovery.IsApproved() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireRecovery,
+				sdk.NewAttribute(types.AttributeKeyAccount, recovery.Account.String()),
+				sdk.NewAttribute(types.AttributeKeyAuth, recovery.Auth.String()),
+			),
+		)
+
+		return nil
+	}
+
+	accountStat := k.ak.GetAccountByName(ctx, recovery.Account)
+	if accountStat == nil {
+		return sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", recovery.Account)
+	}
+
+	if err := k.ak.RotateAuth(ctx, accountStat, recovery.Auth); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteRecovery,
+			sdk.NewAttribute(types.AttributeKeyAccount, recovery.Account.String()),
+			sdk.NewAttribute(types.AttributeKeyAuth, recovery.Auth.String()),
+		),
+	)
+
+	return nil
+}
+
+func (k RecoveryKeeper) getGuardian(ctx sdk.Context, guardian AccountID, name Name) (types.Guardians, error) {
+	guardians, ok := k.ak.GetGuardians(ctx, name)
+	if !ok {
+		return types.Guardians{}, sdkerrors.Wrapf(types.ErrGuardiansNoFound, "account %s", name)
+	}
+
+	if !guardians.IsGuardian(guardian) {
+		return types.Guardians{}, sdkerrors.Wrapf(types.ErrNotGuardian, "%s of %s", guardian, name)
+	}
+
+	return guardians, nil
+}
+
+// checkApprovals starts the time lock if the threshold of the guardians approved the recovery
+func (k RecoveryKeeper) checkApprovals(ctx sdk.Context, guardians types.Guardians, recovery *types.Recovery) {
+	if recovery.IsApproved() || len(recovery.Approvals) < int(guardians.Threshold) {
+		return
+	}
+
+	recovery.UnlockHeight = ctx.BlockHeight() + k.GetRecoveryParams(ctx).TimeLock
+}
+
+func (k RecoveryKeeper) removeRecovery(ctx sdk.Context, recovery types.Recovery) {
+	k.ak.RemoveFromRecoveryQueue(ctx, recovery.Account, recovery.QueueHeight())
+	k.ak.DeleteRecovery(ctx, recovery.Account)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/exported"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RotateAuth sets the auth of the account and updates the accounts of the auths,
+// the pending auth rotation of the account is dropped as the auth changed.
+func (ak AccountKeeper) RotateAuth(ctx sdk.Context, accountStat exported.Account, auth AccAddress) error {
+	oldAuth := accountStat.GetAuth()
+	if err := accountStat.SetAuth(auth); err != nil {
+		return sdkerrors.Wrapf(err, "set auth to account error")
+	}
+
+	ak.SetAccount(ctx, accountStat)
+
+	ak.EnsureAuthInited(ctx, auth)
+	ak.AddAccountByAuth(ctx, auth, accountStat.GetName().String())
+	ak.DeleteAccountByAuth(ctx, oldAuth, accountStat.GetName().String())
+
+	if pending, ok := ak.GetPendingAuth(ctx, accountStat.GetName()); ok {
+		ak.RemoveFromPendingAuthQueue(ctx, pending.Account, pending.ActiveHeight)
+		ak.DeletePendingAuth(ctx, pending.Account)
+	}
+
+	return nil
+}
+
+// GetPendingAuth get the pending auth rotation of the account, return false if no rotation
+func (ak AccountKeeper) GetPendingAuth(ctx sdk.Context, name Name) (types.PendingAuth, bool) {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.PendingAuthStoreKey(name))
+	if bz == nil {
+		return types.PendingAuth{}, false
+	}
+
+	var res types.PendingAuth
+	ak.cdc.MustUnmarshalBinaryBare(bz, &res)
+
+	return res, true
+}
+
+// SetPendingAuth set the pending auth rotation of the account
+func (ak AccountKeeper) SetPendingAuth(ctx sdk.Context, pending types.PendingAuth) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.PendingAuthStoreKey(pending.Account), ak.cdc.MustMarshalBinaryBare(pending))
+}
+
+// DeletePendingAuth delete the pending auth rotation of the account
+func (ak AccountKeeper) DeletePendingAuth(ctx sdk.Context, name Name) {
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.PendingAuthStoreKey(name))
+}
+
+// GetPendingAuths get all the pending auth rotations
+func (ak AccountKeeper) GetPendingAuths(ctx sdk.Context) []types.PendingAuth {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingAuthStoreKeyPrefix)
+	defer iterator.Close()
+
+	res := make([]types.PendingAuth, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var p types.PendingAuth
+		ak.cdc.MustUnmarshalBinaryBare(iterator.Value(), &p)
+		res = append(res, p)
+	}
+
+	return res
+}
+
+// InsertPendingAuthQueue inserts the pending auth rotation of the account to the queue at height
+func (ak AccountKeeper) InsertPendingAuthQueue(ctx sdk.Context, name Name, height int64) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.PendingAuthQueueStoreKey(height, name), name.Bytes())
+}
+
+// RemoveFromPendingAuthQueue removes the pending auth rotation of the account from the queue
+func (ak AccountKeeper) RemoveFromPendingAuthQueue(ctx sdk.Context, name Name, height int64) {
+	store := ctx.KVStore(ak.key)
+	store.Delete(types.PendingAuthQueueStoreKey(height, name))
+}
+
+// IteratePendingAuthQueue iterates over the pending auth rotations which need to be activated before height
+func (ak AccountKeeper) IteratePendingAuthQueue(ctx sdk.Context, height int64, cb func(pending types.PendingAuth) (stop bool)) {
+	store := ctx.KVStore(ak.key)
+
+	iterator := store.Iterator(types.PendingAuthQueueStoreKeyPrefix, sdk.PrefixEndBytes(types.PendingAuthQueuePrefix(height)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		name := chainTypes.NewNameFromBytes(iterator.Value())
+		pending, found := ak.GetPendingAuth(ctx, name)
+		if !found {
+			panic(sdkerrors.Wrapf(types.ErrAuthRotationNoFound, "pending auth of %s does not exist", name))
+		}
+
+		if cb(pending) {
+			break
+		}
+	}
+}
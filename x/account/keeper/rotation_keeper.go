@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/account/exported"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UpdateAuth updates the auth of the account, the new auth becomes active after the auth rotation delay,
+// returns the pending auth rotation, or false if the auth is updated at once as the delay is zero.
+func (k RecoveryKeeper) UpdateAuth(ctx sdk.Context, accountStat exported.Account, auth AccAddress) (types.PendingAuth, bool, error) {
+	name := accountStat.GetName()
+	if _, ok := k.ak.GetPendingAuth(ctx, name); ok {
+		return types.PendingAuth{}, false, sdkerrors.Wrapf(types.ErrAuthRotationPending, "account %s", name)
+	}
+
+	if err := k.ak.ValidateSubAccountAuth(ctx, name, auth); err != nil {
+		return types.PendingAuth{}, false, err
+	}
+
+	delay := k.GetRecoveryParams(ctx).AuthRotationDelay
+	if delay == 0 {
+		return types.PendingAuth{}, false, k.ak.RotateAuth(ctx, accountStat, auth)
+	}
+
+	pending := types.NewPendingAuth(name, auth, accountStat.GetAuth(), ctx.BlockHeight(), ctx.BlockHeight()+delay)
+
+	k.ak.SetPendingAuth(ctx, pending)
+	k.ak.InsertPendingAuthQueue(ctx, name, pending.ActiveHeight)
+
+	return pending, true, nil
+}
+
+// CancelAuthRotation cancels the pending auth rotation of the account
+func (k RecoveryKeeper) CancelAuthRotation(ctx sdk.Context, name Name) (types.PendingAuth, error) {
+	pending, ok := k.ak.GetPendingAuth(ctx, name)
+	if !ok {
+		return types.PendingAuth{}, sdkerrors.Wrapf(types.ErrAuthRotationNoFound, "account %s", name)
+	}
+
+	k.ak.RemoveFromPendingAuthQueue(ctx, name, pending.ActiveHeight)
+	k.ak.DeletePendingAuth(ctx, name)
+
+	return pending, nil
+}
+
+// ProcessAuthRotation activates the new auth of the pending auth rotation, which is dropped
+// if the new auth cannot be the auth of the account any more, such as used by others for a sub-account.
+func (k RecoveryKeeper) ProcessAuthRotation(ctx sdk.Context, pending types.PendingAuth) error {
+	k.ak.RemoveFromPendingAuthQueue(ctx, pending.Account, pending.ActiveHeight)
+	k.ak.DeletePendingAuth(ctx, pending.Account)
+
+	if err := k.ak.ValidateSubAccountAuth(ctx, pending.Account, pending.Auth); err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCancelAuth,
+				sdk.NewAttribute(types.AttributeKeyAccount, pending.Account.String()),
+				sdk.NewAttribute(types.AttributeKeyAuth, pending.Auth.String()),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			),
+		)
+
+		return nil
+	}
+
+	accountStat := k.ak.GetAccountByName(ctx, pending.Account)
+	if accountStat == nil {
+		return sdkerrors.Wrapf(types.ErrAccountNoFound, "name %s", pending.Account)
+	}
+
+	if err := k.ak.RotateAuth(ctx, accountStat, pending.Auth); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateAccountAuth,
+			sdk.NewAttribute(types.AttributeKeyAccount, pending.Account.String()),
+			sdk.NewAttribute(types.AttributeKeyAuth, pending.Auth.String()),
+			sdk.NewAttribute(types.AttributeKeyOldAuth, pending.OldAuth.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetSubAccount get the sub-account with the permissions, return false if the account is not a sub-account
+func (ak AccountKeeper) GetSubAccount(ctx sdk.Context, name Name) (types.SubAccount, bool) {
+	store := ctx.KVStore(ak.key)
+
+	bz := store.Get(types.SubAccountStoreKey(name))
+	if bz == nil {
+		return types.SubAccount{}, false
+	}
+
+	var res types.SubAccount
+	ak.cdc.MustUnmarshalBinaryBare(bz, &res)
+
+	return res, true
+}
+
+// SetSubAccount set the sub-account with the permissions
+func (ak AccountKeeper) SetSubAccount(ctx sdk.Context, subAccount types.SubAccount) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.SubAccountStoreKey(subAccount.Account), ak.cdc.MustMarshalBinaryBare(subAccount))
+}
+
+// GetAllSubAccounts get all the sub-accounts
+func (ak AccountKeeper) GetAllSubAccounts(ctx sdk.Context) []types.SubAccount {
+	store := ctx.KVStore(ak.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.SubAccountStoreKeyPrefix)
+	defer iterator.Close()
+
+	res := make([]types.SubAccount, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var s types.SubAccount
+		ak.cdc.MustUnmarshalBinaryBare(iterator.Value(), &s)
+		res = append(res, s)
+	}
+
+	return res
+}
+
+// GetSubAccountsOf get the sub-accounts of the parent
+func (ak AccountKeeper) GetSubAccountsOf(ctx sdk.Context, parent Name) []types.SubAccount {
+	res := make([]types.SubAccount, 0)
+	for _, s := range ak.GetAllSubAccounts(ctx) {
+		if s.Parent.Eq(parent) {
+			res = append(res, s)
+		}
+	}
+
+	return res
+}
+
+// ValidateSubAccountAuth returns error if the account is a sub-account and the auth is used by other accounts
+func (ak AccountKeeper) ValidateSubAccountAuth(ctx sdk.Context, name Name, auth AccAddress) error {
+	if _, ok := ak.GetSubAccount(ctx, name); !ok {
+		return nil
+	}
+
+	return ak.ValidateAuthNotUsedByOthers(ctx, name, auth)
+}
+
+// ValidateAuthNotUsedByOthers returns error if the auth is used by the accounts other than the account,
+// as the permissions of a sub-account scope its auth, the auth of a sub-account should not be used by others,
+// or anyone could scope the auth of other accounts by a sub-account.
+func (ak AccountKeeper) ValidateAuthNotUsedByOthers(ctx sdk.Context, name Name, auth AccAddress) error {
+	for _, n := range ak.GetAccountsByAuth(ctx, auth) {
+		if n != name.String() {
+			return sdkerrors.Wrapf(types.ErrSubAccountAuthInUse, "auth %s used by %s", auth, n)
+		}
+	}
+
+	if ak.GetAccount(ctx, chainTypes.NewAccountIDFromAccAdd(auth)) != nil {
+		return sdkerrors.Wrapf(types.ErrSubAccountAuthInUse, "auth %s used by the address account", auth)
+	}
+
+	return nil
+}
+
+// ValidateMsgsScope returns error if any of the msgs is signed by the auth of a sub-account,
+// but not allowed by the permissions of the sub-account
+func (ak AccountKeeper) ValidateMsgsScope(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if err := ak.validateMsgScope(ctx, signer, msg); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (ak AccountKeeper) validateMsgScope(ctx sdk.Context, signer AccAddress, msg sdk.Msg) error {
+	for _, n := range ak.GetAccountsByAuth(ctx, signer) {
+		name, err := chainTypes.NewName(n)
+		if err != nil {
+			continue
+		}
+
+		subAccount, ok := ak.GetSubAccount(ctx, name)
+		if !ok {
+			continue
+		}
+
+		if !subAccount.IsMsgAllowed(msg.Route(), msg.Type()) {
+			return sdkerrors.Wrapf(types.ErrSubAccountMsgNotAllowed, "msg %s/%s signed by the auth of %s",
+				msg.Route(), msg.Type(), subAccount.Account)
+		}
+
+		// the transfer in the KuMsg is handled before the msg, so it needs the transfer permission too
+		if transfMsg, ok := msg.(chainTypes.KuTransfMsg); ok && !transfMsg.GetAmount().IsZero() &&
+			!subAccount.IsMsgAllowed(types.TransferPermissionRoute, types.TransferPermissionType) {
+			return sdkerrors.Wrapf(types.ErrSubAccountMsgNotAllowed, "transfer %s in msg %s/%s signed by the auth of %s",
+				transfMsg.GetAmount(), msg.Route(), msg.Type(), subAccount.Account)
+		}
+	}
+
+	return nil
+}
@@ -8,4 +8,5 @@ type (
 	Name       = chainTypes.Name
 	AccountID  = chainTypes.AccountID
 	AccAddress = chainTypes.AccAddress
+	Coin       = chainTypes.Coin
 )
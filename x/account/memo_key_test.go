@@ -0,0 +1,57 @@
+package account_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+)
+
+func TestSetMemoKey(t *testing.T) {
+	assets := types.Coins{
+		types.NewInt64Coin(constants.DefaultBondDenom, 10000000000)}
+	genAccs := simapp.NewGenesisAccounts(
+		wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(assets),
+		simapp.NewSimGenesisAccount(account2, addr2).WithAsset(assets))
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	memoKey, privKey := types.DeriveMemoKey([]byte("seed"))
+
+	Convey("memo key size should be valid", t, func() {
+		msg := accountTypes.NewMsgSetMemoKey(addr1, name1, memoKey[:16])
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, sdkerrors.ErrInvalidPubKey)
+	})
+
+	Convey("only the account can set its memo key", t, func() {
+		msg := accountTypes.NewMsgSetMemoKey(addr2, name1, memoKey)
+		So(deliverAccountMsg(t, app, account2, addr2, false, &msg), ShouldNotBeNil)
+	})
+
+	Convey("set memo key", t, func() {
+		msg := accountTypes.NewMsgSetMemoKey(addr1, name1, memoKey)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &msg), ShouldBeNil)
+
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+		key, ok := app.AccountKeeper().GetMemoKey(ctx, name1)
+		So(ok, ShouldBeTrue)
+		So(key, ShouldResemble, memoKey)
+
+		_, ok = app.AccountKeeper().GetMemoKey(ctx, name2)
+		So(ok, ShouldBeFalse)
+
+		// the memo encrypted to the key can be decrypted by the account
+		memo, err := types.EncryptMemo(account1, key, "invoice 42")
+		So(err, ShouldBeNil)
+
+		plain, err := types.DecryptMemo(memo, privKey)
+		So(err, ShouldBeNil)
+		So(plain, ShouldEqual, "invoice 42")
+	})
+}
@@ -6,9 +6,11 @@ import (
 
 	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
 	"github.com/KuChainNetwork/kuchain/chain/genesis"
+	"github.com/KuChainNetwork/kuchain/chain/grpcserver"
 	"github.com/KuChainNetwork/kuchain/chain/msg"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/account/client/cli"
+	"github.com/KuChainNetwork/kuchain/x/account/client/grpcquery"
 	"github.com/KuChainNetwork/kuchain/x/account/client/rest"
 	"github.com/KuChainNetwork/kuchain/x/account/types"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -20,12 +22,14 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
 )
 
 var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ grpcserver.Module          = AppModuleBasic{}
 )
 
 // AppModuleBasic defines the basic application module used by the account module.
@@ -56,6 +60,11 @@ func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router
 	rest.RegisterRoutes(ctx, rtr, types.StoreKey)
 }
 
+// RegisterGRPCServices registers the gRPC query service of the account module.
+func (AppModuleBasic) RegisterGRPCServices(server *grpc.Server, querier grpcserver.ABCIQuerier) {
+	types.RegisterQueryServer(server, grpcquery.NewQueryServer(types.Cdc(), querier))
+}
+
 // GetTxCmd returns the root tx command for the account module.
 func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	return cli.GetTxCmd(cdc)
@@ -72,15 +81,21 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 
-	accountKeeper Keeper
-	assetTransfer chainTypes.AssetTransfer
+	accountKeeper  Keeper
+	auctionKeeper  AuctionKeeper
+	recoveryKeeper RecoveryKeeper
+	assetTransfer  chainTypes.AssetTransfer
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(accountKeeper Keeper, assetTransfer chainTypes.AssetTransfer) AppModule {
+func NewAppModule(
+	accountKeeper Keeper, auctionKeeper AuctionKeeper, recoveryKeeper RecoveryKeeper, assetTransfer chainTypes.AssetTransfer,
+) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(),
 		accountKeeper:  accountKeeper,
+		auctionKeeper:  auctionKeeper,
+		recoveryKeeper: recoveryKeeper,
 		assetTransfer:  assetTransfer,
 	}
 }
@@ -98,7 +113,7 @@ func (AppModule) Route() string { return RouterKey }
 
 // NewHandler returns an sdk.Handler for the account module.
 func (am AppModule) NewHandler() sdk.Handler {
-	return msg.WarpHandler(am.assetTransfer, am.accountKeeper, NewHandler(am.accountKeeper))
+	return msg.WarpHandler(am.assetTransfer, am.accountKeeper, NewHandler(am.accountKeeper, am.auctionKeeper, am.recoveryKeeper, am.assetTransfer))
 }
 
 // QuerierRoute returns the account module's querier route name.
@@ -108,25 +123,28 @@ func (AppModule) QuerierRoute() string {
 
 // NewQuerierHandler returns the account module sdk.Querier.
 func (am AppModule) NewQuerierHandler() sdk.Querier {
-	return NewQuerier(am.accountKeeper)
+	return NewAuctionQuerier(am.auctionKeeper)
 }
 
 // InitGenesis performs genesis initialization for the account module. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
-	InitGenesis(ctx, am.accountKeeper, data)
+	InitGenesis(ctx, am.accountKeeper, am.auctionKeeper, am.recoveryKeeper, data)
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the account module.
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
-	return types.ModuleCdc.MustMarshalJSON(ExportGenesis(ctx, am.accountKeeper))
+	return types.ModuleCdc.MustMarshalJSON(ExportGenesis(ctx, am.accountKeeper, am.auctionKeeper, am.recoveryKeeper))
 }
 
 // BeginBlock returns the begin blocker for the account module.
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	BeginBlocker(ctx, am.accountKeeper, am.recoveryKeeper)
+}
 
 // EndBlock returns the end blocker for the account module. It returns no validator updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.accountKeeper, am.auctionKeeper)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -0,0 +1,132 @@
+package account_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+)
+
+func TestAccountNameTransfer(t *testing.T) {
+	newAuth := wallet.NewAccAddress()
+	price := types.NewInt64CoreCoins(1000000)
+
+	Convey("name offer should be valid", t, func() {
+		msg := accountTypes.NewMsgOfferAccountName(addr1, account1, name1, newAuth, price)
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrNameTransferNotAllowed)
+
+		msg = accountTypes.NewMsgOfferAccountName(addr2, account2, name1, types.AccAddress{}, price)
+		So(msg.ValidateBasic(), ShouldNotBeNil)
+
+		msg = accountTypes.NewMsgOfferAccountName(addr2, account2, name1, newAuth, price)
+		So(msg.ValidateBasic(), ShouldBeNil)
+
+		transfer := accountTypes.NewMsgTransferAccountName(addr1, name1, account2, account1, price)
+		So(transfer.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrNameTransferNotAllowed)
+	})
+
+	Convey("name transferred to the buyer and the price paid to the receiver", t, func() {
+		app := createAppForRecoveryTest()
+
+		set := accountTypes.NewMsgSetGuardians(addr1, name1, []types.AccountID{account3}, 1)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &set), ShouldBeNil)
+
+		transfer := accountTypes.NewMsgTransferAccountName(addr1, name1, account2, account3, price)
+		So(deliverAccountMsg(t, app, account1, addr1, false, &transfer), simapp.ShouldErrIs, accountTypes.ErrNameOfferNoFound)
+
+		ctx := app.NewTestContext()
+		buyerCoins := getCoins(app, ctx, account2)
+
+		offer := accountTypes.NewMsgOfferAccountName(addr2, account2, name1, newAuth, price)
+		So(deliverAccountMsg(t, app, account2, addr2, true, &offer), ShouldBeNil)
+		So(deliverAccountMsg(t, app, account2, addr2, false, &offer), simapp.ShouldErrIs, accountTypes.ErrNameOfferExists)
+
+		ctx = app.NewTestContext()
+		fee := types.NewInt64CoreCoins(100000 * 2)
+		So(getCoins(app, ctx, account2), simapp.ShouldEq, buyerCoins.Sub(price).Sub(fee))
+
+		offers := app.AccountKeeper().GetNameOffers(ctx, name1)
+		So(offers, ShouldHaveLength, 1)
+		So(offers[0].Auth, simapp.ShouldEq, newAuth)
+
+		// only the owner can accept the offer
+		notOwner := accountTypes.NewMsgTransferAccountName(addr2, name1, account2, account2, price)
+		So(deliverAccountMsg(t, app, account2, addr2, false, &notOwner), simapp.ShouldErrIs, types.ErrMissingAuth)
+
+		So(app.AssetKeeper().GetCoinPowers(ctx, accountTypes.ModuleAccountID).IsEqual(price), ShouldBeTrue)
+
+		receiverPowers := app.AssetKeeper().GetCoinPowers(ctx, account3)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &transfer), ShouldBeNil)
+
+		ctx = app.NewTestContext()
+		auth, err := app.AccountKeeper().GetAuth(ctx, name1)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, newAuth)
+
+		So(app.AssetKeeper().GetCoinPowers(ctx, accountTypes.ModuleAccountID).IsZero(), ShouldBeTrue)
+		So(app.AssetKeeper().GetCoinPowers(ctx, account3).IsEqual(receiverPowers.Add(price...)), ShouldBeTrue)
+		So(app.AccountKeeper().GetNameOffers(ctx, name1), ShouldBeEmpty)
+
+		_, ok := app.AccountKeeper().GetGuardians(ctx, name1)
+		So(ok, ShouldBeFalse)
+	})
+
+	Convey("name transfer fails if the buyer re-offered at another price", t, func() {
+		app := createAppForRecoveryTest()
+
+		offer := accountTypes.NewMsgOfferAccountName(addr2, account2, name1, newAuth, price)
+		So(deliverAccountMsg(t, app, account2, addr2, true, &offer), ShouldBeNil)
+
+		cancel := accountTypes.NewMsgCancelNameOffer(addr2, account2, name1)
+		So(deliverAccountMsg(t, app, account2, addr2, true, &cancel), ShouldBeNil)
+
+		reOffer := accountTypes.NewMsgOfferAccountName(addr2, account2, name1, newAuth, types.Coins{})
+		So(deliverAccountMsg(t, app, account2, addr2, true, &reOffer), ShouldBeNil)
+
+		transfer := accountTypes.NewMsgTransferAccountName(addr1, name1, account2, account3, price)
+		So(deliverAccountMsg(t, app, account1, addr1, false, &transfer), simapp.ShouldErrIs, accountTypes.ErrNameOfferPriceMismatch)
+
+		ctx := app.NewTestContext()
+		auth, err := app.AccountKeeper().GetAuth(ctx, name1)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, addr1)
+		So(app.AccountKeeper().GetNameOffers(ctx, name1), ShouldHaveLength, 1)
+	})
+
+	Convey("name offer canceled with the price refunded", t, func() {
+		app := createAppForRecoveryTest()
+
+		offer := accountTypes.NewMsgOfferAccountName(addr2, account2, name1, newAuth, price)
+		So(deliverAccountMsg(t, app, account2, addr2, true, &offer), ShouldBeNil)
+
+		ctx := app.NewTestContext()
+		buyerCoins := getCoins(app, ctx, account2)
+		buyerPowers := app.AssetKeeper().GetCoinPowers(ctx, account2)
+
+		cancel := accountTypes.NewMsgCancelNameOffer(addr2, account2, name1)
+		So(deliverAccountMsg(t, app, account2, addr2, true, &cancel), ShouldBeNil)
+		So(deliverAccountMsg(t, app, account2, addr2, false, &cancel), simapp.ShouldErrIs, accountTypes.ErrNameOfferNoFound)
+
+		ctx = app.NewTestContext()
+		// the price is refunded as the coin power as the auction bids
+		fee := types.NewInt64CoreCoins(100000 * 2)
+		So(getCoins(app, ctx, account2), simapp.ShouldEq, buyerCoins.Sub(fee))
+		So(app.AssetKeeper().GetCoinPowers(ctx, accountTypes.ModuleAccountID).IsZero(), ShouldBeTrue)
+		So(app.AssetKeeper().GetCoinPowers(ctx, account2).IsEqual(buyerPowers.Add(price...)), ShouldBeTrue)
+		So(app.AccountKeeper().GetNameOffers(ctx, name1), ShouldBeEmpty)
+
+		auth, err := app.AccountKeeper().GetAuth(ctx, name1)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, addr1)
+	})
+}
+
+func getCoins(app *simapp.SimApp, ctx sdk.Context, id types.AccountID) types.Coins {
+	coins, err := app.AssetKeeper().GetCoins(ctx, id)
+	So(err, ShouldBeNil)
+	return coins
+}
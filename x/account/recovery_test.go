@@ -0,0 +1,165 @@
+package account_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+)
+
+const (
+	testRecoveryTimeLock = 5
+	testRecoveryExpiry   = 10
+)
+
+var (
+	name3    = types.MustName("guardian3")
+	addr4    = wallet.NewAccAddressByName(name3)
+	account3 = types.NewAccountIDFromName(name3)
+)
+
+func createAppForRecoveryTest() *simapp.SimApp {
+	assets := types.Coins{
+		types.NewInt64Coin(constants.DefaultBondDenom, 10000000000)}
+	genAccs := simapp.NewGenesisAccounts(
+		wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(assets),
+		simapp.NewSimGenesisAccount(account2, addr2).WithAsset(assets),
+		simapp.NewSimGenesisAccount(account3, addr4).WithAsset(assets))
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	// shorten the time lock and the expiry in a block for test
+	header := abci.Header{Height: app.LastBlockHeight() + 1, Time: time.Now()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.RecoveryKeeper().SetRecoveryParams(app.BaseApp.NewContext(false, header),
+		accountTypes.NewRecoveryParams(testRecoveryTimeLock, testRecoveryExpiry, accountTypes.DefaultAuthRotationDelay))
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	return app
+}
+
+// waitRecovery commits the blocks until the recovery of the account is processed
+func waitRecovery(app *simapp.SimApp, name types.Name) {
+	recovery, ok := app.AccountKeeper().GetRecovery(app.NewTestContext(), name)
+	So(ok, ShouldBeTrue)
+	simapp.AfterBlockCommitted(app, int(recovery.QueueHeight()-app.LastBlockHeight()))
+}
+
+func TestAccountRecovery(t *testing.T) {
+	newAuth := wallet.NewAccAddress()
+	guardians := []types.AccountID{account2, account3}
+
+	Convey("guardians should be valid", t, func() {
+		msg := accountTypes.NewMsgSetGuardians(addr1, name1, []types.AccountID{account2, account1}, 1)
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrAccountGuardianInvalid)
+
+		msg = accountTypes.NewMsgSetGuardians(addr1, name1, []types.AccountID{account2, account2}, 1)
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrAccountGuardianInvalid)
+
+		msg = accountTypes.NewMsgSetGuardians(addr1, name1, guardians, 3)
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrRecoveryThresholdInvalid)
+
+		msg = accountTypes.NewMsgSetGuardians(addr1, name1, nil, 0)
+		So(msg.ValidateBasic(), ShouldBeNil)
+	})
+
+	Convey("auth rotated after the time lock when the threshold of guardians approved", t, func() {
+		app := createAppForRecoveryTest()
+
+		initiate := accountTypes.NewMsgInitiateRecovery(addr2, account2, name1, newAuth)
+		So(deliverAccountMsg(t, app, account2, addr2, false, &initiate), simapp.ShouldErrIs, accountTypes.ErrGuardiansNoFound)
+
+		set := accountTypes.NewMsgSetGuardians(addr1, name1, guardians, 2)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &set), ShouldBeNil)
+
+		notGuardian := accountTypes.NewMsgInitiateRecovery(addr1, account1, name1, newAuth)
+		So(deliverAccountMsg(t, app, account1, addr1, false, &notGuardian), simapp.ShouldErrIs, accountTypes.ErrNotGuardian)
+
+		So(deliverAccountMsg(t, app, account2, addr2, true, &initiate), ShouldBeNil)
+		So(deliverAccountMsg(t, app, account1, addr1, false, &set), simapp.ShouldErrIs, accountTypes.ErrRecoveryPending)
+
+		approve := accountTypes.NewMsgApproveRecovery(addr2, account2, name1, newAuth)
+		So(deliverAccountMsg(t, app, account2, addr2, false, &approve), simapp.ShouldErrIs, accountTypes.ErrRecoveryHasApproved)
+
+		approve = accountTypes.NewMsgApproveRecovery(addr4, account3, name1, addr3)
+		So(deliverAccountMsg(t, app, account3, addr4, false, &approve), simapp.ShouldErrIs, accountTypes.ErrRecoveryAuthMismatch)
+
+		approve = accountTypes.NewMsgApproveRecovery(addr4, account3, name1, newAuth)
+		So(deliverAccountMsg(t, app, account3, addr4, true, &approve), ShouldBeNil)
+
+		ctx := app.NewTestContext()
+		recovery, ok := app.AccountKeeper().GetRecovery(ctx, name1)
+		So(ok, ShouldBeTrue)
+		So(recovery.IsApproved(), ShouldBeTrue)
+		So(recovery.UnlockHeight, ShouldEqual, app.LastBlockHeight()+testRecoveryTimeLock)
+
+		// the auth is not rotated until the time lock ends
+		auth, err := app.AccountKeeper().GetAuth(ctx, name1)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, addr1)
+
+		waitRecovery(app, name1)
+
+		ctx = app.NewTestContext()
+		auth, err = app.AccountKeeper().GetAuth(ctx, name1)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, newAuth)
+
+		_, ok = app.AccountKeeper().GetRecovery(ctx, name1)
+		So(ok, ShouldBeFalse)
+	})
+
+	Convey("recovery canceled by the account in the time lock", t, func() {
+		app := createAppForRecoveryTest()
+
+		set := accountTypes.NewMsgSetGuardians(addr1, name1, guardians, 1)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &set), ShouldBeNil)
+
+		initiate := accountTypes.NewMsgInitiateRecovery(addr4, account3, name1, newAuth)
+		So(deliverAccountMsg(t, app, account3, addr4, true, &initiate), ShouldBeNil)
+
+		cancel := accountTypes.NewMsgCancelRecovery(addr1, name1)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &cancel), ShouldBeNil)
+		So(deliverAccountMsg(t, app, account1, addr1, false, &cancel), simapp.ShouldErrIs, accountTypes.ErrRecoveryNoFound)
+
+		simapp.AfterBlockCommitted(app, testRecoveryTimeLock)
+
+		auth, err := app.AccountKeeper().GetAuth(app.NewTestContext(), name1)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, addr1)
+	})
+
+	Convey("recovery dropped if not approved before expired", t, func() {
+		app := createAppForRecoveryTest()
+
+		set := accountTypes.NewMsgSetGuardians(addr1, name1, guardians, 2)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &set), ShouldBeNil)
+
+		initiate := accountTypes.NewMsgInitiateRecovery(addr2, account2, name1, newAuth)
+		So(deliverAccountMsg(t, app, account2, addr2, true, &initiate), ShouldBeNil)
+
+		waitRecovery(app, name1)
+
+		ctx := app.NewTestContext()
+		_, ok := app.AccountKeeper().GetRecovery(ctx, name1)
+		So(ok, ShouldBeFalse)
+
+		auth, err := app.AccountKeeper().GetAuth(ctx, name1)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, addr1)
+
+		// the guardians can be changed after the recovery dropped
+		set = accountTypes.NewMsgSetGuardians(addr1, name1, nil, 0)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &set), ShouldBeNil)
+
+		_, ok = app.AccountKeeper().GetGuardians(app.NewTestContext(), name1)
+		So(ok, ShouldBeFalse)
+	})
+}
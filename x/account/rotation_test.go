@@ -0,0 +1,119 @@
+package account_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+)
+
+const testAuthRotationDelay = 5
+
+func createAppForRotationTest() *simapp.SimApp {
+	assets := types.Coins{
+		types.NewInt64Coin(constants.DefaultBondDenom, 10000000000)}
+	genAccs := simapp.NewGenesisAccounts(
+		wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(assets),
+		simapp.NewSimGenesisAccount(account2, addr2).WithAsset(assets),
+		simapp.NewSimGenesisAccount(account3, addr4).WithAsset(assets))
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	// delay the auth rotations in a block for test
+	header := abci.Header{Height: app.LastBlockHeight() + 1, Time: time.Now()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.RecoveryKeeper().SetRecoveryParams(app.BaseApp.NewContext(false, header),
+		accountTypes.NewRecoveryParams(testRecoveryTimeLock, testRecoveryExpiry, testAuthRotationDelay))
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	return app
+}
+
+func TestAuthRotation(t *testing.T) {
+	Convey("new auth active after the delay", t, func() {
+		app := createAppForRotationTest()
+		newAuth := wallet.NewAccAddress()
+
+		update := accountTypes.NewMsgUpdateAccountAuth(addr1, name1, newAuth)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &update), ShouldBeNil)
+
+		ctx := app.NewTestContext()
+		pending, ok := app.AccountKeeper().GetPendingAuth(ctx, name1)
+		So(ok, ShouldBeTrue)
+		So(pending.Auth, simapp.ShouldEq, newAuth)
+		So(pending.OldAuth, simapp.ShouldEq, addr1)
+		So(pending.ActiveHeight, ShouldEqual, app.LastBlockHeight()+testAuthRotationDelay)
+
+		// the old auth is still active, and cannot update again until the rotation finished
+		auth, err := app.AccountKeeper().GetAuth(ctx, name1)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, addr1)
+
+		again := accountTypes.NewMsgUpdateAccountAuth(addr1, name1, addr3)
+		So(deliverAccountMsg(t, app, account1, addr1, false, &again), simapp.ShouldErrIs, accountTypes.ErrAuthRotationPending)
+
+		simapp.AfterBlockCommitted(app, int(pending.ActiveHeight-app.LastBlockHeight()))
+
+		ctx = app.NewTestContext()
+		auth, err = app.AccountKeeper().GetAuth(ctx, name1)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, newAuth)
+
+		_, ok = app.AccountKeeper().GetPendingAuth(ctx, name1)
+		So(ok, ShouldBeFalse)
+		So(app.AccountKeeper().GetAccountsByAuth(ctx, addr1), ShouldNotContain, name1.String())
+	})
+
+	Convey("rotation canceled by the old auth before the new auth active", t, func() {
+		app := createAppForRotationTest()
+		stolenAuth := wallet.NewAccAddress()
+
+		update := accountTypes.NewMsgUpdateAccountAuth(addr1, name1, stolenAuth)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &update), ShouldBeNil)
+
+		// only the old auth can cancel
+		cancel := accountTypes.NewMsgCancelAuthRotation(addr2, name1)
+		So(deliverAccountMsg(t, app, account2, addr2, false, &cancel), simapp.ShouldErrIs, types.ErrMissingAuth)
+
+		cancel = accountTypes.NewMsgCancelAuthRotation(addr1, name1)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &cancel), ShouldBeNil)
+		So(deliverAccountMsg(t, app, account1, addr1, false, &cancel), simapp.ShouldErrIs, accountTypes.ErrAuthRotationNoFound)
+
+		simapp.AfterBlockCommitted(app, testAuthRotationDelay)
+
+		auth, err := app.AccountKeeper().GetAuth(app.NewTestContext(), name1)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, addr1)
+	})
+
+	Convey("pending rotation dropped when the auth recovered by the guardians", t, func() {
+		app := createAppForRotationTest()
+		stolenAuth, recoveredAuth := wallet.NewAccAddress(), wallet.NewAccAddress()
+
+		set := accountTypes.NewMsgSetGuardians(addr1, name1, []types.AccountID{account2}, 1)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &set), ShouldBeNil)
+
+		initiate := accountTypes.NewMsgInitiateRecovery(addr2, account2, name1, recoveredAuth)
+		So(deliverAccountMsg(t, app, account2, addr2, true, &initiate), ShouldBeNil)
+
+		update := accountTypes.NewMsgUpdateAccountAuth(addr1, name1, stolenAuth)
+		So(deliverAccountMsg(t, app, account1, addr1, true, &update), ShouldBeNil)
+
+		simapp.AfterBlockCommitted(app, testRecoveryTimeLock+testAuthRotationDelay)
+
+		ctx := app.NewTestContext()
+		auth, err := app.AccountKeeper().GetAuth(ctx, name1)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, recoveredAuth)
+
+		_, ok := app.AccountKeeper().GetPendingAuth(ctx, name1)
+		So(ok, ShouldBeFalse)
+	})
+}
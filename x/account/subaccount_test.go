@@ -0,0 +1,147 @@
+package account_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	accountTypes "github.com/KuChainNetwork/kuchain/x/account/types"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+)
+
+var (
+	corpName     = types.MustName("corp")
+	corpAddr     = wallet.NewAccAddressByName(corpName)
+	corpAccount  = types.NewAccountIDFromName(corpName)
+	treasuryName = types.MustName("corp.treasury")
+	treasuryID   = types.NewAccountIDFromName(treasuryName)
+)
+
+func createAppForSubAccountTest() *simapp.SimApp {
+	assets := types.Coins{
+		types.NewInt64Coin(constants.DefaultBondDenom, 10000000000)}
+	genAccs := simapp.NewGenesisAccounts(
+		wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account2, addr2).WithAsset(assets),
+		simapp.NewSimGenesisAccount(corpAccount, corpAddr).WithAsset(assets))
+	return simapp.SetupWithGenesisAccounts(genAccs)
+}
+
+func TestSubAccountPermissions(t *testing.T) {
+	transferPerms := []string{"asset/transfer"}
+	amount := types.Coins{types.NewInt64Coin(constants.DefaultBondDenom, 1000000000)}
+
+	Convey("sub-account name and permissions should be valid", t, func() {
+		msg := accountTypes.NewMsgCreateSubAccount(corpAddr, corpName, types.MustName("other.treasury"), addr3, transferPerms)
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrSubAccountNameInvalid)
+
+		msg = accountTypes.NewMsgCreateSubAccount(corpAddr, corpName, types.MustName("corp."), addr3, transferPerms)
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrSubAccountNameInvalid)
+
+		msg = accountTypes.NewMsgCreateSubAccount(corpAddr, corpName, treasuryName, addr3, nil)
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrSubAccountPermissionInvalid)
+
+		msg = accountTypes.NewMsgCreateSubAccount(corpAddr, corpName, treasuryName, addr3, []string{"asset/"})
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrSubAccountPermissionInvalid)
+
+		msg = accountTypes.NewMsgCreateSubAccount(corpAddr, corpName, treasuryName, addr3, []string{"asset", "asset"})
+		So(msg.ValidateBasic(), simapp.ShouldErrIs, accountTypes.ErrSubAccountPermissionInvalid)
+
+		msg = accountTypes.NewMsgCreateSubAccount(corpAddr, corpName, treasuryName, addr3, []string{"asset", "kugov/vote"})
+		So(msg.ValidateBasic(), ShouldBeNil)
+	})
+
+	Convey("the auth of sub-account can only sign the msgs in the permissions", t, func() {
+		app := createAppForSubAccountTest()
+		childAuth := wallet.NewAccAddress()
+
+		// the auth of other accounts cannot be scoped by a sub-account
+		create := accountTypes.NewMsgCreateSubAccount(corpAddr, corpName, treasuryName, addr2, transferPerms)
+		So(deliverAccountMsg(t, app, corpAccount, corpAddr, false, &create), simapp.ShouldErrIs, accountTypes.ErrSubAccountAuthInUse)
+
+		// only the parent can create the sub-account
+		create = accountTypes.NewMsgCreateSubAccount(addr2, corpName, treasuryName, childAuth, transferPerms)
+		So(deliverAccountMsg(t, app, account2, addr2, false, &create), simapp.ShouldErrIs, types.ErrMissingAuth)
+
+		create = accountTypes.NewMsgCreateSubAccount(corpAddr, corpName, treasuryName, childAuth, transferPerms)
+		So(deliverAccountMsg(t, app, corpAccount, corpAddr, true, &create), ShouldBeNil)
+		So(deliverAccountMsg(t, app, corpAccount, corpAddr, false, &create), simapp.ShouldErrIs, accountTypes.ErrAccountHasCreated)
+
+		ctx := app.NewTestContext()
+		subAccount, ok := app.AccountKeeper().GetSubAccount(ctx, treasuryName)
+		So(ok, ShouldBeTrue)
+		So(subAccount.Parent, simapp.ShouldEq, corpName)
+		So(subAccount.Permissions, ShouldResemble, transferPerms)
+		So(app.AccountKeeper().GetSubAccountsOf(ctx, corpName), ShouldHaveLength, 1)
+
+		auth, err := app.AccountKeeper().GetAuth(ctx, treasuryName)
+		So(err, ShouldBeNil)
+		So(auth, simapp.ShouldEq, childAuth)
+
+		fund := assetTypes.NewMsgTransfer(corpAddr, corpAccount, treasuryID, amount)
+		So(deliverAccountMsg(t, app, corpAccount, corpAddr, true, &fund), ShouldBeNil)
+
+		transfer := assetTypes.NewMsgTransfer(childAuth, treasuryID, account2, types.Coins{types.NewInt64Coin(constants.DefaultBondDenom, 100000000)})
+		So(deliverAccountMsg(t, app, treasuryID, childAuth, true, &transfer), ShouldBeNil)
+
+		newAuth := wallet.NewAccAddress()
+		update := accountTypes.NewMsgUpdateAccountAuth(childAuth, treasuryName, newAuth)
+		So(deliverAccountMsg(t, app, treasuryID, childAuth, false, &update), simapp.ShouldErrIs, accountTypes.ErrSubAccountMsgNotAllowed)
+
+		// only the parent can set the permissions
+		setPerms := accountTypes.NewMsgSetSubAccountPermissions(addr2, treasuryName, []string{"asset", "account/updateauth"})
+		So(deliverAccountMsg(t, app, account2, addr2, false, &setPerms), simapp.ShouldErrIs, types.ErrMissingAuth)
+
+		setPerms = accountTypes.NewMsgSetSubAccountPermissions(corpAddr, treasuryName, []string{"asset", "account/updateauth"})
+		So(deliverAccountMsg(t, app, corpAccount, corpAddr, true, &setPerms), ShouldBeNil)
+
+		update = accountTypes.NewMsgUpdateAccountAuth(childAuth, treasuryName, addr2)
+		So(deliverAccountMsg(t, app, treasuryID, childAuth, false, &update), simapp.ShouldErrIs, accountTypes.ErrSubAccountAuthInUse)
+
+		update = accountTypes.NewMsgUpdateAccountAuth(childAuth, treasuryName, newAuth)
+		So(deliverAccountMsg(t, app, treasuryID, childAuth, true, &update), ShouldBeNil)
+
+		// the old auth is no longer scoped, and the new auth is scoped
+		ctx = app.NewTestContext()
+		So(app.AccountKeeper().ValidateMsgsScope(ctx, []sdk.Msg{&update}), ShouldBeNil)
+
+		memoKey := accountTypes.NewMsgSetMemoKey(newAuth, treasuryName, []byte("memo key"))
+		So(app.AccountKeeper().ValidateMsgsScope(ctx, []sdk.Msg{&memoKey}), simapp.ShouldErrIs, accountTypes.ErrSubAccountMsgNotAllowed)
+	})
+
+	Convey("the transfer in the msgs signed by the auth of sub-account needs the transfer permission", t, func() {
+		app := createAppForSubAccountTest()
+		childAuth := wallet.NewAccAddress()
+
+		create := accountTypes.NewMsgCreateSubAccount(corpAddr, corpName, treasuryName, childAuth, []string{"kugov/vote"})
+		So(deliverAccountMsg(t, app, corpAccount, corpAddr, true, &create), ShouldBeNil)
+
+		fund := assetTypes.NewMsgTransfer(corpAddr, corpAccount, treasuryID, amount)
+		So(deliverAccountMsg(t, app, corpAccount, corpAddr, true, &fund), ShouldBeNil)
+
+		ctx := app.NewTestContext()
+		vote := govTypes.NewKuMsgVote(childAuth, treasuryID, 1, govTypes.OptionYes)
+		So(app.AccountKeeper().ValidateMsgsScope(ctx, []sdk.Msg{&vote}), ShouldBeNil)
+
+		// a vote with the transfer of all the coins of the sub-account
+		voteWithTransfer := govTypes.KuMsgVote{
+			KuMsg: *msg.MustNewKuMsg(
+				govTypes.RouterKeyName,
+				msg.WithAuth(childAuth),
+				msg.WithTransfer(treasuryID, account2, amount),
+				msg.WithData(govTypes.Cdc(), &govTypes.MsgVote{ProposalID: 1, Voter: treasuryID, Option: govTypes.OptionYes}),
+			),
+		}
+		So(deliverAccountMsg(t, app, treasuryID, childAuth, false, &voteWithTransfer),
+			simapp.ShouldErrIs, accountTypes.ErrSubAccountMsgNotAllowed)
+
+		ctx = app.NewTestContext()
+		So(app.AssetKeeper().GetAllBalances(ctx, treasuryID).IsEqual(amount), ShouldBeTrue)
+	})
+}
@@ -81,6 +81,46 @@ func (ar AccountRetriever) GetAddAuthWithHeight(add types.AccAddress) (Auth, int
 	return authData, height, nil
 }
 
+// GetAccountsAuth queries for the auth states of accounts in one query, the result is in the order of ids.
+func (ar AccountRetriever) GetAccountsAuth(ids []types.AccountID) ([]AccountAuthData, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryAccountsAuthParams(ids...))
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryAccountsAuth), bs)
+	if err != nil {
+		return nil, err
+	}
+
+	var auths []AccountAuthData
+	if err := ModuleCdc.UnmarshalJSON(res, &auths); err != nil {
+		return nil, err
+	}
+
+	return auths, nil
+}
+
+// GetAccountsByAddress queries for the names of the accounts owned by the auth of the address.
+func (ar AccountRetriever) GetAccountsByAddress(address types.AccAddress) (Accounts, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryAccountsByAddressParams(address))
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryAccountsByAuth), bs)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts Accounts
+	if err := ModuleCdc.UnmarshalJSON(res, &accounts); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
 // EnsureExists returns an error if no account exists for the given address else nil.
 func (ar AccountRetriever) EnsureExists(id types.AccountID) error {
 	if _, err := ar.GetAccount(id); err != nil {
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"gopkg.in/yaml.v2"
+)
+
+// NormalAccountNameLen the length of the account names created by users,
+// the shorter names are premium names which can only be sold by auctions
+const NormalAccountNameLen = 12
+
+// AuctionType the type of the name auction
+type AuctionType string
+
+const (
+	// AuctionTypeAscending the bids are public, each bid should exceed the highest bid,
+	// the outbid bidder is refunded at once and the winner pays the highest bid
+	AuctionTypeAscending AuctionType = "ascending"
+
+	// AuctionTypeSealed each bidder bids once by the hash commitment of the bid with a deposit not less than it,
+	// the bids are revealed after the end height in the reveal period, the highest revealed bid wins and pays
+	// the second highest revealed bid (or the min bid), the others are refunded and the unrevealed deposits
+	// go to the community pool when settled
+	AuctionTypeSealed AuctionType = "sealed"
+)
+
+// Validate returns error if the auction type is unknown
+func (t AuctionType) Validate() error {
+	switch t {
+	case AuctionTypeAscending, AuctionTypeSealed:
+		return nil
+	default:
+		return ErrAuctionTypeInvalid
+	}
+}
+
+// NameAuction the auction of a premium account name, the name is reserved
+// from the start of the auction until claimed by the winner
+type NameAuction struct {
+	Name            types.Name      `json:"name" yaml:"name"`
+	Type            AuctionType     `json:"type" yaml:"type"`
+	Starter         types.AccountID `json:"starter" yaml:"starter"`
+	StartHeight     int64           `json:"start_height" yaml:"start_height"`
+	EndHeight       int64           `json:"end_height" yaml:"end_height"`
+	RevealEndHeight int64           `json:"reveal_end_height,omitempty" yaml:"reveal_end_height,omitempty"` // the end height of the reveals, only for sealed auctions
+	MinBid          types.Coin      `json:"min_bid" yaml:"min_bid"`
+	Bidder          types.AccountID `json:"bidder" yaml:"bidder"` // the highest bidder, for sealed auctions set when settled
+	Bid             types.Coin      `json:"bid" yaml:"bid"`       // the highest bid, for sealed auctions set when settled
+	Price           types.Coin      `json:"price" yaml:"price"`   // the price paid by the winner, set when settled
+	Settled         bool            `json:"settled" yaml:"settled"`
+}
+
+// NewNameAuction creates a new name auction
+func NewNameAuction(name types.Name, auctionType AuctionType, starter types.AccountID, startHeight, endHeight int64, minBid types.Coin) NameAuction {
+	return NameAuction{
+		Name:        name,
+		Type:        auctionType,
+		Starter:     starter,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		MinBid:      minBid,
+	}
+}
+
+// SettleHeight returns the height to settle the auction, the sealed auctions are settled after the reveals
+func (a NameAuction) SettleHeight() int64 {
+	if a.Type == AuctionTypeSealed {
+		return a.RevealEndHeight
+	}
+
+	return a.EndHeight
+}
+
+// IsRevealing returns true if the bids of the sealed auction can be revealed at the height
+func (a NameAuction) IsRevealing(height int64) bool {
+	return a.Type == AuctionTypeSealed && !a.Settled && height > a.EndHeight && height <= a.RevealEndHeight
+}
+
+// HasBid returns true if there is a highest bid in the auction
+func (a NameAuction) HasBid() bool {
+	return !a.Bidder.Empty()
+}
+
+func (a NameAuction) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
+
+// NameAuctionBid the bid of a sealed auction, only the commitment of the bid is public until revealed,
+// the deposit is held by the module account until settled
+type NameAuctionBid struct {
+	Name       types.Name      `json:"name" yaml:"name"`
+	Bidder     types.AccountID `json:"bidder" yaml:"bidder"`
+	Deposit    types.Coin      `json:"deposit" yaml:"deposit"`
+	Commitment []byte          `json:"commitment" yaml:"commitment"`
+	Revealed   bool            `json:"revealed" yaml:"revealed"`
+	Amount     types.Coin      `json:"amount" yaml:"amount"` // the bid revealed
+}
+
+// NewNameAuctionBid creates a new bid of a sealed auction by the commitment
+func NewNameAuctionBid(name types.Name, bidder types.AccountID, deposit types.Coin, commitment []byte) NameAuctionBid {
+	return NameAuctionBid{
+		Name:       name,
+		Bidder:     bidder,
+		Deposit:    deposit,
+		Commitment: commitment,
+	}
+}
+
+func (b NameAuctionBid) String() string {
+	if !b.Revealed {
+		return fmt.Sprintf("%s bid %X with deposit %s for %s", b.Bidder, b.Commitment, b.Deposit, b.Name)
+	}
+
+	return fmt.Sprintf("%s bid %s with deposit %s for %s", b.Bidder, b.Amount, b.Deposit, b.Name)
+}
+
+// AuctionBidCommitment returns the commitment of the bid in a sealed auction, the hash of the bid with a salt,
+// the bidder is hashed so that the commitment cannot be copied by the others.
+func AuctionBidCommitment(name types.Name, bidder types.AccountID, amount types.Coin, salt string) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%s", name, bidder, amount, salt)))
+	return hash[:]
+}
+
+// ValidateAuctionName returns error if the name cannot be sold by auction
+func ValidateAuctionName(name types.Name) error {
+	if name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if !types.VerifyNameString(name.String()) {
+		return ErrAccountNameInvalid
+	}
+
+	if name.Len() >= NormalAccountNameLen {
+		return ErrAuctionNameNotPremium
+	}
+
+	return nil
+}
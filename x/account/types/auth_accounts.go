@@ -13,7 +13,14 @@ func NewAuthAccount(auth, acc string) AuthAccounts {
 	}
 }
 
+// AddAccount adds the account to the auth, the account added only once
 func (a *AuthAccounts) AddAccount(acc string) {
+	for _, v := range a.Accounts {
+		if v == acc {
+			return
+		}
+	}
+
 	a.Accounts = append(a.Accounts, acc)
 }
 
@@ -21,6 +28,7 @@ func (a *AuthAccounts) DeleteAccount(acc string) {
 	for i, v := range a.Accounts {
 		if v == acc {
 			a.Accounts = append(a.Accounts[:i], a.Accounts[i+1:]...)
+			return
 		}
 	}
 }
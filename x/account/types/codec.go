@@ -18,10 +18,49 @@ func RegisterCodec(cdc *codec.Codec) {
 
 	cdc.RegisterConcrete(&MsgCreateAccountData{}, "account/createData", nil)
 	cdc.RegisterConcrete(&MsgCreateAccount{}, "account/createMsg", nil)
+	cdc.RegisterConcrete(MsgCreateAccountResponse{}, "account/createResponse", nil)
+	cdc.RegisterConcrete(MsgCreateAccountsResponse{}, "account/createBatchResponse", nil)
 
 	cdc.RegisterConcrete(&MsgUpdateAccountAuthData{}, "account/upAuthData", nil)
 	cdc.RegisterConcrete(&MsgUpdateAccountAuth{}, "account/upAuth", nil)
 
+	cdc.RegisterConcrete(&MsgDeactivateAccountData{}, "account/deactivateData", nil)
+	cdc.RegisterConcrete(&MsgDeactivateAccount{}, "account/deactivate", nil)
+	cdc.RegisterConcrete(&MsgReactivateAccountData{}, "account/reactivateData", nil)
+	cdc.RegisterConcrete(&MsgReactivateAccount{}, "account/reactivate", nil)
+	cdc.RegisterConcrete(&MsgSetMemoKeyData{}, "account/setMemoKeyData", nil)
+	cdc.RegisterConcrete(&MsgSetMemoKey{}, "account/setMemoKey", nil)
+	cdc.RegisterConcrete(&MsgStartAuctionData{}, "account/startAuctionData", nil)
+	cdc.RegisterConcrete(&MsgStartAuction{}, "account/startAuction", nil)
+	cdc.RegisterConcrete(&MsgAuctionBidData{}, "account/auctionBidData", nil)
+	cdc.RegisterConcrete(&MsgAuctionBid{}, "account/auctionBid", nil)
+	cdc.RegisterConcrete(&MsgRevealAuctionBidData{}, "account/revealAuctionBidData", nil)
+	cdc.RegisterConcrete(&MsgRevealAuctionBid{}, "account/revealAuctionBid", nil)
+	cdc.RegisterConcrete(&MsgClaimAuctionData{}, "account/claimAuctionData", nil)
+	cdc.RegisterConcrete(&MsgClaimAuction{}, "account/claimAuction", nil)
+	cdc.RegisterConcrete(&MsgSetGuardiansData{}, "account/setGuardiansData", nil)
+	cdc.RegisterConcrete(&MsgSetGuardians{}, "account/setGuardians", nil)
+	cdc.RegisterConcrete(&MsgInitiateRecoveryData{}, "account/initRecoveryData", nil)
+	cdc.RegisterConcrete(&MsgInitiateRecovery{}, "account/initRecovery", nil)
+	cdc.RegisterConcrete(&MsgApproveRecoveryData{}, "account/approveRecoveryData", nil)
+	cdc.RegisterConcrete(&MsgApproveRecovery{}, "account/approveRecovery", nil)
+	cdc.RegisterConcrete(&MsgCancelRecoveryData{}, "account/cancelRecoveryData", nil)
+	cdc.RegisterConcrete(&MsgCancelRecovery{}, "account/cancelRecovery", nil)
+	cdc.RegisterConcrete(&MsgCreateSubAccountData{}, "account/createSubAccData", nil)
+	cdc.RegisterConcrete(&MsgCreateSubAccount{}, "account/createSubAcc", nil)
+	cdc.RegisterConcrete(&MsgSetSubAccountPermissionsData{}, "account/setSubAccPermsData", nil)
+	cdc.RegisterConcrete(&MsgSetSubAccountPermissions{}, "account/setSubAccPerms", nil)
+	cdc.RegisterConcrete(&MsgCancelAuthRotationData{}, "account/cancelAuthRotationData", nil)
+	cdc.RegisterConcrete(&MsgCancelAuthRotation{}, "account/cancelAuthRotation", nil)
+	cdc.RegisterConcrete(&MsgCreateAccountsData{}, "account/createBatchData", nil)
+	cdc.RegisterConcrete(&MsgCreateAccounts{}, "account/createBatch", nil)
+	cdc.RegisterConcrete(&MsgOfferAccountNameData{}, "account/offerNameData", nil)
+	cdc.RegisterConcrete(&MsgOfferAccountName{}, "account/offerName", nil)
+	cdc.RegisterConcrete(&MsgCancelNameOfferData{}, "account/cancelNameOfferData", nil)
+	cdc.RegisterConcrete(&MsgCancelNameOffer{}, "account/cancelNameOffer", nil)
+	cdc.RegisterConcrete(&MsgTransferAccountNameData{}, "account/transferNameData", nil)
+	cdc.RegisterConcrete(&MsgTransferAccountName{}, "account/transferName", nil)
+
 	cdc.RegisterConcrete(&KuAccount{}, "kuchain/Account", nil)
 	cdc.RegisterConcrete(&ModuleAccount{}, "kuchain/ModuleAccount", nil)
 
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Deactivation the record of a deactivated account, the account cannot send txs until reactivated by guardian
+type Deactivation struct {
+	Account  types.Name      `json:"account" yaml:"account"`
+	Guardian types.AccountID `json:"guardian" yaml:"guardian"`
+	Reason   string          `json:"reason" yaml:"reason"`
+	Height   int64           `json:"height" yaml:"height"`
+}
+
+// NewDeactivation creates a new deactivation record
+func NewDeactivation(account types.Name, guardian types.AccountID, reason string, height int64) Deactivation {
+	return Deactivation{
+		Account:  account,
+		Guardian: guardian,
+		Reason:   reason,
+		Height:   height,
+	}
+}
+
+func (d Deactivation) String() string {
+	out, _ := yaml.Marshal(d)
+	return string(out)
+}
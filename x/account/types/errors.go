@@ -8,4 +8,41 @@ var (
 	ErrAccountCannotCreateSysAccount = sdkerrors.Register(ModuleName, 3, "cannot create system account by create")
 	ErrAccountNameInvalid            = sdkerrors.Register(ModuleName, 4, "account name is invalid")
 	ErrAccountNameLenInvalid         = sdkerrors.Register(ModuleName, 5, "account name length is invalid")
+	ErrAccountDeactivated            = sdkerrors.Register(ModuleName, 6, "account is deactivated")
+	ErrAccountNotDeactivated         = sdkerrors.Register(ModuleName, 7, "account is not deactivated")
+	ErrAccountGuardianInvalid        = sdkerrors.Register(ModuleName, 8, "account guardian is invalid")
+	ErrAccountMemoKeyNoFound         = sdkerrors.Register(ModuleName, 9, "account memo key no found")
+	ErrAccountNameReserved           = sdkerrors.Register(ModuleName, 10, "account name is reserved by auction")
+	ErrAuctionTypeInvalid            = sdkerrors.Register(ModuleName, 11, "auction type is invalid")
+	ErrAuctionNameNotPremium         = sdkerrors.Register(ModuleName, 12, "only the premium account names can be auctioned")
+	ErrAuctionNoFound                = sdkerrors.Register(ModuleName, 13, "auction no found")
+	ErrAuctionHasStarted             = sdkerrors.Register(ModuleName, 14, "auction of the name has started")
+	ErrAuctionEnded                  = sdkerrors.Register(ModuleName, 15, "auction has ended")
+	ErrAuctionNotSettled             = sdkerrors.Register(ModuleName, 16, "auction is not settled")
+	ErrAuctionBidTooLow              = sdkerrors.Register(ModuleName, 17, "auction bid is too low")
+	ErrAuctionBidNotTransferred      = sdkerrors.Register(ModuleName, 18, "auction bid is not transferred to module account")
+	ErrAuctionHasBid                 = sdkerrors.Register(ModuleName, 19, "bidder has bid in the sealed auction")
+	ErrAuctionNotWinner              = sdkerrors.Register(ModuleName, 20, "only the winner can claim the name")
+	ErrRecoveryThresholdInvalid      = sdkerrors.Register(ModuleName, 21, "recovery threshold is invalid")
+	ErrGuardiansNoFound              = sdkerrors.Register(ModuleName, 22, "account has no guardians")
+	ErrNotGuardian                   = sdkerrors.Register(ModuleName, 23, "not the guardian of the account")
+	ErrRecoveryPending               = sdkerrors.Register(ModuleName, 24, "recovery of the account is pending")
+	ErrRecoveryNoFound               = sdkerrors.Register(ModuleName, 25, "recovery no found")
+	ErrRecoveryHasApproved           = sdkerrors.Register(ModuleName, 26, "guardian has approved the recovery")
+	ErrRecoveryAuthMismatch          = sdkerrors.Register(ModuleName, 27, "recovery auth mismatch")
+	ErrSubAccountNameInvalid         = sdkerrors.Register(ModuleName, 28, "sub-account name is invalid")
+	ErrSubAccountPermissionInvalid   = sdkerrors.Register(ModuleName, 29, "sub-account permission is invalid")
+	ErrSubAccountNoFound             = sdkerrors.Register(ModuleName, 30, "sub-account no found")
+	ErrSubAccountAuthInUse           = sdkerrors.Register(ModuleName, 31, "auth of sub-account is used by other accounts")
+	ErrSubAccountMsgNotAllowed       = sdkerrors.Register(ModuleName, 32, "msg is not allowed by the permissions of sub-account")
+	ErrAuthRotationPending           = sdkerrors.Register(ModuleName, 33, "account has a pending auth rotation")
+	ErrAuthRotationNoFound           = sdkerrors.Register(ModuleName, 34, "pending auth rotation no found")
+	ErrNameOfferNoFound              = sdkerrors.Register(ModuleName, 35, "name offer no found")
+	ErrNameOfferExists               = sdkerrors.Register(ModuleName, 36, "buyer has offered for the name")
+	ErrNameOfferNotTransferred       = sdkerrors.Register(ModuleName, 37, "name offer price is not transferred to module account")
+	ErrNameTransferNotAllowed        = sdkerrors.Register(ModuleName, 38, "account name cannot be transferred")
+	ErrNameOfferPriceMismatch        = sdkerrors.Register(ModuleName, 39, "name offer price mismatch")
+	ErrAuctionBidCommitmentInvalid   = sdkerrors.Register(ModuleName, 40, "auction bid commitment is invalid")
+	ErrAuctionNotRevealing           = sdkerrors.Register(ModuleName, 41, "auction is not in the reveal period")
+	ErrAuctionBidRevealed            = sdkerrors.Register(ModuleName, 42, "auction bid has been revealed")
 )
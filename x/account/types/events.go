@@ -5,8 +5,58 @@ const (
 
 	EventTypeCreateAccount     = "account.create"
 	EventTypeUpdateAccountAuth = "account.authupdate"
+	EventTypeDeactivateAccount = "account.deactivate"
+	EventTypeReactivateAccount = "account.reactivate"
+	EventTypePruneAuths        = "account.pruneauths"
+	EventTypeSetMemoKey        = "account.setmemokey"
+	EventTypeStartAuction      = "account.startauction"
+	EventTypeAuctionBid        = "account.auctionbid"
+	EventTypeRevealAuctionBid  = "account.revealauctionbid"
+	EventTypeAuctionRefund     = "account.auctionrefund"
+	EventTypeSettleAuction     = "account.settleauction"
+	EventTypeClaimAuction      = "account.claimauction"
+	EventTypeSetGuardians      = "account.setguardians"
+	EventTypeInitiateRecovery  = "account.initiaterecovery"
+	EventTypeApproveRecovery   = "account.approverecovery"
+	EventTypeCancelRecovery    = "account.cancelrecovery"
+	EventTypeExecuteRecovery   = "account.executerecovery"
+	EventTypeExpireRecovery    = "account.expirerecovery"
+	EventTypeCreateSubAccount  = "account.createsubaccount"
+	EventTypeSetSubAccountPerm = "account.setsubaccountperm"
+	EventTypeScheduleAuth      = "account.scheduleauth"
+	EventTypeCancelAuth        = "account.cancelauth"
+	EventTypeOfferName         = "account.offername"
+	EventTypeCancelNameOffer   = "account.cancelnameoffer"
+	EventTypeTransferName      = "account.transfername"
 
-	AttributeKeyCreator = "creator"
-	AttributeKeyAccount = "account"
-	AttributeKeyAuth    = "auth"
+	AttributeKeyCreator  = "creator"
+	AttributeKeyAccount  = "account"
+	AttributeKeyAuth     = "auth"
+	AttributeKeyGuardian = "guardian"
+	AttributeKeyReason   = "reason"
+	AttributeKeyPruned   = "pruned"
+	AttributeKeyMemoKey  = "memo_key"
+
+	AttributeKeyAuctionType = "auction_type"
+	AttributeKeyEndHeight   = "end_height"
+	AttributeKeyBidder      = "bidder"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyWinner      = "winner"
+	AttributeKeyPrice       = "price"
+	AttributeKeyCommitment  = "commitment"
+	AttributeKeyForfeited   = "forfeited"
+
+	AttributeKeyGuardians    = "guardians"
+	AttributeKeyThreshold    = "threshold"
+	AttributeKeyApprovals    = "approvals"
+	AttributeKeyUnlockHeight = "unlock_height"
+
+	AttributeKeyParent      = "parent"
+	AttributeKeyPermissions = "permissions"
+
+	AttributeKeyOldAuth      = "old_auth"
+	AttributeKeyActiveHeight = "active_height"
+
+	AttributeKeyBuyer    = "buyer"
+	AttributeKeyReceiver = "receiver"
 )
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/supply/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SupplyKeeper defines the expected supply keeper for the module account holding the bids (noalias)
+type SupplyKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) exported.ModuleAccountI
+
+	ModuleCoinsToPower(ctx sdk.Context, recipientModule string, amt Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr AccountID, amt Coins) error
+}
+
+// DistributionKeeper defines the expected distribution keeper for the auction proceeds (noalias)
+type DistributionKeeper interface {
+	FundCommunityPoolFromModule(ctx sdk.Context, senderModule string, amount Coins) error
+}
@@ -2,23 +2,61 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/KuChainNetwork/kuchain/x/account/exported"
 )
 
 // GenesisState genesis state for account module
 type GenesisState struct {
-	Accounts exported.GenesisAccounts `json:"accounts"`
+	Accounts       exported.GenesisAccounts `json:"accounts"`
+	Deactivations  []Deactivation           `json:"deactivations,omitempty"`
+	AuctionParams  AuctionParams            `json:"auction_params"`
+	Auctions       []NameAuction            `json:"auctions,omitempty"`
+	AuctionBids    []NameAuctionBid         `json:"auction_bids,omitempty"`
+	RecoveryParams RecoveryParams           `json:"recovery_params"`
+	Guardians      []Guardians              `json:"guardians,omitempty"`
+	Recoveries     []Recovery               `json:"recoveries,omitempty"`
+	SubAccounts    []SubAccount             `json:"sub_accounts,omitempty"`
+	PendingAuths   []PendingAuth            `json:"pending_auths,omitempty"`
+	NameOffers     []NameOffer              `json:"name_offers,omitempty"`
 }
 
 func (g GenesisState) ValidateGenesis(bz json.RawMessage) error {
-	return nil
+	gs := DefaultGenesisState()
+	if err := ModuleCdc.UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+
+	if err := gs.AuctionParams.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.RecoveryParams.Validate(); err != nil {
+		return err
+	}
+
+	for _, g := range gs.Guardians {
+		if err := g.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range gs.SubAccounts {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return ValidateRecoveries(gs.Guardians, gs.Recoveries)
 }
 
 // DefaultGenesisState get default genesis state for account module
 func DefaultGenesisState() GenesisState {
 	res := GenesisState{
-		Accounts: exported.GenesisAccounts{},
+		Accounts:       exported.GenesisAccounts{},
+		AuctionParams:  DefaultAuctionParams(),
+		RecoveryParams: DefaultRecoveryParams(),
 	}
 
 	return res
@@ -27,6 +65,8 @@ func DefaultGenesisState() GenesisState {
 // NewGenesisState new genesis state by genesis accounts, for test
 func NewGenesisState(accs []exported.GenesisAccount) GenesisState {
 	return GenesisState{
-		Accounts: accs,
+		Accounts:       accs,
+		AuctionParams:  DefaultAuctionParams(),
+		RecoveryParams: DefaultRecoveryParams(),
 	}
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	"github.com/KuChainNetwork/kuchain/chain/types"
 )
 
@@ -25,8 +27,50 @@ var (
 	// Auth - Accounts store prefix
 	AuthAccountsStoreKeyPerfix = []byte{0x0C}
 
+	// DeactivationStoreKeyPrefix the deactivation records of accounts store prefix
+	DeactivationStoreKeyPrefix = []byte{0x0D}
+
+	// MemoKeyStoreKeyPrefix the memo keys of accounts store prefix
+	MemoKeyStoreKeyPrefix = []byte{0x0E}
+
+	// AuctionStoreKeyPrefix the auctions of premium names store prefix
+	AuctionStoreKeyPrefix = []byte{0x0F}
+
+	// AuctionBidStoreKeyPrefix the bids of sealed auctions store prefix
+	AuctionBidStoreKeyPrefix = []byte{0x10}
+
+	// AuctionQueueStoreKeyPrefix the auctions to settle by end height store prefix
+	AuctionQueueStoreKeyPrefix = []byte{0x11}
+
+	// GuardiansStoreKeyPrefix the guardians of accounts store prefix
+	GuardiansStoreKeyPrefix = []byte{0x12}
+
+	// RecoveryStoreKeyPrefix the pending recoveries of accounts store prefix
+	RecoveryStoreKeyPrefix = []byte{0x13}
+
+	// RecoveryQueueStoreKeyPrefix the recoveries to execute or drop by height store prefix
+	RecoveryQueueStoreKeyPrefix = []byte{0x14}
+
+	// SubAccountStoreKeyPrefix the sub-accounts with the permissions store prefix
+	SubAccountStoreKeyPrefix = []byte{0x15}
+
+	// PendingAuthStoreKeyPrefix the pending auth rotations of accounts store prefix
+	PendingAuthStoreKeyPrefix = []byte{0x16}
+
+	// PendingAuthQueueStoreKeyPrefix the pending auth rotations to activate by height store prefix
+	PendingAuthQueueStoreKeyPrefix = []byte{0x17}
+
+	// NameOfferStoreKeyPrefix the offers to buy the account names store prefix
+	NameOfferStoreKeyPrefix = []byte{0x18}
+
+	// CreatedHeightStoreKeyPrefix the heights the accounts created by msgs store prefix
+	CreatedHeightStoreKeyPrefix = []byte{0x19}
+
 	// GlobalAccountNumberKey param key for global account number
 	GlobalAccountNumberKey = types.MustName("g.account.number").Value
+
+	// ModuleAccountID is the account id for module account, which holds the bids of auctions and the name offers
+	ModuleAccountID = types.NewAccountIDFromName(types.MustName(ModuleName))
 )
 
 // AccountIDStoreKey turn an address to key used to get it from the account store
@@ -48,3 +92,99 @@ func AuthSeqStoreKey(addr types.AccAddress) []byte {
 func AuthAccountsStoreKey(auth types.AccAddress) []byte {
 	return append(AuthAccountsStoreKeyPerfix, auth.Bytes()...)
 }
+
+// DeactivationStoreKey the key of the deactivation record of the account
+func DeactivationStoreKey(name types.Name) []byte {
+	return append(DeactivationStoreKeyPrefix, name.Bytes()...)
+}
+
+// MemoKeyStoreKey the key of the memo key of the account
+func MemoKeyStoreKey(name types.Name) []byte {
+	return append(MemoKeyStoreKeyPrefix, name.Bytes()...)
+}
+
+// AuctionStoreKey the key of the auction of the name
+func AuctionStoreKey(name types.Name) []byte {
+	return append(AuctionStoreKeyPrefix, name.Bytes()...)
+}
+
+// AuctionBidsStorePrefix the prefix of the bids of the sealed auction of the name
+func AuctionBidsStorePrefix(name types.Name) []byte {
+	return append(AuctionBidStoreKeyPrefix, name.Bytes()...)
+}
+
+// AuctionBidStoreKey the key of the bid of the bidder in the sealed auction of the name
+func AuctionBidStoreKey(name types.Name, bidder types.AccountID) []byte {
+	return append(AuctionBidsStorePrefix(name), bidder.StoreKey()...)
+}
+
+// AuctionQueuePrefix the prefix of the auctions which will be settled at height
+func AuctionQueuePrefix(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(AuctionQueueStoreKeyPrefix, bz...)
+}
+
+// AuctionQueueStoreKey the key of the auction of the name in the settle queue
+func AuctionQueueStoreKey(height int64, name types.Name) []byte {
+	return append(AuctionQueuePrefix(height), name.Bytes()...)
+}
+
+// GuardiansStoreKey the key of the guardians of the account
+func GuardiansStoreKey(name types.Name) []byte {
+	return append(GuardiansStoreKeyPrefix, name.Bytes()...)
+}
+
+// RecoveryStoreKey the key of the pending recovery of the account
+func RecoveryStoreKey(name types.Name) []byte {
+	return append(RecoveryStoreKeyPrefix, name.Bytes()...)
+}
+
+// RecoveryQueuePrefix the prefix of the recoveries which will be processed at height
+func RecoveryQueuePrefix(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(RecoveryQueueStoreKeyPrefix, bz...)
+}
+
+// RecoveryQueueStoreKey the key of the recovery of the account in the queue
+func RecoveryQueueStoreKey(height int64, name types.Name) []byte {
+	return append(RecoveryQueuePrefix(height), name.Bytes()...)
+}
+
+// SubAccountStoreKey the key of the sub-account
+func SubAccountStoreKey(name types.Name) []byte {
+	return append(SubAccountStoreKeyPrefix, name.Bytes()...)
+}
+
+// PendingAuthStoreKey the key of the pending auth rotation of the account
+func PendingAuthStoreKey(name types.Name) []byte {
+	return append(PendingAuthStoreKeyPrefix, name.Bytes()...)
+}
+
+// PendingAuthQueuePrefix the prefix of the pending auth rotations which will be activated at height
+func PendingAuthQueuePrefix(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(PendingAuthQueueStoreKeyPrefix, bz...)
+}
+
+// PendingAuthQueueStoreKey the key of the pending auth rotation of the account in the queue
+func PendingAuthQueueStoreKey(height int64, name types.Name) []byte {
+	return append(PendingAuthQueuePrefix(height), name.Bytes()...)
+}
+
+// NameOffersStorePrefix the prefix of the offers for the account name
+func NameOffersStorePrefix(name types.Name) []byte {
+	return append(NameOfferStoreKeyPrefix, name.Bytes()...)
+}
+
+// NameOfferStoreKey the key of the offer of the buyer for the account name
+func NameOfferStoreKey(name types.Name, buyer types.AccountID) []byte {
+	return append(NameOffersStorePrefix(name), buyer.StoreKey()...)
+}
+
+// CreatedHeightStoreKey the key of the height the account created
+func CreatedHeightStoreKey(name types.Name) []byte {
+	return append(CreatedHeightStoreKeyPrefix, name.Bytes()...)
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+var _, _ types.MsgResponse = MsgCreateAccountResponse{}, MsgCreateAccountsResponse{}
+
+// MsgCreateAccountResponse is the response of the create account msg
+type MsgCreateAccountResponse struct {
+	Name Name `json:"name" yaml:"name"`
+}
+
+// CreatedID implements types.MsgResponse
+func (r MsgCreateAccountResponse) CreatedID() string {
+	return r.Name.String()
+}
+
+// MsgCreateAccountsResponse is the response of the create accounts in batch msg
+type MsgCreateAccountsResponse struct {
+	Names []Name `json:"names" yaml:"names"`
+}
+
+// CreatedID implements types.MsgResponse, the names of the accounts created split by ','
+func (r MsgCreateAccountsResponse) CreatedID() string {
+	names := make([]string, 0, len(r.Names))
+	for _, n := range r.Names {
+		names = append(names, n.String())
+	}
+
+	return strings.Join(names, ",")
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	"github.com/KuChainNetwork/kuchain/chain/msg"
 	"github.com/KuChainNetwork/kuchain/chain/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,6 +12,19 @@ import (
 const RouterKey = ModuleName
 
 var _, _ types.KuMsgData = (*MsgCreateAccountData)(nil), (*MsgUpdateAccountAuthData)(nil)
+var _, _ types.KuMsgData = (*MsgDeactivateAccountData)(nil), (*MsgReactivateAccountData)(nil)
+var _ types.KuMsgData = (*MsgSetMemoKeyData)(nil)
+var _, _, _ types.KuMsgData = (*MsgStartAuctionData)(nil), (*MsgAuctionBidData)(nil), (*MsgClaimAuctionData)(nil)
+var _ types.KuMsgData = (*MsgRevealAuctionBidData)(nil)
+var _, _ types.KuMsgData = (*MsgSetGuardiansData)(nil), (*MsgInitiateRecoveryData)(nil)
+var _, _ types.KuMsgData = (*MsgApproveRecoveryData)(nil), (*MsgCancelRecoveryData)(nil)
+var _, _ types.KuMsgData = (*MsgCreateSubAccountData)(nil), (*MsgSetSubAccountPermissionsData)(nil)
+var _ types.KuMsgData = (*MsgCancelAuthRotationData)(nil)
+var _ types.KuMsgData = (*MsgCreateAccountsData)(nil)
+var _, _, _ types.KuMsgData = (*MsgOfferAccountNameData)(nil), (*MsgCancelNameOfferData)(nil), (*MsgTransferAccountNameData)(nil)
+
+// MaxCreateAccountsNum the max number of the accounts created by a MsgCreateAccounts
+const MaxCreateAccountsNum = 100
 
 // MsgCreateAccountData the data struct of MsgCreateAccount
 type MsgCreateAccountData struct {
@@ -128,3 +143,1202 @@ func (msg MsgUpdateAccountAuth) ValidateBasic() error {
 
 	return nil
 }
+
+// MaxDeactivateReasonLen the max length of the reason to deactivate account
+const MaxDeactivateReasonLen = 256
+
+// MsgDeactivateAccountData the data struct of MsgDeactivateAccount
+type MsgDeactivateAccountData struct {
+	Name     types.Name      `json:"name" yaml:"name"`
+	Guardian types.AccountID `json:"guardian" yaml:"guardian"`
+	Reason   string          `json:"reason" yaml:"reason"`
+}
+
+func (MsgDeactivateAccountData) Type() types.Name { return types.MustName("deactivate") }
+
+func (msg MsgDeactivateAccountData) Sender() AccountID {
+	return NewAccountIDFromName(msg.Name)
+}
+
+// MsgDeactivateAccount deactivate account msg, the account cannot send txs until reactivated by the guardian
+type MsgDeactivateAccount struct {
+	types.KuMsg
+}
+
+// NewMsgDeactivateAccount create msg to deactivate account
+func NewMsgDeactivateAccount(auth types.AccAddress, name types.Name, guardian types.AccountID, reason string) MsgDeactivateAccount {
+	return MsgDeactivateAccount{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgDeactivateAccountData{
+				Name:     name,
+				Guardian: guardian,
+				Reason:   reason,
+			}),
+		),
+	}
+}
+
+func (msg MsgDeactivateAccount) GetData() (MsgDeactivateAccountData, error) {
+	res := MsgDeactivateAccountData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgDeactivateAccountData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgDeactivateAccount) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if data.Guardian.Empty() {
+		return sdkerrors.Wrap(ErrAccountGuardianInvalid, "guardian should not be empty")
+	}
+
+	if name, ok := data.Guardian.ToName(); ok && name.Eq(data.Name) {
+		return sdkerrors.Wrap(ErrAccountGuardianInvalid, "guardian should not be the account self")
+	}
+
+	if len(data.Reason) > MaxDeactivateReasonLen {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "reason too long, max %d", MaxDeactivateReasonLen)
+	}
+
+	return nil
+}
+
+// MsgReactivateAccountData the data struct of MsgReactivateAccount
+type MsgReactivateAccountData struct {
+	Guardian types.AccountID  `json:"guardian" yaml:"guardian"`
+	Name     types.Name       `json:"name" yaml:"name"`
+	Auth     types.AccAddress `json:"auth,omitempty" yaml:"auth"` // Auth the new auth of the account, empty to keep the old auth
+}
+
+func (MsgReactivateAccountData) Type() types.Name { return types.MustName("reactivate") }
+
+func (msg MsgReactivateAccountData) Sender() AccountID {
+	return msg.Guardian
+}
+
+// MsgReactivateAccount reactivate account msg by the guardian, can reset the auth if the old key compromised
+type MsgReactivateAccount struct {
+	types.KuMsg
+}
+
+// NewMsgReactivateAccount create msg to reactivate account
+func NewMsgReactivateAccount(auth types.AccAddress, guardian types.AccountID, name types.Name, accountAuth types.AccAddress) MsgReactivateAccount {
+	return MsgReactivateAccount{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgReactivateAccountData{
+				Guardian: guardian,
+				Name:     name,
+				Auth:     accountAuth,
+			}),
+		),
+	}
+}
+
+func (msg MsgReactivateAccount) GetData() (MsgReactivateAccountData, error) {
+	res := MsgReactivateAccountData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgReactivateAccountData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgReactivateAccount) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if data.Guardian.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	return nil
+}
+
+// MsgSetMemoKeyData the data struct of MsgSetMemoKey
+type MsgSetMemoKeyData struct {
+	Name    types.Name `json:"name" yaml:"name"`
+	MemoKey []byte     `json:"memo_key" yaml:"memo_key"`
+}
+
+func (MsgSetMemoKeyData) Type() types.Name { return types.MustName("setmemokey") }
+
+func (msg MsgSetMemoKeyData) Sender() AccountID {
+	return NewAccountIDFromName(msg.Name)
+}
+
+// MsgSetMemoKey set the X25519 public key of the account, which the memos to the account are encrypted to
+type MsgSetMemoKey struct {
+	types.KuMsg
+}
+
+// NewMsgSetMemoKey create msg to set the memo key of account
+func NewMsgSetMemoKey(auth types.AccAddress, name types.Name, memoKey []byte) MsgSetMemoKey {
+	return MsgSetMemoKey{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgSetMemoKeyData{
+				Name:    name,
+				MemoKey: memoKey,
+			}),
+		),
+	}
+}
+
+func (msg MsgSetMemoKey) GetData() (MsgSetMemoKeyData, error) {
+	res := MsgSetMemoKeyData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgSetMemoKeyData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgSetMemoKey) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if len(data.MemoKey) != types.MemoKeySize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "memo key size should be %d", types.MemoKeySize)
+	}
+
+	return nil
+}
+
+// MsgStartAuctionData the data struct of MsgStartAuction
+type MsgStartAuctionData struct {
+	Starter     types.AccountID `json:"starter" yaml:"starter"`
+	Name        types.Name      `json:"name" yaml:"name"`
+	AuctionType AuctionType     `json:"auction_type" yaml:"auction_type"`
+}
+
+func (MsgStartAuctionData) Type() types.Name { return types.MustName("startauction") }
+
+func (msg MsgStartAuctionData) Sender() AccountID {
+	return msg.Starter
+}
+
+// MsgStartAuction start the auction of a premium account name, the name is reserved until claimed by the winner
+type MsgStartAuction struct {
+	types.KuMsg
+}
+
+// NewMsgStartAuction create msg to start the auction of the name
+func NewMsgStartAuction(auth types.AccAddress, starter types.AccountID, name types.Name, auctionType AuctionType) MsgStartAuction {
+	return MsgStartAuction{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgStartAuctionData{
+				Starter:     starter,
+				Name:        name,
+				AuctionType: auctionType,
+			}),
+		),
+	}
+}
+
+func (msg MsgStartAuction) GetData() (MsgStartAuctionData, error) {
+	res := MsgStartAuctionData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgStartAuctionData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgStartAuction) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Starter.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if err := ValidateAuctionName(data.Name); err != nil {
+		return err
+	}
+
+	return data.AuctionType.Validate()
+}
+
+// MsgAuctionBidData the data struct of MsgAuctionBid, for the sealed auctions the amount is the deposit
+// of the bid committed by the commitment
+type MsgAuctionBidData struct {
+	Bidder     types.AccountID `json:"bidder" yaml:"bidder"`
+	Name       types.Name      `json:"name" yaml:"name"`
+	Amount     types.Coin      `json:"amount" yaml:"amount"`
+	Commitment []byte          `json:"commitment,omitempty" yaml:"commitment,omitempty"`
+}
+
+func (MsgAuctionBidData) Type() types.Name { return types.MustName("auctionbid") }
+
+func (msg MsgAuctionBidData) Sender() AccountID {
+	return msg.Bidder
+}
+
+// MsgAuctionBid bid in the auction of the name, the amount is transferred to the module account by the msg,
+// NOTE: it should not define GetData, which would hide the GetData of KuMsg and make the transfer skipped
+type MsgAuctionBid struct {
+	types.KuMsg
+}
+
+// NewMsgAuctionBid create msg to bid in the ascending auction of the name
+func NewMsgAuctionBid(auth types.AccAddress, bidder types.AccountID, name types.Name, amount types.Coin) MsgAuctionBid {
+	return NewMsgSealedAuctionBid(auth, bidder, name, amount, nil)
+}
+
+// NewMsgSealedAuctionBid create msg to bid in the sealed auction of the name by the commitment of the bid,
+// the deposit should not be less than the bid, see AuctionBidCommitment
+func NewMsgSealedAuctionBid(auth types.AccAddress, bidder types.AccountID, name types.Name, deposit types.Coin, commitment []byte) MsgAuctionBid {
+	return MsgAuctionBid{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithTransfer(bidder, ModuleAccountID, types.NewCoins(deposit)),
+			msg.WithData(Cdc(), &MsgAuctionBidData{
+				Bidder:     bidder,
+				Name:       name,
+				Amount:     deposit,
+				Commitment: commitment,
+			}),
+		),
+	}
+}
+
+func (msg MsgAuctionBid) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data := MsgAuctionBidData{}
+	if err := msg.UnmarshalData(Cdc(), &data); err != nil {
+		return sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+
+	if data.Bidder.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if !data.Amount.IsValid() || data.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid bid %s", data.Amount)
+	}
+
+	if len(data.Commitment) != 0 && len(data.Commitment) != sha256.Size {
+		return sdkerrors.Wrapf(ErrAuctionBidCommitmentInvalid, "commitment length %d", len(data.Commitment))
+	}
+
+	return nil
+}
+
+// MsgRevealAuctionBidData the data struct of MsgRevealAuctionBid
+type MsgRevealAuctionBidData struct {
+	Bidder types.AccountID `json:"bidder" yaml:"bidder"`
+	Name   types.Name      `json:"name" yaml:"name"`
+	Amount types.Coin      `json:"amount" yaml:"amount"`
+	Salt   string          `json:"salt" yaml:"salt"`
+}
+
+func (MsgRevealAuctionBidData) Type() types.Name { return types.MustName("revealbid") }
+
+func (msg MsgRevealAuctionBidData) Sender() AccountID {
+	return msg.Bidder
+}
+
+// MsgRevealAuctionBid reveal the bid committed in the sealed auction of the name, in the reveal period
+type MsgRevealAuctionBid struct {
+	types.KuMsg
+}
+
+// NewMsgRevealAuctionBid create msg to reveal the bid in the sealed auction of the name
+func NewMsgRevealAuctionBid(auth types.AccAddress, bidder types.AccountID, name types.Name, amount types.Coin, salt string) MsgRevealAuctionBid {
+	return MsgRevealAuctionBid{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgRevealAuctionBidData{
+				Bidder: bidder,
+				Name:   name,
+				Amount: amount,
+				Salt:   salt,
+			}),
+		),
+	}
+}
+
+func (msg MsgRevealAuctionBid) GetData() (MsgRevealAuctionBidData, error) {
+	res := MsgRevealAuctionBidData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgRevealAuctionBidData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgRevealAuctionBid) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Bidder.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if !data.Amount.IsValid() || data.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid bid %s", data.Amount)
+	}
+
+	return nil
+}
+
+// MsgClaimAuctionData the data struct of MsgClaimAuction
+type MsgClaimAuctionData struct {
+	Winner types.AccountID  `json:"winner" yaml:"winner"`
+	Name   types.Name       `json:"name" yaml:"name"`
+	Auth   types.AccAddress `json:"auth" yaml:"auth"`
+}
+
+func (MsgClaimAuctionData) Type() types.Name { return types.MustName("claimauction") }
+
+func (msg MsgClaimAuctionData) Sender() AccountID {
+	return msg.Winner
+}
+
+// MsgClaimAuction claim the name won in the auction, the account of the name is created with the auth
+type MsgClaimAuction struct {
+	types.KuMsg
+}
+
+// NewMsgClaimAuction create msg to claim the name won in the auction
+func NewMsgClaimAuction(auth types.AccAddress, winner types.AccountID, name types.Name, accountAuth types.AccAddress) MsgClaimAuction {
+	return MsgClaimAuction{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgClaimAuctionData{
+				Winner: winner,
+				Name:   name,
+				Auth:   accountAuth,
+			}),
+		),
+	}
+}
+
+func (msg MsgClaimAuction) GetData() (MsgClaimAuctionData, error) {
+	res := MsgClaimAuctionData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgClaimAuctionData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgClaimAuction) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Winner.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if data.Auth.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "auth should not be empty")
+	}
+
+	return nil
+}
+
+// MsgSetGuardiansData the data struct of MsgSetGuardians
+type MsgSetGuardiansData struct {
+	Name      types.Name        `json:"name" yaml:"name"`
+	Guardians []types.AccountID `json:"guardians" yaml:"guardians"`
+	Threshold uint32            `json:"threshold" yaml:"threshold"`
+}
+
+func (MsgSetGuardiansData) Type() types.Name { return types.MustName("setguardians") }
+
+func (msg MsgSetGuardiansData) Sender() AccountID {
+	return NewAccountIDFromName(msg.Name)
+}
+
+// MsgSetGuardians set the guardians of the account, the threshold of them can rotate the auth of the account,
+// empty guardians with zero threshold to remove the guardians
+type MsgSetGuardians struct {
+	types.KuMsg
+}
+
+// NewMsgSetGuardians create msg to set the guardians of the account
+func NewMsgSetGuardians(auth types.AccAddress, name types.Name, guardians []types.AccountID, threshold uint32) MsgSetGuardians {
+	return MsgSetGuardians{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgSetGuardiansData{
+				Name:      name,
+				Guardians: guardians,
+				Threshold: threshold,
+			}),
+		),
+	}
+}
+
+func (msg MsgSetGuardians) GetData() (MsgSetGuardiansData, error) {
+	res := MsgSetGuardiansData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgSetGuardiansData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgSetGuardians) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	// remove the guardians
+	if len(data.Guardians) == 0 && data.Threshold == 0 {
+		return nil
+	}
+
+	return NewGuardians(data.Name, data.Guardians, data.Threshold).Validate()
+}
+
+// MsgInitiateRecoveryData the data struct of MsgInitiateRecovery
+type MsgInitiateRecoveryData struct {
+	Guardian types.AccountID  `json:"guardian" yaml:"guardian"`
+	Name     types.Name       `json:"name" yaml:"name"`
+	Auth     types.AccAddress `json:"auth" yaml:"auth"`
+}
+
+func (MsgInitiateRecoveryData) Type() types.Name { return types.MustName("initrecovery") }
+
+func (msg MsgInitiateRecoveryData) Sender() AccountID {
+	return msg.Guardian
+}
+
+// MsgInitiateRecovery initiate the recovery of the account to the new auth by a guardian, as the first approval
+type MsgInitiateRecovery struct {
+	types.KuMsg
+}
+
+// NewMsgInitiateRecovery create msg to initiate the recovery of the account
+func NewMsgInitiateRecovery(auth types.AccAddress, guardian types.AccountID, name types.Name, accountAuth types.AccAddress) MsgInitiateRecovery {
+	return MsgInitiateRecovery{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgInitiateRecoveryData{
+				Guardian: guardian,
+				Name:     name,
+				Auth:     accountAuth,
+			}),
+		),
+	}
+}
+
+func (msg MsgInitiateRecovery) GetData() (MsgInitiateRecoveryData, error) {
+	res := MsgInitiateRecoveryData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgInitiateRecoveryData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgInitiateRecovery) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Guardian.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if data.Auth.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "auth should not be empty")
+	}
+
+	return nil
+}
+
+// MsgApproveRecoveryData the data struct of MsgApproveRecovery
+type MsgApproveRecoveryData struct {
+	Guardian types.AccountID  `json:"guardian" yaml:"guardian"`
+	Name     types.Name       `json:"name" yaml:"name"`
+	Auth     types.AccAddress `json:"auth" yaml:"auth"` // Auth should be the new auth of the pending recovery
+}
+
+func (MsgApproveRecoveryData) Type() types.Name { return types.MustName("approverecovery") }
+
+func (msg MsgApproveRecoveryData) Sender() AccountID {
+	return msg.Guardian
+}
+
+// MsgApproveRecovery approve the pending recovery of the account by a guardian
+type MsgApproveRecovery struct {
+	types.KuMsg
+}
+
+// NewMsgApproveRecovery create msg to approve the pending recovery of the account
+func NewMsgApproveRecovery(auth types.AccAddress, guardian types.AccountID, name types.Name, accountAuth types.AccAddress) MsgApproveRecovery {
+	return MsgApproveRecovery{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgApproveRecoveryData{
+				Guardian: guardian,
+				Name:     name,
+				Auth:     accountAuth,
+			}),
+		),
+	}
+}
+
+func (msg MsgApproveRecovery) GetData() (MsgApproveRecoveryData, error) {
+	res := MsgApproveRecoveryData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgApproveRecoveryData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgApproveRecovery) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Guardian.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if data.Auth.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "auth should not be empty")
+	}
+
+	return nil
+}
+
+// MsgCancelRecoveryData the data struct of MsgCancelRecovery
+type MsgCancelRecoveryData struct {
+	Name types.Name `json:"name" yaml:"name"`
+}
+
+func (MsgCancelRecoveryData) Type() types.Name { return types.MustName("cancelrecovery") }
+
+func (msg MsgCancelRecoveryData) Sender() AccountID {
+	return NewAccountIDFromName(msg.Name)
+}
+
+// MsgCancelRecovery cancel the pending recovery of the account by the account self before the time lock ends
+type MsgCancelRecovery struct {
+	types.KuMsg
+}
+
+// NewMsgCancelRecovery create msg to cancel the pending recovery of the account
+func NewMsgCancelRecovery(auth types.AccAddress, name types.Name) MsgCancelRecovery {
+	return MsgCancelRecovery{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgCancelRecoveryData{
+				Name: name,
+			}),
+		),
+	}
+}
+
+func (msg MsgCancelRecovery) GetData() (MsgCancelRecoveryData, error) {
+	res := MsgCancelRecoveryData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgCancelRecoveryData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgCancelRecovery) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	return nil
+}
+
+// MsgCreateSubAccountData the data struct of MsgCreateSubAccount
+type MsgCreateSubAccountData struct {
+	Parent      types.Name       `json:"parent" yaml:"parent"`
+	Name        types.Name       `json:"name" yaml:"name"`
+	Auth        types.AccAddress `json:"auth" yaml:"auth"`
+	Permissions []string         `json:"permissions" yaml:"permissions"`
+}
+
+func (MsgCreateSubAccountData) Type() types.Name { return types.MustName("create@subacc") }
+
+func (msg MsgCreateSubAccountData) Sender() AccountID {
+	return NewAccountIDFromName(msg.Parent)
+}
+
+// MsgCreateSubAccount create a sub-account such as `corp.treasury` by the parent account `corp`,
+// the auth of the sub-account can only sign the msgs in the permissions
+type MsgCreateSubAccount struct {
+	types.KuMsg
+}
+
+// NewMsgCreateSubAccount create msg to create the sub-account by the parent
+func NewMsgCreateSubAccount(auth types.AccAddress, parent, name types.Name, accountAuth types.AccAddress, permissions []string) MsgCreateSubAccount {
+	return MsgCreateSubAccount{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgCreateSubAccountData{
+				Parent:      parent,
+				Name:        name,
+				Auth:        accountAuth,
+				Permissions: permissions,
+			}),
+		),
+	}
+}
+
+func (msg MsgCreateSubAccount) GetData() (MsgCreateSubAccountData, error) {
+	res := MsgCreateSubAccountData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgCreateSubAccountData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgCreateSubAccount) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Parent.Empty() || data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if data.Auth.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "auth should not be empty")
+	}
+
+	return NewSubAccount(data.Name, data.Parent, data.Permissions).Validate()
+}
+
+// MsgSetSubAccountPermissionsData the data struct of MsgSetSubAccountPermissions
+type MsgSetSubAccountPermissionsData struct {
+	Name        types.Name `json:"name" yaml:"name"`
+	Permissions []string   `json:"permissions" yaml:"permissions"`
+}
+
+func (MsgSetSubAccountPermissionsData) Type() types.Name { return types.MustName("setsubperms") }
+
+func (msg MsgSetSubAccountPermissionsData) Sender() AccountID {
+	if parent, ok := SubAccountParent(msg.Name); ok {
+		return NewAccountIDFromName(parent)
+	}
+	return types.EmptyAccountID()
+}
+
+// MsgSetSubAccountPermissions set the permissions of the sub-account by the parent account
+type MsgSetSubAccountPermissions struct {
+	types.KuMsg
+}
+
+// NewMsgSetSubAccountPermissions create msg to set the permissions of the sub-account
+func NewMsgSetSubAccountPermissions(auth types.AccAddress, name types.Name, permissions []string) MsgSetSubAccountPermissions {
+	return MsgSetSubAccountPermissions{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgSetSubAccountPermissionsData{
+				Name:        name,
+				Permissions: permissions,
+			}),
+		),
+	}
+}
+
+func (msg MsgSetSubAccountPermissions) GetData() (MsgSetSubAccountPermissionsData, error) {
+	res := MsgSetSubAccountPermissionsData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgSetSubAccountPermissionsData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgSetSubAccountPermissions) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if _, ok := SubAccountParent(data.Name); !ok {
+		return sdkerrors.Wrapf(ErrSubAccountNameInvalid, "%s is not a sub-account name", data.Name)
+	}
+
+	return ValidateSubAccountPermissions(data.Permissions)
+}
+
+// MsgCancelAuthRotationData the data struct of MsgCancelAuthRotation
+type MsgCancelAuthRotationData struct {
+	Name types.Name `json:"name" yaml:"name"`
+}
+
+func (MsgCancelAuthRotationData) Type() types.Name { return types.MustName("cancelrotation") }
+
+func (msg MsgCancelAuthRotationData) Sender() AccountID {
+	return NewAccountIDFromName(msg.Name)
+}
+
+// MsgCancelAuthRotation cancel the pending auth rotation of the account by the old auth before the new auth active
+type MsgCancelAuthRotation struct {
+	types.KuMsg
+}
+
+// NewMsgCancelAuthRotation create msg to cancel the pending auth rotation of the account
+func NewMsgCancelAuthRotation(auth types.AccAddress, name types.Name) MsgCancelAuthRotation {
+	return MsgCancelAuthRotation{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgCancelAuthRotationData{
+				Name: name,
+			}),
+		),
+	}
+}
+
+func (msg MsgCancelAuthRotation) GetData() (MsgCancelAuthRotationData, error) {
+	res := MsgCancelAuthRotationData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgCancelAuthRotationData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgCancelAuthRotation) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	return nil
+}
+
+// CreateAccountParam the account to create in a MsgCreateAccounts, with the initial coins from the creator
+type CreateAccountParam struct {
+	Name  types.Name       `json:"name" yaml:"name"`
+	Auth  types.AccAddress `json:"auth" yaml:"auth"`
+	Coins Coins            `json:"coins" yaml:"coins"`
+}
+
+// NewCreateAccountParam creates a account to create in a MsgCreateAccounts
+func NewCreateAccountParam(name types.Name, auth types.AccAddress, coins Coins) CreateAccountParam {
+	return CreateAccountParam{
+		Name:  name,
+		Auth:  auth,
+		Coins: coins,
+	}
+}
+
+// MsgCreateAccountsData the data struct of MsgCreateAccounts
+type MsgCreateAccountsData struct {
+	Creator  types.AccountID      `json:"creator" yaml:"creator"`
+	Accounts []CreateAccountParam `json:"accounts" yaml:"accounts"`
+}
+
+func (MsgCreateAccountsData) Type() types.Name { return types.MustName("create@batch") }
+
+func (msg MsgCreateAccountsData) Sender() AccountID {
+	return msg.Creator
+}
+
+// MsgCreateAccounts create the accounts in batch with the initial coins from the creator,
+// all the accounts are created or none of them if any failed.
+type MsgCreateAccounts struct {
+	types.KuMsg
+}
+
+// NewMsgCreateAccounts create msg to create the accounts in batch
+func NewMsgCreateAccounts(auth types.AccAddress, creator types.AccountID, accounts []CreateAccountParam) MsgCreateAccounts {
+	return MsgCreateAccounts{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgCreateAccountsData{
+				Creator:  creator,
+				Accounts: accounts,
+			}),
+		),
+	}
+}
+
+func (msg MsgCreateAccounts) GetData() (MsgCreateAccountsData, error) {
+	res := MsgCreateAccountsData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgCreateAccountsData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgCreateAccounts) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Creator.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if len(data.Accounts) == 0 || len(data.Accounts) > MaxCreateAccountsNum {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "accounts number should be in [1, %d]", MaxCreateAccountsNum)
+	}
+
+	for i, a := range data.Accounts {
+		if a.Name.Empty() {
+			return types.ErrNameNilString
+		}
+
+		if a.Auth.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "auth should not be empty")
+		}
+
+		if !a.Coins.IsValid() && !a.Coins.Empty() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "coins %s of %s", a.Coins, a.Name)
+		}
+
+		for _, other := range data.Accounts[:i] {
+			if other.Name.Eq(a.Name) {
+				return sdkerrors.Wrapf(ErrAccountHasCreated, "duplicate name %s", a.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// MsgOfferAccountNameData the data struct of MsgOfferAccountName
+type MsgOfferAccountNameData struct {
+	Buyer types.AccountID  `json:"buyer" yaml:"buyer"`
+	Name  types.Name       `json:"name" yaml:"name"`
+	Auth  types.AccAddress `json:"auth" yaml:"auth"`
+	Price types.Coins      `json:"price" yaml:"price"`
+}
+
+func (MsgOfferAccountNameData) Type() types.Name { return types.MustName("offername") }
+
+func (msg MsgOfferAccountNameData) Sender() AccountID {
+	return msg.Buyer
+}
+
+// MsgOfferAccountName offer to buy the account name, the price is transferred to the module account by the msg,
+// the account is transferred to the auth when the owner accepts the offer by MsgTransferAccountName.
+// NOTE: it should not define GetData, which would hide the GetData of KuMsg and make the transfer skipped
+type MsgOfferAccountName struct {
+	types.KuMsg
+}
+
+// NewMsgOfferAccountName create msg to offer to buy the account name
+func NewMsgOfferAccountName(auth types.AccAddress, buyer types.AccountID, name types.Name, accountAuth types.AccAddress, price types.Coins) MsgOfferAccountName {
+	return MsgOfferAccountName{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithTransfer(buyer, ModuleAccountID, price),
+			msg.WithData(Cdc(), &MsgOfferAccountNameData{
+				Buyer: buyer,
+				Name:  name,
+				Auth:  accountAuth,
+				Price: price,
+			}),
+		),
+	}
+}
+
+func (msg MsgOfferAccountName) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data := MsgOfferAccountNameData{}
+	if err := msg.UnmarshalData(Cdc(), &data); err != nil {
+		return sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+
+	if data.Buyer.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if data.Buyer.Eq(NewAccountIDFromName(data.Name)) {
+		return sdkerrors.Wrapf(ErrNameTransferNotAllowed, "%s cannot buy itself", data.Name)
+	}
+
+	if data.Auth.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "auth should not be empty")
+	}
+
+	if !data.Price.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid price %s", data.Price)
+	}
+
+	return nil
+}
+
+// MsgCancelNameOfferData the data struct of MsgCancelNameOffer
+type MsgCancelNameOfferData struct {
+	Buyer types.AccountID `json:"buyer" yaml:"buyer"`
+	Name  types.Name      `json:"name" yaml:"name"`
+}
+
+func (MsgCancelNameOfferData) Type() types.Name { return types.MustName("cancelnameoffer") }
+
+func (msg MsgCancelNameOfferData) Sender() AccountID {
+	return msg.Buyer
+}
+
+// MsgCancelNameOffer cancel the offer for the account name by the buyer, the price is refunded
+type MsgCancelNameOffer struct {
+	types.KuMsg
+}
+
+// NewMsgCancelNameOffer create msg to cancel the offer for the account name
+func NewMsgCancelNameOffer(auth types.AccAddress, buyer types.AccountID, name types.Name) MsgCancelNameOffer {
+	return MsgCancelNameOffer{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgCancelNameOfferData{
+				Buyer: buyer,
+				Name:  name,
+			}),
+		),
+	}
+}
+
+func (msg MsgCancelNameOffer) GetData() (MsgCancelNameOfferData, error) {
+	res := MsgCancelNameOfferData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgCancelNameOfferData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgCancelNameOffer) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Buyer.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	return nil
+}
+
+// MsgTransferAccountNameData the data struct of MsgTransferAccountName
+type MsgTransferAccountNameData struct {
+	Name     types.Name      `json:"name" yaml:"name"`
+	Buyer    types.AccountID `json:"buyer" yaml:"buyer"`
+	Receiver types.AccountID `json:"receiver" yaml:"receiver"`
+	Price    types.Coins     `json:"price" yaml:"price"` // Price the price of the offer expected by the owner
+}
+
+func (MsgTransferAccountNameData) Type() types.Name { return types.MustName("transfername") }
+
+func (msg MsgTransferAccountNameData) Sender() AccountID {
+	return NewAccountIDFromName(msg.Name)
+}
+
+// MsgTransferAccountName transfer the account name to the auth of the offer of the buyer by the owner,
+// the price held by the module account is paid to the receiver in the same msg.
+type MsgTransferAccountName struct {
+	types.KuMsg
+}
+
+// NewMsgTransferAccountName create msg to transfer the account name to the buyer for the price of the offer,
+// the msg fails if the price of the offer is not the price expected.
+func NewMsgTransferAccountName(auth types.AccAddress, name types.Name, buyer, receiver types.AccountID, price types.Coins) MsgTransferAccountName {
+	return MsgTransferAccountName{
+		*msg.MustNewKuMsg(
+			types.MustName(RouterKey),
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgTransferAccountNameData{
+				Name:     name,
+				Buyer:    buyer,
+				Receiver: receiver,
+				Price:    price,
+			}),
+		),
+	}
+}
+
+func (msg MsgTransferAccountName) GetData() (MsgTransferAccountNameData, error) {
+	res := MsgTransferAccountNameData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgTransferAccountNameData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgTransferAccountName) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Name.Empty() {
+		return types.ErrNameNilString
+	}
+
+	if data.Buyer.Empty() || data.Receiver.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	// the account is owned by the buyer after transferred
+	if data.Receiver.Eq(NewAccountIDFromName(data.Name)) {
+		return sdkerrors.Wrapf(ErrNameTransferNotAllowed, "receiver should not be the transferred account %s", data.Name)
+	}
+
+	if !data.Price.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid price %s", data.Price)
+	}
+
+	return nil
+}
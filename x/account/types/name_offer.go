@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"gopkg.in/yaml.v2"
+)
+
+// NameOffer the offer of the buyer to buy the account name, the price is held by the module account
+// until the owner transfers the name to the buyer or the buyer cancels the offer.
+type NameOffer struct {
+	Name   types.Name       `json:"name" yaml:"name"`
+	Buyer  types.AccountID  `json:"buyer" yaml:"buyer"`
+	Auth   types.AccAddress `json:"auth" yaml:"auth"` // the auth of the account after transferred
+	Price  types.Coins      `json:"price" yaml:"price"`
+	Height int64            `json:"height" yaml:"height"`
+}
+
+// NewNameOffer creates a new offer for the account name
+func NewNameOffer(name types.Name, buyer types.AccountID, auth types.AccAddress, price types.Coins, height int64) NameOffer {
+	return NameOffer{
+		Name:   name,
+		Buyer:  buyer,
+		Auth:   auth,
+		Price:  price,
+		Height: height,
+	}
+}
+
+func (o NameOffer) String() string {
+	out, _ := yaml.Marshal(o)
+	return string(out)
+}
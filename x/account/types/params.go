@@ -1,6 +1,259 @@
 package types
 
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	params "github.com/KuChainNetwork/kuchain/x/params/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
 const (
 	// DefaultParamspace for params keeper
 	DefaultParamspace = ModuleName
+
+	// RecoveryParamspace the params subspace of the account recoveries by guardians
+	RecoveryParamspace = ModuleName + "recovery"
+
+	// DefaultAuctionDuration the default blocks of a name auction, about 7 days
+	DefaultAuctionDuration = int64(100800)
+
+	// DefaultAuctionRevealDuration the default blocks to reveal the bids after a sealed auction ended, about 2 days
+	DefaultAuctionRevealDuration = int64(28800)
+
+	// DefaultRecoveryTimeLock the default blocks from the approval of a recovery to the auth rotation, about 2 days
+	DefaultRecoveryTimeLock = int64(28800)
+
+	// DefaultRecoveryExpiry the default blocks a recovery can wait for the approvals, about 7 days
+	DefaultRecoveryExpiry = int64(100800)
+
+	// DefaultAuthRotationDelay the default blocks from an auth update to the new auth active, zero for at once
+	DefaultAuthRotationDelay = int64(0)
+)
+
+var (
+	DefaultAuctionMinBid       = types.NewCoin(constants.DefaultBondDenom, sdk.TokensFromConsensusPower(100))
+	DefaultAuctionMinIncrement = sdk.NewDecWithPrec(5, 2)
+)
+
+// Parameter store keys
+var (
+	KeyAuctionDuration       = []byte("AuctionDuration")
+	KeyAuctionRevealDuration = []byte("AuctionRevealDuration")
+	KeyAuctionMinBid         = []byte("AuctionMinBid")
+	KeyAuctionMinIncrement   = []byte("AuctionMinIncrement")
+	KeyRecoveryTimeLock      = []byte("RecoveryTimeLock")
+	KeyRecoveryExpiry        = []byte("RecoveryExpiry")
+	KeyAuthRotationDelay     = []byte("AuthRotationDelay")
 )
+
+// AuctionParams the params of the premium account name auctions
+type AuctionParams struct {
+	Duration       int64      `json:"duration" yaml:"duration"`               // blocks from the start of an auction to the end of the bids
+	RevealDuration int64      `json:"reveal_duration" yaml:"reveal_duration"` // blocks from the end of a sealed auction to the settlement, to reveal the bids
+	MinBid         types.Coin `json:"min_bid" yaml:"min_bid"`                 // the minimum bid of an auction, the denom of all the bids
+	MinIncrement   sdk.Dec    `json:"min_increment" yaml:"min_increment"`     // the minimum increment ratio of a bid to the highest bid in ascending auctions
+}
+
+// ParamKeyTable ParamTable for account module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&AuctionParams{})
+}
+
+// NewAuctionParams creates a new AuctionParams object
+func NewAuctionParams(duration, revealDuration int64, minBid types.Coin, minIncrement sdk.Dec) AuctionParams {
+	return AuctionParams{
+		Duration:       duration,
+		RevealDuration: revealDuration,
+		MinBid:         minBid,
+		MinIncrement:   minIncrement,
+	}
+}
+
+// DefaultAuctionParams default auction parameters
+func DefaultAuctionParams() AuctionParams {
+	return NewAuctionParams(DefaultAuctionDuration, DefaultAuctionRevealDuration, DefaultAuctionMinBid, DefaultAuctionMinIncrement)
+}
+
+// Validate validate params
+func (p AuctionParams) Validate() error {
+	if err := validateAuctionDuration(p.Duration); err != nil {
+		return err
+	}
+	if err := validateAuctionRevealDuration(p.RevealDuration); err != nil {
+		return err
+	}
+	if err := validateAuctionMinBid(p.MinBid); err != nil {
+		return err
+	}
+	if err := validateAuctionMinIncrement(p.MinIncrement); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p AuctionParams) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *AuctionParams) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyAuctionDuration, &p.Duration, validateAuctionDuration),
+		params.NewParamSetPair(KeyAuctionRevealDuration, &p.RevealDuration, validateAuctionRevealDuration),
+		params.NewParamSetPair(KeyAuctionMinBid, &p.MinBid, validateAuctionMinBid),
+		params.NewParamSetPair(KeyAuctionMinIncrement, &p.MinIncrement, validateAuctionMinIncrement),
+	}
+}
+
+func validateAuctionDuration(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("auction duration must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateAuctionRevealDuration(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("auction reveal duration must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateAuctionMinBid(i interface{}) error {
+	v, ok := i.(types.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() || v.IsZero() {
+		return fmt.Errorf("invalid auction min bid: %s", v)
+	}
+
+	return nil
+}
+
+func validateAuctionMinIncrement(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("auction min increment cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+// RecoveryParams the params of the account recoveries by guardians and the auth rotations by the accounts
+type RecoveryParams struct {
+	TimeLock          int64 `json:"time_lock" yaml:"time_lock"`                     // blocks from the approval of a recovery to the auth rotation
+	Expiry            int64 `json:"expiry" yaml:"expiry"`                           // blocks from the start of a recovery to drop it if not approved
+	AuthRotationDelay int64 `json:"auth_rotation_delay" yaml:"auth_rotation_delay"` // blocks from an auth update to the new auth active
+}
+
+// RecoveryParamKeyTable ParamTable for the account recoveries.
+func RecoveryParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&RecoveryParams{})
+}
+
+// NewRecoveryParams creates a new RecoveryParams object
+func NewRecoveryParams(timeLock, expiry, authRotationDelay int64) RecoveryParams {
+	return RecoveryParams{
+		TimeLock:          timeLock,
+		Expiry:            expiry,
+		AuthRotationDelay: authRotationDelay,
+	}
+}
+
+// DefaultRecoveryParams default recovery parameters
+func DefaultRecoveryParams() RecoveryParams {
+	return NewRecoveryParams(DefaultRecoveryTimeLock, DefaultRecoveryExpiry, DefaultAuthRotationDelay)
+}
+
+// Validate validate params
+func (p RecoveryParams) Validate() error {
+	if err := validateRecoveryTimeLock(p.TimeLock); err != nil {
+		return err
+	}
+	if err := validateRecoveryExpiry(p.Expiry); err != nil {
+		return err
+	}
+	if err := validateAuthRotationDelay(p.AuthRotationDelay); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p RecoveryParams) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *RecoveryParams) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyRecoveryTimeLock, &p.TimeLock, validateRecoveryTimeLock),
+		params.NewParamSetPair(KeyRecoveryExpiry, &p.Expiry, validateRecoveryExpiry),
+		params.NewParamSetPair(KeyAuthRotationDelay, &p.AuthRotationDelay, validateAuthRotationDelay),
+	}
+}
+
+func validateRecoveryTimeLock(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("recovery time lock must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRecoveryExpiry(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("recovery expiry must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateAuthRotationDelay(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("auth rotation delay cannot be negative: %d", v)
+	}
+
+	return nil
+}
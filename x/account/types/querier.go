@@ -9,9 +9,25 @@ const (
 	QueryAccount        = "account"
 	QueryAuthByAddress  = "authByAddress"
 	QueryAccountsByAuth = "accountsByAuth"
+	QueryAccountsAuth   = "accountsAuth"
 	QueryParams         = "params"
+	QueryDeactivation   = "deactivation"
+	QueryMemoKey        = "memoKey"
+	QueryAuction        = "auction"
+	QueryAuctions       = "auctions"
+	QueryAuctionBids    = "auctionBids"
+	QueryAuctionParams  = "auctionParams"
+	QueryGuardians      = "guardians"
+	QueryRecovery       = "recovery"
+	QuerySubAccount     = "subAccount"
+	QuerySubAccounts    = "subAccounts"
+	QueryPendingAuth    = "pendingAuth"
+	QueryNameOffers     = "nameOffers"
 )
 
+// MaxQueryAccountsAuthNum the max number of accounts in a query accounts auth
+const MaxQueryAccountsAuthNum = 1000
+
 // QueryAccountParams defines the params for querying accounts.
 type QueryAccountParams struct {
 	Id chainTypes.AccountID
@@ -51,3 +67,109 @@ type QueryAccountsByAuthParams struct {
 func NewQueryAccountsByAuthParams(auth string) QueryAccountsByAuthParams {
 	return QueryAccountsByAuthParams{Auth: chainTypes.MustAccAddressFromBech32(auth)}
 }
+
+// NewQueryAccountsByAddressParams creates a new instance of QueryAccountsByAuthParams by the address of the auth.
+func NewQueryAccountsByAddressParams(address chainTypes.AccAddress) QueryAccountsByAuthParams {
+	return QueryAccountsByAuthParams{Auth: address}
+}
+
+// QueryAccountsAuthParams defines the params for querying auths of accounts.
+type QueryAccountsAuthParams struct {
+	Ids []chainTypes.AccountID
+}
+
+// NewQueryAccountsAuthParams creates a new instance of QueryAccountsAuthParams.
+func NewQueryAccountsAuthParams(ids ...chainTypes.AccountID) QueryAccountsAuthParams {
+	return QueryAccountsAuthParams{Ids: ids}
+}
+
+// AccountAuthData the auth data of a account id, for querying auths of accounts.
+type AccountAuthData struct {
+	Id   chainTypes.AccountID `json:"id" yaml:"id"`
+	Auth Auth                 `json:"auth" yaml:"auth"`
+}
+
+// NewAccountAuthData creates a new instance of AccountAuthData.
+func NewAccountAuthData(id chainTypes.AccountID, auth Auth) AccountAuthData {
+	return AccountAuthData{Id: id, Auth: auth}
+}
+
+// QueryDeactivationParams defines the params for querying the deactivation of account.
+type QueryDeactivationParams struct {
+	Name chainTypes.Name
+}
+
+// NewQueryDeactivationParams creates a new instance of QueryDeactivationParams.
+func NewQueryDeactivationParams(name chainTypes.Name) QueryDeactivationParams {
+	return QueryDeactivationParams{Name: name}
+}
+
+// QueryMemoKeyParams defines the params for querying the memo key of account.
+type QueryMemoKeyParams struct {
+	Name chainTypes.Name
+}
+
+// NewQueryMemoKeyParams creates a new instance of QueryMemoKeyParams.
+func NewQueryMemoKeyParams(name chainTypes.Name) QueryMemoKeyParams {
+	return QueryMemoKeyParams{Name: name}
+}
+
+// QueryNameAuctionParams defines the params for querying the auction of the name, and the bids of the auction.
+type QueryNameAuctionParams struct {
+	Name chainTypes.Name
+}
+
+// NewQueryNameAuctionParams creates a new instance of QueryNameAuctionParams.
+func NewQueryNameAuctionParams(name chainTypes.Name) QueryNameAuctionParams {
+	return QueryNameAuctionParams{Name: name}
+}
+
+// QueryGuardiansParams defines the params for querying the guardians of account.
+type QueryGuardiansParams struct {
+	Name chainTypes.Name
+}
+
+// NewQueryGuardiansParams creates a new instance of QueryGuardiansParams.
+func NewQueryGuardiansParams(name chainTypes.Name) QueryGuardiansParams {
+	return QueryGuardiansParams{Name: name}
+}
+
+// QueryAccountRecoveryParams defines the params for querying the pending recovery of account.
+type QueryAccountRecoveryParams struct {
+	Name chainTypes.Name
+}
+
+// NewQueryAccountRecoveryParams creates a new instance of QueryAccountRecoveryParams.
+func NewQueryAccountRecoveryParams(name chainTypes.Name) QueryAccountRecoveryParams {
+	return QueryAccountRecoveryParams{Name: name}
+}
+
+// QuerySubAccountParams defines the params for querying the sub-account, or the sub-accounts of the parent.
+type QuerySubAccountParams struct {
+	Name chainTypes.Name
+}
+
+// NewQuerySubAccountParams creates a new instance of QuerySubAccountParams.
+func NewQuerySubAccountParams(name chainTypes.Name) QuerySubAccountParams {
+	return QuerySubAccountParams{Name: name}
+}
+
+// QueryPendingAuthParams defines the params for querying the pending auth rotation of account.
+type QueryPendingAuthParams struct {
+	Name chainTypes.Name
+}
+
+// NewQueryPendingAuthParams creates a new instance of QueryPendingAuthParams.
+func NewQueryPendingAuthParams(name chainTypes.Name) QueryPendingAuthParams {
+	return QueryPendingAuthParams{Name: name}
+}
+
+// QueryNameOffersParams defines the params for querying the offers for the account name, all the offers if no name.
+type QueryNameOffersParams struct {
+	Name chainTypes.Name
+}
+
+// NewQueryNameOffersParams creates a new instance of QueryNameOffersParams.
+func NewQueryNameOffersParams(name chainTypes.Name) QueryNameOffersParams {
+	return QueryNameOffersParams{Name: name}
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"context"
+
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// The messages of the gRPC query service defined in query.proto, encoded by the protobuf struct tags,
+// keep the tags in sync with query.proto when the messages changed.
+
+type QueryAccountsByAddressRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountsByAddressRequest) Reset()         { *m = QueryAccountsByAddressRequest{} }
+func (m *QueryAccountsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByAddressRequest) ProtoMessage()    {}
+
+type QueryAccountsByAddressResponse struct {
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *QueryAccountsByAddressResponse) Reset()         { *m = QueryAccountsByAddressResponse{} }
+func (m *QueryAccountsByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountsByAddressResponse) ProtoMessage()    {}
+
+// QueryClient is the client API for the Query service.
+type QueryClient interface {
+	AccountsByAddress(ctx context.Context, in *QueryAccountsByAddressRequest, opts ...grpc.CallOption) (*QueryAccountsByAddressResponse, error)
+}
+
+type queryClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewQueryClient creates the client of the Query service
+func NewQueryClient(cc *grpc.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AccountsByAddress(ctx context.Context, in *QueryAccountsByAddressRequest, opts ...grpc.CallOption) (*QueryAccountsByAddressResponse, error) {
+	out := new(QueryAccountsByAddressResponse)
+	return out, c.cc.Invoke(ctx, "/"+QueryServiceName+"/AccountsByAddress", in, out, opts...)
+}
+
+// QueryServer is the server API for the Query service.
+type QueryServer interface {
+	AccountsByAddress(context.Context, *QueryAccountsByAddressRequest) (*QueryAccountsByAddressResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) AccountsByAddress(context.Context, *QueryAccountsByAddressRequest) (*QueryAccountsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsByAddress not implemented")
+}
+
+// QueryServiceName the full name of the Query service in query.proto
+const QueryServiceName = "kuchain.x.account.v1.Query"
+
+// RegisterQueryServer registers the Query service to the gRPC server
+func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
+	s.RegisterService(&queryServiceDesc, srv)
+}
+
+func queryAccountsByAddressHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(QueryServer).AccountsByAddress(ctx, in)
+	}
+
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + QueryServiceName + "/AccountsByAddress",
+	}
+	return interceptor(ctx, in, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountsByAddress(ctx, req.(*QueryAccountsByAddressRequest))
+	})
+}
+
+var queryServiceDesc = grpc.ServiceDesc{
+	ServiceName: QueryServiceName,
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AccountsByAddress",
+			Handler:    queryAccountsByAddressHandler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "x/account/types/query.proto",
+}
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// MaxGuardiansNum the max number of the guardians of an account
+const MaxGuardiansNum = 16
+
+// Guardians the guardians of an account, the threshold of them can rotate the auth of the account
+type Guardians struct {
+	Account   types.Name        `json:"account" yaml:"account"`
+	Guardians []types.AccountID `json:"guardians" yaml:"guardians"`
+	Threshold uint32            `json:"threshold" yaml:"threshold"`
+}
+
+// NewGuardians creates the guardians of the account
+func NewGuardians(account types.Name, guardians []types.AccountID, threshold uint32) Guardians {
+	return Guardians{
+		Account:   account,
+		Guardians: guardians,
+		Threshold: threshold,
+	}
+}
+
+// IsGuardian return true if the id is one of the guardians
+func (g Guardians) IsGuardian(id types.AccountID) bool {
+	for _, guardian := range g.Guardians {
+		if guardian.Eq(id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate returns error if the guardians or the threshold is invalid
+func (g Guardians) Validate() error {
+	if len(g.Guardians) == 0 || len(g.Guardians) > MaxGuardiansNum {
+		return sdkerrors.Wrapf(ErrAccountGuardianInvalid, "guardians number should be in [1, %d]", MaxGuardiansNum)
+	}
+
+	if g.Threshold == 0 || int(g.Threshold) > len(g.Guardians) {
+		return sdkerrors.Wrapf(ErrRecoveryThresholdInvalid, "threshold %d of %d guardians", g.Threshold, len(g.Guardians))
+	}
+
+	for i, guardian := range g.Guardians {
+		if guardian.Empty() {
+			return sdkerrors.Wrap(ErrAccountGuardianInvalid, "guardian should not be empty")
+		}
+
+		if name, ok := guardian.ToName(); ok && name.Eq(g.Account) {
+			return sdkerrors.Wrap(ErrAccountGuardianInvalid, "guardian should not be the account self")
+		}
+
+		for _, other := range g.Guardians[:i] {
+			if other.Eq(guardian) {
+				return sdkerrors.Wrapf(ErrAccountGuardianInvalid, "duplicate guardian %s", guardian)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g Guardians) String() string {
+	out, _ := yaml.Marshal(g)
+	return string(out)
+}
+
+// Recovery the pending recovery of an account, the auth of the account is rotated
+// when the time lock ends after the threshold of the guardians approved it,
+// the account can cancel the recovery before that.
+type Recovery struct {
+	Account      types.Name        `json:"account" yaml:"account"`
+	Auth         types.AccAddress  `json:"auth" yaml:"auth"` // the new auth of the account
+	Approvals    []types.AccountID `json:"approvals" yaml:"approvals"`
+	StartHeight  int64             `json:"start_height" yaml:"start_height"`
+	ExpireHeight int64             `json:"expire_height" yaml:"expire_height"` // the recovery is dropped if not approved before
+	UnlockHeight int64             `json:"unlock_height" yaml:"unlock_height"` // the auth is rotated at, zero if not approved
+}
+
+// NewRecovery creates a new recovery of the account initiated by the guardian
+func NewRecovery(account types.Name, auth types.AccAddress, initiator types.AccountID, startHeight, expireHeight int64) Recovery {
+	return Recovery{
+		Account:      account,
+		Auth:         auth,
+		Approvals:    []types.AccountID{initiator},
+		StartHeight:  startHeight,
+		ExpireHeight: expireHeight,
+	}
+}
+
+// IsApproved return true if the threshold of the guardians approved the recovery
+func (r Recovery) IsApproved() bool {
+	return r.UnlockHeight > 0
+}
+
+// HasApproved return true if the guardian has approved the recovery
+func (r Recovery) HasApproved(guardian types.AccountID) bool {
+	for _, approval := range r.Approvals {
+		if approval.Eq(guardian) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// QueueHeight the height at which the recovery should be processed, executed if approved, or else dropped
+func (r Recovery) QueueHeight() int64 {
+	if r.IsApproved() {
+		return r.UnlockHeight
+	}
+
+	return r.ExpireHeight
+}
+
+func (r Recovery) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// ValidateRecoveries validates the recoveries in genesis, each should have the guardians of the account
+func ValidateRecoveries(guardians []Guardians, recoveries []Recovery) error {
+	for _, r := range recoveries {
+		found := false
+		for _, g := range guardians {
+			if g.Account.Eq(r.Account) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("recovery of %s without guardians", r.Account)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"gopkg.in/yaml.v2"
+)
+
+// PendingAuth the pending auth rotation of an account, the new auth becomes active at the active height,
+// the old auth can cancel the rotation before that, so a rotation by a stolen key can be stopped in time.
+type PendingAuth struct {
+	Account      types.Name       `json:"account" yaml:"account"`
+	Auth         types.AccAddress `json:"auth" yaml:"auth"`         // the new auth of the account
+	OldAuth      types.AccAddress `json:"old_auth" yaml:"old_auth"` // the auth which updated the auth
+	StartHeight  int64            `json:"start_height" yaml:"start_height"`
+	ActiveHeight int64            `json:"active_height" yaml:"active_height"`
+}
+
+// NewPendingAuth creates a new pending auth rotation of the account
+func NewPendingAuth(account types.Name, auth, oldAuth types.AccAddress, startHeight, activeHeight int64) PendingAuth {
+	return PendingAuth{
+		Account:      account,
+		Auth:         auth,
+		OldAuth:      oldAuth,
+		StartHeight:  startHeight,
+		ActiveHeight: activeHeight,
+	}
+}
+
+func (p PendingAuth) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
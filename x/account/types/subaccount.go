@@ -0,0 +1,107 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// MaxSubAccountPermissionsNum the max number of the permissions of a sub-account
+const MaxSubAccountPermissionsNum = 32
+
+// TransferPermissionRoute and TransferPermissionType the permission of the transfer in the KuMsgs,
+// as each KuMsg can carry a transfer which is handled before the msg, a KuMsg with coins signed by
+// the auth of a sub-account requires the permission of the transfer besides the msg itself.
+const (
+	TransferPermissionRoute = "asset"
+	TransferPermissionType  = "transfer"
+)
+
+// SubAccountSeparator the separator between the parent name and the child part of a sub-account name
+const SubAccountSeparator = "."
+
+// SubAccount a sub-account created by the parent account, such as `corp.treasury` of `corp`,
+// the auth of the sub-account can only sign the msgs in the permissions, each permission is
+// a "route/type" for a msg type, such as "asset/transfer", or a "route" for all msgs of the route.
+type SubAccount struct {
+	Account     types.Name `json:"account" yaml:"account"`
+	Parent      types.Name `json:"parent" yaml:"parent"`
+	Permissions []string   `json:"permissions" yaml:"permissions"`
+}
+
+// NewSubAccount creates the sub-account of the parent with the permissions
+func NewSubAccount(account, parent types.Name, permissions []string) SubAccount {
+	return SubAccount{
+		Account:     account,
+		Parent:      parent,
+		Permissions: permissions,
+	}
+}
+
+// IsMsgAllowed returns true if the auth of the sub-account can sign the msg of the route and type
+func (s SubAccount) IsMsgAllowed(route, msgType string) bool {
+	for _, p := range s.Permissions {
+		if p == route || p == route+"/"+msgType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate returns error if the parent or the permissions of the sub-account is invalid
+func (s SubAccount) Validate() error {
+	parent, ok := SubAccountParent(s.Account)
+	if !ok || !parent.Eq(s.Parent) {
+		return sdkerrors.Wrapf(ErrSubAccountNameInvalid, "%s is not a sub-account name of %s", s.Account, s.Parent)
+	}
+
+	return ValidateSubAccountPermissions(s.Permissions)
+}
+
+func (s SubAccount) String() string {
+	out, _ := yaml.Marshal(s)
+	return string(out)
+}
+
+// SubAccountParent returns the parent name of the sub-account name, such as `corp` of `corp.treasury`,
+// return false if the name is not a sub-account name.
+func SubAccountParent(name types.Name) (types.Name, bool) {
+	str := name.String()
+
+	idx := strings.LastIndex(str, SubAccountSeparator)
+	if idx <= 0 || idx == len(str)-1 {
+		return types.Name{}, false
+	}
+
+	parent, err := types.NewName(str[:idx])
+	if err != nil {
+		return types.Name{}, false
+	}
+
+	return parent, true
+}
+
+// ValidateSubAccountPermissions returns error if the permissions of a sub-account are invalid
+func ValidateSubAccountPermissions(permissions []string) error {
+	if len(permissions) == 0 || len(permissions) > MaxSubAccountPermissionsNum {
+		return sdkerrors.Wrapf(ErrSubAccountPermissionInvalid,
+			"permissions number should be in [1, %d]", MaxSubAccountPermissionsNum)
+	}
+
+	for i, p := range permissions {
+		if p == "" || strings.HasPrefix(p, "/") || strings.HasSuffix(p, "/") || strings.Count(p, "/") > 1 {
+			return sdkerrors.Wrapf(ErrSubAccountPermissionInvalid, "permission %q should be a route or route/type", p)
+		}
+
+		for _, other := range permissions[:i] {
+			if other == p {
+				return sdkerrors.Wrapf(ErrSubAccountPermissionInvalid, "duplicate permission %s", p)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package account
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/account/types"
+)
+
+// ProvideKeeper creates the account keeper by the store key declared to the builder
+func ProvideKeeper(b *wiring.Builder) Keeper {
+	return NewAccountKeeper(b.Codec(), b.KVStoreKey(StoreKey))
+}
+
+// AuctionInputs the keepers the auctions of the premium names depend on
+type AuctionInputs struct {
+	AccountKeeper      Keeper
+	SupplyKeeper       types.SupplyKeeper
+	DistributionKeeper types.DistributionKeeper
+}
+
+// ProvideAuctionKeeper creates the auction keeper by the params subspace declared to the builder
+func ProvideAuctionKeeper(b *wiring.Builder, in AuctionInputs) AuctionKeeper {
+	return NewAuctionKeeper(in.AccountKeeper, b.Subspace(DefaultParamspace), in.SupplyKeeper, in.DistributionKeeper)
+}
+
+// ProvideRecoveryKeeper creates the recovery keeper by the params subspace declared to the builder
+func ProvideRecoveryKeeper(b *wiring.Builder, ak Keeper) RecoveryKeeper {
+	return NewRecoveryKeeper(ak, b.Subspace(RecoveryParamspace))
+}
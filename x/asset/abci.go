@@ -0,0 +1,46 @@
+package asset
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker mints the coins due in the block by the emission schedules to the recipients,
+// and unlocks the coins due in the block by the transfer locks
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	emissions, minted, err := k.EmitCoins(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	logger := k.Logger(ctx)
+	for i, emission := range emissions {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEmission,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCreator, emission.Creator.String()),
+				sdk.NewAttribute(types.AttributeKeySymbol, emission.Symbol.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, emission.Schedule.Recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, minted[i].String()),
+				sdk.NewAttribute(types.AttributeKeyEmitted, emission.Emitted.String()),
+			),
+		)
+
+		logger.Info("coin emission minted", "denom", minted[i].Denom, "amount", minted[i], "emitted", emission.Emitted)
+	}
+
+	accounts, unlocked := k.UnlockTransferLocks(ctx)
+	for i, account := range accounts {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTransferUnlock,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyAccount, account.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, unlocked[i].String()),
+			),
+		)
+
+		logger.Debug("transfer lock unlocked", "account", account, "amount", unlocked[i])
+	}
+}
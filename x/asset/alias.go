@@ -11,6 +11,9 @@ const (
 	StoreKey     = types.StoreKey
 	QuerierRoute = types.QuerierRoute
 	RouterKey    = types.RouterKey
+
+	DefaultParamspace            = types.DefaultParamspace
+	ProposalTypeIssuanceApproval = types.ProposalTypeIssuanceApproval
 )
 
 var (
@@ -19,17 +22,87 @@ var (
 )
 
 var (
-	NewAssetKeeper      = keeper.NewAssetKeeper
-	NewGenesisState     = types.NewGenesisState
-	NewGenesisCoin      = types.NewGenesisCoin
-	NewGenesisAsset     = types.NewGenesisAsset
-	DefaultGenesisState = types.DefaultGenesisState
+	NewAssetKeeper        = keeper.NewAssetKeeper
+	RegisterInvariants    = keeper.RegisterInvariants
+	AllInvariants         = keeper.AllInvariants
+	CoinCreatorsInvariant = keeper.CoinCreatorsInvariant
+	NewGenesisState       = types.NewGenesisState
+	NewGenesisCoin        = types.NewGenesisCoin
+	NewGenesisAsset       = types.NewGenesisAsset
+	NewGenesisLockedCoins = types.NewGenesisLockedCoins
+	DefaultGenesisState   = types.DefaultGenesisState
+	NewParams             = types.NewParams
+	DefaultParams         = types.DefaultParams
+
+	NewPendingIssuance          = types.NewPendingIssuance
+	NewIssuanceApprovalProposal = types.NewIssuanceApprovalProposal
+	NewMsgApproveIssuance       = types.NewMsgApproveIssuance
+	NewMsgRejectIssuance        = types.NewMsgRejectIssuance
+	ErrAssetIssuancePending     = types.ErrAssetIssuancePending
+	ErrAssetIssuanceNotFound    = types.ErrAssetIssuanceNotFound
+	ErrAssetIssuanceApprover    = types.ErrAssetIssuanceApprover
+
+	NewCoinAllowList          = types.NewCoinAllowList
+	NewMsgSetAllowListOnly    = types.NewMsgSetAllowListOnly
+	NewMsgAddToAllowList      = types.NewMsgAddToAllowList
+	NewMsgRemoveFromAllowList = types.NewMsgRemoveFromAllowList
+	ErrAssetNotInAllowList    = types.ErrAssetNotInAllowList
+
+	NewClawbackGrant          = types.NewClawbackGrant
+	NewMsgCreateClawbackGrant = types.NewMsgCreateClawbackGrant
+	NewMsgClawback            = types.NewMsgClawback
+	ErrAssetClawbackDisabled  = types.ErrAssetClawbackDisabled
+
+	NewLinearEmissionSchedule = types.NewLinearEmissionSchedule
+	NewCustomEmissionSchedule = types.NewCustomEmissionSchedule
+	NewEmissionCheckpoint     = types.NewEmissionCheckpoint
+	NewMsgCreateWithEmission  = types.NewMsgCreateWithEmission
+	ErrAssetEmissionSchedule  = types.ErrAssetEmissionSchedule
+	ErrAssetEmissionNotFound  = types.ErrAssetEmissionNotFound
+
+	NewCoinFreeze            = types.NewCoinFreeze
+	NewMsgCreateByData       = types.NewMsgCreateByData
+	NewMsgFreeze             = types.NewMsgFreeze
+	NewMsgUnfreeze           = types.NewMsgUnfreeze
+	NewMsgSetTransfersPaused = types.NewMsgSetTransfersPaused
+	ErrAssetNotFreezable     = types.ErrAssetNotFreezable
+	ErrAssetAccountFrozen    = types.ErrAssetAccountFrozen
+	ErrAssetTransfersPaused  = types.ErrAssetTransfersPaused
+
+	NewNFTCollection          = types.NewNFTCollection
+	NewNFT                    = types.NewNFT
+	NewMsgCreateNFTCollection = types.NewMsgCreateNFTCollection
+	NewMsgMintNFT             = types.NewMsgMintNFT
+	NewMsgTransferNFT         = types.NewMsgTransferNFT
+	NewMsgBurnNFT             = types.NewMsgBurnNFT
+	NewMsgEditNFT             = types.NewMsgEditNFT
+	ErrAssetNFTNotFound       = types.ErrAssetNFTNotFound
+	ErrAssetNFTOwner          = types.ErrAssetNFTOwner
+
+	NewTransferLock        = types.NewTransferLock
+	NewMsgTransferWithLock = types.NewMsgTransferWithLock
+	ErrAssetTransferLock   = types.ErrAssetTransferLock
 )
 
 type (
 	Keeper      = keeper.AssetKeeper
 	KuTransfMsg = chainTypes.KuTransfMsg
 
-	GenesisState = types.GenesisState
-	GenesisAsset = types.GenesisAsset
+	GenesisState       = types.GenesisState
+	GenesisAsset       = types.GenesisAsset
+	GenesisLockedCoins = types.GenesisLockedCoins
+
+	Params                   = types.Params
+	PendingIssuance          = types.PendingIssuance
+	IssuanceApprovalProposal = types.IssuanceApprovalProposal
+	CoinAllowList            = types.CoinAllowList
+	ClawbackGrant            = types.ClawbackGrant
+	EmissionSchedule         = types.EmissionSchedule
+	EmissionCheckpoint       = types.EmissionCheckpoint
+	CoinEmission             = types.CoinEmission
+	CoinFreeze               = types.CoinFreeze
+	NFTCollection            = types.NFTCollection
+	NFT                      = types.NFT
+	TransferLock             = types.TransferLock
+	AccountTransferLocks     = types.AccountTransferLocks
 )
@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"bufio"
+	"strconv"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+)
+
+// SetAllowListOnly will create a tx to mark the coin as allow-list-only by the creator
+func SetAllowListOnly(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-allow-list-only [creator] [symbol] [allow_list_only]",
+		Short: "Set the coin only can be transferred between the accounts in allow list",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			allowListOnly, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "allow_list_only parse error")
+			}
+
+			return allowListTx(cmd, cdc, args[0], args[1], func(auth sdk.AccAddress, creator, symbol chainTypes.Name) sdk.Msg {
+				return types.NewMsgSetAllowListOnly(auth, creator, symbol, allowListOnly)
+			})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+// AddToAllowList will create a tx to add accounts to the allow list of the coin by the creator
+func AddToAllowList(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-allow-list [creator] [symbol] [account]...",
+		Short: "Add accounts to the allow list of the coin",
+		Args:  cobra.RangeArgs(3, 2+types.MaxAllowListAccountsInMsg),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			accounts, err := parseAccountIDs(args[2:])
+			if err != nil {
+				return err
+			}
+
+			return allowListTx(cmd, cdc, args[0], args[1], func(auth sdk.AccAddress, creator, symbol chainTypes.Name) sdk.Msg {
+				return types.NewMsgAddToAllowList(auth, creator, symbol, accounts...)
+			})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+// RemoveFromAllowList will create a tx to remove accounts from the allow list of the coin by the creator
+func RemoveFromAllowList(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-allow-list [creator] [symbol] [account]...",
+		Short: "Remove accounts from the allow list of the coin",
+		Args:  cobra.RangeArgs(3, 2+types.MaxAllowListAccountsInMsg),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			accounts, err := parseAccountIDs(args[2:])
+			if err != nil {
+				return err
+			}
+
+			return allowListTx(cmd, cdc, args[0], args[1], func(auth sdk.AccAddress, creator, symbol chainTypes.Name) sdk.Msg {
+				return types.NewMsgRemoveFromAllowList(auth, creator, symbol, accounts...)
+			})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+func parseAccountIDs(args []string) ([]chainTypes.AccountID, error) {
+	res := make([]chainTypes.AccountID, 0, len(args))
+	for _, arg := range args {
+		id, err := chainTypes.NewAccountIDFromStr(arg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "account id %s parse error", arg)
+		}
+		res = append(res, id)
+	}
+	return res, nil
+}
+
+func allowListTx(cmd *cobra.Command, cdc *codec.Codec, creatorStr, symbolStr string,
+	newMsg func(auth sdk.AccAddress, creator, symbol chainTypes.Name) sdk.Msg) error {
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+	creator, err := chainTypes.NewName(creatorStr)
+	if err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+
+	symbol, err := chainTypes.NewName(symbolStr)
+	if err != nil {
+		return sdkerrors.Wrap(err, "symbol")
+	}
+
+	creatorID := chainTypes.NewAccountIDFromName(creator)
+	ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(creatorID)
+	auth, err := txutil.QueryAccountAuth(ctx, creatorID)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "query account %s auth error", creatorID)
+	}
+
+	return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{newMsg(auth, creator, symbol)})
+}
+
+// GetAllowListCmd returns a query the allow list of coin
+func GetAllowListCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allow-list [creator] [symbol]",
+		Short: "Query the allow list of the coin",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			creator, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "creator")
+			}
+
+			symbol, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "symbol")
+			}
+
+			allowList, _, err := types.NewAssetRetriever(cliCtx).GetAllowList(creator, symbol)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(allowList)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
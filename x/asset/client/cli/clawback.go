@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"bufio"
+	"strconv"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+)
+
+// CreateClawbackGrant will create a tx to grant the coins locked in the grantee which the funder can claw back,
+// the tx should be signed by both the funder and the grantee, use --generate-only to get the tx for grantee to sign.
+func CreateClawbackGrant(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-grant [funder] [grantee] [coins] [unlock_block_height]",
+		Short: "Grant coins locked in grantee until the height, the funder can claw back them before unlocked, grantee should sign to consent",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			funder, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "funder")
+			}
+
+			grantee, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "grantee")
+			}
+
+			amount, err := chainTypes.ParseCoins(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "coins")
+			}
+
+			unlockBlockHeight, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "unlock_block_height parse error")
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(funder)
+			funderAuth, err := txutil.QueryAccountAuth(ctx, funder)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", funder)
+			}
+
+			granteeAuth, err := txutil.QueryAccountAuth(ctx, grantee)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", grantee)
+			}
+
+			msg := types.NewMsgCreateClawbackGrant(funderAuth, granteeAuth, funder, grantee, amount, unlockBlockHeight)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+// Clawback will create a tx to claw back the unvested coins of the grant account by the funder
+func Clawback(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [funder] [grantee]",
+		Short: "Claw back the coins of the grant account which are not unlocked",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			funder, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "funder")
+			}
+
+			grantee, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "grantee")
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(funder)
+			auth, err := txutil.QueryAccountAuth(ctx, funder)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", funder)
+			}
+
+			msg := types.NewMsgClawback(auth, funder, grantee)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+// GetClawbackGrantCmd returns a query the clawback grant of account
+func GetClawbackGrantCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback-grant [account]",
+		Short: "Query the clawback grant of the account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "account")
+			}
+
+			grant, _, err := types.NewAssetRetriever(cliCtx).GetClawbackGrant(grantee)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
@@ -15,6 +15,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // Create will create a account create tx and sign it with the given key.
@@ -22,7 +23,22 @@ func Create(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create [creator] [symbol] [max_supply] [canIssue] [canLock] [issueToHeight] [initSupply] [desc]",
 		Short: "Create coin, if canIssue is 1 or canLock is 1, the coin cannot issue or lock after 64 blocks",
-		Args:  cobra.ExactArgs(8),
+		Long: `Create coin, if canIssue is 1 or canLock is 1, the coin cannot issue or lock after 64 blocks.
+
+The coins can be minted by an emission schedule in the begin blockers after the coin created, by a cliff and
+linear schedule:
+
+	--emission-total=1000000creator/sym --emission-cliff=100 --emission-duration=1000
+
+or by the custom checkpoints of the total minted at the blocks after the coin created:
+
+	--emission-total=1000000creator/sym --emission-checkpoints=100:200000,1000:1000000
+
+the issueToHeight should be 0 with the emission schedule, and the initSupply is ignored.
+
+With --freezable the creator can freeze the accounts and pause the transfers of the coin,
+it only can be set when the coin created.`,
+		Args: cobra.ExactArgs(8),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
@@ -78,11 +94,39 @@ func Create(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("coin desc too long, should be less than %d", types.CoinDescriptionLen)
 			}
 
-			msg := types.NewMsgCreate(auth, creator, symbol, maxSupply, isCanIssue, isCanLock, issueToHeight, initSupply, []byte(desc))
+			emission, err := parseEmissionFlags()
+			if err != nil {
+				return err
+			}
+
+			data := types.MsgCreateCoinData{
+				Creator:       creator,
+				Symbol:        symbol,
+				MaxSupply:     maxSupply,
+				CanIssue:      isCanIssue,
+				CanLock:       isCanLock,
+				IssueToHeight: issueToHeight,
+				InitSupply:    initSupply,
+				Desc:          []byte(desc),
+				Emission:      emission,
+				Freezable:     viper.GetBool(FlagFreezable),
+			}
+
+			if emission != nil {
+				if issueToHeight != 0 {
+					return fmt.Errorf("issueToHeight should be 0 with the emission schedule")
+				}
+
+				data.InitSupply = chainTypes.NewCoin(maxSupply.Denom, chainTypes.NewInt(0))
+			}
+
+			msg := types.NewMsgCreateByData(auth, data)
 			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
+	addEmissionFlags(cmd)
+	cmd.Flags().Bool(FlagFreezable, false, "the creator can freeze the accounts and pause the transfers of the coin")
 	cmd = flags.PostCommands(cmd)[0]
 
 	return cmd
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// the flags of the emission schedule for the coin created
+const (
+	FlagEmissionTotal       = "emission-total"
+	FlagEmissionRecipient   = "emission-recipient"
+	FlagEmissionCliff       = "emission-cliff"
+	FlagEmissionDuration    = "emission-duration"
+	FlagEmissionCheckpoints = "emission-checkpoints"
+)
+
+func addEmissionFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagEmissionTotal, "", "the total coins minted by the emission schedule, no emission if empty")
+	cmd.Flags().String(FlagEmissionRecipient, "", "the account the coins minted to, the creator if empty")
+	cmd.Flags().Int64(FlagEmissionCliff, 0, "no coins minted until the blocks after the coin created")
+	cmd.Flags().Int64(FlagEmissionDuration, 0, "the total minted linearly in the blocks after the coin created")
+	cmd.Flags().String(FlagEmissionCheckpoints, "", "the custom schedule as [blocks]:[amount],..., the amounts are the total minted at the blocks")
+}
+
+// parseEmissionFlags returns the emission schedule by the flags, nil if the total not set
+func parseEmissionFlags() (*types.EmissionSchedule, error) {
+	totalStr := viper.GetString(FlagEmissionTotal)
+	if totalStr == "" {
+		return nil, nil
+	}
+
+	total, err := chainTypes.ParseCoin(totalStr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "emission total")
+	}
+
+	var recipient types.AccountID
+	if r := viper.GetString(FlagEmissionRecipient); r != "" {
+		recipient, err = chainTypes.NewAccountIDFromStr(r)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "emission recipient")
+		}
+	}
+
+	checkpointsStr := viper.GetString(FlagEmissionCheckpoints)
+	if checkpointsStr == "" {
+		schedule := types.NewLinearEmissionSchedule(recipient, total,
+			viper.GetInt64(FlagEmissionCliff), viper.GetInt64(FlagEmissionDuration))
+		return &schedule, nil
+	}
+
+	checkpoints, err := parseEmissionCheckpoints(checkpointsStr)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := types.NewCustomEmissionSchedule(recipient, total, checkpoints)
+	return &schedule, nil
+}
+
+func parseEmissionCheckpoints(str string) ([]types.EmissionCheckpoint, error) {
+	res := make([]types.EmissionCheckpoint, 0)
+	for _, c := range strings.Split(str, ",") {
+		parts := strings.Split(strings.TrimSpace(c), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("emission checkpoint %s should be [blocks]:[amount]", c)
+		}
+
+		blocks, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "emission checkpoint %s blocks", c)
+		}
+
+		amount, ok := sdk.NewIntFromString(parts[1])
+		if !ok {
+			return nil, fmt.Errorf("emission checkpoint %s amount invalid", c)
+		}
+
+		res = append(res, types.NewEmissionCheckpoint(blocks, amount))
+	}
+
+	return res, nil
+}
+
+// GetEmissionCmd returns a query the emission schedule of coin
+func GetEmissionCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emission [creator] [symbol]",
+		Short: "Query the emission schedule of the coin",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			creator, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "creator")
+			}
+
+			symbol, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "symbol")
+			}
+
+			emission, _, err := types.NewAssetRetriever(cliCtx).GetCoinEmission(creator, symbol)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(emission)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetEmissionsCmd returns a query all the emission schedules in progress
+func GetEmissionsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emissions",
+		Short: "Query all the emission schedules in progress",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			emissions, _, err := types.NewAssetRetriever(cliCtx).GetCoinEmissions()
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(emissions)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+)
+
+// FlagFreezable the flag to create the coin which the creator can freeze
+const FlagFreezable = "freezable"
+
+// Freeze will create a tx to freeze the accounts of the freezable coin by the creator
+func Freeze(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [creator] [symbol] [account]...",
+		Short: "Freeze the accounts of the freezable coin, the frozen accounts cannot transfer the coin",
+		Args:  cobra.RangeArgs(3, 2+types.MaxFreezeAccountsInMsg),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			accounts, err := parseAccountIDs(args[2:])
+			if err != nil {
+				return err
+			}
+
+			return allowListTx(cmd, cdc, args[0], args[1], func(auth sdk.AccAddress, creator, symbol chainTypes.Name) sdk.Msg {
+				return types.NewMsgFreeze(auth, creator, symbol, accounts...)
+			})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+// Unfreeze will create a tx to unfreeze the frozen accounts of the coin by the creator
+func Unfreeze(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze [creator] [symbol] [account]...",
+		Short: "Unfreeze the frozen accounts of the coin",
+		Args:  cobra.RangeArgs(3, 2+types.MaxFreezeAccountsInMsg),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			accounts, err := parseAccountIDs(args[2:])
+			if err != nil {
+				return err
+			}
+
+			return allowListTx(cmd, cdc, args[0], args[1], func(auth sdk.AccAddress, creator, symbol chainTypes.Name) sdk.Msg {
+				return types.NewMsgUnfreeze(auth, creator, symbol, accounts...)
+			})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+// SetTransfersPaused will create a tx to pause or resume the transfers of the freezable coin by the creator
+func SetTransfersPaused(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfers-paused [creator] [symbol] [paused]",
+		Short: "Pause or resume the transfers of the freezable coin, only the creator can transfer the paused coin",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			paused, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "paused parse error")
+			}
+
+			return allowListTx(cmd, cdc, args[0], args[1], func(auth sdk.AccAddress, creator, symbol chainTypes.Name) sdk.Msg {
+				return types.NewMsgSetTransfersPaused(auth, creator, symbol, paused)
+			})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+// GetFreezeCmd returns a query the freeze state of the freezable coin
+func GetFreezeCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [creator] [symbol]",
+		Short: "Query the frozen accounts and if the transfers paused of the freezable coin",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			creator, err := chainTypes.NewName(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "creator")
+			}
+
+			symbol, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "symbol")
+			}
+
+			freeze, _, err := types.NewAssetRetriever(cliCtx).GetCoinFreeze(creator, symbol)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(freeze)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetFreezesCmd returns a query the freeze states of all the freezable coins
+func GetFreezesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freezes",
+		Short: "Query the freeze states of all the freezable coins",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			freezes, _, err := types.NewAssetRetriever(cliCtx).GetCoinFreezes()
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(freezes)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
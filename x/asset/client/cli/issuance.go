@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	govCli "github.com/KuChainNetwork/kuchain/x/gov/client/cli"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// ApproveIssuance will create a approve issuance tx by the registry account and sign it with the given key.
+func ApproveIssuance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-issuance [registry] [id]",
+		Short: "Approve the pending issuance, the coin will be created",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return reviewIssuance(cmd, cdc, args, func(auth sdk.AccAddress, registry chainTypes.AccountID, id uint64) sdk.Msg {
+				return types.NewMsgApproveIssuance(auth, registry, id)
+			})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+// RejectIssuance will create a reject issuance tx by the registry account and sign it with the given key.
+func RejectIssuance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-issuance [registry] [id]",
+		Short: "Reject the pending issuance, the coin can be submitted again",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return reviewIssuance(cmd, cdc, args, func(auth sdk.AccAddress, registry chainTypes.AccountID, id uint64) sdk.Msg {
+				return types.NewMsgRejectIssuance(auth, registry, id)
+			})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+func reviewIssuance(cmd *cobra.Command, cdc *codec.Codec, args []string,
+	newMsg func(auth sdk.AccAddress, registry chainTypes.AccountID, id uint64) sdk.Msg) error {
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+	registry, err := chainTypes.NewAccountIDFromStr(args[0])
+	if err != nil {
+		return sdkerrors.Wrapf(err, "account id %s parse error", args[0])
+	}
+
+	id, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "issuance id parse error")
+	}
+
+	ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(registry)
+	auth, err := txutil.QueryAccountAuth(ctx, registry)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "query account %s auth error", registry)
+	}
+
+	return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{newMsg(auth, registry, id)})
+}
+
+// GetCmdSubmitIssuanceApprovalProposal implements a command handler for submitting a issuance approval proposal transaction.
+func GetCmdSubmitIssuanceApprovalProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issuance-approval [proposer] [id] [approve]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to approve or reject a pending issuance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to approve or reject a pending issuance, if approved the coin
+will be created, else the pending issuance will be removed.
+
+Example:
+$ %s tx kugov submit-proposal issuance-approval jack 1 true --title="Approve coin" --description="approve the coin" --deposit="1000kuchain/kcs" --from=<key>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			proposer, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposer account id error")
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "issuance id parse error")
+			}
+
+			approve, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "approve parse error")
+			}
+
+			deposit, err := chainTypes.ParseCoins(viper.GetString(govCli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewIssuanceApprovalProposal(
+				viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription), id, approve)
+
+			msg := govTypes.NewKuMsgSubmitProposal(cliCtx.GetFromAddress(), content, deposit, proposer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithFromAccount(proposer)
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govCli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govCli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// GetParamsCmd returns a query asset params
+func GetParamsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current asset parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params, _, err := types.NewAssetRetriever(cliCtx).GetParams()
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetIssuanceCmd returns a query pending issuance
+func GetIssuanceCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issuance [id]",
+		Short: "Query the pending issuance by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "issuance id")
+			}
+
+			issuance, _, err := types.NewAssetRetriever(cliCtx).GetPendingIssuance(id)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(issuance)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetIssuancesCmd returns a query all pending issuances
+func GetIssuancesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issuances",
+		Short: "Query all the pending issuances waiting for approval",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			issuances, _, err := types.NewAssetRetriever(cliCtx).GetPendingIssuances()
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(issuances)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
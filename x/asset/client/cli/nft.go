@@ -0,0 +1,305 @@
+package cli
+
+import (
+	"bufio"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// the flags of the nft commands
+const (
+	FlagNFTData     = "data"
+	FlagNFTMetadata = "metadata"
+)
+
+// CreateNFTCollection will create a tx to create the collection of the non-fungible tokens
+func CreateNFTCollection(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-nft-collection [creator] [name] [description]",
+		Short: "Create the collection of the non-fungible tokens, only the creator can mint the tokens",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return allowListTx(cmd, cdc, args[0], args[1], func(auth sdk.AccAddress, creator, name chainTypes.Name) sdk.Msg {
+				return types.NewMsgCreateNFTCollection(auth, creator, name, args[2])
+			})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+// MintNFT will create a tx to mint the token in the collection to the owner by the creator
+func MintNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-nft [creator] [collection] [id] [owner]",
+		Short: "Mint the token in the collection to the owner, the data is immutable and the metadata can be edited by the creator",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			owner, err := chainTypes.NewAccountIDFromStr(args[3])
+			if err != nil {
+				return sdkerrors.Wrap(err, "owner")
+			}
+
+			return allowListTx(cmd, cdc, args[0], args[1], func(auth sdk.AccAddress, creator, collection chainTypes.Name) sdk.Msg {
+				return types.NewMsgMintNFT(auth, types.NewNFT(creator, collection, args[2], owner,
+					viper.GetString(FlagNFTData), viper.GetString(FlagNFTMetadata)))
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagNFTData, "", "The immutable data of the token")
+	cmd.Flags().String(FlagNFTMetadata, "", "The mutable metadata of the token")
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+// TransferNFT will create a tx to transfer the token by the owner
+func TransferNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-nft [from] [to] [creator] [collection] [id]",
+		Short: "Transfer the token in the collection to the account by the owner",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "to")
+			}
+
+			return nftOwnerTx(cmd, cdc, args[0], args[2], args[3],
+				func(auth sdk.AccAddress, owner chainTypes.AccountID, creator, collection chainTypes.Name) sdk.Msg {
+					return types.NewMsgTransferNFT(auth, owner, to, creator, collection, args[4])
+				})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+// BurnNFT will create a tx to burn the token by the owner
+func BurnNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-nft [owner] [creator] [collection] [id]",
+		Short: "Burn the token in the collection by the owner",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return nftOwnerTx(cmd, cdc, args[0], args[1], args[2],
+				func(auth sdk.AccAddress, owner chainTypes.AccountID, creator, collection chainTypes.Name) sdk.Msg {
+					return types.NewMsgBurnNFT(auth, owner, creator, collection, args[3])
+				})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+// EditNFT will create a tx to edit the mutable metadata of the token by the creator of the collection
+func EditNFT(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-nft [creator] [collection] [id] [metadata]",
+		Short: "Edit the mutable metadata of the token in the collection by the creator",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return allowListTx(cmd, cdc, args[0], args[1], func(auth sdk.AccAddress, creator, collection chainTypes.Name) sdk.Msg {
+				return types.NewMsgEditNFT(auth, creator, collection, args[2], args[3])
+			})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+func nftOwnerTx(cmd *cobra.Command, cdc *codec.Codec, ownerStr, creatorStr, collectionStr string,
+	newMsg func(auth sdk.AccAddress, owner chainTypes.AccountID, creator, collection chainTypes.Name) sdk.Msg) error {
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+	owner, err := chainTypes.NewAccountIDFromStr(ownerStr)
+	if err != nil {
+		return sdkerrors.Wrap(err, "owner")
+	}
+
+	creator, collection, err := parseNFTCollection(creatorStr, collectionStr)
+	if err != nil {
+		return err
+	}
+
+	ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(owner)
+	auth, err := txutil.QueryAccountAuth(ctx, owner)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "query account %s auth error", owner)
+	}
+
+	return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{newMsg(auth, owner, creator, collection)})
+}
+
+func parseNFTCollection(creatorStr, collectionStr string) (chainTypes.Name, chainTypes.Name, error) {
+	creator, err := chainTypes.NewName(creatorStr)
+	if err != nil {
+		return chainTypes.Name{}, chainTypes.Name{}, sdkerrors.Wrap(err, "creator")
+	}
+
+	collection, err := chainTypes.NewName(collectionStr)
+	if err != nil {
+		return chainTypes.Name{}, chainTypes.Name{}, sdkerrors.Wrap(err, "collection")
+	}
+
+	return creator, collection, nil
+}
+
+// GetNFTCollectionCmd returns a query the nft collection
+func GetNFTCollectionCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-collection [creator] [name]",
+		Short: "Query the collection of the non-fungible tokens",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			creator, name, err := parseNFTCollection(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			collection, _, err := types.NewAssetRetriever(cliCtx).GetNFTCollection(creator, name)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(collection)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetNFTCollectionsCmd returns a query the nft collections of the creator, or all the collections
+func GetNFTCollectionsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-collections [creator]",
+		Short: "Query the collections of the non-fungible tokens of the creator, all the collections if no creator",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var creator chainTypes.Name
+			if len(args) > 0 {
+				name, err := chainTypes.NewName(args[0])
+				if err != nil {
+					return sdkerrors.Wrap(err, "creator")
+				}
+				creator = name
+			}
+
+			collections, _, err := types.NewAssetRetriever(cliCtx).GetNFTCollections(creator)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(collections)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetNFTCmd returns a query the token in the nft collection
+func GetNFTCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft [creator] [collection] [id]",
+		Short: "Query the token in the collection",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			creator, collection, err := parseNFTCollection(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			token, _, err := types.NewAssetRetriever(cliCtx).GetNFT(creator, collection, args[2])
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(token)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetNFTsCmd returns a query the page of the tokens in the nft collection
+func GetNFTsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nfts [creator] [collection]",
+		Short: "Query the page of the tokens in the collection",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			creator, collection, err := parseNFTCollection(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			tokens, _, err := types.NewAssetRetriever(cliCtx).GetNFTs(creator, collection,
+				viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(tokens)
+		},
+	}
+
+	return nftPageFlags(cmd)
+}
+
+// GetNFTsByOwnerCmd returns a query the page of the tokens owned by the account
+func GetNFTsByOwnerCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nfts-by-owner [account]",
+		Short: "Query the page of the tokens owned by the account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "account")
+			}
+
+			tokens, _, err := types.NewAssetRetriever(cliCtx).GetNFTsByOwner(owner,
+				viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(tokens)
+		},
+	}
+
+	return nftPageFlags(cmd)
+}
+
+func nftPageFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().Int(flags.FlagPage, 1, "Query a specific page of the tokens")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultNFTsQueryLimit, "Query number of the tokens per page returned")
+
+	return flags.GetCommands(cmd)[0]
+}
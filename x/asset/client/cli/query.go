@@ -9,8 +9,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
+// FlagDisplay the flag to query the coins with the display amounts
+const FlagDisplay = "display"
+
 // GetQueryCmd returns the transaction commands for this module
 func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -28,6 +32,21 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		GetCoinPowersCmd(cdc),
 		GetCoinsLockedCmd(cdc),
 		GetCoinStatCmd(cdc),
+		GetParamsCmd(cdc),
+		GetIssuanceCmd(cdc),
+		GetIssuancesCmd(cdc),
+		GetAllowListCmd(cdc),
+		GetClawbackGrantCmd(cdc),
+		GetEmissionCmd(cdc),
+		GetEmissionsCmd(cdc),
+		GetFreezeCmd(cdc),
+		GetFreezesCmd(cdc),
+		GetNFTCollectionCmd(cdc),
+		GetNFTCollectionsCmd(cdc),
+		GetNFTCmd(cdc),
+		GetNFTsCmd(cdc),
+		GetNFTsByOwnerCmd(cdc),
+		GetTransferLocksCmd(cdc),
 	)
 
 	return cmd
@@ -85,6 +104,15 @@ func GetCoinsCmd(cdc *codec.Codec) *cobra.Command {
 				return sdkerrors.Wrap(err, "account")
 			}
 
+			if viper.GetBool(FlagDisplay) {
+				coins, _, err := accGetter.GetCoinsDisplay(key)
+				if err != nil {
+					return err
+				}
+
+				return cliCtx.PrintOutput(coins)
+			}
+
 			coin, _, err := accGetter.GetCoins(key)
 			if err != nil {
 				return err
@@ -94,6 +122,8 @@ func GetCoinsCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagDisplay, false, "Show the display amounts of the coins by the denom displays in params")
+
 	return flags.GetCommands(cmd)[0]
 }
 
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"bufio"
+	"strconv"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+)
+
+// TransferWithLock will create a tx to transfer the coins locked in the receiver by the vesting schedule
+func TransferWithLock(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-with-lock [from] [to] [coins] [cliff_height] [end_height]",
+		Short: "Transfer coins locked in the receiver, unlocked linearly until the end height after the cliff height, or all at the end height if cliff is it",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			from, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "from")
+			}
+
+			to, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "to")
+			}
+
+			amount, err := chainTypes.ParseCoins(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "coins")
+			}
+
+			cliffHeight, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "cliff_height parse error")
+			}
+
+			endHeight, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "end_height parse error")
+			}
+
+			ctx := txutil.NewKuCLICtx(cliCtx).WithFromAccount(from)
+			auth, err := txutil.QueryAccountAuth(ctx, from)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", from)
+			}
+
+			msg := types.NewMsgTransferWithLock(auth, from, to, amount, cliffHeight, endHeight)
+			return txutil.GenerateOrBroadcastMsgs(ctx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = flags.PostCommands(cmd)[0]
+	return cmd
+}
+
+// GetTransferLocksCmd returns a query the transfer locks of account
+func GetTransferLocksCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locks [account]",
+		Short: "Query the coins transferred locked in the account and the unlocked by the vesting schedules",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			account, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "account")
+			}
+
+			locks, _, err := types.NewAssetRetriever(cliCtx).GetTransferLocks(account)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(locks)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
@@ -31,6 +31,22 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		Issue(cdc),
 		LockCoin(cdc),
 		UnlockCoin(cdc),
+		ApproveIssuance(cdc),
+		RejectIssuance(cdc),
+		SetAllowListOnly(cdc),
+		AddToAllowList(cdc),
+		RemoveFromAllowList(cdc),
+		CreateClawbackGrant(cdc),
+		Clawback(cdc),
+		Freeze(cdc),
+		Unfreeze(cdc),
+		SetTransfersPaused(cdc),
+		CreateNFTCollection(cdc),
+		MintNFT(cdc),
+		TransferNFT(cdc),
+		BurnNFT(cdc),
+		EditNFT(cdc),
+		TransferWithLock(cdc),
 	)
 
 	return txCmd
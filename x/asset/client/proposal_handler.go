@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/asset/client/cli"
+	"github.com/KuChainNetwork/kuchain/x/asset/client/rest"
+	"github.com/KuChainNetwork/kuchain/x/gov/client"
+)
+
+// IssuanceApprovalProposalHandler the asset issuance approval proposal handler
+var IssuanceApprovalProposalHandler = client.NewProposalHandler(cli.GetCmdSubmitIssuanceApprovalProposal, rest.IssuanceApprovalProposalRESTHandler)
@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	govRest "github.com/KuChainNetwork/kuchain/x/gov/client/rest"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+)
+
+// IssuanceApprovalProposalReq defines a issuance approval proposal request body.
+type IssuanceApprovalProposalReq struct {
+	BaseReq chainTypes.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title              string               `json:"title" yaml:"title"`
+	Description        string               `json:"description" yaml:"description"`
+	IssuanceID         uint64               `json:"issuance_id" yaml:"issuance_id"`
+	Approve            bool                 `json:"approve" yaml:"approve"`
+	Proposer           chainTypes.AccountID `json:"proposer" yaml:"proposer"`
+	Deposit            chainTypes.Coins     `json:"deposit" yaml:"deposit"`
+	ProposerAccAddress sdk.AccAddress       `json:"proposer_accaddress" yaml:"proposer_accaddress"`
+}
+
+// IssuanceApprovalProposalRESTHandler returns a ProposalRESTHandler that exposes the issuance approval REST handler with a given sub-route.
+func IssuanceApprovalProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "issuance_approval",
+		Handler:  postIssuanceApprovalProposalHandlerFn(cliCtx),
+	}
+}
+
+func postIssuanceApprovalProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req IssuanceApprovalProposalReq
+		if !chainTypes.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewIssuanceApprovalProposal(req.Title, req.Description, req.IssuanceID, req.Approve)
+		msg := govTypes.NewKuMsgSubmitProposal(req.ProposerAccAddress, content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			chainTypes.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txutil.WriteGenerateStdTxResponse(w, txutil.NewKuCLICtx(cliCtx), req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func getParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := types.NewAssetRetriever(cliCtx).GetParams()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getIssuanceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := types.NewAssetRetriever(cliCtx).GetPendingIssuance(id)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getIssuancesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := types.NewAssetRetriever(cliCtx).GetPendingIssuances()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
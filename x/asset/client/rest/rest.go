@@ -24,6 +24,66 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		"/assets/coin_stat/{creator}/{symbol}",
 		getCoinStatHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/assets/params",
+		getParamsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/issuances",
+		getIssuancesHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/issuances/{id}",
+		getIssuanceHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/allow_list/{creator}/{symbol}",
+		getAllowListHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/clawback_grant/{account}",
+		getClawbackGrantHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/emissions",
+		getEmissionsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/emissions/{creator}/{symbol}",
+		getEmissionHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/freezes",
+		getFreezesHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/freezes/{creator}/{symbol}",
+		getFreezeHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/nft_collections",
+		getNFTCollectionsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/nft_collections/{creator}/{name}",
+		getNFTCollectionHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/nfts/{creator}/{collection}",
+		getNFTsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/nfts/{creator}/{collection}/{id}",
+		getNFTHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/nfts_by_owner/{account}",
+		getNFTsByOwnerHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/assets/transfer_locks/{account}",
+		getTransferLocksHandlerFn(cliCtx),
+	).Methods("GET")
 
 	r.HandleFunc(
 		"/assets/transfer",
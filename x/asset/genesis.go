@@ -17,6 +17,8 @@ func InitGenesis(ctx sdk.Context, ak Keeper, bz json.RawMessage) {
 
 	logger.Debug("init genesis", "module", ModuleName, "data", data)
 
+	ak.SetParams(ctx, data.Params)
+
 	for _, a := range data.GenesisCoins {
 		logger.Info("init genesis asset coin", "accountID", a.GetCreator(), "coins", a.GetSymbol(), "maxsupply:", a.GetMaxSupply())
 
@@ -35,11 +37,68 @@ func InitGenesis(ctx sdk.Context, ak Keeper, bz json.RawMessage) {
 			panic(err)
 		}
 	}
+
+	for _, l := range data.GenesisLockedCoins {
+		logger.Info("init genesis account locked coins", "accountID", l.ID, "coins", l.Coins, "unlock", l.UnlockBlockHeight)
+		if err := ak.LockCoins(ctx, l.ID, l.UnlockBlockHeight, l.Coins); err != nil {
+			panic(err)
+		}
+	}
+
+	ak.InitPendingIssuances(ctx, data.PendingIssuances)
+
+	for _, l := range data.CoinAllowLists {
+		if err := ak.SetAllowListOnly(ctx, l.Creator, l.Symbol, l.AllowListOnly); err != nil {
+			panic(err)
+		}
+
+		if err := ak.AddToAllowList(ctx, l.Creator, l.Symbol, l.Accounts...); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, g := range data.ClawbackGrants {
+		ak.SetClawbackGrant(ctx, g)
+	}
+
+	for _, e := range data.CoinEmissions {
+		ak.SetCoinEmission(ctx, e)
+	}
+
+	for _, f := range data.CoinFreezes {
+		if err := ak.InitCoinFreeze(ctx, f); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, c := range data.NFTCollections {
+		ak.SetNFTCollection(ctx, c)
+	}
+
+	for _, n := range data.NFTs {
+		ak.SetNFT(ctx, n)
+	}
+
+	for _, l := range data.TransferLocks {
+		if err := ak.InitTransferLocks(ctx, l); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
 func ExportGenesis(ctx sdk.Context, ak Keeper) GenesisState {
-	return GenesisState{}
+	return GenesisState{
+		Params:           ak.GetParams(ctx),
+		PendingIssuances: ak.GetPendingIssuances(ctx),
+		CoinAllowLists:   ak.GetCoinAllowLists(ctx),
+		ClawbackGrants:   ak.GetClawbackGrants(ctx),
+		CoinEmissions:    ak.GetCoinEmissions(ctx),
+		CoinFreezes:      ak.GetCoinFreezes(ctx),
+		NFTCollections:   ak.GetNFTCollections(ctx, types.Name{}),
+		NFTs:             ak.GetAllNFTs(ctx),
+		TransferLocks:    ak.GetAllTransferLocks(ctx),
+	}
 }
 
 // GenesisBalancesIterator implements genesis account iteration.
@@ -28,6 +28,38 @@ func NewHandler(k keeper.AssetCoinsKeeper) msg.Handler {
 			return handleMsgLockCoin(ctx, k, msg)
 		case *types.MsgUnlockCoin:
 			return handleMsgUnlockCoin(ctx, k, msg)
+		case *types.MsgApproveIssuance:
+			return handleMsgApproveIssuance(ctx, k, msg)
+		case *types.MsgRejectIssuance:
+			return handleMsgRejectIssuance(ctx, k, msg)
+		case *types.MsgSetAllowListOnly:
+			return handleMsgSetAllowListOnly(ctx, k, msg)
+		case *types.MsgAddToAllowList:
+			return handleMsgAddToAllowList(ctx, k, msg)
+		case *types.MsgRemoveFromAllowList:
+			return handleMsgRemoveFromAllowList(ctx, k, msg)
+		case *types.MsgCreateClawbackGrant:
+			return handleMsgCreateClawbackGrant(ctx, k, msg)
+		case *types.MsgClawback:
+			return handleMsgClawback(ctx, k, msg)
+		case *types.MsgFreeze:
+			return handleMsgFreeze(ctx, k, msg)
+		case *types.MsgUnfreeze:
+			return handleMsgUnfreeze(ctx, k, msg)
+		case *types.MsgSetTransfersPaused:
+			return handleMsgSetTransfersPaused(ctx, k, msg)
+		case *types.MsgCreateNFTCollection:
+			return handleMsgCreateNFTCollection(ctx, k, msg)
+		case *types.MsgMintNFT:
+			return handleMsgMintNFT(ctx, k, msg)
+		case *types.MsgTransferNFT:
+			return handleMsgTransferNFT(ctx, k, msg)
+		case *types.MsgBurnNFT:
+			return handleMsgBurnNFT(ctx, k, msg)
+		case *types.MsgEditNFT:
+			return handleMsgEditNFT(ctx, k, msg)
+		case *types.MsgTransferWithLock:
+			return handleMsgTransferWithLock(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized asset message type: %T", msg)
 		}
@@ -65,12 +97,33 @@ func handleMsgCreate(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *typ
 	if denom != msgData.InitSupply.Denom || denom != msgData.MaxSupply.Denom {
 		return nil, sdkerrors.Wrapf(types.ErrAssetSymbolError, "coin denom should be equal")
 	}
+
+	// the coin will be created after approved if the issuance approval enabled
+	if k.GetParams(ctx.Context()).IssuanceApproval {
+		return handleSubmitIssuance(ctx, k, msgData)
+	}
+
 	if err := k.Create(ctx.Context(),
 		msgData.Creator, msgData.Symbol, msgData.MaxSupply,
 		msgData.CanIssue, msgData.CanLock, msgData.IssueToHeight, msgData.InitSupply, msgData.Desc); err != nil {
 		return nil, sdkerrors.Wrapf(err, "msg create coin %s", msgData.Symbol)
 	}
 
+	emission := ""
+	if msgData.Emission != nil {
+		coinEmission, err := k.CreateEmission(ctx.Context(), msgData.Creator, msgData.Symbol, *msgData.Emission)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "msg create coin %s emission", msgData.Symbol)
+		}
+		emission = coinEmission.Schedule.Total.String()
+	}
+
+	if msgData.Freezable {
+		if err := k.EnableFreeze(ctx.Context(), msgData.Creator, msgData.Symbol); err != nil {
+			return nil, sdkerrors.Wrapf(err, "msg create coin %s freezable", msgData.Symbol)
+		}
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeCreate,
@@ -83,10 +136,13 @@ func handleMsgCreate(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *typ
 			sdk.NewAttribute(types.AttributeKeyIssueToHeight, strconv.FormatInt(msgData.IssueToHeight, 10)),
 			sdk.NewAttribute(types.AttributeKeyInit, msgData.InitSupply.String()),
 			sdk.NewAttribute(types.AttributeKeyDescription, string(msgData.Desc)),
+			sdk.NewAttribute(types.AttributeKeyEmission, emission),
+			sdk.NewAttribute(types.AttributeKeyFreezable, strconv.FormatBool(msgData.Freezable)),
 		),
 	)
 
-	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+	res := types.MsgCreateCoinResponse{Denom: denom}
+	return chainTypes.NewMsgResult(Cdc(), res, ctx.EventManager().Events()), nil
 }
 
 // handleMsgIssue Handle Msg Issue coin
@@ -250,3 +306,529 @@ func handleMsgUnlockCoin(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// handleSubmitIssuance submits the coin creation to the pending issuances
+func handleSubmitIssuance(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msgData types.MsgCreateCoinData) (*sdk.Result, error) {
+	id, err := k.SubmitIssuance(ctx.Context(), msgData)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg submit issuance %s", msgData.Symbol)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitIssuance,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyIssuanceID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msgData.Creator.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, msgData.Symbol.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxSupply, msgData.MaxSupply.String()),
+		),
+	)
+
+	res := types.MsgCreateCoinResponse{
+		Denom:      types.CoinDenom(msgData.Creator, msgData.Symbol),
+		IssuanceID: id,
+	}
+	return chainTypes.NewMsgResult(Cdc(), res, ctx.EventManager().Events()), nil
+}
+
+// checkIssuanceApprover checks the approver is the registry account and signed the msg
+func checkIssuanceApprover(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, approver chainTypes.AccountID) error {
+	registry := k.GetParams(ctx.Context()).Registry
+	if registry.Empty() || !registry.Eq(approver) {
+		return sdkerrors.Wrapf(types.ErrAssetIssuanceApprover, "approver %s", approver)
+	}
+
+	ctx.RequireAuth(approver)
+
+	return nil
+}
+
+// handleMsgApproveIssuance Handle Msg approve issuance by the registry account
+func handleMsgApproveIssuance(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgApproveIssuance) (*sdk.Result, error) {
+	msgData := types.MsgApproveIssuanceData{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg approve issuance data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle approve issuance",
+		"approver", msgData.Approver,
+		"id", msgData.ID)
+
+	if err := checkIssuanceApprover(ctx, k, msgData.Approver); err != nil {
+		return nil, err
+	}
+
+	issuance, err := k.ApproveIssuance(ctx.Context(), msgData.ID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg approve issuance %d", msgData.ID)
+	}
+
+	emitIssuanceEvent(ctx.Context(), types.EventTypeApproveIssuance, msgData.Approver.String(), issuance)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgRejectIssuance Handle Msg reject issuance by the registry account
+func handleMsgRejectIssuance(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgRejectIssuance) (*sdk.Result, error) {
+	msgData := types.MsgRejectIssuanceData{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg reject issuance data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle reject issuance",
+		"approver", msgData.Approver,
+		"id", msgData.ID)
+
+	if err := checkIssuanceApprover(ctx, k, msgData.Approver); err != nil {
+		return nil, err
+	}
+
+	issuance, err := k.RejectIssuance(ctx.Context(), msgData.ID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg reject issuance %d", msgData.ID)
+	}
+
+	emitIssuanceEvent(ctx.Context(), types.EventTypeRejectIssuance, msgData.Approver.String(), issuance)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func emitIssuanceEvent(ctx sdk.Context, eventType, approver string, issuance types.PendingIssuance) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyIssuanceID, strconv.FormatUint(issuance.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyApprover, approver),
+			sdk.NewAttribute(types.AttributeKeyCreator, issuance.Coin.Creator.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, issuance.Coin.Symbol.String()),
+		),
+	)
+}
+
+// handleMsgSetAllowListOnly Handle Msg set coin allow-list-only by the creator
+func handleMsgSetAllowListOnly(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgSetAllowListOnly) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg set allow list only data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle set allow list only",
+		"creator", msgData.Creator,
+		"symbol", msgData.Symbol,
+		"allowListOnly", msgData.AllowListOnly)
+
+	ctx.RequireAccount(msgData.Creator)
+
+	if err := k.SetAllowListOnly(ctx.Context(), msgData.Creator, msgData.Symbol, msgData.AllowListOnly); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg set allow list only %s", msgData.Symbol)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAllowListOnly,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCreator, msgData.Creator.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, msgData.Symbol.String()),
+			sdk.NewAttribute(types.AttributeKeyAllowListOnly, strconv.FormatBool(msgData.AllowListOnly)),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgAddToAllowList Handle Msg add accounts to the allow list of coin by the creator
+func handleMsgAddToAllowList(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgAddToAllowList) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg add to allow list data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle add to allow list",
+		"creator", msgData.Creator,
+		"symbol", msgData.Symbol,
+		"accounts", msgData.Accounts)
+
+	ctx.RequireAccount(msgData.Creator)
+
+	if err := k.AddToAllowList(ctx.Context(), msgData.Creator, msgData.Symbol, msgData.Accounts...); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg add to allow list %s", msgData.Symbol)
+	}
+
+	emitCoinAccountEvents(ctx.Context(), types.EventTypeAddToAllowList, msgData.Creator, msgData.Symbol, msgData.Accounts)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgRemoveFromAllowList Handle Msg remove accounts from the allow list of coin by the creator
+func handleMsgRemoveFromAllowList(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgRemoveFromAllowList) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg remove from allow list data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle remove from allow list",
+		"creator", msgData.Creator,
+		"symbol", msgData.Symbol,
+		"accounts", msgData.Accounts)
+
+	ctx.RequireAccount(msgData.Creator)
+
+	if err := k.RemoveFromAllowList(ctx.Context(), msgData.Creator, msgData.Symbol, msgData.Accounts...); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg remove from allow list %s", msgData.Symbol)
+	}
+
+	emitCoinAccountEvents(ctx.Context(), types.EventTypeRemoveFromAllowList, msgData.Creator, msgData.Symbol, msgData.Accounts)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func emitCoinAccountEvents(ctx sdk.Context, eventType string, creator, symbol chainTypes.Name, accounts []chainTypes.AccountID) {
+	for _, account := range accounts {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+				sdk.NewAttribute(types.AttributeKeySymbol, symbol.String()),
+				sdk.NewAttribute(types.AttributeKeyAccount, account.String()),
+			),
+		)
+	}
+}
+
+// handleMsgCreateClawbackGrant Handle Msg create the clawback grant, the grantee signs the msg to consent the clawback
+func handleMsgCreateClawbackGrant(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgCreateClawbackGrant) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg create clawback grant data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle create clawback grant",
+		"funder", msgData.Funder,
+		"grantee", msgData.Grantee,
+		"amount", msgData.Amount,
+		"height", msgData.UnlockBlockHeight)
+
+	ctx.RequireAuth(msgData.Funder, msgData.Grantee)
+
+	// the grant coins should be transferred from funder to grantee by the msg
+	if !msg.GetFrom().Eq(msgData.Funder) || !msg.GetTo().Eq(msgData.Grantee) || !msg.GetAmount().IsEqual(msgData.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrAssetClawbackGrantFunds, "grant %s not transferred", msgData.Amount)
+	}
+
+	grant, err := k.CreateClawbackGrant(ctx.Context(), msgData.Funder, msgData.Grantee, msgData.Amount, msgData.UnlockBlockHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg create clawback grant %s", msgData.Grantee)
+	}
+
+	emitClawbackEvent(ctx.Context(), types.EventTypeCreateClawbackGrant, grant)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgClawback Handle Msg claw back the unvested coins of the grant account by the funder
+func handleMsgClawback(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgClawback) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg clawback data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle clawback",
+		"funder", msgData.Funder,
+		"grantee", msgData.Grantee)
+
+	ctx.RequireAuth(msgData.Funder)
+
+	grant, err := k.Clawback(ctx.Context(), msgData.Funder, msgData.Grantee)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg clawback %s", msgData.Grantee)
+	}
+
+	emitClawbackEvent(ctx.Context(), types.EventTypeClawback, grant)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func emitClawbackEvent(ctx sdk.Context, eventType string, grant types.ClawbackGrant) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyFunder, grant.Funder.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, grant.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockHeight, strconv.FormatInt(grant.UnlockBlockHeight, 10)),
+		),
+	)
+}
+
+// handleMsgFreeze Handle Msg freeze the accounts of the freezable coin by the creator
+func handleMsgFreeze(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgFreeze) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg freeze data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle freeze",
+		"creator", msgData.Creator,
+		"symbol", msgData.Symbol,
+		"accounts", msgData.Accounts)
+
+	ctx.RequireAccount(msgData.Creator)
+
+	if err := k.FreezeAccounts(ctx.Context(), msgData.Creator, msgData.Symbol, msgData.Accounts...); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg freeze %s", msgData.Symbol)
+	}
+
+	emitCoinAccountEvents(ctx.Context(), types.EventTypeFreeze, msgData.Creator, msgData.Symbol, msgData.Accounts)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgUnfreeze Handle Msg unfreeze the frozen accounts of the coin by the creator
+func handleMsgUnfreeze(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgUnfreeze) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg unfreeze data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle unfreeze",
+		"creator", msgData.Creator,
+		"symbol", msgData.Symbol,
+		"accounts", msgData.Accounts)
+
+	ctx.RequireAccount(msgData.Creator)
+
+	if err := k.UnfreezeAccounts(ctx.Context(), msgData.Creator, msgData.Symbol, msgData.Accounts...); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg unfreeze %s", msgData.Symbol)
+	}
+
+	emitCoinAccountEvents(ctx.Context(), types.EventTypeUnfreeze, msgData.Creator, msgData.Symbol, msgData.Accounts)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgSetTransfersPaused Handle Msg pause or resume the transfers of the freezable coin by the creator
+func handleMsgSetTransfersPaused(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgSetTransfersPaused) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg set transfers paused data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle set transfers paused",
+		"creator", msgData.Creator,
+		"symbol", msgData.Symbol,
+		"paused", msgData.Paused)
+
+	ctx.RequireAccount(msgData.Creator)
+
+	if err := k.SetTransfersPaused(ctx.Context(), msgData.Creator, msgData.Symbol, msgData.Paused); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg set transfers paused %s", msgData.Symbol)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetTransfersPaused,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCreator, msgData.Creator.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, msgData.Symbol.String()),
+			sdk.NewAttribute(types.AttributeKeyPaused, strconv.FormatBool(msgData.Paused)),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgCreateNFTCollection Handle Msg create the collection of the non-fungible tokens
+func handleMsgCreateNFTCollection(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgCreateNFTCollection) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg create nft collection data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle create nft collection",
+		"creator", msgData.Creator,
+		"name", msgData.Name)
+
+	ctx.RequireAccount(msgData.Creator)
+
+	if _, err := k.CreateNFTCollection(ctx.Context(), msgData.Creator, msgData.Name, msgData.Description); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg create nft collection %s", msgData.Name)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateNFTCollection,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCreator, msgData.Creator.String()),
+			sdk.NewAttribute(types.AttributeKeyCollection, msgData.Name.String()),
+			sdk.NewAttribute(types.AttributeKeyDescription, msgData.Description),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgMintNFT Handle Msg mint the token in the collection by the creator
+func handleMsgMintNFT(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgMintNFT) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg mint nft data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle mint nft",
+		"creator", msgData.Creator,
+		"collection", msgData.Collection,
+		"id", msgData.ID,
+		"owner", msgData.Owner)
+
+	ctx.RequireAccount(msgData.Creator)
+
+	if err := k.MintNFT(ctx.Context(), msgData.NFT()); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg mint nft %s", msgData.ID)
+	}
+
+	emitNFTEvent(ctx.Context(), types.EventTypeMintNFT, msgData.NFT())
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgTransferNFT Handle Msg transfer the token by the owner
+func handleMsgTransferNFT(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgTransferNFT) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg transfer nft data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle transfer nft",
+		"from", msgData.From,
+		"to", msgData.To,
+		"creator", msgData.Creator,
+		"collection", msgData.Collection,
+		"id", msgData.ID)
+
+	ctx.RequireAuth(msgData.From)
+
+	token, err := k.TransferNFT(ctx.Context(), msgData.From, msgData.To, msgData.Creator, msgData.Collection, msgData.ID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg transfer nft %s", msgData.ID)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferNFT,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCreator, token.Creator.String()),
+			sdk.NewAttribute(types.AttributeKeyCollection, token.Collection.String()),
+			sdk.NewAttribute(types.AttributeKeyNFTID, token.ID),
+			sdk.NewAttribute(types.AttributeKeyFrom, msgData.From.String()),
+			sdk.NewAttribute(types.AttributeKeyTo, msgData.To.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgBurnNFT Handle Msg burn the token by the owner
+func handleMsgBurnNFT(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgBurnNFT) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg burn nft data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle burn nft",
+		"owner", msgData.Owner,
+		"creator", msgData.Creator,
+		"collection", msgData.Collection,
+		"id", msgData.ID)
+
+	ctx.RequireAuth(msgData.Owner)
+
+	token, err := k.BurnNFT(ctx.Context(), msgData.Owner, msgData.Creator, msgData.Collection, msgData.ID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg burn nft %s", msgData.ID)
+	}
+
+	emitNFTEvent(ctx.Context(), types.EventTypeBurnNFT, token)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgEditNFT Handle Msg edit the mutable metadata of the token by the creator of the collection
+func handleMsgEditNFT(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgEditNFT) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg edit nft data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle edit nft",
+		"creator", msgData.Creator,
+		"collection", msgData.Collection,
+		"id", msgData.ID)
+
+	ctx.RequireAccount(msgData.Creator)
+
+	token, err := k.EditNFT(ctx.Context(), msgData.Creator, msgData.Collection, msgData.ID, msgData.Metadata)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg edit nft %s", msgData.ID)
+	}
+
+	emitNFTEvent(ctx.Context(), types.EventTypeEditNFT, token)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func emitNFTEvent(ctx sdk.Context, eventType string, token types.NFT) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCreator, token.Creator.String()),
+			sdk.NewAttribute(types.AttributeKeyCollection, token.Collection.String()),
+			sdk.NewAttribute(types.AttributeKeyNFTID, token.ID),
+			sdk.NewAttribute(types.AttributeKeyOwner, token.Owner.String()),
+		),
+	)
+}
+
+// handleMsgTransferWithLock Handle Msg transfer the coins locked in the receiver by the vesting schedule
+func handleMsgTransferWithLock(ctx chainTypes.Context, k keeper.AssetCoinsKeeper, msg *types.MsgTransferWithLock) (*sdk.Result, error) {
+	msgData, err := msg.GetData()
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg transfer with lock data unmarshal error")
+	}
+
+	ctx.Logger().Debug("handle transfer with lock",
+		"from", msgData.From,
+		"to", msgData.To,
+		"amount", msgData.Amount,
+		"cliff", msgData.CliffHeight,
+		"end", msgData.EndHeight)
+
+	ctx.RequireAuth(msgData.From)
+
+	// the coins should be transferred from the account to the receiver by the msg
+	if !msg.GetFrom().Eq(msgData.From) || !msg.GetTo().Eq(msgData.To) || !msg.GetAmount().IsEqual(msgData.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrAssetTransferLock, "%s not transferred", msgData.Amount)
+	}
+
+	if _, err := k.CreateTransferLocks(ctx.Context(), msgData.From, msgData.To, msgData.Amount, msgData.CliffHeight, msgData.EndHeight); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg transfer with lock to %s", msgData.To)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferWithLock,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyFrom, msgData.From.String()),
+			sdk.NewAttribute(types.AttributeKeyTo, msgData.To.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msgData.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCliffHeight, strconv.FormatInt(msgData.CliffHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(msgData.EndHeight, 10)),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
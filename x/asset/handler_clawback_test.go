@@ -0,0 +1,95 @@
+package asset_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+)
+
+func createClawbackGrant(t *testing.T, app *simapp.SimApp, isSuccess bool, funder, grantee types.AccountID,
+	amt types.Coins, unlockBlockHeight int64, signers ...types.AccAddress) error {
+	ctx := app.NewTestContext()
+
+	funderAuth := app.AccountKeeper().GetAccount(ctx, funder).GetAuth()
+	granteeAuth := app.AccountKeeper().GetAccount(ctx, grantee).GetAuth()
+
+	privs := make([]crypto.PrivKey, 0, len(signers))
+	for _, signer := range signers {
+		privs = append(privs, wallet.PrivKey(signer))
+	}
+
+	msg := assetTypes.NewMsgCreateClawbackGrant(funderAuth, granteeAuth, funder, grantee, amt, unlockBlockHeight)
+	tx := simapp.NewTxForTest(funder, []sdk.Msg{&msg}, privs...)
+
+	if !isSuccess {
+		tx = tx.WithCannotPass()
+	}
+
+	return simapp.CheckTxs(t, app, ctx, tx)
+}
+
+func clawback(t *testing.T, app *simapp.SimApp, isSuccess bool, funder, grantee types.AccountID) error {
+	ctx := app.NewTestContext()
+	auth := app.AccountKeeper().GetAccount(ctx, funder).GetAuth()
+
+	msg := assetTypes.NewMsgClawback(auth, funder, grantee)
+	tx := simapp.NewTxForTest(funder, []sdk.Msg{&msg}, wallet.PrivKey(auth))
+	if !isSuccess {
+		tx = tx.WithCannotPass()
+	}
+
+	return simapp.CheckTxs(t, app, ctx, tx)
+}
+
+func TestClawback(t *testing.T) {
+	app, _ := createAppForTest()
+
+	Convey("test clawback grant account", t, func() {
+		amt := types.NewCoins(types.NewInt64Coin(constants.DefaultBondDenom, 1000000))
+		unlockBlockHeight := app.LastBlockHeight() + 100
+
+		// the grantee should sign to consent the clawback
+		err := createClawbackGrant(t, app, false, account1, account4, amt, unlockBlockHeight, addr1)
+		So(err, simapp.ShouldErrIs, types.ErrUnauthorized)
+
+		So(createClawbackGrant(t, app, true, account1, account4, amt, unlockBlockHeight, addr1, addr4), ShouldBeNil)
+
+		ctx := app.NewTestContext()
+		grant, found := app.AssetKeeper().GetClawbackGrant(ctx, account4)
+		So(found, ShouldBeTrue)
+		So(grant.Funder, ShouldResemble, account1)
+		So(grant.Amount, simapp.ShouldEq, amt)
+
+		locked, _, err := app.AssetKeeper().GetLockCoins(ctx, account4)
+		So(err, ShouldBeNil)
+		So(locked, simapp.ShouldEq, amt)
+
+		// only one unvested grant for an account
+		err = createClawbackGrant(t, app, false, account5, account4, amt, unlockBlockHeight, addr5, addr4)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetClawbackGrantExists)
+
+		// only the funder can claw back
+		So(clawback(t, app, false, account5, account4), simapp.ShouldErrIs, assetTypes.ErrAssetClawbackFunder)
+
+		granteeCoins := app.AssetKeeper().GetAllBalances(app.NewTestContext(), account4)
+		So(clawback(t, app, true, account1, account4), ShouldBeNil)
+
+		ctx = app.NewTestContext()
+		_, found = app.AssetKeeper().GetClawbackGrant(ctx, account4)
+		So(found, ShouldBeFalse)
+
+		locked, _, err = app.AssetKeeper().GetLockCoins(ctx, account4)
+		So(err, ShouldBeNil)
+		So(locked.IsZero(), ShouldBeTrue)
+		So(app.AssetKeeper().GetAllBalances(ctx, account4), simapp.ShouldEq, granteeCoins.Sub(amt))
+
+		So(clawback(t, app, false, account1, account4), simapp.ShouldErrIs, assetTypes.ErrAssetClawbackGrantNotFound)
+	})
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/account/exported"
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountStatKeeper is the interface to get the accounts for the asset invariants
+type AccountStatKeeper interface {
+	GetAccount(sdk.Context, types.AccountID) exported.Account // can return nil.
+}
+
+// RegisterInvariants registers all asset invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k AssetKeeper, ak AccountStatKeeper) {
+	ir.RegisterRoute(types.ModuleName, "coin-creators", CoinCreatorsInvariant(k, ak))
+}
+
+// AllInvariants runs all invariants of the asset module
+func AllInvariants(k AssetKeeper, ak AccountStatKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return CoinCreatorsInvariant(k, ak)(ctx)
+	}
+}
+
+// CoinCreatorsInvariant checks that the creator account of every issued coin exists,
+// the coins without creator are not checked.
+func CoinCreatorsInvariant(k AssetKeeper, ak AccountStatKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.GetKeyPrefix(types.CoinStatStoreKeyPrefix))
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			var stat types.CoinStat
+			k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stat)
+
+			if stat.Creator.Empty() {
+				continue
+			}
+
+			if ak.GetAccount(ctx, types.NewAccountIDFromName(stat.Creator)) == nil {
+				count++
+				msg += fmt.Sprintf("\tcoin %s/%s references a missing creator account\n", stat.Creator, stat.Symbol)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "coin creators", fmt.Sprintf(
+			"%d coins of missing creators found\n%s", count, msg)), count != 0
+	}
+}
@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/asset/keeper"
+)
+
+func TestCoinCreatorsInvariant(t *testing.T) {
+	Convey("TestCoinCreatorsInvariant", t, func() {
+		app, ctx := createTestApp()
+		invariant := keeper.CoinCreatorsInvariant(*app.AssetKeeper(), app.AccountKeeper())
+
+		_, broken := invariant(ctx)
+		So(broken, ShouldBeFalse)
+
+		symbol := types.MustName("abc")
+		maxSupply := types.NewInt64Coin(types.CoinDenom(constants.SystemAccount, symbol), 10000000)
+		So(app.AssetKeeper().Create(ctx, constants.SystemAccount, symbol, maxSupply, true, true, 0, types.NewInt64Coin(maxSupply.Denom, 0), nil), ShouldBeNil)
+		_, broken = invariant(ctx)
+		So(broken, ShouldBeFalse)
+
+		// the creator account is missing
+		maxSupply = types.NewInt64Coin(types.CoinDenom(name2, symbol), 10000000)
+		So(app.AssetKeeper().Create(ctx, name2, symbol, maxSupply, true, true, 0, types.NewInt64Coin(maxSupply.Denom, 0), nil), ShouldBeNil)
+		msg, broken := invariant(ctx)
+		So(broken, ShouldBeTrue)
+		So(msg, ShouldContainSubstring, "1 coins of missing creators found")
+	})
+}
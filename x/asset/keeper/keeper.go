@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"github.com/KuChainNetwork/kuchain/chain/types/coin"
+	"github.com/KuChainNetwork/kuchain/x/account/exported"
 	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	"github.com/KuChainNetwork/kuchain/x/params"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -21,6 +23,70 @@ type AssetCoinsKeeper interface {
 	Burn(ctx sdk.Context, id types.AccountID, amt types.Coin) error
 	LockCoins(ctx sdk.Context, account types.AccountID, unlockBlockHeight int64, coins types.Coins) error
 	UnLockCoins(ctx sdk.Context, account types.AccountID, coins types.Coins) error
+
+	AssetIssuanceKeeper
+	AssetAllowListKeeper
+	AssetClawbackKeeper
+	AssetEmissionKeeper
+	AssetFreezeKeeper
+	AssetNFTKeeper
+	AssetTransferLockKeeper
+}
+
+// AssetIssuanceKeeper keeper interface for the coin creations need approval
+type AssetIssuanceKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	SubmitIssuance(ctx sdk.Context, coin types.MsgCreateCoinData) (uint64, error)
+	ApproveIssuance(ctx sdk.Context, id uint64) (types.PendingIssuance, error)
+	RejectIssuance(ctx sdk.Context, id uint64) (types.PendingIssuance, error)
+}
+
+// AssetAllowListKeeper keeper interface for the allow lists of the allow-list-only coins
+type AssetAllowListKeeper interface {
+	SetAllowListOnly(ctx sdk.Context, creator, symbol types.Name, allowListOnly bool) error
+	AddToAllowList(ctx sdk.Context, creator, symbol types.Name, accounts ...types.AccountID) error
+	RemoveFromAllowList(ctx sdk.Context, creator, symbol types.Name, accounts ...types.AccountID) error
+}
+
+// AssetClawbackKeeper keeper interface for the grant accounts which the funders can claw back
+type AssetClawbackKeeper interface {
+	CreateClawbackGrant(ctx sdk.Context, funder, grantee types.AccountID, amount types.Coins, unlockBlockHeight int64) (types.ClawbackGrant, error)
+	Clawback(ctx sdk.Context, funder, grantee types.AccountID) (types.ClawbackGrant, error)
+	SetClawbackGrant(ctx sdk.Context, grant types.ClawbackGrant)
+}
+
+// AssetEmissionKeeper keeper interface for the emission schedules of the coins
+type AssetEmissionKeeper interface {
+	CreateEmission(ctx sdk.Context, creator, symbol types.Name, schedule types.EmissionSchedule) (types.CoinEmission, error)
+	EmitCoins(ctx sdk.Context) ([]types.CoinEmission, []types.Coin, error)
+	SetCoinEmission(ctx sdk.Context, emission types.CoinEmission)
+}
+
+// AssetFreezeKeeper keeper interface for the freeze controls of the freezable coins
+type AssetFreezeKeeper interface {
+	EnableFreeze(ctx sdk.Context, creator, symbol types.Name) error
+	FreezeAccounts(ctx sdk.Context, creator, symbol types.Name, accounts ...types.AccountID) error
+	UnfreezeAccounts(ctx sdk.Context, creator, symbol types.Name, accounts ...types.AccountID) error
+	SetTransfersPaused(ctx sdk.Context, creator, symbol types.Name, paused bool) error
+	InitCoinFreeze(ctx sdk.Context, freeze types.CoinFreeze) error
+}
+
+// AssetNFTKeeper keeper interface for the collections of the non-fungible tokens
+type AssetNFTKeeper interface {
+	CreateNFTCollection(ctx sdk.Context, creator, name types.Name, description string) (types.NFTCollection, error)
+	MintNFT(ctx sdk.Context, token types.NFT) error
+	TransferNFT(ctx sdk.Context, from, to types.AccountID, creator, collection types.Name, id string) (types.NFT, error)
+	BurnNFT(ctx sdk.Context, owner types.AccountID, creator, collection types.Name, id string) (types.NFT, error)
+	EditNFT(ctx sdk.Context, creator, collection types.Name, id, metadata string) (types.NFT, error)
+	SetNFTCollection(ctx sdk.Context, collection types.NFTCollection)
+	SetNFT(ctx sdk.Context, token types.NFT)
+}
+
+// AssetTransferLockKeeper keeper interface for the coins transferred locked by the vesting schedules
+type AssetTransferLockKeeper interface {
+	CreateTransferLocks(ctx sdk.Context, from, to types.AccountID, amount types.Coins, cliffHeight, endHeight int64) ([]types.TransferLock, error)
+	UnlockTransferLocks(ctx sdk.Context) ([]types.AccountID, []types.Coin)
+	InitTransferLocks(ctx sdk.Context, locks types.AccountTransferLocks) error
 }
 
 // AssetViewKeeper keeper view interface for asset module
@@ -34,10 +100,30 @@ type AssetViewKeeper interface {
 	GetCoinDesc(ctx sdk.Context, creator, symbol types.Name) (*types.CoinDescription, error)
 	GetCoinStat(ctx sdk.Context, creator, symbol types.Name) (*types.CoinStat, error)
 	GetLockCoins(ctx sdk.Context, account types.AccountID) (types.Coins, []LockedCoins, error)
+	GetParams(ctx sdk.Context) types.Params
+	GetPendingIssuance(ctx sdk.Context, id uint64) (types.PendingIssuance, bool)
+	GetPendingIssuances(ctx sdk.Context) []types.PendingIssuance
+	GetAllowList(ctx sdk.Context, creator, symbol types.Name) []types.AccountID
+	IsInAllowList(ctx sdk.Context, creator, symbol types.Name, account types.AccountID) bool
+	GetClawbackGrant(ctx sdk.Context, grantee types.AccountID) (types.ClawbackGrant, bool)
+	GetCoinEmission(ctx sdk.Context, creator, symbol types.Name) (types.CoinEmission, bool)
+	GetCoinEmissions(ctx sdk.Context) []types.CoinEmission
+	IsFrozen(ctx sdk.Context, creator, symbol types.Name, account types.AccountID) bool
+	GetCoinFreeze(ctx sdk.Context, creator, symbol types.Name) (types.CoinFreeze, error)
+	GetCoinFreezes(ctx sdk.Context) []types.CoinFreeze
+	GetNFTCollection(ctx sdk.Context, creator, name types.Name) (types.NFTCollection, bool)
+	GetNFTCollections(ctx sdk.Context, creator types.Name) []types.NFTCollection
+	GetNFT(ctx sdk.Context, creator, collection types.Name, id string) (types.NFT, bool)
+	GetNFTs(ctx sdk.Context, creator, collection types.Name, page, limit int) types.NFTs
+	GetNFTsByOwner(ctx sdk.Context, owner types.AccountID, page, limit int) types.NFTs
+	GetTransferLocks(ctx sdk.Context, account types.AccountID, denom string) (types.AccountTransferLocks, bool)
+	GetAccountTransferLocks(ctx sdk.Context, account types.AccountID) []types.AccountTransferLocks
+	GetAllTransferLocks(ctx sdk.Context) []types.AccountTransferLocks
 }
 
 type AccountEnsurer interface {
 	EnsureAccount(ctx sdk.Context, account types.AccountID) error
+	GetAccount(ctx sdk.Context, account types.AccountID) exported.Account // can return nil.
 }
 
 // AssetKeeper for asset state
@@ -48,6 +134,9 @@ type AssetKeeper struct {
 	// The codec codec for binary encoding/decoding of accounts.
 	cdc *codec.Codec
 
+	// The params subspace for asset params
+	paramSpace params.Subspace
+
 	// AccountKeeper interface
 	ak AccountEnsurer
 }
@@ -55,11 +144,12 @@ type AssetKeeper struct {
 var _ AssetCoinsKeeper = AssetKeeper{}
 
 // NewAssetKeeper new asset keeper
-func NewAssetKeeper(cdc *codec.Codec, key sdk.StoreKey, ak AccountEnsurer) AssetKeeper {
+func NewAssetKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, ak AccountEnsurer) AssetKeeper {
 	return AssetKeeper{
-		key: key,
-		cdc: cdc,
-		ak:  ak,
+		key:        key,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		ak:         ak,
 	}
 }
 
@@ -108,6 +198,10 @@ func (a AssetKeeper) Create(ctx sdk.Context, creator, symbol types.Name, max_sup
 }
 
 func (a AssetKeeper) Issue(ctx sdk.Context, creator, symbol types.Name, amount types.Coin) error {
+	if err := a.checkEmissionReserved(ctx, creator, symbol, amount); err != nil {
+		return err
+	}
+
 	if err := a.issueCoinStat(ctx, amount); err != nil {
 		return err
 	}
@@ -191,6 +285,10 @@ func (a AssetKeeper) Transfer(ctx sdk.Context, from, to types.AccountID, amount
 		return sdkerrors.Wrap(err, "transfer")
 	}
 
+	if err := a.checkTransferable(ctx, from, to, amount); err != nil {
+		return sdkerrors.Wrap(err, "transfer")
+	}
+
 	if err := a.setCoins(ctx, to, toCoins.Add(amount...)); err != nil {
 		return sdkerrors.Wrap(err, "set to coins")
 	}
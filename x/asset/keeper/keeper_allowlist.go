@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetAllowListOnly marks the coin as allow-list-only, so the coin only can be transferred between the accounts in allow list
+func (a AssetKeeper) SetAllowListOnly(ctx sdk.Context, creator, symbol types.Name, allowListOnly bool) error {
+	stat, err := a.getStat(ctx, creator, symbol)
+	if err != nil {
+		return err
+	}
+
+	stat.AllowListOnly = allowListOnly
+
+	return a.setStat(ctx, stat)
+}
+
+// AddToAllowList adds the accounts to the allow list of the coin
+func (a AssetKeeper) AddToAllowList(ctx sdk.Context, creator, symbol types.Name, accounts ...types.AccountID) error {
+	if _, err := a.getStat(ctx, creator, symbol); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(a.key)
+	for _, account := range accounts {
+		store.Set(types.CoinAllowListStoreKey(creator, symbol, account), a.cdc.MustMarshalBinaryBare(account))
+	}
+
+	return nil
+}
+
+// RemoveFromAllowList removes the accounts from the allow list of the coin
+func (a AssetKeeper) RemoveFromAllowList(ctx sdk.Context, creator, symbol types.Name, accounts ...types.AccountID) error {
+	if _, err := a.getStat(ctx, creator, symbol); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(a.key)
+	for _, account := range accounts {
+		key := types.CoinAllowListStoreKey(creator, symbol, account)
+		if !store.Has(key) {
+			return sdkerrors.Wrapf(types.ErrAssetNotInAllowList, "account %s", account)
+		}
+		store.Delete(key)
+	}
+
+	return nil
+}
+
+// IsInAllowList returns if the account is in the allow list of the coin
+func (a AssetKeeper) IsInAllowList(ctx sdk.Context, creator, symbol types.Name, account types.AccountID) bool {
+	return ctx.KVStore(a.key).Has(types.CoinAllowListStoreKey(creator, symbol, account))
+}
+
+// GetAllowList returns all the accounts in the allow list of the coin
+func (a AssetKeeper) GetAllowList(ctx sdk.Context, creator, symbol types.Name) []types.AccountID {
+	res := make([]types.AccountID, 0)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(a.key), types.CoinAllowListPrefix(creator, symbol))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var account types.AccountID
+		a.cdc.MustUnmarshalBinaryBare(iterator.Value(), &account)
+		res = append(res, account)
+	}
+
+	return res
+}
+
+// GetCoinAllowLists returns the allow lists of all the coins which are allow-list-only or have accounts in allow list
+func (a AssetKeeper) GetCoinAllowLists(ctx sdk.Context) []types.CoinAllowList {
+	res := make([]types.CoinAllowList, 0)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(a.key), types.GetKeyPrefix(types.CoinStatStoreKeyPrefix))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stat types.CoinStat
+		a.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stat)
+
+		accounts := a.GetAllowList(ctx, stat.Creator, stat.Symbol)
+		if stat.AllowListOnly || len(accounts) > 0 {
+			res = append(res, types.NewCoinAllowList(stat.Creator, stat.Symbol, stat.AllowListOnly, accounts))
+		}
+	}
+
+	return res
+}
+
+// checkTransferable checks the transfer controls set by the creators of the coins to transfer,
+// the allow lists of the allow-list-only coins and the freeze states of the freezable coins.
+func (a AssetKeeper) checkTransferable(ctx sdk.Context, from, to types.AccountID, amount types.Coins) error {
+	for _, c := range amount {
+		creator, symbol, err := types.CoinAccountsFromDenom(c.Denom)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "get creator and symbol from coin %s", c.Denom)
+		}
+
+		stat, err := a.getStat(ctx, creator, symbol)
+		if err != nil {
+			continue
+		}
+
+		if err := a.checkAllowList(ctx, stat, from, to); err != nil {
+			return err
+		}
+
+		if err := a.checkFreeze(ctx, stat, from); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkAllowList checks both the accounts are in the allow list of the allow-list-only coin to transfer,
+// the creator of the coin is always allowed.
+func (a AssetKeeper) checkAllowList(ctx sdk.Context, stat *types.CoinStat, from, to types.AccountID) error {
+	if !stat.AllowListOnly {
+		return nil
+	}
+
+	creatorID := types.NewAccountIDFromName(stat.Creator)
+	for _, id := range []types.AccountID{from, to} {
+		if !id.Eq(creatorID) && !a.IsInAllowList(ctx, stat.Creator, stat.Symbol, id) {
+			return sdkerrors.Wrapf(types.ErrAssetNotInAllowList, "account %s for coin %s", id, stat.MaxSupply.Denom)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+)
+
+func TestAssetAllowList(t *testing.T) {
+	app, ctx := createTestApp()
+	keeper := app.AssetKeeper()
+
+	symbol := types.MustName("abc")
+	denom := types.CoinDenom(name2, symbol)
+	amt := types.NewCoins(types.NewInt64Coin(denom, 100))
+	holder := types.NewAccountIDFromAccAdd(wallet.NewAccAddress())
+
+	Convey("test allow list transfer", t, func() {
+		So(keeper.Create(ctx, name2, symbol, types.NewInt64Coin(denom, 10000000),
+			true, true, 0, types.NewInt64Coin(denom, 0), []byte{}), ShouldBeNil)
+		So(keeper.Issue(ctx, name2, symbol, types.NewInt64Coin(denom, 10000)), ShouldBeNil)
+
+		// transfer is not limited before allow-list-only
+		So(keeper.Transfer(ctx, account2, account1, amt), ShouldBeNil)
+
+		So(keeper.SetAllowListOnly(ctx, name2, symbol, true), ShouldBeNil)
+
+		// the creator always can transfer, but the receiver should be in allow list
+		err := keeper.Transfer(ctx, account2, holder, amt)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetNotInAllowList)
+
+		So(keeper.AddToAllowList(ctx, name2, symbol, holder), ShouldBeNil)
+		So(keeper.Transfer(ctx, account2, holder, amt), ShouldBeNil)
+
+		// both accounts need to be in allow list
+		err = keeper.Transfer(ctx, account1, holder, amt)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetNotInAllowList)
+
+		So(keeper.AddToAllowList(ctx, name2, symbol, account1), ShouldBeNil)
+		So(keeper.Transfer(ctx, account1, holder, amt), ShouldBeNil)
+
+		So(keeper.IsInAllowList(ctx, name2, symbol, account1), ShouldBeTrue)
+		So(len(keeper.GetAllowList(ctx, name2, symbol)), ShouldEqual, 2)
+
+		So(keeper.RemoveFromAllowList(ctx, name2, symbol, account1), ShouldBeNil)
+		err = keeper.Transfer(ctx, holder, account1, amt)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetNotInAllowList)
+
+		err = keeper.RemoveFromAllowList(ctx, name2, symbol, account1)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetNotInAllowList)
+
+		allowLists := keeper.GetCoinAllowLists(ctx)
+		So(len(allowLists), ShouldEqual, 1)
+		So(allowLists[0].AllowListOnly, ShouldBeTrue)
+		So(allowLists[0].Accounts[0], ShouldResemble, holder)
+
+		// transfer is not limited after disabled
+		So(keeper.SetAllowListOnly(ctx, name2, symbol, false), ShouldBeNil)
+		So(keeper.Transfer(ctx, holder, account1, amt), ShouldBeNil)
+	})
+
+	Convey("test pay fee by allow-list-only coin", t, func() {
+		So(keeper.SetAllowListOnly(ctx, name2, symbol, true), ShouldBeNil)
+
+		// the coins cannot be spent by fees if the accounts not in allow list
+		err := keeper.PayFee(ctx, account1, amt)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetNotInAllowList)
+
+		So(keeper.AddToAllowList(ctx, name2, symbol, account1), ShouldBeNil)
+		err = keeper.PayFee(ctx, account1, amt)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetNotInAllowList)
+
+		So(keeper.AddToAllowList(ctx, name2, symbol, constants.GetFeeCollector()), ShouldBeNil)
+		So(keeper.PayFee(ctx, account1, amt), ShouldBeNil)
+
+		So(keeper.SetAllowListOnly(ctx, name2, symbol, false), ShouldBeNil)
+	})
+
+	Convey("test allow list of coin not exist", t, func() {
+		err := keeper.AddToAllowList(ctx, name2, types.MustName("noexit"), account1)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetCoinNoExit)
+	})
+}
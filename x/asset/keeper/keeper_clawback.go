@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateClawbackGrant locks the coins granted by the funder in the grantee account until the unlock block height,
+// the coins should have been transferred to the grantee, the funder can claw back them before unlocked.
+func (a AssetKeeper) CreateClawbackGrant(ctx sdk.Context, funder, grantee types.AccountID, amount types.Coins, unlockBlockHeight int64) (types.ClawbackGrant, error) {
+	if a.GetParams(ctx).ClawbackDisabled {
+		return types.ClawbackGrant{}, types.ErrAssetClawbackDisabled
+	}
+
+	if grant, found := a.GetClawbackGrant(ctx, grantee); found && !grant.IsVested(ctx.BlockHeight()) {
+		return types.ClawbackGrant{}, sdkerrors.Wrapf(types.ErrAssetClawbackGrantExists, "grantee %s", grantee)
+	}
+
+	if err := a.LockCoins(ctx, grantee, unlockBlockHeight, amount); err != nil {
+		return types.ClawbackGrant{}, sdkerrors.Wrap(err, "lock grant coins")
+	}
+
+	grant := types.NewClawbackGrant(grantee, funder, amount, unlockBlockHeight, ctx.BlockHeight())
+	a.SetClawbackGrant(ctx, grant)
+
+	return grant, nil
+}
+
+// Clawback returns the unvested coins of the grant account to the funder and removes the grant
+func (a AssetKeeper) Clawback(ctx sdk.Context, funder, grantee types.AccountID) (types.ClawbackGrant, error) {
+	if a.GetParams(ctx).ClawbackDisabled {
+		return types.ClawbackGrant{}, types.ErrAssetClawbackDisabled
+	}
+
+	grant, found := a.GetClawbackGrant(ctx, grantee)
+	if !found {
+		return types.ClawbackGrant{}, sdkerrors.Wrapf(types.ErrAssetClawbackGrantNotFound, "grantee %s", grantee)
+	}
+
+	if !grant.Funder.Eq(funder) {
+		return types.ClawbackGrant{}, sdkerrors.Wrapf(types.ErrAssetClawbackFunder, "funder %s", funder)
+	}
+
+	if grant.IsVested(ctx.BlockHeight()) {
+		return types.ClawbackGrant{}, sdkerrors.Wrapf(types.ErrAssetClawbackGrantVested, "unlocked at %d", grant.UnlockBlockHeight)
+	}
+
+	if err := a.removeLockedCoins(ctx, grantee, grant.UnlockBlockHeight, grant.Amount); err != nil {
+		return types.ClawbackGrant{}, sdkerrors.Wrap(err, "clawback")
+	}
+
+	if err := a.Transfer(ctx, grantee, funder, grant.Amount); err != nil {
+		return types.ClawbackGrant{}, sdkerrors.Wrap(err, "clawback")
+	}
+
+	a.deleteClawbackGrant(ctx, grantee)
+
+	return grant, nil
+}
+
+// GetClawbackGrant get the clawback grant of the grantee account
+func (a AssetKeeper) GetClawbackGrant(ctx sdk.Context, grantee types.AccountID) (types.ClawbackGrant, bool) {
+	bz := ctx.KVStore(a.key).Get(types.ClawbackGrantStoreKey(grantee))
+	if bz == nil {
+		return types.ClawbackGrant{}, false
+	}
+
+	var grant types.ClawbackGrant
+	a.cdc.MustUnmarshalBinaryBare(bz, &grant)
+
+	return grant, true
+}
+
+// SetClawbackGrant set the clawback grant to store, the grant coins should be locked already
+func (a AssetKeeper) SetClawbackGrant(ctx sdk.Context, grant types.ClawbackGrant) {
+	ctx.KVStore(a.key).Set(types.ClawbackGrantStoreKey(grant.Grantee), a.cdc.MustMarshalBinaryBare(grant))
+}
+
+func (a AssetKeeper) deleteClawbackGrant(ctx sdk.Context, grantee types.AccountID) {
+	ctx.KVStore(a.key).Delete(types.ClawbackGrantStoreKey(grantee))
+}
+
+// GetClawbackGrants returns all the clawback grants
+func (a AssetKeeper) GetClawbackGrants(ctx sdk.Context) []types.ClawbackGrant {
+	res := make([]types.ClawbackGrant, 0)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(a.key), types.GetKeyPrefix(types.ClawbackGrantStoreKeyPrefix))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.ClawbackGrant
+		a.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+		res = append(res, grant)
+	}
+
+	return res
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+)
+
+func TestAssetClawback(t *testing.T) {
+	app, ctx := createTestApp()
+	keeper := app.AssetKeeper()
+
+	amt := types.NewCoins(types.NewInt64Coin(constants.DefaultBondDenom, 100))
+	grantee := types.NewAccountIDFromAccAdd(wallet.NewAccAddress())
+	unlockBlockHeight := ctx.BlockHeight() + 10
+
+	Convey("test clawback grant vested", t, func() {
+		ctx, _ := ctx.CacheContext()
+
+		So(keeper.Transfer(ctx, account1, grantee, amt), ShouldBeNil)
+		_, err := keeper.CreateClawbackGrant(ctx, account1, grantee, amt, unlockBlockHeight)
+		So(err, ShouldBeNil)
+
+		// the grant coins cannot be used before unlocked
+		err = keeper.Transfer(ctx, grantee, account1, amt)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetCoinsLocked)
+
+		_, err = keeper.Clawback(ctx.WithBlockHeight(unlockBlockHeight), account1, grantee)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetClawbackGrantVested)
+
+		// a new grant can be created after the last one vested
+		So(keeper.Transfer(ctx, account1, grantee, amt), ShouldBeNil)
+		_, err = keeper.CreateClawbackGrant(ctx.WithBlockHeight(unlockBlockHeight), account1, grantee, amt, unlockBlockHeight+10)
+		So(err, ShouldBeNil)
+		So(keeper.GetClawbackGrants(ctx), ShouldHaveLength, 1)
+	})
+
+	Convey("test clawback disabled by gov", t, func() {
+		ctx, _ := ctx.CacheContext()
+
+		So(keeper.Transfer(ctx, account1, grantee, amt), ShouldBeNil)
+		_, err := keeper.CreateClawbackGrant(ctx, account1, grantee, amt, unlockBlockHeight)
+		So(err, ShouldBeNil)
+
+		params := keeper.GetParams(ctx)
+		params.ClawbackDisabled = true
+		keeper.SetParams(ctx, params)
+
+		_, err = keeper.Clawback(ctx, account1, grantee)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetClawbackDisabled)
+
+		_, err = keeper.CreateClawbackGrant(ctx, account1, account2, amt, unlockBlockHeight)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetClawbackDisabled)
+
+		params.ClawbackDisabled = false
+		keeper.SetParams(ctx, params)
+
+		_, err = keeper.Clawback(ctx, account1, grantee)
+		So(err, ShouldBeNil)
+		So(keeper.GetAllBalances(ctx, grantee).IsZero(), ShouldBeTrue)
+	})
+}
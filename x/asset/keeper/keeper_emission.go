@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateEmission starts the emission schedule of the coin created in the block, the coins are minted to
+// the recipient in the begin blockers, the remaining coins of the schedule are reserved from the max supply.
+func (a AssetKeeper) CreateEmission(ctx sdk.Context, creator, symbol types.Name, schedule types.EmissionSchedule) (types.CoinEmission, error) {
+	stat, _ := a.getStat(ctx, creator, symbol)
+	if stat == nil {
+		return types.CoinEmission{}, types.ErrAssetCoinNoExit
+	}
+
+	if _, found := a.GetCoinEmission(ctx, creator, symbol); found {
+		return types.CoinEmission{}, sdkerrors.Wrapf(types.ErrAssetEmissionSchedule, "coin %s has emission", stat.MaxSupply.Denom)
+	}
+
+	if stat.IssueToHeight != 0 {
+		return types.CoinEmission{}, sdkerrors.Wrap(types.ErrAssetEmissionSchedule, "issue to height should not be set with emission")
+	}
+
+	if err := schedule.Validate(stat.MaxSupply.Denom); err != nil {
+		return types.CoinEmission{}, err
+	}
+
+	if !stat.MaxSupply.IsGTE(stat.Supply.Add(schedule.Total)) {
+		return types.CoinEmission{}, sdkerrors.Wrapf(types.ErrAssetIssueGTMaxSupply, "emission total %s", schedule.Total)
+	}
+
+	if schedule.Recipient.Empty() {
+		schedule.Recipient = types.NewAccountIDFromName(creator)
+	}
+
+	if err := a.ak.EnsureAccount(ctx, schedule.Recipient); err != nil {
+		return types.CoinEmission{}, sdkerrors.Wrapf(err, "emission recipient %s", schedule.Recipient)
+	}
+
+	emission := types.NewCoinEmission(creator, symbol, ctx.BlockHeight(), schedule)
+	a.SetCoinEmission(ctx, emission)
+
+	return emission, nil
+}
+
+// EmitCoins mints the coins due at the height of the emission schedules to the recipients,
+// the schedules are removed after the total coins minted, returns the emissions minted in the block.
+func (a AssetKeeper) EmitCoins(ctx sdk.Context) ([]types.CoinEmission, []types.Coin, error) {
+	emissions := a.GetCoinEmissions(ctx)
+
+	res := make([]types.CoinEmission, 0, len(emissions))
+	minted := make([]types.Coin, 0, len(emissions))
+	for _, emission := range emissions {
+		due := emission.Due(ctx.BlockHeight())
+		if due.IsZero() {
+			continue
+		}
+
+		if err := a.mintEmission(ctx, emission, due); err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "emission of %s", due.Denom)
+		}
+
+		emission.Emitted = emission.Emitted.Add(due)
+		if emission.IsCompleted() {
+			a.deleteCoinEmission(ctx, emission.Creator, emission.Symbol)
+		} else {
+			a.SetCoinEmission(ctx, emission)
+		}
+
+		res = append(res, emission)
+		minted = append(minted, due)
+	}
+
+	return res, minted, nil
+}
+
+// mintEmission mints the coins to the recipient of the schedule, the coins are reserved in the max supply,
+// so not limited by the issue options of the coin.
+func (a AssetKeeper) mintEmission(ctx sdk.Context, emission types.CoinEmission, amount types.Coin) error {
+	stat, err := a.getStat(ctx, emission.Creator, emission.Symbol)
+	if stat == nil {
+		return types.ErrAssetCoinNoExit
+	}
+	if err != nil {
+		return err
+	}
+
+	stat.Supply = stat.Supply.Add(amount)
+	if !stat.MaxSupply.IsGTE(stat.Supply) {
+		return types.ErrAssetIssueGTMaxSupply
+	}
+
+	if err := a.setStat(ctx, stat); err != nil {
+		return sdkerrors.Wrap(err, "set stat")
+	}
+
+	recipient := emission.Schedule.Recipient
+	coins, err := a.getCoins(ctx, recipient)
+	if err != nil {
+		return sdkerrors.Wrap(err, "get coins")
+	}
+
+	return a.setCoins(ctx, recipient, coins.Add(amount))
+}
+
+// checkEmissionReserved returns error if the amount issued takes the coins reserved by the emission schedule
+func (a AssetKeeper) checkEmissionReserved(ctx sdk.Context, creator, symbol types.Name, amount types.Coin) error {
+	emission, found := a.GetCoinEmission(ctx, creator, symbol)
+	if !found {
+		return nil
+	}
+
+	stat, err := a.GetCoinStat(ctx, creator, symbol)
+	if err != nil {
+		return err
+	}
+
+	if !stat.MaxSupply.IsGTE(stat.Supply.Add(amount).Add(emission.Remaining())) {
+		return sdkerrors.Wrapf(types.ErrAssetIssueGTMaxSupply, "%s reserved by the emission schedule", emission.Remaining())
+	}
+
+	return nil
+}
+
+// GetCoinEmission get the emission schedule of the coin
+func (a AssetKeeper) GetCoinEmission(ctx sdk.Context, creator, symbol types.Name) (types.CoinEmission, bool) {
+	bz := ctx.KVStore(a.key).Get(types.CoinEmissionStoreKey(creator, symbol))
+	if bz == nil {
+		return types.CoinEmission{}, false
+	}
+
+	var emission types.CoinEmission
+	a.cdc.MustUnmarshalBinaryBare(bz, &emission)
+
+	return emission, true
+}
+
+// SetCoinEmission set the emission schedule of the coin to store
+func (a AssetKeeper) SetCoinEmission(ctx sdk.Context, emission types.CoinEmission) {
+	ctx.KVStore(a.key).Set(types.CoinEmissionStoreKey(emission.Creator, emission.Symbol), a.cdc.MustMarshalBinaryBare(emission))
+}
+
+func (a AssetKeeper) deleteCoinEmission(ctx sdk.Context, creator, symbol types.Name) {
+	ctx.KVStore(a.key).Delete(types.CoinEmissionStoreKey(creator, symbol))
+}
+
+// GetCoinEmissions returns all the emission schedules in progress
+func (a AssetKeeper) GetCoinEmissions(ctx sdk.Context) []types.CoinEmission {
+	res := make([]types.CoinEmission, 0)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(a.key), types.GetKeyPrefix(types.CoinEmissionStoreKeyPrefix))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var emission types.CoinEmission
+		a.cdc.MustUnmarshalBinaryBare(iterator.Value(), &emission)
+		res = append(res, emission)
+	}
+
+	return res
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+)
+
+func TestAssetEmission(t *testing.T) {
+	app, ctx := createTestApp()
+	keeper := app.AssetKeeper()
+
+	symbol := types.MustName("emit")
+	denom := types.CoinDenom(name2, symbol)
+	start := ctx.BlockHeight()
+
+	createCoin := func(ctx sdk.Context) {
+		So(keeper.Create(ctx, name2, symbol, types.NewInt64Coin(denom, 2000),
+			true, true, 0, types.NewInt64Coin(denom, 0), []byte{}), ShouldBeNil)
+	}
+
+	getCoin := func(ctx sdk.Context, account types.AccountID) int64 {
+		coin, err := keeper.GetCoin(ctx, account, name2, symbol)
+		So(err, ShouldBeNil)
+		return coin.Amount.Int64()
+	}
+
+	Convey("test linear emission", t, func() {
+		ctx, _ := ctx.CacheContext()
+		createCoin(ctx)
+
+		schedule := assetTypes.NewLinearEmissionSchedule(account1, types.NewInt64Coin(denom, 1000), 10, 100)
+		_, err := keeper.CreateEmission(ctx, name2, symbol, schedule)
+		So(err, ShouldBeNil)
+
+		_, err = keeper.CreateEmission(ctx, name2, symbol, schedule)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetEmissionSchedule)
+
+		// no coins minted before the cliff
+		emissions, _, err := keeper.EmitCoins(ctx.WithBlockHeight(start + 9))
+		So(err, ShouldBeNil)
+		So(emissions, ShouldBeEmpty)
+
+		_, minted, err := keeper.EmitCoins(ctx.WithBlockHeight(start + 10))
+		So(err, ShouldBeNil)
+		So(minted, ShouldResemble, []types.Coin{types.NewInt64Coin(denom, 100)})
+		So(getCoin(ctx, account1), ShouldEqual, 100)
+
+		// the coins not minted are reserved from the max supply
+		err = keeper.Issue(ctx, name2, symbol, types.NewInt64Coin(denom, 1001))
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetIssueGTMaxSupply)
+		So(keeper.Issue(ctx, name2, symbol, types.NewInt64Coin(denom, 1000)), ShouldBeNil)
+
+		_, minted, err = keeper.EmitCoins(ctx.WithBlockHeight(start + 55))
+		So(err, ShouldBeNil)
+		So(minted, ShouldResemble, []types.Coin{types.NewInt64Coin(denom, 450)})
+
+		emission, found := keeper.GetCoinEmission(ctx, name2, symbol)
+		So(found, ShouldBeTrue)
+		So(emission.Emitted, ShouldResemble, types.NewInt64Coin(denom, 550))
+
+		_, minted, err = keeper.EmitCoins(ctx.WithBlockHeight(start + 200))
+		So(err, ShouldBeNil)
+		So(minted, ShouldResemble, []types.Coin{types.NewInt64Coin(denom, 450)})
+		So(getCoin(ctx, account1), ShouldEqual, 1000)
+
+		// the emission is removed after completed
+		_, found = keeper.GetCoinEmission(ctx, name2, symbol)
+		So(found, ShouldBeFalse)
+
+		stat, err := keeper.GetCoinStat(ctx, name2, symbol)
+		So(err, ShouldBeNil)
+		So(stat.Supply, ShouldResemble, types.NewInt64Coin(denom, 2000))
+	})
+
+	Convey("test checkpoints emission", t, func() {
+		ctx, _ := ctx.CacheContext()
+		createCoin(ctx)
+
+		schedule := assetTypes.NewCustomEmissionSchedule(account1, types.NewInt64Coin(denom, 1000),
+			[]assetTypes.EmissionCheckpoint{
+				assetTypes.NewEmissionCheckpoint(10, types.NewInt(300)),
+				assetTypes.NewEmissionCheckpoint(50, types.NewInt(1000)),
+			})
+		emission, err := keeper.CreateEmission(ctx, name2, symbol, schedule)
+		So(err, ShouldBeNil)
+		So(emission.Schedule.Recipient, ShouldResemble, account1)
+
+		_, minted, err := keeper.EmitCoins(ctx.WithBlockHeight(start + 49))
+		So(err, ShouldBeNil)
+		So(minted, ShouldResemble, []types.Coin{types.NewInt64Coin(denom, 300)})
+
+		_, minted, err = keeper.EmitCoins(ctx.WithBlockHeight(start + 50))
+		So(err, ShouldBeNil)
+		So(minted, ShouldResemble, []types.Coin{types.NewInt64Coin(denom, 700)})
+		So(getCoin(ctx, account1), ShouldEqual, 1000)
+		So(keeper.GetCoinEmissions(ctx), ShouldBeEmpty)
+	})
+
+	Convey("test invalid emission", t, func() {
+		ctx, _ := ctx.CacheContext()
+		createCoin(ctx)
+
+		// the total should not be more than max supply
+		schedule := assetTypes.NewLinearEmissionSchedule(account1, types.NewInt64Coin(denom, 3000), 0, 100)
+		_, err := keeper.CreateEmission(ctx, name2, symbol, schedule)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetIssueGTMaxSupply)
+
+		schedule = assetTypes.NewCustomEmissionSchedule(account1, types.NewInt64Coin(denom, 1000),
+			[]assetTypes.EmissionCheckpoint{assetTypes.NewEmissionCheckpoint(10, types.NewInt(300))})
+		_, err = keeper.CreateEmission(ctx, name2, symbol, schedule)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetEmissionSchedule)
+
+		schedule = assetTypes.NewLinearEmissionSchedule(types.NewAccountIDFromName(types.MustName("noexit")), types.NewInt64Coin(denom, 1000), 0, 100)
+		_, err = keeper.CreateEmission(ctx, name2, symbol, schedule)
+		So(err, ShouldNotBeNil)
+	})
+}
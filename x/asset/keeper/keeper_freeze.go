@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EnableFreeze marks the coin created in the block as freezable, the flag only can be set at the creation,
+// so the holders know if the creator can freeze the coin before they hold it.
+func (a AssetKeeper) EnableFreeze(ctx sdk.Context, creator, symbol types.Name) error {
+	stat, err := a.getStat(ctx, creator, symbol)
+	if err != nil {
+		return err
+	}
+
+	if stat.CreateHeight != ctx.BlockHeight() {
+		return sdkerrors.Wrapf(types.ErrAssetNotFreezable, "coin %s freezable only can be set at the creation", stat.MaxSupply.Denom)
+	}
+
+	stat.Freezable = true
+
+	return a.setStat(ctx, stat)
+}
+
+// getFreezableStat returns the stat of the coin, or error if the coin is not freezable
+func (a AssetKeeper) getFreezableStat(ctx sdk.Context, creator, symbol types.Name) (*types.CoinStat, error) {
+	stat, err := a.getStat(ctx, creator, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	if !stat.Freezable {
+		return nil, sdkerrors.Wrapf(types.ErrAssetNotFreezable, "coin %s", stat.MaxSupply.Denom)
+	}
+
+	return stat, nil
+}
+
+// FreezeAccounts freezes the accounts, so the accounts cannot transfer the coin, the creator cannot be frozen
+func (a AssetKeeper) FreezeAccounts(ctx sdk.Context, creator, symbol types.Name, accounts ...types.AccountID) error {
+	if _, err := a.getFreezableStat(ctx, creator, symbol); err != nil {
+		return err
+	}
+
+	creatorID := types.NewAccountIDFromName(creator)
+	store := ctx.KVStore(a.key)
+	for _, account := range accounts {
+		if account.Eq(creatorID) {
+			return sdkerrors.Wrapf(types.ErrAssetFreezeAccounts, "cannot freeze the creator %s", account)
+		}
+		store.Set(types.CoinFrozenStoreKey(creator, symbol, account), a.cdc.MustMarshalBinaryBare(account))
+	}
+
+	return nil
+}
+
+// UnfreezeAccounts unfreezes the frozen accounts of the coin
+func (a AssetKeeper) UnfreezeAccounts(ctx sdk.Context, creator, symbol types.Name, accounts ...types.AccountID) error {
+	if _, err := a.getFreezableStat(ctx, creator, symbol); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(a.key)
+	for _, account := range accounts {
+		key := types.CoinFrozenStoreKey(creator, symbol, account)
+		if !store.Has(key) {
+			return sdkerrors.Wrapf(types.ErrAssetFreezeAccounts, "account %s not frozen", account)
+		}
+		store.Delete(key)
+	}
+
+	return nil
+}
+
+// SetTransfersPaused pauses or resumes the transfers of the coin, only the creator can transfer the paused coin
+func (a AssetKeeper) SetTransfersPaused(ctx sdk.Context, creator, symbol types.Name, paused bool) error {
+	stat, err := a.getFreezableStat(ctx, creator, symbol)
+	if err != nil {
+		return err
+	}
+
+	stat.TransfersPaused = paused
+
+	return a.setStat(ctx, stat)
+}
+
+// IsFrozen returns if the account is frozen for the coin
+func (a AssetKeeper) IsFrozen(ctx sdk.Context, creator, symbol types.Name, account types.AccountID) bool {
+	return ctx.KVStore(a.key).Has(types.CoinFrozenStoreKey(creator, symbol, account))
+}
+
+// GetFrozenAccounts returns all the frozen accounts of the coin
+func (a AssetKeeper) GetFrozenAccounts(ctx sdk.Context, creator, symbol types.Name) []types.AccountID {
+	res := make([]types.AccountID, 0)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(a.key), types.CoinFrozenPrefix(creator, symbol))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var account types.AccountID
+		a.cdc.MustUnmarshalBinaryBare(iterator.Value(), &account)
+		res = append(res, account)
+	}
+
+	return res
+}
+
+// GetCoinFreeze returns the freeze state of the freezable coin
+func (a AssetKeeper) GetCoinFreeze(ctx sdk.Context, creator, symbol types.Name) (types.CoinFreeze, error) {
+	stat, err := a.getFreezableStat(ctx, creator, symbol)
+	if err != nil {
+		return types.CoinFreeze{}, err
+	}
+
+	return types.NewCoinFreeze(creator, symbol, stat.TransfersPaused, a.GetFrozenAccounts(ctx, creator, symbol)), nil
+}
+
+// GetCoinFreezes returns the freeze states of all the freezable coins
+func (a AssetKeeper) GetCoinFreezes(ctx sdk.Context) []types.CoinFreeze {
+	res := make([]types.CoinFreeze, 0)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(a.key), types.GetKeyPrefix(types.CoinStatStoreKeyPrefix))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stat types.CoinStat
+		a.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stat)
+
+		if stat.Freezable {
+			res = append(res, types.NewCoinFreeze(stat.Creator, stat.Symbol, stat.TransfersPaused,
+				a.GetFrozenAccounts(ctx, stat.Creator, stat.Symbol)))
+		}
+	}
+
+	return res
+}
+
+// InitCoinFreeze sets the freeze state of the coin from genesis, the coin is marked as freezable
+func (a AssetKeeper) InitCoinFreeze(ctx sdk.Context, freeze types.CoinFreeze) error {
+	stat, err := a.getStat(ctx, freeze.Creator, freeze.Symbol)
+	if err != nil {
+		return err
+	}
+
+	stat.Freezable = true
+	stat.TransfersPaused = freeze.TransfersPaused
+	if err := a.setStat(ctx, stat); err != nil {
+		return err
+	}
+
+	return a.FreezeAccounts(ctx, freeze.Creator, freeze.Symbol, freeze.FrozenAccounts...)
+}
+
+// checkFreeze checks the sender is not frozen and the transfers not paused for the freezable coin,
+// the creator of the coin is always allowed.
+func (a AssetKeeper) checkFreeze(ctx sdk.Context, stat *types.CoinStat, from types.AccountID) error {
+	if !stat.Freezable || from.Eq(types.NewAccountIDFromName(stat.Creator)) {
+		return nil
+	}
+
+	if stat.TransfersPaused {
+		return sdkerrors.Wrapf(types.ErrAssetTransfersPaused, "coin %s", stat.MaxSupply.Denom)
+	}
+
+	if a.IsFrozen(ctx, stat.Creator, stat.Symbol, from) {
+		return sdkerrors.Wrapf(types.ErrAssetAccountFrozen, "account %s for coin %s", from, stat.MaxSupply.Denom)
+	}
+
+	return nil
+}
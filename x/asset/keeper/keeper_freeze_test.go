@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+)
+
+func TestAssetFreeze(t *testing.T) {
+	app, ctx := createTestApp()
+	keeper := app.AssetKeeper()
+
+	symbol := types.MustName("frz")
+	denom := types.CoinDenom(name2, symbol)
+	amt := types.NewCoins(types.NewInt64Coin(denom, 100))
+	holder := types.NewAccountIDFromAccAdd(wallet.NewAccAddress())
+
+	Convey("test freeze transfer", t, func() {
+		So(keeper.Create(ctx, name2, symbol, types.NewInt64Coin(denom, 10000000),
+			true, true, 0, types.NewInt64Coin(denom, 0), []byte{}), ShouldBeNil)
+		So(keeper.Issue(ctx, name2, symbol, types.NewInt64Coin(denom, 10000)), ShouldBeNil)
+
+		// the coin is not freezable before enabled
+		err := keeper.FreezeAccounts(ctx, name2, symbol, account1)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetNotFreezable)
+
+		So(keeper.EnableFreeze(ctx, name2, symbol), ShouldBeNil)
+
+		So(keeper.Transfer(ctx, account2, account1, amt), ShouldBeNil)
+		So(keeper.FreezeAccounts(ctx, name2, symbol, account1), ShouldBeNil)
+		So(keeper.IsFrozen(ctx, name2, symbol, account1), ShouldBeTrue)
+
+		// the frozen account cannot send, but can receive
+		err = keeper.Transfer(ctx, account1, holder, amt)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetAccountFrozen)
+		So(keeper.Transfer(ctx, account2, account1, amt), ShouldBeNil)
+
+		// the other coins of the frozen account not limited
+		So(keeper.Transfer(ctx, account1, holder, types.NewInt64CoreCoins(100)), ShouldBeNil)
+
+		// the creator cannot be frozen
+		err = keeper.FreezeAccounts(ctx, name2, symbol, account2)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetFreezeAccounts)
+
+		freeze, err := keeper.GetCoinFreeze(ctx, name2, symbol)
+		So(err, ShouldBeNil)
+		So(freeze.FrozenAccounts, ShouldResemble, []types.AccountID{account1})
+
+		So(keeper.UnfreezeAccounts(ctx, name2, symbol, account1), ShouldBeNil)
+		So(keeper.Transfer(ctx, account1, holder, amt), ShouldBeNil)
+
+		err = keeper.UnfreezeAccounts(ctx, name2, symbol, account1)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetFreezeAccounts)
+	})
+
+	Convey("test pause transfers", t, func() {
+		So(keeper.SetTransfersPaused(ctx, name2, symbol, true), ShouldBeNil)
+
+		err := keeper.Transfer(ctx, holder, account1, amt)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetTransfersPaused)
+
+		// the creator always can transfer
+		So(keeper.Transfer(ctx, account2, holder, amt), ShouldBeNil)
+
+		freezes := keeper.GetCoinFreezes(ctx)
+		So(len(freezes), ShouldEqual, 1)
+		So(freezes[0].TransfersPaused, ShouldBeTrue)
+
+		So(keeper.SetTransfersPaused(ctx, name2, symbol, false), ShouldBeNil)
+		So(keeper.Transfer(ctx, holder, account1, amt), ShouldBeNil)
+	})
+
+	Convey("test pay fee by frozen account", t, func() {
+		So(keeper.FreezeAccounts(ctx, name2, symbol, account1), ShouldBeNil)
+
+		// the frozen coins cannot be spent by fees
+		err := keeper.PayFee(ctx, account1, amt)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetAccountFrozen)
+		So(keeper.PayFee(ctx, account1, types.NewInt64CoreCoins(100)), ShouldBeNil)
+
+		So(keeper.UnfreezeAccounts(ctx, name2, symbol, account1), ShouldBeNil)
+		So(keeper.SetTransfersPaused(ctx, name2, symbol, true), ShouldBeNil)
+
+		err = keeper.PayFee(ctx, account1, amt)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetTransfersPaused)
+
+		So(keeper.SetTransfersPaused(ctx, name2, symbol, false), ShouldBeNil)
+		So(keeper.PayFee(ctx, account1, amt), ShouldBeNil)
+	})
+
+	Convey("test freezable only set at creation", t, func() {
+		other := types.MustName("frzlate")
+		otherDenom := types.CoinDenom(name2, other)
+		So(keeper.Create(ctx, name2, other, types.NewInt64Coin(otherDenom, 10000000),
+			true, true, 0, types.NewInt64Coin(otherDenom, 0), []byte{}), ShouldBeNil)
+
+		nextCtx := ctx.WithBlockHeader(abci.Header{Height: ctx.BlockHeight() + 1})
+		err := keeper.EnableFreeze(nextCtx, name2, other)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetNotFreezable)
+
+		err = keeper.SetTransfersPaused(nextCtx, name2, other, true)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetNotFreezable)
+	})
+}
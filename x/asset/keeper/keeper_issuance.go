@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/KuChainNetwork/kuchain/chain/types/coin"
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetParams returns the total set of asset parameters.
+func (a AssetKeeper) GetParams(ctx sdk.Context) (params types.Params) {
+	a.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of asset parameters.
+func (a AssetKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	a.paramSpace.SetParamSet(ctx, &params)
+}
+
+// SubmitIssuance adds the coin creation to the pending issuances, the coin will be created when approved,
+// the denom is reserved by the pending issuance, so others cannot create or submit it.
+func (a AssetKeeper) SubmitIssuance(ctx sdk.Context, data types.MsgCreateCoinData) (uint64, error) {
+	if stat, _ := a.getStat(ctx, data.Creator, data.Symbol); stat != nil {
+		return 0, types.ErrAssetHasCreated
+	}
+
+	denom := types.CoinDenom(data.Creator, data.Symbol)
+	if err := coin.ValidateDenom(denom); err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrAssetDenom, "denom %s", denom)
+	}
+
+	if len(data.Desc) > types.CoinDescriptionLen {
+		return 0, types.ErrAssetDescriptorTooLarge
+	}
+
+	store := ctx.KVStore(a.key)
+	if store.Has(types.PendingIssuanceDenomStoreKey(data.Creator, data.Symbol)) {
+		return 0, sdkerrors.Wrapf(types.ErrAssetIssuancePending, "denom %s", denom)
+	}
+
+	id := a.nextIssuanceID(ctx)
+	a.setPendingIssuance(ctx, types.NewPendingIssuance(id, ctx.BlockHeight(), data))
+
+	return id, nil
+}
+
+// ApproveIssuance creates the coin by the pending issuance and removes it from the pending issuances
+func (a AssetKeeper) ApproveIssuance(ctx sdk.Context, id uint64) (types.PendingIssuance, error) {
+	issuance, found := a.GetPendingIssuance(ctx, id)
+	if !found {
+		return types.PendingIssuance{}, sdkerrors.Wrapf(types.ErrAssetIssuanceNotFound, "id %d", id)
+	}
+
+	a.deletePendingIssuance(ctx, issuance)
+
+	data := issuance.Coin
+	if err := a.Create(ctx,
+		data.Creator, data.Symbol, data.MaxSupply,
+		data.CanIssue, data.CanLock, data.IssueToHeight, data.InitSupply, data.Desc); err != nil {
+		return types.PendingIssuance{}, sdkerrors.Wrapf(err, "create coin by issuance %d", id)
+	}
+
+	if data.Emission != nil {
+		if _, err := a.CreateEmission(ctx, data.Creator, data.Symbol, *data.Emission); err != nil {
+			return types.PendingIssuance{}, sdkerrors.Wrapf(err, "create emission by issuance %d", id)
+		}
+	}
+
+	if data.Freezable {
+		if err := a.EnableFreeze(ctx, data.Creator, data.Symbol); err != nil {
+			return types.PendingIssuance{}, sdkerrors.Wrapf(err, "enable freeze by issuance %d", id)
+		}
+	}
+
+	return issuance, nil
+}
+
+// RejectIssuance removes the pending issuance, the denom can be submitted again
+func (a AssetKeeper) RejectIssuance(ctx sdk.Context, id uint64) (types.PendingIssuance, error) {
+	issuance, found := a.GetPendingIssuance(ctx, id)
+	if !found {
+		return types.PendingIssuance{}, sdkerrors.Wrapf(types.ErrAssetIssuanceNotFound, "id %d", id)
+	}
+
+	a.deletePendingIssuance(ctx, issuance)
+
+	return issuance, nil
+}
+
+// GetPendingIssuance returns the pending issuance by id
+func (a AssetKeeper) GetPendingIssuance(ctx sdk.Context, id uint64) (types.PendingIssuance, bool) {
+	bz := ctx.KVStore(a.key).Get(types.PendingIssuanceStoreKey(id))
+	if bz == nil {
+		return types.PendingIssuance{}, false
+	}
+
+	var issuance types.PendingIssuance
+	a.cdc.MustUnmarshalBinaryBare(bz, &issuance)
+	return issuance, true
+}
+
+// GetPendingIssuances returns all the pending issuances by id
+func (a AssetKeeper) GetPendingIssuances(ctx sdk.Context) []types.PendingIssuance {
+	res := make([]types.PendingIssuance, 0)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(a.key), types.GetKeyPrefix(types.PendingIssuanceStoreKeyPrefix))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var issuance types.PendingIssuance
+		a.cdc.MustUnmarshalBinaryBare(iterator.Value(), &issuance)
+		res = append(res, issuance)
+	}
+
+	return res
+}
+
+// InitPendingIssuances sets the pending issuances from genesis, the next id will be after them
+func (a AssetKeeper) InitPendingIssuances(ctx sdk.Context, issuances []types.PendingIssuance) {
+	lastID := a.getLastIssuanceID(ctx)
+	for _, issuance := range issuances {
+		a.setPendingIssuance(ctx, issuance)
+		if issuance.ID > lastID {
+			lastID = issuance.ID
+		}
+	}
+	a.setLastIssuanceID(ctx, lastID)
+}
+
+func (a AssetKeeper) setPendingIssuance(ctx sdk.Context, issuance types.PendingIssuance) {
+	store := ctx.KVStore(a.key)
+	store.Set(types.PendingIssuanceStoreKey(issuance.ID), a.cdc.MustMarshalBinaryBare(issuance))
+	store.Set(types.PendingIssuanceDenomStoreKey(issuance.Coin.Creator, issuance.Coin.Symbol), sdk.Uint64ToBigEndian(issuance.ID))
+}
+
+func (a AssetKeeper) deletePendingIssuance(ctx sdk.Context, issuance types.PendingIssuance) {
+	store := ctx.KVStore(a.key)
+	store.Delete(types.PendingIssuanceStoreKey(issuance.ID))
+	store.Delete(types.PendingIssuanceDenomStoreKey(issuance.Coin.Creator, issuance.Coin.Symbol))
+}
+
+func (a AssetKeeper) nextIssuanceID(ctx sdk.Context) uint64 {
+	id := a.getLastIssuanceID(ctx) + 1
+	a.setLastIssuanceID(ctx, id)
+	return id
+}
+
+func (a AssetKeeper) getLastIssuanceID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(a.key).Get(types.PendingIssuanceIDStoreKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (a AssetKeeper) setLastIssuanceID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(a.key).Set(types.PendingIssuanceIDStoreKey, sdk.Uint64ToBigEndian(id))
+}
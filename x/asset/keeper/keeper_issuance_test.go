@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+)
+
+func TestAssetIssuance(t *testing.T) {
+	app, ctx := createTestApp()
+	keeper := app.AssetKeeper()
+
+	newCoinData := func(symbol types.Name) assetTypes.MsgCreateCoinData {
+		denom := types.CoinDenom(name2, symbol)
+		return assetTypes.MsgCreateCoinData{
+			Creator:    name2,
+			Symbol:     symbol,
+			MaxSupply:  types.NewInt64Coin(denom, 10000000),
+			CanIssue:   true,
+			InitSupply: types.NewInt64Coin(denom, 0),
+		}
+	}
+
+	Convey("test approve issuance", t, func() {
+		symbol := types.MustName("abc")
+
+		id, err := keeper.SubmitIssuance(ctx, newCoinData(symbol))
+		So(err, ShouldBeNil)
+		So(id, ShouldEqual, 1)
+
+		_, err = keeper.SubmitIssuance(ctx, newCoinData(symbol))
+		So(assetTypes.ErrAssetIssuancePending.Is(err), ShouldBeTrue)
+
+		So(len(keeper.GetPendingIssuances(ctx)), ShouldEqual, 1)
+
+		issuance, err := keeper.ApproveIssuance(ctx, id)
+		So(err, ShouldBeNil)
+		So(issuance.Coin.Symbol, ShouldResemble, symbol)
+
+		stat, err := keeper.GetCoinStat(ctx, name2, symbol)
+		So(err, ShouldBeNil)
+		So(stat, ShouldNotBeNil)
+
+		_, found := keeper.GetPendingIssuance(ctx, id)
+		So(found, ShouldBeFalse)
+
+		_, err = keeper.SubmitIssuance(ctx, newCoinData(symbol))
+		So(assetTypes.ErrAssetHasCreated.Is(err), ShouldBeTrue)
+	})
+
+	Convey("test reject issuance", t, func() {
+		symbol := types.MustName("abd")
+
+		id, err := keeper.SubmitIssuance(ctx, newCoinData(symbol))
+		So(err, ShouldBeNil)
+
+		_, err = keeper.RejectIssuance(ctx, id)
+		So(err, ShouldBeNil)
+
+		_, err = keeper.RejectIssuance(ctx, id)
+		So(assetTypes.ErrAssetIssuanceNotFound.Is(err), ShouldBeTrue)
+
+		stat, _ := keeper.GetCoinStat(ctx, name2, symbol)
+		So(stat, ShouldBeNil)
+
+		// the denom can be submitted again after rejected
+		newID, err := keeper.SubmitIssuance(ctx, newCoinData(symbol))
+		So(err, ShouldBeNil)
+		So(newID, ShouldBeGreaterThan, id)
+	})
+}
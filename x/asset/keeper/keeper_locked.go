@@ -171,6 +171,48 @@ func (a AssetKeeper) UnLockCoins(ctx sdk.Context, account types.AccountID, coins
 
 }
 
+// removeLockedCoins removes the locked coins entry with the unlock block height before it unlocked
+func (a AssetKeeper) removeLockedCoins(ctx sdk.Context, account types.AccountID, unlockBlockHeight int64, coins types.Coins) error {
+	coinLocked, err := a.getCoinsLocked(ctx, account)
+	if err != nil {
+		return sdkerrors.Wrap(err, "removeLockedCoins: get coins locked")
+	}
+
+	stat, err := a.getCoinsLockedStat(ctx, account)
+	if err != nil {
+		return sdkerrors.Wrap(err, "removeLockedCoins: get coins locked stat")
+	}
+
+	newStat := accountLockedCoins{
+		ID:      account,
+		Lockeds: make([]LockedCoins, 0, len(stat.Lockeds)),
+	}
+
+	removed := false
+	for _, l := range stat.Lockeds {
+		if !removed && l.UnlockBlockHeight == unlockBlockHeight && l.Coins.IsEqual(coins) {
+			removed = true
+			continue
+		}
+		newStat.Lockeds = append(newStat.Lockeds, l)
+	}
+
+	if !removed {
+		return sdkerrors.Wrapf(types.ErrAssetUnLockCoins, "no locked %s until %d", coins, unlockBlockHeight)
+	}
+
+	newCoinsLocked, isNegative := coinLocked.SafeSub(coins)
+	if isNegative {
+		return sdkerrors.Wrapf(types.ErrAssetUnLockCoins, "remove locked %s >= %s", coins, coinLocked)
+	}
+
+	if err := a.setCoinsLocked(ctx, account, newCoinsLocked); err != nil {
+		return sdkerrors.Wrap(err, "removeLockedCoins")
+	}
+
+	return sdkerrors.Wrap(a.setCoinsLockedStat(ctx, account, newStat), "removeLockedCoins")
+}
+
 // GetLockCoins get locked data
 func (a AssetKeeper) GetLockCoins(ctx sdk.Context, account types.AccountID) (types.Coins, []LockedCoins, error) {
 	lockedStat, err := a.getCoinsLockedStat(ctx, account)
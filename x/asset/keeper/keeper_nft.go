@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateNFTCollection creates the collection of the non-fungible tokens by the creator
+func (a AssetKeeper) CreateNFTCollection(ctx sdk.Context, creator, name types.Name, description string) (types.NFTCollection, error) {
+	collection := types.NewNFTCollection(creator, name, description, ctx.BlockHeight())
+	if err := collection.Validate(); err != nil {
+		return types.NFTCollection{}, err
+	}
+
+	if _, ok := a.GetNFTCollection(ctx, creator, name); ok {
+		return types.NFTCollection{}, sdkerrors.Wrapf(types.ErrAssetNFTCollectionExists, "collection %s/%s", creator, name)
+	}
+
+	a.SetNFTCollection(ctx, collection)
+
+	return collection, nil
+}
+
+// GetNFTCollection returns the collection of the creator
+func (a AssetKeeper) GetNFTCollection(ctx sdk.Context, creator, name types.Name) (types.NFTCollection, bool) {
+	bz := ctx.KVStore(a.key).Get(types.NFTCollectionStoreKey(creator, name))
+	if bz == nil {
+		return types.NFTCollection{}, false
+	}
+
+	var collection types.NFTCollection
+	a.cdc.MustUnmarshalBinaryBare(bz, &collection)
+	return collection, true
+}
+
+// GetNFTCollections returns the collections of the creator, or all the collections if the creator is empty
+func (a AssetKeeper) GetNFTCollections(ctx sdk.Context, creator types.Name) []types.NFTCollection {
+	res := make([]types.NFTCollection, 0)
+
+	prefix := types.GetKeyPrefix(types.NFTCollectionStoreKeyPrefix)
+	if !creator.Empty() {
+		prefix = append(prefix, creator.Bytes()...)
+	}
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(a.key), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var collection types.NFTCollection
+		a.cdc.MustUnmarshalBinaryBare(iterator.Value(), &collection)
+		res = append(res, collection)
+	}
+
+	return res
+}
+
+// SetNFTCollection sets the collection, used by genesis
+func (a AssetKeeper) SetNFTCollection(ctx sdk.Context, collection types.NFTCollection) {
+	ctx.KVStore(a.key).Set(types.NFTCollectionStoreKey(collection.Creator, collection.Name), a.cdc.MustMarshalBinaryBare(collection))
+}
+
+// MintNFT mints the token in the collection to the owner, the id should be unique in the collection
+func (a AssetKeeper) MintNFT(ctx sdk.Context, token types.NFT) error {
+	if err := token.Validate(); err != nil {
+		return err
+	}
+
+	collection, ok := a.GetNFTCollection(ctx, token.Creator, token.Collection)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrAssetNFTCollectionNotFound, "collection %s/%s", token.Creator, token.Collection)
+	}
+
+	if _, ok := a.GetNFT(ctx, token.Creator, token.Collection, token.ID); ok {
+		return sdkerrors.Wrapf(types.ErrAssetNFTExists, "nft %s in %s/%s", token.ID, token.Creator, token.Collection)
+	}
+
+	if err := a.ak.EnsureAccount(ctx, token.Owner); err != nil {
+		return sdkerrors.Wrapf(err, "ensure account %s error", token.Owner)
+	}
+
+	a.SetNFT(ctx, token)
+
+	collection.Supply++
+	a.SetNFTCollection(ctx, collection)
+
+	return nil
+}
+
+// TransferNFT transfers the token from the owner to the account
+func (a AssetKeeper) TransferNFT(ctx sdk.Context, from, to types.AccountID, creator, collection types.Name, id string) (types.NFT, error) {
+	token, err := a.getOwnedNFT(ctx, from, creator, collection, id)
+	if err != nil {
+		return types.NFT{}, err
+	}
+
+	if err := a.ak.EnsureAccount(ctx, to); err != nil {
+		return types.NFT{}, sdkerrors.Wrapf(err, "ensure account %s error", to)
+	}
+
+	a.deleteNFT(ctx, token)
+	token.Owner = to
+	a.SetNFT(ctx, token)
+
+	return token, nil
+}
+
+// BurnNFT burns the token by the owner
+func (a AssetKeeper) BurnNFT(ctx sdk.Context, owner types.AccountID, creator, collection types.Name, id string) (types.NFT, error) {
+	token, err := a.getOwnedNFT(ctx, owner, creator, collection, id)
+	if err != nil {
+		return types.NFT{}, err
+	}
+
+	a.deleteNFT(ctx, token)
+
+	if c, ok := a.GetNFTCollection(ctx, creator, collection); ok {
+		c.Supply--
+		a.SetNFTCollection(ctx, c)
+	}
+
+	return token, nil
+}
+
+// EditNFT sets the mutable metadata of the token by the creator of the collection, the data is immutable
+func (a AssetKeeper) EditNFT(ctx sdk.Context, creator, collection types.Name, id, metadata string) (types.NFT, error) {
+	if err := types.ValidateNFTMetadata(metadata); err != nil {
+		return types.NFT{}, err
+	}
+
+	token, ok := a.GetNFT(ctx, creator, collection, id)
+	if !ok {
+		return types.NFT{}, sdkerrors.Wrapf(types.ErrAssetNFTNotFound, "nft %s in %s/%s", id, creator, collection)
+	}
+
+	token.Metadata = metadata
+	a.SetNFT(ctx, token)
+
+	return token, nil
+}
+
+// GetNFT returns the token in the collection
+func (a AssetKeeper) GetNFT(ctx sdk.Context, creator, collection types.Name, id string) (types.NFT, bool) {
+	return a.getNFTByKey(ctx.KVStore(a.key), types.NFTStoreKey(creator, collection, id))
+}
+
+// GetNFTs returns the page of the tokens in the collection, the page starts from 1
+func (a AssetKeeper) GetNFTs(ctx sdk.Context, creator, collection types.Name, page, limit int) types.NFTs {
+	store := ctx.KVStore(a.key)
+	return a.paginateNFTs(store, types.NFTPrefix(creator, collection), page, limit, func(key, value []byte) types.NFT {
+		var token types.NFT
+		a.cdc.MustUnmarshalBinaryBare(value, &token)
+		return token
+	})
+}
+
+// GetNFTsByOwner returns the page of the tokens owned by the account, the page starts from 1
+func (a AssetKeeper) GetNFTsByOwner(ctx sdk.Context, owner types.AccountID, page, limit int) types.NFTs {
+	store := ctx.KVStore(a.key)
+	return a.paginateNFTs(store, types.NFTOwnerPrefix(owner), page, limit, func(key, value []byte) types.NFT {
+		token, _ := a.getNFTByKey(store, value)
+		return token
+	})
+}
+
+// GetAllNFTs returns all the tokens, used by genesis
+func (a AssetKeeper) GetAllNFTs(ctx sdk.Context) []types.NFT {
+	res := make([]types.NFT, 0)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(a.key), types.GetKeyPrefix(types.NFTStoreKeyPrefix))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var token types.NFT
+		a.cdc.MustUnmarshalBinaryBare(iterator.Value(), &token)
+		res = append(res, token)
+	}
+
+	return res
+}
+
+// SetNFT sets the token and the index of the owner, used by genesis
+func (a AssetKeeper) SetNFT(ctx sdk.Context, token types.NFT) {
+	store := ctx.KVStore(a.key)
+
+	key := types.NFTStoreKey(token.Creator, token.Collection, token.ID)
+	store.Set(key, a.cdc.MustMarshalBinaryBare(token))
+	store.Set(types.NFTOwnerStoreKey(token.Owner, token.Creator, token.Collection, token.ID), key)
+}
+
+func (a AssetKeeper) deleteNFT(ctx sdk.Context, token types.NFT) {
+	store := ctx.KVStore(a.key)
+
+	store.Delete(types.NFTStoreKey(token.Creator, token.Collection, token.ID))
+	store.Delete(types.NFTOwnerStoreKey(token.Owner, token.Creator, token.Collection, token.ID))
+}
+
+func (a AssetKeeper) getNFTByKey(store sdk.KVStore, key []byte) (types.NFT, bool) {
+	bz := store.Get(key)
+	if bz == nil {
+		return types.NFT{}, false
+	}
+
+	var token types.NFT
+	a.cdc.MustUnmarshalBinaryBare(bz, &token)
+	return token, true
+}
+
+// getOwnedNFT returns the token, or error if the account is not the owner
+func (a AssetKeeper) getOwnedNFT(ctx sdk.Context, owner types.AccountID, creator, collection types.Name, id string) (types.NFT, error) {
+	token, ok := a.GetNFT(ctx, creator, collection, id)
+	if !ok {
+		return types.NFT{}, sdkerrors.Wrapf(types.ErrAssetNFTNotFound, "nft %s in %s/%s", id, creator, collection)
+	}
+
+	if !token.Owner.Eq(owner) {
+		return types.NFT{}, sdkerrors.Wrapf(types.ErrAssetNFTOwner, "account %s of nft %s in %s/%s", owner, id, creator, collection)
+	}
+
+	return token, nil
+}
+
+// paginateNFTs iterates the keys of the prefix and only decodes the tokens in the page
+func (a AssetKeeper) paginateNFTs(store sdk.KVStore, prefix []byte, page, limit int,
+	decode func(key, value []byte) types.NFT) types.NFTs {
+	page, limit = types.NormalizeNFTsPage(page, limit)
+	start := (page - 1) * limit
+
+	res := types.NFTs{Tokens: make([]types.NFT, 0)}
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if res.Total >= uint64(start) && len(res.Tokens) < limit {
+			res.Tokens = append(res.Tokens, decode(iterator.Key(), iterator.Value()))
+		}
+		res.Total++
+	}
+
+	return res
+}
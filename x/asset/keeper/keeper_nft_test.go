@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+)
+
+func TestAssetNFT(t *testing.T) {
+	app, ctx := createTestApp()
+	keeper := app.AssetKeeper()
+
+	collection := types.MustName("heroes")
+	holder := types.NewAccountIDFromAccAdd(wallet.NewAccAddress())
+
+	Convey("test nft mint, transfer, edit and burn", t, func() {
+		_, err := keeper.CreateNFTCollection(ctx, name2, collection, "the heroes of the game")
+		So(err, ShouldBeNil)
+
+		_, err = keeper.CreateNFTCollection(ctx, name2, collection, "")
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetNFTCollectionExists)
+
+		// the collection should be created before minted
+		err = keeper.MintNFT(ctx, assetTypes.NewNFT(name2, types.MustName("other"), "1", account1, "", ""))
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetNFTCollectionNotFound)
+
+		token := assetTypes.NewNFT(name2, collection, "hero-1", account1, "str=10", "level=1")
+		So(keeper.MintNFT(ctx, token), ShouldBeNil)
+		So(keeper.MintNFT(ctx, token), simapp.ShouldErrIs, assetTypes.ErrAssetNFTExists)
+
+		res, ok := keeper.GetNFT(ctx, name2, collection, "hero-1")
+		So(ok, ShouldBeTrue)
+		So(res, ShouldResemble, token)
+
+		// only the owner can transfer the token, the account of the address is created
+		_, err = keeper.TransferNFT(ctx, account2, holder, name2, collection, "hero-1")
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetNFTOwner)
+
+		res, err = keeper.TransferNFT(ctx, account1, holder, name2, collection, "hero-1")
+		So(err, ShouldBeNil)
+		So(res.Owner, simapp.ShouldEq, holder)
+		So(keeper.GetNFTsByOwner(ctx, account1, 1, 10).Total, ShouldEqual, 0)
+		So(keeper.GetNFTsByOwner(ctx, holder, 1, 10).Tokens, ShouldResemble, []assetTypes.NFT{res})
+
+		// the metadata is mutable while the data is not
+		res, err = keeper.EditNFT(ctx, name2, collection, "hero-1", "level=2")
+		So(err, ShouldBeNil)
+		So(res.Data, ShouldEqual, "str=10")
+		So(res.Metadata, ShouldEqual, "level=2")
+
+		c, ok := keeper.GetNFTCollection(ctx, name2, collection)
+		So(ok, ShouldBeTrue)
+		So(c.Supply, ShouldEqual, 1)
+
+		_, err = keeper.BurnNFT(ctx, account1, name2, collection, "hero-1")
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetNFTOwner)
+
+		_, err = keeper.BurnNFT(ctx, holder, name2, collection, "hero-1")
+		So(err, ShouldBeNil)
+
+		_, ok = keeper.GetNFT(ctx, name2, collection, "hero-1")
+		So(ok, ShouldBeFalse)
+		So(keeper.GetNFTsByOwner(ctx, holder, 1, 10).Total, ShouldEqual, 0)
+
+		c, _ = keeper.GetNFTCollection(ctx, name2, collection)
+		So(c.Supply, ShouldEqual, 0)
+	})
+
+	Convey("test nft pages", t, func() {
+		for i := 0; i < 5; i++ {
+			So(keeper.MintNFT(ctx, assetTypes.NewNFT(name2, collection, fmt.Sprintf("page-%d", i), account1, "", "")), ShouldBeNil)
+		}
+
+		tokens := keeper.GetNFTs(ctx, name2, collection, 2, 2)
+		So(tokens.Total, ShouldEqual, 5)
+		So(len(tokens.Tokens), ShouldEqual, 2)
+		So(tokens.Tokens[0].ID, ShouldEqual, "page-2")
+		So(tokens.Tokens[1].ID, ShouldEqual, "page-3")
+
+		tokens = keeper.GetNFTsByOwner(ctx, account1, 3, 2)
+		So(tokens.Total, ShouldEqual, 5)
+		So(len(tokens.Tokens), ShouldEqual, 1)
+		So(tokens.Tokens[0].ID, ShouldEqual, "page-4")
+
+		So(len(keeper.GetNFTs(ctx, name2, collection, 4, 2).Tokens), ShouldEqual, 0)
+		So(len(keeper.GetNFTCollections(ctx, name2)), ShouldEqual, 1)
+		So(len(keeper.GetNFTCollections(ctx, name1)), ShouldEqual, 0)
+		So(len(keeper.GetAllNFTs(ctx)), ShouldEqual, 5)
+	})
+}
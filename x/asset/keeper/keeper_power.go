@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	supplyexported "github.com/KuChainNetwork/kuchain/x/supply/exported"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -114,6 +115,10 @@ func (a AssetKeeper) CoinsToPower(ctx sdk.Context, from, to types.AccountID, amt
 		return sdkerrors.Wrap(err, "coinsToPower")
 	}
 
+	if err := a.checkSpendable(ctx, from, to, amt); err != nil {
+		return sdkerrors.Wrap(err, "coinsToPower")
+	}
+
 	if err := a.setCoins(ctx, from, subed); err != nil {
 		return sdkerrors.Wrap(err, "CoinsToPower: set coins")
 	}
@@ -125,6 +130,43 @@ func (a AssetKeeper) CoinsToPower(ctx sdk.Context, from, to types.AccountID, amt
 	return nil
 }
 
+// checkSpendable checks the allow lists and the freeze states of the coins spent by the account
+// to the modules, such as the fees and the delegations, the coins of the module accounts are not
+// checked as they are checked when sent to the modules.
+func (a AssetKeeper) checkSpendable(ctx sdk.Context, from, to types.AccountID, amount Coins) error {
+	if a.isModuleAccount(ctx, from) {
+		return nil
+	}
+
+	for _, c := range amount {
+		creator, symbol, err := types.CoinAccountsFromDenom(c.Denom)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "get creator and symbol from coin %s", c.Denom)
+		}
+
+		stat, err := a.getStat(ctx, creator, symbol)
+		if err != nil {
+			continue
+		}
+
+		if err := a.checkAllowList(ctx, stat, from, to); err != nil {
+			return err
+		}
+
+		if err := a.checkFreeze(ctx, stat, from); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isModuleAccount returns true if the account is a module account
+func (a AssetKeeper) isModuleAccount(ctx sdk.Context, id types.AccountID) bool {
+	_, ok := a.ak.GetAccount(ctx, id).(supplyexported.ModuleAccountI)
+	return ok
+}
+
 // ExerciseCoinPower exercise coin power to get coins to account
 func (a AssetKeeper) ExerciseCoinPower(ctx sdk.Context, id types.AccountID, amt types.Coin) error {
 	if _, err := a.subCoinPower(ctx, id, Coins{amt}); err != nil {
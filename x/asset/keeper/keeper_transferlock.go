@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateTransferLocks locks the coins transferred from the account to the receiver by the vesting schedule,
+// the coins should have been transferred to the receiver, the locks are unlocked in the begin blockers
+// at the heights in the transfer lock queue.
+func (a AssetKeeper) CreateTransferLocks(ctx sdk.Context, from, to types.AccountID, amount types.Coins, cliffHeight, endHeight int64) ([]types.TransferLock, error) {
+	height := ctx.BlockHeight()
+	if err := types.ValidateTransferLockHeights(height, cliffHeight, endHeight); err != nil {
+		return nil, err
+	}
+
+	if !amount.IsValid() || amount.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrAssetTransferLock, "amount %s invalid", amount)
+	}
+
+	params := a.GetParams(ctx)
+	for _, coin := range amount {
+		if err := params.ValidateTransferLockAmount(coin); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrAssetTransferLock, err.Error())
+		}
+	}
+
+	if err := a.addCoinsLocked(ctx, to, amount); err != nil {
+		return nil, sdkerrors.Wrap(err, "lock transfer coins")
+	}
+
+	res := make([]types.TransferLock, 0, len(amount))
+	for _, coin := range amount {
+		lock := types.NewTransferLock(from, coin, height, cliffHeight, endHeight)
+
+		locks, _ := a.GetTransferLocks(ctx, to, coin.Denom)
+		locks.Locks = append(locks.Locks, lock)
+		a.setTransferLocks(ctx, locks)
+
+		if next, ok := lock.NextUnlockHeight(height); ok {
+			a.insertTransferLockQueue(ctx, next, to, coin.Denom)
+		}
+
+		res = append(res, lock)
+	}
+
+	return res, nil
+}
+
+// UnlockTransferLocks unlocks the coins due at the height of the transfer locks in the queue,
+// the locks are removed after all the coins unlocked, returns the coins unlocked of the accounts
+// in the block. The locks failed to unlock are logged and retried in the next block.
+func (a AssetKeeper) UnlockTransferLocks(ctx sdk.Context) ([]types.AccountID, []types.Coin) {
+	height := ctx.BlockHeight()
+	store := ctx.KVStore(a.key)
+
+	queueKeys := make([][]byte, 0)
+	locksKeys := make([][]byte, 0)
+	iterator := store.Iterator(
+		types.GetKeyPrefix(types.TransferLockQueueStoreKeyPrefix), sdk.PrefixEndBytes(types.TransferLockQueuePrefix(height)))
+	for ; iterator.Valid(); iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+		locksKeys = append(locksKeys, iterator.Value())
+	}
+	iterator.Close()
+
+	accounts := make([]types.AccountID, 0)
+	unlocked := make([]types.Coin, 0)
+	for i, key := range queueKeys {
+		store.Delete(key)
+
+		bz := store.Get(locksKeys[i])
+		if bz == nil {
+			continue
+		}
+
+		var locks types.AccountTransferLocks
+		a.cdc.MustUnmarshalBinaryBare(bz, &locks)
+
+		cacheCtx, write := ctx.CacheContext()
+		due, err := a.unlockTransferLocks(cacheCtx, locks)
+		if err != nil {
+			a.Logger(ctx).Error("unlock transfer locks failed", "account", locks.Account, "denom", locks.Denom, "err", err)
+			a.insertTransferLockQueue(ctx, height+1, locks.Account, locks.Denom)
+			continue
+		}
+		write()
+
+		if !due.IsZero() {
+			accounts = append(accounts, locks.Account)
+			unlocked = append(unlocked, due)
+		}
+	}
+
+	return accounts, unlocked
+}
+
+// unlockTransferLocks unlocks the coins due at the height of the transfer locks,
+// and puts the locks into the queue by the next unlock height.
+func (a AssetKeeper) unlockTransferLocks(ctx sdk.Context, locks types.AccountTransferLocks) (types.Coin, error) {
+	height := ctx.BlockHeight()
+
+	due := types.NewCoin(locks.Denom, types.NewInt(0))
+	remaining := make([]types.TransferLock, 0, len(locks.Locks))
+	for _, l := range locks.Locks {
+		d := l.Due(height)
+		l.Unlocked = l.Unlocked.Add(d)
+		due = due.Add(d)
+
+		if !l.IsCompleted() {
+			remaining = append(remaining, l)
+		}
+	}
+
+	if !due.IsZero() {
+		if err := a.subCoinsLocked(ctx, locks.Account, types.NewCoins(due)); err != nil {
+			return due, sdkerrors.Wrapf(err, "unlock transfer locks of %s", locks.Account)
+		}
+	}
+
+	locks.Locks = remaining
+	a.setTransferLocks(ctx, locks)
+
+	if next, ok := locks.NextUnlockHeight(height); ok {
+		a.insertTransferLockQueue(ctx, next, locks.Account, locks.Denom)
+	}
+
+	return due, nil
+}
+
+// InitTransferLocks sets the transfer locks and locks the coins still locked, used by genesis
+func (a AssetKeeper) InitTransferLocks(ctx sdk.Context, locks types.AccountTransferLocks) error {
+	if err := a.addCoinsLocked(ctx, locks.Account, types.NewCoins(locks.Locked())); err != nil {
+		return sdkerrors.Wrapf(err, "init transfer locks of %s", locks.Account)
+	}
+
+	a.setTransferLocks(ctx, locks)
+
+	if next, ok := locks.NextUnlockHeight(ctx.BlockHeight()); ok {
+		a.insertTransferLockQueue(ctx, next, locks.Account, locks.Denom)
+	}
+
+	return nil
+}
+
+// insertTransferLockQueue puts the transfer locks of the coins in the denom of the account
+// into the queue to unlock at the height
+func (a AssetKeeper) insertTransferLockQueue(ctx sdk.Context, height int64, account types.AccountID, denom string) {
+	ctx.KVStore(a.key).Set(
+		types.TransferLockQueueStoreKey(height, account, denom), types.TransferLockStoreKey(account, denom))
+}
+
+// GetTransferLocks returns the transfer locks of the coins in the denom of the account
+func (a AssetKeeper) GetTransferLocks(ctx sdk.Context, account types.AccountID, denom string) (types.AccountTransferLocks, bool) {
+	bz := ctx.KVStore(a.key).Get(types.TransferLockStoreKey(account, denom))
+	if bz == nil {
+		return types.NewAccountTransferLocks(account, denom), false
+	}
+
+	var locks types.AccountTransferLocks
+	a.cdc.MustUnmarshalBinaryBare(bz, &locks)
+	return locks, true
+}
+
+// GetAccountTransferLocks returns the transfer locks of all the denoms of the account
+func (a AssetKeeper) GetAccountTransferLocks(ctx sdk.Context, account types.AccountID) []types.AccountTransferLocks {
+	return a.iterateTransferLocks(ctx, types.TransferLockPrefix(account))
+}
+
+// GetAllTransferLocks returns all the transfer locks
+func (a AssetKeeper) GetAllTransferLocks(ctx sdk.Context) []types.AccountTransferLocks {
+	return a.iterateTransferLocks(ctx, types.GetKeyPrefix(types.TransferLockStoreKeyPrefix))
+}
+
+func (a AssetKeeper) iterateTransferLocks(ctx sdk.Context, prefix []byte) []types.AccountTransferLocks {
+	res := make([]types.AccountTransferLocks, 0)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(a.key), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var locks types.AccountTransferLocks
+		a.cdc.MustUnmarshalBinaryBare(iterator.Value(), &locks)
+		res = append(res, locks)
+	}
+
+	return res
+}
+
+// setTransferLocks sets the transfer locks, or removes them if no locks remaining
+func (a AssetKeeper) setTransferLocks(ctx sdk.Context, locks types.AccountTransferLocks) {
+	key := types.TransferLockStoreKey(locks.Account, locks.Denom)
+	if len(locks.Locks) == 0 {
+		ctx.KVStore(a.key).Delete(key)
+		return
+	}
+
+	ctx.KVStore(a.key).Set(key, a.cdc.MustMarshalBinaryBare(locks))
+}
+
+// addCoinsLocked adds the coins to the locked coins of the account, which cannot be used by the transfers,
+// the account should have enough coins to lock.
+func (a AssetKeeper) addCoinsLocked(ctx sdk.Context, account types.AccountID, coins types.Coins) error {
+	currentCoins, err := a.getCoins(ctx, account)
+	if err != nil {
+		return sdkerrors.Wrap(err, "get coins")
+	}
+
+	coinsLocked, err := a.getCoinsLocked(ctx, account)
+	if err != nil {
+		return sdkerrors.Wrap(err, "get coins locked")
+	}
+
+	coinsLockedAll := coinsLocked.Add(coins...)
+	if !currentCoins.IsAllGTE(coinsLockedAll) {
+		return types.ErrAssetLockCoinsNoEnough
+	}
+
+	return a.setCoinsLocked(ctx, account, coinsLockedAll)
+}
+
+func (a AssetKeeper) subCoinsLocked(ctx sdk.Context, account types.AccountID, coins types.Coins) error {
+	coinsLocked, err := a.getCoinsLocked(ctx, account)
+	if err != nil {
+		return sdkerrors.Wrap(err, "get coins locked")
+	}
+
+	newCoinsLocked, isNegative := coinsLocked.SafeSub(coins)
+	if isNegative {
+		return sdkerrors.Wrapf(types.ErrAssetUnLockCoins, "unlock %s >= %s", coins, coinsLocked)
+	}
+
+	return a.setCoinsLocked(ctx, account, newCoinsLocked)
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+)
+
+func TestAssetTransferLocks(t *testing.T) {
+	app, ctx := createTestApp()
+	keeper := app.AssetKeeper()
+
+	denom := constants.DefaultBondDenom
+	start := ctx.BlockHeight()
+	coins := func(amount int64) types.Coins {
+		return types.NewCoins(types.NewInt64Coin(denom, amount))
+	}
+
+	params := keeper.GetParams(ctx)
+	params.MinTransferLock = coins(100)
+	keeper.SetParams(ctx, params)
+
+	Convey("test linear transfer lock", t, func() {
+		ctx, _ := ctx.CacheContext()
+		receiver := types.NewAccountIDFromAccAdd(wallet.NewAccAddress())
+
+		So(keeper.Transfer(ctx, account1, receiver, coins(1000)), ShouldBeNil)
+
+		_, err := keeper.CreateTransferLocks(ctx, account1, receiver, coins(1000), start+10, start)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetTransferLock)
+
+		_, err = keeper.CreateTransferLocks(ctx, account1, receiver, coins(1001), start+10, start+100)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetLockCoinsNoEnough)
+
+		_, err = keeper.CreateTransferLocks(ctx, account1, receiver, coins(1000), start+10, start+100)
+		So(err, ShouldBeNil)
+
+		// the coins locked cannot be transferred
+		So(keeper.Transfer(ctx, receiver, account1, coins(1)), simapp.ShouldErrIs, assetTypes.ErrAssetCoinsLocked)
+
+		// no coins unlocked before the cliff
+		accounts, _ := keeper.UnlockTransferLocks(ctx.WithBlockHeight(start + 9))
+		So(accounts, ShouldBeEmpty)
+
+		accounts, unlocked := keeper.UnlockTransferLocks(ctx.WithBlockHeight(start + 10))
+		So(accounts, ShouldResemble, []types.AccountID{receiver})
+		So(unlocked, ShouldResemble, []types.Coin{types.NewInt64Coin(denom, 100)})
+
+		So(keeper.Transfer(ctx, receiver, account1, coins(101)), simapp.ShouldErrIs, assetTypes.ErrAssetCoinsLocked)
+		So(keeper.Transfer(ctx, receiver, account1, coins(100)), ShouldBeNil)
+
+		locks, found := keeper.GetTransferLocks(ctx, receiver, denom)
+		So(found, ShouldBeTrue)
+		So(locks.Locked(), ShouldResemble, types.NewInt64Coin(denom, 900))
+
+		_, unlocked = keeper.UnlockTransferLocks(ctx.WithBlockHeight(start + 200))
+		So(unlocked, ShouldResemble, []types.Coin{types.NewInt64Coin(denom, 900)})
+		So(keeper.Transfer(ctx, receiver, account1, coins(900)), ShouldBeNil)
+
+		// the locks are removed after all the coins unlocked
+		_, found = keeper.GetTransferLocks(ctx, receiver, denom)
+		So(found, ShouldBeFalse)
+		So(keeper.GetAllTransferLocks(ctx), ShouldBeEmpty)
+	})
+
+	Convey("test cliff transfer lock", t, func() {
+		ctx, _ := ctx.CacheContext()
+		receiver := types.NewAccountIDFromAccAdd(wallet.NewAccAddress())
+
+		So(keeper.Transfer(ctx, account1, receiver, coins(300)), ShouldBeNil)
+		_, err := keeper.CreateTransferLocks(ctx, account1, receiver, coins(100), start+50, start+50)
+		So(err, ShouldBeNil)
+		_, err = keeper.CreateTransferLocks(ctx, account1, receiver, coins(100), start+20, start+20)
+		So(err, ShouldBeNil)
+
+		So(keeper.GetAccountTransferLocks(ctx, receiver)[0].Locks, ShouldHaveLength, 2)
+		So(keeper.Transfer(ctx, receiver, account1, coins(101)), simapp.ShouldErrIs, assetTypes.ErrAssetCoinsLocked)
+
+		_, unlocked := keeper.UnlockTransferLocks(ctx.WithBlockHeight(start + 49))
+		So(unlocked, ShouldResemble, []types.Coin{types.NewInt64Coin(denom, 100)})
+		So(keeper.GetAccountTransferLocks(ctx, receiver)[0].Locks, ShouldHaveLength, 1)
+
+		So(keeper.Transfer(ctx, receiver, account1, coins(201)), simapp.ShouldErrIs, assetTypes.ErrAssetCoinsLocked)
+		So(keeper.Transfer(ctx, receiver, account1, coins(200)), ShouldBeNil)
+
+		_, unlocked = keeper.UnlockTransferLocks(ctx.WithBlockHeight(start + 50))
+		So(unlocked, ShouldResemble, []types.Coin{types.NewInt64Coin(denom, 100)})
+		So(keeper.Transfer(ctx, receiver, account1, coins(100)), ShouldBeNil)
+	})
+
+	Convey("test the min transfer lock", t, func() {
+		ctx, _ := ctx.CacheContext()
+		receiver := types.NewAccountIDFromAccAdd(wallet.NewAccAddress())
+
+		So(keeper.Transfer(ctx, account1, receiver, coins(1000)), ShouldBeNil)
+
+		_, err := keeper.CreateTransferLocks(ctx, account1, receiver, coins(99), start+10, start+100)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetTransferLock)
+
+		other := types.NewCoins(types.NewInt64Coin("foo/coin", 1000))
+		_, err = keeper.CreateTransferLocks(ctx, account1, receiver, other, start+10, start+100)
+		So(err, simapp.ShouldErrIs, assetTypes.ErrAssetTransferLock)
+		So(keeper.GetAllTransferLocks(ctx), ShouldBeEmpty)
+	})
+
+	Convey("test the transfer locks unlocked by the queue", t, func() {
+		ctx, _ := ctx.CacheContext()
+		receiver := types.NewAccountIDFromAccAdd(wallet.NewAccAddress())
+
+		So(keeper.Transfer(ctx, account1, receiver, coins(200)), ShouldBeNil)
+		_, err := keeper.CreateTransferLocks(ctx, account1, receiver, coins(100), start+20, start+20)
+		So(err, ShouldBeNil)
+		_, err = keeper.CreateTransferLocks(ctx, account1, receiver, coins(100), start+10, start+30)
+		So(err, ShouldBeNil)
+
+		// the unlocks missed are unlocked at once
+		_, unlocked := keeper.UnlockTransferLocks(ctx.WithBlockHeight(start + 25))
+		So(unlocked, ShouldResemble, []types.Coin{types.NewInt64Coin(denom, 183)})
+
+		_, unlocked = keeper.UnlockTransferLocks(ctx.WithBlockHeight(start + 25))
+		So(unlocked, ShouldBeEmpty)
+
+		_, unlocked = keeper.UnlockTransferLocks(ctx.WithBlockHeight(start + 26))
+		So(unlocked, ShouldResemble, []types.Coin{types.NewInt64Coin(denom, 3)})
+
+		_, unlocked = keeper.UnlockTransferLocks(ctx.WithBlockHeight(start + 40))
+		So(unlocked, ShouldResemble, []types.Coin{types.NewInt64Coin(denom, 14)})
+		So(keeper.GetAllTransferLocks(ctx), ShouldBeEmpty)
+
+		// no locks left in the queue
+		_, unlocked = keeper.UnlockTransferLocks(ctx.WithBlockHeight(start + 41))
+		So(unlocked, ShouldBeEmpty)
+	})
+}
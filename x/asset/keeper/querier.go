@@ -16,6 +16,8 @@ func NewQuerier(keeper AssetViewKeeper) sdk.Querier {
 			return queryCoin(ctx, req, keeper)
 		case types.QueryCoins:
 			return queryCoins(ctx, req, keeper)
+		case types.QueryCoinsDisplay:
+			return queryCoinsDisplay(ctx, req, keeper)
 		case types.QueryCoinPower:
 			return queryCoinPower(ctx, req, keeper)
 		case types.QueryCoinPowers:
@@ -26,6 +28,36 @@ func NewQuerier(keeper AssetViewKeeper) sdk.Querier {
 			return queryCoinDesc(ctx, req, keeper)
 		case types.QueryCoinLocked:
 			return queryCoinLocked(ctx, req, keeper)
+		case types.QueryParams:
+			return queryParams(ctx, keeper)
+		case types.QueryIssuance:
+			return queryIssuance(ctx, req, keeper)
+		case types.QueryIssuances:
+			return queryIssuances(ctx, keeper)
+		case types.QueryAllowList:
+			return queryAllowList(ctx, req, keeper)
+		case types.QueryClawbackGrant:
+			return queryClawbackGrant(ctx, req, keeper)
+		case types.QueryEmission:
+			return queryEmission(ctx, req, keeper)
+		case types.QueryEmissions:
+			return queryEmissions(ctx, keeper)
+		case types.QueryFreeze:
+			return queryFreeze(ctx, req, keeper)
+		case types.QueryFreezes:
+			return queryFreezes(ctx, keeper)
+		case types.QueryNFTCollection:
+			return queryNFTCollection(ctx, req, keeper)
+		case types.QueryNFTCollections:
+			return queryNFTCollections(ctx, req, keeper)
+		case types.QueryNFT:
+			return queryNFT(ctx, req, keeper)
+		case types.QueryNFTs:
+			return queryNFTs(ctx, req, keeper)
+		case types.QueryNFTsByOwner:
+			return queryNFTsByOwner(ctx, req, keeper)
+		case types.QueryTransferLocks:
+			return queryTransferLocks(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -76,6 +108,29 @@ func queryCoins(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper)
 	return bz, nil
 }
 
+// queryCoinsDisplay query account coins with the display amounts
+func queryCoinsDisplay(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper) ([]byte, error) {
+	cdc := keeper.Cdc()
+
+	var params types.QueryCoinsParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	coins, err := keeper.GetCoins(ctx, params.AccountID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "get coin from keeper")
+	}
+
+	res := types.NewDisplayCoins(coins, keeper.GetParams(ctx).DenomDisplays)
+	bz, err := codec.MarshalJSONIndent(cdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 // queryCoinPowers query account coin power
 func queryCoinPowers(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper) ([]byte, error) {
 	cdc := keeper.Cdc()
@@ -195,3 +250,274 @@ func queryCoinLocked(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKee
 
 	return bz, nil
 }
+
+// queryParams query asset params
+func queryParams(ctx sdk.Context, keeper AssetViewKeeper) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc(), keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryIssuance query pending issuance by id
+func queryIssuance(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper) ([]byte, error) {
+	cdc := keeper.Cdc()
+
+	var params types.QueryIssuanceParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	issuance, found := keeper.GetPendingIssuance(ctx, params.ID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAssetIssuanceNotFound, "id %d", params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, issuance)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryIssuances query all pending issuances
+func queryIssuances(ctx sdk.Context, keeper AssetViewKeeper) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc(), keeper.GetPendingIssuances(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryAllowList query the allow list of coin
+func queryAllowList(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper) ([]byte, error) {
+	cdc := keeper.Cdc()
+
+	var params types.QueryAllowListParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	stat, err := keeper.GetCoinStat(ctx, params.Creator, params.Symbol)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "get stat from keeper")
+	}
+
+	res := types.NewCoinAllowList(params.Creator, params.Symbol, stat.AllowListOnly,
+		keeper.GetAllowList(ctx, params.Creator, params.Symbol))
+
+	bz, err := codec.MarshalJSONIndent(cdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryClawbackGrant query the clawback grant of account
+func queryClawbackGrant(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper) ([]byte, error) {
+	cdc := keeper.Cdc()
+
+	var params types.QueryClawbackGrantParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	grant, found := keeper.GetClawbackGrant(ctx, params.Grantee)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAssetClawbackGrantNotFound, "grantee %s", params.Grantee)
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, grant)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryEmission query the emission schedule of coin
+func queryEmission(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper) ([]byte, error) {
+	cdc := keeper.Cdc()
+
+	var params types.QueryEmissionParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	emission, found := keeper.GetCoinEmission(ctx, params.Creator, params.Symbol)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAssetEmissionNotFound, "coin %s", types.CoinDenom(params.Creator, params.Symbol))
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, emission)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryEmissions query all the emission schedules in progress
+func queryEmissions(ctx sdk.Context, keeper AssetViewKeeper) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc(), keeper.GetCoinEmissions(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryFreeze query the freeze state of the freezable coin
+func queryFreeze(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper) ([]byte, error) {
+	cdc := keeper.Cdc()
+
+	var params types.QueryFreezeParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	freeze, err := keeper.GetCoinFreeze(ctx, params.Creator, params.Symbol)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "get freeze from keeper")
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, freeze)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryFreezes query the freeze states of all the freezable coins
+func queryFreezes(ctx sdk.Context, keeper AssetViewKeeper) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc(), keeper.GetCoinFreezes(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryNFTCollection query the nft collection
+func queryNFTCollection(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper) ([]byte, error) {
+	cdc := keeper.Cdc()
+
+	var params types.QueryNFTCollectionParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	collection, ok := keeper.GetNFTCollection(ctx, params.Creator, params.Name)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrAssetNFTCollectionNotFound, "collection %s/%s", params.Creator, params.Name)
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, collection)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryNFTCollections query the nft collections of the creator, or all the collections if the creator is empty
+func queryNFTCollections(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper) ([]byte, error) {
+	cdc := keeper.Cdc()
+
+	var params types.QueryNFTCollectionsParams
+	if len(req.Data) > 0 {
+		if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, keeper.GetNFTCollections(ctx, params.Creator))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryNFT query the token in the nft collection
+func queryNFT(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper) ([]byte, error) {
+	cdc := keeper.Cdc()
+
+	var params types.QueryNFTParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	token, ok := keeper.GetNFT(ctx, params.Creator, params.Collection, params.ID)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrAssetNFTNotFound, "nft %s in %s/%s", params.ID, params.Creator, params.Collection)
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, token)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryNFTs query the page of the tokens in the nft collection
+func queryNFTs(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper) ([]byte, error) {
+	cdc := keeper.Cdc()
+
+	var params types.QueryNFTsParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if _, ok := keeper.GetNFTCollection(ctx, params.Creator, params.Collection); !ok {
+		return nil, sdkerrors.Wrapf(types.ErrAssetNFTCollectionNotFound, "collection %s/%s", params.Creator, params.Collection)
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, keeper.GetNFTs(ctx, params.Creator, params.Collection, params.Page, params.Limit))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryNFTsByOwner query the page of the tokens owned by the account
+func queryNFTsByOwner(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper) ([]byte, error) {
+	cdc := keeper.Cdc()
+
+	var params types.QueryNFTsByOwnerParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, keeper.GetNFTsByOwner(ctx, params.Owner, params.Page, params.Limit))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryTransferLocks query the transfer locks of all the denoms of account
+func queryTransferLocks(ctx sdk.Context, req abci.RequestQuery, keeper AssetViewKeeper) ([]byte, error) {
+	cdc := keeper.Cdc()
+
+	var params types.QueryTransferLocksParams
+	if err := cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	bz, err := codec.MarshalJSONIndent(cdc, keeper.GetAccountTransferLocks(ctx, params.Account))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
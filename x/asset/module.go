@@ -72,30 +72,38 @@ type AppModule struct {
 	AppModuleBasic
 
 	assetKeeper   keeper.AssetKeeper
-	accountAuther chainType.AccountAuther
+	accountKeeper AccountKeeper
+}
+
+// AccountKeeper the account keeper used by the asset module, to auth the msgs and check the creators
+type AccountKeeper interface {
+	chainType.AccountAuther
+	keeper.AccountStatKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(accountAuther chainType.AccountAuther, assetKeeper keeper.AssetKeeper) AppModule {
+func NewAppModule(accountKeeper AccountKeeper, assetKeeper keeper.AssetKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(),
 		assetKeeper:    assetKeeper,
-		accountAuther:  accountAuther,
+		accountKeeper:  accountKeeper,
 	}
 }
 
 // Name returns the asset module's name.
 func (AppModule) Name() string { return types.ModuleName }
 
-// RegisterInvariants performs a no-op.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the asset module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.assetKeeper, am.accountKeeper)
+}
 
 // Route returns the message routing key for the asset module.
 func (AppModule) Route() string { return RouterKey }
 
 // NewHandler returns an sdk.Handler for the asset module.
 func (am AppModule) NewHandler() sdk.Handler {
-	return msg.WarpHandler(am.assetKeeper, am.accountAuther, NewHandler(am.assetKeeper))
+	return msg.WarpHandler(am.assetKeeper, am.accountKeeper, NewHandler(am.assetKeeper))
 }
 
 // QuerierRoute returns the asset module's querier route name.
@@ -120,7 +128,9 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 }
 
 // BeginBlock returns the begin blocker for the asset module.
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	BeginBlocker(ctx, am.assetKeeper)
+}
 
 // EndBlock returns the end blocker for the asset module. It returns no validator updates.
 func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
@@ -0,0 +1,40 @@
+package asset
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/asset/keeper"
+	"github.com/KuChainNetwork/kuchain/x/asset/types"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewIssuanceProposalHandler creates a governance handler to approve or reject the pending issuances
+func NewIssuanceProposalHandler(k keeper.AssetIssuanceKeeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) error {
+		switch c := content.(type) {
+		case types.IssuanceApprovalProposal:
+			return handleIssuanceApprovalProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized asset proposal content type: %T", c)
+		}
+	}
+}
+
+func handleIssuanceApprovalProposal(ctx sdk.Context, k keeper.AssetIssuanceKeeper, p types.IssuanceApprovalProposal) error {
+	eventType := types.EventTypeRejectIssuance
+	approve := k.RejectIssuance
+	if p.Approve {
+		eventType = types.EventTypeApproveIssuance
+		approve = k.ApproveIssuance
+	}
+
+	issuance, err := approve(ctx, p.IssuanceID)
+	if err != nil {
+		return err
+	}
+
+	emitIssuanceEvent(ctx, eventType, govTypes.ModuleName, issuance)
+
+	return nil
+}
@@ -17,6 +17,7 @@ var (
 	CoinDenom             = types.CoinDenom
 	CoinAccountsFromDenom = types.CoinAccountsFromDenom
 	NewCoin               = types.NewCoin
+	NewCoins              = types.NewCoins
 	NewName               = types.NewName
 	MustName              = types.MustName
 	NewAccountIDFromName  = types.NewAccountIDFromName
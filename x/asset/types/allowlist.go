@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"gopkg.in/yaml.v2"
+)
+
+// CoinAllowList the accounts allowed to transfer the allow-list-only coin, managed by the coin creator
+type CoinAllowList struct {
+	Creator       Name        `json:"creator" yaml:"creator"`
+	Symbol        Name        `json:"symbol" yaml:"symbol"`
+	AllowListOnly bool        `json:"allow_list_only,omitempty" yaml:"allow_list_only"`
+	Accounts      []AccountID `json:"accounts" yaml:"accounts"`
+}
+
+// NewCoinAllowList creates a new allow list of the coin
+func NewCoinAllowList(creator, symbol Name, allowListOnly bool, accounts []AccountID) CoinAllowList {
+	return CoinAllowList{
+		Creator:       creator,
+		Symbol:        symbol,
+		AllowListOnly: allowListOnly,
+		Accounts:      accounts,
+	}
+}
+
+// Validate validates the allow list in genesis
+func (l CoinAllowList) Validate() error {
+	if err := types.ValidateDenom(CoinDenom(l.Creator, l.Symbol)); err != nil {
+		return fmt.Errorf("allow list coin denom invalid: %w", err)
+	}
+
+	seen := make(map[string]bool, len(l.Accounts))
+	for _, account := range l.Accounts {
+		if account.Empty() {
+			return fmt.Errorf("allow list of %s has empty account", CoinDenom(l.Creator, l.Symbol))
+		}
+
+		if seen[account.String()] {
+			return fmt.Errorf("allow list of %s has duplicated account %s", CoinDenom(l.Creator, l.Symbol), account)
+		}
+		seen[account.String()] = true
+	}
+
+	return nil
+}
+
+func (l CoinAllowList) String() string {
+	res, _ := yaml.Marshal(l)
+	return string(res)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ClawbackGrant the coins granted by the funder to the grant account, such as the unvested team tokens,
+// the coins are locked in the grantee account until the unlock block height, and the funder can claw back
+// them before unlocked, as the grantee consented by signing the creation of the grant.
+type ClawbackGrant struct {
+	Grantee           AccountID `json:"grantee" yaml:"grantee"`
+	Funder            AccountID `json:"funder" yaml:"funder"`
+	Amount            Coins     `json:"amount" yaml:"amount"`
+	UnlockBlockHeight int64     `json:"unlockBlockHeight" yaml:"unlockBlockHeight"`
+	ConsentHeight     int64     `json:"consentHeight" yaml:"consentHeight"` // ConsentHeight the height the grantee consented to the clawback
+}
+
+// NewClawbackGrant creates a new clawback grant
+func NewClawbackGrant(grantee, funder AccountID, amount Coins, unlockBlockHeight, consentHeight int64) ClawbackGrant {
+	return ClawbackGrant{
+		Grantee:           grantee,
+		Funder:            funder,
+		Amount:            amount,
+		UnlockBlockHeight: unlockBlockHeight,
+		ConsentHeight:     consentHeight,
+	}
+}
+
+// IsVested returns if the grant coins has been unlocked at the height, which cannot be clawed back
+func (g ClawbackGrant) IsVested(height int64) bool {
+	return g.UnlockBlockHeight <= height
+}
+
+// Validate validates the clawback grant in genesis
+func (g ClawbackGrant) Validate() error {
+	if g.Grantee.Empty() || g.Funder.Empty() {
+		return fmt.Errorf("clawback grant grantee and funder cannot be empty")
+	}
+
+	if g.Grantee.Eq(g.Funder) {
+		return fmt.Errorf("clawback grant grantee cannot be the funder %s", g.Funder)
+	}
+
+	if !g.Amount.IsValid() || g.Amount.IsZero() {
+		return fmt.Errorf("clawback grant amount invalid: %s", g.Amount)
+	}
+
+	if g.UnlockBlockHeight <= 0 {
+		return fmt.Errorf("clawback grant unlock height should be positive: %d", g.UnlockBlockHeight)
+	}
+
+	return nil
+}
+
+func (g ClawbackGrant) String() string {
+	res, _ := yaml.Marshal(g)
+	return string(res)
+}
@@ -27,6 +27,40 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&MsgLockCoin{}, "asset/lock", nil)
 	cdc.RegisterConcrete(&MsgUnlockCoinData{}, "asset/unlockData", nil)
 	cdc.RegisterConcrete(&MsgUnlockCoin{}, "asset/unlock", nil)
+	cdc.RegisterConcrete(&MsgApproveIssuanceData{}, "asset/approveIssuanceData", nil)
+	cdc.RegisterConcrete(&MsgApproveIssuance{}, "asset/approveIssuance", nil)
+	cdc.RegisterConcrete(&MsgRejectIssuanceData{}, "asset/rejectIssuanceData", nil)
+	cdc.RegisterConcrete(&MsgRejectIssuance{}, "asset/rejectIssuance", nil)
+	cdc.RegisterConcrete(&MsgSetAllowListOnlyData{}, "asset/setAllowListOnlyData", nil)
+	cdc.RegisterConcrete(&MsgSetAllowListOnly{}, "asset/setAllowListOnly", nil)
+	cdc.RegisterConcrete(&MsgAddToAllowListData{}, "asset/addToAllowListData", nil)
+	cdc.RegisterConcrete(&MsgAddToAllowList{}, "asset/addToAllowList", nil)
+	cdc.RegisterConcrete(&MsgRemoveFromAllowListData{}, "asset/removeFromAllowListData", nil)
+	cdc.RegisterConcrete(&MsgRemoveFromAllowList{}, "asset/removeFromAllowList", nil)
+	cdc.RegisterConcrete(&MsgCreateClawbackGrantData{}, "asset/createClawbackGrantData", nil)
+	cdc.RegisterConcrete(&MsgCreateClawbackGrant{}, "asset/createClawbackGrant", nil)
+	cdc.RegisterConcrete(&MsgClawbackData{}, "asset/clawbackData", nil)
+	cdc.RegisterConcrete(&MsgClawback{}, "asset/clawback", nil)
+	cdc.RegisterConcrete(&MsgFreezeData{}, "asset/freezeData", nil)
+	cdc.RegisterConcrete(&MsgFreeze{}, "asset/freeze", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeData{}, "asset/unfreezeData", nil)
+	cdc.RegisterConcrete(&MsgUnfreeze{}, "asset/unfreeze", nil)
+	cdc.RegisterConcrete(&MsgSetTransfersPausedData{}, "asset/setTransfersPausedData", nil)
+	cdc.RegisterConcrete(&MsgSetTransfersPaused{}, "asset/setTransfersPaused", nil)
+	cdc.RegisterConcrete(&MsgCreateNFTCollectionData{}, "asset/createNFTCollectionData", nil)
+	cdc.RegisterConcrete(&MsgCreateNFTCollection{}, "asset/createNFTCollection", nil)
+	cdc.RegisterConcrete(&MsgMintNFTData{}, "asset/mintNFTData", nil)
+	cdc.RegisterConcrete(&MsgMintNFT{}, "asset/mintNFT", nil)
+	cdc.RegisterConcrete(&MsgTransferNFTData{}, "asset/transferNFTData", nil)
+	cdc.RegisterConcrete(&MsgTransferNFT{}, "asset/transferNFT", nil)
+	cdc.RegisterConcrete(&MsgBurnNFTData{}, "asset/burnNFTData", nil)
+	cdc.RegisterConcrete(&MsgBurnNFT{}, "asset/burnNFT", nil)
+	cdc.RegisterConcrete(&MsgEditNFTData{}, "asset/editNFTData", nil)
+	cdc.RegisterConcrete(&MsgEditNFT{}, "asset/editNFT", nil)
+	cdc.RegisterConcrete(&MsgTransferWithLockData{}, "asset/transferWithLockData", nil)
+	cdc.RegisterConcrete(&MsgTransferWithLock{}, "asset/transferWithLock", nil)
+
+	cdc.RegisterConcrete(MsgCreateCoinResponse{}, "asset/createResponse", nil)
 }
 
 // Cdc get codec for types
@@ -36,7 +36,11 @@ type CoinStat struct {
 	CanIssue      bool  `json:"can_issue,omitempty" yaml:"can_issue"`
 	CanLock       bool  `json:"can_lock,omitempty" yaml:"can_lock"`
 	IssueToHeight int64 `json:"issue_to_height,omitempty" yaml:"issue_to_height"`
-	InitSupply    Coin  `json:"init_supply" yaml:"init_supply"` // InitSupply coin init supply, if issue_to_height is not zero, this will be the start supply for issue
+	InitSupply    Coin  `json:"init_supply" yaml:"init_supply"`                   // InitSupply coin init supply, if issue_to_height is not zero, this will be the start supply for issue
+	AllowListOnly bool  `json:"allow_list_only,omitempty" yaml:"allow_list_only"` // AllowListOnly if true, only the accounts in the allow list of the creator can transfer the coin
+
+	Freezable       bool `json:"freezable,omitempty" yaml:"freezable"`               // Freezable if true, the creator can freeze the accounts and pause the transfers, only set at creation
+	TransfersPaused bool `json:"transfers_paused,omitempty" yaml:"transfers_paused"` // TransfersPaused if true, only the creator can transfer the coin
 }
 
 // NewCoinStat creates a Coin status
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"gopkg.in/yaml.v2"
+)
+
+// MaxDisplayExponent the max exponent of the display amounts
+const MaxDisplayExponent = 36
+
+// DenomDisplay the display metadata of a denom, edited by gov, the display amount is the base amount
+// divided by 10^Exponent, such as the base amount 1500000 with exponent 6 is displayed as 1.500000.
+type DenomDisplay struct {
+	Denom    string `json:"denom" yaml:"denom"`       // the base denom, such as kuchain/kcs
+	Exponent uint32 `json:"exponent" yaml:"exponent"` // the decimal places of the display amount
+	Symbol   string `json:"symbol" yaml:"symbol"`     // the display symbol, such as KCS
+}
+
+// NewDenomDisplay creates a new DenomDisplay object
+func NewDenomDisplay(denom string, exponent uint32, symbol string) DenomDisplay {
+	return DenomDisplay{
+		Denom:    denom,
+		Exponent: exponent,
+		Symbol:   symbol,
+	}
+}
+
+// Validate validates the display metadata
+func (d DenomDisplay) Validate() error {
+	if err := types.ValidateDenom(d.Denom); err != nil {
+		return err
+	}
+
+	if d.Exponent > MaxDisplayExponent {
+		return fmt.Errorf("exponent of %s should not be greater than %d", d.Denom, MaxDisplayExponent)
+	}
+
+	if strings.TrimSpace(d.Symbol) == "" {
+		return fmt.Errorf("display symbol of %s is empty", d.Denom)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (d DenomDisplay) String() string {
+	out, _ := yaml.Marshal(d)
+	return string(out)
+}
+
+// DenomDisplays the display metadata of the denoms
+type DenomDisplays []DenomDisplay
+
+// Get returns the display metadata of the denom
+func (ds DenomDisplays) Get(denom string) (DenomDisplay, bool) {
+	for _, d := range ds {
+		if d.Denom == denom {
+			return d, true
+		}
+	}
+
+	return DenomDisplay{}, false
+}
+
+// Validate validates the display metadata, each denom has at most one metadata
+func (ds DenomDisplays) Validate() error {
+	denoms := make(map[string]bool, len(ds))
+	for _, d := range ds {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+
+		if denoms[d.Denom] {
+			return fmt.Errorf("duplicated display of %s", d.Denom)
+		}
+		denoms[d.Denom] = true
+	}
+
+	return nil
+}
+
+// DisplayCoin a coin with both the base amount and the display amount
+type DisplayCoin struct {
+	Denom         string `json:"denom" yaml:"denom"`
+	Amount        Int    `json:"amount" yaml:"amount"`
+	DisplaySymbol string `json:"display_symbol" yaml:"display_symbol"`
+	DisplayAmount string `json:"display_amount" yaml:"display_amount"`
+	Exponent      uint32 `json:"exponent" yaml:"exponent"`
+}
+
+// NewDisplayCoin creates the display coin of the coin by the display metadata,
+// the coin is displayed as the base denom if no metadata of the denom.
+func NewDisplayCoin(coin Coin, displays DenomDisplays) DisplayCoin {
+	d, ok := displays.Get(coin.Denom)
+	if !ok {
+		d = NewDenomDisplay(coin.Denom, 0, coin.Denom)
+	}
+
+	return DisplayCoin{
+		Denom:         coin.Denom,
+		Amount:        coin.Amount,
+		DisplaySymbol: d.Symbol,
+		DisplayAmount: FormatDisplayAmount(coin.Amount, d.Exponent),
+		Exponent:      d.Exponent,
+	}
+}
+
+// String implements the Stringer interface.
+func (c DisplayCoin) String() string {
+	return fmt.Sprintf("%s%s (%s %s)", c.Amount, c.Denom, c.DisplayAmount, c.DisplaySymbol)
+}
+
+// DisplayCoins the display coins
+type DisplayCoins []DisplayCoin
+
+// NewDisplayCoins creates the display coins of the coins by the display metadata
+func NewDisplayCoins(coins Coins, displays DenomDisplays) DisplayCoins {
+	res := make(DisplayCoins, 0, len(coins))
+	for _, coin := range coins {
+		res = append(res, NewDisplayCoin(coin, displays))
+	}
+
+	return res
+}
+
+// String implements the Stringer interface.
+func (cs DisplayCoins) String() string {
+	strs := make([]string, 0, len(cs))
+	for _, c := range cs {
+		strs = append(strs, c.String())
+	}
+
+	return strings.Join(strs, ",")
+}
+
+// FormatDisplayAmount formats the base amount to the display amount with exponent decimal places,
+// the amount is formatted by the digits, so no precision lost for any exponent.
+func FormatDisplayAmount(amount Int, exponent uint32) string {
+	if exponent == 0 {
+		return amount.String()
+	}
+
+	digits := new(big.Int).Abs(amount.BigInt()).String()
+	if pad := int(exponent) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+
+	point := len(digits) - int(exponent)
+	res := digits[:point] + "." + digits[point:]
+	if amount.IsNegative() {
+		res = "-" + res
+	}
+
+	return res
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFormatDisplayAmount(t *testing.T) {
+	Convey("TestFormatDisplayAmount", t, func() {
+		So(FormatDisplayAmount(sdk.NewInt(1500000), 0), ShouldEqual, "1500000")
+		So(FormatDisplayAmount(sdk.NewInt(1500000), 6), ShouldEqual, "1.500000")
+		So(FormatDisplayAmount(sdk.NewInt(15), 6), ShouldEqual, "0.000015")
+		So(FormatDisplayAmount(sdk.NewInt(0), 2), ShouldEqual, "0.00")
+		So(FormatDisplayAmount(sdk.NewInt(-15), 3), ShouldEqual, "-0.015")
+
+		amount, ok := sdk.NewIntFromString("123456789012345678901234567890")
+		So(ok, ShouldBeTrue)
+		So(FormatDisplayAmount(amount, 27), ShouldEqual, "123.456789012345678901234567890")
+	})
+}
+
+func TestDenomDisplays(t *testing.T) {
+	denom := chainTypes.CoinDenom(chainTypes.MustName("kuchain"), chainTypes.MustName("kcs"))
+
+	Convey("TestDenomDisplays", t, func() {
+		displays := DenomDisplays{NewDenomDisplay(denom, 18, "KCS")}
+		So(displays.Validate(), ShouldBeNil)
+
+		So(append(displays, NewDenomDisplay(denom, 6, "KCS")).Validate(), ShouldNotBeNil)
+		So(DenomDisplays{NewDenomDisplay(denom, MaxDisplayExponent+1, "KCS")}.Validate(), ShouldNotBeNil)
+		So(DenomDisplays{NewDenomDisplay(denom, 18, " ")}.Validate(), ShouldNotBeNil)
+		So(DenomDisplays{NewDenomDisplay("", 18, "KCS")}.Validate(), ShouldNotBeNil)
+
+		other := chainTypes.CoinDenom(chainTypes.MustName("kuchain"), chainTypes.MustName("abc"))
+		coins := chainTypes.NewCoins(NewCoin(denom, sdk.NewInt(2500000000000000000)), NewCoin(other, sdk.NewInt(7)))
+		res := NewDisplayCoins(coins, displays)
+		So(res, ShouldHaveLength, 2)
+
+		So(res[0].Denom, ShouldEqual, other)
+		So(res[0].DisplaySymbol, ShouldEqual, other)
+		So(res[0].DisplayAmount, ShouldEqual, "7")
+
+		So(res[1].Denom, ShouldEqual, denom)
+		So(res[1].Amount.Equal(sdk.NewInt(2500000000000000000)), ShouldBeTrue)
+		So(res[1].DisplaySymbol, ShouldEqual, "KCS")
+		So(res[1].DisplayAmount, ShouldEqual, "2.500000000000000000")
+		So(res[1].Exponent, ShouldEqual, 18)
+	})
+}
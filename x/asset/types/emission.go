@@ -0,0 +1,180 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// EmissionCheckpoint the total amount emitted by the schedule when the blocks after the start reached
+type EmissionCheckpoint struct {
+	Blocks int64 `json:"blocks" yaml:"blocks"`
+	Amount Int   `json:"amount" yaml:"amount"`
+}
+
+// NewEmissionCheckpoint creates a checkpoint of the custom emission schedule
+func NewEmissionCheckpoint(blocks int64, amount Int) EmissionCheckpoint {
+	return EmissionCheckpoint{
+		Blocks: blocks,
+		Amount: amount,
+	}
+}
+
+// EmissionSchedule the schedule to mint the total coins to the recipient after the coin created,
+// by a cliff and linear schedule, or by the custom checkpoints if set. The heights of the schedule
+// are the blocks after the start, so the coins created after approved are emitted from the approval.
+type EmissionSchedule struct {
+	Recipient   AccountID            `json:"recipient" yaml:"recipient"`                         // Recipient the account the coins minted to, the creator if empty
+	Total       Coin                 `json:"total" yaml:"total"`                                 // Total the coins minted by the schedule
+	Cliff       int64                `json:"cliff,omitempty" yaml:"cliff"`                       // Cliff no coins minted until the blocks after the start
+	Duration    int64                `json:"duration,omitempty" yaml:"duration"`                 // Duration the total minted linearly in the blocks after the start
+	Checkpoints []EmissionCheckpoint `json:"checkpoints,omitempty" yaml:"checkpoints,omitempty"` // Checkpoints the custom schedule, the amounts are ascending to the total
+}
+
+// NewLinearEmissionSchedule creates a cliff and linear emission schedule
+func NewLinearEmissionSchedule(recipient AccountID, total Coin, cliff, duration int64) EmissionSchedule {
+	return EmissionSchedule{
+		Recipient: recipient,
+		Total:     total,
+		Cliff:     cliff,
+		Duration:  duration,
+	}
+}
+
+// NewCustomEmissionSchedule creates an emission schedule by the checkpoints
+func NewCustomEmissionSchedule(recipient AccountID, total Coin, checkpoints []EmissionCheckpoint) EmissionSchedule {
+	return EmissionSchedule{
+		Recipient:   recipient,
+		Total:       total,
+		Checkpoints: checkpoints,
+	}
+}
+
+// Validate validates the schedule of the coin denom
+func (s EmissionSchedule) Validate(denom string) error {
+	if s.Total.Denom != denom {
+		return sdkerrors.Wrapf(ErrAssetEmissionSchedule, "total denom should be %s", denom)
+	}
+
+	if !s.Total.IsValid() || s.Total.IsZero() {
+		return sdkerrors.Wrapf(ErrAssetEmissionSchedule, "total should be positive: %s", s.Total)
+	}
+
+	if len(s.Checkpoints) == 0 {
+		if s.Duration <= 0 {
+			return sdkerrors.Wrapf(ErrAssetEmissionSchedule, "duration should be positive: %d", s.Duration)
+		}
+
+		if s.Cliff < 0 || s.Cliff > s.Duration {
+			return sdkerrors.Wrapf(ErrAssetEmissionSchedule, "cliff should be in [0, duration]: %d", s.Cliff)
+		}
+
+		return nil
+	}
+
+	if s.Cliff != 0 || s.Duration != 0 {
+		return sdkerrors.Wrap(ErrAssetEmissionSchedule, "cliff and duration should not be set with checkpoints")
+	}
+
+	var last EmissionCheckpoint
+	for i, c := range s.Checkpoints {
+		if c.Blocks <= last.Blocks {
+			return sdkerrors.Wrapf(ErrAssetEmissionSchedule, "checkpoint %d blocks should be positive and ascending", i)
+		}
+
+		if c.Amount == (Int{}) || !c.Amount.IsPositive() || (i > 0 && c.Amount.LTE(last.Amount)) {
+			return sdkerrors.Wrapf(ErrAssetEmissionSchedule, "checkpoint %d amount should be positive and ascending", i)
+		}
+
+		last = c
+	}
+
+	if !last.Amount.Equal(s.Total.Amount) {
+		return sdkerrors.Wrapf(ErrAssetEmissionSchedule, "the last checkpoint amount should be the total %s", s.Total.Amount)
+	}
+
+	return nil
+}
+
+// EmittedAt returns the total amount should be emitted when the blocks after the start reached
+func (s EmissionSchedule) EmittedAt(blocks int64) Int {
+	if len(s.Checkpoints) > 0 {
+		res := NewInt(0)
+		for _, c := range s.Checkpoints {
+			if c.Blocks > blocks {
+				break
+			}
+			res = c.Amount
+		}
+		return res
+	}
+
+	switch {
+	case blocks < s.Cliff || blocks <= 0:
+		return NewInt(0)
+	case blocks >= s.Duration:
+		return s.Total.Amount
+	default:
+		return s.Total.Amount.MulRaw(blocks).QuoRaw(s.Duration)
+	}
+}
+
+// CoinEmission the emission schedule of the coin in progress
+type CoinEmission struct {
+	Creator     Name             `json:"creator" yaml:"creator"`
+	Symbol      Name             `json:"symbol" yaml:"symbol"`
+	StartHeight int64            `json:"start_height" yaml:"start_height"` // StartHeight the height the coin created
+	Schedule    EmissionSchedule `json:"schedule" yaml:"schedule"`
+	Emitted     Coin             `json:"emitted" yaml:"emitted"` // Emitted the coins minted by the schedule
+}
+
+// NewCoinEmission creates the emission of the coin started at the height
+func NewCoinEmission(creator, symbol Name, startHeight int64, schedule EmissionSchedule) CoinEmission {
+	return CoinEmission{
+		Creator:     creator,
+		Symbol:      symbol,
+		StartHeight: startHeight,
+		Schedule:    schedule,
+		Emitted:     NewCoin(schedule.Total.Denom, NewInt(0)),
+	}
+}
+
+// Due returns the coins should be minted at the height
+func (e CoinEmission) Due(height int64) Coin {
+	emitted := e.Schedule.EmittedAt(height - e.StartHeight)
+	if emitted.LTE(e.Emitted.Amount) {
+		return NewCoin(e.Emitted.Denom, NewInt(0))
+	}
+
+	return NewCoin(e.Emitted.Denom, emitted.Sub(e.Emitted.Amount))
+}
+
+// Remaining returns the coins not minted yet
+func (e CoinEmission) Remaining() Coin {
+	return e.Schedule.Total.Sub(e.Emitted)
+}
+
+// IsCompleted returns if the total coins of the schedule minted
+func (e CoinEmission) IsCompleted() bool {
+	return e.Emitted.IsGTE(e.Schedule.Total)
+}
+
+// Validate validates the coin emission in genesis
+func (e CoinEmission) Validate() error {
+	denom := CoinDenom(e.Creator, e.Symbol)
+	if err := e.Schedule.Validate(denom); err != nil {
+		return err
+	}
+
+	if e.Emitted.Denom != denom || e.Emitted.IsNegative() || !e.Schedule.Total.IsGTE(e.Emitted) {
+		return fmt.Errorf("coin emission of %s emitted invalid: %s", denom, e.Emitted)
+	}
+
+	return nil
+}
+
+func (e CoinEmission) String() string {
+	res, _ := yaml.Marshal(e)
+	return string(res)
+}
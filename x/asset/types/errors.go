@@ -23,4 +23,30 @@ var (
 	ErrAssetCoinMustSupplyNeedGTInitSupply   = sdkerrors.Register(ModuleName, 18, "coin max_supply need > init_supply")
 	ErrAssetIssueToHeightMustGTCurrentHeight = sdkerrors.Register(ModuleName, 19, "coin issue to height must > current height")
 	ErrAssetSymbolError                      = sdkerrors.Register(ModuleName, 20, "asset symbol error")
+	ErrAssetIssuancePending                  = sdkerrors.Register(ModuleName, 21, "asset issuance is pending for approval")
+	ErrAssetIssuanceNotFound                 = sdkerrors.Register(ModuleName, 22, "asset pending issuance not found")
+	ErrAssetIssuanceApprover                 = sdkerrors.Register(ModuleName, 23, "asset issuance approver is not the registry")
+	ErrAssetNotInAllowList                   = sdkerrors.Register(ModuleName, 24, "account is not in the allow list of coin")
+	ErrAssetAllowListAccounts                = sdkerrors.Register(ModuleName, 25, "allow list accounts error")
+	ErrAssetClawbackDisabled                 = sdkerrors.Register(ModuleName, 26, "asset clawback is disabled")
+	ErrAssetClawbackGrantExists              = sdkerrors.Register(ModuleName, 27, "account already has an unvested clawback grant")
+	ErrAssetClawbackGrantNotFound            = sdkerrors.Register(ModuleName, 28, "clawback grant not found")
+	ErrAssetClawbackFunder                   = sdkerrors.Register(ModuleName, 29, "account is not the funder of the clawback grant")
+	ErrAssetClawbackGrantVested              = sdkerrors.Register(ModuleName, 30, "clawback grant coins has vested")
+	ErrAssetClawbackGrantFunds               = sdkerrors.Register(ModuleName, 31, "clawback grant coins not transferred to grantee")
+	ErrAssetEmissionSchedule                 = sdkerrors.Register(ModuleName, 32, "asset emission schedule invalid")
+	ErrAssetEmissionNotFound                 = sdkerrors.Register(ModuleName, 33, "asset emission schedule not found")
+	ErrAssetNotFreezable                     = sdkerrors.Register(ModuleName, 34, "coin is not freezable")
+	ErrAssetAccountFrozen                    = sdkerrors.Register(ModuleName, 35, "account is frozen for coin")
+	ErrAssetTransfersPaused                  = sdkerrors.Register(ModuleName, 36, "transfers of coin are paused")
+	ErrAssetFreezeAccounts                   = sdkerrors.Register(ModuleName, 37, "freeze accounts error")
+	ErrAssetNFTCollection                    = sdkerrors.Register(ModuleName, 38, "nft collection invalid")
+	ErrAssetNFTCollectionExists              = sdkerrors.Register(ModuleName, 39, "nft collection already exists")
+	ErrAssetNFTCollectionNotFound            = sdkerrors.Register(ModuleName, 40, "nft collection not found")
+	ErrAssetNFTID                            = sdkerrors.Register(ModuleName, 41, "nft id invalid")
+	ErrAssetNFTMetadata                      = sdkerrors.Register(ModuleName, 42, "nft metadata invalid")
+	ErrAssetNFTExists                        = sdkerrors.Register(ModuleName, 43, "nft already exists")
+	ErrAssetNFTNotFound                      = sdkerrors.Register(ModuleName, 44, "nft not found")
+	ErrAssetNFTOwner                         = sdkerrors.Register(ModuleName, 45, "account is not the owner of nft")
+	ErrAssetTransferLock                     = sdkerrors.Register(ModuleName, 46, "transfer lock invalid")
 )
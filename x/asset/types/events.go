@@ -10,6 +10,32 @@ const (
 	EventTypeTransfer = "transfer"
 	EventTypeLock     = "lock"
 	EventTypeUnlock   = "unlock"
+
+	EventTypeSubmitIssuance  = "submit_issuance"
+	EventTypeApproveIssuance = "approve_issuance"
+	EventTypeRejectIssuance  = "reject_issuance"
+
+	EventTypeSetAllowListOnly    = "set_allow_list_only"
+	EventTypeAddToAllowList      = "add_allow_list"
+	EventTypeRemoveFromAllowList = "remove_allow_list"
+
+	EventTypeCreateClawbackGrant = "create_clawback_grant"
+	EventTypeClawback            = "clawback"
+
+	EventTypeEmission = "emission"
+
+	EventTypeFreeze             = "freeze"
+	EventTypeUnfreeze           = "unfreeze"
+	EventTypeSetTransfersPaused = "set_transfers_paused"
+
+	EventTypeCreateNFTCollection = "create_nft_collection"
+	EventTypeMintNFT             = "mint_nft"
+	EventTypeTransferNFT         = "transfer_nft"
+	EventTypeBurnNFT             = "burn_nft"
+	EventTypeEditNFT             = "edit_nft"
+
+	EventTypeTransferWithLock = "transfer_with_lock"
+	EventTypeTransferUnlock   = "transfer_unlock"
 )
 
 const (
@@ -26,4 +52,19 @@ const (
 	AttributeKeyIssueToHeight = "issueToHeight"
 	AttributeKeyInit          = "init"
 	AttributeKeyDescription   = "desc"
+	AttributeKeyIssuanceID    = "issuanceID"
+	AttributeKeyApprover      = "approver"
+	AttributeKeyAllowListOnly = "allowListOnly"
+	AttributeKeyFunder        = "funder"
+	AttributeKeyGrantee       = "grantee"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyEmission      = "emission"
+	AttributeKeyEmitted       = "emitted"
+	AttributeKeyFreezable     = "freezable"
+	AttributeKeyPaused        = "paused"
+	AttributeKeyCollection    = "collection"
+	AttributeKeyNFTID         = "nftID"
+	AttributeKeyOwner         = "owner"
+	AttributeKeyCliffHeight   = "cliffHeight"
+	AttributeKeyEndHeight     = "endHeight"
 )
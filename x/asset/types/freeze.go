@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"gopkg.in/yaml.v2"
+)
+
+// CoinFreeze the freeze state of the freezable coin, managed by the coin creator, the frozen accounts
+// cannot transfer the coin, and only the creator can transfer the coin if the transfers paused.
+type CoinFreeze struct {
+	Creator         Name        `json:"creator" yaml:"creator"`
+	Symbol          Name        `json:"symbol" yaml:"symbol"`
+	TransfersPaused bool        `json:"transfers_paused,omitempty" yaml:"transfers_paused"`
+	FrozenAccounts  []AccountID `json:"frozen_accounts" yaml:"frozen_accounts"`
+}
+
+// NewCoinFreeze creates a new freeze state of the coin
+func NewCoinFreeze(creator, symbol Name, transfersPaused bool, frozenAccounts []AccountID) CoinFreeze {
+	return CoinFreeze{
+		Creator:         creator,
+		Symbol:          symbol,
+		TransfersPaused: transfersPaused,
+		FrozenAccounts:  frozenAccounts,
+	}
+}
+
+// Validate validates the freeze state in genesis
+func (f CoinFreeze) Validate() error {
+	denom := CoinDenom(f.Creator, f.Symbol)
+	if err := types.ValidateDenom(denom); err != nil {
+		return fmt.Errorf("coin freeze denom invalid: %w", err)
+	}
+
+	creator := NewAccountIDFromName(f.Creator)
+	seen := make(map[string]bool, len(f.FrozenAccounts))
+	for _, account := range f.FrozenAccounts {
+		if account.Empty() || account.Eq(creator) {
+			return fmt.Errorf("coin freeze of %s has invalid account %s", denom, account)
+		}
+
+		if seen[account.String()] {
+			return fmt.Errorf("coin freeze of %s has duplicated account %s", denom, account)
+		}
+		seen[account.String()] = true
+	}
+
+	return nil
+}
+
+func (f CoinFreeze) String() string {
+	res, _ := yaml.Marshal(f)
+	return string(res)
+}
@@ -12,6 +12,36 @@ import (
 type GenesisState struct {
 	GenesisAssets []GenesisAsset `json:"genesisAssets"`
 	GenesisCoins  []GenesisCoin  `json:"genesisCoins"`
+
+	// GenesisLockedCoins the coins locked in genesis, used for vesting schedules
+	GenesisLockedCoins []GenesisLockedCoins `json:"genesisLockedCoins,omitempty"`
+
+	// Params the asset params
+	Params Params `json:"params"`
+
+	// PendingIssuances the coin creations waiting for the approval
+	PendingIssuances []PendingIssuance `json:"pendingIssuances,omitempty"`
+
+	// CoinAllowLists the allow lists of the allow-list-only coins
+	CoinAllowLists []CoinAllowList `json:"coinAllowLists,omitempty"`
+
+	// ClawbackGrants the grant accounts the funders can claw back the unvested coins
+	ClawbackGrants []ClawbackGrant `json:"clawbackGrants,omitempty"`
+
+	// CoinEmissions the emission schedules of the coins in progress
+	CoinEmissions []CoinEmission `json:"coinEmissions,omitempty"`
+
+	// CoinFreezes the freeze states of the freezable coins
+	CoinFreezes []CoinFreeze `json:"coinFreezes,omitempty"`
+
+	// NFTCollections the collections of the non-fungible tokens
+	NFTCollections []NFTCollection `json:"nftCollections,omitempty"`
+
+	// NFTs the non-fungible tokens in the collections
+	NFTs []NFT `json:"nfts,omitempty"`
+
+	// TransferLocks the coins transferred locked by the vesting schedules
+	TransferLocks []AccountTransferLocks `json:"transferLocks,omitempty"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -19,6 +49,7 @@ func NewGenesisState() GenesisState {
 	return GenesisState{
 		GenesisAssets: make([]GenesisAsset, 0),
 		GenesisCoins:  make([]GenesisCoin, 0),
+		Params:        DefaultParams(),
 	}
 }
 
@@ -37,7 +68,82 @@ func (g GenesisState) ValidateGenesis(bz json.RawMessage) error {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
 	}
 
-	return nil
+	for _, l := range gs.GenesisLockedCoins {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	ids := make(map[uint64]bool, len(gs.PendingIssuances))
+	for _, p := range gs.PendingIssuances {
+		if p.ID == 0 || ids[p.ID] {
+			return fmt.Errorf("genesis pending issuance id invalid or duplicated: %d", p.ID)
+		}
+		ids[p.ID] = true
+	}
+
+	for _, l := range gs.CoinAllowLists {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+	}
+
+	grantees := make(map[string]bool, len(gs.ClawbackGrants))
+	for _, g := range gs.ClawbackGrants {
+		if err := g.Validate(); err != nil {
+			return err
+		}
+
+		if grantees[g.Grantee.String()] {
+			return fmt.Errorf("genesis clawback grant of %s duplicated", g.Grantee)
+		}
+		grantees[g.Grantee.String()] = true
+	}
+
+	denoms := make(map[string]bool, len(gs.CoinEmissions))
+	for _, e := range gs.CoinEmissions {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+
+		denom := CoinDenom(e.Creator, e.Symbol)
+		if denoms[denom] {
+			return fmt.Errorf("genesis coin emission of %s duplicated", denom)
+		}
+		denoms[denom] = true
+	}
+
+	freezes := make(map[string]bool, len(gs.CoinFreezes))
+	for _, f := range gs.CoinFreezes {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+
+		denom := CoinDenom(f.Creator, f.Symbol)
+		if freezes[denom] {
+			return fmt.Errorf("genesis coin freeze of %s duplicated", denom)
+		}
+		freezes[denom] = true
+	}
+
+	transferLocks := make(map[string]bool, len(gs.TransferLocks))
+	for _, l := range gs.TransferLocks {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+
+		key := l.Account.String() + "/" + l.Denom
+		if transferLocks[key] {
+			return fmt.Errorf("genesis transfer locks of %s in %s duplicated", l.Account, l.Denom)
+		}
+		transferLocks[key] = true
+	}
+
+	return validateGenesisNFTs(gs.NFTCollections, gs.NFTs)
 }
 
 // GenesisAsset gensis asset for accountID
@@ -130,3 +236,35 @@ func (g BaseGensisAssetCoin) GetMaxSupply() Coin { return g.MaxSupply }
 
 // GetDescription imp GenesisCoin
 func (g BaseGensisAssetCoin) GetDescription() string { return g.Description }
+
+// GenesisLockedCoins the coins of account locked until the unlock block height
+type GenesisLockedCoins struct {
+	ID                AccountID `json:"id"`
+	UnlockBlockHeight int64     `json:"unlockBlockHeight"`
+	Coins             Coins     `json:"coins"`
+}
+
+func NewGenesisLockedCoins(id AccountID, unlockBlockHeight int64, coins Coins) GenesisLockedCoins {
+	return GenesisLockedCoins{
+		ID:                id,
+		UnlockBlockHeight: unlockBlockHeight,
+		Coins:             coins,
+	}
+}
+
+// Validate validates the genesis locked coins
+func (g GenesisLockedCoins) Validate() error {
+	if g.ID.Empty() {
+		return fmt.Errorf("genesis locked coins account cannot be empty")
+	}
+
+	if g.UnlockBlockHeight <= 0 {
+		return fmt.Errorf("genesis locked coins unlock height should be positive: %d", g.UnlockBlockHeight)
+	}
+
+	if !g.Coins.IsValid() || g.Coins.IsZero() {
+		return fmt.Errorf("genesis locked coins invalid: %s", g.Coins)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// PendingIssuance the coin creation waiting for the approval, when the issuance
+// approval enabled, the coin will be created by the data after approved.
+type PendingIssuance struct {
+	ID     uint64            `json:"id" yaml:"id"`
+	Height int64             `json:"height" yaml:"height"` // the block height the issuance submitted
+	Coin   MsgCreateCoinData `json:"coin" yaml:"coin"`
+}
+
+// NewPendingIssuance creates a pending issuance
+func NewPendingIssuance(id uint64, height int64, coin MsgCreateCoinData) PendingIssuance {
+	return PendingIssuance{
+		ID:     id,
+		Height: height,
+		Coin:   coin,
+	}
+}
+
+// String implements the Stringer interface.
+func (p PendingIssuance) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+const (
+	// ProposalTypeIssuanceApproval defines the type for a IssuanceApprovalProposal
+	ProposalTypeIssuanceApproval = "IssuanceApproval"
+)
+
+// Assert IssuanceApprovalProposal implements govtypes.Content at compile-time
+var _ govTypes.Content = IssuanceApprovalProposal{}
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeIssuanceApproval)
+	govTypes.RegisterProposalTypeCodec(IssuanceApprovalProposal{}, "kuchain/IssuanceApprovalProposal")
+}
+
+// IssuanceApprovalProposal approves or rejects a pending issuance by governance
+type IssuanceApprovalProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	IssuanceID  uint64 `json:"issuance_id" yaml:"issuance_id"`
+	Approve     bool   `json:"approve" yaml:"approve"`
+}
+
+// NewIssuanceApprovalProposal creates a new issuance approval proposal.
+func NewIssuanceApprovalProposal(title, description string, issuanceID uint64, approve bool) IssuanceApprovalProposal {
+	return IssuanceApprovalProposal{title, description, issuanceID, approve}
+}
+
+// GetTitle returns the title of a issuance approval proposal.
+func (p IssuanceApprovalProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a issuance approval proposal.
+func (p IssuanceApprovalProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a issuance approval proposal.
+func (p IssuanceApprovalProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a issuance approval proposal.
+func (p IssuanceApprovalProposal) ProposalType() string { return ProposalTypeIssuanceApproval }
+
+// ValidateBasic runs basic stateless validity checks
+func (p IssuanceApprovalProposal) ValidateBasic() error {
+	if err := govTypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.IssuanceID == 0 {
+		return sdkerrors.Wrap(ErrAssetIssuanceNotFound, "issuance id should be positive")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p IssuanceApprovalProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Issuance Approval Proposal:
+  Title:       %s
+  Description: %s
+  Issuance:    %d
+  Approve:     %t
+`, p.Title, p.Description, p.IssuanceID, p.Approve))
+	return b.String()
+}
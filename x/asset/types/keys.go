@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/pkg/errors"
@@ -34,6 +35,25 @@ var (
 	CoinStatStoreKeyPrefix       = chainTypes.MustName("coin.stat").Bytes()
 	CoinDescStoreKeyPrefix       = chainTypes.MustName("coin.desc").Bytes()
 
+	PendingIssuanceStoreKeyPrefix      = chainTypes.MustName("coin.pending").Bytes()
+	PendingIssuanceDenomStoreKeyPrefix = chainTypes.MustName("coin.pendings").Bytes()
+	PendingIssuanceIDStoreKey          = genCoinStoreKey(chainTypes.MustName("coin.pendingid").Bytes())
+
+	CoinAllowListStoreKeyPrefix = chainTypes.MustName("coin.allow").Bytes()
+
+	ClawbackGrantStoreKeyPrefix = chainTypes.MustName("coin.clawback").Bytes()
+
+	CoinEmissionStoreKeyPrefix = chainTypes.MustName("coin.emission").Bytes()
+
+	CoinFrozenStoreKeyPrefix = chainTypes.MustName("coin.frozen").Bytes()
+
+	NFTCollectionStoreKeyPrefix = chainTypes.MustName("nft.collection").Bytes()
+	NFTStoreKeyPrefix           = chainTypes.MustName("nft.token").Bytes()
+	NFTOwnerStoreKeyPrefix      = chainTypes.MustName("nft.owner").Bytes()
+
+	TransferLockStoreKeyPrefix      = chainTypes.MustName("coin.translock").Bytes()
+	TransferLockQueueStoreKeyPrefix = chainTypes.MustName("coin.lockqueue").Bytes()
+
 	coinStoreKeyPreLen = len(AssetModuleKeyPrefix)
 )
 
@@ -124,3 +144,93 @@ func CoinDescStoreKey(creator, symbol chainTypes.Name) []byte {
 	}
 	return genCoinStoreKey(CoinDescStoreKeyPrefix, creator.Bytes(), symbol.Bytes())
 }
+
+// PendingIssuanceStoreKey get the key of the pending issuance by id
+func PendingIssuanceStoreKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return genCoinStoreKey(PendingIssuanceStoreKeyPrefix, bz)
+}
+
+// PendingIssuanceDenomStoreKey get the key of the pending issuance id by the coin creator and symbol
+func PendingIssuanceDenomStoreKey(creator, symbol chainTypes.Name) []byte {
+	return genCoinStoreKey(PendingIssuanceDenomStoreKeyPrefix, creator.Bytes(), symbol.Bytes())
+}
+
+// CoinAllowListStoreKey get the key of the account in the allow list of the coin
+func CoinAllowListStoreKey(creator, symbol chainTypes.Name, account chainTypes.AccountID) []byte {
+	return genCoinStoreKey(CoinAllowListStoreKeyPrefix, creator.Bytes(), symbol.Bytes(), account.StoreKey())
+}
+
+// ClawbackGrantStoreKey get the key of the clawback grant of the grantee account
+func ClawbackGrantStoreKey(grantee chainTypes.AccountID) []byte {
+	return genCoinStoreKey(ClawbackGrantStoreKeyPrefix, grantee.StoreKey())
+}
+
+// CoinEmissionStoreKey get the key of the emission schedule of the coin
+func CoinEmissionStoreKey(creator, symbol chainTypes.Name) []byte {
+	return genCoinStoreKey(CoinEmissionStoreKeyPrefix, creator.Bytes(), symbol.Bytes())
+}
+
+// CoinFrozenStoreKey get the key of the frozen account of the coin
+func CoinFrozenStoreKey(creator, symbol chainTypes.Name, account chainTypes.AccountID) []byte {
+	return genCoinStoreKey(CoinFrozenStoreKeyPrefix, creator.Bytes(), symbol.Bytes(), account.StoreKey())
+}
+
+// CoinFrozenPrefix get the key prefix of the frozen accounts of the coin
+func CoinFrozenPrefix(creator, symbol chainTypes.Name) []byte {
+	return genCoinStoreKey(CoinFrozenStoreKeyPrefix, creator.Bytes(), symbol.Bytes())
+}
+
+// NFTCollectionStoreKey get the key of the nft collection
+func NFTCollectionStoreKey(creator, name chainTypes.Name) []byte {
+	return genCoinStoreKey(NFTCollectionStoreKeyPrefix, creator.Bytes(), name.Bytes())
+}
+
+// NFTStoreKey get the key of the token in the nft collection
+func NFTStoreKey(creator, collection chainTypes.Name, id string) []byte {
+	return genCoinStoreKey(NFTStoreKeyPrefix, creator.Bytes(), collection.Bytes(), []byte(id))
+}
+
+// NFTPrefix get the key prefix of the tokens in the nft collection
+func NFTPrefix(creator, collection chainTypes.Name) []byte {
+	return genCoinStoreKey(NFTStoreKeyPrefix, creator.Bytes(), collection.Bytes())
+}
+
+// NFTOwnerStoreKey get the key of the index of the token owned by the account
+func NFTOwnerStoreKey(owner chainTypes.AccountID, creator, collection chainTypes.Name, id string) []byte {
+	return genCoinStoreKey(NFTOwnerStoreKeyPrefix, owner.StoreKey(), creator.Bytes(), collection.Bytes(), []byte(id))
+}
+
+// NFTOwnerPrefix get the key prefix of the index of the tokens owned by the account
+func NFTOwnerPrefix(owner chainTypes.AccountID) []byte {
+	return genCoinStoreKey(NFTOwnerStoreKeyPrefix, owner.StoreKey())
+}
+
+// TransferLockStoreKey get the key of the transfer locks of the coins in the denom of the account
+func TransferLockStoreKey(account chainTypes.AccountID, denom string) []byte {
+	return genCoinStoreKey(TransferLockStoreKeyPrefix, account.StoreKey(), []byte(denom))
+}
+
+// TransferLockPrefix get the key prefix of the transfer locks of the account
+func TransferLockPrefix(account chainTypes.AccountID) []byte {
+	return genCoinStoreKey(TransferLockStoreKeyPrefix, account.StoreKey())
+}
+
+// TransferLockQueuePrefix get the key prefix of the transfer locks to unlock at the height
+func TransferLockQueuePrefix(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return genCoinStoreKey(TransferLockQueueStoreKeyPrefix, bz)
+}
+
+// TransferLockQueueStoreKey get the key of the transfer locks of the coins in the denom of the account
+// in the queue to unlock at the height
+func TransferLockQueueStoreKey(height int64, account chainTypes.AccountID, denom string) []byte {
+	return append(append(TransferLockQueuePrefix(height), account.StoreKey()...), denom...)
+}
+
+// CoinAllowListPrefix get the key prefix of the allow list of the coin
+func CoinAllowListPrefix(creator, symbol chainTypes.Name) []byte {
+	return genCoinStoreKey(CoinAllowListStoreKeyPrefix, creator.Bytes(), symbol.Bytes())
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+var _ types.MsgResponse = MsgCreateCoinResponse{}
+
+// MsgCreateCoinResponse is the response of the create coin msg, if the issuance approval
+// enabled, the coin is not created but submitted to the pending issuances.
+type MsgCreateCoinResponse struct {
+	Denom      string `json:"denom" yaml:"denom"`
+	IssuanceID uint64 `json:"issuance_id,omitempty" yaml:"issuance_id,omitempty"`
+}
+
+// CreatedID implements types.MsgResponse, it returns the pending issuance id if the coin is not created
+func (r MsgCreateCoinResponse) CreatedID() string {
+	if r.IssuanceID != 0 {
+		return strconv.FormatUint(r.IssuanceID, 10)
+	}
+	return r.Denom
+}
@@ -14,6 +14,12 @@ const RouterKey = ModuleName
 var (
 	RouterKeyName                 = types.MustName(RouterKey)
 	_, _, _, _, _ types.KuMsgData = (*MsgCreateCoinData)(nil), (*MsgIssueCoinData)(nil), (*MsgBurnCoinData)(nil), (*MsgLockCoinData)(nil), (*MsgUnlockCoinData)(nil)
+	_, _          types.KuMsgData = (*MsgApproveIssuanceData)(nil), (*MsgRejectIssuanceData)(nil)
+	_, _, _       types.KuMsgData = (*MsgSetAllowListOnlyData)(nil), (*MsgAddToAllowListData)(nil), (*MsgRemoveFromAllowListData)(nil)
+	_, _          types.KuMsgData = (*MsgCreateClawbackGrantData)(nil), (*MsgClawbackData)(nil)
+	_, _, _       types.KuMsgData = (*MsgFreezeData)(nil), (*MsgUnfreezeData)(nil), (*MsgSetTransfersPausedData)(nil)
+	_, _, _, _, _ types.KuMsgData = (*MsgCreateNFTCollectionData)(nil), (*MsgMintNFTData)(nil), (*MsgTransferNFTData)(nil), (*MsgBurnNFTData)(nil), (*MsgEditNFTData)(nil)
+	_             types.KuMsgData = (*MsgTransferWithLockData)(nil)
 )
 
 type (
@@ -42,6 +48,12 @@ type MsgCreateCoinData struct {
 	IssueToHeight int64  `json:"issue_to_height,omitempty" yaml:"issue_to_height"` // IssueToHeight if this is not zero, creator only can issue this
 	InitSupply    Coin   `json:"init_supply" yaml:"init_supply"`                   // InitSupply coin init supply, if issue_to_height is not zero, this will be the start supply for issue
 	Desc          []byte `json:"desc" yaml:"desc"`                                 // Description
+
+	// Emission the schedule minting the coins in the begin blockers after the coin created, optional
+	Emission *EmissionSchedule `json:"emission,omitempty" yaml:"emission,omitempty"`
+
+	// Freezable if the creator can freeze the accounts and pause the transfers of the coin, cannot be changed after created
+	Freezable bool `json:"freezable,omitempty" yaml:"freezable,omitempty"`
 }
 
 func (MsgCreateCoinData) Type() types.Name { return types.MustName("create@asset") }
@@ -70,6 +82,37 @@ func NewMsgCreate(auth types.AccAddress, creator types.Name, symbol types.Name,
 	}
 }
 
+// NewMsgCreateWithEmission new create coin msg with the schedule minting the coins after the coin created
+func NewMsgCreateWithEmission(auth types.AccAddress, creator types.Name, symbol types.Name, maxSupply types.Coin, canIssue, canLock bool, desc []byte, emission EmissionSchedule) MsgCreateCoin {
+	return MsgCreateCoin{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgCreateCoinData{
+				Creator:    creator,
+				Symbol:     symbol,
+				MaxSupply:  maxSupply,
+				CanIssue:   canIssue,
+				CanLock:    canLock,
+				InitSupply: types.NewCoin(maxSupply.Denom, types.NewInt(0)),
+				Desc:       desc,
+				Emission:   &emission,
+			}),
+		),
+	}
+}
+
+// NewMsgCreateByData new create coin msg by the data, used to create the coin with the optional fields
+func NewMsgCreateByData(auth types.AccAddress, data MsgCreateCoinData) MsgCreateCoin {
+	return MsgCreateCoin{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &data),
+		),
+	}
+}
+
 func (msg MsgCreateCoin) GetData() (MsgCreateCoinData, error) {
 	res := MsgCreateCoinData{}
 	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
@@ -113,6 +156,30 @@ func (msg MsgCreateCoin) ValidateBasic() error {
 		return err
 	}
 
+	if data.Emission != nil {
+		if err := data.ValidateEmission(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateEmission validates the emission schedule of the coin, the supply limited by the issue to height
+// conflicts with the schedule, and the total emitted should not be more than the max supply.
+func (msg MsgCreateCoinData) ValidateEmission() error {
+	if msg.IssueToHeight != 0 {
+		return sdkerrors.Wrap(ErrAssetEmissionSchedule, "issue to height should not be set with emission")
+	}
+
+	if err := msg.Emission.Validate(msg.MaxSupply.Denom); err != nil {
+		return err
+	}
+
+	if !msg.MaxSupply.IsGTE(msg.Emission.Total) {
+		return sdkerrors.Wrapf(ErrAssetEmissionSchedule, "total %s should not be more than max supply", msg.Emission.Total)
+	}
+
 	return nil
 }
 
@@ -382,3 +449,992 @@ func (msg MsgUnlockCoin) ValidateBasic() error {
 
 	return nil
 }
+
+type MsgApproveIssuance struct {
+	types.KuMsg
+}
+
+type MsgApproveIssuanceData struct {
+	Approver AccountID `json:"approver" yaml:"approver"` // Approver the registry account
+	ID       uint64    `json:"id" yaml:"id"`             // ID the pending issuance id
+}
+
+// Type imp for data KuMsgData
+func (m *MsgApproveIssuanceData) Type() types.Name { return types.MustName("approve@coin") }
+
+func (m MsgApproveIssuanceData) Sender() AccountID {
+	return m.Approver
+}
+
+// NewMsgApproveIssuance create new approve issuance msg by the registry account
+func NewMsgApproveIssuance(auth types.AccAddress, approver types.AccountID, id uint64) MsgApproveIssuance {
+	return MsgApproveIssuance{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgApproveIssuanceData{
+				Approver: approver,
+				ID:       id,
+			}),
+		),
+	}
+}
+
+func (msg MsgApproveIssuance) GetData() (MsgApproveIssuanceData, error) {
+	res := MsgApproveIssuanceData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgApproveIssuanceData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgApproveIssuance) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	return validateIssuanceReview(data.Approver, data.ID)
+}
+
+type MsgRejectIssuance struct {
+	types.KuMsg
+}
+
+type MsgRejectIssuanceData struct {
+	Approver AccountID `json:"approver" yaml:"approver"` // Approver the registry account
+	ID       uint64    `json:"id" yaml:"id"`             // ID the pending issuance id
+}
+
+// Type imp for data KuMsgData
+func (m *MsgRejectIssuanceData) Type() types.Name { return types.MustName("reject@coin") }
+
+func (m MsgRejectIssuanceData) Sender() AccountID {
+	return m.Approver
+}
+
+// NewMsgRejectIssuance create new reject issuance msg by the registry account
+func NewMsgRejectIssuance(auth types.AccAddress, approver types.AccountID, id uint64) MsgRejectIssuance {
+	return MsgRejectIssuance{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgRejectIssuanceData{
+				Approver: approver,
+				ID:       id,
+			}),
+		),
+	}
+}
+
+func (msg MsgRejectIssuance) GetData() (MsgRejectIssuanceData, error) {
+	res := MsgRejectIssuanceData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgRejectIssuanceData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgRejectIssuance) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	return validateIssuanceReview(data.Approver, data.ID)
+}
+
+func validateIssuanceReview(approver AccountID, id uint64) error {
+	if approver.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if id == 0 {
+		return sdkerrors.Wrap(ErrAssetIssuanceNotFound, "issuance id should be positive")
+	}
+
+	return nil
+}
+
+// MaxAllowListAccountsInMsg the max number of accounts to add or remove in one allow list msg
+const MaxAllowListAccountsInMsg = 128
+
+type MsgSetAllowListOnly struct {
+	types.KuMsg
+}
+
+type MsgSetAllowListOnlyData struct {
+	Creator       Name `json:"creator" yaml:"creator"`                 // Creator coin creator account name
+	Symbol        Name `json:"symbol" yaml:"symbol"`                   // Symbol coin symbol name
+	AllowListOnly bool `json:"allow_list_only" yaml:"allow_list_only"` // AllowListOnly if the coin only can be transferred between the accounts in allow list
+}
+
+// Type imp for data KuMsgData
+func (m *MsgSetAllowListOnlyData) Type() types.Name { return types.MustName("allowonly@coin") }
+
+func (m MsgSetAllowListOnlyData) Sender() AccountID {
+	return NewAccountIDFromName(m.Creator)
+}
+
+// NewMsgSetAllowListOnly create new msg to mark the coin as allow-list-only by the creator
+func NewMsgSetAllowListOnly(auth types.AccAddress, creator, symbol types.Name, allowListOnly bool) MsgSetAllowListOnly {
+	return MsgSetAllowListOnly{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgSetAllowListOnlyData{
+				Creator:       creator,
+				Symbol:        symbol,
+				AllowListOnly: allowListOnly,
+			}),
+		),
+	}
+}
+
+func (msg MsgSetAllowListOnly) GetData() (MsgSetAllowListOnlyData, error) {
+	res := MsgSetAllowListOnlyData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgSetAllowListOnlyData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgSetAllowListOnly) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	return types.ValidateDenom(types.CoinDenom(data.Creator, data.Symbol))
+}
+
+type MsgAddToAllowList struct {
+	types.KuMsg
+}
+
+type MsgAddToAllowListData struct {
+	Creator  Name        `json:"creator" yaml:"creator"`   // Creator coin creator account name
+	Symbol   Name        `json:"symbol" yaml:"symbol"`     // Symbol coin symbol name
+	Accounts []AccountID `json:"accounts" yaml:"accounts"` // Accounts the accounts to add to the allow list
+}
+
+// Type imp for data KuMsgData
+func (m *MsgAddToAllowListData) Type() types.Name { return types.MustName("allowadd@coin") }
+
+func (m MsgAddToAllowListData) Sender() AccountID {
+	return NewAccountIDFromName(m.Creator)
+}
+
+// NewMsgAddToAllowList create new msg to add accounts to the allow list of the coin by the creator
+func NewMsgAddToAllowList(auth types.AccAddress, creator, symbol types.Name, accounts ...types.AccountID) MsgAddToAllowList {
+	return MsgAddToAllowList{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgAddToAllowListData{
+				Creator:  creator,
+				Symbol:   symbol,
+				Accounts: accounts,
+			}),
+		),
+	}
+}
+
+func (msg MsgAddToAllowList) GetData() (MsgAddToAllowListData, error) {
+	res := MsgAddToAllowListData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgAddToAllowListData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgAddToAllowList) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	return validateAllowListAccounts(data.Creator, data.Symbol, data.Accounts)
+}
+
+type MsgRemoveFromAllowList struct {
+	types.KuMsg
+}
+
+type MsgRemoveFromAllowListData struct {
+	Creator  Name        `json:"creator" yaml:"creator"`   // Creator coin creator account name
+	Symbol   Name        `json:"symbol" yaml:"symbol"`     // Symbol coin symbol name
+	Accounts []AccountID `json:"accounts" yaml:"accounts"` // Accounts the accounts to remove from the allow list
+}
+
+// Type imp for data KuMsgData
+func (m *MsgRemoveFromAllowListData) Type() types.Name { return types.MustName("allowrm@coin") }
+
+func (m MsgRemoveFromAllowListData) Sender() AccountID {
+	return NewAccountIDFromName(m.Creator)
+}
+
+// NewMsgRemoveFromAllowList create new msg to remove accounts from the allow list of the coin by the creator
+func NewMsgRemoveFromAllowList(auth types.AccAddress, creator, symbol types.Name, accounts ...types.AccountID) MsgRemoveFromAllowList {
+	return MsgRemoveFromAllowList{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgRemoveFromAllowListData{
+				Creator:  creator,
+				Symbol:   symbol,
+				Accounts: accounts,
+			}),
+		),
+	}
+}
+
+func (msg MsgRemoveFromAllowList) GetData() (MsgRemoveFromAllowListData, error) {
+	res := MsgRemoveFromAllowListData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgRemoveFromAllowListData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgRemoveFromAllowList) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	return validateAllowListAccounts(data.Creator, data.Symbol, data.Accounts)
+}
+
+func validateAllowListAccounts(creator, symbol Name, accounts []AccountID) error {
+	if err := types.ValidateDenom(types.CoinDenom(creator, symbol)); err != nil {
+		return err
+	}
+
+	if len(accounts) == 0 {
+		return sdkerrors.Wrap(ErrAssetAllowListAccounts, "accounts should not be empty")
+	}
+
+	if len(accounts) > MaxAllowListAccountsInMsg {
+		return sdkerrors.Wrapf(ErrAssetAllowListAccounts, "too many accounts %d, max %d", len(accounts), MaxAllowListAccountsInMsg)
+	}
+
+	for _, account := range accounts {
+		if account.Empty() {
+			return types.ErrKuMsgAccountIDNil
+		}
+	}
+
+	return nil
+}
+
+type MsgCreateClawbackGrant struct {
+	types.KuMsg
+}
+
+type MsgCreateClawbackGrantData struct {
+	Funder            AccountID `json:"funder" yaml:"funder"`                       // Funder the account funds the grant and can claw back it
+	Grantee           AccountID `json:"grantee" yaml:"grantee"`                     // Grantee the grant account, which should sign the msg to consent
+	Amount            Coins     `json:"amount" yaml:"amount"`                       // Amount coins to grant, transferred by the msg
+	UnlockBlockHeight int64     `json:"unlockBlockHeight" yaml:"unlockBlockHeight"` // UnlockBlockHeight the block height the coins vested
+}
+
+// Type imp for data KuMsgData
+func (m *MsgCreateClawbackGrantData) Type() types.Name { return types.MustName("grant@coin") }
+
+func (m MsgCreateClawbackGrantData) Sender() AccountID {
+	return m.Funder
+}
+
+// NewMsgCreateClawbackGrant create new msg to grant the coins locked in grantee, which the funder can claw back,
+// both funder and grantee should sign the msg
+func NewMsgCreateClawbackGrant(funderAuth, granteeAuth types.AccAddress, funder, grantee types.AccountID, amount types.Coins, unlockBlockHeight int64) MsgCreateClawbackGrant {
+	return MsgCreateClawbackGrant{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuths([]types.AccAddress{funderAuth, granteeAuth}),
+			msg.WithTransfer(funder, grantee, amount),
+			msg.WithData(Cdc(), &MsgCreateClawbackGrantData{
+				Funder:            funder,
+				Grantee:           grantee,
+				Amount:            amount,
+				UnlockBlockHeight: unlockBlockHeight,
+			}),
+		),
+	}
+}
+
+func (msg MsgCreateClawbackGrant) GetData() (MsgCreateClawbackGrantData, error) {
+	res := MsgCreateClawbackGrantData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgCreateClawbackGrantData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgCreateClawbackGrant) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Funder.Empty() || data.Grantee.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if data.Funder.Eq(data.Grantee) {
+		return sdkerrors.Wrap(ErrAssetClawbackFunder, "grantee cannot be the funder")
+	}
+
+	if !data.Amount.IsValid() || data.Amount.IsZero() {
+		return sdkerrors.Wrapf(ErrAssetCoinNoEnough, "grant amount %s invalid", data.Amount)
+	}
+
+	if data.UnlockBlockHeight <= 0 {
+		return ErrAssetLockUnlockBlockHeightErr
+	}
+
+	return nil
+}
+
+type MsgClawback struct {
+	types.KuMsg
+}
+
+type MsgClawbackData struct {
+	Funder  AccountID `json:"funder" yaml:"funder"`   // Funder the funder of the grant
+	Grantee AccountID `json:"grantee" yaml:"grantee"` // Grantee the grant account to claw back
+}
+
+// Type imp for data KuMsgData
+func (m *MsgClawbackData) Type() types.Name { return types.MustName("clawback@coin") }
+
+func (m MsgClawbackData) Sender() AccountID {
+	return m.Funder
+}
+
+// NewMsgClawback create new msg to claw back the unvested coins of the grant account by the funder
+func NewMsgClawback(auth types.AccAddress, funder, grantee types.AccountID) MsgClawback {
+	return MsgClawback{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgClawbackData{
+				Funder:  funder,
+				Grantee: grantee,
+			}),
+		),
+	}
+}
+
+func (msg MsgClawback) GetData() (MsgClawbackData, error) {
+	res := MsgClawbackData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgClawbackData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgClawback) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Funder.Empty() || data.Grantee.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	return nil
+}
+
+// MaxFreezeAccountsInMsg the max number of accounts to freeze or unfreeze in one msg
+const MaxFreezeAccountsInMsg = 128
+
+type MsgFreeze struct {
+	types.KuMsg
+}
+
+type MsgFreezeData struct {
+	Creator  Name        `json:"creator" yaml:"creator"`   // Creator coin creator account name
+	Symbol   Name        `json:"symbol" yaml:"symbol"`     // Symbol coin symbol name
+	Accounts []AccountID `json:"accounts" yaml:"accounts"` // Accounts the accounts to freeze
+}
+
+// Type imp for data KuMsgData
+func (m *MsgFreezeData) Type() types.Name { return types.MustName("freeze@coin") }
+
+func (m MsgFreezeData) Sender() AccountID {
+	return NewAccountIDFromName(m.Creator)
+}
+
+// NewMsgFreeze create new msg to freeze the accounts of the freezable coin by the creator
+func NewMsgFreeze(auth types.AccAddress, creator, symbol types.Name, accounts ...types.AccountID) MsgFreeze {
+	return MsgFreeze{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgFreezeData{
+				Creator:  creator,
+				Symbol:   symbol,
+				Accounts: accounts,
+			}),
+		),
+	}
+}
+
+func (msg MsgFreeze) GetData() (MsgFreezeData, error) {
+	res := MsgFreezeData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgFreezeData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgFreeze) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	return validateFreezeAccounts(data.Creator, data.Symbol, data.Accounts)
+}
+
+type MsgUnfreeze struct {
+	types.KuMsg
+}
+
+type MsgUnfreezeData struct {
+	Creator  Name        `json:"creator" yaml:"creator"`   // Creator coin creator account name
+	Symbol   Name        `json:"symbol" yaml:"symbol"`     // Symbol coin symbol name
+	Accounts []AccountID `json:"accounts" yaml:"accounts"` // Accounts the frozen accounts to unfreeze
+}
+
+// Type imp for data KuMsgData
+func (m *MsgUnfreezeData) Type() types.Name { return types.MustName("unfreeze@coin") }
+
+func (m MsgUnfreezeData) Sender() AccountID {
+	return NewAccountIDFromName(m.Creator)
+}
+
+// NewMsgUnfreeze create new msg to unfreeze the frozen accounts of the coin by the creator
+func NewMsgUnfreeze(auth types.AccAddress, creator, symbol types.Name, accounts ...types.AccountID) MsgUnfreeze {
+	return MsgUnfreeze{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgUnfreezeData{
+				Creator:  creator,
+				Symbol:   symbol,
+				Accounts: accounts,
+			}),
+		),
+	}
+}
+
+func (msg MsgUnfreeze) GetData() (MsgUnfreezeData, error) {
+	res := MsgUnfreezeData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgUnfreezeData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgUnfreeze) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	return validateFreezeAccounts(data.Creator, data.Symbol, data.Accounts)
+}
+
+func validateFreezeAccounts(creator, symbol Name, accounts []AccountID) error {
+	if err := types.ValidateDenom(types.CoinDenom(creator, symbol)); err != nil {
+		return err
+	}
+
+	if len(accounts) == 0 {
+		return sdkerrors.Wrap(ErrAssetFreezeAccounts, "accounts should not be empty")
+	}
+
+	if len(accounts) > MaxFreezeAccountsInMsg {
+		return sdkerrors.Wrapf(ErrAssetFreezeAccounts, "too many accounts %d, max %d", len(accounts), MaxFreezeAccountsInMsg)
+	}
+
+	for _, account := range accounts {
+		if account.Empty() {
+			return types.ErrKuMsgAccountIDNil
+		}
+	}
+
+	return nil
+}
+
+type MsgSetTransfersPaused struct {
+	types.KuMsg
+}
+
+type MsgSetTransfersPausedData struct {
+	Creator Name `json:"creator" yaml:"creator"` // Creator coin creator account name
+	Symbol  Name `json:"symbol" yaml:"symbol"`   // Symbol coin symbol name
+	Paused  bool `json:"paused" yaml:"paused"`   // Paused if only the creator can transfer the coin
+}
+
+// Type imp for data KuMsgData
+func (m *MsgSetTransfersPausedData) Type() types.Name { return types.MustName("pause@coin") }
+
+func (m MsgSetTransfersPausedData) Sender() AccountID {
+	return NewAccountIDFromName(m.Creator)
+}
+
+// NewMsgSetTransfersPaused create new msg to pause or resume the transfers of the freezable coin by the creator
+func NewMsgSetTransfersPaused(auth types.AccAddress, creator, symbol types.Name, paused bool) MsgSetTransfersPaused {
+	return MsgSetTransfersPaused{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgSetTransfersPausedData{
+				Creator: creator,
+				Symbol:  symbol,
+				Paused:  paused,
+			}),
+		),
+	}
+}
+
+func (msg MsgSetTransfersPaused) GetData() (MsgSetTransfersPausedData, error) {
+	res := MsgSetTransfersPausedData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgSetTransfersPausedData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgSetTransfersPaused) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	return types.ValidateDenom(types.CoinDenom(data.Creator, data.Symbol))
+}
+
+type MsgCreateNFTCollection struct {
+	types.KuMsg
+}
+
+type MsgCreateNFTCollectionData struct {
+	Creator     Name   `json:"creator" yaml:"creator"`         // Creator collection creator account name
+	Name        Name   `json:"name" yaml:"name"`               // Name collection name
+	Description string `json:"description" yaml:"description"` // Description collection description
+}
+
+// Type imp for data KuMsgData
+func (m *MsgCreateNFTCollectionData) Type() types.Name { return types.MustName("nftcreate@coin") }
+
+func (m MsgCreateNFTCollectionData) Sender() AccountID {
+	return NewAccountIDFromName(m.Creator)
+}
+
+// NewMsgCreateNFTCollection create new msg to create the collection of the non-fungible tokens
+func NewMsgCreateNFTCollection(auth types.AccAddress, creator, name types.Name, description string) MsgCreateNFTCollection {
+	return MsgCreateNFTCollection{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgCreateNFTCollectionData{
+				Creator:     creator,
+				Name:        name,
+				Description: description,
+			}),
+		),
+	}
+}
+
+func (msg MsgCreateNFTCollection) GetData() (MsgCreateNFTCollectionData, error) {
+	res := MsgCreateNFTCollectionData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgCreateNFTCollectionData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgCreateNFTCollection) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	return NewNFTCollection(data.Creator, data.Name, data.Description, 0).Validate()
+}
+
+type MsgMintNFT struct {
+	types.KuMsg
+}
+
+type MsgMintNFTData struct {
+	Creator    Name      `json:"creator" yaml:"creator"`       // Creator collection creator account name
+	Collection Name      `json:"collection" yaml:"collection"` // Collection collection name
+	ID         string    `json:"id" yaml:"id"`                 // ID token id in the collection
+	Owner      AccountID `json:"owner" yaml:"owner"`           // Owner the account the token minted to
+	Data       string    `json:"data" yaml:"data"`             // Data the immutable data of the token
+	Metadata   string    `json:"metadata" yaml:"metadata"`     // Metadata the mutable metadata of the token
+}
+
+// Type imp for data KuMsgData
+func (m *MsgMintNFTData) Type() types.Name { return types.MustName("nftmint@coin") }
+
+func (m MsgMintNFTData) Sender() AccountID {
+	return NewAccountIDFromName(m.Creator)
+}
+
+// NewMsgMintNFT create new msg to mint the token in the collection to the owner by the creator
+func NewMsgMintNFT(auth types.AccAddress, token NFT) MsgMintNFT {
+	return MsgMintNFT{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgMintNFTData{
+				Creator:    token.Creator,
+				Collection: token.Collection,
+				ID:         token.ID,
+				Owner:      token.Owner,
+				Data:       token.Data,
+				Metadata:   token.Metadata,
+			}),
+		),
+	}
+}
+
+func (msg MsgMintNFT) GetData() (MsgMintNFTData, error) {
+	res := MsgMintNFTData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgMintNFTData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgMintNFT) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	return data.NFT().Validate()
+}
+
+// NFT returns the token to mint
+func (m MsgMintNFTData) NFT() NFT {
+	return NewNFT(m.Creator, m.Collection, m.ID, m.Owner, m.Data, m.Metadata)
+}
+
+type MsgTransferNFT struct {
+	types.KuMsg
+}
+
+type MsgTransferNFTData struct {
+	From       AccountID `json:"from" yaml:"from"`             // From the owner of the token
+	To         AccountID `json:"to" yaml:"to"`                 // To the account to transfer the token to
+	Creator    Name      `json:"creator" yaml:"creator"`       // Creator collection creator account name
+	Collection Name      `json:"collection" yaml:"collection"` // Collection collection name
+	ID         string    `json:"id" yaml:"id"`                 // ID token id in the collection
+}
+
+// Type imp for data KuMsgData
+func (m *MsgTransferNFTData) Type() types.Name { return types.MustName("nftsend@coin") }
+
+func (m MsgTransferNFTData) Sender() AccountID {
+	return m.From
+}
+
+// NewMsgTransferNFT create new msg to transfer the token by the owner
+func NewMsgTransferNFT(auth types.AccAddress, from, to types.AccountID, creator, collection types.Name, id string) MsgTransferNFT {
+	return MsgTransferNFT{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgTransferNFTData{
+				From:       from,
+				To:         to,
+				Creator:    creator,
+				Collection: collection,
+				ID:         id,
+			}),
+		),
+	}
+}
+
+func (msg MsgTransferNFT) GetData() (MsgTransferNFTData, error) {
+	res := MsgTransferNFTData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgTransferNFTData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgTransferNFT) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.From.Empty() || data.To.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if data.From.Eq(data.To) {
+		return sdkerrors.Wrap(ErrAssetNFTOwner, "cannot transfer the token to the owner self")
+	}
+
+	return validateNFTRef(data.Creator, data.Collection, data.ID)
+}
+
+type MsgBurnNFT struct {
+	types.KuMsg
+}
+
+type MsgBurnNFTData struct {
+	Owner      AccountID `json:"owner" yaml:"owner"`           // Owner the owner of the token
+	Creator    Name      `json:"creator" yaml:"creator"`       // Creator collection creator account name
+	Collection Name      `json:"collection" yaml:"collection"` // Collection collection name
+	ID         string    `json:"id" yaml:"id"`                 // ID token id in the collection
+}
+
+// Type imp for data KuMsgData
+func (m *MsgBurnNFTData) Type() types.Name { return types.MustName("nftburn@coin") }
+
+func (m MsgBurnNFTData) Sender() AccountID {
+	return m.Owner
+}
+
+// NewMsgBurnNFT create new msg to burn the token by the owner
+func NewMsgBurnNFT(auth types.AccAddress, owner types.AccountID, creator, collection types.Name, id string) MsgBurnNFT {
+	return MsgBurnNFT{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgBurnNFTData{
+				Owner:      owner,
+				Creator:    creator,
+				Collection: collection,
+				ID:         id,
+			}),
+		),
+	}
+}
+
+func (msg MsgBurnNFT) GetData() (MsgBurnNFTData, error) {
+	res := MsgBurnNFTData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgBurnNFTData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgBurnNFT) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.Owner.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	return validateNFTRef(data.Creator, data.Collection, data.ID)
+}
+
+type MsgEditNFT struct {
+	types.KuMsg
+}
+
+type MsgEditNFTData struct {
+	Creator    Name   `json:"creator" yaml:"creator"`       // Creator collection creator account name
+	Collection Name   `json:"collection" yaml:"collection"` // Collection collection name
+	ID         string `json:"id" yaml:"id"`                 // ID token id in the collection
+	Metadata   string `json:"metadata" yaml:"metadata"`     // Metadata the new mutable metadata of the token
+}
+
+// Type imp for data KuMsgData
+func (m *MsgEditNFTData) Type() types.Name { return types.MustName("nftedit@coin") }
+
+func (m MsgEditNFTData) Sender() AccountID {
+	return NewAccountIDFromName(m.Creator)
+}
+
+// NewMsgEditNFT create new msg to edit the mutable metadata of the token by the creator of the collection
+func NewMsgEditNFT(auth types.AccAddress, creator, collection types.Name, id, metadata string) MsgEditNFT {
+	return MsgEditNFT{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgEditNFTData{
+				Creator:    creator,
+				Collection: collection,
+				ID:         id,
+				Metadata:   metadata,
+			}),
+		),
+	}
+}
+
+func (msg MsgEditNFT) GetData() (MsgEditNFTData, error) {
+	res := MsgEditNFTData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgEditNFTData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgEditNFT) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if err := validateNFTRef(data.Creator, data.Collection, data.ID); err != nil {
+		return err
+	}
+
+	return ValidateNFTMetadata(data.Metadata)
+}
+
+func validateNFTRef(creator, collection Name, id string) error {
+	if creator.Empty() || collection.Empty() {
+		return sdkerrors.Wrap(ErrAssetNFTCollection, "collection creator and name should not be empty")
+	}
+
+	return ValidateNFTID(id)
+}
+
+type MsgTransferWithLock struct {
+	types.KuMsg
+}
+
+type MsgTransferWithLockData struct {
+	From        AccountID `json:"from" yaml:"from"`               // From the account transfers the coins
+	To          AccountID `json:"to" yaml:"to"`                   // To the account the coins locked in
+	Amount      Coins     `json:"amount" yaml:"amount"`           // Amount coins to transfer, transferred by the msg
+	CliffHeight int64     `json:"cliffHeight" yaml:"cliffHeight"` // CliffHeight no coins unlocked before the height
+	EndHeight   int64     `json:"endHeight" yaml:"endHeight"`     // EndHeight all the coins unlocked at the height
+}
+
+// Type imp for data KuMsgData
+func (m *MsgTransferWithLockData) Type() types.Name { return types.MustName("translock@coin") }
+
+func (m MsgTransferWithLockData) Sender() AccountID {
+	return m.From
+}
+
+// NewMsgTransferWithLock create new msg to transfer the coins locked in the account, which unlocked linearly
+// from the block of the msg to the end height after the cliff height, or all at the end height if cliff is it.
+func NewMsgTransferWithLock(auth types.AccAddress, from, to types.AccountID, amount types.Coins, cliffHeight, endHeight int64) MsgTransferWithLock {
+	return MsgTransferWithLock{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithTransfer(from, to, amount),
+			msg.WithData(Cdc(), &MsgTransferWithLockData{
+				From:        from,
+				To:          to,
+				Amount:      amount,
+				CliffHeight: cliffHeight,
+				EndHeight:   endHeight,
+			}),
+		),
+	}
+}
+
+func (msg MsgTransferWithLock) GetData() (MsgTransferWithLockData, error) {
+	res := MsgTransferWithLockData{}
+	if err := msg.UnmarshalData(Cdc(), &res); err != nil {
+		return MsgTransferWithLockData{}, sdkerrors.Wrapf(types.ErrKuMsgDataUnmarshal, "%s", err.Error())
+	}
+	return res, nil
+}
+
+func (msg MsgTransferWithLock) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	data, err := msg.GetData()
+	if err != nil {
+		return err
+	}
+
+	if data.From.Empty() || data.To.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if data.From.Eq(data.To) {
+		return sdkerrors.Wrap(ErrAssetTransferLock, "cannot transfer with lock to self")
+	}
+
+	if !data.Amount.IsValid() || data.Amount.IsZero() {
+		return sdkerrors.Wrapf(ErrAssetCoinNoEnough, "transfer amount %s invalid", data.Amount)
+	}
+
+	return ValidateTransferLockHeights(0, data.CliffHeight, data.EndHeight)
+}
@@ -0,0 +1,186 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// MaxNFTIDLength the max length of the id of the token in the collection
+	MaxNFTIDLength = 64
+
+	// MaxNFTMetadataLength the max length of the immutable data and the mutable metadata of the token
+	MaxNFTMetadataLength = 1024
+)
+
+// NFTCollection the collection of the non-fungible tokens, scoped by the creator account,
+// only the creator can mint the tokens and edit the mutable metadata of the tokens.
+type NFTCollection struct {
+	Creator      Name   `json:"creator" yaml:"creator"`
+	Name         Name   `json:"name" yaml:"name"`
+	Description  string `json:"description" yaml:"description"`
+	Supply       uint64 `json:"supply" yaml:"supply"` // Supply the number of the tokens not burned
+	CreateHeight int64  `json:"create_height" yaml:"create_height"`
+}
+
+// NewNFTCollection creates a new collection
+func NewNFTCollection(creator, name Name, description string, createHeight int64) NFTCollection {
+	return NFTCollection{
+		Creator:      creator,
+		Name:         name,
+		Description:  description,
+		CreateHeight: createHeight,
+	}
+}
+
+// Validate validates the collection
+func (c NFTCollection) Validate() error {
+	if c.Creator.Empty() || c.Name.Empty() {
+		return sdkerrors.Wrap(ErrAssetNFTCollection, "collection creator and name should not be empty")
+	}
+
+	if len(c.Description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(ErrAssetNFTCollection, "collection description too long %d, max %d", len(c.Description), MaxDescriptionLength)
+	}
+
+	return nil
+}
+
+func (c NFTCollection) String() string {
+	res, _ := yaml.Marshal(c)
+	return string(res)
+}
+
+// NFT the non-fungible token in the collection, the data is immutable after minted,
+// while the metadata can be edited by the creator of the collection.
+type NFT struct {
+	Creator    Name      `json:"creator" yaml:"creator"`
+	Collection Name      `json:"collection" yaml:"collection"`
+	ID         string    `json:"id" yaml:"id"`
+	Owner      AccountID `json:"owner" yaml:"owner"`
+	Data       string    `json:"data,omitempty" yaml:"data"`
+	Metadata   string    `json:"metadata,omitempty" yaml:"metadata"`
+}
+
+// NewNFT creates a new token
+func NewNFT(creator, collection Name, id string, owner AccountID, data, metadata string) NFT {
+	return NFT{
+		Creator:    creator,
+		Collection: collection,
+		ID:         id,
+		Owner:      owner,
+		Data:       data,
+		Metadata:   metadata,
+	}
+}
+
+// Validate validates the token
+func (n NFT) Validate() error {
+	if n.Creator.Empty() || n.Collection.Empty() {
+		return sdkerrors.Wrap(ErrAssetNFTCollection, "collection creator and name should not be empty")
+	}
+
+	if err := ValidateNFTID(n.ID); err != nil {
+		return err
+	}
+
+	if n.Owner.Empty() {
+		return types.ErrKuMsgAccountIDNil
+	}
+
+	if err := ValidateNFTMetadata(n.Data); err != nil {
+		return err
+	}
+
+	return ValidateNFTMetadata(n.Metadata)
+}
+
+func (n NFT) String() string {
+	res, _ := yaml.Marshal(n)
+	return string(res)
+}
+
+// ValidateNFTID validates the id of the token, which is of the letters, digits, '.', '_' and '-'
+func ValidateNFTID(id string) error {
+	if len(id) == 0 || len(id) > MaxNFTIDLength {
+		return sdkerrors.Wrapf(ErrAssetNFTID, "id length should be in [1, %d]", MaxNFTIDLength)
+	}
+
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '.', c == '_', c == '-':
+		default:
+			return sdkerrors.Wrapf(ErrAssetNFTID, "id %s has invalid char %q", id, c)
+		}
+	}
+
+	return nil
+}
+
+// ValidateNFTMetadata validates the length of the data or the metadata of the token
+func ValidateNFTMetadata(metadata string) error {
+	if len(metadata) > MaxNFTMetadataLength {
+		return sdkerrors.Wrapf(ErrAssetNFTMetadata, "metadata too long %d, max %d", len(metadata), MaxNFTMetadataLength)
+	}
+
+	return nil
+}
+
+// NFTs the page of the tokens queried
+type NFTs struct {
+	Total  uint64 `json:"total" yaml:"total"`
+	Tokens []NFT  `json:"tokens" yaml:"tokens"`
+}
+
+func (n NFTs) String() string {
+	res, _ := yaml.Marshal(n)
+	return string(res)
+}
+
+// validateGenesisNFTs validates the collections and the tokens in genesis, the supplies of
+// the collections should be the numbers of the tokens.
+func validateGenesisNFTs(collections []NFTCollection, tokens []NFT) error {
+	supplies := make(map[string]uint64, len(collections))
+	for _, c := range collections {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s", c.Creator, c.Name)
+		if _, ok := supplies[key]; ok {
+			return fmt.Errorf("genesis nft collection %s duplicated", key)
+		}
+		supplies[key] = 0
+	}
+
+	ids := make(map[string]bool, len(tokens))
+	for _, n := range tokens {
+		if err := n.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s", n.Creator, n.Collection)
+		if _, ok := supplies[key]; !ok {
+			return fmt.Errorf("genesis nft %s of collection %s not found", n.ID, key)
+		}
+		supplies[key]++
+
+		id := key + "/" + n.ID
+		if ids[id] {
+			return fmt.Errorf("genesis nft %s duplicated", id)
+		}
+		ids[id] = true
+	}
+
+	for _, c := range collections {
+		key := fmt.Sprintf("%s/%s", c.Creator, c.Name)
+		if supplies[key] != c.Supply {
+			return fmt.Errorf("genesis nft collection %s supply %d not equal to the tokens %d", key, c.Supply, supplies[key])
+		}
+	}
+
+	return nil
+}
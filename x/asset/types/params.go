@@ -0,0 +1,159 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	params "github.com/KuChainNetwork/kuchain/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace = ModuleName
+)
+
+// Parameter store keys
+var (
+	KeyIssuanceApproval = []byte("IssuanceApproval")
+	KeyRegistry         = []byte("Registry")
+	KeyClawbackDisabled = []byte("ClawbackDisabled")
+	KeyDenomDisplays    = []byte("DenomDisplays")
+	KeyMinTransferLock  = []byte("MinTransferLock")
+)
+
+// DefaultMinTransferLock the min amount of the core coins locked by a transfer
+var DefaultMinTransferLock = types.NewInt64CoreCoins(100000)
+
+// Params asset parameters
+type Params struct {
+	IssuanceApproval bool          `json:"issuance_approval" yaml:"issuance_approval"` // creating coins need approval by gov or the registry
+	Registry         AccountID     `json:"registry" yaml:"registry"`                   // the account can approve the issuances, empty for gov only
+	ClawbackDisabled bool          `json:"clawback_disabled" yaml:"clawback_disabled"` // the switch for gov to disable the clawback of grant accounts
+	DenomDisplays    DenomDisplays `json:"denom_displays" yaml:"denom_displays"`       // the display metadata of the denoms in the query responses
+	MinTransferLock  Coins         `json:"min_transfer_lock" yaml:"min_transfer_lock"` // the min amount of the coins locked by a transfer, other denoms cannot be locked
+}
+
+// ParamKeyTable ParamTable for asset module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(issuanceApproval bool, registry AccountID, clawbackDisabled bool, denomDisplays DenomDisplays, minTransferLock Coins) Params {
+	return Params{
+		IssuanceApproval: issuanceApproval,
+		Registry:         registry,
+		ClawbackDisabled: clawbackDisabled,
+		DenomDisplays:    denomDisplays,
+		MinTransferLock:  minTransferLock,
+	}
+}
+
+// DefaultParams default asset module parameters, the coins can be created without approval
+// and the clawback of grant accounts is enabled, no display metadata of the denoms,
+// only the core coins can be locked by the transfers
+func DefaultParams() Params {
+	return NewParams(false, types.EmptyAccountID(), false, DenomDisplays{}, DefaultMinTransferLock)
+}
+
+// ValidateTransferLockAmount returns error if the coin is less than the min amount to lock
+func (p Params) ValidateTransferLockAmount(coin Coin) error {
+	min := p.MinTransferLock.AmountOf(coin.Denom)
+	if min.IsZero() {
+		return fmt.Errorf("%s cannot be locked by transfer", coin.Denom)
+	}
+
+	if coin.Amount.LT(min) {
+		return fmt.Errorf("%s less than the min transfer lock %s", coin, types.NewCoin(coin.Denom, min))
+	}
+
+	return nil
+}
+
+// Validate validate params
+func (p Params) Validate() error {
+	if err := validateIssuanceApproval(p.IssuanceApproval); err != nil {
+		return err
+	}
+	if err := validateRegistry(p.Registry); err != nil {
+		return err
+	}
+	if err := validateClawbackDisabled(p.ClawbackDisabled); err != nil {
+		return err
+	}
+	if err := validateDenomDisplays(p.DenomDisplays); err != nil {
+		return err
+	}
+	if err := validateMinTransferLock(p.MinTransferLock); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyIssuanceApproval, &p.IssuanceApproval, validateIssuanceApproval),
+		params.NewParamSetPair(KeyRegistry, &p.Registry, validateRegistry),
+		params.NewParamSetPair(KeyClawbackDisabled, &p.ClawbackDisabled, validateClawbackDisabled),
+		params.NewParamSetPair(KeyDenomDisplays, &p.DenomDisplays, validateDenomDisplays),
+		params.NewParamSetPair(KeyMinTransferLock, &p.MinTransferLock, validateMinTransferLock),
+	}
+}
+
+func validateIssuanceApproval(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRegistry(i interface{}) error {
+	_, ok := i.(AccountID)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateClawbackDisabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDenomDisplays(i interface{}) error {
+	v, ok := i.(DenomDisplays)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateMinTransferLock(i interface{}) error {
+	v, ok := i.(Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid min transfer lock: %s", v)
+	}
+
+	return nil
+}
@@ -8,11 +8,27 @@ import (
 const (
 	QueryCoin            = "coin"
 	QueryCoins           = "coins"
+	QueryCoinsDisplay    = "coinsdisplay"
 	QueryCoinPower       = "coinpower"
 	QueryCoinPowers      = "coinpowers"
 	QueryCoinStat        = "coinstate"
 	QueryCoinDescription = "coindesc"
 	QueryCoinLocked      = "coinslocked"
+	QueryParams          = "params"
+	QueryIssuance        = "issuance"
+	QueryIssuances       = "issuances"
+	QueryAllowList       = "allowlist"
+	QueryClawbackGrant   = "clawbackgrant"
+	QueryEmission        = "emission"
+	QueryEmissions       = "emissions"
+	QueryFreeze          = "freeze"
+	QueryFreezes         = "freezes"
+	QueryNFTCollection   = "nftcollection"
+	QueryNFTCollections  = "nftcollections"
+	QueryNFT             = "nft"
+	QueryNFTs            = "nfts"
+	QueryNFTsByOwner     = "nftsbyowner"
+	QueryTransferLocks   = "transferlocks"
 )
 
 // QueryCoinParams defines the params for querying coin.
@@ -111,6 +127,183 @@ func NewQueryLockedCoinsParams(accountID types.AccountID) QueryLockedCoinsParams
 	}
 }
 
+// QueryIssuanceParams defines the params for querying pending issuance.
+type QueryIssuanceParams struct {
+	ID uint64
+}
+
+// NewQueryIssuanceParams creates a new instance of QueryIssuanceParams.
+func NewQueryIssuanceParams(id uint64) QueryIssuanceParams {
+	return QueryIssuanceParams{
+		ID: id,
+	}
+}
+
+// QueryAllowListParams defines the params for querying the allow list of coin.
+type QueryAllowListParams struct {
+	Creator types.Name
+	Symbol  types.Name
+}
+
+// NewQueryAllowListParams creates a new instance of QueryAllowListParams.
+func NewQueryAllowListParams(creator, symbol types.Name) QueryAllowListParams {
+	return QueryAllowListParams{
+		Creator: creator,
+		Symbol:  symbol,
+	}
+}
+
+// QueryClawbackGrantParams defines the params for querying the clawback grant of account.
+type QueryClawbackGrantParams struct {
+	Grantee types.AccountID
+}
+
+// NewQueryClawbackGrantParams creates a new instance of QueryClawbackGrantParams.
+func NewQueryClawbackGrantParams(grantee types.AccountID) QueryClawbackGrantParams {
+	return QueryClawbackGrantParams{
+		Grantee: grantee,
+	}
+}
+
+// QueryEmissionParams defines the params for querying the emission schedule of coin.
+type QueryEmissionParams struct {
+	Creator types.Name
+	Symbol  types.Name
+}
+
+// NewQueryEmissionParams creates a new instance of QueryEmissionParams.
+func NewQueryEmissionParams(creator, symbol types.Name) QueryEmissionParams {
+	return QueryEmissionParams{
+		Creator: creator,
+		Symbol:  symbol,
+	}
+}
+
+// QueryFreezeParams defines the params for querying the freeze state of coin.
+type QueryFreezeParams struct {
+	Creator types.Name
+	Symbol  types.Name
+}
+
+// NewQueryFreezeParams creates a new instance of QueryFreezeParams.
+func NewQueryFreezeParams(creator, symbol types.Name) QueryFreezeParams {
+	return QueryFreezeParams{
+		Creator: creator,
+		Symbol:  symbol,
+	}
+}
+
+// the limits of the page of the tokens queried
+const (
+	DefaultNFTsQueryLimit = 100
+	MaxNFTsQueryLimit     = 1000
+)
+
+// NormalizeNFTsPage returns the page from 1 and the limit in (0, MaxNFTsQueryLimit]
+func NormalizeNFTsPage(page, limit int) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+
+	if limit <= 0 {
+		limit = DefaultNFTsQueryLimit
+	}
+
+	if limit > MaxNFTsQueryLimit {
+		limit = MaxNFTsQueryLimit
+	}
+
+	return page, limit
+}
+
+// QueryNFTCollectionParams defines the params for querying the nft collection.
+type QueryNFTCollectionParams struct {
+	Creator types.Name
+	Name    types.Name
+}
+
+// NewQueryNFTCollectionParams creates a new instance of QueryNFTCollectionParams.
+func NewQueryNFTCollectionParams(creator, name types.Name) QueryNFTCollectionParams {
+	return QueryNFTCollectionParams{
+		Creator: creator,
+		Name:    name,
+	}
+}
+
+// QueryNFTCollectionsParams defines the params for querying the nft collections of the creator, all if empty.
+type QueryNFTCollectionsParams struct {
+	Creator types.Name
+}
+
+// NewQueryNFTCollectionsParams creates a new instance of QueryNFTCollectionsParams.
+func NewQueryNFTCollectionsParams(creator types.Name) QueryNFTCollectionsParams {
+	return QueryNFTCollectionsParams{
+		Creator: creator,
+	}
+}
+
+// QueryNFTParams defines the params for querying the token in the nft collection.
+type QueryNFTParams struct {
+	Creator    types.Name
+	Collection types.Name
+	ID         string
+}
+
+// NewQueryNFTParams creates a new instance of QueryNFTParams.
+func NewQueryNFTParams(creator, collection types.Name, id string) QueryNFTParams {
+	return QueryNFTParams{
+		Creator:    creator,
+		Collection: collection,
+		ID:         id,
+	}
+}
+
+// QueryNFTsParams defines the params for querying the page of the tokens in the nft collection.
+type QueryNFTsParams struct {
+	Creator    types.Name
+	Collection types.Name
+	Page       int
+	Limit      int
+}
+
+// NewQueryNFTsParams creates a new instance of QueryNFTsParams.
+func NewQueryNFTsParams(creator, collection types.Name, page, limit int) QueryNFTsParams {
+	return QueryNFTsParams{
+		Creator:    creator,
+		Collection: collection,
+		Page:       page,
+		Limit:      limit,
+	}
+}
+
+// QueryNFTsByOwnerParams defines the params for querying the page of the tokens owned by the account.
+type QueryNFTsByOwnerParams struct {
+	Owner types.AccountID
+	Page  int
+	Limit int
+}
+
+// NewQueryNFTsByOwnerParams creates a new instance of QueryNFTsByOwnerParams.
+func NewQueryNFTsByOwnerParams(owner types.AccountID, page, limit int) QueryNFTsByOwnerParams {
+	return QueryNFTsByOwnerParams{
+		Owner: owner,
+		Page:  page,
+		Limit: limit,
+	}
+}
+
+// QueryTransferLocksParams defines the params for querying the transfer locks of account.
+type QueryTransferLocksParams struct {
+	Account types.AccountID
+}
+
+// NewQueryTransferLocksParams creates a new instance of QueryTransferLocksParams.
+func NewQueryTransferLocksParams(account types.AccountID) QueryTransferLocksParams {
+	return QueryTransferLocksParams{
+		Account: account,
+	}
+}
+
 type LockedCoins struct {
 	Coins             types.Coins `json:"coins" yaml:"coins"`
 	UnlockBlockHeight int64       `json:"unlock_block_height" yaml:"unlock_block_height"`
@@ -63,6 +63,26 @@ func (ar AssetRetriever) GetCoins(acc AccountID) (Coins, int64, error) {
 	return coinData, height, nil
 }
 
+// GetCoinsDisplay queries for coins with the display amounts for a account
+func (ar AssetRetriever) GetCoinsDisplay(acc AccountID) (DisplayCoins, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryCoinsParams(acc))
+	if err != nil {
+		return DisplayCoins{}, 0, err
+	}
+
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryCoinsDisplay), bs)
+	if err != nil {
+		return DisplayCoins{}, height, err
+	}
+
+	var coinData DisplayCoins
+	if err := ModuleCdc.UnmarshalJSON(res, &coinData); err != nil {
+		return DisplayCoins{}, height, err
+	}
+
+	return coinData, height, nil
+}
+
 // GetCoin queries for coin for a account
 func (ar AssetRetriever) GetCoinPower(acc AccountID, creator, symbol Name) (Coin, int64, error) {
 	bs, err := ModuleCdc.MarshalJSON(NewQueryCoinPowerParams(acc, creator, symbol))
@@ -164,3 +184,277 @@ func (ar AssetRetriever) GetCoinStat(creator, symbol Name) (GetCoinStatResponse,
 
 	return resData, height, nil
 }
+
+// GetParams queries the asset params
+func (ar AssetRetriever) GetParams() (Params, int64, error) {
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryParams), nil)
+	if err != nil {
+		return Params{}, height, err
+	}
+
+	var params Params
+	if err := ModuleCdc.UnmarshalJSON(res, &params); err != nil {
+		return Params{}, height, err
+	}
+
+	return params, height, nil
+}
+
+// GetPendingIssuance queries the pending issuance by id
+func (ar AssetRetriever) GetPendingIssuance(id uint64) (PendingIssuance, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryIssuanceParams(id))
+	if err != nil {
+		return PendingIssuance{}, 0, err
+	}
+
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryIssuance), bs)
+	if err != nil {
+		return PendingIssuance{}, height, err
+	}
+
+	var issuance PendingIssuance
+	if err := ModuleCdc.UnmarshalJSON(res, &issuance); err != nil {
+		return PendingIssuance{}, height, err
+	}
+
+	return issuance, height, nil
+}
+
+// GetPendingIssuances queries all the pending issuances
+func (ar AssetRetriever) GetPendingIssuances() ([]PendingIssuance, int64, error) {
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryIssuances), nil)
+	if err != nil {
+		return nil, height, err
+	}
+
+	var issuances []PendingIssuance
+	if err := ModuleCdc.UnmarshalJSON(res, &issuances); err != nil {
+		return nil, height, err
+	}
+
+	return issuances, height, nil
+}
+
+// GetAllowList queries the allow list of the coin
+func (ar AssetRetriever) GetAllowList(creator, symbol Name) (CoinAllowList, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryAllowListParams(creator, symbol))
+	if err != nil {
+		return CoinAllowList{}, 0, err
+	}
+
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryAllowList), bs)
+	if err != nil {
+		return CoinAllowList{}, height, err
+	}
+
+	var allowList CoinAllowList
+	if err := ModuleCdc.UnmarshalJSON(res, &allowList); err != nil {
+		return CoinAllowList{}, height, err
+	}
+
+	return allowList, height, nil
+}
+
+// GetClawbackGrant queries the clawback grant of the grantee account
+func (ar AssetRetriever) GetClawbackGrant(grantee AccountID) (ClawbackGrant, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryClawbackGrantParams(grantee))
+	if err != nil {
+		return ClawbackGrant{}, 0, err
+	}
+
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryClawbackGrant), bs)
+	if err != nil {
+		return ClawbackGrant{}, height, err
+	}
+
+	var grant ClawbackGrant
+	if err := ModuleCdc.UnmarshalJSON(res, &grant); err != nil {
+		return ClawbackGrant{}, height, err
+	}
+
+	return grant, height, nil
+}
+
+// GetCoinEmission queries the emission schedule of the coin
+func (ar AssetRetriever) GetCoinEmission(creator, symbol Name) (CoinEmission, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryEmissionParams(creator, symbol))
+	if err != nil {
+		return CoinEmission{}, 0, err
+	}
+
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryEmission), bs)
+	if err != nil {
+		return CoinEmission{}, height, err
+	}
+
+	var emission CoinEmission
+	if err := ModuleCdc.UnmarshalJSON(res, &emission); err != nil {
+		return CoinEmission{}, height, err
+	}
+
+	return emission, height, nil
+}
+
+// GetCoinEmissions queries all the emission schedules in progress
+func (ar AssetRetriever) GetCoinEmissions() ([]CoinEmission, int64, error) {
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryEmissions), nil)
+	if err != nil {
+		return nil, height, err
+	}
+
+	var emissions []CoinEmission
+	if err := ModuleCdc.UnmarshalJSON(res, &emissions); err != nil {
+		return nil, height, err
+	}
+
+	return emissions, height, nil
+}
+
+// GetCoinFreeze queries the freeze state of the freezable coin
+func (ar AssetRetriever) GetCoinFreeze(creator, symbol Name) (CoinFreeze, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryFreezeParams(creator, symbol))
+	if err != nil {
+		return CoinFreeze{}, 0, err
+	}
+
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryFreeze), bs)
+	if err != nil {
+		return CoinFreeze{}, height, err
+	}
+
+	var freeze CoinFreeze
+	if err := ModuleCdc.UnmarshalJSON(res, &freeze); err != nil {
+		return CoinFreeze{}, height, err
+	}
+
+	return freeze, height, nil
+}
+
+// GetCoinFreezes queries the freeze states of all the freezable coins
+func (ar AssetRetriever) GetCoinFreezes() ([]CoinFreeze, int64, error) {
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryFreezes), nil)
+	if err != nil {
+		return nil, height, err
+	}
+
+	var freezes []CoinFreeze
+	if err := ModuleCdc.UnmarshalJSON(res, &freezes); err != nil {
+		return nil, height, err
+	}
+
+	return freezes, height, nil
+}
+
+// GetNFTCollection queries the nft collection
+func (ar AssetRetriever) GetNFTCollection(creator, name Name) (NFTCollection, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryNFTCollectionParams(creator, name))
+	if err != nil {
+		return NFTCollection{}, 0, err
+	}
+
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryNFTCollection), bs)
+	if err != nil {
+		return NFTCollection{}, height, err
+	}
+
+	var collection NFTCollection
+	if err := ModuleCdc.UnmarshalJSON(res, &collection); err != nil {
+		return NFTCollection{}, height, err
+	}
+
+	return collection, height, nil
+}
+
+// GetNFTCollections queries the nft collections of the creator, or all the collections if the creator is empty
+func (ar AssetRetriever) GetNFTCollections(creator Name) ([]NFTCollection, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryNFTCollectionsParams(creator))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryNFTCollections), bs)
+	if err != nil {
+		return nil, height, err
+	}
+
+	var collections []NFTCollection
+	if err := ModuleCdc.UnmarshalJSON(res, &collections); err != nil {
+		return nil, height, err
+	}
+
+	return collections, height, nil
+}
+
+// GetNFT queries the token in the nft collection
+func (ar AssetRetriever) GetNFT(creator, collection Name, id string) (NFT, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryNFTParams(creator, collection, id))
+	if err != nil {
+		return NFT{}, 0, err
+	}
+
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryNFT), bs)
+	if err != nil {
+		return NFT{}, height, err
+	}
+
+	var token NFT
+	if err := ModuleCdc.UnmarshalJSON(res, &token); err != nil {
+		return NFT{}, height, err
+	}
+
+	return token, height, nil
+}
+
+// GetNFTs queries the page of the tokens in the nft collection
+func (ar AssetRetriever) GetNFTs(creator, collection Name, page, limit int) (NFTs, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryNFTsParams(creator, collection, page, limit))
+	if err != nil {
+		return NFTs{}, 0, err
+	}
+
+	return ar.queryNFTs(QueryNFTs, bs)
+}
+
+// GetNFTsByOwner queries the page of the tokens owned by the account
+func (ar AssetRetriever) GetNFTsByOwner(owner AccountID, page, limit int) (NFTs, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryNFTsByOwnerParams(owner, page, limit))
+	if err != nil {
+		return NFTs{}, 0, err
+	}
+
+	return ar.queryNFTs(QueryNFTsByOwner, bs)
+}
+
+func (ar AssetRetriever) queryNFTs(path string, data []byte) (NFTs, int64, error) {
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, path), data)
+	if err != nil {
+		return NFTs{}, height, err
+	}
+
+	var tokens NFTs
+	if err := ModuleCdc.UnmarshalJSON(res, &tokens); err != nil {
+		return NFTs{}, height, err
+	}
+
+	return tokens, height, nil
+}
+
+// GetTransferLocks queries the transfer locks of all the denoms of the account
+func (ar AssetRetriever) GetTransferLocks(account AccountID) ([]AccountTransferLocks, int64, error) {
+	bs, err := ModuleCdc.MarshalJSON(NewQueryTransferLocksParams(account))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	res, height, err := ar.querier.QueryWithData(fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryTransferLocks), bs)
+	if err != nil {
+		return nil, height, err
+	}
+
+	var locks []AccountTransferLocks
+	if err := ModuleCdc.UnmarshalJSON(res, &locks); err != nil {
+		return nil, height, err
+	}
+
+	return locks, height, nil
+}
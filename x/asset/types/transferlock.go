@@ -0,0 +1,187 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// TransferLock the coins transferred to the account locked by a vesting schedule, no coins unlocked before
+// the cliff height, then the coins unlocked linearly from the start height to the end height,
+// so all the coins unlocked at the cliff height if it is the end height.
+type TransferLock struct {
+	From        AccountID `json:"from" yaml:"from"`                 // From the account transferred the coins
+	Amount      Coin      `json:"amount" yaml:"amount"`             // Amount the coins locked by the transfer
+	StartHeight int64     `json:"start_height" yaml:"start_height"` // StartHeight the height the coins transferred
+	CliffHeight int64     `json:"cliff_height" yaml:"cliff_height"` // CliffHeight no coins unlocked before the height
+	EndHeight   int64     `json:"end_height" yaml:"end_height"`     // EndHeight all the coins unlocked at the height
+	Unlocked    Coin      `json:"unlocked" yaml:"unlocked"`         // Unlocked the coins unlocked by the begin blockers
+}
+
+// NewTransferLock creates the lock of the coins transferred at the start height
+func NewTransferLock(from AccountID, amount Coin, startHeight, cliffHeight, endHeight int64) TransferLock {
+	return TransferLock{
+		From:        from,
+		Amount:      amount,
+		StartHeight: startHeight,
+		CliffHeight: cliffHeight,
+		EndHeight:   endHeight,
+		Unlocked:    NewCoin(amount.Denom, NewInt(0)),
+	}
+}
+
+// ValidateTransferLockHeights validates the cliff and end heights of the lock started at the height
+func ValidateTransferLockHeights(startHeight, cliffHeight, endHeight int64) error {
+	if endHeight <= startHeight {
+		return sdkerrors.Wrapf(ErrAssetTransferLock, "end height %d should be after %d", endHeight, startHeight)
+	}
+
+	if cliffHeight < startHeight || cliffHeight > endHeight {
+		return sdkerrors.Wrapf(ErrAssetTransferLock, "cliff height should be in [%d, %d]: %d", startHeight, endHeight, cliffHeight)
+	}
+
+	return nil
+}
+
+// UnlockedAt returns the amount should be unlocked at the height
+func (l TransferLock) UnlockedAt(height int64) Int {
+	switch {
+	case height < l.CliffHeight || height <= l.StartHeight:
+		return NewInt(0)
+	case height >= l.EndHeight:
+		return l.Amount.Amount
+	default:
+		return l.Amount.Amount.MulRaw(height - l.StartHeight).QuoRaw(l.EndHeight - l.StartHeight)
+	}
+}
+
+// Due returns the coins should be unlocked at the height
+func (l TransferLock) Due(height int64) Coin {
+	unlocked := l.UnlockedAt(height)
+	if unlocked.LTE(l.Unlocked.Amount) {
+		return NewCoin(l.Unlocked.Denom, NewInt(0))
+	}
+
+	return NewCoin(l.Unlocked.Denom, unlocked.Sub(l.Unlocked.Amount))
+}
+
+// NextUnlockHeight returns the next height after the height the lock should be unlocked at,
+// the coins are unlocked from the cliff height, or at once if the lock is overdue.
+func (l TransferLock) NextUnlockHeight(height int64) (int64, bool) {
+	if l.IsCompleted() {
+		return 0, false
+	}
+
+	first := l.CliffHeight
+	if first <= l.StartHeight {
+		first = l.StartHeight + 1
+	}
+
+	if height < first {
+		return first, true
+	}
+
+	return height + 1, true
+}
+
+// Locked returns the coins still locked
+func (l TransferLock) Locked() Coin {
+	return l.Amount.Sub(l.Unlocked)
+}
+
+// IsCompleted returns if all the coins unlocked
+func (l TransferLock) IsCompleted() bool {
+	return l.Unlocked.IsGTE(l.Amount)
+}
+
+// Validate validates the transfer lock in genesis
+func (l TransferLock) Validate() error {
+	if l.From.Empty() {
+		return fmt.Errorf("transfer lock from cannot be empty")
+	}
+
+	if !l.Amount.IsValid() || l.Amount.IsZero() {
+		return fmt.Errorf("transfer lock amount invalid: %s", l.Amount)
+	}
+
+	if err := ValidateTransferLockHeights(l.StartHeight, l.CliffHeight, l.EndHeight); err != nil {
+		return err
+	}
+
+	if l.Unlocked.Denom != l.Amount.Denom || l.Unlocked.IsNegative() || !l.Amount.IsGTE(l.Unlocked) {
+		return fmt.Errorf("transfer lock of %s unlocked invalid: %s", l.Amount.Denom, l.Unlocked)
+	}
+
+	return nil
+}
+
+// AccountTransferLocks the transfer locks of the coins in the denom of the account
+type AccountTransferLocks struct {
+	Account AccountID      `json:"account" yaml:"account"`
+	Denom   string         `json:"denom" yaml:"denom"`
+	Locks   []TransferLock `json:"locks" yaml:"locks"`
+}
+
+// NewAccountTransferLocks creates the transfer locks of the coins in the denom of the account
+func NewAccountTransferLocks(account AccountID, denom string, locks ...TransferLock) AccountTransferLocks {
+	return AccountTransferLocks{
+		Account: account,
+		Denom:   denom,
+		Locks:   locks,
+	}
+}
+
+// Locked returns the coins still locked by the transfer locks
+func (a AccountTransferLocks) Locked() Coin {
+	res := NewCoin(a.Denom, NewInt(0))
+	for _, l := range a.Locks {
+		res = res.Add(l.Locked())
+	}
+	return res
+}
+
+// NextUnlockHeight returns the next height after the height the transfer locks should be unlocked at
+func (a AccountTransferLocks) NextUnlockHeight(height int64) (int64, bool) {
+	var (
+		next  int64
+		found bool
+	)
+
+	for _, l := range a.Locks {
+		h, ok := l.NextUnlockHeight(height)
+		if ok && (!found || h < next) {
+			next, found = h, true
+		}
+	}
+
+	return next, found
+}
+
+// Validate validates the transfer locks of the account in genesis
+func (a AccountTransferLocks) Validate() error {
+	if a.Account.Empty() {
+		return fmt.Errorf("transfer locks account cannot be empty")
+	}
+
+	if len(a.Locks) == 0 {
+		return fmt.Errorf("transfer locks of %s in %s cannot be empty", a.Account, a.Denom)
+	}
+
+	for _, l := range a.Locks {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+
+		if l.Amount.Denom != a.Denom {
+			return fmt.Errorf("transfer locks of %s denom should be %s: %s", a.Account, a.Denom, l.Amount.Denom)
+		}
+	}
+
+	return nil
+}
+
+func (a AccountTransferLocks) String() string {
+	res, _ := yaml.Marshal(a)
+	return string(res)
+}
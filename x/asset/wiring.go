@@ -0,0 +1,16 @@
+package asset
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/asset/keeper"
+)
+
+// Inputs the keepers the asset module depends on
+type Inputs struct {
+	AccountKeeper keeper.AccountEnsurer
+}
+
+// ProvideKeeper creates the asset keeper by the store key and params subspace declared to the builder
+func ProvideKeeper(b *wiring.Builder, in Inputs) Keeper {
+	return NewAssetKeeper(b.Codec(), b.KVStoreKey(StoreKey), b.Subspace(DefaultParamspace), in.AccountKeeper)
+}
@@ -0,0 +1,53 @@
+package attestation
+
+// nolint
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/attestation/keeper"
+	"github.com/KuChainNetwork/kuchain/x/attestation/types"
+)
+
+const (
+	ModuleName        = types.ModuleName
+	StoreKey          = types.StoreKey
+	RouterKey         = types.RouterKey
+	QuerierRoute      = types.QuerierRoute
+	DefaultParamspace = types.DefaultParamspace
+	QueryParameters   = types.QueryParameters
+	QueryAttestation  = types.QueryAttestation
+	QueryAttestations = types.QueryAttestations
+)
+
+var (
+	// functions aliases
+	NewKeeper                  = keeper.NewKeeper
+	NewQuerier                 = keeper.NewQuerier
+	RegisterCodec              = types.RegisterCodec
+	NewGenesisState            = types.NewGenesisState
+	DefaultGenesisState        = types.DefaultGenesisState
+	ValidateGenesis            = types.ValidateGenesis
+	ParamKeyTable              = types.ParamKeyTable
+	NewParams                  = types.NewParams
+	DefaultParams              = types.DefaultParams
+	NewAttestation             = types.NewAttestation
+	NewMsgAttest               = types.NewMsgAttest
+	NewMsgRevokeAttestation    = types.NewMsgRevokeAttestation
+	NewKuMsgAttest             = types.NewKuMsgAttest
+	NewKuMsgRevokeAttestation  = types.NewKuMsgRevokeAttestation
+	NewQueryAttestationParams  = types.NewQueryAttestationParams
+	NewQueryAttestationsParams = types.NewQueryAttestationsParams
+
+	// variable aliases
+	ModuleCdc = types.ModuleCdc
+	Cdc       = types.Cdc
+)
+
+type (
+	Keeper               = keeper.Keeper
+	GenesisState         = types.GenesisState
+	Params               = types.Params
+	Attestation          = types.Attestation
+	Attestations         = types.Attestations
+	MsgAttest            = types.MsgAttest
+	MsgRevokeAttestation = types.MsgRevokeAttestation
+)
@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/attestation/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	attestationQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the attestation module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	attestationQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryAttestation(cdc),
+			GetCmdQueryAttestations(cdc),
+			GetCmdQueryParams(cdc),
+		)...,
+	)
+
+	return attestationQueryCmd
+}
+
+// GetCmdQueryAttestation implements the query attestation command.
+func GetCmdQueryAttestation(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "attestation [account] [type]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the attestation of an account by type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the attestation of an account by the attestation type.
+
+Example:
+$ %s query attestation attestation alice kyc
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			account, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			attestationType, err := chainTypes.NewName(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAttestationParams(account, attestationType))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAttestation)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var attestation types.Attestation
+			cdc.MustUnmarshalJSON(res, &attestation)
+			return cliCtx.PrintOutput(attestation)
+		},
+	}
+}
+
+// GetCmdQueryAttestations implements the query attestations of account command.
+func GetCmdQueryAttestations(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "attestations [account]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the attestations of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the attestations of an account, all attestations if no account.
+
+Example:
+$ %s query attestation attestations alice
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var account chainTypes.AccountID
+			if len(args) > 0 {
+				id, err := chainTypes.NewAccountIDFromStr(args[0])
+				if err != nil {
+					return err
+				}
+				account = id
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAttestationsParams(account))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAttestations)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var attestations types.Attestations
+			cdc.MustUnmarshalJSON(res, &attestations)
+			return cliCtx.PrintOutput(attestations)
+		},
+	}
+}
+
+// GetCmdQueryParams implements a command to fetch attestation parameters.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current attestation parameters",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current parameters for the attestation module, including the approved attestors:
+
+$ %s query attestation params
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/attestation/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	attestationTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Attestation transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	attestationTxCmd.AddCommand(flags.PostCommands(
+		GetCmdAttest(cdc),
+		GetCmdRevoke(cdc),
+	)...)
+
+	return attestationTxCmd
+}
+
+// GetCmdAttest implements the attest command.
+func GetCmdAttest(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "attest [attestor] [account] [type] [value] [expire-height]",
+		Args:  cobra.RangeArgs(4, 5),
+		Short: "Tag an account with an attestation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Tag an account with an attestation by the attestor approved by gov,
+the attestation of the same type will be overwritten, the attestation never expires if no expire-height.
+
+Example:
+$ %s tx attestation attest kyc-provider alice kyc level2 100000 --from kyc-provider
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			attestor, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "attestor account id error")
+			}
+
+			account, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "account id error")
+			}
+
+			attestationType, err := chainTypes.NewName(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "attestation type error")
+			}
+
+			var expireHeight int64
+			if len(args) > 4 {
+				expireHeight, err = strconv.ParseInt(args[4], 10, 64)
+				if err != nil {
+					return sdkerrors.Wrap(err, "expire height parse error")
+				}
+			}
+
+			attestorAuth, err := txutil.QueryAccountAuth(cliCtx, attestor)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", attestor)
+			}
+
+			msg := types.NewKuMsgAttest(attestorAuth, attestor, account, attestationType, args[3], expireHeight)
+			cliCtx = cliCtx.WithFromAccount(attestor)
+			if txBldr.FeePayer().Empty() {
+				txBldr = txBldr.WithPayer(args[0])
+			}
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRevoke implements the revoke attestation command.
+func GetCmdRevoke(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [attestor] [account] [type]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Revoke an attestation of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke an attestation of an account, only the attestor issued it can revoke.
+
+Example:
+$ %s tx attestation revoke kyc-provider alice kyc --from kyc-provider
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			attestor, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "attestor account id error")
+			}
+
+			account, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "account id error")
+			}
+
+			attestationType, err := chainTypes.NewName(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "attestation type error")
+			}
+
+			attestorAuth, err := txutil.QueryAccountAuth(cliCtx, attestor)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", attestor)
+			}
+
+			msg := types.NewKuMsgRevokeAttestation(attestorAuth, attestor, account, attestationType)
+			cliCtx = cliCtx.WithFromAccount(attestor)
+			if txBldr.FeePayer().Empty() {
+				txBldr = txBldr.WithPayer(args[0])
+			}
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
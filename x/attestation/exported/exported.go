@@ -0,0 +1,13 @@
+package exported
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AttestationChecker the interface for the compliance-constrained modules to check the attestations of accounts
+type AttestationChecker interface {
+	// HasValidAttestation returns if the account has the attestation of the type which is not expired
+	// and the attestor is still approved, if value is not empty, the value of attestation should be equal to it.
+	HasValidAttestation(ctx sdk.Context, account types.AccountID, attestationType types.Name, value string) bool
+}
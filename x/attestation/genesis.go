@@ -0,0 +1,27 @@
+package attestation
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/attestation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initialize default parameters and the attestations
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	for _, attestation := range data.Attestations {
+		keeper.SetAttestation(ctx, attestation)
+	}
+}
+
+// ExportGenesis writes the current store values
+// to a genesis file, which can be imported again
+// with InitGenesis
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	attestations := keeper.GetAttestations(ctx, types.AccountID{})
+	if attestations == nil {
+		attestations = types.Attestations{}
+	}
+
+	return NewGenesisState(keeper.GetParams(ctx), attestations)
+}
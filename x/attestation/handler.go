@@ -0,0 +1,87 @@
+package attestation
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/attestation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewHandler(k Keeper) msg.Handler {
+	return func(ctx chainTypes.Context, msg sdk.Msg) (*sdk.Result, error) {
+		switch msg := msg.(type) {
+		case types.KuMsgAttest:
+			return handleKuMsgAttest(ctx, k, msg)
+		case types.KuMsgRevokeAttestation:
+			return handleKuMsgRevokeAttestation(ctx, k, msg)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleKuMsgAttest(ctx chainTypes.Context, k Keeper, msg types.KuMsgAttest) (*sdk.Result, error) {
+	msgData := types.MsgAttest{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg Attest data unmarshal error")
+	}
+
+	ctx.RequireAuth(msgData.Attestor)
+
+	attestation, err := k.Attest(ctx.Context(),
+		msgData.Attestor, msgData.Account, msgData.AttestationType, msgData.Value, msgData.ExpireHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msgData.Attestor.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeAttest,
+			sdk.NewAttribute(types.AttributeKeyAttestor, attestation.Attestor.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, attestation.Account.String()),
+			sdk.NewAttribute(types.AttributeKeyType, attestation.Type.String()),
+			sdk.NewAttribute(types.AttributeKeyValue, attestation.Value),
+			sdk.NewAttribute(types.AttributeKeyExpireHeight, fmt.Sprintf("%d", attestation.ExpireHeight)),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleKuMsgRevokeAttestation(ctx chainTypes.Context, k Keeper, msg types.KuMsgRevokeAttestation) (*sdk.Result, error) {
+	msgData := types.MsgRevokeAttestation{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg RevokeAttestation data unmarshal error")
+	}
+
+	ctx.RequireAuth(msgData.Attestor)
+
+	attestation, err := k.Revoke(ctx.Context(), msgData.Attestor, msgData.Account, msgData.AttestationType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msgData.Attestor.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeRevoke,
+			sdk.NewAttribute(types.AttributeKeyAttestor, attestation.Attestor.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, attestation.Account.String()),
+			sdk.NewAttribute(types.AttributeKeyType, attestation.Type.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
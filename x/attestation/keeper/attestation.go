@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/attestation/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetAttestation get the attestation of the account by the type
+func (k Keeper) GetAttestation(ctx sdk.Context, account types.AccountID, attestationType types.Name) (types.Attestation, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.AttestationKey(account, attestationType))
+	if bz == nil {
+		return types.Attestation{}, false
+	}
+
+	var attestation types.Attestation
+	k.cdc.MustUnmarshalBinaryBare(bz, &attestation)
+
+	return attestation, true
+}
+
+// SetAttestation set an attestation to store
+func (k Keeper) SetAttestation(ctx sdk.Context, attestation types.Attestation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(attestation)
+	store.Set(types.AttestationKey(attestation.Account, attestation.Type), bz)
+}
+
+// DeleteAttestation deletes an attestation from store
+func (k Keeper) DeleteAttestation(ctx sdk.Context, account types.AccountID, attestationType types.Name) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AttestationKey(account, attestationType))
+}
+
+// IterateAttestations iterates over the attestations with the prefix and performs a callback function
+func (k Keeper) IterateAttestations(ctx sdk.Context, prefix []byte, cb func(attestation types.Attestation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation types.Attestation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &attestation)
+
+		if cb(attestation) {
+			break
+		}
+	}
+}
+
+// GetAttestations returns the attestations of the account, all attestations if account is empty
+func (k Keeper) GetAttestations(ctx sdk.Context, account types.AccountID) (attestations types.Attestations) {
+	prefix := types.AttestationKeyPrefix
+	if !account.Empty() {
+		prefix = types.AttestationsPrefix(account)
+	}
+
+	k.IterateAttestations(ctx, prefix, func(attestation types.Attestation) bool {
+		attestations = append(attestations, attestation)
+		return false
+	})
+
+	return attestations
+}
+
+// Attest tags the account with the attestation by the attestor, the attestation of the same type will be overwritten
+func (k Keeper) Attest(ctx sdk.Context, attestor, account types.AccountID, attestationType types.Name, value string, expireHeight int64) (types.Attestation, error) {
+	if !k.IsAttestor(ctx, attestor) {
+		return types.Attestation{}, sdkerrors.Wrapf(types.ErrNotAttestor, "%s", attestor)
+	}
+
+	if expireHeight != 0 && expireHeight <= ctx.BlockHeight() {
+		return types.Attestation{}, sdkerrors.Wrapf(types.ErrInvalidAttestationExpiry,
+			"expire height %d should be larger than current height %d", expireHeight, ctx.BlockHeight())
+	}
+
+	attestation := types.NewAttestation(account, attestationType, value, attestor, ctx.BlockHeight(), expireHeight)
+	if err := attestation.Validate(); err != nil {
+		return types.Attestation{}, sdkerrors.Wrap(types.ErrInvalidAttestation, err.Error())
+	}
+
+	k.SetAttestation(ctx, attestation)
+
+	return attestation, nil
+}
+
+// Revoke revokes the attestation of the account, only the attestor issued it can revoke
+func (k Keeper) Revoke(ctx sdk.Context, attestor, account types.AccountID, attestationType types.Name) (types.Attestation, error) {
+	attestation, found := k.GetAttestation(ctx, account, attestationType)
+	if !found {
+		return types.Attestation{}, sdkerrors.Wrapf(types.ErrUnknownAttestation, "%s of %s", attestationType, account)
+	}
+
+	if !attestation.Attestor.Eq(attestor) {
+		return types.Attestation{}, sdkerrors.Wrapf(types.ErrNotAttestationIssuer, "%s", attestor)
+	}
+
+	k.DeleteAttestation(ctx, account, attestationType)
+
+	return attestation, nil
+}
+
+// GetValidAttestation returns the attestation of the account by the type if it is not expired
+// and the attestor is still approved by gov
+func (k Keeper) GetValidAttestation(ctx sdk.Context, account types.AccountID, attestationType types.Name) (types.Attestation, bool) {
+	attestation, found := k.GetAttestation(ctx, account, attestationType)
+	if !found {
+		return types.Attestation{}, false
+	}
+
+	if attestation.IsExpired(ctx.BlockHeight()) || !k.IsAttestor(ctx, attestation.Attestor) {
+		return types.Attestation{}, false
+	}
+
+	return attestation, true
+}
+
+// HasValidAttestation returns if the account has a valid attestation of the type,
+// if value is not empty, the value of the attestation should be equal to it.
+func (k Keeper) HasValidAttestation(ctx sdk.Context, account types.AccountID, attestationType types.Name, value string) bool {
+	attestation, found := k.GetValidAttestation(ctx, account, attestationType)
+	if !found {
+		return false
+	}
+
+	return value == "" || attestation.Value == value
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/attestation/exported"
+	"github.com/KuChainNetwork/kuchain/x/attestation/types"
+	"github.com/KuChainNetwork/kuchain/x/params"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+var _ exported.AttestationChecker = Keeper{}
+
+// Keeper of the attestation store
+type Keeper struct {
+	cdc        *codec.Codec
+	storeKey   sdk.StoreKey
+	paramSpace params.Subspace
+}
+
+// NewKeeper creates a new attestation Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of attestation parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of attestation parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// IsAttestor returns if the account is an attestor approved by gov
+func (k Keeper) IsAttestor(ctx sdk.Context, account types.AccountID) bool {
+	return k.GetParams(ctx).IsAttestor(account)
+}
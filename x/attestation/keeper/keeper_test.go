@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	attestationTypes "github.com/KuChainNetwork/kuchain/x/attestation/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var (
+	attestor = types.NewAccountIDFromName(types.MustName("attestor"))
+	other    = types.NewAccountIDFromName(types.MustName("other"))
+	holder   = types.NewAccountIDFromName(types.MustName("holder"))
+	kycType  = types.MustName("kyc")
+)
+
+func TestAttestation(t *testing.T) {
+	app := simapp.SetupWithGenesisAccounts(simapp.NewGenesisAccounts(simapp.NewWallet().GetRootAuth()))
+	k := app.AttestationKeeper()
+
+	Convey("test attest and revoke attestation", t, func() {
+		ctx, _ := app.NewTestContext().CacheContext()
+		ctx = ctx.WithBlockHeight(10)
+
+		_, err := k.Attest(ctx, attestor, holder, kycType, "level2", 0)
+		So(err, simapp.ShouldErrIs, attestationTypes.ErrNotAttestor)
+
+		k.SetParams(ctx, attestationTypes.NewParams([]types.AccountID{attestor, other}))
+
+		_, err = k.Attest(ctx, attestor, holder, kycType, "level2", 10)
+		So(err, simapp.ShouldErrIs, attestationTypes.ErrInvalidAttestationExpiry)
+
+		_, err = k.Attest(ctx, attestor, holder, kycType, "level2", 20)
+		So(err, ShouldBeNil)
+		So(k.HasValidAttestation(ctx, holder, kycType, ""), ShouldBeTrue)
+		So(k.HasValidAttestation(ctx, holder, kycType, "level2"), ShouldBeTrue)
+		So(k.HasValidAttestation(ctx, holder, kycType, "level1"), ShouldBeFalse)
+		So(k.GetAttestations(ctx, holder), ShouldHaveLength, 1)
+
+		Convey("attestation expired", func() {
+			So(k.HasValidAttestation(ctx.WithBlockHeight(20), holder, kycType, ""), ShouldBeFalse)
+		})
+
+		Convey("attestor removed by gov", func() {
+			k.SetParams(ctx, attestationTypes.NewParams([]types.AccountID{other}))
+			So(k.HasValidAttestation(ctx, holder, kycType, ""), ShouldBeFalse)
+		})
+
+		Convey("only the issuer can revoke", func() {
+			_, err := k.Revoke(ctx, other, holder, kycType)
+			So(err, simapp.ShouldErrIs, attestationTypes.ErrNotAttestationIssuer)
+
+			_, err = k.Revoke(ctx, attestor, holder, kycType)
+			So(err, ShouldBeNil)
+			So(k.HasValidAttestation(ctx, holder, kycType, ""), ShouldBeFalse)
+
+			_, err = k.Revoke(ctx, attestor, holder, kycType)
+			So(err, simapp.ShouldErrIs, attestationTypes.ErrUnknownAttestation)
+		})
+
+		Convey("attestation overwritten by other attestor", func() {
+			_, err := k.Attest(ctx, other, holder, kycType, "level3", 0)
+			So(err, ShouldBeNil)
+
+			attestation, found := k.GetValidAttestation(ctx.WithBlockHeight(100), holder, kycType)
+			So(found, ShouldBeTrue)
+			So(attestation.Attestor, ShouldResemble, other)
+			So(attestation.Value, ShouldEqual, "level3")
+		})
+	})
+}
+
+func TestValidateParams(t *testing.T) {
+	Convey("test validate attestation params", t, func() {
+		So(attestationTypes.DefaultParams().Validate(), ShouldBeNil)
+		So(attestationTypes.NewParams([]types.AccountID{attestor}).Validate(), ShouldBeNil)
+		So(attestationTypes.NewParams([]types.AccountID{attestor, attestor}).Validate(), ShouldNotBeNil)
+		So(attestationTypes.NewParams([]types.AccountID{{}}).Validate(), ShouldNotBeNil)
+	})
+}
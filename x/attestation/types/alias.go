@@ -0,0 +1,17 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+type (
+	AccountID  = types.AccountID
+	AccAddress = types.AccAddress
+	KuMsg      = types.KuMsg
+	Name       = types.Name
+)
+
+var (
+	MustName            = types.MustName
+	NewAccountIDFromStr = types.NewAccountIDFromStr
+)
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// MaxAttestationValueLen the max length of the value of attestation
+const MaxAttestationValueLen = 64
+
+// Attestation the tag of an account by the attestor, such as the KYC level or the jurisdiction
+type Attestation struct {
+	Account      AccountID `json:"account" yaml:"account"`
+	Type         Name      `json:"type" yaml:"type"`   // Type the attestation type, such as kyc or jurisdiction
+	Value        string    `json:"value" yaml:"value"` // Value the attested value, such as the KYC level
+	Attestor     AccountID `json:"attestor" yaml:"attestor"`
+	Height       int64     `json:"height" yaml:"height"`               // Height the height of the attestation
+	ExpireHeight int64     `json:"expire_height" yaml:"expire_height"` // ExpireHeight the attestation is invalid from the height, 0 for never expire
+}
+
+// NewAttestation creates a new Attestation instance
+func NewAttestation(account AccountID, attestationType Name, value string, attestor AccountID, height, expireHeight int64) Attestation {
+	return Attestation{
+		Account:      account,
+		Type:         attestationType,
+		Value:        value,
+		Attestor:     attestor,
+		Height:       height,
+		ExpireHeight: expireHeight,
+	}
+}
+
+// IsExpired returns if the attestation is expired at the height
+func (a Attestation) IsExpired(height int64) bool {
+	return a.ExpireHeight != 0 && height >= a.ExpireHeight
+}
+
+// Validate validates the attestation
+func (a Attestation) Validate() error {
+	if a.Account.Empty() || a.Attestor.Empty() {
+		return fmt.Errorf("attestation account and attestor should not be empty")
+	}
+
+	if a.Type.Empty() {
+		return fmt.Errorf("attestation type should not be empty")
+	}
+
+	if len(a.Value) > MaxAttestationValueLen {
+		return fmt.Errorf("attestation value too long, max %d", MaxAttestationValueLen)
+	}
+
+	if a.ExpireHeight < 0 {
+		return fmt.Errorf("attestation expire height should not be negative: %d", a.ExpireHeight)
+	}
+
+	return nil
+}
+
+func (a Attestation) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
+
+// Attestations is a collection of Attestation objects
+type Attestations []Attestation
+
+func (a Attestations) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgAttest{}, "attestation/MsgAttest", nil)
+	cdc.RegisterConcrete(KuMsgAttest{}, "attestation/KuMsgAttest", nil)
+	cdc.RegisterConcrete(&MsgRevokeAttestation{}, "attestation/MsgRevokeAttestation", nil)
+	cdc.RegisterConcrete(KuMsgRevokeAttestation{}, "attestation/KuMsgRevokeAttestation", nil)
+}
+
+var (
+	// ModuleCdc references the global x/attestation module codec.
+	ModuleCdc = codec.New()
+)
+
+// Cdc get codec for types
+func Cdc() *codec.Codec {
+	return ModuleCdc
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
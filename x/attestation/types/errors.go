@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/attestation module sentinel errors
+var (
+	ErrNotAttestor              = sdkerrors.Register(ModuleName, 2, "account is not an approved attestor")
+	ErrUnknownAttestation       = sdkerrors.Register(ModuleName, 3, "unknown attestation")
+	ErrInvalidAttestation       = sdkerrors.Register(ModuleName, 4, "invalid attestation")
+	ErrInvalidAttestationExpiry = sdkerrors.Register(ModuleName, 5, "invalid attestation expiry")
+	ErrNotAttestationIssuer     = sdkerrors.Register(ModuleName, 6, "attestation is not issued by the attestor")
+)
@@ -0,0 +1,15 @@
+package types
+
+// attestation module event types
+const (
+	EventTypeAttest = "attest"
+	EventTypeRevoke = "revoke_attestation"
+
+	AttributeKeyAttestor     = "attestor"
+	AttributeKeyAccount      = "account"
+	AttributeKeyType         = "type"
+	AttributeKeyValue        = "value"
+	AttributeKeyExpireHeight = "expire_height"
+
+	AttributeValueCategory = ModuleName
+)
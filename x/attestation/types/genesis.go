@@ -0,0 +1,58 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GenesisState - all attestation state that must be provided at genesis
+type GenesisState struct {
+	Params       Params       `json:"params" yaml:"params"`
+	Attestations Attestations `json:"attestations" yaml:"attestations"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, attestations Attestations) GenesisState {
+	return GenesisState{
+		Params:       params,
+		Attestations: attestations,
+	}
+}
+
+// DefaultGenesisState - default GenesisState
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), Attestations{})
+}
+
+// ValidateGenesis performs basic validation of attestation genesis data returning an
+// error for any failed validation criteria.
+func (g GenesisState) ValidateGenesis(bz json.RawMessage) error {
+	gs := DefaultGenesisState()
+	if err := Cdc().UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+
+	return ValidateGenesis(gs)
+}
+
+// ValidateGenesis validates the attestation genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.Attestations))
+	for _, a := range data.Attestations {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+
+		key := string(AttestationKey(a.Account, a.Type))
+		if seen[key] {
+			return fmt.Errorf("duplicated attestation %s of %s", a.Type, a.Account)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
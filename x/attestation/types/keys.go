@@ -0,0 +1,33 @@
+package types
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "attestation"
+
+	// StoreKey is the store key string for attestations
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for attestations
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for attestations
+	QuerierRoute = ModuleName
+)
+
+// Keys for attestation store
+// Items are stored with the following key: values
+//
+// - 0x01<account_Bytes><type_Bytes>: Attestation
+var (
+	AttestationKeyPrefix = []byte{0x01}
+)
+
+// AttestationsPrefix gets the prefix of the attestations of the account
+func AttestationsPrefix(account AccountID) []byte {
+	return append(AttestationKeyPrefix, account.StoreKey()...)
+}
+
+// AttestationKey gets the key of the attestation of the account by the type
+func AttestationKey(account AccountID, attestationType Name) []byte {
+	return append(AttestationsPrefix(account), attestationType.Bytes()...)
+}
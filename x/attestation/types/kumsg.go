@@ -0,0 +1,76 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	RouterKeyName = MustName(RouterKey)
+)
+
+type KuMsgAttest struct {
+	KuMsg
+}
+
+// NewKuMsgAttest creates a msg for the attestor to tag the account with the attestation
+func NewKuMsgAttest(auth sdk.AccAddress, attestor, account AccountID, attestationType Name, value string, expireHeight int64) KuMsgAttest {
+	return KuMsgAttest{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgAttest{
+				Attestor:        attestor,
+				Account:         account,
+				AttestationType: attestationType,
+				Value:           value,
+				ExpireHeight:    expireHeight,
+			}),
+		),
+	}
+}
+
+func (msg KuMsgAttest) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgData := MsgAttest{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return err
+	}
+
+	return msgData.ValidateBasic()
+}
+
+type KuMsgRevokeAttestation struct {
+	KuMsg
+}
+
+// NewKuMsgRevokeAttestation creates a msg for the attestor to revoke the attestation
+func NewKuMsgRevokeAttestation(auth sdk.AccAddress, attestor, account AccountID, attestationType Name) KuMsgRevokeAttestation {
+	return KuMsgRevokeAttestation{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgRevokeAttestation{
+				Attestor:        attestor,
+				Account:         account,
+				AttestationType: attestationType,
+			}),
+		),
+	}
+}
+
+func (msg KuMsgRevokeAttestation) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgData := MsgRevokeAttestation{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return err
+	}
+
+	return msgData.ValidateBasic()
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	chainType "github.com/KuChainNetwork/kuchain/chain/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// verify interface at compile time
+var _, _ chainType.KuMsgData = (*MsgAttest)(nil), (*MsgRevokeAttestation)(nil)
+
+// MsgAttest - struct for the attestor to tag an account with the attestation
+type MsgAttest struct {
+	Attestor        AccountID `json:"attestor" yaml:"attestor"`
+	Account         AccountID `json:"account" yaml:"account"`
+	AttestationType Name      `json:"type" yaml:"type"`
+	Value           string    `json:"value" yaml:"value"`
+	ExpireHeight    int64     `json:"expire_height" yaml:"expire_height"` // 0 for never expire
+}
+
+// NewMsgAttest creates a new MsgAttest instance
+func NewMsgAttest(attestor, account AccountID, attestationType Name, value string, expireHeight int64) MsgAttest {
+	return MsgAttest{
+		Attestor:        attestor,
+		Account:         account,
+		AttestationType: attestationType,
+		Value:           value,
+		ExpireHeight:    expireHeight,
+	}
+}
+
+// nolint
+func (msg MsgAttest) Route() string     { return RouterKey }
+func (msg MsgAttest) Type() Name        { return MustName("attest") }
+func (msg MsgAttest) Sender() AccountID { return msg.Attestor }
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgAttest) ValidateBasic() error {
+	if msg.Attestor.Empty() {
+		return sdkerrors.Wrap(ErrNotAttestor, "attestor should not be empty")
+	}
+
+	if msg.Account.Empty() {
+		return sdkerrors.Wrap(ErrInvalidAttestation, "account should not be empty")
+	}
+
+	if msg.AttestationType.Empty() {
+		return sdkerrors.Wrap(ErrInvalidAttestation, "type should not be empty")
+	}
+
+	if len(msg.Value) > MaxAttestationValueLen {
+		return sdkerrors.Wrapf(ErrInvalidAttestation, "value too long, max %d", MaxAttestationValueLen)
+	}
+
+	if msg.ExpireHeight < 0 {
+		return sdkerrors.Wrapf(ErrInvalidAttestationExpiry, "expire height %d", msg.ExpireHeight)
+	}
+
+	return nil
+}
+
+// MsgRevokeAttestation - struct for the attestor to revoke the attestation it issued
+type MsgRevokeAttestation struct {
+	Attestor        AccountID `json:"attestor" yaml:"attestor"`
+	Account         AccountID `json:"account" yaml:"account"`
+	AttestationType Name      `json:"type" yaml:"type"`
+}
+
+// NewMsgRevokeAttestation creates a new MsgRevokeAttestation instance
+func NewMsgRevokeAttestation(attestor, account AccountID, attestationType Name) MsgRevokeAttestation {
+	return MsgRevokeAttestation{
+		Attestor:        attestor,
+		Account:         account,
+		AttestationType: attestationType,
+	}
+}
+
+// nolint
+func (msg MsgRevokeAttestation) Route() string     { return RouterKey }
+func (msg MsgRevokeAttestation) Type() Name        { return MustName("revoke") }
+func (msg MsgRevokeAttestation) Sender() AccountID { return msg.Attestor }
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgRevokeAttestation) ValidateBasic() error {
+	if msg.Attestor.Empty() {
+		return sdkerrors.Wrap(ErrNotAttestor, "attestor should not be empty")
+	}
+
+	if msg.Account.Empty() {
+		return sdkerrors.Wrap(ErrInvalidAttestation, "account should not be empty")
+	}
+
+	if msg.AttestationType.Empty() {
+		return sdkerrors.Wrap(ErrInvalidAttestation, "type should not be empty")
+	}
+
+	return nil
+}
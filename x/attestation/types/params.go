@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+
+	params "github.com/KuChainNetwork/kuchain/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace = ModuleName
+)
+
+// Parameter store keys
+var (
+	KeyAttestors = []byte("Attestors")
+)
+
+// Params attestation parameters
+type Params struct {
+	Attestors []AccountID `json:"attestors" yaml:"attestors"` // the attestor accounts approved by gov
+}
+
+// ParamKeyTable ParamTable for attestation module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(attestors []AccountID) Params {
+	return Params{
+		Attestors: attestors,
+	}
+}
+
+// DefaultParams default attestation module parameters
+func DefaultParams() Params {
+	return NewParams([]AccountID{})
+}
+
+// Validate validate params
+func (p Params) Validate() error {
+	return validateAttestors(p.Attestors)
+}
+
+// IsAttestor returns if the account is an approved attestor
+func (p Params) IsAttestor(account AccountID) bool {
+	for _, a := range p.Attestors {
+		if a.Eq(account) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyAttestors, &p.Attestors, validateAttestors),
+	}
+}
+
+func validateAttestors(i interface{}) error {
+	v, ok := i.([]AccountID)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, a := range v {
+		if a.Empty() {
+			return fmt.Errorf("attestor should not be empty")
+		}
+
+		if seen[a.String()] {
+			return fmt.Errorf("duplicated attestor %s", a)
+		}
+		seen[a.String()] = true
+	}
+
+	return nil
+}
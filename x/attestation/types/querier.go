@@ -0,0 +1,31 @@
+package types
+
+// Query endpoints supported by the attestation querier
+const (
+	QueryParameters   = "parameters"
+	QueryAttestation  = "attestation"
+	QueryAttestations = "attestations"
+)
+
+// QueryAttestationParams defines the params for the following queries:
+// - 'custom/attestation/attestation'
+type QueryAttestationParams struct {
+	Account AccountID
+	Type    Name
+}
+
+// NewQueryAttestationParams creates a new QueryAttestationParams instance
+func NewQueryAttestationParams(account AccountID, attestationType Name) QueryAttestationParams {
+	return QueryAttestationParams{account, attestationType}
+}
+
+// QueryAttestationsParams defines the params for the following queries:
+// - 'custom/attestation/attestations'
+type QueryAttestationsParams struct {
+	Account AccountID
+}
+
+// NewQueryAttestationsParams creates a new QueryAttestationsParams instance
+func NewQueryAttestationsParams(account AccountID) QueryAttestationsParams {
+	return QueryAttestationsParams{account}
+}
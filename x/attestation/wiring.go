@@ -0,0 +1,10 @@
+package attestation
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+)
+
+// ProvideKeeper creates the attestation keeper by the store key and params subspace declared to the builder
+func ProvideKeeper(b *wiring.Builder) Keeper {
+	return NewKeeper(b.Codec(), b.KVStoreKey(StoreKey), b.Subspace(DefaultParamspace))
+}
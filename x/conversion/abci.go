@@ -0,0 +1,36 @@
+package conversion
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/conversion/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker converts the remaining old coins of the conversions whose swap window ended
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	logger := k.Logger(ctx)
+
+	ended := make([]Conversion, 0)
+	k.IterateConversions(ctx, func(conversion Conversion) bool {
+		if !conversion.Completed && conversion.IsEnded(ctx.BlockHeight()) {
+			ended = append(ended, conversion)
+		}
+		return false
+	})
+
+	for _, c := range ended {
+		conversion := k.CompleteConversion(ctx, c)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteConversion,
+				sdk.NewAttribute(types.AttributeKeyFromDenom, conversion.FromDenom),
+				sdk.NewAttribute(types.AttributeKeyToDenom, conversion.ToDenom),
+				sdk.NewAttribute(types.AttributeKeyConverted, conversion.Converted.String()),
+				sdk.NewAttribute(types.AttributeKeyMinted, conversion.Minted.String()),
+			),
+		)
+
+		logger.Info("conversion completed", "from", conversion.FromDenom, "to", conversion.ToDenom,
+			"converted", conversion.Converted, "minted", conversion.Minted)
+	}
+}
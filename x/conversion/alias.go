@@ -0,0 +1,48 @@
+package conversion
+
+// nolint
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/conversion/keeper"
+	"github.com/KuChainNetwork/kuchain/x/conversion/types"
+)
+
+const (
+	ModuleName             = types.ModuleName
+	StoreKey               = types.StoreKey
+	RouterKey              = types.RouterKey
+	QuerierRoute           = types.QuerierRoute
+	QueryConversion        = types.QueryConversion
+	QueryConversions       = types.QueryConversions
+	ProposalTypeConversion = types.ProposalTypeConversion
+)
+
+var (
+	// functions aliases
+	NewKeeper                = keeper.NewKeeper
+	NewQuerier               = keeper.NewQuerier
+	RegisterInvariants       = keeper.RegisterInvariants
+	ValueConservation        = keeper.ValueConservation
+	RegisterCodec            = types.RegisterCodec
+	NewGenesisState          = types.NewGenesisState
+	DefaultGenesisState      = types.DefaultGenesisState
+	ValidateGenesis          = types.ValidateGenesis
+	NewConversion            = types.NewConversion
+	NewConversionProposal    = types.NewConversionProposal
+	NewMsgConvert            = types.NewMsgConvert
+	NewKuMsgConvert          = types.NewKuMsgConvert
+	NewQueryConversionParams = types.NewQueryConversionParams
+
+	// variable aliases
+	ModuleCdc = types.ModuleCdc
+	Cdc       = types.Cdc
+)
+
+type (
+	Keeper             = keeper.Keeper
+	GenesisState       = types.GenesisState
+	Conversion         = types.Conversion
+	Conversions        = types.Conversions
+	ConversionProposal = types.ConversionProposal
+	MsgConvert         = types.MsgConvert
+)
@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/blockutil"
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/x/conversion/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	conversionQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the conversion module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	conversionQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryConversion(cdc),
+			GetCmdQueryConversions(cdc),
+		)...,
+	)
+
+	return conversionQueryCmd
+}
+
+// GetCmdQueryConversion implements the query conversion command.
+func GetCmdQueryConversion(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "conversion [from-denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the conversion of the old denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the conversion of the old denom, including the rate, the swap window and the converted amount.
+
+Example:
+$ %s query conversion conversion foo/old
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryConversionParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryConversion)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var conversion types.Conversion
+			cdc.MustUnmarshalJSON(res, &conversion)
+			if err := cliCtx.PrintOutput(conversion); err != nil {
+				return err
+			}
+
+			if !conversion.Completed {
+				blockutil.PrintHeightNote(cliCtx, cmd.ErrOrStderr(), "swap window ends", conversion.EndHeight)
+			}
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryConversions implements the query all conversions command.
+func GetCmdQueryConversions(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "conversions",
+		Args:  cobra.NoArgs,
+		Short: "Query all the conversions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the conversions defined by gov.
+
+Example:
+$ %s query conversion conversions
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryConversions)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var conversions types.Conversions
+			cdc.MustUnmarshalJSON(res, &conversions)
+			return cliCtx.PrintOutput(conversions)
+		},
+	}
+}
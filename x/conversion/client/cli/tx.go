@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/conversion/types"
+	govCli "github.com/KuChainNetwork/kuchain/x/gov/client/cli"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	conversionTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Conversion transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	conversionTxCmd.AddCommand(flags.PostCommands(
+		GetCmdConvert(cdc),
+	)...)
+
+	return conversionTxCmd
+}
+
+// GetCmdConvert implements the convert command.
+func GetCmdConvert(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert [owner] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Swap the old coins to the new coins by the conversion rate",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap the old coins to the new coins by the conversion rate defined by gov,
+the old coins will be burned, it can only be done before the end height of the conversion.
+
+Example:
+$ %s tx conversion convert alice 1000foo/old --from alice
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			owner, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "owner account id error")
+			}
+
+			amount, err := chainTypes.ParseCoin(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "amount parse error")
+			}
+
+			ownerAuth, err := txutil.QueryAccountAuth(cliCtx, owner)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", owner)
+			}
+
+			msg := types.NewKuMsgConvert(ownerAuth, owner, amount)
+			cliCtx = cliCtx.WithFromAccount(owner)
+			if txBldr.FeePayer().Empty() {
+				txBldr = txBldr.WithPayer(args[0])
+			}
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitConversionProposal implements a command handler for submitting a conversion proposal transaction.
+func GetCmdSubmitConversionProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion [proposer] [from-denom] [to-denom] [rate] [end-height]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a proposal to convert the old denom to the new denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to convert the old denom to the new denom by the rate, the holders
+can swap the old coins until the end height, the remaining old coins will be converted automatically at the end height.
+Both coins should be created before the proposal passed.
+
+Example:
+$ %s tx kugov submit-proposal conversion jack foo/old foo/new 10 100000 --title="Redenominate foo" --description="redenominate foo" --deposit="1000kuchain/kcs" --from=<key>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			proposer, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposer account id error")
+			}
+
+			rate, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return sdkerrors.Wrap(err, "rate parse error")
+			}
+
+			endHeight, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "end height parse error")
+			}
+
+			deposit, err := chainTypes.ParseCoins(viper.GetString(govCli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewConversionProposal(
+				viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription), args[1], args[2], rate, endHeight)
+
+			msg := govTypes.NewKuMsgSubmitProposal(cliCtx.GetFromAddress(), content, deposit, proposer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithFromAccount(proposer)
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govCli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govCli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
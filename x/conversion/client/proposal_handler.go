@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/conversion/client/cli"
+	"github.com/KuChainNetwork/kuchain/x/conversion/client/rest"
+	"github.com/KuChainNetwork/kuchain/x/gov/client"
+)
+
+// ConversionProposalHandler the conversion proposal handler
+var ConversionProposalHandler = client.NewProposalHandler(cli.GetCmdSubmitConversionProposal, rest.ConversionProposalRESTHandler)
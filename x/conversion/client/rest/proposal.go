@@ -0,0 +1,59 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/conversion/types"
+	govRest "github.com/KuChainNetwork/kuchain/x/gov/client/rest"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConversionProposalReq defines a conversion proposal request body.
+type ConversionProposalReq struct {
+	BaseReq chainTypes.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title              string               `json:"title" yaml:"title"`
+	Description        string               `json:"description" yaml:"description"`
+	FromDenom          string               `json:"from_denom" yaml:"from_denom"`
+	ToDenom            string               `json:"to_denom" yaml:"to_denom"`
+	Rate               sdk.Dec              `json:"rate" yaml:"rate"`
+	EndHeight          int64                `json:"end_height" yaml:"end_height"`
+	Proposer           chainTypes.AccountID `json:"proposer" yaml:"proposer"`
+	Deposit            chainTypes.Coins     `json:"deposit" yaml:"deposit"`
+	ProposerAccAddress sdk.AccAddress       `json:"proposer_accaddress" yaml:"proposer_accaddress"`
+}
+
+// ConversionProposalRESTHandler returns a ProposalRESTHandler that exposes the conversion REST handler with a given sub-route.
+func ConversionProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "conversion",
+		Handler:  postConversionProposalHandlerFn(cliCtx),
+	}
+}
+
+func postConversionProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ConversionProposalReq
+		if !chainTypes.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewConversionProposal(req.Title, req.Description, req.FromDenom, req.ToDenom, req.Rate, req.EndHeight)
+		msg := govTypes.NewKuMsgSubmitProposal(req.ProposerAccAddress, content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			chainTypes.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txutil.WriteGenerateStdTxResponse(w, txutil.NewKuCLICtx(cliCtx), req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,25 @@
+package conversion
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/conversion/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initialize the conversions
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	for _, conversion := range data.Conversions {
+		keeper.SetConversion(ctx, conversion)
+	}
+}
+
+// ExportGenesis writes the current store values
+// to a genesis file, which can be imported again
+// with InitGenesis
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	conversions := keeper.GetConversions(ctx)
+	if conversions == nil {
+		conversions = types.Conversions{}
+	}
+
+	return NewGenesisState(conversions)
+}
@@ -0,0 +1,50 @@
+package conversion
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/conversion/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewHandler(k Keeper) msg.Handler {
+	return func(ctx chainTypes.Context, msg sdk.Msg) (*sdk.Result, error) {
+		switch msg := msg.(type) {
+		case types.KuMsgConvert:
+			return handleKuMsgConvert(ctx, k, msg)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleKuMsgConvert(ctx chainTypes.Context, k Keeper, msg types.KuMsgConvert) (*sdk.Result, error) {
+	msgData := types.MsgConvert{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg Convert data unmarshal error")
+	}
+
+	ctx.RequireAuth(msgData.Owner)
+
+	converted, err := k.Convert(ctx.Context(), msgData.Owner, msgData.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msgData.Owner.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeConvert,
+			sdk.NewAttribute(types.AttributeKeyOwner, msgData.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msgData.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyMinted, converted.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
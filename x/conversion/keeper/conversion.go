@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/conversion/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetConversion gets the conversion of the old denom
+func (k Keeper) GetConversion(ctx sdk.Context, fromDenom string) (conversion types.Conversion, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConversionKey(fromDenom))
+	if bz == nil {
+		return conversion, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &conversion)
+	return conversion, true
+}
+
+// SetConversion sets the conversion to store
+func (k Keeper) SetConversion(ctx sdk.Context, conversion types.Conversion) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConversionKey(conversion.FromDenom), k.cdc.MustMarshalBinaryBare(conversion))
+}
+
+// IterateConversions iterates all the conversions
+func (k Keeper) IterateConversions(ctx sdk.Context, cb func(conversion types.Conversion) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConversionKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var conversion types.Conversion
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &conversion)
+		if cb(conversion) {
+			break
+		}
+	}
+}
+
+// GetConversions gets all the conversions
+func (k Keeper) GetConversions(ctx sdk.Context) (conversions types.Conversions) {
+	k.IterateConversions(ctx, func(conversion types.Conversion) bool {
+		conversions = append(conversions, conversion)
+		return false
+	})
+	return conversions
+}
+
+// CreateConversion creates the conversion from the old denom to the new denom, both coins should be created
+func (k Keeper) CreateConversion(ctx sdk.Context, fromDenom, toDenom string, rate sdk.Dec, endHeight int64) (types.Conversion, error) {
+	if _, found := k.GetConversion(ctx, fromDenom); found {
+		return types.Conversion{}, sdkerrors.Wrapf(types.ErrConversionExists, "denom %s", fromDenom)
+	}
+
+	if endHeight <= ctx.BlockHeight() {
+		return types.Conversion{}, sdkerrors.Wrapf(types.ErrInvalidConversion,
+			"end height %d should be greater than current %d", endHeight, ctx.BlockHeight())
+	}
+
+	for _, denom := range []string{fromDenom, toDenom} {
+		if err := k.checkCoinExists(ctx, denom); err != nil {
+			return types.Conversion{}, err
+		}
+	}
+
+	conversion := types.NewConversion(fromDenom, toDenom, rate, endHeight)
+	if err := conversion.Validate(); err != nil {
+		return types.Conversion{}, sdkerrors.Wrap(types.ErrInvalidConversion, err.Error())
+	}
+
+	k.SetConversion(ctx, conversion)
+
+	return conversion, nil
+}
+
+func (k Keeper) checkCoinExists(ctx sdk.Context, denom string) error {
+	creator, symbol, err := types.CoinAccountsFromDenom(denom)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidConversion, "denom %s: %s", denom, err.Error())
+	}
+
+	stat, err := k.assetKeeper.GetCoinStat(ctx, creator, symbol)
+	if err != nil || stat == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidConversion, "coin %s not exists", denom)
+	}
+
+	return nil
+}
+
+// Convert swaps the old coins of the owner to the new coins by the conversion rate in the swap window
+func (k Keeper) Convert(ctx sdk.Context, owner types.AccountID, amount types.Coin) (types.Coin, error) {
+	conversion, found := k.GetConversion(ctx, amount.Denom)
+	if !found {
+		return types.Coin{}, sdkerrors.Wrapf(types.ErrUnknownConversion, "denom %s", amount.Denom)
+	}
+
+	if conversion.IsEnded(ctx.BlockHeight()) {
+		return types.Coin{}, sdkerrors.Wrapf(types.ErrConversionEnded, "denom %s ended at %d", amount.Denom, conversion.EndHeight)
+	}
+
+	converted, err := k.convert(ctx, &conversion, owner, amount.Amount)
+	if err != nil {
+		return types.Coin{}, err
+	}
+
+	k.SetConversion(ctx, conversion)
+
+	return converted, nil
+}
+
+// convert burns the old coins and issues the new coins to the owner, the conversion stat
+// will be updated only if success
+func (k Keeper) convert(ctx sdk.Context, conversion *types.Conversion, owner types.AccountID, amount sdk.Int) (types.Coin, error) {
+	converted := types.NewCoin(conversion.ToDenom, conversion.ConvertAmount(amount))
+	if !converted.IsPositive() {
+		return types.Coin{}, sdkerrors.Wrapf(types.ErrInvalidConversionAmount, "%s%s is too small to convert", amount, conversion.FromDenom)
+	}
+
+	if err := k.assetKeeper.Burn(ctx, owner, types.NewCoin(conversion.FromDenom, amount)); err != nil {
+		return types.Coin{}, sdkerrors.Wrap(err, "burn old coins")
+	}
+
+	if _, err := k.assetKeeper.IssueCoinPower(ctx, owner, types.Coins{converted}); err != nil {
+		return types.Coin{}, sdkerrors.Wrap(err, "issue new coins")
+	}
+
+	if err := k.assetKeeper.ExerciseCoinPower(ctx, owner, converted); err != nil {
+		return types.Coin{}, sdkerrors.Wrap(err, "exercise new coins")
+	}
+
+	conversion.Converted = conversion.Converted.Add(amount)
+	conversion.Minted = conversion.Minted.Add(converted.Amount)
+	conversion.Count++
+
+	return converted, nil
+}
+
+// CompleteConversion converts all the remaining old coins of the holders when the swap window ended,
+// the holders which can not be converted, such as the coins locked, will keep the old coins.
+func (k Keeper) CompleteConversion(ctx sdk.Context, conversion types.Conversion) types.Conversion {
+	type holding struct {
+		owner  types.AccountID
+		amount sdk.Int
+	}
+
+	// collect the holders first, as the conversion will change the coins store
+	holdings := make([]holding, 0)
+	k.assetKeeper.IterateAllCoins(ctx, func(address types.AccountID, balance types.Coins) bool {
+		if amount := balance.AmountOf(conversion.FromDenom); amount.IsPositive() {
+			holdings = append(holdings, holding{address, amount})
+		}
+		return false
+	})
+
+	logger := k.Logger(ctx)
+	for _, h := range holdings {
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := k.convert(cacheCtx, &conversion, h.owner, h.amount); err != nil {
+			logger.Error("convert coins failed", "owner", h.owner, "amount", h.amount, "denom", conversion.FromDenom, "err", err)
+			continue
+		}
+		write()
+	}
+
+	conversion.Completed = true
+	k.SetConversion(ctx, conversion)
+
+	return conversion
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/conversion/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants register all conversion invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "value-conservation", ValueConservation(k))
+}
+
+// ValueConservation checks that the new coins minted by each conversion reflects the old coins
+// burned by the rate, each conversion truncates less than one unit of the new coin.
+func ValueConservation(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		k.IterateConversions(ctx, func(conversion types.Conversion) bool {
+			expected := conversion.Rate.MulInt(conversion.Converted)
+			truncated := expected.Sub(conversion.Minted.ToDec())
+
+			if truncated.IsNegative() || (!truncated.IsZero() && truncated.GTE(sdk.NewDec(int64(conversion.Count)))) {
+				broken = true
+				msg += fmt.Sprintf("\tconversion %s converted %s minted %s %s by rate %s in %d conversions\n",
+					conversion.FromDenom, conversion.Converted, conversion.Minted, conversion.ToDenom,
+					conversion.Rate, conversion.Count)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "value conservation", msg), broken
+	}
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/conversion/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Keeper of the conversion store
+type Keeper struct {
+	cdc         *codec.Codec
+	storeKey    sdk.StoreKey
+	assetKeeper types.AssetKeeper
+}
+
+// NewKeeper creates a new conversion Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, assetKeeper types.AssetKeeper) Keeper {
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    key,
+		assetKeeper: assetKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
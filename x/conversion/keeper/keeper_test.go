@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/conversion"
+	"github.com/KuChainNetwork/kuchain/x/conversion/keeper"
+	conversionTypes "github.com/KuChainNetwork/kuchain/x/conversion/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var (
+	wallet    = simapp.NewWallet()
+	creator   = types.MustName("foo")
+	creatorID = types.NewAccountIDFromName(creator)
+	oldSymbol = types.MustName("old")
+	newSymbol = types.MustName("new")
+	oldDenom  = types.CoinDenom(creator, oldSymbol)
+	newDenom  = types.CoinDenom(creator, newSymbol)
+)
+
+func TestConversion(t *testing.T) {
+	app := simapp.SetupWithGenesisAccounts(simapp.NewGenesisAccounts(wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(creatorID, wallet.NewAccAddress()),
+	))
+	k := app.ConversionKeeper()
+	ak := app.AssetKeeper()
+
+	holder := types.NewAccountIDFromAccAdd(wallet.NewAccAddress())
+	dustHolder := types.NewAccountIDFromAccAdd(wallet.NewAccAddress())
+
+	Convey("test convert coins in the swap window and at the end", t, func() {
+		ctx, _ := app.NewTestContext().CacheContext()
+		ctx = ctx.WithBlockHeight(10)
+
+		rate := sdk.NewDecWithPrec(4, 1) // 0.4
+
+		_, err := k.CreateConversion(ctx, oldDenom, newDenom, rate, 20)
+		So(err, simapp.ShouldErrIs, conversionTypes.ErrInvalidConversion)
+
+		So(ak.Create(ctx, creator, oldSymbol, types.NewInt64Coin(oldDenom, 10000000),
+			true, true, 0, types.NewInt64Coin(oldDenom, 0), []byte{}), ShouldBeNil)
+		So(ak.Create(ctx, creator, newSymbol, types.NewInt64Coin(newDenom, 100000000),
+			true, true, 0, types.NewInt64Coin(newDenom, 0), []byte{}), ShouldBeNil)
+		So(ak.Issue(ctx, creator, oldSymbol, types.NewInt64Coin(oldDenom, 10000)), ShouldBeNil)
+		So(ak.Transfer(ctx, creatorID, holder, types.NewCoins(types.NewInt64Coin(oldDenom, 1000))), ShouldBeNil)
+		So(ak.Transfer(ctx, creatorID, dustHolder, types.NewCoins(types.NewInt64Coin(oldDenom, 1))), ShouldBeNil)
+
+		_, err = k.Convert(ctx, holder, types.NewInt64Coin(oldDenom, 100))
+		So(err, simapp.ShouldErrIs, conversionTypes.ErrUnknownConversion)
+
+		_, err = k.CreateConversion(ctx, oldDenom, newDenom, rate, 10)
+		So(err, simapp.ShouldErrIs, conversionTypes.ErrInvalidConversion)
+
+		_, err = k.CreateConversion(ctx, oldDenom, newDenom, rate, 20)
+		So(err, ShouldBeNil)
+
+		_, err = k.CreateConversion(ctx, oldDenom, newDenom, rate, 30)
+		So(err, simapp.ShouldErrIs, conversionTypes.ErrConversionExists)
+
+		converted, err := k.Convert(ctx, holder, types.NewInt64Coin(oldDenom, 301))
+		So(err, ShouldBeNil)
+		So(converted, ShouldResemble, types.NewInt64Coin(newDenom, 120))
+		So(ak.GetBalance(ctx, holder, oldDenom).Amount.Int64(), ShouldEqual, 699)
+		So(ak.GetBalance(ctx, holder, newDenom).Amount.Int64(), ShouldEqual, 120)
+
+		// the failed msg will not be committed
+		failedCtx, _ := ctx.CacheContext()
+		_, err = k.Convert(failedCtx, holder, types.NewInt64Coin(oldDenom, 1000))
+		So(err, ShouldNotBeNil)
+
+		_, broken := keeper.ValueConservation(*k)(ctx)
+		So(broken, ShouldBeFalse)
+
+		Convey("remaining coins converted at the end height", func() {
+			ctx := ctx.WithBlockHeight(20)
+
+			_, err := k.Convert(ctx, holder, types.NewInt64Coin(oldDenom, 100))
+			So(err, simapp.ShouldErrIs, conversionTypes.ErrConversionEnded)
+
+			conversion.EndBlocker(ctx, *k)
+
+			c, found := k.GetConversion(ctx, oldDenom)
+			So(found, ShouldBeTrue)
+			So(c.Completed, ShouldBeTrue)
+			So(c.Count, ShouldEqual, 3)
+			So(c.Converted.Int64(), ShouldEqual, 9999)
+
+			So(ak.GetBalance(ctx, holder, oldDenom).IsZero(), ShouldBeTrue)
+			So(ak.GetBalance(ctx, holder, newDenom).Amount.Int64(), ShouldEqual, 120+279)
+			So(ak.GetBalance(ctx, creatorID, oldDenom).IsZero(), ShouldBeTrue)
+			So(ak.GetBalance(ctx, creatorID, newDenom).Amount.Int64(), ShouldEqual, 3599)
+
+			// the dust can not be converted, keep the old coin
+			So(ak.GetBalance(ctx, dustHolder, oldDenom).Amount.Int64(), ShouldEqual, 1)
+
+			_, broken := keeper.ValueConservation(*k)(ctx)
+			So(broken, ShouldBeFalse)
+		})
+
+		Convey("invariant broken by minted more than the rate", func() {
+			c, _ := k.GetConversion(ctx, oldDenom)
+			c.Minted = c.Minted.AddRaw(1)
+			k.SetConversion(ctx, c)
+
+			_, broken := keeper.ValueConservation(*k)(ctx)
+			So(broken, ShouldBeTrue)
+		})
+	})
+}
+
+func TestValidateGenesis(t *testing.T) {
+	Convey("test validate conversion genesis", t, func() {
+		c := conversionTypes.NewConversion(oldDenom, newDenom, sdk.OneDec(), 100)
+
+		So(conversionTypes.ValidateGenesis(conversionTypes.DefaultGenesisState()), ShouldBeNil)
+		So(conversionTypes.ValidateGenesis(conversionTypes.NewGenesisState(conversionTypes.Conversions{c})), ShouldBeNil)
+		So(conversionTypes.ValidateGenesis(conversionTypes.NewGenesisState(conversionTypes.Conversions{c, c})), ShouldNotBeNil)
+
+		c.ToDenom = oldDenom
+		So(conversionTypes.ValidateGenesis(conversionTypes.NewGenesisState(conversionTypes.Conversions{c})), ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/x/conversion/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewQuerier creates a new querier for conversion clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryConversion:
+			return queryConversion(ctx, req, k)
+
+		case types.QueryConversions:
+			return queryConversions(ctx, k)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+	}
+}
+
+func queryConversion(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryConversionParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	conversion, found := k.GetConversion(ctx, params.FromDenom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownConversion, "denom %s", params.FromDenom)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, conversion)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryConversions(ctx sdk.Context, k Keeper) ([]byte, error) {
+	conversions := k.GetConversions(ctx)
+	if conversions == nil {
+		conversions = types.Conversions{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, conversions)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
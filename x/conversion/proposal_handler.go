@@ -0,0 +1,42 @@
+package conversion
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/conversion/types"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewConversionProposalHandler creates a governance handler to define the conversions of the denoms
+func NewConversionProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) error {
+		switch c := content.(type) {
+		case types.ConversionProposal:
+			return handleConversionProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized conversion proposal content type: %T", c)
+		}
+	}
+}
+
+func handleConversionProposal(ctx sdk.Context, k Keeper, p types.ConversionProposal) error {
+	conversion, err := k.CreateConversion(ctx, p.FromDenom, p.ToDenom, p.Rate, p.EndHeight)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateConversion,
+			sdk.NewAttribute(types.AttributeKeyFromDenom, conversion.FromDenom),
+			sdk.NewAttribute(types.AttributeKeyToDenom, conversion.ToDenom),
+			sdk.NewAttribute(types.AttributeKeyRate, conversion.Rate.String()),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, fmt.Sprintf("%d", conversion.EndHeight)),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+type (
+	AccountID  = types.AccountID
+	AccAddress = types.AccAddress
+	KuMsg      = types.KuMsg
+	Name       = types.Name
+	Coin       = types.Coin
+	Coins      = types.Coins
+)
+
+var (
+	MustName              = types.MustName
+	NewAccountIDFromStr   = types.NewAccountIDFromStr
+	NewCoin               = types.NewCoin
+	CoinAccountsFromDenom = types.CoinAccountsFromDenom
+)
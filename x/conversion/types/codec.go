@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgConvert{}, "conversion/MsgConvert", nil)
+	cdc.RegisterConcrete(KuMsgConvert{}, "conversion/KuMsgConvert", nil)
+	cdc.RegisterConcrete(ConversionProposal{}, "kuchain/ConversionProposal", nil)
+}
+
+var (
+	// ModuleCdc references the global x/conversion module codec.
+	ModuleCdc = codec.New()
+)
+
+// Cdc get codec for types
+func Cdc() *codec.Codec {
+	return ModuleCdc
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
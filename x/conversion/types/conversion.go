@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Conversion the redenomination of the old denom to the new denom defined by gov,
+// the holders can swap the old coins until the end height, at which the remaining
+// old coins will be converted automatically.
+type Conversion struct {
+	FromDenom string  `json:"from_denom" yaml:"from_denom"`
+	ToDenom   string  `json:"to_denom" yaml:"to_denom"`
+	Rate      sdk.Dec `json:"rate" yaml:"rate"`             // Rate the new coins amount for one old coin
+	EndHeight int64   `json:"end_height" yaml:"end_height"` // EndHeight the swap window ends at the height
+	Completed bool    `json:"completed" yaml:"completed"`   // Completed the remaining old coins has been converted at the end height
+
+	Converted sdk.Int `json:"converted" yaml:"converted"` // Converted the total amount of the old coins burned
+	Minted    sdk.Int `json:"minted" yaml:"minted"`       // Minted the total amount of the new coins issued
+	Count     uint64  `json:"count" yaml:"count"`         // Count the number of the conversions made
+}
+
+// NewConversion creates a new Conversion instance
+func NewConversion(fromDenom, toDenom string, rate sdk.Dec, endHeight int64) Conversion {
+	return Conversion{
+		FromDenom: fromDenom,
+		ToDenom:   toDenom,
+		Rate:      rate,
+		EndHeight: endHeight,
+		Converted: sdk.ZeroInt(),
+		Minted:    sdk.ZeroInt(),
+	}
+}
+
+// IsEnded returns if the swap window is ended at the height
+func (c Conversion) IsEnded(height int64) bool {
+	return c.Completed || height >= c.EndHeight
+}
+
+// ConvertAmount returns the new coins amount for the old coins amount, truncated
+func (c Conversion) ConvertAmount(amount sdk.Int) sdk.Int {
+	return c.Rate.MulInt(amount).TruncateInt()
+}
+
+// Validate validates the conversion
+func (c Conversion) Validate() error {
+	if err := validateDenoms(c.FromDenom, c.ToDenom); err != nil {
+		return err
+	}
+
+	if c.Rate.IsNil() || !c.Rate.IsPositive() {
+		return fmt.Errorf("conversion rate should be positive: %s", c.Rate)
+	}
+
+	if c.EndHeight <= 0 {
+		return fmt.Errorf("conversion end height should be positive: %d", c.EndHeight)
+	}
+
+	if c.Converted.IsNegative() || c.Minted.IsNegative() {
+		return fmt.Errorf("conversion converted and minted should not be negative")
+	}
+
+	return nil
+}
+
+func validateDenoms(fromDenom, toDenom string) error {
+	if err := chainTypes.ValidateDenom(fromDenom); err != nil {
+		return fmt.Errorf("conversion from denom %s error: %w", fromDenom, err)
+	}
+
+	if err := chainTypes.ValidateDenom(toDenom); err != nil {
+		return fmt.Errorf("conversion to denom %s error: %w", toDenom, err)
+	}
+
+	if fromDenom == toDenom {
+		return fmt.Errorf("conversion from denom should not be the same as to denom: %s", fromDenom)
+	}
+
+	return nil
+}
+
+func (c Conversion) String() string {
+	out, _ := yaml.Marshal(c)
+	return string(out)
+}
+
+// Conversions is a collection of Conversion objects
+type Conversions []Conversion
+
+func (c Conversions) String() string {
+	out, _ := yaml.Marshal(c)
+	return string(out)
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/conversion module sentinel errors
+var (
+	ErrInvalidConversion       = sdkerrors.Register(ModuleName, 2, "invalid conversion")
+	ErrConversionExists        = sdkerrors.Register(ModuleName, 3, "conversion of the denom already exists")
+	ErrUnknownConversion       = sdkerrors.Register(ModuleName, 4, "unknown conversion")
+	ErrConversionEnded         = sdkerrors.Register(ModuleName, 5, "conversion swap window ended")
+	ErrInvalidConversionAmount = sdkerrors.Register(ModuleName, 6, "invalid conversion amount")
+)
@@ -0,0 +1,19 @@
+package types
+
+// conversion module event types
+const (
+	EventTypeCreateConversion   = "create_conversion"
+	EventTypeConvert            = "convert"
+	EventTypeCompleteConversion = "complete_conversion"
+
+	AttributeKeyOwner     = "owner"
+	AttributeKeyFromDenom = "from_denom"
+	AttributeKeyToDenom   = "to_denom"
+	AttributeKeyRate      = "rate"
+	AttributeKeyEndHeight = "end_height"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyConverted = "converted"
+	AttributeKeyMinted    = "minted"
+
+	AttributeValueCategory = ModuleName
+)
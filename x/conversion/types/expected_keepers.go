@@ -0,0 +1,15 @@
+package types
+
+import (
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AssetKeeper defines the expected asset keeper to burn the old coins and issue the new coins
+type AssetKeeper interface {
+	GetCoinStat(ctx sdk.Context, creator, symbol Name) (*assetTypes.CoinStat, error)
+	Burn(ctx sdk.Context, id AccountID, amount Coin) error
+	IssueCoinPower(ctx sdk.Context, id AccountID, amt Coins) (Coins, error)
+	ExerciseCoinPower(ctx sdk.Context, id AccountID, amt Coin) error
+	IterateAllCoins(ctx sdk.Context, cb func(address AccountID, balance Coins) (stop bool))
+}
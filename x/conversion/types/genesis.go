@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GenesisState - all conversion state that must be provided at genesis
+type GenesisState struct {
+	Conversions Conversions `json:"conversions" yaml:"conversions"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(conversions Conversions) GenesisState {
+	return GenesisState{
+		Conversions: conversions,
+	}
+}
+
+// DefaultGenesisState - default GenesisState
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(Conversions{})
+}
+
+// ValidateGenesis performs basic validation of conversion genesis data returning an
+// error for any failed validation criteria.
+func (g GenesisState) ValidateGenesis(bz json.RawMessage) error {
+	gs := DefaultGenesisState()
+	if err := Cdc().UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+
+	return ValidateGenesis(gs)
+}
+
+// ValidateGenesis validates the conversion genesis data
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.Conversions))
+	for _, c := range data.Conversions {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+
+		if seen[c.FromDenom] {
+			return fmt.Errorf("duplicated conversion of %s", c.FromDenom)
+		}
+		seen[c.FromDenom] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package types
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "conversion"
+
+	// StoreKey is the store key string for conversions
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for conversions
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for conversions
+	QuerierRoute = ModuleName
+)
+
+// Keys for conversion store
+// Items are stored with the following key: values
+//
+// - 0x01<from_denom_Bytes>: Conversion
+var (
+	ConversionKeyPrefix = []byte{0x01}
+)
+
+// ConversionKey gets the key of the conversion by the old denom
+func ConversionKey(fromDenom string) []byte {
+	return append(ConversionKeyPrefix, []byte(fromDenom)...)
+}
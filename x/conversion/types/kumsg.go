@@ -0,0 +1,41 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	RouterKeyName = MustName(RouterKey)
+)
+
+type KuMsgConvert struct {
+	KuMsg
+}
+
+// NewKuMsgConvert creates a msg for the holder to swap the old coins to the new coins
+func NewKuMsgConvert(auth sdk.AccAddress, owner AccountID, amount Coin) KuMsgConvert {
+	return KuMsgConvert{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgConvert{
+				Owner:  owner,
+				Amount: amount,
+			}),
+		),
+	}
+}
+
+func (msg KuMsgConvert) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgData := MsgConvert{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return err
+	}
+
+	return msgData.ValidateBasic()
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	chainType "github.com/KuChainNetwork/kuchain/chain/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// verify interface at compile time
+var _ chainType.KuMsgData = (*MsgConvert)(nil)
+
+// MsgConvert - struct for the holder to swap the old coins to the new coins
+type MsgConvert struct {
+	Owner  AccountID `json:"owner" yaml:"owner"`
+	Amount Coin      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgConvert creates a new MsgConvert instance
+func NewMsgConvert(owner AccountID, amount Coin) MsgConvert {
+	return MsgConvert{
+		Owner:  owner,
+		Amount: amount,
+	}
+}
+
+// nolint
+func (msg MsgConvert) Route() string     { return RouterKey }
+func (msg MsgConvert) Type() Name        { return MustName("convert") }
+func (msg MsgConvert) Sender() AccountID { return msg.Owner }
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgConvert) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(ErrInvalidConversion, "owner should not be empty")
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidConversionAmount, "amount %s", msg.Amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ProposalTypeConversion defines the type for a ConversionProposal
+	ProposalTypeConversion = "Conversion"
+)
+
+// Assert ConversionProposal implements govtypes.Content at compile-time
+var _ govTypes.Content = ConversionProposal{}
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeConversion)
+	govTypes.RegisterProposalTypeCodec(ConversionProposal{}, "kuchain/ConversionProposal")
+}
+
+// ConversionProposal defines the conversion from the old denom to the new denom by governance
+type ConversionProposal struct {
+	Title       string  `json:"title" yaml:"title"`
+	Description string  `json:"description" yaml:"description"`
+	FromDenom   string  `json:"from_denom" yaml:"from_denom"`
+	ToDenom     string  `json:"to_denom" yaml:"to_denom"`
+	Rate        sdk.Dec `json:"rate" yaml:"rate"`
+	EndHeight   int64   `json:"end_height" yaml:"end_height"`
+}
+
+// NewConversionProposal creates a new conversion proposal.
+func NewConversionProposal(title, description, fromDenom, toDenom string, rate sdk.Dec, endHeight int64) ConversionProposal {
+	return ConversionProposal{title, description, fromDenom, toDenom, rate, endHeight}
+}
+
+// GetTitle returns the title of a conversion proposal.
+func (p ConversionProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a conversion proposal.
+func (p ConversionProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a conversion proposal.
+func (p ConversionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a conversion proposal.
+func (p ConversionProposal) ProposalType() string { return ProposalTypeConversion }
+
+// ValidateBasic runs basic stateless validity checks
+func (p ConversionProposal) ValidateBasic() error {
+	if err := govTypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := validateDenoms(p.FromDenom, p.ToDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidConversion, err.Error())
+	}
+
+	if p.Rate.IsNil() || !p.Rate.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidConversion, "rate should be positive")
+	}
+
+	if p.EndHeight <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidConversion, "end height should be positive")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ConversionProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Conversion Proposal:
+  Title:       %s
+  Description: %s
+  From:        %s
+  To:          %s
+  Rate:        %s
+  End Height:  %d
+`, p.Title, p.Description, p.FromDenom, p.ToDenom, p.Rate, p.EndHeight))
+	return b.String()
+}
@@ -0,0 +1,18 @@
+package types
+
+// Query endpoints supported by the conversion querier
+const (
+	QueryConversion  = "conversion"
+	QueryConversions = "conversions"
+)
+
+// QueryConversionParams defines the params for the following queries:
+// - 'custom/conversion/conversion'
+type QueryConversionParams struct {
+	FromDenom string
+}
+
+// NewQueryConversionParams creates a new QueryConversionParams instance
+func NewQueryConversionParams(fromDenom string) QueryConversionParams {
+	return QueryConversionParams{fromDenom}
+}
@@ -0,0 +1,16 @@
+package conversion
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/conversion/types"
+)
+
+// Inputs the keepers the conversion module depends on
+type Inputs struct {
+	AssetKeeper types.AssetKeeper
+}
+
+// ProvideKeeper creates the conversion keeper by the store key declared to the builder
+func ProvideKeeper(b *wiring.Builder, in Inputs) Keeper {
+	return NewKeeper(b.Codec(), b.KVStoreKey(StoreKey), in.AssetKeeper)
+}
@@ -106,6 +106,8 @@ var (
 	ParamStoreKeyBaseProposerReward      = types.ParamStoreKeyBaseProposerReward
 	ParamStoreKeyBonusProposerReward     = types.ParamStoreKeyBonusProposerReward
 	ParamStoreKeyWithdrawAddrEnabled     = types.ParamStoreKeyWithdrawAddrEnabled
+	ParamStoreKeyReferralFeeRate         = types.ParamStoreKeyReferralFeeRate
+	ParamStoreKeyFeeBurnRate             = types.ParamStoreKeyFeeBurnRate
 	ModuleCdc                            = types.ModuleCdc
 	EventTypeSetWithdrawAddress          = types.EventTypeSetWithdrawAddress
 	EventTypeRewards                     = types.EventTypeRewards
@@ -156,3 +158,18 @@ type (
 type (
 	AccountID = types.AccountID
 )
+
+const (
+	RewardCurveLinear             = types.RewardCurveLinear
+	RewardCurveTapered            = types.RewardCurveTapered
+	TaperRecipientCommunityPool   = types.TaperRecipientCommunityPool
+	TaperRecipientSmallValidators = types.TaperRecipientSmallValidators
+	EventTypeRewardTaper          = types.EventTypeRewardTaper
+)
+
+var (
+	ParamStoreKeyRewardCurve    = types.ParamStoreKeyRewardCurve
+	ParamStoreKeyTaperThreshold = types.ParamStoreKeyTaperThreshold
+	ParamStoreKeyTaperRate      = types.ParamStoreKeyTaperRate
+	ParamStoreKeyTaperRecipient = types.ParamStoreKeyTaperRecipient
+)
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	chainType "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/distribution/client/common"
 	"github.com/KuChainNetwork/kuchain/x/distribution/types"
+	govCli "github.com/KuChainNetwork/kuchain/x/gov/client/cli"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -208,37 +210,40 @@ $ %s tx kudistribution set-withdraw withdrawacc  account --from account
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "community-pool-spend [proposer] [proposal-file]",
-		Args:  cobra.ExactArgs(2),
+		Use:   "community-pool-spend [proposer] [recipient-account] [amount]",
+		Args:  cobra.RangeArgs(1, 3),
 		Short: "Submit a community pool spend proposal",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Submit a community pool spend proposal along with an initial deposit.
-The proposal details must be supplied via a JSON file.
+			fmt.Sprintf(`Submit a proposal to spend the coins of the community pool to the recipient account along with an initial deposit.
 
 Example:
-$ %s tx gov submit-proposal community-pool-spend <path/to/proposal.json> --from=<key>
+$ %s tx kugov submit-proposal community-pool-spend jack alice 10000kuchain/kcs --title="Community Pool Spend" --description="Pay me some coins!" --deposit="1000kuchain/kcs" --from=<key>
+
+The proposal details can also be supplied via a JSON file by --proposal, the recipient and the amount args are omitted:
+
+$ %s tx kugov submit-proposal community-pool-spend jack --proposal=<path/to/proposal.json> --from=<key>
 
 Where proposal.json contains:
 
 {
   "title": "Community Pool Spend",
-  "description": "Pay me some Atoms!",
-  "recipient": "jack",
+  "description": "Pay me some coins!",
+  "recipient": "alice",
   "amount": [
     {
-      "denom": "stake",
+      "denom": "kuchain/kcs",
       "amount": "10000"
     }
   ],
   "deposit": [
     {
-      "denom": "stake",
-      "amount": "10000"
+      "denom": "kuchain/kcs",
+      "amount": "1000"
     }
   ]
 }
 `,
-				version.ClientName,
+				version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -246,17 +251,18 @@ Where proposal.json contains:
 			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
 			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
 
-			proposal, err := ParseCommunityPoolSpendProposalJSON(cdc, args[1])
+			proposerAccount, err := chainType.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposer account id error")
+			}
+
+			proposal, err := parseCommunityPoolSpendProposal(cdc, args[1:])
 			if err != nil {
 				return err
 			}
 
 			from := cliCtx.GetFromAddress()
 			content := types.NewCommunityPoolSpendProposal(proposal.Title, proposal.Description, proposal.Recipient, proposal.Amount)
-			proposerAccount, err := chainType.NewAccountIDFromStr(args[0])
-			if err != nil {
-				return sdkerrors.Wrap(err, "proposer account id error")
-			}
 
 			msg := types.GovTypesNewKuMsgSubmitProposal(from, content, proposal.Deposit, proposerAccount)
 			if err := msg.ValidateBasic(); err != nil {
@@ -267,5 +273,44 @@ Where proposal.json contains:
 		},
 	}
 
+	cmd.Flags().String(govCli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govCli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(govCli.FlagProposal, "", "proposal file path (if this path is given, the recipient, amount and other proposal flags are ignored)")
+
 	return cmd
 }
+
+// parseCommunityPoolSpendProposal parses the proposal from the --proposal file, or from the recipient and amount args and the flags
+func parseCommunityPoolSpendProposal(cdc *codec.Codec, args []string) (CommunityPoolSpendProposalJSON, error) {
+	if proposalFile := viper.GetString(govCli.FlagProposal); proposalFile != "" {
+		return ParseCommunityPoolSpendProposalJSON(cdc, proposalFile)
+	}
+
+	if len(args) != 2 {
+		return CommunityPoolSpendProposalJSON{}, errors.New("the recipient account and the amount are required if no --proposal file")
+	}
+
+	recipient, err := chainType.NewAccountIDFromStr(args[0])
+	if err != nil {
+		return CommunityPoolSpendProposalJSON{}, sdkerrors.Wrap(err, "recipient account id error")
+	}
+
+	amount, err := chainType.ParseCoins(args[1])
+	if err != nil {
+		return CommunityPoolSpendProposalJSON{}, err
+	}
+
+	deposit, err := chainType.ParseCoins(viper.GetString(govCli.FlagDeposit))
+	if err != nil {
+		return CommunityPoolSpendProposalJSON{}, err
+	}
+
+	return CommunityPoolSpendProposalJSON{
+		Title:       viper.GetString(govCli.FlagTitle),
+		Description: viper.GetString(govCli.FlagDescription),
+		Recipient:   recipient,
+		Amount:      amount,
+		Deposit:     deposit,
+	}, nil
+}
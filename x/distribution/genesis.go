@@ -37,6 +37,9 @@ func InitGenesis(ctx sdk.Context, bk types.BankKeeperAccountID, supplyKeeper typ
 	for _, evt := range data.ValidatorSlashEvents {
 		keeper.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	for _, free := range data.FreeTxUsages {
+		keeper.SetFreeTxUsed(ctx, free.Account, free.Used)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -137,5 +140,19 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	frees := make([]types.FreeTxUsageRecord, 0)
+	keeper.IterateFreeTxUsed(ctx,
+		func(id AccountID, used uint64) (stop bool) {
+			frees = append(frees, types.FreeTxUsageRecord{
+				Account: id,
+				Used:    used,
+			})
+			return false
+		},
+	)
+
+	gs := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	gs.FreeTxUsages = frees
+
+	return gs
 }
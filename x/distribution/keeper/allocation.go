@@ -5,6 +5,7 @@ import (
 
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/distribution/types"
+	supplyTypes "github.com/KuChainNetwork/kuchain/x/supply/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -17,6 +18,9 @@ func (k Keeper) AllocateTokens(
 
 	logger := k.Logger(ctx)
 
+	// burn a share of the collected fees before the allocation
+	k.burnCollectedFees(ctx)
+
 	// fetch and clear the collected fees for distribution, since this is
 	// called in BeginBlock, collected fees will be from the previous block
 	// (and distributed to the previous proposer)
@@ -85,6 +89,7 @@ func (k Keeper) AllocateTokens(
 
 	// allocate tokens proportionally to voting power
 	// TODO consider parallelizing later, ref https://github.com/cosmos/cosmos-sdk/pull/3099#discussion_r246276376
+	rewards := make([]validatorReward, 0, len(previousVotes))
 	for _, vote := range previousVotes {
 		validator := k.stakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address)
 
@@ -92,8 +97,13 @@ func (k Keeper) AllocateTokens(
 		// ref https://github.com/cosmos/cosmos-sdk/issues/2525#issuecomment-430838701
 		powerFraction := chainTypes.NewDec(vote.Validator.Power).QuoTruncate(chainTypes.NewDec(totalPreviousPower))
 		reward := feesCollected.MulDecTruncate(voteMultiplier).MulDecTruncate(powerFraction)
-		k.AllocateTokensToValidator(ctx, validator, reward)
-		remaining = remaining.Sub(reward)
+		rewards = append(rewards, validatorReward{validator, powerFraction, reward})
+	}
+
+	// the rewards tapered and not redirected to validators go to the community pool
+	for _, r := range k.applyRewardCurve(ctx, rewards) {
+		k.AllocateTokensToValidator(ctx, r.validator, r.reward)
+		remaining = remaining.Sub(r.reward)
 	}
 
 	// allocate community funding
@@ -102,6 +112,33 @@ func (k Keeper) AllocateTokens(
 	k.SetFeePool(ctx, feePool)
 }
 
+// burnCollectedFees burns the share of collected fees by the fee burn rate param
+func (k Keeper) burnCollectedFees(ctx sdk.Context) {
+	burnRate := k.GetFeeBurnRate(ctx)
+	if burnRate.IsNil() || !burnRate.IsPositive() {
+		return
+	}
+
+	feeCollector := k.supplyKeeper.GetModuleAccount(ctx, k.feeCollectorName)
+	feesCollected := chainTypes.NewDecCoinsFromCoins(k.BankKeeper.GetCoinPowers(ctx, feeCollector.GetID())...)
+
+	burned, _ := feesCollected.MulDecTruncate(burnRate).TruncateDecimal()
+	if burned.IsZero() {
+		return
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, supplyTypes.BlackHole, burned); err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnFee,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, burned.String()),
+		),
+	)
+}
+
 // AllocateTokensToValidator allocate tokens to a particular validator, splitting according to commission
 func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val types.StakingExportedValidatorI, tokens types.DecCoins) {
 	// split tokens between validator and delegators according to commission
@@ -361,3 +361,28 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.True(t, k.GetValidatorOutstandingRewards(ctx, Acc8).Rewards.IsValid())
 	require.True(t, k.GetValidatorOutstandingRewards(ctx, Acc10).Rewards.IsValid())
 }
+
+func TestAllocateTokensWithFeeBurn(t *testing.T) {
+	ctx, _, k, _, supplyKeeper, ask := CreateTestInputDefault(t, false, 1000)
+
+	params := k.GetParams(ctx)
+	params.FeeBurnRate = sdk.NewDecWithPrec(3, 1)
+	k.SetParams(ctx, params)
+
+	feeCollector := supplyKeeper.GetModuleAccount(ctx, k.feeCollectorName)
+	require.NotNil(t, feeCollector)
+
+	fees := chainType.NewCoins(chainType.NewCoin(constants.DefaultBondDenom, sdk.NewInt(100)))
+	_, err := ask.IssueCoinPower(ctx, feeCollector.GetID(), fees)
+	require.NoError(t, err)
+
+	burnedOld := supplyKeeper.GetBurnedSupply(ctx)
+
+	// no validators power, the fees left go to community pool
+	k.AllocateTokens(ctx, 0, 0, sdk.ConsAddress{}, nil)
+
+	burned := chainType.NewCoins(chainType.NewCoin(constants.DefaultBondDenom, sdk.NewInt(30)))
+	require.Equal(t, burnedOld.Add(burned...), supplyKeeper.GetBurnedSupply(ctx))
+	require.True(t, k.GetFeePool(ctx).CommunityPool.IsEqual(chainType.NewDecCoinsFromCoins(fees.Sub(burned)...)))
+	require.True(t, ask.GetCoinPowers(ctx, feeCollector.GetID()).IsZero())
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/distribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+)
+
+// GetFreeTxUsed returns the number of free txs the account has used
+func (k Keeper) GetFreeTxUsed(ctx sdk.Context, id AccountID) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFreeTxUsedKey(id))
+	if bz == nil {
+		return 0
+	}
+
+	used := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &used)
+	return used.Value
+}
+
+// SetFreeTxUsed sets the number of free txs the account has used
+func (k Keeper) SetFreeTxUsed(ctx sdk.Context, id AccountID, used uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&gogotypes.UInt64Value{Value: used})
+	store.Set(types.GetFreeTxUsedKey(id), bz)
+}
+
+// IterateFreeTxUsed iterate over the free txs used by accounts
+func (k Keeper) IterateFreeTxUsed(ctx sdk.Context, handler func(id AccountID, used uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FreeTxUsedPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		used := gogotypes.UInt64Value{}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &used)
+		id := types.GetFreeTxUsedAccount(iter.Key())
+		if handler(id, used.Value) {
+			break
+		}
+	}
+}
+
+// FreeTxFee returns the fee paid by the community pool for a free tx with gas
+func (k Keeper) FreeTxFee(ctx sdk.Context, gas uint64) Coins {
+	price := k.GetFreeTxGasPrice(ctx)
+	fee := price.MulInt64(int64(gas)).Ceil().RoundInt()
+	if !fee.IsPositive() {
+		return Coins{}
+	}
+
+	return chainTypes.NewCoins(chainTypes.NewCoin(constants.DefaultBondDenom, fee))
+}
+
+// IsFreeTxAccount returns true if the account is created by the create account msg
+// in the free tx account age blocks, the accounts in genesis are not new accounts.
+func (k Keeper) IsFreeTxAccount(ctx sdk.Context, id AccountID) bool {
+	created, ok := k.AccKeeper.GetAccountCreatedHeight(ctx, id)
+	if !ok {
+		return false
+	}
+
+	return ctx.BlockHeight()-created <= k.GetFreeTxAccountAge(ctx)
+}
+
+// UseFreeTxAllowance uses a free tx of the payer, the fee will be paid to fee collector by the
+// community pool, return false if the payer is not a new account, has no free tx allowance
+// or the pool cannot pay for it.
+func (k Keeper) UseFreeTxAllowance(ctx sdk.Context, payer AccountID, gas uint64) (bool, error) {
+	if gas > k.GetFreeTxMaxGas(ctx) {
+		return false, nil
+	}
+
+	if !k.IsFreeTxAccount(ctx, payer) {
+		return false, nil
+	}
+
+	used := k.GetFreeTxUsed(ctx, payer)
+	if used >= k.GetFreeTxAllowance(ctx) {
+		return false, nil
+	}
+
+	fee := k.FreeTxFee(ctx, gas)
+	if !fee.IsZero() {
+		feePool := k.GetFeePool(ctx)
+		newPool, negative := feePool.CommunityPool.SafeSub(chainTypes.NewDecCoinsFromCoins(fee...))
+		if negative {
+			return false, nil
+		}
+
+		if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fee); err != nil {
+			return false, err
+		}
+
+		feePool.CommunityPool = newPool
+		k.SetFeePool(ctx, feePool)
+	}
+
+	k.SetFreeTxUsed(ctx, payer, used+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFreeTx,
+			sdk.NewAttribute(types.AttributeKeyPayer, payer.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyFreeTxUsed, fmt.Sprintf("%d", used+1)),
+		),
+	)
+
+	return true, nil
+}
@@ -5,7 +5,6 @@ import (
 	"time"
 
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
-	"github.com/KuChainNetwork/kuchain/x/account"
 	"github.com/KuChainNetwork/kuchain/x/distribution/types"
 	params "github.com/KuChainNetwork/kuchain/x/params/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -24,7 +23,7 @@ type Keeper struct {
 	BankKeeper       types.BankKeeperAccountID
 	stakingKeeper    types.StakingKeeperAccountID
 	supplyKeeper     types.SupplyKeeperAccountID
-	AccKeeper        account.Keeper
+	AccKeeper        types.AccountKeeper
 	blacklistedAddrs map[string]bool
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
@@ -39,7 +38,7 @@ func NewKeeper(
 	bk types.BankKeeperAccountID,
 	sk types.StakingKeeperAccountID,
 	supplyKeeper types.SupplyKeeperAccountID,
-	accKeeper account.Keeper,
+	accKeeper types.AccountKeeper,
 	feeCollectorName string, blacklistedAddrs map[string]bool,
 ) Keeper {
 	// set KeyTable if it has not already been set
@@ -190,6 +189,19 @@ func (k Keeper) FundCommunityPool(ctx sdk.Context, amount Coins, sender chainTyp
 	return nil
 }
 
+// FundCommunityPoolFromModule sends the coin power of a module account to the community pool.
+func (k Keeper) FundCommunityPoolFromModule(ctx sdk.Context, senderModule string, amount Coins) error {
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, amount); err != nil {
+		return sdkerrors.Wrap(err, "FundCommunityPoolFromModule")
+	}
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(chainTypes.NewDecCoinsFromCoins(amount...)...)
+	k.SetFeePool(ctx, feePool)
+
+	return nil
+}
+
 func (k Keeper) SetStartNotDistributionTimePoint(ctx sdk.Context, t time.Time) {
 	k.startNotDistriTimePoint = t
 
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/testutil"
+	"github.com/KuChainNetwork/kuchain/x/distribution/keeper"
+	distrtestutil "github.com/KuChainNetwork/kuchain/x/distribution/testutil"
+	"github.com/KuChainNetwork/kuchain/x/distribution/types"
+	"github.com/KuChainNetwork/kuchain/x/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestKeeperWithMockKeepers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bankKeeper := distrtestutil.NewMockBankKeeperAccountID(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeperAccountID(ctrl)
+	supplyKeeper := distrtestutil.NewMockSupplyKeeperAccountID(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+	ctx := testutil.NewContext(abci.Header{Height: 10}, key, paramsKey, paramsTKey)
+
+	paramSpace := params.NewKeeper(types.ModuleCdc, paramsKey, paramsTKey).Subspace(types.DefaultParamspace)
+	k := keeper.NewKeeper(
+		types.ModuleCdc, key, paramSpace, bankKeeper, stakingKeeper, supplyKeeper, accountKeeper, "fee", nil,
+	)
+	k.SetFeePool(ctx, types.InitialFeePool())
+
+	sender := chainTypes.MustAccountID("sender")
+	amount := chainTypes.NewInt64CoreCoins(100)
+
+	// fund community pool by the coin power of sender
+	bankKeeper.EXPECT().CoinsToPower(gomock.Any(), sender, types.ModuleAccountID, amount).Return(nil).Times(1)
+	require.NoError(t, k.FundCommunityPool(ctx, amount, sender))
+	require.Equal(t, chainTypes.NewDecCoinsFromCoins(amount...), k.GetFeePool(ctx).CommunityPool)
+
+	// the pool not changed if the power failed
+	bankKeeper.EXPECT().CoinsToPower(gomock.Any(), sender, types.ModuleAccountID, amount).Return(errors.New("no enough coins")).Times(1)
+	require.Error(t, k.FundCommunityPool(ctx, amount, sender))
+	require.Equal(t, chainTypes.NewDecCoinsFromCoins(amount...), k.GetFeePool(ctx).CommunityPool)
+
+	// fund community pool from the module account
+	supplyKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee", types.ModuleName, amount).Return(nil).Times(1)
+	require.NoError(t, k.FundCommunityPoolFromModule(ctx, "fee", amount))
+	require.Equal(t, chainTypes.NewDecCoinsFromCoins(amount.Add(amount...)...), k.GetFeePool(ctx).CommunityPool)
+
+	// no rewards for the unknown validator
+	validator := chainTypes.MustAccountID("validator")
+	stakingKeeper.EXPECT().Validator(gomock.Any(), validator).Return(nil).Times(1)
+	_, err := k.WithdrawDelegationRewards(ctx, sender, validator)
+	require.Equal(t, types.ErrNoValidatorDistInfo, err)
+}
@@ -41,3 +41,63 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetReferralFeeRate returns the share of tx fees routed to the tx referrer.
+func (k Keeper) GetReferralFeeRate(ctx sdk.Context) (percent sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyReferralFeeRate, &percent)
+	return percent
+}
+
+// GetFeeBurnRate returns the share of collected fees to be burned.
+func (k Keeper) GetFeeBurnRate(ctx sdk.Context) (percent sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyFeeBurnRate, &percent)
+	return percent
+}
+
+// GetFreeTxAllowance returns the number of free txs for a new account.
+func (k Keeper) GetFreeTxAllowance(ctx sdk.Context) (allowance uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyFreeTxAllowance, &allowance)
+	return allowance
+}
+
+// GetFreeTxMaxGas returns the max gas a free tx can use.
+func (k Keeper) GetFreeTxMaxGas(ctx sdk.Context) (gas uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyFreeTxMaxGas, &gas)
+	return gas
+}
+
+// GetFreeTxGasPrice returns the gas price paid by community pool for free txs.
+func (k Keeper) GetFreeTxGasPrice(ctx sdk.Context) (price sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyFreeTxGasPrice, &price)
+	return price
+}
+
+// GetFreeTxAccountAge returns the number of blocks an account can use the free txs after created.
+func (k Keeper) GetFreeTxAccountAge(ctx sdk.Context) (age int64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyFreeTxAccountAge, &age)
+	return age
+}
+
+// GetRewardCurve returns the curve of the rewards to validators by the voting power.
+func (k Keeper) GetRewardCurve(ctx sdk.Context) (curve string) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyRewardCurve, &curve)
+	return curve
+}
+
+// GetTaperThreshold returns the voting power fraction above which the rewards are tapered.
+func (k Keeper) GetTaperThreshold(ctx sdk.Context) (threshold sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyTaperThreshold, &threshold)
+	return threshold
+}
+
+// GetTaperRate returns the share of the rewards kept for the voting power above the threshold.
+func (k Keeper) GetTaperRate(ctx sdk.Context) (percent sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyTaperRate, &percent)
+	return percent
+}
+
+// GetTaperRecipient returns the recipient of the rewards tapered from the validators.
+func (k Keeper) GetTaperRecipient(ctx sdk.Context) (recipient string) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyTaperRecipient, &recipient)
+	return recipient
+}
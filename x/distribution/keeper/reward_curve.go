@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/distribution/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// validatorReward is the reward to a validator by its voting power fraction
+type validatorReward struct {
+	validator     types.StakingExportedValidatorI
+	powerFraction sdk.Dec
+	reward        chainTypes.DecCoins
+}
+
+// applyRewardCurve applies the reward curve param to the rewards of validators,
+// in tapered curve the validators above the taper threshold only get the taper rate share
+// of the rewards for the voting power above the threshold, the excess is redirected to
+// the validators not above the threshold by their voting power if the taper recipient is small validators,
+// otherwise it is left out of the rewards, which will go to the community pool.
+func (k Keeper) applyRewardCurve(ctx sdk.Context, rewards []validatorReward) []validatorReward {
+	if k.GetRewardCurve(ctx) != types.RewardCurveTapered {
+		return rewards
+	}
+
+	threshold := k.GetTaperThreshold(ctx)
+	taperRate := k.GetTaperRate(ctx)
+
+	excess := chainTypes.NewDecCoins()
+	smallPower := sdk.ZeroDec()
+	for i, r := range rewards {
+		if r.powerFraction.LTE(threshold) {
+			smallPower = smallPower.Add(r.powerFraction)
+			continue
+		}
+
+		// the rewards tapered: reward * (powerFraction - threshold) * (1 - taperRate) / powerFraction
+		taperMultiplier := r.powerFraction.Sub(threshold).Mul(sdk.OneDec().Sub(taperRate))
+		tapered := r.reward.MulDecTruncate(taperMultiplier).QuoDecTruncate(r.powerFraction)
+		if tapered.IsZero() {
+			continue
+		}
+
+		rewards[i].reward = r.reward.Sub(tapered)
+		excess = excess.Add(tapered...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRewardTaper,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, tapered.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, r.validator.GetOperator().String()),
+			),
+		)
+	}
+
+	if excess.IsZero() || k.GetTaperRecipient(ctx) != types.TaperRecipientSmallValidators || !smallPower.IsPositive() {
+		return rewards
+	}
+
+	for i, r := range rewards {
+		if r.powerFraction.GT(threshold) {
+			continue
+		}
+
+		rewards[i].reward = r.reward.Add(excess.MulDecTruncate(r.powerFraction).QuoDecTruncate(smallPower)...)
+	}
+
+	return rewards
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	chainType "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/distribution/types"
+	sktypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestApplyRewardCurve(t *testing.T) {
+	ctx, _, k, _, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	newRewards := func() []validatorReward {
+		rewards := make([]validatorReward, 0, 3)
+		for i, acc := range []chainType.AccountID{Acc1, Acc2, Acc3} {
+			powerFraction := []sdk.Dec{sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1)}[i]
+			rewards = append(rewards, validatorReward{
+				validator:     sktypes.NewValidator(acc, nil, GetDescription()),
+				powerFraction: powerFraction,
+				reward:        chainType.NewDecCoins(chainType.NewDecCoinFromDec(constants.DefaultBondDenom, powerFraction.MulInt64(1000))),
+			})
+		}
+		return rewards
+	}
+	amountOf := func(r validatorReward) sdk.Dec {
+		return r.reward.AmountOf(constants.DefaultBondDenom)
+	}
+
+	// the linear curve not changes the rewards
+	rewards := k.applyRewardCurve(ctx, newRewards())
+	require.Equal(t, sdk.NewDec(600), amountOf(rewards[0]))
+
+	params := k.GetParams(ctx)
+	params.RewardCurve = types.RewardCurveTapered
+	params.TaperThreshold = sdk.NewDecWithPrec(4, 1)
+	params.TaperRate = sdk.NewDecWithPrec(5, 1)
+	k.SetParams(ctx, params)
+
+	// the validator above 40% only gets half of the rewards for the power above it
+	rewards = k.applyRewardCurve(ctx, newRewards())
+	require.Equal(t, sdk.NewDec(500), amountOf(rewards[0]))
+	require.Equal(t, sdk.NewDec(300), amountOf(rewards[1]))
+	require.Equal(t, sdk.NewDec(100), amountOf(rewards[2]))
+
+	// the excess redirected to the small validators by their voting power
+	params.TaperRecipient = types.TaperRecipientSmallValidators
+	k.SetParams(ctx, params)
+
+	rewards = k.applyRewardCurve(ctx, newRewards())
+	require.Equal(t, sdk.NewDec(500), amountOf(rewards[0]))
+	require.Equal(t, sdk.NewDec(375), amountOf(rewards[1]))
+	require.Equal(t, sdk.NewDec(125), amountOf(rewards[2]))
+}
@@ -154,7 +154,7 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 		staking.ModuleName:        nil,
 	}
 
-	assetKeeper := asset.NewAssetKeeper(cdc, sdk.NewKVStoreKey(asset.StoreKey), AccountKeeper)
+	assetKeeper := asset.NewAssetKeeper(cdc, sdk.NewKVStoreKey(asset.StoreKey), pk.Subspace(asset.DefaultParamspace), AccountKeeper)
 	supplyKeeper := supply.NewKeeper(cdc, sdk.NewKVStoreKey(supply.StoreKey), AccountKeeper, assetKeeper, mAccPerms)
 
 	distrAcc := supply.NewEmptyModuleAccount(types.ModuleName)
@@ -18,6 +18,9 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+	ReferralFeeRate     = "referral_fee_rate"
+	FeeBurnRate         = "fee_burn_rate"
+	FreeTxAllowance     = "free_tx_allowance"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -35,6 +38,21 @@ func GenBonusProposerReward(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2))
 }
 
+// GenReferralFeeRate randomized ReferralFeeRate
+func GenReferralFeeRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(50)), 2)
+}
+
+// GenFeeBurnRate randomized FeeBurnRate
+func GenFeeBurnRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(50)), 2)
+}
+
+// GenFreeTxAllowance randomized FreeTxAllowance
+func GenFreeTxAllowance(r *rand.Rand) uint64 {
+	return uint64(r.Intn(5))
+}
+
 // GenWithdrawEnabled returns a randomized WithdrawEnabled parameter.
 func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
@@ -66,6 +84,26 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var referralFeeRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ReferralFeeRate, &referralFeeRate, simState.Rand,
+		func(r *rand.Rand) { referralFeeRate = GenReferralFeeRate(r) },
+	)
+
+	var feeBurnRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeBurnRate, &feeBurnRate, simState.Rand,
+		func(r *rand.Rand) { feeBurnRate = GenFeeBurnRate(r) },
+	)
+
+	var freeTxAllowance uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FreeTxAllowance, &freeTxAllowance, simState.Rand,
+		func(r *rand.Rand) { freeTxAllowance = GenFreeTxAllowance(r) },
+	)
+
+	defaultParams := types.DefaultParams()
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
@@ -73,6 +111,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+			ReferralFeeRate:     referralFeeRate,
+			FeeBurnRate:         feeBurnRate,
+			FreeTxAllowance:     freeTxAllowance,
+			FreeTxMaxGas:        defaultParams.FreeTxMaxGas,
+			FreeTxGasPrice:      defaultParams.FreeTxGasPrice,
+			FreeTxAccountAge:    defaultParams.FreeTxAccountAge,
+			RewardCurve:         defaultParams.RewardCurve,
+			TaperThreshold:      defaultParams.TaperThreshold,
+			TaperRate:           defaultParams.TaperRate,
+			TaperRecipient:      defaultParams.TaperRecipient,
 		},
 	}
 
@@ -12,6 +12,8 @@ const (
 	keyCommunityTax        = "communitytax"
 	keyBaseProposerReward  = "baseproposerreward"
 	keyBonusProposerReward = "bonusproposerreward"
+	keyReferralFeeRate     = "referralfeerate"
+	keyFeeBurnRate         = "feeburnrate"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -33,5 +35,15 @@ func ParamChanges(r *rand.Rand) []sim.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenBonusProposerReward(r))
 			},
 		),
+		sim.NewSimParamChange(types.ModuleName, keyReferralFeeRate,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenReferralFeeRate(r))
+			},
+		),
+		sim.NewSimParamChange(types.ModuleName, keyFeeBurnRate,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenFeeBurnRate(r))
+			},
+		),
 	}
 }
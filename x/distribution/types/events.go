@@ -8,9 +8,14 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeFreeTx             = "free_tx"
+	EventTypeBurnFee            = "burn_fee"
+	EventTypeRewardTaper        = "reward_taper"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyPayer           = "payer"
+	AttributeKeyFreeTxUsed      = "used"
 
 	AttributeValueCategory = ModuleName
 )
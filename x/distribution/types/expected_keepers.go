@@ -1,5 +1,7 @@
 package types
 
+//go:generate mockgen -package testutil -destination ../testutil/expected_keepers_mocks.go . AccountKeeper,BankKeeperAccountID,StakingKeeperAccountID,SupplyKeeperAccountID,DistributionKeeper
+
 import (
 	"time"
 
@@ -15,6 +17,12 @@ type AccountKeeperAccountID interface {
 	GetAccount(ctx sdk.Context, Id AccountID) accExported.Account
 }
 
+// AccountKeeper defines the expected account keeper used by the distribution keeper
+type AccountKeeper interface {
+	IsAccountExist(ctx sdk.Context, id AccountID) bool
+	GetAccountCreatedHeight(ctx sdk.Context, id AccountID) (int64, bool)
+}
+
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeperAccountID interface {
 	types.AssetTransfer
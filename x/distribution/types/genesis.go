@@ -54,6 +54,12 @@ type ValidatorSlashEventRecord struct {
 	Event            ValidatorSlashEvent `json:"validator_slash_event" yaml:"validator_slash_event"`
 }
 
+// used for import / export via genesis json
+type FreeTxUsageRecord struct {
+	Account AccountID `json:"account" yaml:"account"`
+	Used    uint64    `json:"used" yaml:"used"`
+}
+
 // GenesisState - all distribution state that must be provided at genesis
 type GenesisState struct {
 	Params                          Params                                 `json:"params" yaml:"params"`
@@ -66,6 +72,7 @@ type GenesisState struct {
 	ValidatorCurrentRewards         []ValidatorCurrentRewardsRecord        `json:"validator_current_rewards" yaml:"validator_current_rewards"`
 	DelegatorStartingInfos          []DelegatorStartingInfoRecord          `json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	ValidatorSlashEvents            []ValidatorSlashEventRecord            `json:"validator_slash_events" yaml:"validator_slash_events"`
+	FreeTxUsages                    []FreeTxUsageRecord                    `json:"free_tx_usages,omitempty" yaml:"free_tx_usages"`
 }
 
 func NewGenesisState(
@@ -45,6 +45,8 @@ var (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accountID_Bytes>: FreeTxUsed
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -56,6 +58,7 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	FreeTxUsedPrefix                     = []byte{0x09} // key for the number of free txs used by account
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -173,3 +176,13 @@ func GetValidatorSlashEventKey(v AccountID, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the key for the number of free txs used by account
+func GetFreeTxUsedKey(id AccountID) []byte {
+	return append(FreeTxUsedPrefix, id.StoreKey()...)
+}
+
+// gets the account from a free tx used key
+func GetFreeTxUsedAccount(key []byte) AccountID {
+	return NewAccountIDFromStoreKey(key)
+}
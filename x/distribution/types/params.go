@@ -13,12 +13,41 @@ const (
 	DefaultParamspace = ModuleName
 )
 
+var (
+	// MaxReferralFeeRate is the upper bound of the tx fee share routed to a referrer
+	MaxReferralFeeRate = sdk.NewDecWithPrec(5, 1) // 50%
+)
+
+// The reward curves of the validators
+const (
+	// RewardCurveLinear allocates the rewards proportionally to the voting power
+	RewardCurveLinear = "linear"
+	// RewardCurveTapered tapers the rewards for the voting power above the threshold
+	RewardCurveTapered = "tapered"
+)
+
+// The recipients of the rewards tapered from the validators above the threshold
+const (
+	TaperRecipientCommunityPool   = "community_pool"
+	TaperRecipientSmallValidators = "small_validators"
+)
+
 // Parameter keys
 var (
 	ParamStoreKeyCommunityTax        = []byte("communitytax")
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyReferralFeeRate     = []byte("referralfeerate")
+	ParamStoreKeyFeeBurnRate         = []byte("feeburnrate")
+	ParamStoreKeyFreeTxAllowance     = []byte("freetxallowance")
+	ParamStoreKeyFreeTxMaxGas        = []byte("freetxmaxgas")
+	ParamStoreKeyFreeTxGasPrice      = []byte("freetxgasprice")
+	ParamStoreKeyFreeTxAccountAge    = []byte("freetxaccountage")
+	ParamStoreKeyRewardCurve         = []byte("rewardcurve")
+	ParamStoreKeyTaperThreshold      = []byte("taperthreshold")
+	ParamStoreKeyTaperRate           = []byte("taperrate")
+	ParamStoreKeyTaperRecipient      = []byte("taperrecipient")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -32,6 +61,27 @@ type Params struct {
 	BaseProposerReward  Dec  `json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward Dec  `json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool `json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	ReferralFeeRate     Dec  `json:"referral_fee_rate" yaml:"referral_fee_rate"`
+
+	// FeeBurnRate is the share of collected fees burned before the allocation
+	// to validators and community pool
+	FeeBurnRate Dec `json:"fee_burn_rate" yaml:"fee_burn_rate"`
+
+	// FreeTxAllowance is the number of zero fee txs a new account can send,
+	// the fee of these txs is paid by the community pool, an account is new
+	// in FreeTxAccountAge blocks after it created by the create account msg
+	FreeTxAllowance  uint64 `json:"free_tx_allowance" yaml:"free_tx_allowance"`
+	FreeTxMaxGas     uint64 `json:"free_tx_max_gas" yaml:"free_tx_max_gas"`
+	FreeTxGasPrice   Dec    `json:"free_tx_gas_price" yaml:"free_tx_gas_price"`
+	FreeTxAccountAge int64  `json:"free_tx_account_age" yaml:"free_tx_account_age"`
+
+	// RewardCurve is the curve of the rewards to validators by the voting power,
+	// in tapered curve the validator only gets the TaperRate share of the rewards for
+	// the voting power fraction above the TaperThreshold, the excess goes to the TaperRecipient
+	RewardCurve    string `json:"reward_curve" yaml:"reward_curve"`
+	TaperThreshold Dec    `json:"taper_threshold" yaml:"taper_threshold"`
+	TaperRate      Dec    `json:"taper_rate" yaml:"taper_rate"`
+	TaperRecipient string `json:"taper_recipient" yaml:"taper_recipient"`
 }
 
 // DefaultParams returns default distribution parameters
@@ -41,6 +91,16 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+		ReferralFeeRate:     sdk.NewDecWithPrec(1, 1), // 10%
+		FeeBurnRate:         sdk.ZeroDec(),
+		FreeTxAllowance:     3,
+		FreeTxMaxGas:        200000,
+		FreeTxGasPrice:      sdk.NewDecWithPrec(1, 2),
+		FreeTxAccountAge:    100000,
+		RewardCurve:         RewardCurveLinear,
+		TaperThreshold:      sdk.NewDecWithPrec(1, 1), // 10%
+		TaperRate:           sdk.NewDecWithPrec(5, 1), // 50%
+		TaperRecipient:      TaperRecipientCommunityPool,
 	}
 }
 
@@ -56,6 +116,16 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		params.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		params.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		params.NewParamSetPair(ParamStoreKeyReferralFeeRate, &p.ReferralFeeRate, validateReferralFeeRate),
+		params.NewParamSetPair(ParamStoreKeyFeeBurnRate, &p.FeeBurnRate, validateFeeBurnRate),
+		params.NewParamSetPair(ParamStoreKeyFreeTxAllowance, &p.FreeTxAllowance, validateFreeTxAllowance),
+		params.NewParamSetPair(ParamStoreKeyFreeTxMaxGas, &p.FreeTxMaxGas, validateFreeTxMaxGas),
+		params.NewParamSetPair(ParamStoreKeyFreeTxGasPrice, &p.FreeTxGasPrice, validateFreeTxGasPrice),
+		params.NewParamSetPair(ParamStoreKeyFreeTxAccountAge, &p.FreeTxAccountAge, validateFreeTxAccountAge),
+		params.NewParamSetPair(ParamStoreKeyRewardCurve, &p.RewardCurve, validateRewardCurve),
+		params.NewParamSetPair(ParamStoreKeyTaperThreshold, &p.TaperThreshold, validateTaperThreshold),
+		params.NewParamSetPair(ParamStoreKeyTaperRate, &p.TaperRate, validateTaperRate),
+		params.NewParamSetPair(ParamStoreKeyTaperRecipient, &p.TaperRecipient, validateTaperRecipient),
 	}
 }
 
@@ -81,6 +151,36 @@ func (p Params) ValidateBasic() error {
 			"sum of base and bonus proposer reward cannot greater than one: %s", v,
 		)
 	}
+	if p.ReferralFeeRate.IsNegative() || p.ReferralFeeRate.GT(MaxReferralFeeRate) {
+		return fmt.Errorf(
+			"referral fee rate should non-negative and not greater than %s: %s", MaxReferralFeeRate, p.ReferralFeeRate,
+		)
+	}
+	if p.FeeBurnRate.IsNil() || p.FeeBurnRate.IsNegative() || p.FeeBurnRate.GT(sdk.OneDec()) {
+		return fmt.Errorf(
+			"fee burn rate should non-negative and less than one: %s", p.FeeBurnRate,
+		)
+	}
+	if p.FreeTxGasPrice.IsNil() || p.FreeTxGasPrice.IsNegative() {
+		return fmt.Errorf(
+			"free tx gas price should non-negative: %s", p.FreeTxGasPrice,
+		)
+	}
+	if err := validateFreeTxAccountAge(p.FreeTxAccountAge); err != nil {
+		return err
+	}
+	if err := validateRewardCurve(p.RewardCurve); err != nil {
+		return err
+	}
+	if err := validateTaperThreshold(p.TaperThreshold); err != nil {
+		return err
+	}
+	if err := validateTaperRate(p.TaperRate); err != nil {
+		return err
+	}
+	if err := validateTaperRecipient(p.TaperRecipient); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -142,6 +242,91 @@ func validateBonusProposerReward(i interface{}) error {
 	return nil
 }
 
+func validateReferralFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("referral fee rate must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("referral fee rate must be positive: %s", v)
+	}
+	if v.GT(MaxReferralFeeRate) {
+		return fmt.Errorf("referral fee rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateFeeBurnRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("fee burn rate must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("fee burn rate must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee burn rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateFreeTxAllowance(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFreeTxMaxGas(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFreeTxAccountAge(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("free tx account age must be non-negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateFreeTxGasPrice(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("free tx gas price must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("free tx gas price must be positive: %s", v)
+	}
+
+	return nil
+}
+
 func validateWithdrawAddrEnabled(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -150,3 +335,67 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateRewardCurve(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != RewardCurveLinear && v != RewardCurveTapered {
+		return fmt.Errorf("reward curve must be %s or %s: %s", RewardCurveLinear, RewardCurveTapered, v)
+	}
+
+	return nil
+}
+
+func validateTaperThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("taper threshold must be not nil")
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("taper threshold must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("taper threshold too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateTaperRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("taper rate must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("taper rate must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("taper rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateTaperRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != TaperRecipientCommunityPool && v != TaperRecipientSmallValidators {
+		return fmt.Errorf("taper recipient must be %s or %s: %s", TaperRecipientCommunityPool, TaperRecipientSmallValidators, v)
+	}
+
+	return nil
+}
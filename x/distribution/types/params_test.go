@@ -35,3 +35,75 @@ func Test_validateAuxFuncs(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateReferralFeeRate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		i       interface{}
+		wantErr bool
+	}{
+		{"wrong type", 10.5, true},
+		{"nil Int pointer", sdk.Dec{}, true},
+		{"negative", sdk.NewDec(-1), true},
+		{"zero", sdk.ZeroDec(), false},
+		{"max rate", MaxReferralFeeRate, false},
+		{"one dec", sdk.NewDec(1), true},
+	}
+
+	for _, tc := range testCases {
+		stc := tc
+
+		t.Run(stc.name, func(t *testing.T) {
+			require.Equal(t, stc.wantErr, validateReferralFeeRate(stc.i) != nil)
+		})
+	}
+}
+
+func Test_validateFeeBurnRate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		i       interface{}
+		wantErr bool
+	}{
+		{"wrong type", 10.5, true},
+		{"nil Int pointer", sdk.Dec{}, true},
+		{"negative", sdk.NewDec(-1), true},
+		{"zero", sdk.ZeroDec(), false},
+		{"one dec", sdk.NewDec(1), false},
+		{"two dec", sdk.NewDec(2), true},
+	}
+
+	for _, tc := range testCases {
+		stc := tc
+
+		t.Run(stc.name, func(t *testing.T) {
+			require.Equal(t, stc.wantErr, validateFeeBurnRate(stc.i) != nil)
+		})
+	}
+}
+
+func Test_validateRewardCurve(t *testing.T) {
+	testCases := []struct {
+		name    string
+		curve   interface{}
+		taper   interface{}
+		wantErr bool
+	}{
+		{"wrong type", 10.5, 10.5, true},
+		{"unknown", "quadratic", "validators", true},
+		{"linear", RewardCurveLinear, TaperRecipientCommunityPool, false},
+		{"tapered", RewardCurveTapered, TaperRecipientSmallValidators, false},
+	}
+
+	for _, tc := range testCases {
+		stc := tc
+
+		t.Run(stc.name, func(t *testing.T) {
+			require.Equal(t, stc.wantErr, validateRewardCurve(stc.curve) != nil)
+			require.Equal(t, stc.wantErr, validateTaperRecipient(stc.taper) != nil)
+		})
+	}
+
+	require.Error(t, validateTaperThreshold(sdk.ZeroDec()))
+	require.NoError(t, validateTaperRate(sdk.ZeroDec()))
+}
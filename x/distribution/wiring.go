@@ -0,0 +1,25 @@
+package distribution
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/distribution/types"
+)
+
+// Inputs the keepers and module accounts the distribution module depends on
+type Inputs struct {
+	BankKeeper       types.BankKeeperAccountID
+	StakingKeeper    types.StakingKeeperAccountID
+	SupplyKeeper     types.SupplyKeeperAccountID
+	AccountKeeper    types.AccountKeeper
+	FeeCollectorName string
+	BlacklistedAddrs map[string]bool
+}
+
+// ProvideKeeper creates the distribution keeper by the store key and params subspace declared to the builder
+func ProvideKeeper(b *wiring.Builder, in Inputs) Keeper {
+	return NewKeeper(
+		b.Codec(), b.KVStoreKey(StoreKey), b.Subspace(DefaultParamspace),
+		in.BankKeeper, in.StakingKeeper, in.SupplyKeeper, in.AccountKeeper,
+		in.FeeCollectorName, in.BlacklistedAddrs,
+	)
+}
@@ -0,0 +1,18 @@
+package evidence
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/evidence/types"
+)
+
+// Inputs the keepers the evidence module depends on
+type Inputs struct {
+	StakingKeeper  types.StakingKeeper
+	SlashingKeeper types.SlashingKeeper
+}
+
+// ProvideKeeper creates the evidence keeper by the store key and params subspace declared to the builder,
+// the evidence router should be set by the app after all the routes added.
+func ProvideKeeper(b *wiring.Builder, in Inputs) *Keeper {
+	return NewKeeper(b.KVStoreKey(StoreKey), b.Subspace(DefaultParamspace), in.StakingKeeper, in.SlashingKeeper)
+}
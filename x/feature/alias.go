@@ -0,0 +1,43 @@
+package feature
+
+// nolint
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/feature/keeper"
+	"github.com/KuChainNetwork/kuchain/x/feature/types"
+)
+
+const (
+	ModuleName        = types.ModuleName
+	QuerierRoute      = types.QuerierRoute
+	DefaultParamspace = types.DefaultParamspace
+	QueryParameters   = types.QueryParameters
+)
+
+var (
+	// functions aliases
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	NewRouter           = keeper.NewRouter
+	NewGenesisState     = types.NewGenesisState
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+	ParamKeyTable       = types.ParamKeyTable
+	NewParams           = types.NewParams
+	DefaultParams       = types.DefaultParams
+
+	// variable aliases
+	ModuleCdc                     = types.ModuleCdc
+	Cdc                           = types.Cdc
+	DefaultMaintenanceAllowedMsgs = types.DefaultMaintenanceAllowedMsgs
+	GovMaintenanceMsgs            = types.GovMaintenanceMsgs
+	ErrRouteDisabled              = types.ErrRouteDisabled
+	ErrMsgNotAllowedInMaintenance = types.ErrMsgNotAllowedInMaintenance
+)
+
+type (
+	Keeper       = keeper.Keeper
+	Router       = keeper.Router
+	GenesisState = types.GenesisState
+	Params       = types.Params
+)
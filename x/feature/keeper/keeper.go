@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/feature/types"
+	"github.com/KuChainNetwork/kuchain/x/params"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Keeper of the feature flags, the flags are params so that governance
+// can switch them by param change proposals.
+type Keeper struct {
+	cdc        *codec.Codec
+	paramSpace params.Subspace
+
+	// localMaintenance is the maintenance mode configured by the node, only for CheckTx
+	localMaintenance bool
+}
+
+// NewKeeper creates a new feature Keeper instance
+func NewKeeper(cdc *codec.Codec, paramSpace params.Subspace) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of feature parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feature parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// IsRouteEnabled returns true if the msgs to the route can be handled
+func (k Keeper) IsRouteEnabled(ctx sdk.Context, route string) bool {
+	return !k.GetParams(ctx).IsRouteDisabled(route)
+}
+
+// WithLocalMaintenance returns a copy of the keeper with the maintenance mode configured by the node,
+// which only rejects the msgs in CheckTx, so the txs not allowed will not get into the mempool of the node.
+func (k Keeper) WithLocalMaintenance(enabled bool) Keeper {
+	k.localMaintenance = enabled
+	return k
+}
+
+// IsMaintenanceMode returns true if the chain is in maintenance mode by governance,
+// or the node is in maintenance mode for CheckTx.
+func (k Keeper) IsMaintenanceMode(ctx sdk.Context) bool {
+	return k.GetParams(ctx).MaintenanceMode || (k.localMaintenance && ctx.IsCheckTx())
+}
+
+// ValidateMsgsAllowed returns an error if any of the msgs is not allowed in maintenance mode
+func (k Keeper) ValidateMsgsAllowed(ctx sdk.Context, msgs []sdk.Msg) error {
+	if !k.IsMaintenanceMode(ctx) {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	for _, msg := range msgs {
+		if !params.IsMsgAllowedInMaintenance(msg.Route(), msg.Type()) {
+			return sdkerrors.Wrapf(types.ErrMsgNotAllowedInMaintenance, "msg %s/%s", msg.Route(), msg.Type())
+		}
+	}
+
+	return nil
+}
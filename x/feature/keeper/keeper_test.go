@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/feature/keeper"
+	featureTypes "github.com/KuChainNetwork/kuchain/x/feature/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFeatureRouter(t *testing.T) {
+	app := simapp.SetupWithGenesisAccounts(simapp.NewGenesisAccounts(simapp.NewWallet().GetRootAuth()))
+	k := app.FeatureKeeper()
+
+	handler := func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		return &sdk.Result{}, nil
+	}
+
+	router := keeper.NewRouter(baseapp.NewRouter(), *k, "kugov")
+	router.AddRoute("kuasset", handler).
+		AddRoute("kugov", handler)
+
+	Convey("test feature router by params", t, func() {
+		ctx, _ := app.NewTestContext().CacheContext()
+
+		So(k.IsRouteEnabled(ctx, "kuasset"), ShouldBeTrue)
+		_, err := router.Route(ctx, "kuasset")(ctx, nil)
+		So(err, ShouldBeNil)
+		So(router.Route(ctx, "unknown"), ShouldBeNil)
+
+		k.SetParams(ctx, featureTypes.NewParams("kuasset", "kugov"))
+
+		Convey("disabled route is rejected", func() {
+			So(k.IsRouteEnabled(ctx, "kuasset"), ShouldBeFalse)
+			_, err := router.Route(ctx, "kuasset")(ctx, nil)
+			So(err, simapp.ShouldErrIs, featureTypes.ErrRouteDisabled)
+		})
+
+		Convey("protected route cannot be disabled", func() {
+			_, err := router.Route(ctx, "kugov")(ctx, nil)
+			So(err, ShouldBeNil)
+		})
+
+		Convey("route enabled again", func() {
+			k.SetParams(ctx, featureTypes.DefaultParams())
+			_, err := router.Route(ctx, "kuasset")(ctx, nil)
+			So(err, ShouldBeNil)
+		})
+	})
+}
+
+func TestValidateParams(t *testing.T) {
+	Convey("test validate feature params", t, func() {
+		So(featureTypes.DefaultParams().Validate(), ShouldBeNil)
+		So(featureTypes.NewParams("kuasset", "kustaking").Validate(), ShouldBeNil)
+		So(featureTypes.NewParams("kuasset", "kuasset").Validate(), ShouldNotBeNil)
+		So(featureTypes.NewParams("").Validate(), ShouldNotBeNil)
+
+		params := featureTypes.DefaultParams().WithMaintenanceMode(true)
+		So(params.Validate(), ShouldBeNil)
+		params.MaintenanceAllowedMsgs = []string{"kugov/vote", "kugov/vote"}
+		So(params.Validate(), ShouldNotBeNil)
+		params.MaintenanceAllowedMsgs = []string{"kugov/"}
+		So(params.Validate(), ShouldNotBeNil)
+	})
+}
+
+type testMsg struct {
+	sdk.Msg
+
+	route, msgType string
+}
+
+func (m testMsg) Route() string { return m.route }
+func (m testMsg) Type() string  { return m.msgType }
+
+func TestMaintenanceMode(t *testing.T) {
+	app := simapp.SetupWithGenesisAccounts(simapp.NewGenesisAccounts(simapp.NewWallet().GetRootAuth()))
+	k := app.FeatureKeeper()
+
+	vote := testMsg{route: "kugov", msgType: "vote"}
+	voteWeighted := testMsg{route: "kugov", msgType: "voteweighted"}
+	transfer := testMsg{route: "account", msgType: "transfer"}
+
+	Convey("test maintenance mode by params", t, func() {
+		ctx, _ := app.NewTestContext().CacheContext()
+
+		So(k.IsMaintenanceMode(ctx), ShouldBeFalse)
+		So(k.ValidateMsgsAllowed(ctx, []sdk.Msg{vote, transfer}), ShouldBeNil)
+
+		k.SetParams(ctx, featureTypes.DefaultParams().WithMaintenanceMode(true))
+		So(k.IsMaintenanceMode(ctx), ShouldBeTrue)
+		So(k.ValidateMsgsAllowed(ctx, []sdk.Msg{vote, voteWeighted}), ShouldBeNil)
+
+		err := k.ValidateMsgsAllowed(ctx, []sdk.Msg{vote, transfer})
+		So(err, simapp.ShouldErrIs, featureTypes.ErrMsgNotAllowedInMaintenance)
+
+		// all msgs of the route are allowed
+		params := k.GetParams(ctx)
+		params.MaintenanceAllowedMsgs = append(params.MaintenanceAllowedMsgs, "account")
+		k.SetParams(ctx, params)
+		So(k.ValidateMsgsAllowed(ctx, []sdk.Msg{vote, transfer}), ShouldBeNil)
+	})
+
+	Convey("test gov msgs always allowed in maintenance mode", t, func() {
+		ctx, _ := app.NewTestContext().CacheContext()
+
+		params := featureTypes.DefaultParams().WithMaintenanceMode(true)
+		params.MaintenanceAllowedMsgs = []string{}
+		k.SetParams(ctx, params)
+
+		submit := testMsg{route: "kugov", msgType: "submitproposal"}
+		deposit := testMsg{route: "kugov", msgType: "deposit"}
+		So(k.ValidateMsgsAllowed(ctx, []sdk.Msg{submit, deposit, vote, voteWeighted}), ShouldBeNil)
+
+		err := k.ValidateMsgsAllowed(ctx, []sdk.Msg{transfer})
+		So(err, simapp.ShouldErrIs, featureTypes.ErrMsgNotAllowedInMaintenance)
+	})
+
+	Convey("test maintenance mode of the node", t, func() {
+		ctx, _ := app.NewTestContext().CacheContext()
+		local := k.WithLocalMaintenance(true)
+
+		So(local.IsMaintenanceMode(ctx.WithIsCheckTx(true)), ShouldBeTrue)
+		err := local.ValidateMsgsAllowed(ctx.WithIsCheckTx(true), []sdk.Msg{transfer})
+		So(err, simapp.ShouldErrIs, featureTypes.ErrMsgNotAllowedInMaintenance)
+
+		// the node config not changes the state machine
+		So(local.IsMaintenanceMode(ctx.WithIsCheckTx(false)), ShouldBeFalse)
+		So(local.ValidateMsgsAllowed(ctx.WithIsCheckTx(false), []sdk.Msg{transfer}), ShouldBeNil)
+	})
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/feature/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Router wraps the msg router of app, the msgs to the routes disabled by
+// feature params will be rejected, the protected routes cannot be disabled.
+type Router struct {
+	sdk.Router
+
+	keeper    Keeper
+	protected map[string]bool
+}
+
+var _ sdk.Router = Router{}
+
+// NewRouter creates a router checked by feature flags
+func NewRouter(router sdk.Router, keeper Keeper, protected ...string) Router {
+	r := Router{
+		Router:    router,
+		keeper:    keeper,
+		protected: make(map[string]bool, len(protected)),
+	}
+
+	for _, p := range protected {
+		r.protected[p] = true
+	}
+
+	return r
+}
+
+// AddRoute adds a route path to the wrapped router
+func (r Router) AddRoute(path string, h sdk.Handler) sdk.Router {
+	r.Router.AddRoute(path, h)
+	return r
+}
+
+// Route returns a handler for a given route path, if the route is disabled
+// the handler will always return ErrRouteDisabled.
+func (r Router) Route(ctx sdk.Context, path string) sdk.Handler {
+	h := r.Router.Route(ctx, path)
+	if h == nil || r.protected[path] || r.keeper.IsRouteEnabled(ctx, path) {
+		return h
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		return nil, sdkerrors.Wrapf(types.ErrRouteDisabled, "route %s", path)
+	}
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feature module sentinel errors
+var (
+	ErrRouteDisabled              = sdkerrors.Register(ModuleName, 2, "msg route is disabled")
+	ErrMsgNotAllowedInMaintenance = sdkerrors.Register(ModuleName, 3, "msg is not allowed in maintenance mode")
+)
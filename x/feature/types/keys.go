@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName is the name of the feature module
+	ModuleName = "feature"
+
+	// QuerierRoute is the querier route for the feature module
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the feature querier
+	QueryParameters = "parameters"
+)
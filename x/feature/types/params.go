@@ -0,0 +1,184 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	params "github.com/KuChainNetwork/kuchain/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace = ModuleName
+)
+
+// Parameter store keys
+var (
+	KeyDisabledRoutes         = []byte("DisabledRoutes")
+	KeyMaintenanceMode        = []byte("MaintenanceMode")
+	KeyMaintenanceAllowedMsgs = []byte("MaintenanceAllowedMsgs")
+)
+
+// GovMaintenanceMsgs the gov msgs always accepted in maintenance mode, so that the maintenance mode
+// can be turned off by the param change proposal whatever the allowed msgs in params are.
+var GovMaintenanceMsgs = []string{
+	"kugov/submitproposal",
+	"kugov/deposit",
+	"kugov/vote",
+	"kugov/voteweighted",
+}
+
+// DefaultMaintenanceAllowedMsgs the msgs accepted in maintenance mode by default, gov votes and weighted votes,
+// unjail and evidence submissions, as "route/type", the modules are not imported here.
+var DefaultMaintenanceAllowedMsgs = []string{
+	"kugov/vote",
+	"kugov/voteweighted",
+	"kugov/govunjail",
+	"kuslashing/unjail",
+	"kuevidence/submit_evidence",
+}
+
+// Params feature parameters, the msgs to the disabled routes will be rejected,
+// it can be changed by the param change proposal of governance.
+// In maintenance mode, only the msgs in MaintenanceAllowedMsgs are accepted, the item
+// is a "route/type" for a msg type or a "route" for all msgs of the route.
+type Params struct {
+	DisabledRoutes         []string `json:"disabled_routes" yaml:"disabled_routes"`
+	MaintenanceMode        bool     `json:"maintenance_mode" yaml:"maintenance_mode"`
+	MaintenanceAllowedMsgs []string `json:"maintenance_allowed_msgs" yaml:"maintenance_allowed_msgs"`
+}
+
+// ParamKeyTable ParamTable for feature module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(disabledRoutes ...string) Params {
+	if disabledRoutes == nil {
+		disabledRoutes = []string{}
+	}
+
+	return Params{
+		DisabledRoutes:         disabledRoutes,
+		MaintenanceMode:        false,
+		MaintenanceAllowedMsgs: append([]string{}, DefaultMaintenanceAllowedMsgs...),
+	}
+}
+
+// WithMaintenanceMode returns a copy of the params with the maintenance mode switched
+func (p Params) WithMaintenanceMode(enabled bool) Params {
+	p.MaintenanceMode = enabled
+	return p
+}
+
+// DefaultParams default feature module parameters, all routes are enabled
+func DefaultParams() Params {
+	return NewParams()
+}
+
+// IsRouteDisabled returns true if the msg route is disabled
+func (p Params) IsRouteDisabled(route string) bool {
+	for _, r := range p.DisabledRoutes {
+		if r == route {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsMsgAllowedInMaintenance returns true if the msg of the route and type is allowed in maintenance mode,
+// the gov msgs in GovMaintenanceMsgs are always allowed.
+func (p Params) IsMsgAllowedInMaintenance(route, msgType string) bool {
+	for _, m := range GovMaintenanceMsgs {
+		if m == route+"/"+msgType {
+			return true
+		}
+	}
+
+	for _, m := range p.MaintenanceAllowedMsgs {
+		if m == route || m == route+"/"+msgType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate validate params
+func (p Params) Validate() error {
+	if err := validateDisabledRoutes(p.DisabledRoutes); err != nil {
+		return err
+	}
+
+	if err := validateMaintenanceMode(p.MaintenanceMode); err != nil {
+		return err
+	}
+
+	return validateMaintenanceAllowedMsgs(p.MaintenanceAllowedMsgs)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyDisabledRoutes, &p.DisabledRoutes, validateDisabledRoutes),
+		params.NewParamSetPair(KeyMaintenanceMode, &p.MaintenanceMode, validateMaintenanceMode),
+		params.NewParamSetPair(KeyMaintenanceAllowedMsgs, &p.MaintenanceAllowedMsgs, validateMaintenanceAllowedMsgs),
+	}
+}
+
+func validateDisabledRoutes(i interface{}) error {
+	routes, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(routes))
+	for _, r := range routes {
+		if r == "" {
+			return fmt.Errorf("disabled route cannot be empty")
+		}
+		if seen[r] {
+			return fmt.Errorf("duplicate disabled route: %s", r)
+		}
+		seen[r] = true
+	}
+
+	return nil
+}
+
+func validateMaintenanceMode(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaintenanceAllowedMsgs(i interface{}) error {
+	msgs, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(msgs))
+	for _, m := range msgs {
+		if m == "" || strings.HasPrefix(m, "/") || strings.HasSuffix(m, "/") || strings.Count(m, "/") > 1 {
+			return fmt.Errorf("invalid maintenance allowed msg: %s", m)
+		}
+		if seen[m] {
+			return fmt.Errorf("duplicate maintenance allowed msg: %s", m)
+		}
+		seen[m] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,10 @@
+package feature
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+)
+
+// ProvideKeeper creates the feature keeper by the params subspace declared to the builder
+func ProvideKeeper(b *wiring.Builder) Keeper {
+	return NewKeeper(b.Codec(), b.Subspace(DefaultParamspace))
+}
@@ -0,0 +1,10 @@
+package feemarket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker adjusts the base fee and the block gas limit by the gas used in this block
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.UpdateFeeMarket(ctx, ctx.BlockGasMeter().GasConsumedToLimit())
+}
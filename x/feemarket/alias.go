@@ -0,0 +1,40 @@
+package feemarket
+
+// nolint
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/feemarket/keeper"
+	"github.com/KuChainNetwork/kuchain/x/feemarket/types"
+)
+
+const (
+	ModuleName         = types.ModuleName
+	StoreKey           = types.StoreKey
+	QuerierRoute       = types.QuerierRoute
+	DefaultParamspace  = types.DefaultParamspace
+	QueryParameters    = types.QueryParameters
+	QueryBaseFee       = types.QueryBaseFee
+	QueryBlockGasLimit = types.QueryBlockGasLimit
+)
+
+var (
+	// functions aliases
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	NewGenesisState     = types.NewGenesisState
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+	ParamKeyTable       = types.ParamKeyTable
+	NewParams           = types.NewParams
+	DefaultParams       = types.DefaultParams
+
+	// variable aliases
+	ModuleCdc = types.ModuleCdc
+	Cdc       = types.Cdc
+)
+
+type (
+	Keeper       = keeper.Keeper
+	GenesisState = types.GenesisState
+	Params       = types.Params
+)
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/x/feemarket/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	feemarketQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the fee market module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feemarketQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryParams(cdc),
+			GetCmdQueryBaseFee(cdc),
+			GetCmdQueryBlockGasLimit(cdc),
+		)...,
+	)
+
+	return feemarketQueryCmd
+}
+
+// GetCmdQueryParams implements a command to fetch fee market parameters.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current fee market parameters",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current parameters for the fee market module:
+
+$ %s query feemarket params
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetCmdQueryBaseFee implements a command to fetch the current base fee per gas.
+func GetCmdQueryBaseFee(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "base-fee",
+		Short: "Query the current base fee per gas",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBaseFee)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var baseFee sdk.Dec
+			cdc.MustUnmarshalJSON(res, &baseFee)
+			return cliCtx.PrintOutput(baseFee)
+		},
+	}
+}
+
+// GetCmdQueryBlockGasLimit implements a command to fetch the current block gas limit.
+func GetCmdQueryBlockGasLimit(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "block-gas-limit",
+		Short: "Query the current block gas limit",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBlockGasLimit)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var limit uint64
+			cdc.MustUnmarshalJSON(res, &limit)
+			return cliCtx.PrintOutput(limit)
+		},
+	}
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/feemarket/types"
+	supplyTypes "github.com/KuChainNetwork/kuchain/x/supply/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// BaseFeeOf returns the base fee for a tx with gas limit
+func (k Keeper) BaseFeeOf(ctx sdk.Context, gas uint64) chainTypes.Coins {
+	fee := k.GetBaseFee(ctx).MulInt64(int64(gas)).Ceil().RoundInt()
+	if !fee.IsPositive() {
+		return chainTypes.Coins{}
+	}
+
+	return chainTypes.NewCoins(chainTypes.NewCoin(constants.DefaultBondDenom, fee))
+}
+
+// CollectBaseFee takes the base fee from fee collector, it will be burned or sent to community pool
+func (k Keeper) CollectBaseFee(ctx sdk.Context, baseFee chainTypes.Coins) error {
+	if baseFee.IsZero() {
+		return nil
+	}
+
+	if k.GetParams(ctx).BurnBaseFee {
+		return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, supplyTypes.BlackHole, baseFee)
+	}
+
+	return k.distrKeeper.FundCommunityPoolFromModule(ctx, k.feeCollectorName, baseFee)
+}
+
+// UpdateFeeMarket adjusts the base fee and the block gas limit by the gas used in the block,
+// both of them move towards the target utilization of the block gas limit.
+func (k Keeper) UpdateFeeMarket(ctx sdk.Context, gasUsed uint64) {
+	params := k.GetParams(ctx)
+
+	limit := k.GetBlockGasLimit(ctx)
+	if limit == 0 {
+		return
+	}
+
+	target := params.TargetUtilization.MulInt64(int64(limit))
+	if !target.IsPositive() {
+		return
+	}
+
+	// delta is the ratio of the gas used to the target, in [-1, 1/TargetUtilization - 1]
+	delta := sdk.NewDec(int64(gasUsed)).Sub(target).Quo(target)
+
+	baseFee := k.GetBaseFee(ctx)
+	baseFee = baseFee.Add(baseFee.Mul(delta).Mul(params.BaseFeeChangeRate))
+	if baseFee.LT(params.MinBaseFee) {
+		baseFee = params.MinBaseFee
+	}
+	k.SetBaseFee(ctx, baseFee)
+
+	if params.IsAdaptiveLimit() {
+		newLimit := sdk.NewDec(int64(limit))
+		newLimit = newLimit.Add(newLimit.Mul(delta).Mul(params.LimitChangeRate))
+		limit = uint64(newLimit.TruncateInt64())
+
+		if limit < params.MinBlockGas {
+			limit = params.MinBlockGas
+		}
+		if limit > params.MaxBlockGas {
+			limit = params.MaxBlockGas
+		}
+		k.SetBlockGasLimit(ctx, limit)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGasLimit, fmt.Sprintf("%d", limit)),
+			sdk.NewAttribute(types.AttributeKeyBlockGasUsed, fmt.Sprintf("%d", gasUsed)),
+		),
+	)
+}
+
+// BlockParamsUpdate returns the consensus params update for tendermint if the block gas limit changed,
+// it returns nil if the block max bytes is unknown.
+//
+// NOTE: the block gas meter in baseapp is still limited by the consensus params in genesis,
+// so the max gas in genesis should be -1 or not less than the MaxBlockGas param.
+func (k Keeper) BlockParamsUpdate(ctx sdk.Context) *abci.ConsensusParams {
+	maxBytes := k.GetBlockMaxBytes(ctx)
+	limit := k.GetBlockGasLimit(ctx)
+	if maxBytes == 0 || limit == 0 || !k.GetParams(ctx).IsAdaptiveLimit() {
+		return nil
+	}
+
+	if k.getUint64(ctx, types.EmittedLimitKey) == limit {
+		return nil
+	}
+	k.setUint64(ctx, types.EmittedLimitKey, limit)
+
+	return &abci.ConsensusParams{
+		Block: &abci.BlockParams{
+			MaxBytes: int64(maxBytes),
+			MaxGas:   int64(limit),
+		},
+	}
+}
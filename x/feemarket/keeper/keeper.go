@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/feemarket/types"
+	"github.com/KuChainNetwork/kuchain/x/params"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc          *codec.Codec
+	storeKey     sdk.StoreKey
+	paramSpace   params.Subspace
+	supplyKeeper types.SupplyKeeper
+	distrKeeper  types.DistributionKeeper
+
+	feeCollectorName string // name of the FeeCollector ModuleAccount
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
+	supplyKeeper types.SupplyKeeper, distrKeeper types.DistributionKeeper, feeCollectorName string,
+) Keeper {
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
+		supplyKeeper:     supplyKeeper,
+		distrKeeper:      distrKeeper,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feemarket parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBaseFee returns the current base fee per gas
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		return k.GetParams(ctx).MinBaseFee
+	}
+
+	var baseFee sdk.Dec
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &baseFee)
+	return baseFee
+}
+
+// SetBaseFee sets the current base fee per gas
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BaseFeeKey, k.cdc.MustMarshalBinaryLengthPrefixed(baseFee))
+}
+
+// GetBlockGasLimit returns the current block gas limit, 0 if not set
+func (k Keeper) GetBlockGasLimit(ctx sdk.Context) uint64 {
+	return k.getUint64(ctx, types.BlockGasLimitKey)
+}
+
+// SetBlockGasLimit sets the current block gas limit
+func (k Keeper) SetBlockGasLimit(ctx sdk.Context, limit uint64) {
+	k.setUint64(ctx, types.BlockGasLimitKey, limit)
+}
+
+// GetBlockMaxBytes returns the block max bytes in consensus params, 0 if unknown
+func (k Keeper) GetBlockMaxBytes(ctx sdk.Context) uint64 {
+	return k.getUint64(ctx, types.BlockMaxBytesKey)
+}
+
+// SetBlockMaxBytes sets the block max bytes in consensus params, it should be called in InitChainer,
+// as the max bytes is needed when the block gas limit update is sent to tendermint.
+func (k Keeper) SetBlockMaxBytes(ctx sdk.Context, maxBytes uint64) {
+	k.setUint64(ctx, types.BlockMaxBytesKey, maxBytes)
+}
+
+func (k Keeper) getUint64(ctx sdk.Context, key []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setUint64(ctx sdk.Context, key []byte, val uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, sdk.Uint64ToBigEndian(val))
+}
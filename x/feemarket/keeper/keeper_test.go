@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	feemarketTypes "github.com/KuChainNetwork/kuchain/x/feemarket/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var (
+	wallet   = simapp.NewWallet()
+	name1    = types.MustName("feetest1")
+	addr1    = wallet.NewAccAddressByName(name1)
+	account1 = types.NewAccountIDFromName(name1)
+)
+
+func createAppForTest() *simapp.SimApp {
+	genAccs := simapp.NewGenesisAccounts(wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(types.NewInt64CoreCoins(1000000)),
+	)
+	return simapp.SetupWithGenesisAccounts(genAccs)
+}
+
+func testParams(burn bool) feemarketTypes.Params {
+	return feemarketTypes.NewParams(
+		1000, 10000,
+		sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(125, 3), sdk.NewDecWithPrec(125, 3),
+		sdk.NewDecWithPrec(1, 3), burn,
+	)
+}
+
+func TestUpdateFeeMarket(t *testing.T) {
+	app := createAppForTest()
+	keeper := app.FeeMarketKeeper()
+
+	Convey("test update fee market by block gas used", t, func() {
+		ctx, _ := app.NewTestContext().CacheContext()
+		keeper.SetParams(ctx, testParams(true))
+		keeper.SetBaseFee(ctx, sdk.NewDecWithPrec(1, 1))
+		keeper.SetBlockGasLimit(ctx, 4000)
+
+		Convey("full block increase base fee and limit", func() {
+			keeper.UpdateFeeMarket(ctx, 4000)
+			So(keeper.GetBaseFee(ctx), simapp.ShouldEq, sdk.NewDecWithPrec(1125, 4))
+			So(keeper.GetBlockGasLimit(ctx), ShouldEqual, 4500)
+		})
+
+		Convey("target block keep base fee and limit", func() {
+			keeper.UpdateFeeMarket(ctx, 2000)
+			So(keeper.GetBaseFee(ctx), simapp.ShouldEq, sdk.NewDecWithPrec(1, 1))
+			So(keeper.GetBlockGasLimit(ctx), ShouldEqual, 4000)
+		})
+
+		Convey("empty block decrease base fee and limit", func() {
+			keeper.UpdateFeeMarket(ctx, 0)
+			So(keeper.GetBaseFee(ctx), simapp.ShouldEq, sdk.NewDecWithPrec(875, 4))
+			So(keeper.GetBlockGasLimit(ctx), ShouldEqual, 3500)
+		})
+
+		Convey("base fee and limit are clamped by params", func() {
+			keeper.SetBaseFee(ctx, sdk.NewDecWithPrec(1, 3))
+			keeper.SetBlockGasLimit(ctx, 1000)
+
+			keeper.UpdateFeeMarket(ctx, 0)
+			So(keeper.GetBaseFee(ctx), simapp.ShouldEq, sdk.NewDecWithPrec(1, 3))
+			So(keeper.GetBlockGasLimit(ctx), ShouldEqual, 1000)
+
+			keeper.SetBlockGasLimit(ctx, 10000)
+			keeper.UpdateFeeMarket(ctx, 10000)
+			So(keeper.GetBlockGasLimit(ctx), ShouldEqual, 10000)
+		})
+
+		Convey("block gas limit is fixed if not adaptive", func() {
+			params := testParams(true)
+			params.MaxBlockGas = 0
+			keeper.SetParams(ctx, params)
+
+			keeper.UpdateFeeMarket(ctx, 4000)
+			So(keeper.GetBaseFee(ctx), simapp.ShouldEq, sdk.NewDecWithPrec(1125, 4))
+			So(keeper.GetBlockGasLimit(ctx), ShouldEqual, 4000)
+		})
+	})
+}
+
+func TestBlockParamsUpdate(t *testing.T) {
+	app := createAppForTest()
+	keeper := app.FeeMarketKeeper()
+
+	Convey("test block params update to tendermint", t, func() {
+		ctx, _ := app.NewTestContext().CacheContext()
+		keeper.SetParams(ctx, testParams(true))
+		keeper.SetBlockGasLimit(ctx, 4000)
+		keeper.SetBlockMaxBytes(ctx, 0)
+
+		So(keeper.BlockParamsUpdate(ctx), ShouldBeNil)
+
+		keeper.SetBlockMaxBytes(ctx, 1000000)
+		update := keeper.BlockParamsUpdate(ctx)
+		So(update, ShouldNotBeNil)
+		So(update.Block.MaxBytes, ShouldEqual, 1000000)
+		So(update.Block.MaxGas, ShouldEqual, 4000)
+
+		// no update if limit not changed
+		So(keeper.BlockParamsUpdate(ctx), ShouldBeNil)
+
+		keeper.UpdateFeeMarket(ctx, 4000)
+		update = keeper.BlockParamsUpdate(ctx)
+		So(update, ShouldNotBeNil)
+		So(update.Block.MaxGas, ShouldEqual, 4500)
+	})
+}
+
+func TestCollectBaseFee(t *testing.T) {
+	app := createAppForTest()
+	keeper := app.FeeMarketKeeper()
+	asset := app.AssetKeeper()
+	distr := app.DistrKeeper()
+
+	Convey("test collect base fee from fee collector", t, func() {
+		ctx, _ := app.NewTestContext().CacheContext()
+		keeper.SetBaseFee(ctx, sdk.NewDecWithPrec(1, 1))
+
+		baseFee := keeper.BaseFeeOf(ctx, 10000)
+		So(baseFee, simapp.ShouldEq, types.NewInt64CoreCoins(1000))
+
+		_, err := asset.IssueCoinPower(ctx, constants.GetFeeCollector(), types.NewInt64CoreCoins(10000))
+		So(err, ShouldBeNil)
+		collectorOld := asset.GetCoinPowers(ctx, constants.GetFeeCollector())
+
+		Convey("base fee burned", func() {
+			keeper.SetParams(ctx, testParams(true))
+			poolOld := distr.GetFeePool(ctx).CommunityPool
+
+			So(keeper.CollectBaseFee(ctx, baseFee), ShouldBeNil)
+			So(asset.GetCoinPowers(ctx, constants.GetFeeCollector()), simapp.ShouldEq, collectorOld.Sub(baseFee))
+			So(distr.GetFeePool(ctx).CommunityPool.IsEqual(poolOld), ShouldBeTrue)
+		})
+
+		Convey("base fee sent to community pool", func() {
+			keeper.SetParams(ctx, testParams(false))
+			poolOld := distr.GetFeePool(ctx).CommunityPool
+
+			So(keeper.CollectBaseFee(ctx, baseFee), ShouldBeNil)
+			So(asset.GetCoinPowers(ctx, constants.GetFeeCollector()), simapp.ShouldEq, collectorOld.Sub(baseFee))
+
+			poolExpected := poolOld.Add(types.NewDecCoinsFromCoins(baseFee...)...)
+			So(distr.GetFeePool(ctx).CommunityPool.IsEqual(poolExpected), ShouldBeTrue)
+		})
+	})
+}
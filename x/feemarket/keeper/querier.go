@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/x/feemarket/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewQuerier creates a new querier for feemarket clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryParameters:
+			return queryJSON(k.GetParams(ctx))
+
+		case types.QueryBaseFee:
+			return queryJSON(k.GetBaseFee(ctx))
+
+		case types.QueryBlockGasLimit:
+			return queryJSON(k.GetBlockGasLimit(ctx))
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+	}
+}
+
+func queryJSON(obj interface{}) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, obj)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var (
+	// ModuleCdc references the global x/feemarket module codec, it is only used for JSON encoding.
+	ModuleCdc = codec.New()
+)
+
+func Cdc() *codec.Codec {
+	return ModuleCdc
+}
+
+func init() {
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
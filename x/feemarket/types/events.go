@@ -0,0 +1,10 @@
+package types
+
+// feemarket module event types
+const (
+	EventTypeFeeMarket = ModuleName
+
+	AttributeKeyBaseFee       = "base_fee"
+	AttributeKeyBlockGasLimit = "block_gas_limit"
+	AttributeKeyBlockGasUsed  = "block_gas_used"
+)
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - all feemarket state that must be provided at genesis
+type GenesisState struct {
+	Params        Params  `json:"params" yaml:"params"`
+	BaseFee       sdk.Dec `json:"base_fee" yaml:"base_fee"`
+	BlockGasLimit uint64  `json:"block_gas_limit" yaml:"block_gas_limit"` // 0 to disable the fee market adjustment
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseFee sdk.Dec, blockGasLimit uint64) GenesisState {
+	return GenesisState{
+		Params:        params,
+		BaseFee:       baseFee,
+		BlockGasLimit: blockGasLimit,
+	}
+}
+
+// DefaultGenesisState - default GenesisState
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultMinBaseFee, DefaultMaxBlockGas/2)
+}
+
+// ValidateGenesis performs basic validation of feemarket genesis data returning an
+// error for any failed validation criteria.
+func (g GenesisState) ValidateGenesis(bz json.RawMessage) error {
+	gs := DefaultGenesisState()
+	if err := Cdc().UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+
+	return ValidateGenesis(gs)
+}
+
+// ValidateGenesis validates the feemarket genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.BaseFee.IsNil() || data.BaseFee.LT(data.Params.MinBaseFee) {
+		return fmt.Errorf("base fee %s should not be less than min base fee %s", data.BaseFee, data.Params.MinBaseFee)
+	}
+
+	if data.Params.IsAdaptiveLimit() && data.BlockGasLimit != 0 &&
+		(data.BlockGasLimit < data.Params.MinBlockGas || data.BlockGasLimit > data.Params.MaxBlockGas) {
+		return fmt.Errorf("block gas limit %d should be in [%d, %d]",
+			data.BlockGasLimit, data.Params.MinBlockGas, data.Params.MaxBlockGas)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package types
+
+const (
+	// ModuleName is the name of the feemarket module
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for feemarket
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the feemarket module
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the feemarket querier
+	QueryParameters    = "parameters"
+	QueryBaseFee       = "base_fee"
+	QueryBlockGasLimit = "block_gas_limit"
+)
+
+// Keys for feemarket store
+var (
+	BaseFeeKey       = []byte{0x01} // key for the current base fee
+	BlockGasLimitKey = []byte{0x02} // key for the current block gas limit
+	EmittedLimitKey  = []byte{0x03} // key for the block gas limit last sent to tendermint
+	BlockMaxBytesKey = []byte{0x04} // key for the block max bytes in consensus params
+)
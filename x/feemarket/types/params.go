@@ -0,0 +1,198 @@
+package types
+
+import (
+	"fmt"
+
+	params "github.com/KuChainNetwork/kuchain/x/params/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace  = ModuleName
+	DefaultMinBlockGas = uint64(10000000)
+	DefaultMaxBlockGas = uint64(100000000)
+)
+
+// Default parameter values
+var (
+	DefaultTargetUtilization = sdk.NewDecWithPrec(5, 1)   // 50%
+	DefaultLimitChangeRate   = sdk.NewDecWithPrec(125, 3) // 12.5%
+	DefaultBaseFeeChangeRate = sdk.NewDecWithPrec(125, 3) // 12.5%
+	DefaultMinBaseFee        = sdk.NewDecWithPrec(1, 6)
+)
+
+// Parameter store keys
+var (
+	KeyMinBlockGas       = []byte("MinBlockGas")
+	KeyMaxBlockGas       = []byte("MaxBlockGas")
+	KeyTargetUtilization = []byte("TargetUtilization")
+	KeyLimitChangeRate   = []byte("LimitChangeRate")
+	KeyBaseFeeChangeRate = []byte("BaseFeeChangeRate")
+	KeyMinBaseFee        = []byte("MinBaseFee")
+	KeyBurnBaseFee       = []byte("BurnBaseFee")
+)
+
+// Params feemarket parameters
+type Params struct {
+	MinBlockGas       uint64  `json:"min_block_gas" yaml:"min_block_gas"`               // lower bound of the block gas limit
+	MaxBlockGas       uint64  `json:"max_block_gas" yaml:"max_block_gas"`               // upper bound of the block gas limit, 0 to disable the adaptive limit
+	TargetUtilization sdk.Dec `json:"target_utilization" yaml:"target_utilization"`     // target ratio of gas used to block gas limit
+	LimitChangeRate   sdk.Dec `json:"limit_change_rate" yaml:"limit_change_rate"`       // max change ratio of block gas limit per block
+	BaseFeeChangeRate sdk.Dec `json:"base_fee_change_rate" yaml:"base_fee_change_rate"` // max change ratio of base fee per block
+	MinBaseFee        sdk.Dec `json:"min_base_fee" yaml:"min_base_fee"`                 // lower bound of the base fee per gas
+	BurnBaseFee       bool    `json:"burn_base_fee" yaml:"burn_base_fee"`               // burn the base fee, or send it to community pool
+}
+
+// ParamKeyTable ParamTable for feemarket module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	minBlockGas, maxBlockGas uint64, targetUtilization, limitChangeRate, baseFeeChangeRate, minBaseFee sdk.Dec, burnBaseFee bool,
+) Params {
+	return Params{
+		MinBlockGas:       minBlockGas,
+		MaxBlockGas:       maxBlockGas,
+		TargetUtilization: targetUtilization,
+		LimitChangeRate:   limitChangeRate,
+		BaseFeeChangeRate: baseFeeChangeRate,
+		MinBaseFee:        minBaseFee,
+		BurnBaseFee:       burnBaseFee,
+	}
+}
+
+// DefaultParams default feemarket module parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMinBlockGas, DefaultMaxBlockGas,
+		DefaultTargetUtilization, DefaultLimitChangeRate, DefaultBaseFeeChangeRate,
+		DefaultMinBaseFee, true,
+	)
+}
+
+// IsAdaptiveLimit returns if the block gas limit is adjusted by utilization
+func (p Params) IsAdaptiveLimit() bool {
+	return p.MaxBlockGas > 0
+}
+
+// Validate validate params
+func (p Params) Validate() error {
+	if err := validateBlockGas(p.MinBlockGas); err != nil {
+		return err
+	}
+	if err := validateBlockGas(p.MaxBlockGas); err != nil {
+		return err
+	}
+	if p.IsAdaptiveLimit() && p.MinBlockGas > p.MaxBlockGas {
+		return fmt.Errorf("min block gas %d should not be greater than max block gas %d", p.MinBlockGas, p.MaxBlockGas)
+	}
+	if err := validateTargetUtilization(p.TargetUtilization); err != nil {
+		return err
+	}
+	if err := validateChangeRate(p.LimitChangeRate); err != nil {
+		return err
+	}
+	if err := validateChangeRate(p.BaseFeeChangeRate); err != nil {
+		return err
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyMinBlockGas, &p.MinBlockGas, validateBlockGas),
+		params.NewParamSetPair(KeyMaxBlockGas, &p.MaxBlockGas, validateBlockGas),
+		params.NewParamSetPair(KeyTargetUtilization, &p.TargetUtilization, validateTargetUtilization),
+		params.NewParamSetPair(KeyLimitChangeRate, &p.LimitChangeRate, validateChangeRate),
+		params.NewParamSetPair(KeyBaseFeeChangeRate, &p.BaseFeeChangeRate, validateChangeRate),
+		params.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+		params.NewParamSetPair(KeyBurnBaseFee, &p.BurnBaseFee, validateBurnBaseFee),
+	}
+}
+
+func validateBlockGas(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateTargetUtilization(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("target utilization must be not nil")
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("target utilization must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("target utilization too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateChangeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("change rate must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("change rate must be positive: %s", v)
+	}
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("change rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("min base fee must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min base fee must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateBurnBaseFee(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package feemarket
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/feemarket/types"
+)
+
+// Inputs the keepers and fee collector the feemarket module depends on
+type Inputs struct {
+	SupplyKeeper       types.SupplyKeeper
+	DistributionKeeper types.DistributionKeeper
+	FeeCollectorName   string
+}
+
+// ProvideKeeper creates the feemarket keeper by the store key and params subspace declared to the builder
+func ProvideKeeper(b *wiring.Builder, in Inputs) Keeper {
+	return NewKeeper(
+		b.Codec(), b.KVStoreKey(StoreKey), b.Subspace(DefaultParamspace), in.SupplyKeeper, in.DistributionKeeper, in.FeeCollectorName,
+	)
+}
@@ -29,4 +29,7 @@ type (
 	MigrationCallback = types.MigrationCallback
 	MigrationMap      = types.MigrationMap
 	InitConfig        = types.InitConfig
+	GenTxChecks       = types.GenTxChecks
+
+	GenTxValidatorInfo = types.GenTxValidatorInfo
 )
@@ -2,9 +2,15 @@ package cli
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
 	"github.com/KuChainNetwork/kuchain/x/genutil"
 	"github.com/KuChainNetwork/kuchain/x/genutil/types"
-	"path/filepath"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -17,7 +23,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
-const flagGenTxDir = "gentx-dir"
+const (
+	flagGenTxDir         = "gentx-dir"
+	flagVerifySignatures = "verify-signatures"
+	flagCheckBalances    = "check-balances"
+	flagUniqueMonikers   = "unique-monikers"
+)
 
 // CollectGenTxsCmd - return the cobra command to collect genesis transactions
 func CollectGenTxsCmd(ctx *server.Context, cdc *codec.Codec, genBalIterator types.GenesisBalancesIterator,
@@ -47,6 +58,11 @@ func CollectGenTxsCmd(ctx *server.Context, cdc *codec.Codec, genBalIterator type
 
 			toPrint := newPrintInfo(config.Moniker, genDoc.ChainID, nodeID, genTxsDir, json.RawMessage(""))
 			initCfg := genutil.NewInitConfig(genDoc.ChainID, genTxsDir, name, nodeID, valPubKey)
+			initCfg.Checks = types.GenTxChecks{
+				VerifySignatures: viper.GetBool(flagVerifySignatures),
+				CheckBalances:    viper.GetBool(flagCheckBalances),
+				UniqueMonikers:   viper.GetBool(flagUniqueMonikers),
+			}
 
 			appMessage, err := genutil.GenAppStateFromConfig(cdc, config, initCfg, *genDoc, genBalIterator, manager)
 			if err != nil {
@@ -56,7 +72,11 @@ func CollectGenTxsCmd(ctx *server.Context, cdc *codec.Codec, genBalIterator type
 			toPrint.AppMessage = appMessage
 
 			// print out some key information
-			return displayInfo(cdc, toPrint)
+			if err := displayInfo(cdc, toPrint); err != nil {
+				return err
+			}
+
+			return displayValidatorSet(cdc, os.Stderr, appMessage, manager)
 		},
 	}
 
@@ -64,5 +84,39 @@ func CollectGenTxsCmd(ctx *server.Context, cdc *codec.Codec, genBalIterator type
 	cmd.Flags().String(flagGenTxDir, "",
 		"override default \"gentx\" directory from which collect and execute "+
 			"genesis transactions; default [--home]/config/gentx/")
+	cmd.Flags().Bool(flagVerifySignatures, false, "verify the signature of each gentx by the chain id of genesis")
+	cmd.Flags().Bool(flagCheckBalances, false, "check each gentx is a self-delegation and covered by genesis balances")
+	cmd.Flags().Bool(flagUniqueMonikers, false, "reject the gentxs with duplicate monikers instead of warning")
 	return cmd
 }
+
+// displayValidatorSet prints a summary table of the validator set in genesis gentxs
+func displayValidatorSet(cdc *codec.Codec, w io.Writer, appMessage json.RawMessage, manager types.StakingFuncManager) error {
+	var appState map[string]json.RawMessage
+	if err := cdc.UnmarshalJSON(appMessage, &appState); err != nil {
+		return errors.Wrap(err, "failed to unmarshal app state")
+	}
+
+	genesisState := types.GetGenesisStateFromAppState(cdc, appState)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "MONIKER\tVALIDATOR\tSELF-DELEGATION\tCOMMISSION\tCONSENSUS-PUBKEY")
+
+	for _, genTx := range genesisState.GenTxs {
+		var tx txutil.StdTx
+		if err := cdc.UnmarshalJSON(genTx, &tx); err != nil {
+			return errors.Wrap(err, "failed to unmarshal gentx")
+		}
+
+		info, err := manager.GetGenTxValidatorInfo(tx.GetMsgs())
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+			info.Moniker, info.Validator, info.SelfDelegation, info.Commission, info.ConsensusPubkey)
+	}
+
+	fmt.Fprintf(tw, "total %d validators\n", len(genesisState.GenTxs))
+	return tw.Flush()
+}
@@ -4,20 +4,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 
+	"github.com/KuChainNetwork/kuchain/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	tmtypes "github.com/tendermint/tendermint/types"
 )
 
+const flagStrict = "strict"
+
 // Validate genesis command takes
-func ValidateGenesisCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager) *cobra.Command {
-	return &cobra.Command{
+func ValidateGenesisCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager, checks ...types.GenesisCheck) *cobra.Command {
+	cmd := &cobra.Command{
 		Use:   "validate-genesis [file]",
 		Args:  cobra.RangeArgs(0, 1),
 		Short: "validates the genesis file at the default location or at the location passed as an arg",
+		Long: `validates the genesis file at the default location or at the location passed as an arg,
+with --strict all modules and the cross-module checks will be run, and all violations will be reported`,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 
 			// Load default if passed no args, otherwise load passed file
@@ -40,7 +47,15 @@ func ValidateGenesisCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicM
 				return fmt.Errorf("error unmarshalling genesis doc %s: %s", genesis, err.Error())
 			}
 
-			if err = mbm.ValidateGenesis(genState); err != nil {
+			if viper.GetBool(flagStrict) {
+				violations := StrictValidateGenesis(mbm, genState, checks...)
+				if len(violations) > 0 {
+					for _, v := range violations {
+						fmt.Fprintln(os.Stderr, v.String())
+					}
+					return fmt.Errorf("genesis file %s has %d violations", genesis, len(violations))
+				}
+			} else if err = mbm.ValidateGenesis(genState); err != nil {
 				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
 			}
 
@@ -48,4 +63,31 @@ func ValidateGenesisCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicM
 			return nil
 		},
 	}
+
+	cmd.Flags().Bool(flagStrict, false, "run all modules and cross-module checks, report all violations")
+
+	return cmd
+}
+
+// StrictValidateGenesis runs the ValidateGenesis of every module and the cross-module checks,
+// it will not stop at the first error, all the violations will be returned.
+func StrictValidateGenesis(mbm module.BasicManager, genState map[string]json.RawMessage, checks ...types.GenesisCheck) []types.GenesisViolation {
+	names := make([]string, 0, len(mbm))
+	for name := range mbm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	violations := make([]types.GenesisViolation, 0)
+	for _, name := range names {
+		if err := mbm[name].ValidateGenesis(genState[name]); err != nil {
+			violations = append(violations, types.NewGenesisViolation(types.AppStatePath(name), "%s", err.Error()))
+		}
+	}
+
+	for _, check := range checks {
+		violations = append(violations, check(genState)...)
+	}
+
+	return violations
 }
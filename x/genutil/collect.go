@@ -1,6 +1,7 @@
 package genutil
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/asset"
 	"github.com/KuChainNetwork/kuchain/x/genutil/types"
 
@@ -27,7 +29,7 @@ func GenAppStateFromConfig(cdc *codec.Codec, config *cfg.Config, initCfg InitCon
 
 	// process genesis transactions, else create default genesis.json
 	appGenTxs, persistentPeers, err := CollectStdTxs(cdc, config.Moniker, initCfg.GenTxsDir, genDoc,
-		genBalIterator, stakingFuncManager)
+		genBalIterator, stakingFuncManager, initCfg.Checks)
 	if err != nil {
 		return appState, err
 	}
@@ -64,8 +66,11 @@ func GenAppStateFromConfig(cdc *codec.Codec, config *cfg.Config, initCfg InitCon
 
 // CollectStdTxs processes and validates application's genesis StdTxs and returns
 // the list of appGenTxs, and persistent peers required to generate genesis.json.
+// The signatures, the balances of self-delegations and the uniqueness of monikers
+// will be checked if enabled in checks, the duplicate monikers are warned otherwise.
 func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string, genDoc tmtypes.GenesisDoc,
 	genBalIterator types.GenesisBalancesIterator, stakingFuncManager types.StakingFuncManager,
+	checks types.GenTxChecks,
 ) (appGenTxs []txutil.StdTx, persistentPeers string, err error) {
 
 	var fos []os.FileInfo
@@ -93,6 +98,10 @@ func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string, genDoc tmtypes.G
 	// addresses and IPs (and port) validator server info
 	var addressesIPs []string
 
+	// the gentx file of each moniker, and the total self-delegations of each delegator
+	monikers := make(map[string]string)
+	delegated := make(map[string]types.Coins)
+
 	for _, fo := range fos {
 		filename := filepath.Join(genTxsDir, fo.Name())
 		if !fo.IsDir() && (filepath.Ext(filename) != ".json") {
@@ -120,19 +129,39 @@ func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string, genDoc tmtypes.G
 			return appGenTxs, persistentPeers, fmt.Errorf("failed to find node's address and IP in %s", fo.Name())
 		}
 
-		// genesis transactions must be single-message
+		// genesis transactions must be a create-validator and a delegate msg
 		msgs := genStdTx.GetMsgs()
 
-		err := stakingFuncManager.MsgDelegateWithBalance(msgs[1], balancesMap)
-
+		info, err := stakingFuncManager.GetGenTxValidatorInfo(msgs)
 		if err != nil {
+			return appGenTxs, persistentPeers, fmt.Errorf("invalid gentx %s: %w", fo.Name(), err)
+		}
+
+		if err := stakingFuncManager.MsgDelegateWithBalance(msgs[1], balancesMap); err != nil {
 			return appGenTxs, persistentPeers, err
 		}
 
-		msgMoniker, err := stakingFuncManager.GetMsgCreateValidatorMoniker(msgs[0])
+		msgMoniker := info.Moniker
+		if other, ok := monikers[msgMoniker]; ok {
+			if checks.UniqueMonikers {
+				return appGenTxs, persistentPeers,
+					fmt.Errorf("duplicate moniker %s in gentx %s and %s", msgMoniker, other, fo.Name())
+			}
+			fmt.Fprintf(os.Stderr, "WARNING: duplicate moniker %s in gentx %s and %s\n", msgMoniker, other, fo.Name())
+		} else {
+			monikers[msgMoniker] = fo.Name()
+		}
 
-		if err != nil {
-			return appGenTxs, persistentPeers, err
+		if checks.VerifySignatures {
+			if err := VerifyGenTxSignatures(genDoc.ChainID, genStdTx); err != nil {
+				return appGenTxs, persistentPeers, fmt.Errorf("invalid gentx %s: %w", fo.Name(), err)
+			}
+		}
+
+		if checks.CheckBalances {
+			if err := checkSelfDelegation(info, balancesMap, delegated); err != nil {
+				return appGenTxs, persistentPeers, fmt.Errorf("invalid gentx %s: %w", fo.Name(), err)
+			}
 		}
 
 		// exclude itself from persistent peers
@@ -147,3 +176,55 @@ func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string, genDoc tmtypes.G
 
 	return appGenTxs, persistentPeers, nil
 }
+
+// VerifyGenTxSignatures verifies the signatures of gentx, the gentx is signed
+// with account number and sequence both zero as it is delivered in genesis.
+func VerifyGenTxSignatures(chainID string, tx txutil.StdTx) error {
+	sigs := tx.GetSignatures()
+	signers := tx.GetSigners()
+
+	if len(sigs) != len(signers) {
+		return fmt.Errorf("invalid number of signer, expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	signBytes := chainTypes.StdSignBytes(chainID, 0, 0, tx.Fee, tx.Msgs, tx.Memo)
+	for i, sig := range sigs {
+		if sig.PubKey == nil {
+			return fmt.Errorf("no pubkey in signature of %s", signers[i])
+		}
+
+		if !bytes.Equal(sig.PubKey.Address(), signers[i]) {
+			return fmt.Errorf("pubkey of signature not match signer %s", signers[i])
+		}
+
+		if !sig.PubKey.VerifyBytes(signBytes, sig.Signature) {
+			return fmt.Errorf("signature verification failed for %s, verify correct chain-id %s", signers[i], chainID)
+		}
+	}
+
+	return nil
+}
+
+// checkSelfDelegation checks the gentx is a self-delegation, and the total self-delegations
+// of the delegator are covered by its balance in genesis.
+func checkSelfDelegation(info types.GenTxValidatorInfo,
+	balancesMap map[string]asset.GenesisAsset, delegated map[string]types.Coins) error {
+	if !info.IsSelfDelegation() {
+		return fmt.Errorf("delegator %s is not the validator %s", info.Delegator, info.Validator)
+	}
+
+	key := info.Delegator.String()
+	total := delegated[key].Add(info.SelfDelegation)
+
+	balance, ok := balancesMap[key]
+	if !ok {
+		return fmt.Errorf("account %s balance not in genesis state", key)
+	}
+
+	if !balance.GetCoins().IsAllGTE(total) {
+		return fmt.Errorf("insufficient fund for self-delegations of %s: %s < %s", key, balance.GetCoins(), total)
+	}
+
+	delegated[key] = total
+	return nil
+}
@@ -0,0 +1,83 @@
+package genutil_test
+
+import (
+	"bufio"
+	"os"
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/genutil"
+	"github.com/KuChainNetwork/kuchain/x/staking"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/spf13/viper"
+	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+	"github.com/tendermint/tendermint/libs/cli"
+)
+
+func TestVerifyGenTxSignatures(t *testing.T) {
+	Convey("TestVerifyGenTxSignatures", t, func() {
+		home, cleanup := simapp.NewTestCaseDir(t)
+		defer cleanup()
+		viper.Set(cli.HomeFlag, home)
+		viper.Set(flags.FlagKeyringBackend, "test")
+
+		const chainID = "test_chain"
+
+		cfg, err := tcmd.ParseConfig()
+		So(err, ShouldBeNil)
+
+		cdc := makeCodec()
+		inBuf := bufio.NewReader(os.Stdin)
+		auth := wallet.NewAccAddress()
+		smbh.PrepareFlagsForTxCreateValidator(cfg, "moniker", chainID, wallet.PrivKey(auth).PubKey())
+		txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc)).WithPayer("validator")
+		cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+		valAccountID := types.MustAccountID("validator")
+
+		txBldr, msg, err := smbh.BuildCreateValidatorMsg(cliCtx, txBldr, valAccountID, auth)
+		So(err, ShouldBeNil)
+
+		txBldr, msgdelegator, err := smbh.BuildDelegateMsg(cliCtx, txBldr, valAccountID, valAccountID)
+		So(err, ShouldBeNil)
+
+		stdSignMsg, err := txBldr.BuildSignMsg([]sdk.Msg{msg, msgdelegator})
+		So(err, ShouldBeNil)
+
+		signTx := func(chainID string) txutil.StdTx {
+			priv := wallet.PrivKey(auth)
+			signBytes := types.StdSignBytes(chainID, 0, 0, stdSignMsg.Fee, stdSignMsg.Msg, stdSignMsg.Memo)
+			sig, err := priv.Sign(signBytes)
+			So(err, ShouldBeNil)
+
+			return types.NewStdTx(stdSignMsg.Msg, stdSignMsg.Fee,
+				[]types.StdSignature{{PubKey: priv.PubKey(), Signature: sig}}, stdSignMsg.Memo)
+		}
+
+		Convey("valid signature", func() {
+			So(genutil.VerifyGenTxSignatures(chainID, signTx(chainID)), ShouldBeNil)
+		})
+
+		Convey("signed by other chain id", func() {
+			So(genutil.VerifyGenTxSignatures(chainID, signTx("other_chain")), ShouldNotBeNil)
+		})
+
+		Convey("no signature", func() {
+			stdTx := types.NewStdTx(stdSignMsg.Msg, stdSignMsg.Fee, nil, stdSignMsg.Memo)
+			So(genutil.VerifyGenTxSignatures(chainID, stdTx), ShouldNotBeNil)
+		})
+
+		Convey("gentx validator info", func() {
+			info, err := staking.NewFuncManager().GetGenTxValidatorInfo(stdSignMsg.Msg)
+			So(err, ShouldBeNil)
+			So(info.Validator, ShouldResemble, valAccountID)
+			So(info.IsSelfDelegation(), ShouldBeTrue)
+
+			_, err = staking.NewFuncManager().GetGenTxValidatorInfo(stdSignMsg.Msg[:1])
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
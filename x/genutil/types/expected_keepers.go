@@ -50,6 +50,8 @@ type StakingFuncManager interface {
 	// function: validate stakingtypes.MsgCreateValidator and check balance, then return monitor
 	MsgDelegateWithBalance(m sdk.Msg, balancesMap map[string]asset.GenesisAsset) error
 	GetMsgCreateValidatorMoniker(msg sdk.Msg) (string, error)
+	// function: get the validator info from the msgs of gentx
+	GetGenTxValidatorInfo(msgs []sdk.Msg) (GenTxValidatorInfo, error)
 	// function: decode appGenesisState and get bondDenom
 	GetBondDenom(appGenesisState map[string]json.RawMessage) string
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
 )
 
@@ -28,6 +29,34 @@ type InitConfig struct {
 	Name      string
 	NodeID    string
 	ValPubKey crypto.PubKey
+
+	// Checks extra checks for gentxs when collecting
+	Checks GenTxChecks
+}
+
+// GenTxChecks the extra checks for gentxs before merging into genesis
+type GenTxChecks struct {
+	// VerifySignatures verify the signatures of gentx by the chain id of genesis
+	VerifySignatures bool
+	// CheckBalances check the self-delegations of all gentxs are covered by balances
+	CheckBalances bool
+	// UniqueMonikers reject the gentxs with duplicate monikers, else only warn about them
+	UniqueMonikers bool
+}
+
+// GenTxValidatorInfo the validator info in a gentx
+type GenTxValidatorInfo struct {
+	Validator       types.AccountID
+	Delegator       types.AccountID
+	Moniker         string
+	ConsensusPubkey string
+	Commission      sdk.Dec
+	SelfDelegation  types.Coin
+}
+
+// IsSelfDelegation returns true if the delegator of gentx is the validator
+func (i GenTxValidatorInfo) IsSelfDelegation() bool {
+	return i.Delegator.Eq(i.Validator)
 }
 
 // NewInitConfig creates a new InitConfig object
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GenesisViolation is a violation found by the strict genesis validation,
+// the Path is the json path of the invalid value in genesis file.
+type GenesisViolation struct {
+	Path    string `json:"path" yaml:"path"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// NewGenesisViolation creates a new GenesisViolation object
+func NewGenesisViolation(path, format string, args ...interface{}) GenesisViolation {
+	return GenesisViolation{
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (v GenesisViolation) String() string {
+	return fmt.Sprintf("%s: %s", v.Path, v.Message)
+}
+
+// GenesisCheck checks the app state in genesis across modules
+type GenesisCheck func(appState map[string]json.RawMessage) []GenesisViolation
+
+// AppStatePath returns the json path of a module genesis state in genesis file
+func AppStatePath(module string, fields ...interface{}) string {
+	path := "app_state." + module
+	for _, f := range fields {
+		switch v := f.(type) {
+		case int:
+			path += fmt.Sprintf("[%d]", v)
+		default:
+			path += fmt.Sprintf(".%v", v)
+		}
+	}
+
+	return path
+}
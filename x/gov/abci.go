@@ -2,6 +2,7 @@ package gov
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/KuChainNetwork/kuchain/x/gov/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +17,9 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		keeper.DeleteProposal(ctx, proposal.ProposalID)
 		keeper.DeleteDeposits(ctx, proposal.ProposalID)
 
+		// called when the proposal is dropped for not reaching the min deposit
+		keeper.AfterProposalFailedMinDeposit(ctx, proposal.ProposalID)
+
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeInactiveProposal,
@@ -23,41 +27,79 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 				sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalDropped),
 			),
 		)
+		ctx.EventManager().EmitEvent(
+			types.NewProposalStatusEvent(types.EventTypeProposalDepositPeriodEnded, proposal, types.AttributeValueProposalDropped),
+		)
 
 		logger.Info(
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalID,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				keeper.GetProposalMinDeposit(ctx, proposal),
 				proposal.TotalDeposit,
 			),
 		)
 		return false
 	})
 
+	// remind the proposals in voting period every reminder interval blocks
+	if interval := keeper.GetVotingParams(ctx).ReminderInterval; interval > 0 && ctx.BlockHeight()%interval == 0 {
+		remindVotingProposals(ctx, keeper)
+	}
+
 	// fetch active proposals whose voting periods have ended (are passed the block time)
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
-		var tagValue, logMsg string
+		var tagValue, eventType, logMsg string
+
+		// the expedited proposal failed in the expedited vote is converted to a normal proposal,
+		// the votes are kept and tallied again at the end of the normal voting period
+		if proposal.Expedited {
+			cacheCtx, _ := ctx.CacheContext()
+			if passes, _, _, _, _, _ := keeper.Tally(cacheCtx, proposal); !passes {
+				proposal = keeper.ConvertExpeditedProposal(ctx, proposal)
+
+				logger.Info(
+					fmt.Sprintf(
+						"expedited proposal %d (%s) didn't pass; converted to a normal proposal ending at %s",
+						proposal.ProposalID, proposal.GetTitle(), proposal.VotingEndTime,
+					),
+				)
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeActiveProposal,
+						sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+						sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalConverted),
+						sdk.NewAttribute(types.AttributeKeyVotingEndTime, proposal.VotingEndTime.UTC().Format(time.RFC3339)),
+					),
+				)
+				return false
+			}
+		}
 
 		passes, burnDeposits, tallyResults, _, ispunish, vetobp := keeper.Tally(ctx, proposal)
 
-		if burnDeposits {
+		// the proposal is vetoed if the veto validators are punished, the proposer's deposit is slashed
+		switch {
+		case ispunish:
+			keeper.BurnVetoedDeposits(ctx, proposal)
+		case burnDeposits:
 			keeper.DeleteDeposits(ctx, proposal.ProposalID)
-		} else {
+		default:
 			keeper.RefundDeposits(ctx, proposal.ProposalID)
 		}
 
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
 
 			// The proposal handler may execute state mutating logic depending
 			// on the proposal content. If the handler fails, no state mutation
 			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.Content)
+			err := keeper.ExecuteContent(cacheCtx, proposal.Content)
 			if err == nil {
 				proposal.Status = StatusPassed
 				tagValue = types.AttributeValueProposalPassed
+				eventType = types.EventTypeProposalPassed
 				logMsg = "passed"
 
 				// The cached context is created with a new EventManager. However, since
@@ -71,12 +113,14 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			} else {
 				proposal.Status = StatusFailed
 				tagValue = types.AttributeValueProposalFailed
+				eventType = types.EventTypeProposalFailed
 				logMsg = fmt.Sprintf("passed, but failed on execution: %s", err)
 			}
 
 		} else {
 			proposal.Status = StatusRejected
 			tagValue = types.AttributeValueProposalRejected
+			eventType = types.EventTypeProposalRejected
 			logMsg = "rejected"
 		}
 
@@ -92,6 +136,9 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		keeper.SetProposal(ctx, proposal)
 		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
 
+		// called when the voting period of the proposal ended and the proposal is tallied
+		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalID)
+
 		logger.Info(
 			fmt.Sprintf(
 				"proposal %d (%s) tallied; result: %s",
@@ -106,6 +153,35 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
 			),
 		)
+		ctx.EventManager().EmitEvent(types.NewProposalStatusEvent(eventType, proposal, tagValue))
+		return false
+	})
+}
+
+// remindVotingProposals emits a vote reminder event for each proposal in voting period,
+// with the time remaining and the turnout, for the monitoring and notification services.
+func remindVotingProposals(ctx sdk.Context, keeper Keeper) {
+	blockTime := ctx.BlockHeader().Time
+
+	keeper.IterateAllActiveProposalsQueue(ctx, func(proposal Proposal) bool {
+		// the proposals ended in this block are tallied later
+		if !proposal.VotingEndTime.After(blockTime) {
+			return false
+		}
+
+		_, turnout := keeper.Turnout(ctx, proposal)
+		quorum := keeper.GetProposalTallyParams(ctx, proposal).Quorum
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeVoteReminder,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+				sdk.NewAttribute(types.AttributeKeyVotingEndTime, proposal.VotingEndTime.UTC().Format(time.RFC3339)),
+				sdk.NewAttribute(types.AttributeKeyTimeRemaining, proposal.VotingEndTime.Sub(blockTime).String()),
+				sdk.NewAttribute(types.AttributeKeyTurnout, turnout.String()),
+				sdk.NewAttribute(types.AttributeKeyQuorum, quorum.String()),
+			),
+		)
 		return false
 	})
 }
@@ -49,6 +49,7 @@ var (
 	RegisterInvariants            = keeper.RegisterInvariants
 	AllInvariants                 = keeper.AllInvariants
 	ModuleAccountInvariant        = keeper.ModuleAccountInvariant
+	DepositProposalsInvariant     = keeper.DepositProposalsInvariant
 	NewKeeper                     = keeper.NewKeeper
 	NewQuerier                    = keeper.NewQuerier
 	RegisterCodec                 = types.RegisterCodec
@@ -109,6 +110,7 @@ var (
 	NewTallyResultFromMap         = types.NewTallyResultFromMap
 	EmptyTallyResult              = types.EmptyTallyResult
 	NewVote                       = types.NewVote
+	NewVoteReceipt                = types.NewVoteReceipt
 	VoteOptionFromString          = types.VoteOptionFromString
 	ValidVoteOption               = types.ValidVoteOption
 
@@ -154,5 +156,182 @@ type (
 	TallyResult           = types.TallyResult
 	Vote                  = types.Vote
 	Votes                 = types.Votes
+	VoteReceipt           = types.VoteReceipt
 	VoteOption            = types.VoteOption
 )
+
+var (
+	NewMsgServerImpl                   = keeper.NewMsgServerImpl
+	UnmarshalMsgSubmitProposalResponse = types.UnmarshalMsgSubmitProposalResponse
+)
+
+type (
+	MsgServer                 = keeper.MsgServer
+	MsgSubmitProposalResponse = types.MsgSubmitProposalResponse
+	MsgDepositResponse        = types.MsgDepositResponse
+	MsgVoteResponse           = types.MsgVoteResponse
+	MsgGovUnjailResponse      = types.MsgGovUnjailResponse
+)
+
+const (
+	TypeMsgVoteWeighted = types.TypeMsgVoteWeighted
+)
+
+var (
+	NewMsgVoteWeighted            = types.NewMsgVoteWeighted
+	NewKuMsgVoteWeighted          = types.NewKuMsgVoteWeighted
+	NewWeightedVote               = types.NewWeightedVote
+	NewWeightedVoteOption         = types.NewWeightedVoteOption
+	NewNonSplitVoteOption         = types.NewNonSplitVoteOption
+	ValidWeightedVoteOptions      = types.ValidWeightedVoteOptions
+	WeightedVoteOptionsFromString = types.WeightedVoteOptionsFromString
+)
+
+type (
+	MsgVoteWeighted         = types.MsgVoteWeighted
+	KuMsgVoteWeighted       = types.KuMsgVoteWeighted
+	MsgVoteWeightedResponse = types.MsgVoteWeightedResponse
+	WeightedVoteOption      = types.WeightedVoteOption
+	WeightedVoteOptions     = types.WeightedVoteOptions
+)
+
+var (
+	ProposalContentTemplate        = types.ProposalContentTemplate
+	RegisteredProposalContentTypes = types.RegisteredProposalContentTypes
+)
+
+const (
+	TypeMsgCancelProposal   = types.TypeMsgCancelProposal
+	EventTypeCancelProposal = types.EventTypeCancelProposal
+)
+
+var (
+	NewMsgCancelProposal   = types.NewMsgCancelProposal
+	NewKuMsgCancelProposal = types.NewKuMsgCancelProposal
+	ErrInvalidProposer     = types.ErrInvalidProposer
+	DefaultCancelBurnRate  = types.DefaultCancelBurnRate
+	DefaultVetoBurnRate    = types.DefaultVetoBurnRate
+	EventTypeVetoSlash     = types.EventTypeVetoSlash
+)
+
+type (
+	MsgCancelProposal         = types.MsgCancelProposal
+	KuMsgCancelProposal       = types.KuMsgCancelProposal
+	MsgCancelProposalResponse = types.MsgCancelProposalResponse
+)
+
+const (
+	ParamExpedited                  = types.ParamExpedited
+	AttributeValueProposalConverted = types.AttributeValueProposalConverted
+)
+
+var (
+	NewExpeditedParams               = types.NewExpeditedParams
+	DefaultExpeditedParams           = types.DefaultExpeditedParams
+	NewKuMsgSubmitExpeditedProposal  = types.NewKuMsgSubmitExpeditedProposal
+	ParamStoreKeyExpeditedParams     = types.ParamStoreKeyExpeditedParams
+	DefaultExpeditedMinDepositTokens = types.DefaultExpeditedMinDepositTokens
+)
+
+type (
+	ExpeditedParams = types.ExpeditedParams
+)
+
+var (
+	ErrMinInitialDeposit          = types.ErrMinInitialDeposit
+	DefaultMinInitialDepositRatio = types.DefaultMinInitialDepositRatio
+)
+
+var (
+	ErrTooManyActiveProposals = types.ErrTooManyActiveProposals
+	ErrSubmitCooldown         = types.ErrSubmitCooldown
+	LastSubmitTimeKey         = types.LastSubmitTimeKey
+	DefaultMaxActiveProposals = types.DefaultMaxActiveProposals
+	DefaultSubmitCooldown     = types.DefaultSubmitCooldown
+)
+
+const (
+	QueryTallyDetail = types.QueryTallyDetail
+)
+
+var (
+	NewValidatorTallyDetail = types.NewValidatorTallyDetail
+	NewTallyDetail          = types.NewTallyDetail
+)
+
+type (
+	ValidatorTallyDetail = types.ValidatorTallyDetail
+	TallyDetail          = types.TallyDetail
+)
+
+const (
+	QueryDepositsHistory   = types.QueryDepositsHistory
+	EventTypeDepositRefund = types.EventTypeDepositRefund
+	EventTypeDepositBurn   = types.EventTypeDepositBurn
+	AttributeKeyDepositor  = types.AttributeKeyDepositor
+)
+
+var (
+	NewDepositDisposal        = types.NewDepositDisposal
+	DepositDisposalsKey       = types.DepositDisposalsKey
+	DepositDisposalKey        = types.DepositDisposalKey
+	DepositDisposalsKeyPrefix = types.DepositDisposalsKeyPrefix
+)
+
+type (
+	DepositDisposal  = types.DepositDisposal
+	DepositDisposals = types.DepositDisposals
+)
+
+var (
+	NewMultiGovHooks = types.NewMultiGovHooks
+)
+
+type (
+	GovHooks      = types.GovHooks
+	MultiGovHooks = types.MultiGovHooks
+)
+
+const (
+	ProposalTypeMulti = types.ProposalTypeMulti
+	MaxMultiContents  = types.MaxMultiContents
+)
+
+var (
+	NewMultiContentProposal = types.NewMultiContentProposal
+)
+
+type (
+	MultiContentProposal = types.MultiContentProposal
+)
+
+const (
+	QueryVoterPower         = types.QueryVoterPower
+	VoterPowerModeValidator = types.VoterPowerModeValidator
+	VoterPowerModeInherited = types.VoterPowerModeInherited
+)
+
+var (
+	NewVoterPower = types.NewVoterPower
+)
+
+type (
+	DelegationPower = types.DelegationPower
+	VoterPower      = types.VoterPower
+)
+
+const (
+	ParamType = types.ParamType
+)
+
+var (
+	NewProposalTypeParams   = types.NewProposalTypeParams
+	NewTypeParams           = types.NewTypeParams
+	DefaultTypeParams       = types.DefaultTypeParams
+	ParamStoreKeyTypeParams = types.ParamStoreKeyTypeParams
+)
+
+type (
+	ProposalTypeParams = types.ProposalTypeParams
+	TypeParams         = types.TypeParams
+)
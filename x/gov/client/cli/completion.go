@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/completion"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+)
+
+// completeProposalIDs completes the ids of the proposals in the status by a light query, StatusNil for all
+func completeProposalIDs(cdc *codec.Codec, status types.ProposalStatus) completion.ArgCompleter {
+	return func(_ *cobra.Command, _ string) []string {
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+		params := types.NewQueryProposalsParams(1, completion.MaxCandidates, status, chainTypes.EmptyAccountID(), chainTypes.EmptyAccountID())
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			return nil
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposals), bz)
+		if err != nil {
+			return nil
+		}
+
+		var proposals types.Proposals
+		if err := cdc.UnmarshalJSON(res, &proposals); err != nil {
+			return nil
+		}
+
+		ids := make([]string, 0, len(proposals))
+		for _, p := range proposals {
+			ids = append(ids, fmt.Sprintf("%d\t%s", p.ProposalID, p.GetTitle()))
+		}
+
+		return ids
+	}
+}
@@ -0,0 +1,222 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/completion"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+)
+
+// GetCmdDraftProposal implements the command to generate a proposal JSON template.
+func GetCmdDraftProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "draft-proposal [proposal-type]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Generate a proposal JSON template of the registered proposal type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Generate a proposal JSON template with the fields of the registered proposal type,
+the template can be edited and used as the proposal file of the submit-proposal commands.
+If the proposal type is not given, the type, title, description and deposit are prompted.
+
+Registered proposal types: %s
+
+Example:
+$ %s tx kugov draft-proposal parameter_change --title="Update voting period" --deposit="1000kuchain/kcs" > proposal.json
+$ %s tx kugov draft-proposal
+`,
+				strings.Join(types.RegisteredProposalContentTypes(), ", "), version.ClientName, version.ClientName,
+			),
+		),
+		ValidArgsFunction: completion.Args(completion.Words(types.RegisteredProposalContentTypes()...)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+
+			title := viper.GetString(FlagTitle)
+			description := viper.GetString(FlagDescription)
+			deposit := viper.GetString(FlagDeposit)
+
+			var err error
+			proposalType := ""
+			if len(args) > 0 {
+				proposalType = args[0]
+			} else {
+				// interactive mode, prompts the fields not given by the flags
+				proposalType, err = input.GetString(
+					fmt.Sprintf("proposal type (%s):", strings.Join(types.RegisteredProposalContentTypes(), "/")), inBuf)
+				if err != nil {
+					return err
+				}
+
+				for _, field := range []struct {
+					prompt string
+					value  *string
+				}{
+					{"proposal title:", &title},
+					{"proposal description:", &description},
+					{"proposal deposit:", &deposit},
+				} {
+					if *field.value != "" {
+						continue
+					}
+
+					if *field.value, err = input.GetString(field.prompt, inBuf); err != nil {
+						return err
+					}
+				}
+			}
+
+			content, err := proposalContentTemplateByName(proposalType)
+			if err != nil {
+				return err
+			}
+
+			template, err := draftProposal(content, title, description, deposit)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(template))
+			return err
+		},
+	}
+
+	cmd.Flags().String(FlagTitle, "", "title of proposal")
+	cmd.Flags().String(FlagDescription, "", "description of proposal")
+	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// proposalContentTemplateByName returns the registered proposal content of the type,
+// the type name is case-insensitive and can be in snake case, like parameter_change.
+func proposalContentTemplateByName(name string) (types.Content, error) {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), "_", ""))
+	for _, ty := range types.RegisteredProposalContentTypes() {
+		if strings.ToLower(ty) == normalized {
+			content, _ := types.ProposalContentTemplate(ty)
+			return content, nil
+		}
+	}
+
+	return nil, fmt.Errorf("'%s' is not a registered proposal type, types: %s",
+		name, strings.Join(types.RegisteredProposalContentTypes(), ", "))
+}
+
+// draftProposal builds the proposal JSON template by the fields of the content,
+// all the fields are in the template even if they are omitted when empty, and the
+// slices have one element to show the fields of the element.
+func draftProposal(content types.Content, title, description, deposit string) ([]byte, error) {
+	fields := templateObject{
+		{Name: FlagTitle, Value: title},
+		{Name: FlagDescription, Value: description},
+		{Name: flagProposalType, Value: content.ProposalType()},
+	}
+
+	for _, field := range templateValue(reflect.TypeOf(content)).(templateObject) {
+		if field.Name != FlagTitle && field.Name != FlagDescription {
+			fields = append(fields, field)
+		}
+	}
+
+	fields = append(fields, templateField{Name: FlagDeposit, Value: deposit})
+
+	return json.MarshalIndent(fields, "", "  ")
+}
+
+type templateField struct {
+	Name  string
+	Value interface{}
+}
+
+// templateObject is a JSON object which keeps the order of the fields
+type templateObject []templateField
+
+// MarshalJSON implements the json.Marshaler interface
+func (o templateObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// templateValue returns the template of the type, the types with the json marshaler use their zero value
+func templateValue(t reflect.Type) interface{} {
+	if t.Implements(jsonMarshalerType) {
+		return reflect.Zero(t).Interface()
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return templateValue(t.Elem())
+
+	case reflect.Struct:
+		fields := templateObject{}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "-" {
+				continue
+			}
+
+			if name == "" {
+				name = field.Name
+			}
+
+			fields = append(fields, templateField{Name: name, Value: templateValue(field.Type)})
+		}
+		return fields
+
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return ""
+		}
+		return []interface{}{templateValue(t.Elem())}
+
+	case reflect.Map:
+		return map[string]interface{}{}
+
+	case reflect.Int64, reflect.Uint64, reflect.Int, reflect.Uint:
+		// amino encodes the 64-bit integers as strings
+		return "0"
+
+	default:
+		return reflect.Zero(t).Interface()
+	}
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func init() {
+	txutil.RegisterEventFormatter(types.EventTypeSubmitProposal, formatSubmitProposalEvent)
+	txutil.RegisterEventFormatter(types.EventTypeProposalDeposit, formatDepositEvent)
+	txutil.RegisterEventFormatter(types.EventTypeProposalVote, formatVoteEvent)
+	txutil.RegisterEventFormatter(types.EventTypeCancelProposal, formatCancelProposalEvent)
+}
+
+func formatSubmitProposalEvent(event sdk.StringEvent, msgEvents sdk.StringEvents) []string {
+	line := fmt.Sprintf("Proposal %s submitted by %s",
+		txutil.EventAttribute(event, types.AttributeKeyProposalID), txutil.MsgSender(msgEvents))
+
+	if deposit, ok := txutil.FindEvent(msgEvents, types.EventTypeProposalDeposit); ok {
+		if amount := txutil.EventAttribute(deposit, sdk.AttributeKeyAmount); amount != "" {
+			line = fmt.Sprintf("%s with %s deposit", line, amount)
+		}
+	}
+
+	if txutil.EventAttribute(event, types.AttributeKeyVotingPeriodStart) != "" {
+		line += ", voting period started"
+	}
+
+	return []string{line}
+}
+
+func formatDepositEvent(event sdk.StringEvent, msgEvents sdk.StringEvents) []string {
+	// the initial deposit is formatted with the submitted proposal
+	if _, ok := txutil.FindEvent(msgEvents, types.EventTypeSubmitProposal); ok {
+		return nil
+	}
+
+	line := fmt.Sprintf("Deposited %s to proposal %s by %s", txutil.EventAttribute(event, sdk.AttributeKeyAmount),
+		txutil.EventAttribute(event, types.AttributeKeyProposalID), txutil.MsgSender(msgEvents))
+
+	if txutil.EventAttribute(event, types.AttributeKeyVotingPeriodStart) != "" {
+		line += ", voting period started"
+	}
+
+	return []string{line}
+}
+
+func formatVoteEvent(event sdk.StringEvent, msgEvents sdk.StringEvents) []string {
+	return []string{fmt.Sprintf("Voted %s on proposal %s by %s", txutil.EventAttribute(event, types.AttributeKeyOption),
+		txutil.EventAttribute(event, types.AttributeKeyProposalID), txutil.MsgSender(msgEvents))}
+}
+
+func formatCancelProposalEvent(event sdk.StringEvent, _ sdk.StringEvents) []string {
+	return []string{fmt.Sprintf("Proposal %s canceled by %s, %s burned and %s refunded",
+		txutil.EventAttribute(event, types.AttributeKeyProposalID), txutil.EventAttribute(event, types.AttributeKeyProposer),
+		txutil.EventAttribute(event, types.AttributeKeyBurned), txutil.EventAttribute(event, types.AttributeKeyRefunded))}
+}
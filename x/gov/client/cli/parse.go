@@ -1,13 +1,20 @@
 package cli
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/viper"
 
 	govutils "github.com/KuChainNetwork/kuchain/x/gov/client/utils"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
 )
 
 func parseSubmitProposalFlags() (*proposal, error) {
@@ -40,3 +47,112 @@ func parseSubmitProposalFlags() (*proposal, error) {
 
 	return proposal, nil
 }
+
+// content returns the content of the proposal, a multi-content proposal if the contents are given
+func (p *proposal) content(cdc *codec.Codec) (types.Content, error) {
+	if len(p.Contents) == 0 {
+		return types.ContentFromProposalType(p.Title, p.Description, p.Type), nil
+	}
+
+	if p.Type != "" && p.Type != types.ProposalTypeMulti {
+		return nil, fmt.Errorf("proposal type %s provided alongside contents", p.Type)
+	}
+
+	var contents []types.Content
+	if err := cdc.UnmarshalJSON(p.Contents, &contents); err != nil {
+		return nil, fmt.Errorf("unmarshal proposal contents: %w", err)
+	}
+
+	return types.NewMultiContentProposal(p.Title, p.Description, contents), nil
+}
+
+// voteRecord the option to vote for a proposal
+type voteRecord struct {
+	ProposalID uint64
+	Option     types.VoteOption
+}
+
+// parseVoteArgs parses the votes from the args of the vote command, or from the votes file if given
+func parseVoteArgs(args []string, votesFile string) ([]voteRecord, error) {
+	if votesFile != "" {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("--%s flag provided alongside proposal-id and option args", flagVotesFile)
+		}
+
+		f, err := os.Open(votesFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		return parseVotesCSV(f)
+	}
+
+	if len(args) != 3 {
+		return nil, fmt.Errorf("accepts 3 arg(s) without --%s, received %d", flagVotesFile, len(args))
+	}
+
+	vote, err := parseVoteRecord(args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	return []voteRecord{vote}, nil
+}
+
+// parseVotesCSV parses the votes from csv, each row is proposal-id and option,
+// the proposal should not be voted twice in one file.
+func parseVotesCSV(r io.Reader) ([]voteRecord, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = 2
+	reader.Comment = '#'
+
+	res := make([]voteRecord, 0)
+	voted := make(map[uint64]bool)
+	for line := 1; ; line++ {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			// the csv parse error has the line number
+			return nil, err
+		}
+
+		vote, err := parseVoteRecord(fields[0], fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if voted[vote.ProposalID] {
+			return nil, fmt.Errorf("line %d: proposal %d voted more than once", line, vote.ProposalID)
+		}
+		voted[vote.ProposalID] = true
+
+		res = append(res, vote)
+	}
+
+	if len(res) == 0 {
+		return nil, fmt.Errorf("no votes in the votes file")
+	}
+
+	return res, nil
+}
+
+func parseVoteRecord(proposalID, option string) (voteRecord, error) {
+	// validate that the proposal id is a uint
+	id, err := strconv.ParseUint(strings.TrimSpace(proposalID), 10, 64)
+	if err != nil {
+		return voteRecord{}, fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", proposalID)
+	}
+
+	// Find out which vote option user chose
+	voteOption, err := types.VoteOptionFromString(govutils.NormalizeVoteOption(strings.TrimSpace(option)))
+	if err != nil {
+		return voteRecord{}, err
+	}
+
+	return voteRecord{ProposalID: id, Option: voteOption}, nil
+}
@@ -2,12 +2,17 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/KuChainNetwork/kuchain/chain/client/completion"
 	"github.com/KuChainNetwork/kuchain/chain/client/flags"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	gcutils "github.com/KuChainNetwork/kuchain/x/gov/client/utils"
@@ -33,22 +38,27 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryProposal(queryRoute, cdc),
 		GetCmdQueryProposals(queryRoute, cdc),
 		GetCmdQueryVote(queryRoute, cdc),
+		GetCmdQueryVoteProof(cdc),
 		GetCmdQueryVotes(queryRoute, cdc),
 		GetCmdQueryParam(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryProposer(queryRoute, cdc),
 		GetCmdQueryDeposit(queryRoute, cdc),
 		GetCmdQueryDeposits(queryRoute, cdc),
+		GetCmdQueryDepositsHistory(queryRoute, cdc),
 		GetCmdQueryPunishValidators(queryRoute, cdc),
 		GetCmdQueryPunishValidator(queryRoute, cdc),
-		GetCmdQueryTally(queryRoute, cdc))...)
+		GetCmdQueryTally(queryRoute, cdc),
+		GetCmdQueryTallyDetail(queryRoute, cdc),
+		GetCmdQueryVoterPower(queryRoute, cdc),
+		GetCmdExportArchive(queryRoute, cdc))...)
 
 	return govQueryCmd
 }
 
 // GetCmdQueryProposal implements the query proposal command.
 func GetCmdQueryProposal(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "proposal [proposal-id]",
 		Args:  cobra.ExactArgs(1),
 		Short: "Query details of a single proposal",
@@ -56,12 +66,18 @@ func GetCmdQueryProposal(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			fmt.Sprintf(`Query details for a proposal. You can find the
 proposal-id by running "%s query gov proposals".
 
+With --render, the metadata of the proposal, linked by the first ipfs:// or http(s):// link in its
+description, is fetched and rendered as plain text. If the description has the content hash of the
+metadata as sha256:<hex>, the fetched metadata is verified by it.
+
 Example:
 $ %s query kugov proposal 1
+$ %s query kugov proposal 1 --render --ipfs-gateway https://ipfs.io/ipfs/
 `,
-				version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
+		ValidArgsFunction: completion.Args(completeProposalIDs(cdc, types.StatusNil)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -77,11 +93,35 @@ $ %s query kugov proposal 1
 				return err
 			}
 
-			var proposal types.Proposal
+			var proposal types.ProposalWithProgress
 			cdc.MustUnmarshalJSON(res, &proposal)
-			return cliCtx.PrintOutput(proposal) // nolint:errcheck
+
+			if !viper.GetBool(flagRender) {
+				return cliCtx.PrintOutput(proposal) // nolint:errcheck
+			}
+
+			client := &http.Client{Timeout: gcutils.DefaultFetchTimeout}
+			text, notes, err := gcutils.RenderProposal(client,
+				types.Proposal{Content: proposal.Content, ProposalBase: proposal.ProposalBase},
+				viper.GetString(flagIPFSGateway), viper.GetInt64(flagMaxSize))
+			if err != nil {
+				return err
+			}
+
+			for _, note := range notes {
+				_, _ = fmt.Fprintln(os.Stderr, note)
+			}
+
+			_, err = fmt.Fprint(cmd.OutOrStdout(), text)
+			return err
 		},
 	}
+
+	cmd.Flags().Bool(flagRender, false, "fetch the metadata of the proposal and render it as plain text")
+	cmd.Flags().String(flagIPFSGateway, gcutils.DefaultIPFSGateway, "gateway to fetch the ipfs:// metadata links")
+	cmd.Flags().Int64(flagMaxSize, gcutils.DefaultMaxMetadataSize, "max size in bytes of the metadata to fetch")
+
+	return cmd
 }
 
 // GetCmdQueryProposals implements a query proposals command.
@@ -190,6 +230,7 @@ $ %s query kugov vote 1 validator
 				version.ClientName,
 			),
 		),
+		ValidArgsFunction: completion.Args(completeProposalIDs(cdc, types.StatusNil)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -244,6 +285,49 @@ $ %s query kugov vote 1 validator
 	}
 }
 
+// GetCmdQueryVoteProof implements the query vote with merkle proof command.
+func GetCmdQueryVoteProof(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vote-proof [proposal-id] [voter-account]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query a vote with the merkle proof against a block header",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a vote on a proposal with the merkle proof of it in the state,
+and the signed header of the next block, which contains the app hash the proof is against.
+The receipt can be verified by third parties without trusting the node, the vote records
+will be removed after the proposal finished, so query with '--height' for the finished.
+
+Example:
+$ %s query kugov vote-proof 1 validator --height 100
+`,
+				version.ClientName,
+			),
+		),
+		ValidArgsFunction: completion.Args(completeProposalIDs(cdc, types.StatusNil)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			voterAccountID, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			receipt, err := gcutils.QueryVoteReceipt(cliCtx, proposalID, voterAccountID)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(receipt)
+		},
+	}
+}
+
 // GetCmdQueryVotes implements the command to query for proposal votes.
 func GetCmdQueryVotes(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -260,6 +344,7 @@ $ %[1]s query kugov votes 1 --page=2 --limit=100
 				version.ClientName,
 			),
 		),
+		ValidArgsFunction: completion.Args(completeProposalIDs(cdc, types.StatusNil)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -324,6 +409,7 @@ $ %s query kugov deposit 1 validator
 				version.ClientName,
 			),
 		),
+		ValidArgsFunction: completion.Args(completeProposalIDs(cdc, types.StatusNil)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -387,6 +473,7 @@ $ %s query kugov deposits 1
 				version.ClientName, version.ClientName,
 			),
 		),
+		ValidArgsFunction: completion.Args(completeProposalIDs(cdc, types.StatusNil)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -429,6 +516,48 @@ $ %s query kugov deposits 1
 	}
 }
 
+// GetCmdQueryDepositsHistory implements the command to query for how the deposits of a proposal are disposed.
+func GetCmdQueryDepositsHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposits-history [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query how the deposits on a proposal are refunded or burned",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the refunded and burned amount of all deposits on a resolved proposal,
+the deposits are disposed when the proposal is passed, rejected, dropped or canceled.
+
+Example:
+$ %s query kugov deposits-history 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryProposalParams(proposalID))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDepositsHistory), bz)
+			if err != nil {
+				return err
+			}
+
+			var disposals types.DepositDisposals
+			cdc.MustUnmarshalJSON(res, &disposals)
+			return cliCtx.PrintOutput(disposals)
+		},
+	}
+}
+
 // GetCmdQueryTally implements the command to query for proposal tally result.
 func GetCmdQueryTally(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -445,6 +574,7 @@ $ %s query kugov tally 1
 				version.ClientName, version.ClientName,
 			),
 		),
+		ValidArgsFunction: completion.Args(completeProposalIDs(cdc, types.StatusNil)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -480,6 +610,101 @@ $ %s query kugov tally 1
 	}
 }
 
+// GetCmdQueryTallyDetail implements the command to query for the live tally detail of a proposal in voting period.
+func GetCmdQueryTallyDetail(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tally-detail [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the live tally detail of a proposal in voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the live tally detail of a proposal in voting period, the voting power
+applied of each bonded validator, the power inherited from the delegators of the validator,
+and the ratios toward the quorum, threshold and veto.
+
+Example:
+$ %s query kugov tally-detail 1
+`,
+				version.ClientName,
+			),
+		),
+		ValidArgsFunction: completion.Args(completeProposalIDs(cdc, types.StatusVotingPeriod)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryProposalParams(proposalID))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTallyDetail), bz)
+			if err != nil {
+				return err
+			}
+
+			var detail types.TallyDetail
+			cdc.MustUnmarshalJSON(res, &detail)
+			return cliCtx.PrintOutput(detail)
+		},
+	}
+}
+
+// GetCmdQueryVoterPower implements the command to query the effective voting power of a voter on a proposal in voting period.
+func GetCmdQueryVoterPower(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "voter-power [voter] [proposal-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Get the effective voting power of a voter on a proposal in voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the effective voting power of a voter on a proposal in voting period,
+and the delegations of the voter with the votes of the validators they inherit.
+
+The tally only counts the votes of the bonded validators, the vote of a bonded validator
+carries all its bonded tokens, and the delegations of a delegator count by the votes of
+the validators, the vote of a delegator is recorded but does not override them.
+
+Example:
+$ %s query kugov voter-power jack 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			voter, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[1])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryVoteParams(proposalID, voter))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVoterPower), bz)
+			if err != nil {
+				return err
+			}
+
+			var power types.VoterPower
+			cdc.MustUnmarshalJSON(res, &power)
+			return cliCtx.PrintOutput(power)
+		},
+	}
+}
+
 // GetCmdQueryProposal implements the query proposal command.
 func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -497,27 +722,15 @@ $ %s query kugov params
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			tp, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params/tallying", queryRoute), nil)
-			if err != nil {
-				return err
-			}
-			dp, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params/deposit", queryRoute), nil)
-			if err != nil {
-				return err
-			}
-			vp, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params/voting", queryRoute), nil)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParams), nil)
 			if err != nil {
 				return err
 			}
 
-			var tallyParams types.TallyParams
-			cdc.MustUnmarshalJSON(tp, &tallyParams)
-			var depositParams types.DepositParams
-			cdc.MustUnmarshalJSON(dp, &depositParams)
-			var votingParams types.VotingParams
-			cdc.MustUnmarshalJSON(vp, &votingParams)
+			var params types.Params
+			cdc.MustUnmarshalJSON(res, &params)
 
-			return cliCtx.PrintOutput(types.NewParams(votingParams, tallyParams, depositParams))
+			return cliCtx.PrintOutput(params)
 		},
 	}
 }
@@ -527,7 +740,7 @@ func GetCmdQueryParam(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "param [param-type]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query the parameters (voting|tallying|deposit) of the governance process",
+		Short: "Query the parameters (voting|tallying|deposit|expedited|type) of the governance process",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the all the parameters for the governance process.
 
@@ -535,8 +748,10 @@ Example:
 $ %s query kugov param voting
 $ %s query kugov param tallying
 $ %s query kugov param deposit
+$ %s query kugov param expedited
+$ %s query kugov param type
 `,
-				version.ClientName, version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -561,8 +776,16 @@ $ %s query kugov param deposit
 				var param types.DepositParams
 				cdc.MustUnmarshalJSON(res, &param)
 				out = param
+			case "expedited":
+				var param types.ExpeditedParams
+				cdc.MustUnmarshalJSON(res, &param)
+				out = param
+			case "type":
+				var param types.TypeParams
+				cdc.MustUnmarshalJSON(res, &param)
+				out = param
 			default:
-				return fmt.Errorf("argument must be one of (voting|tallying|deposit), was %s", args[0])
+				return fmt.Errorf("argument must be one of (voting|tallying|deposit|expedited|type), was %s", args[0])
 			}
 
 			return cliCtx.PrintOutput(out)
@@ -585,6 +808,7 @@ $ %s query kugov proposer 1
 				version.ClientName,
 			),
 		),
+		ValidArgsFunction: completion.Args(completeProposalIDs(cdc, types.StatusNil)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -687,3 +911,70 @@ $ %s query kugov punish-validator validator
 }
 
 // DONTCOVER
+
+// GetCmdExportArchive implements the command to export all the historical proposals to a directory.
+func GetCmdExportArchive(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-archive",
+		Args:  cobra.NoArgs,
+		Short: "Export all the proposals with their deposits, votes and tally to json files",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export every proposal with its content, deposits, votes and final tally,
+each proposal is written to its own json file named by the proposal id in the output directory.
+The deposits and the votes of the finished proposals are rebuilt from the txs.
+
+Example:
+$ %s query kugov export-archive --output-dir ./archive/
+$ %s query kugov export-archive --output-dir ./archive/ --limit=50
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			outputDir := viper.GetString(flagArchiveOutput)
+			if len(outputDir) == 0 {
+				return fmt.Errorf("--%s is required", flagArchiveOutput)
+			}
+
+			limit := viper.GetInt(flags.FlagLimit)
+			if limit <= 0 {
+				return fmt.Errorf("--%s should be positive", flags.FlagLimit)
+			}
+
+			if err := os.MkdirAll(outputDir, 0755); err != nil {
+				return err
+			}
+
+			proposals, err := gcutils.QueryAllProposals(cliCtx, queryRoute, limit)
+			if err != nil {
+				return err
+			}
+
+			for _, proposal := range proposals {
+				archive, err := gcutils.QueryProposalArchive(cliCtx, queryRoute, proposal, limit)
+				if err != nil {
+					return err
+				}
+
+				bz, err := cdc.MarshalJSONIndent(archive, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				path := filepath.Join(outputDir, fmt.Sprintf("proposal-%d.json", proposal.ProposalID))
+				if err := ioutil.WriteFile(path, bz, 0644); err != nil {
+					return err
+				}
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "exported %d proposals to %s\n", len(proposals), outputDir)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagArchiveOutput, "", "directory to write the proposal json files to")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of proposals and votes fetched by each query")
+	return cmd
+}
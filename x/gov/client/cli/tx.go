@@ -2,10 +2,12 @@ package cli
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/KuChainNetwork/kuchain/chain/client/completion"
 	"github.com/KuChainNetwork/kuchain/chain/client/flags"
 	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
@@ -17,18 +19,25 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // Proposal flags
 const (
-	FlagTitle        = "title"
-	FlagDescription  = "description"
-	flagProposalType = "type"
-	FlagDeposit      = "deposit"
-	flagVoter        = "voter"
-	flagDepositor    = "depositor"
-	flagStatus       = "status"
-	FlagProposal     = "proposal"
+	FlagTitle         = "title"
+	FlagDescription   = "description"
+	flagProposalType  = "type"
+	FlagDeposit       = "deposit"
+	flagVoter         = "voter"
+	flagDepositor     = "depositor"
+	flagStatus        = "status"
+	FlagProposal      = "proposal"
+	flagVotesFile     = "votes-file"
+	FlagExpedited     = "expedited"
+	flagArchiveOutput = "output-dir"
+	flagRender        = "render"
+	flagIPFSGateway   = "ipfs-gateway"
+	flagMaxSize       = "max-size"
 )
 
 type proposal struct {
@@ -36,6 +45,10 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+
+	// Contents the amino json array of the contents executed in order on passage,
+	// the proposal is a multi-content proposal if given.
+	Contents json.RawMessage
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -67,12 +80,17 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 		cmdSubmitProp.AddCommand(flags.PostCommands(pcmd)[0])
 	}
 
-	govTxCmd.AddCommand(flags.PostCommands(
+	govTxCmd.AddCommand(flags.PostCommands(flags.OfflineCommands(
 		GetCmdDeposit(cdc),
 		GetCmdVote(cdc),
-		GetCmdUnJail(cdc),
+		GetCmdWeightedVote(cdc),
 		cmdSubmitProp,
+	)...)...)
+	govTxCmd.AddCommand(flags.PostCommands(
+		GetCmdCancelProposal(cdc),
+		GetCmdUnJail(cdc),
 	)...)
+	govTxCmd.AddCommand(GetCmdDraftProposal(cdc))
 
 	return govTxCmd
 }
@@ -102,8 +120,26 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx kugov submit-proposal jack --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from jack
+
+An expedited proposal needs a higher deposit and quorum to pass in a shorter voting period,
+if the expedited vote fails, it is converted to a normal proposal:
+
+$ %s tx kugov submit-proposal jack --proposal="path/to/proposal.json" --expedited --from jack
+
+A proposal can carry several contents which are executed in order on passage, if any of them
+fails, none of them takes effect. The contents are given in the proposal file:
+
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  "contents": [
+    {"type": "kuchain/TextProposal", "value": {"title": "Text", "description": "text content"}},
+    {"type": "kuchain/ParameterChangeProposal", "value": {"title": "Param", "description": "param change", "changes": [...]}}
+  ],
+  "deposit": "10test"
+}
 `,
-				version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -126,7 +162,10 @@ $ %s tx kugov submit-proposal jack --title="Test Proposal" --description="My awe
 				return sdkerrors.Wrap(err, "proposer account id error")
 			}
 
-			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
+			content, err := proposal.content(cdc)
+			if err != nil {
+				return err
+			}
 
 			proposalAccAddress, err := txutil.QueryAccountAuth(cliCtx, proposerAccount)
 			if err != nil {
@@ -134,6 +173,9 @@ $ %s tx kugov submit-proposal jack --title="Test Proposal" --description="My awe
 			}
 
 			msg := types.NewKuMsgSubmitProposal(proposalAccAddress, content, amount, proposerAccount)
+			if viper.GetBool(FlagExpedited) {
+				msg = types.NewKuMsgSubmitExpeditedProposal(proposalAccAddress, content, amount, proposerAccount)
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -150,6 +192,7 @@ $ %s tx kugov submit-proposal jack --title="Test Proposal" --description="My awe
 	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
 	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Bool(FlagExpedited, false, "submit the proposal as an expedited proposal")
 
 	return cmd
 }
@@ -170,6 +213,7 @@ $ %s tx kugov deposit 1 10stake --from mykey
 				version.ClientName, version.ClientName,
 			),
 		),
+		ValidArgsFunction: completion.Args(nil, completeProposalIDs(cdc, types.StatusDepositPeriod)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
@@ -214,21 +258,95 @@ $ %s tx kugov deposit 1 10stake --from mykey
 
 // GetCmdVote implements creating a new vote command.
 func GetCmdVote(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "vote [voter-account] [proposal-id] [option]",
-		Args:  cobra.ExactArgs(3),
+		Args:  cobra.RangeArgs(1, 3),
 		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a vote for an active proposal. You can
 find the proposal-id by running "%s query gov proposals".
 
+The votes for multiple proposals can be submitted in one transaction by --votes-file,
+the file is a csv file with the rows of proposal-id and option, the proposal-id and
+option args should not be given with it.
+
+For the airgapped voters, the unsigned tx can be built without querying a node by --offline,
+with the account number and the sequence given, the auth of the voter is from --from:
 
 Example:
 $ %s tx kugov vote jack 1 yes --from mykey
+$ %s tx kugov vote jack --votes-file votes.csv --from mykey
+$ %s tx kugov vote jack 1 yes --generate-only --offline --account-number 12 --sequence 3 --from kuchain1... > vote.json
+
+Where votes.csv contains:
+
+1,yes
+2,no_with_veto
+3,abstain
+`,
+				version.ClientName, version.ClientName, version.ClientName, version.ClientName,
+			),
+		),
+		ValidArgsFunction: completion.Args(nil, completeProposalIDs(cdc, types.StatusVotingPeriod), completion.Words("yes", "no", "no_with_veto", "abstain")),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			votes, err := parseVoteArgs(args, viper.GetString(flagVotesFile))
+			if err != nil {
+				return err
+			}
+
+			VoterAccount, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "depositor account id error")
+			}
+			// Get vote address
+			voterAccAddress, err := txutil.QueryAccountAuth(cliCtx, VoterAccount)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", VoterAccount)
+			}
+			// Build vote messages and run basic validation
+			msgs := make([]sdk.Msg, 0, len(votes))
+			for _, vote := range votes {
+				msg := types.NewKuMsgVote(voterAccAddress, VoterAccount, vote.ProposalID, vote.Option)
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+				msgs = append(msgs, msg)
+			}
+			cliCtx = cliCtx.WithFromAccount(VoterAccount)
+			if txBldr.FeePayer().Empty() {
+				txBldr = txBldr.WithPayer(args[0])
+			}
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, msgs)
+		},
+	}
+
+	cmd.Flags().String(flagVotesFile, "", "csv file with the rows of proposal-id and option to vote for multiple proposals")
+
+	return cmd
+}
+
+// GetCmdWeightedVote implements creating a new weighted vote command.
+func GetCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [voter-account] [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Vote for an active proposal split across options, options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal split across multiple options,
+the weights of the options should sum to 1. You can find the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx kugov weighted-vote jack 1 yes=0.6,abstain=0.4 --from mykey
 `,
 				version.ClientName, version.ClientName,
 			),
 		),
+		ValidArgsFunction: completion.Args(nil, completeProposalIDs(cdc, types.StatusVotingPeriod)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
@@ -240,23 +358,23 @@ $ %s tx kugov vote jack 1 yes --from mykey
 				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[1])
 			}
 
-			// Find out which vote option user chose
-			byteVoteOption, err := types.VoteOptionFromString(govutils.NormalizeVoteOption(args[2]))
+			// Figure out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[2]))
 			if err != nil {
 				return err
 			}
 
 			VoterAccount, err := chainTypes.NewAccountIDFromStr(args[0])
 			if err != nil {
-				return sdkerrors.Wrap(err, "depositor account id error")
+				return sdkerrors.Wrap(err, "voter account id error")
 			}
 			// Get vote address
 			voterAccAddress, err := txutil.QueryAccountAuth(cliCtx, VoterAccount)
 			if err != nil {
 				return sdkerrors.Wrapf(err, "query account %s auth error", VoterAccount)
 			}
-			// Build vote message and run basic validation
-			msg := types.NewKuMsgVote(voterAccAddress, VoterAccount, proposalID, byteVoteOption)
+			// Build weighted vote message and run basic validation
+			msg := types.NewKuMsgVoteWeighted(voterAccAddress, VoterAccount, proposalID, options)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -270,6 +388,58 @@ $ %s tx kugov vote jack 1 yes --from mykey
 	}
 }
 
+// GetCmdCancelProposal implements the command to cancel a proposal in deposit period by the proposer.
+func GetCmdCancelProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-proposal [proposer] [proposal-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel a proposal in deposit period by the proposer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal in deposit period, only the proposer of the proposal can cancel it.
+The deposits are refunded to the depositors after burning the part by the cancel burn rate in the gov params.
+
+Example:
+$ %s tx kugov cancel-proposal jack 1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		ValidArgsFunction: completion.Args(nil, completeProposalIDs(cdc, types.StatusDepositPeriod)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[1])
+			}
+
+			proposerAccount, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposer account id error")
+			}
+
+			proposerAccAddress, err := txutil.QueryAccountAuth(cliCtx, proposerAccount)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", proposerAccount)
+			}
+
+			msg := types.NewKuMsgCancelProposal(proposerAccAddress, proposerAccount, proposalID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithFromAccount(proposerAccount)
+			if txBldr.FeePayer().Empty() {
+				txBldr = txBldr.WithPayer(args[0])
+			}
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdVote implements creating a new vote command.
 func GetCmdUnJail(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -0,0 +1,308 @@
+package grpcquery
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/chain/grpcserver"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultLimit the default limit of the paginated queries, same as the legacy querier
+const defaultLimit = 100
+
+var _ types.QueryServer = queryServer{}
+
+// queryServer the gRPC query service of the gov module, served by the legacy querier of the module
+type queryServer struct {
+	cdc     *codec.Codec
+	querier grpcserver.ABCIQuerier
+}
+
+// NewQueryServer creates the gRPC query service of the gov module
+func NewQueryServer(cdc *codec.Codec, querier grpcserver.ABCIQuerier) types.QueryServer {
+	return queryServer{
+		cdc:     cdc,
+		querier: querier,
+	}
+}
+
+// query queries the legacy querier by the path, the params and the result are in amino JSON
+func (s queryServer) query(path string, params, res interface{}) error {
+	var data []byte
+	if params != nil {
+		bz, err := s.cdc.MarshalJSON(params)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		data = bz
+	}
+
+	resp, err := s.querier(abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path),
+		Data: data,
+	})
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	if !resp.IsOK() {
+		if resp.Codespace == types.ModuleName && resp.Code == types.ErrUnknownProposal.ABCICode() {
+			return status.Error(codes.NotFound, resp.Log)
+		}
+		return status.Error(codes.InvalidArgument, resp.Log)
+	}
+
+	if res == nil {
+		return nil
+	}
+
+	if err := s.cdc.UnmarshalJSON(resp.Value, res); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+// paginate returns the range of the page in the results, and the pagination of the response
+func paginate(total int, page *types.PageRequest) (start, end int, res *types.PageResponse) {
+	p, limit := 1, 0
+	if page != nil {
+		if page.Page > 0 {
+			p = int(page.Page)
+		}
+		limit = int(page.Limit)
+	}
+
+	start, end = client.Paginate(total, p, limit, defaultLimit)
+	return start, end, &types.PageResponse{Total: uint64(total)}
+}
+
+func accountID(name, str string) (types.AccountID, error) {
+	if str == "" {
+		return types.AccountID{}, nil
+	}
+
+	id, err := chainTypes.NewAccountIDFromStr(str)
+	if err != nil {
+		return id, status.Errorf(codes.InvalidArgument, "invalid %s %s: %s", name, str, err.Error())
+	}
+
+	return id, nil
+}
+
+func (s queryServer) Proposals(_ context.Context, req *types.QueryProposalsRequest) (*types.QueryProposalsResponse, error) {
+	var (
+		proposalStatus types.ProposalStatus
+		err            error
+	)
+
+	if req.Status != "" {
+		if proposalStatus, err = types.ProposalStatusFromString(req.Status); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	voter, err := accountID("voter", req.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	depositor, err := accountID("depositor", req.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	// query all the proposals matched to get the total
+	var proposals types.Proposals
+	params := types.NewQueryProposalsParams(1, math.MaxInt32, proposalStatus, voter, depositor)
+	if err := s.query(types.QueryProposals, params, &proposals); err != nil {
+		return nil, err
+	}
+
+	start, end, page := paginate(len(proposals), req.Pagination)
+	res := &types.QueryProposalsResponse{Pagination: page}
+	if start < 0 || end < 0 {
+		return res, nil
+	}
+
+	for _, proposal := range proposals[start:end] {
+		info, err := s.proposalInfo(proposal)
+		if err != nil {
+			return nil, err
+		}
+		res.Proposals = append(res.Proposals, info)
+	}
+
+	return res, nil
+}
+
+func (s queryServer) Proposal(_ context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	var proposal types.ProposalWithProgress
+	if err := s.query(types.QueryProposal, types.NewQueryProposalParams(req.ProposalId), &proposal); err != nil {
+		return nil, err
+	}
+
+	info, err := s.proposalInfo(types.Proposal{Content: proposal.Content, ProposalBase: proposal.ProposalBase})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProposalResponse{Proposal: info}, nil
+}
+
+func (s queryServer) Votes(_ context.Context, req *types.QueryVotesRequest) (*types.QueryVotesResponse, error) {
+	var votes types.Votes
+	params := types.NewQueryProposalVotesParams(req.ProposalId, 1, math.MaxInt32)
+	if err := s.query(types.QueryVotes, params, &votes); err != nil {
+		return nil, err
+	}
+
+	start, end, page := paginate(len(votes), req.Pagination)
+	res := &types.QueryVotesResponse{Pagination: page}
+	if start < 0 || end < 0 {
+		return res, nil
+	}
+
+	for _, vote := range votes[start:end] {
+		res.Votes = append(res.Votes, voteInfo(vote))
+	}
+
+	return res, nil
+}
+
+func (s queryServer) Vote(_ context.Context, req *types.QueryVoteRequest) (*types.QueryVoteResponse, error) {
+	voter, err := accountID("voter", req.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	var vote types.Vote
+	if err := s.query(types.QueryVote, types.NewQueryVoteParams(req.ProposalId, voter), &vote); err != nil {
+		return nil, err
+	}
+
+	// the legacy querier returns an empty vote if not found
+	if vote.Voter.Empty() {
+		return nil, status.Errorf(codes.NotFound, "vote of %s on proposal %d not found", req.Voter, req.ProposalId)
+	}
+
+	return &types.QueryVoteResponse{Vote: voteInfo(vote)}, nil
+}
+
+func (s queryServer) Deposits(_ context.Context, req *types.QueryDepositsRequest) (*types.QueryDepositsResponse, error) {
+	var deposits types.Deposits
+	if err := s.query(types.QueryDeposits, types.NewQueryProposalParams(req.ProposalId), &deposits); err != nil {
+		return nil, err
+	}
+
+	start, end, page := paginate(len(deposits), req.Pagination)
+	res := &types.QueryDepositsResponse{Pagination: page}
+	if start < 0 || end < 0 {
+		return res, nil
+	}
+
+	for _, deposit := range deposits[start:end] {
+		res.Deposits = append(res.Deposits, &types.DepositInfo{
+			ProposalId: deposit.ProposalID,
+			Depositor:  deposit.Depositor.String(),
+			Amount:     deposit.Amount.String(),
+		})
+	}
+
+	return res, nil
+}
+
+func (s queryServer) TallyResult(_ context.Context, req *types.QueryTallyResultRequest) (*types.QueryTallyResultResponse, error) {
+	var tally types.TallyResult
+	if err := s.query(types.QueryTally, types.NewQueryProposalParams(req.ProposalId), &tally); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTallyResultResponse{Tally: tallyResultInfo(tally)}, nil
+}
+
+func (s queryServer) Params(_ context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	path := types.QueryParams
+	if req.ParamsType != "" {
+		path = strings.Join([]string{path, req.ParamsType}, "/")
+	}
+
+	resp, err := s.querier(abci.RequestQuery{Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)})
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if !resp.IsOK() {
+		return nil, status.Error(codes.InvalidArgument, resp.Log)
+	}
+
+	return &types.QueryParamsResponse{Params: resp.Value}, nil
+}
+
+func (s queryServer) proposalInfo(proposal types.Proposal) (*types.ProposalInfo, error) {
+	content, err := s.cdc.MarshalJSON(proposal.Content)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.ProposalInfo{
+		ProposalId:       proposal.ProposalID,
+		ContentType:      proposal.ProposalType(),
+		Title:            proposal.GetTitle(),
+		Description:      proposal.GetDescription(),
+		Content:          content,
+		Status:           proposal.Status.String(),
+		FinalTallyResult: tallyResultInfo(proposal.FinalTallyResult),
+		SubmitTime:       formatTime(proposal.SubmitTime),
+		DepositEndTime:   formatTime(proposal.DepositEndTime),
+		TotalDeposit:     proposal.TotalDeposit.String(),
+		VotingStartTime:  formatTime(proposal.VotingStartTime),
+		VotingEndTime:    formatTime(proposal.VotingEndTime),
+		Proposer:         proposal.Proposer.String(),
+		Expedited:        proposal.Expedited,
+	}, nil
+}
+
+func voteInfo(vote types.Vote) *types.VoteInfo {
+	info := &types.VoteInfo{
+		ProposalId: vote.ProposalID,
+		Voter:      vote.Voter.String(),
+		Option:     vote.Option.String(),
+	}
+
+	for _, option := range vote.Options {
+		info.Options = append(info.Options, &types.WeightedVoteOptionInfo{
+			Option: option.Option.String(),
+			Weight: option.Weight.String(),
+		})
+	}
+
+	return info
+}
+
+func tallyResultInfo(tally types.TallyResult) *types.TallyResultInfo {
+	return &types.TallyResultInfo{
+		Yes:        tally.Yes.String(),
+		Abstain:    tally.Abstain.String(),
+		No:         tally.No.String(),
+		NoWithVeto: tally.NoWithVeto.String(),
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,118 @@
+package grpcquery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testQuerier serves the legacy queries by the proposal and the votes in memory
+type testQuerier struct {
+	proposal types.Proposal
+	votes    types.Votes
+}
+
+func (q testQuerier) query(req abci.RequestQuery) (abci.ResponseQuery, error) {
+	cdc := types.Cdc()
+	path := strings.TrimPrefix(req.Path, fmt.Sprintf("custom/%s/", types.QuerierRoute))
+
+	var res interface{}
+	switch path {
+	case types.QueryProposal:
+		var params types.QueryProposalParams
+		cdc.MustUnmarshalJSON(req.Data, &params)
+		if params.ProposalID != q.proposal.ProposalID {
+			err := types.ErrUnknownProposal
+			return abci.ResponseQuery{Codespace: err.Codespace(), Code: err.ABCICode(), Log: err.Error()}, nil
+		}
+		res = types.NewProposalWithProgress(q.proposal, types.ProposalProgress{})
+
+	case types.QueryVotes:
+		res = q.votes
+
+	case types.QueryParams + "/" + types.ParamVoting:
+		res = types.DefaultParams().VotingParams
+
+	default:
+		return abci.ResponseQuery{Code: 1, Log: "unknown path " + path}, nil
+	}
+
+	return abci.ResponseQuery{Value: cdc.MustMarshalJSON(res)}, nil
+}
+
+func startTestServer(t *testing.T, querier testQuerier) types.QueryClient {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	types.RegisterQueryServer(server, NewQueryServer(types.Cdc(), querier.query))
+	go server.Serve(listener) // nolint:errcheck
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return types.NewQueryClient(conn)
+}
+
+func TestQueryServer(t *testing.T) {
+	submitTime := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	proposal := types.NewProposal(types.NewTextProposal("title", "description"), 1, submitTime, submitTime.Add(time.Hour))
+
+	querier := testQuerier{proposal: proposal}
+	for i := 0; i < 5; i++ {
+		voter := chainTypes.NewAccountIDFromName(chainTypes.MustName(fmt.Sprintf("voter%d", i)))
+		querier.votes = append(querier.votes, types.NewVote(1, voter, types.OptionYes))
+	}
+
+	client := startTestServer(t, querier)
+	ctx := context.Background()
+
+	res, err := client.Proposal(ctx, &types.QueryProposalRequest{ProposalId: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Proposal.ProposalId)
+	require.Equal(t, types.ProposalTypeText, res.Proposal.ContentType)
+	require.Equal(t, "title", res.Proposal.Title)
+	require.Equal(t, types.StatusDepositPeriod.String(), res.Proposal.Status)
+	require.Equal(t, "2020-06-01T00:00:00Z", res.Proposal.SubmitTime)
+
+	var content types.Content
+	require.NoError(t, types.Cdc().UnmarshalJSON(res.Proposal.Content, &content))
+	require.Equal(t, "description", content.GetDescription())
+
+	_, err = client.Proposal(ctx, &types.QueryProposalRequest{ProposalId: 2})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	votes, err := client.Votes(ctx, &types.QueryVotesRequest{ProposalId: 1, Pagination: &types.PageRequest{Page: 2, Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), votes.Pagination.Total)
+	require.Len(t, votes.Votes, 2)
+	require.Equal(t, "voter2", votes.Votes[0].Voter)
+	require.Equal(t, types.OptionYes.String(), votes.Votes[0].Option)
+
+	votes, err = client.Votes(ctx, &types.QueryVotesRequest{ProposalId: 1, Pagination: &types.PageRequest{Page: 4, Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), votes.Pagination.Total)
+	require.Empty(t, votes.Votes)
+
+	params, err := client.Params(ctx, &types.QueryParamsRequest{ParamsType: types.ParamVoting})
+	require.NoError(t, err)
+	var votingParams types.VotingParams
+	require.NoError(t, types.Cdc().UnmarshalJSON(params.Params, &votingParams))
+	require.Equal(t, types.DefaultParams().VotingParams, votingParams)
+
+	_, err = client.Params(ctx, &types.QueryParamsRequest{ParamsType: "unknown"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
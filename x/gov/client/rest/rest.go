@@ -40,13 +40,14 @@ type PostProposalReq struct {
 	Description    string       `json:"description" yaml:"description"`         // Description of the proposal
 	InitialDeposit string       `json:"initial_deposit" yaml:"initial_deposit"` // Coins to add to the proposal's deposit
 	ProposerAcc    string       `json:"proposer_acc" yaml:"proposer_acc"`       // account of the proposer
+	Expedited      bool         `json:"expedited" yaml:"expedited"`             // submit as an expedited proposal
 }
 
 // DepositReq defines the properties of a deposit request's body.
 type DepositReq struct {
 	ProposalId string       `json:"proposal_id" yaml:"proposal_id"`
 	BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
-	Depositor  string       `json:"depositor" yaml:"depositor"` // Address of the depositor
+	Depositor  string       `json:"depositor" yaml:"depositor"` // account name or address of the depositor
 	Amount     string       `json:"amount" yaml:"amount"`       // Coins to add to the proposal's deposit
 }
 
@@ -57,3 +58,24 @@ type VoteReq struct {
 	Voter      string       `json:"voter" yaml:"voter"`
 	Option     string       `json:"option" yaml:"option"`
 }
+
+// WeightedVoteReq defines the properties of a weighted vote request's body.
+type WeightedVoteReq struct {
+	ProposalId string       `json:"proposal_id" yaml:"proposal_id"`
+	BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Voter      string       `json:"voter" yaml:"voter"`
+	Options    string       `json:"options" yaml:"options"` // Weighted options like "yes=0.6,abstain=0.4"
+}
+
+// CancelProposalReq defines the properties of a cancel proposal request's body.
+type CancelProposalReq struct {
+	ProposalId string       `json:"proposal_id" yaml:"proposal_id"`
+	BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Proposer   string       `json:"proposer" yaml:"proposer"`
+}
+
+// UnjailReq defines the properties of a gov unjail request's body.
+type UnjailReq struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	ValidatorAcc string       `json:"validator_acc" yaml:"validator_acc"` // account name or address of the validator
+}
@@ -25,6 +25,9 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, phs []ProposalRE
 	r.HandleFunc("/gov/proposals", postProposalHandlerFn(kuCliCtx)).Methods("POST")
 	r.HandleFunc("/gov/deposits", depositHandlerFn(kuCliCtx)).Methods("POST")
 	r.HandleFunc("/gov/votes", voteHandlerFn(kuCliCtx)).Methods("POST")
+	r.HandleFunc("/gov/weighted_votes", weightedVoteHandlerFn(kuCliCtx)).Methods("POST")
+	r.HandleFunc("/gov/cancel_proposals", cancelProposalHandlerFn(kuCliCtx)).Methods("POST")
+	r.HandleFunc("/gov/unjail", unjailHandlerFn(kuCliCtx)).Methods("POST")
 }
 
 func postProposalHandlerFn(cliCtx txutil.KuCLIContext) http.HandlerFunc {
@@ -57,6 +60,9 @@ func postProposalHandlerFn(cliCtx txutil.KuCLIContext) http.HandlerFunc {
 		}
 
 		msg := types.NewKuMsgSubmitProposal(proposalAccAddress, content, deposit, proposerAccount)
+		if req.Expedited {
+			msg = types.NewKuMsgSubmitExpeditedProposal(proposalAccAddress, content, deposit, proposerAccount)
+		}
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -159,3 +165,123 @@ func voteHandlerFn(cliCtx txutil.KuCLIContext) http.HandlerFunc {
 		txutil.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func weightedVoteHandlerFn(cliCtx txutil.KuCLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req WeightedVoteReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if len(req.ProposalId) == 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "proposalId required but not specified")
+			return
+		}
+
+		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, req.ProposalId)
+		if !ok {
+			return
+		}
+
+		options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(req.Options))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		VoterAccount, err := chainTypes.NewAccountIDFromStr(req.Voter)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("voter account id error, %v", err))
+			return
+		}
+
+		voterAccAddress, err := txutil.QueryAccountAuth(cliCtx, VoterAccount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("query account %s auth error, %v", VoterAccount, err))
+			return
+		}
+
+		msg := types.NewKuMsgVoteWeighted(voterAccAddress, VoterAccount, proposalID, options)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txutil.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func cancelProposalHandlerFn(cliCtx txutil.KuCLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if len(req.ProposalId) == 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "proposalId required but not specified")
+			return
+		}
+
+		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, req.ProposalId)
+		if !ok {
+			return
+		}
+
+		proposerAccount, err := chainTypes.NewAccountIDFromStr(req.Proposer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("proposer account id error, %v", err))
+			return
+		}
+
+		proposerAccAddress, err := txutil.QueryAccountAuth(cliCtx, proposerAccount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("query account %s auth error, %v", proposerAccount, err))
+			return
+		}
+
+		msg := types.NewKuMsgCancelProposal(proposerAccAddress, proposerAccount, proposalID)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txutil.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func unjailHandlerFn(cliCtx txutil.KuCLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UnjailReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		validatorAccount, err := chainTypes.NewAccountIDFromStr(req.ValidatorAcc)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("validator account id error, %v", err))
+			return
+		}
+
+		validatorAccAddress, err := txutil.QueryAccountAuth(cliCtx, validatorAccount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("query account %s auth error, %v", validatorAccount, err))
+			return
+		}
+
+		msg := types.NewMsgGovUnjail(validatorAccAddress, validatorAccount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txutil.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
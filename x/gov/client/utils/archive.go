@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// ProposalArchive contains the full record of a governance proposal, used for
+// exporting the historical proposals.
+type ProposalArchive struct {
+	Proposal types.Proposal    `json:"proposal" yaml:"proposal"`
+	Deposits types.Deposits    `json:"deposits" yaml:"deposits"`
+	Votes    types.Votes       `json:"votes" yaml:"votes"`
+	Tally    types.TallyResult `json:"tally" yaml:"tally"`
+}
+
+// QueryAllProposals fetches all the proposals page by page, limit is the number
+// of the proposals fetched by each query.
+func QueryAllProposals(cliCtx context.CLIContext, queryRoute string, limit int) (types.Proposals, error) {
+	var proposals types.Proposals
+
+	for page := defaultPage; ; page++ {
+		params := types.NewQueryProposalsParams(page, limit, types.StatusNil, types.AccountID{}, types.AccountID{})
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			return nil, err
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProposals), bz)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageProposals types.Proposals
+		if err := cliCtx.Codec.UnmarshalJSON(res, &pageProposals); err != nil {
+			return nil, err
+		}
+
+		proposals = append(proposals, pageProposals...)
+		if len(pageProposals) < limit {
+			return proposals, nil
+		}
+	}
+}
+
+// QueryProposalArchive builds the archive of the proposal, the deposits and the votes
+// of the finished proposals are rebuilt from the txs as they are removed from the store.
+func QueryProposalArchive(cliCtx context.CLIContext, queryRoute string, proposal types.Proposal, limit int) (ProposalArchive, error) {
+	archive := ProposalArchive{Proposal: proposal}
+	finished := !(proposal.Status == types.StatusVotingPeriod || proposal.Status == types.StatusDepositPeriod)
+
+	proposalParams := types.NewQueryProposalParams(proposal.ProposalID)
+	bz, err := cliCtx.Codec.MarshalJSON(proposalParams)
+	if err != nil {
+		return archive, err
+	}
+
+	var res []byte
+	if finished {
+		res, err = QueryDepositsByTxQuery(cliCtx, proposalParams)
+	} else {
+		res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDeposits), bz)
+	}
+	if err != nil {
+		return archive, fmt.Errorf("failed to fetch deposits of proposal %d: %s", proposal.ProposalID, err)
+	}
+	if err := cliCtx.Codec.UnmarshalJSON(res, &archive.Deposits); err != nil {
+		return archive, err
+	}
+
+	for page := defaultPage; ; page++ {
+		votesParams := types.NewQueryProposalVotesParams(proposal.ProposalID, page, limit)
+		if finished {
+			res, err = QueryVotesByTxQuery(cliCtx, votesParams)
+		} else {
+			var votesBz []byte
+			if votesBz, err = cliCtx.Codec.MarshalJSON(votesParams); err != nil {
+				return archive, err
+			}
+			res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVotes), votesBz)
+		}
+		if err != nil {
+			return archive, fmt.Errorf("failed to fetch votes of proposal %d: %s", proposal.ProposalID, err)
+		}
+
+		var votes types.Votes
+		if err := cliCtx.Codec.UnmarshalJSON(res, &votes); err != nil {
+			return archive, err
+		}
+
+		archive.Votes = append(archive.Votes, votes...)
+		if len(votes) < limit {
+			break
+		}
+	}
+
+	res, _, err = cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTally), bz)
+	if err != nil {
+		return archive, fmt.Errorf("failed to fetch tally of proposal %d: %s", proposal.ProposalID, err)
+	}
+	if err := cliCtx.Codec.UnmarshalJSON(res, &archive.Tally); err != nil {
+		return archive, err
+	}
+
+	return archive, nil
+}
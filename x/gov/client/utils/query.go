@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
@@ -8,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
 )
 
 const (
@@ -82,40 +84,38 @@ func QueryDepositsByTxQuery(cliCtx context.CLIContext, params types.QueryProposa
 // marshalled result or any error that occurred.
 func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalVotesParams) ([]byte, error) {
 	var (
-		events = []string{
-			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		}
 		votes      []types.Vote
-		nextTxPage = defaultPage
 		totalLimit = params.Limit * params.Page
 	)
-	// query interrupted either if we collected enough votes or tx indexer run out of relevant txs
-	for len(votes) < totalLimit {
-		searchResult, err := txutil.QueryTxsByEvents(cliCtx, events, nextTxPage, defaultLimit)
-		if err != nil {
-			return nil, err
+	// the votes and the weighted votes are searched by their own actions
+	for _, action := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+		events := []string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, action),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		}
-		nextTxPage++
-		for _, info := range searchResult.Txs {
-			for _, msg := range info.Tx.GetMsgs() {
-				if msg.Type() == types.TypeMsgVote {
-					voteMsg := msg.(types.KuMsgVote)
-					msgData := types.MsgVote{}
-					if err := voteMsg.UnmarshalData(types.Cdc(), &msgData); err != nil {
+		nextTxPage := defaultPage
+		// query interrupted either if we collected enough votes or tx indexer run out of relevant txs
+		for len(votes) < totalLimit {
+			searchResult, err := txutil.QueryTxsByEvents(cliCtx, events, nextTxPage, defaultLimit)
+			if err != nil {
+				return nil, err
+			}
+			nextTxPage++
+			for _, info := range searchResult.Txs {
+				for _, msg := range info.Tx.GetMsgs() {
+					vote, ok, err := voteFromMsg(msg, params.ProposalID)
+					if err != nil {
 						return cliCtx.Codec.MarshalJSON(votes)
 					}
 
-					votes = append(votes, types.Vote{
-						Voter:      msgData.Voter,
-						ProposalID: params.ProposalID,
-						Option:     msgData.Option,
-					})
+					if ok {
+						votes = append(votes, vote)
+					}
 				}
 			}
-		}
-		if len(searchResult.Txs) != defaultLimit {
-			break
+			if len(searchResult.Txs) != defaultLimit {
+				break
+			}
 		}
 	}
 	start, end := client.Paginate(len(votes), params.Page, params.Limit, 100)
@@ -132,32 +132,29 @@ func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalVo
 
 // QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
 func QueryVoteByTxQuery(cliCtx context.CLIContext, params types.QueryVoteParams) ([]byte, error) {
-	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
-	}
+	for _, action := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+		events := []string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, action),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
+		}
 
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	searchResult, err := txutil.QueryTxsByEvents(cliCtx, events, defaultPage, defaultLimit)
-	if err != nil {
-		return nil, err
-	}
-	for _, info := range searchResult.Txs {
-		for _, msg := range info.Tx.GetMsgs() {
-			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(types.KuMsgVote)
-				msgData := types.MsgVote{}
-				if err := voteMsg.UnmarshalData(types.Cdc(), &msgData); err != nil {
+		// NOTE: SearchTxs is used to facilitate the txs query which does not currently
+		// support configurable pagination.
+		searchResult, err := txutil.QueryTxsByEvents(cliCtx, events, defaultPage, defaultLimit)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range searchResult.Txs {
+			for _, msg := range info.Tx.GetMsgs() {
+				// there should only be a single vote under the given conditions
+				vote, ok, err := voteFromMsg(msg, params.ProposalID)
+				if err != nil {
 					return nil, err
 				}
 
-				vote := types.Vote{
-					Voter:      msgData.Voter,
-					ProposalID: params.ProposalID,
-					Option:     msgData.Option,
+				if !ok {
+					continue
 				}
 
 				if cliCtx.Indent {
@@ -172,6 +169,31 @@ func QueryVoteByTxQuery(cliCtx context.CLIContext, params types.QueryVoteParams)
 	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
 }
 
+// voteFromMsg builds the vote from the vote msg or the weighted vote msg, returns false if the msg is not a vote.
+func voteFromMsg(msg sdk.Msg, proposalID uint64) (types.Vote, bool, error) {
+	switch msg.Type() {
+	case types.TypeMsgVote:
+		voteMsg := msg.(types.KuMsgVote)
+		msgData := types.MsgVote{}
+		if err := voteMsg.UnmarshalData(types.Cdc(), &msgData); err != nil {
+			return types.Vote{}, false, err
+		}
+
+		return types.NewVote(proposalID, msgData.Voter, msgData.Option), true, nil
+
+	case types.TypeMsgVoteWeighted:
+		voteMsg := msg.(types.KuMsgVoteWeighted)
+		msgData := types.MsgVoteWeighted{}
+		if err := voteMsg.UnmarshalData(types.Cdc(), &msgData); err != nil {
+			return types.Vote{}, false, err
+		}
+
+		return types.NewWeightedVote(proposalID, msgData.Voter, msgData.Options), true, nil
+	}
+
+	return types.Vote{}, false, nil
+}
+
 // QueryDepositByTxQuery will query for a single deposit via a direct txs tags
 // query.
 func QueryDepositByTxQuery(cliCtx context.CLIContext, params types.QueryDepositParams) ([]byte, error) {
@@ -263,3 +285,69 @@ func QueryProposalByID(proposalID uint64, cliCtx context.CLIContext, queryRoute
 
 	return res, err
 }
+
+// QueryVoteReceipt queries the vote with the merkle proof of it in the gov store, and the
+// header of the next block which has the app hash the proof against, the receipt will be
+// verified before returned.
+//
+// NOTE: the next block should be committed, so query at the height before latest.
+func QueryVoteReceipt(cliCtx context.CLIContext, proposalID uint64, voter types.AccountID) (types.VoteReceipt, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return types.VoteReceipt{}, err
+	}
+
+	key := types.VoteKey(proposalID, voter)
+	res, err := node.ABCIQueryWithOptions(
+		fmt.Sprintf("/store/%s/key", types.StoreKey), key,
+		rpcclient.ABCIQueryOptions{Height: cliCtx.Height, Prove: true})
+	if err != nil {
+		return types.VoteReceipt{}, err
+	}
+
+	resp := res.Response
+	if !resp.IsOK() {
+		return types.VoteReceipt{}, fmt.Errorf("query vote proof failed: %s", resp.Log)
+	}
+
+	if len(resp.Value) == 0 {
+		return types.VoteReceipt{}, fmt.Errorf("vote of %s on proposal %d not found at height %d", voter, proposalID, resp.Height)
+	}
+
+	var vote types.Vote
+	if err := cliCtx.Codec.UnmarshalBinaryBare(resp.Value, &vote); err != nil {
+		return types.VoteReceipt{}, err
+	}
+
+	headerHeight := resp.Height + 1
+	commit, err := node.Commit(&headerHeight)
+	if err != nil {
+		return types.VoteReceipt{}, fmt.Errorf("query header at height %d failed: %s", headerHeight, err)
+	}
+
+	receipt := types.NewVoteReceipt(vote, resp.Height, key, resp.Value, resp.Proof, &commit.SignedHeader)
+	if err := receipt.Verify(cliCtx.Codec); err != nil {
+		return types.VoteReceipt{}, err
+	}
+
+	return receipt, nil
+}
+
+// ProposalIDFromTxResponse gets the id of the proposal created by the submit proposal tx from its response data
+func ProposalIDFromTxResponse(res sdk.TxResponse) (uint64, error) {
+	if res.Data == "" {
+		return 0, fmt.Errorf("no data in tx response %s", res.TxHash)
+	}
+
+	data, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return 0, err
+	}
+
+	msgRes, err := types.UnmarshalMsgSubmitProposalResponse(data)
+	if err != nil {
+		return 0, err
+	}
+
+	return msgRes.ProposalID, nil
+}
@@ -0,0 +1,203 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+)
+
+const (
+	// DefaultIPFSGateway the gateway to fetch the ipfs:// metadata links
+	DefaultIPFSGateway = "https://ipfs.io/ipfs/"
+
+	// DefaultMaxMetadataSize the max size in bytes of the metadata fetched
+	DefaultMaxMetadataSize int64 = 1 << 20
+
+	// DefaultFetchTimeout the timeout to fetch the metadata
+	DefaultFetchTimeout = 30 * time.Second
+)
+
+var (
+	// the metadata link is the first ipfs or http link in the description of the proposal
+	metadataLinkRegexp = regexp.MustCompile(`(ipfs|https?)://[^\s()<>\[\]"']+`)
+
+	// the content hash of the metadata is given in the description as sha256:<hex>
+	contentHashRegexp = regexp.MustCompile(`sha256:([0-9a-fA-F]{64})`)
+)
+
+// MetadataLink returns the metadata link of the proposal, which is the first ipfs or http link
+// in the description, returns false if no link.
+func MetadataLink(description string) (string, bool) {
+	link := metadataLinkRegexp.FindString(description)
+	return strings.TrimRight(link, ".,;:"), link != ""
+}
+
+// ContentHash returns the sha256 hash of the metadata given in the description as sha256:<hex>,
+// returns false if no hash.
+func ContentHash(description string) ([]byte, bool) {
+	m := contentHashRegexp.FindStringSubmatch(description)
+	if m == nil {
+		return nil, false
+	}
+
+	hash, err := hex.DecodeString(m[1])
+	if err != nil {
+		return nil, false
+	}
+
+	return hash, true
+}
+
+// VerifyContentHash checks the sha256 hash of the content is the hash
+func VerifyContentHash(content, hash []byte) error {
+	got := sha256.Sum256(content)
+	if !bytes.Equal(got[:], hash) {
+		return fmt.Errorf("metadata hash mismatch, expected sha256:%x, got sha256:%x", hash, got)
+	}
+
+	return nil
+}
+
+// FetchMetadata fetches the metadata by the link, the ipfs links are fetched by the gateway,
+// the metadata larger than maxSize is rejected.
+func FetchMetadata(client *http.Client, link, gateway string, maxSize int64) ([]byte, error) {
+	url := link
+	if strings.HasPrefix(link, "ipfs://") {
+		url = strings.TrimRight(gateway, "/") + "/" + strings.TrimPrefix(link, "ipfs://")
+	}
+
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch %s: %s", url, resp.Status)
+	}
+
+	if resp.ContentLength > maxSize {
+		return nil, fmt.Errorf("metadata of %d bytes exceeds the max size %d", resp.ContentLength, maxSize)
+	}
+
+	// read one more byte to find the metadata exceeding the max size with no content length
+	content, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("metadata exceeds the max size %d", maxSize)
+	}
+
+	return content, nil
+}
+
+// RenderProposal renders the proposal with its metadata as plain text for the terminal, the metadata
+// is fetched by the link in the description and verified by the content hash if given, the description
+// is rendered if no metadata link.
+func RenderProposal(client *http.Client, proposal types.Proposal, gateway string, maxSize int64) (string, []string, error) {
+	var notes []string
+
+	body := proposal.GetDescription()
+	if link, ok := MetadataLink(body); ok {
+		content, err := FetchMetadata(client, link, gateway, maxSize)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if hash, ok := ContentHash(proposal.GetDescription()); ok {
+			if err := VerifyContentHash(content, hash); err != nil {
+				return "", nil, err
+			}
+			notes = append(notes, fmt.Sprintf("metadata %s verified by the content hash", link))
+		} else {
+			notes = append(notes, fmt.Sprintf("metadata %s not verified, no content hash in the description", link))
+		}
+
+		body = string(content)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Proposal %d: %s\n", proposal.ProposalID, proposal.GetTitle())
+	fmt.Fprintf(&b, "Type: %s, Status: %s\n\n", proposal.ProposalType(), proposal.Status)
+	b.WriteString(RenderMarkdown(body))
+
+	return b.String(), notes, nil
+}
+
+var (
+	headingRegexp    = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	listItemRegexp   = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	ruleRegexp       = regexp.MustCompile(`^\s*([-*_])(\s*([-*_]))*\s*$`)
+	imageRegexp      = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)[^)]*\)`)
+	linkRegexp       = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)[^)]*\)`)
+	strongRegexp     = regexp.MustCompile(`(\*\*|__)(\S(?:.*?\S)?)(\*\*|__)`)
+	emphasisRegexp   = regexp.MustCompile(`\*(\S(?:[^*]*?\S)?)\*`)
+	inlineCodeRegexp = regexp.MustCompile("`([^`]+)`")
+)
+
+// RenderMarkdown renders the markdown as plain text for the terminal, the headings are underlined,
+// the code blocks are indented, and the markups of the links and the emphasis are removed.
+func RenderMarkdown(md string) string {
+	var b strings.Builder
+
+	inCode := false
+	for _, line := range strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+			continue
+		}
+
+		if inCode {
+			b.WriteString("    " + line + "\n")
+			continue
+		}
+
+		switch {
+		case headingRegexp.MatchString(line):
+			m := headingRegexp.FindStringSubmatch(line)
+			text := renderInline(m[2])
+			switch len(m[1]) {
+			case 1:
+				b.WriteString(strings.ToUpper(text) + "\n" + strings.Repeat("=", len(text)) + "\n")
+			case 2:
+				b.WriteString(text + "\n" + strings.Repeat("-", len(text)) + "\n")
+			default:
+				b.WriteString(text + "\n")
+			}
+
+		case ruleRegexp.MatchString(line) && len(strings.TrimSpace(line)) >= 3:
+			b.WriteString(strings.Repeat("-", 40) + "\n")
+
+		case listItemRegexp.MatchString(line):
+			m := listItemRegexp.FindStringSubmatch(line)
+			b.WriteString(m[1] + "  * " + renderInline(m[2]) + "\n")
+
+		case strings.HasPrefix(strings.TrimSpace(line), ">"):
+			b.WriteString("  | " + renderInline(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), ">"))) + "\n")
+
+		default:
+			b.WriteString(renderInline(line) + "\n")
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+func renderInline(text string) string {
+	text = imageRegexp.ReplaceAllString(text, "[image: $1] ($2)")
+	text = linkRegexp.ReplaceAllString(text, "$1 ($2)")
+	text = strongRegexp.ReplaceAllString(text, "$2")
+	text = emphasisRegexp.ReplaceAllString(text, "$1")
+	return inlineCodeRegexp.ReplaceAllString(text, "$1")
+}
@@ -0,0 +1,72 @@
+package utils_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/KuChainNetwork/kuchain/x/gov/client/utils"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+)
+
+const metadata = "# Upgrade\n\nSee the [plan](https://example.com/plan) for **details**.\n\n- step one\n"
+
+func TestRenderProposal(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/large" {
+			_, _ = w.Write([]byte(strings.Repeat("a", 64)))
+			return
+		}
+		_, _ = w.Write([]byte(metadata))
+	}))
+	defer srv.Close()
+
+	newProposal := func(description string) types.Proposal {
+		return types.NewProposal(types.NewTextProposal("upgrade", description), 1, time.Now(), time.Now())
+	}
+
+	hash := sha256.Sum256([]byte(metadata))
+
+	text, notes, err := utils.RenderProposal(srv.Client(),
+		newProposal(fmt.Sprintf("metadata %s/doc.md sha256:%x", srv.URL, hash)), utils.DefaultIPFSGateway, 1024)
+	require.NoError(t, err)
+	require.Len(t, notes, 1)
+	require.Contains(t, notes[0], "verified by the content hash")
+	require.Contains(t, text, "Proposal 1: upgrade")
+	require.Contains(t, text, "UPGRADE\n=======")
+	require.Contains(t, text, "See the plan (https://example.com/plan) for details.")
+	require.Contains(t, text, "  * step one")
+
+	// the unverified metadata is rendered with a note
+	_, notes, err = utils.RenderProposal(srv.Client(),
+		newProposal(fmt.Sprintf("metadata %s/doc.md", srv.URL)), utils.DefaultIPFSGateway, 1024)
+	require.NoError(t, err)
+	require.Contains(t, notes[0], "not verified")
+
+	// the hash mismatched
+	_, _, err = utils.RenderProposal(srv.Client(),
+		newProposal(fmt.Sprintf("metadata %s/doc.md sha256:%s", srv.URL, strings.Repeat("00", 32))), utils.DefaultIPFSGateway, 1024)
+	require.Error(t, err)
+
+	// the metadata too large
+	_, _, err = utils.RenderProposal(srv.Client(),
+		newProposal(fmt.Sprintf("metadata %s/large", srv.URL)), utils.DefaultIPFSGateway, 32)
+	require.Error(t, err)
+
+	// the ipfs links are fetched by the gateway
+	content, err := utils.FetchMetadata(srv.Client(), "ipfs://QmHash", srv.URL+"/ipfs/", 1024)
+	require.NoError(t, err)
+	require.Equal(t, metadata, string(content))
+
+	// the description is rendered if no metadata link
+	text, notes, err = utils.RenderProposal(srv.Client(), newProposal("just `text`"), utils.DefaultIPFSGateway, 1024)
+	require.NoError(t, err)
+	require.Empty(t, notes)
+	require.Contains(t, text, "just text")
+}
@@ -13,6 +13,8 @@ func InitGenesis(ctx sdk.Context, bk types.BankKeeper, supplyKeeper types.Supply
 	k.SetDepositParams(ctx, data.DepositParams)
 	k.SetVotingParams(ctx, data.VotingParams)
 	k.SetTallyParams(ctx, data.TallyParams)
+	k.SetExpeditedParams(ctx, data.ExpeditedParams)
+	k.SetTypeParams(ctx, data.TypeParams)
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -51,6 +53,8 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	depositParams := k.GetDepositParams(ctx)
 	votingParams := k.GetVotingParams(ctx)
 	tallyParams := k.GetTallyParams(ctx)
+	expeditedParams := k.GetExpeditedParams(ctx)
+	typeParams := k.GetTypeParams(ctx)
 	proposals := k.GetProposals(ctx)
 
 	var proposalsDeposits Deposits
@@ -71,5 +75,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
 		TallyParams:        tallyParams,
+		ExpeditedParams:    expeditedParams,
+		TypeParams:         typeParams,
 	}
 }
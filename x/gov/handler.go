@@ -1,145 +1,94 @@
 package gov
 
 import (
-	"fmt"
-
 	"github.com/KuChainNetwork/kuchain/chain/msg"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/gov/keeper"
 	"github.com/KuChainNetwork/kuchain/x/gov/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func NewHandler(k Keeper) msg.Handler {
+	server := keeper.NewMsgServerImpl(k)
+
 	return func(ctx chainTypes.Context, msg sdk.Msg) (*sdk.Result, error) {
 		switch msg := msg.(type) {
 		case types.KuMsgSubmitProposal:
 			ctx.RequireAuth(msg.GetProposerAccountID())
-			return handleMsgSubmitProposal(ctx.Context(), k, msg)
+			res, err := server.SubmitProposal(ctx.Context(), msg)
+			return newResult(ctx.Context(), res, err)
 		case types.KuMsgDeposit:
-			return handleKuMsgDeposit(ctx, k, msg)
+			return handleKuMsgDeposit(ctx, server, msg)
 		case types.KuMsgVote:
-			return handleKuMsgVote(ctx, k, msg)
+			return handleKuMsgVote(ctx, server, msg)
+		case types.KuMsgVoteWeighted:
+			return handleKuMsgVoteWeighted(ctx, server, msg)
+		case types.KuMsgCancelProposal:
+			return handleKuMsgCancelProposal(ctx, server, msg)
 		case types.MsgGovUnJail:
-			return handleMsgGovUnJail(ctx, k, msg)
+			return handleMsgGovUnJail(ctx, server, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
 	}
 }
 
-func handleKuMsgDeposit(ctx chainTypes.Context, k Keeper, msg types.KuMsgDeposit) (*sdk.Result, error) {
+// newResult encodes the typed response of the msg server to the result data
+func newResult(ctx sdk.Context, res chainTypes.MsgResponse, err error) (*sdk.Result, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return chainTypes.NewMsgResult(types.ModuleCdc, res, ctx.EventManager().Events()), nil
+}
+
+func handleKuMsgDeposit(ctx chainTypes.Context, server keeper.MsgServer, msg types.KuMsgDeposit) (*sdk.Result, error) {
 	msgData := types.MsgDeposit{}
 	if err := msg.UnmarshalData(types.Cdc(), &msgData); err != nil {
 		return nil, sdkerrors.Wrapf(err, "msg MsgDeposit  data unmarshal error")
 	}
 	ctx.RequireAuth(msgData.Depositor)
-	return handleMsgDeposit(ctx.Context(), k, msgData)
+	res, err := server.Deposit(ctx.Context(), msgData)
+	return newResult(ctx.Context(), res, err)
 }
 
-func handleKuMsgVote(ctx chainTypes.Context, k Keeper, msg types.KuMsgVote) (*sdk.Result, error) {
+func handleKuMsgVote(ctx chainTypes.Context, server keeper.MsgServer, msg types.KuMsgVote) (*sdk.Result, error) {
 	msgData := types.MsgVote{}
 	if err := msg.UnmarshalData(types.Cdc(), &msgData); err != nil {
 		return nil, sdkerrors.Wrapf(err, "msg MsgVote  data unmarshal error")
 	}
 	ctx.RequireAuth(msgData.Voter)
-	return handleMsgVote(ctx.Context(), k, msgData)
+	res, err := server.Vote(ctx.Context(), msgData)
+	return newResult(ctx.Context(), res, err)
 }
 
-func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposalI) (*sdk.Result, error) {
-	proposal, err := keeper.SubmitProposal(ctx, msg.GetContent())
-	if err != nil {
-		return nil, err
-	}
-
-	votingStarted, err := keeper.AddDeposit(ctx, proposal.ProposalID, msg.GetProposerAccountID(), msg.GetInitialDeposit())
-	if err != nil {
-		return nil, err
-	}
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.GetProposer().String()),
-		),
-	)
-
-	submitEvent := sdk.NewEvent(types.EventTypeSubmitProposal, sdk.NewAttribute(types.AttributeKeyProposalType, msg.GetContent().ProposalType()))
-	if votingStarted {
-		submitEvent = submitEvent.AppendAttributes(
-			sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.ProposalID)),
-		)
-	}
-	ctx.EventManager().EmitEvent(submitEvent)
-
-	return &sdk.Result{
-		Data:   GetProposalIDBytes(proposal.ProposalID),
-		Events: ctx.EventManager().Events(),
-	}, nil
-}
-
-func handleMsgDeposit(ctx sdk.Context, keeper Keeper, msg MsgDeposit) (*sdk.Result, error) {
-	votingStarted, err := keeper.AddDeposit(ctx, msg.ProposalID, msg.Depositor, msg.Amount)
-	if err != nil {
-		return nil, err
-	}
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor.String()),
-		),
-	)
-
-	if votingStarted {
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeProposalDeposit,
-				sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", msg.ProposalID)),
-			),
-		)
+func handleKuMsgVoteWeighted(ctx chainTypes.Context, server keeper.MsgServer, msg types.KuMsgVoteWeighted) (*sdk.Result, error) {
+	msgData := types.MsgVoteWeighted{}
+	if err := msg.UnmarshalData(types.Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg MsgVoteWeighted data unmarshal error")
 	}
-
-	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+	ctx.RequireAuth(msgData.Voter)
+	res, err := server.VoteWeighted(ctx.Context(), msgData)
+	return newResult(ctx.Context(), res, err)
 }
 
-func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) (*sdk.Result, error) {
-	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option)
-	if err != nil {
-		return nil, err
+func handleKuMsgCancelProposal(ctx chainTypes.Context, server keeper.MsgServer, msg types.KuMsgCancelProposal) (*sdk.Result, error) {
+	msgData := types.MsgCancelProposal{}
+	if err := msg.UnmarshalData(types.Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg MsgCancelProposal data unmarshal error")
 	}
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
-		),
-	)
-
-	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+	ctx.RequireAuth(msgData.Proposer)
+	res, err := server.CancelProposal(ctx.Context(), msgData)
+	return newResult(ctx.Context(), res, err)
 }
 
-func handleMsgGovUnJail(ctx chainTypes.Context, keeper Keeper, msg types.MsgGovUnJail) (*sdk.Result, error) {
+func handleMsgGovUnJail(ctx chainTypes.Context, server keeper.MsgServer, msg types.MsgGovUnJail) (*sdk.Result, error) {
 	msgData := types.MsgGovUnjailBase{}
 	if err := msg.UnmarshalData(types.Cdc(), &msgData); err != nil {
 		return nil, sdkerrors.Wrapf(err, "msg MsgGovUnJail  data unmarshal error")
 	}
 	ctx.RequireAuth(msgData.GetUnjailValidator())
-	err := keeper.UnJail(ctx.Context(), msgData.GetUnjailValidator())
-	if err != nil {
-		return nil, err
-	}
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msgData.GetUnjailValidator().String()),
-		),
-	)
-
-	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+	res, err := server.Unjail(ctx.Context(), msgData)
+	return newResult(ctx.Context(), res, err)
 }
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestCancelProposal(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestCancelProposal", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		depositParams := keeper.GetDepositParams(ctx)
+		depositParams.CancelBurnRate = sdk.NewDecWithPrec(2, 1)
+		keeper.SetDepositParams(ctx, depositParams)
+
+		proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		proposal.Proposer = TestAddrs[0]
+		keeper.SetProposal(ctx, proposal)
+		proposalID := proposal.ProposalID
+
+		denom := stakingKeeper.BondDenom(ctx)
+		deposit0 := chainTypes.NewCoins(chainTypes.NewCoin(denom, sdk.NewInt(1000)))
+		deposit1 := chainTypes.NewCoins(chainTypes.NewCoin(denom, sdk.NewInt(505)))
+
+		_, err = keeper.AddDeposit(ctx, proposalID, TestAddrs[0], deposit0)
+		require.NoError(t, err)
+		_, err = keeper.AddDeposit(ctx, proposalID, TestAddrs[1], deposit1)
+		require.NoError(t, err)
+
+		before0 := app.AssetKeeper().GetCoinPowers(ctx, TestAddrs[0])
+		before1 := app.AssetKeeper().GetCoinPowers(ctx, TestAddrs[1])
+
+		// only the proposer can cancel the proposal
+		_, _, err = keeper.CancelProposal(ctx, proposalID, TestAddrs[1])
+		So(err, simapp.ShouldErrIs, types.ErrInvalidProposer)
+
+		burned, refunded, err := keeper.CancelProposal(ctx, proposalID, TestAddrs[0])
+		So(err, ShouldBeNil)
+		So(burned, ShouldResemble, chainTypes.NewCoins(chainTypes.NewCoin(denom, sdk.NewInt(200+101))))
+		So(refunded, ShouldResemble, chainTypes.NewCoins(chainTypes.NewCoin(denom, sdk.NewInt(800+404))))
+
+		after0 := app.AssetKeeper().GetCoinPowers(ctx, TestAddrs[0])
+		after1 := app.AssetKeeper().GetCoinPowers(ctx, TestAddrs[1])
+		So(after0.Sub(before0).AmountOf(denom), ShouldResemble, sdk.NewInt(800))
+		So(after1.Sub(before1).AmountOf(denom), ShouldResemble, sdk.NewInt(404))
+
+		_, ok := keeper.GetProposal(ctx, proposalID)
+		So(ok, ShouldBeFalse)
+		So(keeper.GetDeposits(ctx, proposalID), ShouldBeEmpty)
+
+		_, _, err = keeper.CancelProposal(ctx, proposalID, TestAddrs[0])
+		So(err, simapp.ShouldErrIs, types.ErrUnknownProposal)
+	})
+
+	Convey("TestCancelProposalInVotingPeriod", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		proposal.Proposer = TestAddrs[0]
+		keeper.SetProposal(ctx, proposal)
+
+		minDeposit := keeper.GetDepositParams(ctx).MinDeposit
+		votingStarted, err := keeper.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], minDeposit)
+		require.NoError(t, err)
+		require.True(t, votingStarted)
+
+		_, _, err = keeper.CancelProposal(ctx, proposal.ProposalID, TestAddrs[0])
+		So(err, simapp.ShouldErrIs, types.ErrInactiveProposal)
+	})
+}
+
+func TestDepositParamsCancelBurnRate(t *testing.T) {
+	Convey("TestDepositParamsCancelBurnRate", t, func() {
+		params := types.DefaultParams()
+		So(params.DepositParams.CancelBurnRate, ShouldResemble, types.DefaultCancelBurnRate)
+		So(types.ValidateGenesis(types.GenesisState{
+			StartingProposalID: 1,
+			DepositParams:      params.DepositParams,
+			VotingParams:       params.VotingParams,
+			TallyParams:        params.TallyParams,
+			ExpeditedParams:    params.ExpeditedParams,
+		}), ShouldBeNil)
+
+		params.DepositParams.CancelBurnRate = sdk.NewDec(2)
+		So(types.ValidateGenesis(types.GenesisState{
+			StartingProposalID: 1,
+			DepositParams:      params.DepositParams,
+			VotingParams:       params.VotingParams,
+			TallyParams:        params.TallyParams,
+			ExpeditedParams:    params.ExpeditedParams,
+		}), ShouldNotBeNil)
+
+		// the params stored before the cancel burn rate burn nothing
+		So(types.DepositParams{}.GetCancelBurnRate(), ShouldResemble, sdk.ZeroDec())
+	})
+}
+
+func TestBurnVetoedDeposits(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestBurnVetoedDeposits", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		depositParams := keeper.GetDepositParams(ctx)
+		depositParams.VetoBurnRate = sdk.NewDecWithPrec(3, 1)
+		keeper.SetDepositParams(ctx, depositParams)
+
+		proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		proposal.Proposer = TestAddrs[0]
+		keeper.SetProposal(ctx, proposal)
+
+		denom := stakingKeeper.BondDenom(ctx)
+		deposit0 := chainTypes.NewCoins(chainTypes.NewCoin(denom, sdk.NewInt(1000)))
+		deposit1 := chainTypes.NewCoins(chainTypes.NewCoin(denom, sdk.NewInt(500)))
+
+		_, err = keeper.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], deposit0)
+		require.NoError(t, err)
+		_, err = keeper.AddDeposit(ctx, proposal.ProposalID, TestAddrs[1], deposit1)
+		require.NoError(t, err)
+
+		before0 := app.AssetKeeper().GetCoinPowers(ctx, TestAddrs[0])
+		before1 := app.AssetKeeper().GetCoinPowers(ctx, TestAddrs[1])
+
+		// the deposit of the proposer is burned by the rate, the others are all burned
+		burned := keeper.BurnVetoedDeposits(ctx, proposal)
+		So(burned, ShouldResemble, chainTypes.NewCoins(chainTypes.NewCoin(denom, sdk.NewInt(800))))
+
+		after0 := app.AssetKeeper().GetCoinPowers(ctx, TestAddrs[0])
+		after1 := app.AssetKeeper().GetCoinPowers(ctx, TestAddrs[1])
+		So(after0.Sub(before0).AmountOf(denom), ShouldResemble, sdk.NewInt(700))
+		So(after1.Sub(before1).AmountOf(denom), ShouldResemble, sdk.ZeroInt())
+		So(keeper.GetDeposits(ctx, proposal.ProposalID), ShouldBeEmpty)
+
+		found := false
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeVetoSlash {
+				found = true
+			}
+		}
+		So(found, ShouldBeTrue)
+	})
+}
+
+func TestDepositParamsVetoBurnRate(t *testing.T) {
+	Convey("TestDepositParamsVetoBurnRate", t, func() {
+		params := types.DefaultParams()
+		So(params.DepositParams.VetoBurnRate, ShouldResemble, types.DefaultVetoBurnRate)
+
+		params.DepositParams.VetoBurnRate = sdk.NewDec(-1)
+		So(types.ValidateGenesis(types.GenesisState{
+			StartingProposalID: 1,
+			DepositParams:      params.DepositParams,
+			VotingParams:       params.VotingParams,
+			TallyParams:        params.TallyParams,
+			ExpeditedParams:    params.ExpeditedParams,
+		}), ShouldNotBeNil)
+
+		// the params stored before the veto burn rate burn the whole deposit of the proposer
+		So(types.DepositParams{}.GetVetoBurnRate(), ShouldResemble, sdk.OneDec())
+	})
+}
@@ -47,15 +47,13 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) (deposits t
 
 // DeleteDeposits deletes all the deposits on a specific proposal without refunding them
 func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
-	store := ctx.KVStore(keeper.storeKey)
-
 	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
 		err := keeper.supplyKeeper.BurnCoins(ctx, types.ModuleAccountID, deposit.Amount)
 		if err != nil {
 			panic(err)
 		}
 
-		store.Delete(types.DepositKey(proposalID, deposit.Depositor))
+		keeper.disposeDeposit(ctx, deposit, Coins{}, deposit.Amount)
 		return false
 	})
 }
@@ -92,6 +90,19 @@ func (keeper Keeper) IterateDeposits(ctx sdk.Context, proposalID uint64, cb func
 	}
 }
 
+// ValidateInitialDeposit checks the initial deposit of the proposal submitted is not less than the min initial deposit,
+// which rejects the spam proposals, the others can still top up the deposits after the proposal submitted.
+func (keeper Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit Coins, proposalType string, expedited bool) error {
+	minDeposit := keeper.GetMinDeposit(ctx, proposalType, expedited)
+
+	minInitialDeposit := keeper.GetDepositParams(ctx).MinInitialDeposit(minDeposit)
+	if !initialDeposit.IsAllGTE(minInitialDeposit) {
+		return sdkerrors.Wrapf(types.ErrMinInitialDeposit, "%s is less than %s", initialDeposit, minInitialDeposit)
+	}
+
+	return nil
+}
+
 // AddDeposit adds or updates a deposit of a specific depositor on a specific proposal
 // Activates voting period when appropriate
 func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAddr AccountID, depositAmount Coins) (bool, error) {
@@ -117,7 +128,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetProposalMinDeposit(ctx, proposal)) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
 	}
@@ -139,20 +150,131 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	)
 
 	keeper.SetDeposit(ctx, deposit)
+
+	// called when deposit has been added to a proposal, however the proposal may not be active
+	keeper.AfterProposalDeposit(ctx, proposalID, depositorAddr)
+
 	return activatedVotingPeriod, nil
 }
 
 // RefundDeposits refunds and deletes all the deposits on a specific proposal
 func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
-	store := ctx.KVStore(keeper.storeKey)
-
 	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
 		err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, deposit.Amount)
 		if err != nil {
 			panic(err)
 		}
 
-		store.Delete(types.DepositKey(proposalID, deposit.Depositor))
+		keeper.disposeDeposit(ctx, deposit, deposit.Amount, Coins{})
+		return false
+	})
+}
+
+// BurnVetoedDeposits burns the vetoed deposits when the proposal is rejected with veto, the deposits of
+// the others are all burned as before, and the proposer's deposit is burned by the veto burn rate in the
+// deposit params, the rest of it is refunded, returns the burned coins.
+func (keeper Keeper) BurnVetoedDeposits(ctx sdk.Context, proposal types.Proposal) Coins {
+	rate := keeper.GetDepositParams(ctx).GetVetoBurnRate()
+
+	burned := Coins{}
+	keeper.IterateDeposits(ctx, proposal.ProposalID, func(deposit types.Deposit) bool {
+		depositRate := sdk.OneDec()
+		if deposit.Depositor.Eq(proposal.Proposer) {
+			depositRate = rate
+		}
+
+		toBurn, _, err := keeper.burnDeposit(ctx, deposit, depositRate)
+		if err != nil {
+			panic(err)
+		}
+
+		burned = burned.Add(toBurn...)
 		return false
 	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVetoSlash,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposal.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+		),
+	)
+
+	return burned
+}
+
+// burnDeposit burns the part of the deposit by the rate and refunds the rest to the depositor
+func (keeper Keeper) burnDeposit(ctx sdk.Context, deposit types.Deposit, rate sdk.Dec) (toBurn, toRefund Coins, err error) {
+	toBurn = Coins{}
+	for _, coin := range deposit.Amount {
+		amount := coin.Amount.ToDec().Mul(rate).TruncateInt()
+		if amount.IsPositive() {
+			toBurn = toBurn.Add(types.NewCoin(coin.Denom, amount))
+		}
+	}
+	toRefund = deposit.Amount.Sub(toBurn)
+
+	if !toBurn.IsZero() {
+		if err := keeper.supplyKeeper.BurnCoins(ctx, types.ModuleAccountID, toBurn); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if !toRefund.IsZero() {
+		if err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, toRefund); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	keeper.disposeDeposit(ctx, deposit, toRefund, toBurn)
+
+	return toBurn, toRefund, nil
+}
+
+// disposeDeposit deletes the deposit refunded or burned, the disposal of the deposit is recorded
+// and emitted by the events, so that the deposits can be audited after the proposal resolved.
+func (keeper Keeper) disposeDeposit(ctx sdk.Context, deposit types.Deposit, refunded, burned Coins) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.DepositKey(deposit.ProposalID, deposit.Depositor))
+
+	disposal := types.NewDepositDisposal(deposit, refunded, burned, ctx.BlockHeight(), ctx.BlockTime())
+	store.Set(types.DepositDisposalKey(deposit.ProposalID, deposit.Depositor), keeper.cdc.MustMarshalBinaryBare(&disposal))
+
+	if !refunded.IsZero() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDepositRefund,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", deposit.ProposalID)),
+				sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, refunded.String()),
+			),
+		)
+	}
+
+	if !burned.IsZero() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDepositBurn,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", deposit.ProposalID)),
+				sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, burned.String()),
+			),
+		)
+	}
+}
+
+// GetDepositDisposals returns the disposals of all the deposits on a resolved proposal
+func (keeper Keeper) GetDepositDisposals(ctx sdk.Context, proposalID uint64) (disposals types.DepositDisposals) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DepositDisposalsKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var disposal types.DepositDisposal
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &disposal)
+		disposals = append(disposals, disposal)
+	}
+
+	return disposals
 }
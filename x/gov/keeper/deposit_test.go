@@ -8,7 +8,9 @@ import (
 
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
 	"github.com/KuChainNetwork/kuchain/x/staking/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	. "github.com/smartystreets/goconvey/convey"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -96,5 +98,52 @@ func TestDeposits(t *testing.T) {
 		keeper.RefundDeposits(ctx, proposalID)
 		deposit, found = keeper.GetDeposit(ctx, proposalID, TestAddrs[1])
 		require.False(t, found)
+
+		// Test the disposals of the refunded deposits
+		disposals := keeper.GetDepositDisposals(ctx, proposalID)
+		require.Len(t, disposals, 2)
+		require.Equal(t, TestAddrs[1], disposals[0].Depositor)
+		require.Equal(t, fourStake, disposals[0].Refunded)
+		require.True(t, disposals[0].Burned.IsZero())
+		require.Equal(t, ctx.BlockHeight(), disposals[0].Height)
+		So(countEvents(ctx, types.EventTypeDepositRefund), ShouldEqual, 2)
+		So(countEvents(ctx, types.EventTypeDepositBurn), ShouldEqual, 0)
 	})
+
+	Convey("TestDepositsBurned", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		proposalID := proposal.ProposalID
+
+		stake := chainTypes.NewCoins(chainTypes.NewCoin(stakingKeeper.BondDenom(ctx), exported.TokensFromConsensusPower(10)))
+		_, err = keeper.AddDeposit(ctx, proposalID, TestAddrs[0], stake)
+		require.NoError(t, err)
+
+		So(keeper.GetDepositDisposals(ctx, proposalID), ShouldBeEmpty)
+
+		keeper.DeleteDeposits(ctx, proposalID)
+		So(keeper.GetDeposits(ctx, proposalID), ShouldBeEmpty)
+
+		disposals := keeper.GetDepositDisposals(ctx, proposalID)
+		require.Len(t, disposals, 1)
+		require.Equal(t, TestAddrs[0], disposals[0].Depositor)
+		require.Equal(t, stake, disposals[0].Burned)
+		require.True(t, disposals[0].Refunded.IsZero())
+		So(countEvents(ctx, types.EventTypeDepositBurn), ShouldEqual, 1)
+	})
+}
+
+func countEvents(ctx sdk.Context, eventType string) int {
+	count := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return count
 }
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/gov"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func newExpeditedProposal(t *testing.T, app *simapp.SimApp, ctx sdk.Context) types.Proposal {
+	k := app.GovKeeper()
+	denom := app.StakeKeeper().BondDenom(ctx)
+
+	depositParams := k.GetDepositParams(ctx)
+	depositParams.MinDeposit = chainTypes.NewCoins(chainTypes.NewCoin(denom, sdk.NewInt(1000)))
+	k.SetDepositParams(ctx, depositParams)
+
+	expeditedParams := k.GetExpeditedParams(ctx)
+	expeditedParams.MinDeposit = chainTypes.NewCoins(chainTypes.NewCoin(denom, sdk.NewInt(2000)))
+	k.SetExpeditedParams(ctx, expeditedParams)
+
+	proposal, err := k.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposal.Proposer = TestAddrs[0]
+	proposal.Expedited = true
+	k.SetProposal(ctx, proposal)
+
+	// the min deposit of the normal proposals is not enough for the expedited
+	votingStarted, err := k.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], depositParams.MinDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	votingStarted, err = k.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], depositParams.MinDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, ok := k.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	return proposal
+}
+
+func TestExpeditedProposal(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestExpeditedProposalPasses", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		k := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper().EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		createValidators(app, ctx, stakingKeeper, []int64{5, 5, 5})
+
+		proposal := newExpeditedProposal(t, app, ctx)
+		So(proposal.Status, ShouldEqual, types.StatusVotingPeriod)
+		So(proposal.VotingEndTime, ShouldResemble, proposal.VotingStartTime.Add(k.GetExpeditedParams(ctx).VotingPeriod))
+
+		for _, voter := range []chainTypes.AccountID{valAccAddr1, valAccAddr2, valAccAddr3} {
+			require.NoError(t, k.AddVote(ctx, proposal.ProposalID, voter, types.OptionYes))
+		}
+
+		gov.EndBlocker(ctx.WithBlockTime(proposal.VotingEndTime), *k)
+
+		proposal, ok := k.GetProposal(ctx, proposal.ProposalID)
+		So(ok, ShouldBeTrue)
+		So(proposal.Status, ShouldEqual, types.StatusPassed)
+		So(proposal.Expedited, ShouldBeTrue)
+	})
+
+	Convey("TestExpeditedProposalConverted", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		k := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper().EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		createValidators(app, ctx, stakingKeeper, []int64{5, 5, 5})
+
+		proposal := newExpeditedProposal(t, app, ctx)
+
+		// 1/3 turnout reaches the normal quorum, but not the expedited quorum
+		require.NoError(t, k.AddVote(ctx, proposal.ProposalID, valAccAddr1, types.OptionYes))
+		So(k.GetProposalTallyParams(ctx, proposal).Quorum, ShouldResemble, k.GetExpeditedParams(ctx).Quorum)
+
+		gov.EndBlocker(ctx.WithBlockTime(proposal.VotingEndTime), *k)
+
+		converted, ok := k.GetProposal(ctx, proposal.ProposalID)
+		So(ok, ShouldBeTrue)
+		So(converted.Status, ShouldEqual, types.StatusVotingPeriod)
+		So(converted.Expedited, ShouldBeFalse)
+		So(converted.VotingEndTime, ShouldResemble, proposal.VotingStartTime.Add(k.GetVotingParams(ctx).VotingPeriod))
+		So(k.GetDeposits(ctx, proposal.ProposalID), ShouldNotBeEmpty)
+
+		// the votes are kept for the normal voting period
+		_, found := k.GetVote(ctx, proposal.ProposalID, valAccAddr1)
+		So(found, ShouldBeTrue)
+
+		require.NoError(t, k.AddVote(ctx, proposal.ProposalID, valAccAddr2, types.OptionYes))
+		gov.EndBlocker(ctx.WithBlockTime(converted.VotingEndTime), *k)
+
+		proposal, ok = k.GetProposal(ctx, proposal.ProposalID)
+		So(ok, ShouldBeTrue)
+		So(proposal.Status, ShouldEqual, types.StatusPassed)
+	})
+
+	Convey("TestExpeditedParams", t, func() {
+		params := types.DefaultParams()
+		genesis := types.NewGenesisState(1, params.DepositParams, params.VotingParams, params.TallyParams, params.ExpeditedParams, params.TypeParams)
+		So(types.ValidateGenesis(genesis), ShouldBeNil)
+
+		genesis.ExpeditedParams.VotingPeriod = params.VotingParams.VotingPeriod
+		So(types.ValidateGenesis(genesis), ShouldNotBeNil)
+
+		genesis.ExpeditedParams = params.ExpeditedParams
+		genesis.ExpeditedParams.Threshold = params.TallyParams.Threshold.QuoInt64(2)
+		So(types.ValidateGenesis(genesis), ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/gov"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+var _ types.GovHooks = &mockGovHooks{}
+
+type mockGovHooks struct {
+	AfterProposalSubmissionValid        bool
+	AfterProposalDepositValid           bool
+	AfterProposalVoteValid              bool
+	AfterProposalFailedMinDepositValid  bool
+	AfterProposalVotingPeriodEndedValid bool
+}
+
+func (h *mockGovHooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
+	h.AfterProposalSubmissionValid = true
+}
+
+func (h *mockGovHooks) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr chainTypes.AccountID) {
+	h.AfterProposalDepositValid = true
+}
+
+func (h *mockGovHooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr chainTypes.AccountID) {
+	h.AfterProposalVoteValid = true
+}
+
+func (h *mockGovHooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {
+	h.AfterProposalFailedMinDepositValid = true
+}
+
+func (h *mockGovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	h.AfterProposalVotingPeriodEndedValid = true
+}
+
+func TestHooks(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestHooks", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper().EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		createValidators(app, ctx, stakingKeeper, []int64{5, 5, 5})
+
+		govHooksReceiver := mockGovHooks{}
+		keeper.SetHooks(types.NewMultiGovHooks(&govHooksReceiver))
+		So(func() { keeper.SetHooks(&mockGovHooks{}) }, ShouldPanic)
+
+		// the proposal without deposit is dropped at the end of the deposit period
+		proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		So(govHooksReceiver.AfterProposalSubmissionValid, ShouldBeTrue)
+
+		gov.EndBlocker(ctx.WithBlockTime(proposal.DepositEndTime), *keeper)
+		So(govHooksReceiver.AfterProposalFailedMinDepositValid, ShouldBeTrue)
+
+		proposal, err = keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+
+		minDeposit := keeper.GetDepositParams(ctx).MinDeposit
+		votingStarted, err := keeper.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], minDeposit)
+		require.NoError(t, err)
+		require.True(t, votingStarted)
+		So(govHooksReceiver.AfterProposalDepositValid, ShouldBeTrue)
+
+		require.NoError(t, keeper.AddVote(ctx, proposal.ProposalID, valAccAddr1, types.OptionYes))
+		So(govHooksReceiver.AfterProposalVoteValid, ShouldBeTrue)
+
+		proposal, ok := keeper.GetProposal(ctx, proposal.ProposalID)
+		require.True(t, ok)
+		So(govHooksReceiver.AfterProposalVotingPeriodEndedValid, ShouldBeFalse)
+
+		gov.EndBlocker(ctx.WithBlockTime(proposal.VotingEndTime), *keeper)
+		So(govHooksReceiver.AfterProposalVotingPeriodEndedValid, ShouldBeTrue)
+	})
+}
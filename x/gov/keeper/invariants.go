@@ -12,12 +12,18 @@ import (
 // RegisterInvariants registers all governance invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, "deposit-proposals", DepositProposalsInvariant(keeper))
 }
 
 // AllInvariants runs all invariants of the governance module
 func AllInvariants(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return ModuleAccountInvariant(keeper, bk)(ctx)
+		res, stop := ModuleAccountInvariant(keeper, bk)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return DepositProposalsInvariant(keeper)(ctx)
 	}
 }
 
@@ -41,3 +47,22 @@ func ModuleAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 				balances, expectedDeposits)), broken
 	}
 }
+
+// DepositProposalsInvariant checks that every deposit held on store references an existing proposal
+func DepositProposalsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		keeper.IterateAllDeposits(ctx, func(deposit types.Deposit) bool {
+			if _, found := keeper.GetProposal(ctx, deposit.ProposalID); !found {
+				count++
+				msg += fmt.Sprintf("\tdeposit of %s references a missing proposal %d\n", deposit.Depositor, deposit.ProposalID)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "deposit proposals", fmt.Sprintf(
+			"%d deposits of missing proposals found\n%s", count, msg)), count != 0
+	}
+}
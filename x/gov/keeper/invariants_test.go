@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/gov/keeper"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestDepositProposalsInvariant(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestDepositProposalsInvariant", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		govKeeper := app.GovKeeper()
+		ctx := app.BaseApp.NewContext(false, abci.Header{Height: app.LastBlockHeight() + 1})
+		invariant := keeper.DepositProposalsInvariant(*govKeeper)
+
+		proposal, err := govKeeper.SubmitProposal(ctx, TestProposal)
+		So(err, ShouldBeNil)
+
+		amount := chainTypes.NewCoins(chainTypes.NewInt64Coin(app.StakeKeeper().BondDenom(ctx), 10))
+		govKeeper.SetDeposit(ctx, types.NewDeposit(proposal.ProposalID, TestAddrs[0], amount))
+		_, broken := invariant(ctx)
+		So(broken, ShouldBeFalse)
+
+		// the proposal is missing
+		govKeeper.SetDeposit(ctx, types.NewDeposit(proposal.ProposalID+1, TestAddrs[0], amount))
+		msg, broken := invariant(ctx)
+		So(broken, ShouldBeTrue)
+		So(msg, ShouldContainSubstring, "1 deposits of missing proposals found")
+	})
+}
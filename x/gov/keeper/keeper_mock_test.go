@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/testutil"
+	"github.com/KuChainNetwork/kuchain/x/gov/keeper"
+	govtestutil "github.com/KuChainNetwork/kuchain/x/gov/testutil"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestKeeperWithMockKeepers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	supplyKeeper := govtestutil.NewMockSupplyKeeper(ctrl)
+	stakingKeeper := govtestutil.NewMockStakingKeeper(ctrl)
+	distrKeeper := govtestutil.NewMockDistributionKeeper(ctrl)
+	paramSpace := govtestutil.NewMockParamSubspace(ctrl)
+
+	tallyParams := types.DefaultTallyParams()
+	paramSpace.EXPECT().Get(gomock.Any(), types.ParamStoreKeyTallyParams, gomock.Any()).
+		Do(func(_ sdk.Context, _ []byte, ptr interface{}) {
+			*ptr.(*types.TallyParams) = tallyParams
+		}).AnyTimes()
+
+	// the gov module account should be set
+	supplyKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress("gov")).Times(1)
+
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	blockTime := time.Unix(1600000000, 0).UTC()
+	ctx := testutil.NewContext(abci.Header{Height: 10, Time: blockTime}, key)
+	k := keeper.NewKeeper(types.ModuleCdc, key, paramSpace, supplyKeeper, stakingKeeper, distrKeeper, types.NewRouter())
+
+	validator := chainTypes.MustAccountID("validator")
+
+	// jail the validator and punish it until the max punish period
+	stakingKeeper.EXPECT().JailByAccount(gomock.Any(), validator).Times(1)
+	k.Jail(ctx, validator, 1)
+
+	punish, found := k.GetPunishValidator(ctx, validator)
+	require.True(t, found)
+	require.Equal(t, blockTime.Add(tallyParams.MaxPunishPeriod), punish.JailedUntil)
+
+	// cannot unjail before the punish period end
+	require.Equal(t, types.ErrValidatorJailed, k.UnJail(ctx, validator))
+
+	stakingKeeper.EXPECT().UnjailByAccount(gomock.Any(), validator).Times(1)
+	require.NoError(t, k.UnJail(ctx.WithBlockTime(punish.JailedUntil), validator))
+
+	_, found = k.GetPunishValidator(ctx, validator)
+	require.False(t, found)
+	require.Equal(t, types.ErrValidatorNoPunish, k.UnJail(ctx, validator))
+
+	// slash by the fraction in tally params
+	stakingKeeper.EXPECT().SlashByValidatorAccount(gomock.Any(), validator, int64(10), tallyParams.SlashFraction).Times(1)
+	k.SlashValidator(ctx, validator)
+
+	distrKeeper.EXPECT().SetStartNotDistributionTimePoint(gomock.Any(), blockTime).Times(1)
+	k.Slash(ctx)
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgServer is the server of the gov msgs, each msg returns a typed response,
+// the auth of the msgs should be checked by the caller.
+type MsgServer interface {
+	SubmitProposal(ctx sdk.Context, msg types.MsgSubmitProposalI) (*types.MsgSubmitProposalResponse, error)
+	Deposit(ctx sdk.Context, msg types.MsgDeposit) (*types.MsgDepositResponse, error)
+	Vote(ctx sdk.Context, msg types.MsgVote) (*types.MsgVoteResponse, error)
+	VoteWeighted(ctx sdk.Context, msg types.MsgVoteWeighted) (*types.MsgVoteWeightedResponse, error)
+	Unjail(ctx sdk.Context, msg types.MsgGovUnjailBase) (*types.MsgGovUnjailResponse, error)
+	CancelProposal(ctx sdk.Context, msg types.MsgCancelProposal) (*types.MsgCancelProposalResponse, error)
+}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the gov MsgServer for the keeper
+func NewMsgServerImpl(keeper Keeper) MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ MsgServer = msgServer{}
+
+func (k msgServer) SubmitProposal(ctx sdk.Context, msg types.MsgSubmitProposalI) (*types.MsgSubmitProposalResponse, error) {
+	if err := k.ValidateInitialDeposit(ctx, msg.GetInitialDeposit(), msg.GetContent().ProposalType(), msg.GetExpedited()); err != nil {
+		return nil, err
+	}
+
+	// throttle the submissions of the proposer to curb the governance spam
+	if err := k.ValidateProposerThrottle(ctx, msg.GetProposerAccountID()); err != nil {
+		return nil, err
+	}
+
+	proposal, err := k.Keeper.SubmitProposal(ctx, msg.GetContent())
+	if err != nil {
+		return nil, err
+	}
+
+	// record the proposer for canceling the proposal in deposit period,
+	// and the expedited flag for the deposit, voting period and tally of the proposal
+	proposal.Proposer = msg.GetProposerAccountID()
+	proposal.Expedited = msg.GetExpedited()
+	k.SetProposal(ctx, proposal)
+	k.SetLastSubmitTime(ctx, proposal.Proposer, ctx.BlockTime())
+
+	votingStarted, err := k.AddDeposit(ctx, proposal.ProposalID, msg.GetProposerAccountID(), msg.GetInitialDeposit())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.GetProposer().String()),
+		),
+	)
+
+	submitEvent := sdk.NewEvent(types.EventTypeSubmitProposal,
+		sdk.NewAttribute(types.AttributeKeyProposalType, msg.GetContent().ProposalType()),
+		sdk.NewAttribute(types.AttributeKeyExpedited, fmt.Sprintf("%t", proposal.Expedited)),
+	)
+	if votingStarted {
+		submitEvent = submitEvent.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.ProposalID)),
+		)
+	}
+	ctx.EventManager().EmitEvent(submitEvent)
+
+	return &types.MsgSubmitProposalResponse{
+		ProposalID:    proposal.ProposalID,
+		VotingStarted: votingStarted,
+	}, nil
+}
+
+func (k msgServer) Deposit(ctx sdk.Context, msg types.MsgDeposit) (*types.MsgDepositResponse, error) {
+	votingStarted, err := k.AddDeposit(ctx, msg.ProposalID, msg.Depositor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor.String()),
+		),
+	)
+
+	if votingStarted {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalDeposit,
+				sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", msg.ProposalID)),
+			),
+		)
+	}
+
+	return &types.MsgDepositResponse{VotingStarted: votingStarted}, nil
+}
+
+func (k msgServer) Vote(ctx sdk.Context, msg types.MsgVote) (*types.MsgVoteResponse, error) {
+	if err := k.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	)
+
+	return &types.MsgVoteResponse{}, nil
+}
+
+func (k msgServer) VoteWeighted(ctx sdk.Context, msg types.MsgVoteWeighted) (*types.MsgVoteWeightedResponse, error) {
+	if err := k.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	)
+
+	return &types.MsgVoteWeightedResponse{}, nil
+}
+
+func (k msgServer) Unjail(ctx sdk.Context, msg types.MsgGovUnjailBase) (*types.MsgGovUnjailResponse, error) {
+	if err := k.UnJail(ctx, msg.GetUnjailValidator()); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.GetUnjailValidator().String()),
+		),
+	)
+
+	return &types.MsgGovUnjailResponse{}, nil
+}
+
+func (k msgServer) CancelProposal(ctx sdk.Context, msg types.MsgCancelProposal) (*types.MsgCancelProposalResponse, error) {
+	burned, refunded, err := k.Keeper.CancelProposal(ctx, msg.ProposalID, msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+		),
+	)
+
+	return &types.MsgCancelProposalResponse{Burned: burned, Refunded: refunded}, nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/gov/keeper"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/KuChainNetwork/kuchain/x/staking/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestMsgServer(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestMsgServer", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		stakingKeeper := app.StakeKeeper()
+		stakingKeeper = stakingKeeper.EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		createValidators(app, ctx, stakingKeeper, powers)
+		server := keeper.NewMsgServerImpl(*app.GovKeeper())
+
+		bondDenom := stakingKeeper.BondDenom(ctx)
+		initDeposit := chainTypes.NewCoins(chainTypes.NewCoin(bondDenom, exported.TokensFromConsensusPower(100)))
+		minDeposit := app.GovKeeper().GetDepositParams(ctx).MinDeposit
+
+		// the created proposal id is returned in the response
+		submitMsg := types.NewKuMsgSubmitProposal(Addrs[0], TestProposal, initDeposit, TestAddrs[0])
+		res, err := server.SubmitProposal(ctx, submitMsg)
+		require.NoError(t, err)
+		require.False(t, res.VotingStarted)
+
+		proposal, ok := app.GovKeeper().GetProposal(ctx, res.ProposalID)
+		require.True(t, ok)
+		require.Equal(t, types.StatusDepositPeriod, proposal.Status)
+
+		res2, err := server.SubmitProposal(ctx, submitMsg)
+		require.NoError(t, err)
+		require.Equal(t, res.ProposalID+1, res2.ProposalID)
+
+		// the response can be decoded from the result data
+		data := append(types.MarshalMsgResponse(res2), types.MarshalMsgResponse(types.MsgVoteResponse{})...)
+		decoded, err := types.UnmarshalMsgSubmitProposalResponse(data)
+		require.NoError(t, err)
+		require.Equal(t, *res2, decoded)
+
+		_, err = types.UnmarshalMsgSubmitProposalResponse(nil)
+		require.Error(t, err)
+
+		depositRes, err := server.Deposit(ctx, types.NewMsgDeposit(TestAddrs[1], res.ProposalID, minDeposit))
+		require.NoError(t, err)
+		require.True(t, depositRes.VotingStarted)
+
+		_, err = server.Vote(ctx, types.NewMsgVote(TestAddrs[1], res.ProposalID, types.OptionYes))
+		require.NoError(t, err)
+
+		_, err = server.Vote(ctx, types.NewMsgVote(TestAddrs[1], res2.ProposalID, types.OptionYes))
+		require.Error(t, err)
+	})
+}
+
+func TestMsgServerMinInitialDeposit(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestMsgServerMinInitialDeposit", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		server := keeper.NewMsgServerImpl(*app.GovKeeper())
+		bondDenom := app.StakeKeeper().BondDenom(ctx)
+
+		depositParams := app.GovKeeper().GetDepositParams(ctx)
+		depositParams.MinInitialDepositRatio = sdk.NewDecWithPrec(5, 1)
+		app.GovKeeper().SetDepositParams(ctx, depositParams)
+
+		deposit := func(power int64) chainTypes.Coins {
+			return chainTypes.NewCoins(chainTypes.NewCoin(bondDenom, exported.TokensFromConsensusPower(power)))
+		}
+
+		// the min deposit is 500, so the initial deposit should be at least 250
+		_, err := server.SubmitProposal(ctx, types.NewKuMsgSubmitProposal(Addrs[0], TestProposal, deposit(100), TestAddrs[0]))
+		So(err, simapp.ShouldErrIs, types.ErrMinInitialDeposit)
+
+		// the expedited proposals are by the expedited min deposit
+		_, err = server.SubmitProposal(ctx, types.NewKuMsgSubmitExpeditedProposal(Addrs[0], TestProposal, deposit(250), TestAddrs[0]))
+		So(err, simapp.ShouldErrIs, types.ErrMinInitialDeposit)
+
+		res, err := server.SubmitProposal(ctx, types.NewKuMsgSubmitProposal(Addrs[0], TestProposal, deposit(250), TestAddrs[0]))
+		So(err, ShouldBeNil)
+		So(res.VotingStarted, ShouldBeFalse)
+
+		// the others can top up the deposits with any amount
+		depositRes, err := server.Deposit(ctx, types.NewMsgDeposit(TestAddrs[1], res.ProposalID, deposit(1)))
+		So(err, ShouldBeNil)
+		So(depositRes.VotingStarted, ShouldBeFalse)
+	})
+}
+
+func TestMsgServerProposerThrottle(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestMsgServerProposerThrottle", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		k := app.GovKeeper()
+		server := keeper.NewMsgServerImpl(*k)
+		bondDenom := app.StakeKeeper().BondDenom(ctx)
+		initDeposit := chainTypes.NewCoins(chainTypes.NewCoin(bondDenom, exported.TokensFromConsensusPower(1)))
+
+		depositParams := k.GetDepositParams(ctx)
+		depositParams.MaxActiveProposals = 2
+		depositParams.SubmitCooldown = time.Hour
+		k.SetDepositParams(ctx, depositParams)
+
+		submit := func(ctx sdk.Context, proposer chainTypes.AccountID) (*types.MsgSubmitProposalResponse, error) {
+			return server.SubmitProposal(ctx, types.NewKuMsgSubmitProposal(Addrs[0], TestProposal, initDeposit, proposer))
+		}
+
+		res, err := submit(ctx, TestAddrs[0])
+		So(err, ShouldBeNil)
+
+		// in the cooldown of the proposer, the others are not affected
+		_, err = submit(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)), TestAddrs[0])
+		So(err, simapp.ShouldErrIs, types.ErrSubmitCooldown)
+		_, err = submit(ctx, TestAddrs[1])
+		So(err, ShouldBeNil)
+
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		_, err = submit(ctx, TestAddrs[0])
+		So(err, ShouldBeNil)
+		So(k.CountActiveProposals(ctx, TestAddrs[0]), ShouldEqual, 2)
+
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		_, err = submit(ctx, TestAddrs[0])
+		So(err, simapp.ShouldErrIs, types.ErrTooManyActiveProposals)
+
+		// the canceled proposal is not active any more
+		_, _, err = k.CancelProposal(ctx, res.ProposalID, TestAddrs[0])
+		So(err, ShouldBeNil)
+		So(k.CountActiveProposals(ctx, TestAddrs[0]), ShouldEqual, 1)
+		_, err = submit(ctx, TestAddrs[0])
+		So(err, ShouldBeNil)
+	})
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/KuChainNetwork/kuchain/x/gov/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -26,6 +28,80 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) types.TallyParams {
 	return tallyParams
 }
 
+// GetExpeditedParams returns the current ExpeditedParams from the global param store,
+// the chains started before the expedited proposals added use the default params.
+func (keeper Keeper) GetExpeditedParams(ctx sdk.Context) types.ExpeditedParams {
+	expeditedParams := types.DefaultExpeditedParams()
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeyExpeditedParams, &expeditedParams)
+	return expeditedParams
+}
+
+// GetTypeParams returns the current TypeParams from the global param store,
+// the chains started before the proposal type params added use the default params.
+func (keeper Keeper) GetTypeParams(ctx sdk.Context) types.TypeParams {
+	typeParams := types.DefaultTypeParams()
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeyTypeParams, &typeParams)
+	return typeParams
+}
+
+// GetProposalTypeParams returns the params of the proposal type, returns false if the type uses the general params
+func (keeper Keeper) GetProposalTypeParams(ctx sdk.Context, proposalType string) (types.ProposalTypeParams, bool) {
+	return keeper.GetTypeParams(ctx).Get(proposalType)
+}
+
+// GetMinDeposit returns the min deposit for a proposal of the type to enter voting period
+func (keeper Keeper) GetMinDeposit(ctx sdk.Context, proposalType string, expedited bool) types.Coins {
+	if expedited {
+		return keeper.GetExpeditedParams(ctx).MinDeposit
+	}
+
+	if typeParams, ok := keeper.GetProposalTypeParams(ctx, proposalType); ok && !typeParams.MinDeposit.Empty() {
+		return typeParams.MinDeposit
+	}
+
+	return keeper.GetDepositParams(ctx).MinDeposit
+}
+
+// GetProposalMinDeposit returns the min deposit for the proposal to enter voting period
+func (keeper Keeper) GetProposalMinDeposit(ctx sdk.Context, proposal types.Proposal) types.Coins {
+	return keeper.GetMinDeposit(ctx, proposal.ProposalType(), proposal.Expedited)
+}
+
+// GetProposalVotingPeriod returns the length of the voting period of the proposal
+func (keeper Keeper) GetProposalVotingPeriod(ctx sdk.Context, proposal types.Proposal) time.Duration {
+	if proposal.Expedited {
+		return keeper.GetExpeditedParams(ctx).VotingPeriod
+	}
+
+	if typeParams, ok := keeper.GetProposalTypeParams(ctx, proposal.ProposalType()); ok && typeParams.VotingPeriod > 0 {
+		return typeParams.VotingPeriod
+	}
+
+	return keeper.GetVotingParams(ctx).VotingPeriod
+}
+
+// GetProposalTallyParams returns the tally params for the proposal, the quorum and threshold are overridden
+// by the params of the proposal type, then by the expedited params for the expedited proposals,
+// which never lower the quorum and threshold of the proposal type.
+func (keeper Keeper) GetProposalTallyParams(ctx sdk.Context, proposal types.Proposal) types.TallyParams {
+	tallyParams := keeper.GetTallyParams(ctx)
+	if typeParams, ok := keeper.GetProposalTypeParams(ctx, proposal.ProposalType()); ok {
+		if !typeParams.Quorum.IsNil() {
+			tallyParams.Quorum = typeParams.Quorum
+		}
+		if !typeParams.Threshold.IsNil() {
+			tallyParams.Threshold = typeParams.Threshold
+		}
+	}
+
+	if proposal.Expedited {
+		expeditedParams := keeper.GetExpeditedParams(ctx)
+		tallyParams.Quorum = sdk.MaxDec(tallyParams.Quorum, expeditedParams.Quorum)
+		tallyParams.Threshold = sdk.MaxDec(tallyParams.Threshold, expeditedParams.Threshold)
+	}
+	return tallyParams
+}
+
 // SetDepositParams sets DepositParams to the global param store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams types.DepositParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
@@ -40,3 +116,13 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams types.VotingP
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams types.TallyParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// SetExpeditedParams sets ExpeditedParams to the global param store
+func (keeper Keeper) SetExpeditedParams(ctx sdk.Context, expeditedParams types.ExpeditedParams) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyExpeditedParams, &expeditedParams)
+}
+
+// SetTypeParams sets TypeParams to the global param store
+func (keeper Keeper) SetTypeParams(ctx sdk.Context, typeParams types.TypeParams) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTypeParams, &typeParams)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/KuChainNetwork/kuchain/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -9,6 +10,33 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// ExecuteContent executes the proposal content by the handler of its route, the contents of a
+// multi-content proposal are executed in order and the error names the failing content. The
+// caller should execute it in a cache-wrapped context to drop the state changes on failure.
+func (keeper Keeper) ExecuteContent(ctx sdk.Context, content types.Content) error {
+	multi, ok := content.(types.MultiContentProposal)
+	if !ok {
+		return keeper.executeContent(ctx, content)
+	}
+
+	for i, c := range multi.Contents {
+		if err := keeper.executeContent(ctx, c); err != nil {
+			return sdkerrors.Wrapf(err, "content %d (%s)", i, c.ProposalType())
+		}
+	}
+
+	return nil
+}
+
+func (keeper Keeper) executeContent(ctx sdk.Context, content types.Content) error {
+	if !keeper.router.HasRoute(content.ProposalRoute()) {
+		return sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
+	}
+
+	handler := keeper.router.GetRoute(content.ProposalRoute())
+	return handler(ctx, content)
+}
+
 // SubmitProposal create new proposal given a content
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
@@ -19,8 +47,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	// actual parameter changes before the proposal proceeds through the
 	// governance process. State is not persisted.
 	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
+	if err := keeper.ExecuteContent(cacheCtx, content); err != nil {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
 	}
 
@@ -38,6 +65,9 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
 	keeper.SetProposalID(ctx, proposalID+1)
 
+	// called right after a proposal is submitted
+	keeper.AfterProposalSubmission(ctx, proposalID)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSubmitProposal,
@@ -179,13 +209,33 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetProposalVotingPeriod(ctx, proposal)
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
 	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.ProposalID, proposal.DepositEndTime)
 	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+	ctx.EventManager().EmitEvent(
+		types.NewProposalStatusEvent(types.EventTypeProposalVotingStarted, proposal, "",
+			sdk.NewAttribute(types.AttributeKeyVotingEndTime, proposal.VotingEndTime.UTC().Format(time.RFC3339)),
+		),
+	)
+}
+
+// ConvertExpeditedProposal converts the expedited proposal whose expedited vote failed into a normal proposal,
+// the voting period is extended to the normal voting period from the voting start time, and the votes are kept.
+func (keeper Keeper) ConvertExpeditedProposal(ctx sdk.Context, proposal types.Proposal) types.Proposal {
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+	proposal.Expedited = false
+	proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetProposalVotingPeriod(ctx, proposal))
+	keeper.SetProposal(ctx, proposal)
+
+	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+	return proposal
 }
 
 func (keeper Keeper) MarshalProposal(proposal types.Proposal) ([]byte, error) {
@@ -218,3 +268,47 @@ func (keeper Keeper) MustUnmarshalProposal(bz []byte, proposal *types.Proposal)
 		panic(err)
 	}
 }
+
+// CancelProposal cancels the proposal in deposit period by the proposer, the deposits are refunded
+// after burning the part by the cancel burn rate in the deposit params, returns the burned and refunded coins
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer AccountID) (burned, refunded Coins, err error) {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Status != types.StatusDepositPeriod {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInactiveProposal, "%d not in deposit period", proposalID)
+	}
+
+	if !proposal.Proposer.Eq(proposer) {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of %d", proposer, proposalID)
+	}
+
+	rate := keeper.GetDepositParams(ctx).GetCancelBurnRate()
+
+	burned, refunded = Coins{}, Coins{}
+	for _, deposit := range keeper.GetDeposits(ctx, proposalID) {
+		toBurn, toRefund, err := keeper.burnDeposit(ctx, deposit, rate)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		burned = burned.Add(toBurn...)
+		refunded = refunded.Add(toRefund...)
+	}
+
+	keeper.DeleteProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+			sdk.NewAttribute(types.AttributeKeyRefunded, refunded.String()),
+		),
+	)
+
+	return burned, refunded, nil
+}
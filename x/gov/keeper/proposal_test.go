@@ -2,15 +2,21 @@ package keeper_test
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/KuChainNetwork/kuchain/chain/constants"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/test/simapp"
+	distrTypes "github.com/KuChainNetwork/kuchain/x/distribution/types"
 	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	paramproposal "github.com/KuChainNetwork/kuchain/x/params/types/proposal"
+	"github.com/KuChainNetwork/kuchain/x/staking"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	. "github.com/smartystreets/goconvey/convey"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -157,3 +163,80 @@ func TestProposalAll(t *testing.T) {
 		}
 	})
 }
+
+func TestMultiContentProposal(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestMultiContentProposal", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		maxValidators := app.StakeKeeper().MaxValidators(ctx)
+		validChange := paramproposal.NewParameterChangeProposal("param", "description", []paramproposal.ParamChange{
+			paramproposal.NewParamChange(staking.DefaultParamspace, string(stakingTypes.KeyMaxValidators), fmt.Sprintf("%d", maxValidators+1)),
+		})
+		invalidChange := paramproposal.NewParameterChangeProposal("param", "description", []paramproposal.ParamChange{
+			paramproposal.NewParamChange(staking.DefaultParamspace, string(stakingTypes.KeyMaxValidators), "invalid"),
+		})
+
+		// basic validation
+		So(types.NewMultiContentProposal("title", "description", []types.Content{TestProposal, validChange}).ValidateBasic(), ShouldBeNil)
+		So(types.NewMultiContentProposal("title", "description", nil).ValidateBasic(), ShouldNotBeNil)
+		So(types.NewMultiContentProposal("title", "description", []types.Content{
+			types.NewMultiContentProposal("nested", "description", []types.Content{TestProposal}),
+		}).ValidateBasic(), ShouldNotBeNil)
+
+		// the contents are stored and executed in order
+		multi := types.NewMultiContentProposal("title", "description", []types.Content{TestProposal, validChange})
+		proposal, err := keeper.SubmitProposal(ctx, multi)
+		require.NoError(t, err)
+
+		gotProposal, ok := keeper.GetProposal(ctx, proposal.ProposalID)
+		require.True(t, ok)
+		require.True(t, ProposalEqual(keeper, proposal, gotProposal))
+		So(app.StakeKeeper().MaxValidators(ctx), ShouldEqual, maxValidators)
+
+		cacheCtx, _ := ctx.CacheContext()
+		So(keeper.ExecuteContent(cacheCtx, gotProposal.Content), ShouldBeNil)
+		So(app.StakeKeeper().MaxValidators(cacheCtx), ShouldEqual, maxValidators+1)
+
+		// the failing content is named, the former contents are not applied
+		multi = types.NewMultiContentProposal("title", "description", []types.Content{validChange, invalidChange})
+		_, err = keeper.SubmitProposal(ctx, multi)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, "content 1 (ParameterChange)")
+
+		cacheCtx, _ = ctx.CacheContext()
+		So(keeper.ExecuteContent(cacheCtx, multi), ShouldNotBeNil)
+		So(app.StakeKeeper().MaxValidators(ctx), ShouldEqual, maxValidators)
+	})
+}
+
+func TestCommunityPoolSpendProposal(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestCommunityPoolSpendProposal", t, func() {
+		_, _, _, accAlice, accJack, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		distrKeeper := app.DistrKeeper()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		funds := chainTypes.NewInt64CoreCoins(1000)
+		require.NoError(t, distrKeeper.FundCommunityPool(ctx, funds, accJack))
+
+		// the proposal is routed to the distribution module
+		spend := chainTypes.NewInt64CoreCoins(600)
+		content := distrTypes.NewCommunityPoolSpendProposal("spend", "description", accAlice, spend)
+		proposal, err := keeper.SubmitProposal(ctx, content)
+		require.NoError(t, err)
+
+		before := app.AssetKeeper().GetCoinPowers(ctx, accAlice)
+		So(keeper.ExecuteContent(ctx, proposal.Content), ShouldBeNil)
+		So(app.AssetKeeper().GetCoinPowers(ctx, accAlice), ShouldResemble, before.Add(spend...))
+		So(distrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(constants.DefaultBondDenom).TruncateInt64(), ShouldEqual, 400)
+
+		// cannot spend more than the community pool
+		content = distrTypes.NewCommunityPoolSpendProposal("spend", "description", accAlice, spend)
+		_, err = keeper.SubmitProposal(ctx, content)
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -29,6 +29,9 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		case types.QueryDeposit:
 			return queryDeposit(ctx, path[1:], req, keeper)
 
+		case types.QueryDepositsHistory:
+			return queryDepositsHistory(ctx, path[1:], req, keeper)
+
 		case types.QueryVotes:
 			return queryVotes(ctx, path[1:], req, keeper)
 
@@ -38,6 +41,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		case types.QueryTally:
 			return queryTally(ctx, path[1:], req, keeper)
 
+		case types.QueryTallyDetail:
+			return queryTallyDetail(ctx, path[1:], req, keeper)
+
+		case types.QueryVoterPower:
+			return queryVoterPower(ctx, path[1:], req, keeper)
+
 		case types.QueryPunishValidators:
 			return queryPunishedValidators(ctx, path[1:], req, keeper)
 
@@ -51,6 +60,17 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 }
 
 func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	// all params grouped by type in one response, if no param type in path
+	if len(path) == 0 {
+		params := types.NewParams(keeper.GetVotingParams(ctx), keeper.GetTallyParams(ctx), keeper.GetDepositParams(ctx),
+			keeper.GetExpeditedParams(ctx), keeper.GetTypeParams(ctx))
+		bz, err := codec.MarshalJSONIndent(keeper.cdc, params)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		return bz, nil
+	}
+
 	switch path[0] {
 	case types.ParamDeposit:
 		bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetDepositParams(ctx))
@@ -73,6 +93,20 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 		}
 		return bz, nil
 
+	case types.ParamExpedited:
+		bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetExpeditedParams(ctx))
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		return bz, nil
+
+	case types.ParamType:
+		bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetTypeParams(ctx))
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		return bz, nil
+
 	default:
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s is not a valid query request path", req.Path)
 	}
@@ -91,7 +125,8 @@ func queryProposal(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", params.ProposalID)
 	}
 
-	bz, err := codec.MarshalJSONIndent(keeper.cdc, proposal)
+	res := types.NewProposalWithProgress(proposal, keeper.GetProposalProgress(ctx, proposal))
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, res)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -154,6 +189,27 @@ func queryDeposits(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 	return bz, nil
 }
 
+// nolint: unparam
+func queryDepositsHistory(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	disposals := keeper.GetDepositDisposals(ctx, params.ProposalID)
+	if disposals == nil {
+		disposals = types.DepositDisposals{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, disposals)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 // nolint: unparam
 func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	var params types.QueryProposalParams
@@ -191,6 +247,58 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+// nolint: unparam
+func queryTallyDetail(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposal, ok := keeper.GetProposal(ctx, params.ProposalID)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", params.ProposalID)
+	}
+
+	// the votes are deleted after tallied, so the detail is only for the proposals in voting period
+	if proposal.Status != types.StatusVotingPeriod {
+		return nil, sdkerrors.Wrapf(types.ErrInactiveProposal, "proposal %d is not in voting period", params.ProposalID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetTallyDetail(ctx, proposal))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// nolint: unparam
+func queryVoterPower(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryVoteParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposal, ok := keeper.GetProposal(ctx, params.ProposalID)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", params.ProposalID)
+	}
+
+	// the votes are deleted after tallied, so the power is only for the proposals in voting period
+	if proposal.Status != types.StatusVotingPeriod {
+		return nil, sdkerrors.Wrapf(types.ErrInactiveProposal, "proposal %d is not in voting period", params.ProposalID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetVoterPower(ctx, proposal, params.Voter))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 // nolint: unparam
 func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	var params types.QueryProposalVotesParams
@@ -145,6 +145,30 @@ func getQueriedVotes(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sd
 	return votes
 }
 
+func TestQueryAllParams(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestQueryAllParams", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		querier := govKeeper.NewQuerier(*app.GovKeeper())
+
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryParams}, "/"),
+			Data: []byte{},
+		}
+
+		bz, err := querier(ctx, []string{types.QueryParams}, query)
+		require.NoError(t, err)
+		require.NotNil(t, bz)
+
+		var params types.Params
+		require.NoError(t, app.Codec().UnmarshalJSON(bz, &params))
+
+		depositParams, votingParams, tallyParams := getQueriedParams(t, ctx, app.Codec(), querier)
+		require.Equal(t, types.NewParams(votingParams, tallyParams, depositParams, app.GovKeeper().GetExpeditedParams(ctx), app.GovKeeper().GetTypeParams(ctx)), params)
+	})
+}
+
 func TestQuerier(t *testing.T) {
 	wallet := simapp.NewWallet()
 	Convey("TestQueries", t, func() {
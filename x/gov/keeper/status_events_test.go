@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/gov"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// findStatusEvent returns the attributes of the typed status event of the proposal
+func findStatusEvent(events sdk.Events, eventType string, proposalID uint64) (map[string]string, bool) {
+	for _, evt := range events {
+		if evt.Type != eventType {
+			continue
+		}
+
+		attrs := make(map[string]string, len(evt.Attributes))
+		for _, attr := range evt.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+
+		if attrs[types.AttributeKeyProposalID] == fmt.Sprintf("%d", proposalID) {
+			return attrs, true
+		}
+	}
+
+	return nil, false
+}
+
+func TestProposalStatusEvents(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestProposalStatusEvents", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper().EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		createValidators(app, ctx, stakingKeeper, []int64{5, 5, 5})
+
+		// the proposal without deposit is dropped at the end of the deposit period
+		dropped, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+
+		endCtx := ctx.WithBlockTime(dropped.DepositEndTime).WithEventManager(sdk.NewEventManager())
+		gov.EndBlocker(endCtx, *keeper)
+
+		attrs, ok := findStatusEvent(endCtx.EventManager().Events(), types.EventTypeProposalDepositPeriodEnded, dropped.ProposalID)
+		So(ok, ShouldBeTrue)
+		So(attrs[types.AttributeKeyProposalResult], ShouldEqual, types.AttributeValueProposalDropped)
+		So(attrs[types.AttributeKeyProposalType], ShouldEqual, types.ProposalTypeText)
+
+		proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		proposal.Proposer = TestAddrs[0]
+		keeper.SetProposal(ctx, proposal)
+
+		depositCtx := ctx.WithEventManager(sdk.NewEventManager())
+		votingStarted, err := keeper.AddDeposit(depositCtx, proposal.ProposalID, TestAddrs[0], keeper.GetDepositParams(ctx).MinDeposit)
+		require.NoError(t, err)
+		require.True(t, votingStarted)
+
+		proposal, ok = keeper.GetProposal(ctx, proposal.ProposalID)
+		So(ok, ShouldBeTrue)
+
+		attrs, ok = findStatusEvent(depositCtx.EventManager().Events(), types.EventTypeProposalVotingStarted, proposal.ProposalID)
+		So(ok, ShouldBeTrue)
+		So(attrs[types.AttributeKeyProposer], ShouldEqual, TestAddrs[0].String())
+		So(attrs[types.AttributeKeyVotingEndTime], ShouldNotBeEmpty)
+		_, hasResult := attrs[types.AttributeKeyProposalResult]
+		So(hasResult, ShouldBeFalse)
+
+		for _, voter := range []chainTypes.AccountID{valAccAddr1, valAccAddr2, valAccAddr3} {
+			require.NoError(t, keeper.AddVote(ctx, proposal.ProposalID, voter, types.OptionYes))
+		}
+
+		endCtx = ctx.WithBlockTime(proposal.VotingEndTime).WithEventManager(sdk.NewEventManager())
+		gov.EndBlocker(endCtx, *keeper)
+
+		attrs, ok = findStatusEvent(endCtx.EventManager().Events(), types.EventTypeProposalPassed, proposal.ProposalID)
+		So(ok, ShouldBeTrue)
+		So(attrs[types.AttributeKeyProposer], ShouldEqual, TestAddrs[0].String())
+		So(attrs[types.AttributeKeyProposalResult], ShouldEqual, types.AttributeValueProposalPassed)
+
+		_, ok = findStatusEvent(endCtx.EventManager().Events(), types.EventTypeProposalRejected, proposal.ProposalID)
+		So(ok, ShouldBeFalse)
+	})
+}
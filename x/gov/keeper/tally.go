@@ -26,7 +26,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			types.OptionEmpty,
+			nil,
 		)
 
 		return false
@@ -36,7 +36,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		//if validator, just record it in the map
 		valAddrStr := vote.Voter.String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.GetOptions()
 			currValidators[valAddrStr] = val
 		}
 
@@ -47,12 +47,13 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 	var punishValidators []AccountID
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == types.OptionEmpty {
+		if len(val.Vote) == 0 {
 			punishValidators = append(punishValidators, val.Address)
 			continue
 		}
 
-		if val.Vote == types.OptionNoWithVeto {
+		// only the validator voting NoWithVeto by the majority of its weights is punished for the veto
+		if val.Vote.Weight(types.OptionNoWithVeto).GT(sdk.NewDecWithPrec(5, 1)) {
 			vetobp = append(vetobp, val.Address)
 		}
 
@@ -60,11 +61,14 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
 		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		// a weighted vote splits the voting power of the validator by the weights
+		for _, option := range val.Vote {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
+	tallyParams := keeper.GetProposalTallyParams(ctx, proposal)
 	tallyResults = types.NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
@@ -98,6 +102,36 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 	return false, false, tallyResults, punishValidators, false, vetobp
 }
 
+// Turnout returns the current tally result of the proposal in voting period and the turnout,
+// the voted power by the total bonded tokens, the tally is in a cache context so the votes are kept.
+func (keeper Keeper) Turnout(ctx sdk.Context, proposal types.Proposal) (types.TallyResult, sdk.Dec) {
+	cacheCtx, _ := ctx.CacheContext()
+	_, _, tallyResults, _, _, _ := keeper.Tally(cacheCtx, proposal)
+
+	totalBonded := keeper.sk.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
+		return tallyResults, sdk.ZeroDec()
+	}
+
+	voted := tallyResults.Yes.Add(tallyResults.Abstain).Add(tallyResults.No).Add(tallyResults.NoWithVeto)
+	return tallyResults, voted.ToDec().Quo(totalBonded.ToDec())
+}
+
+// GetProposalProgress returns the progress of the proposal, the countdown of the deposit or voting period,
+// and the quorum and threshold status by the current votes in voting period.
+func (keeper Keeper) GetProposalProgress(ctx sdk.Context, proposal types.Proposal) types.ProposalProgress {
+	var (
+		tallyResults = types.EmptyTallyResult()
+		turnout      = sdk.ZeroDec()
+	)
+
+	if proposal.Status == types.StatusVotingPeriod {
+		tallyResults, turnout = keeper.Turnout(ctx, proposal)
+	}
+
+	return types.NewProposalProgress(proposal, ctx.BlockTime(), tallyResults, turnout, keeper.GetProposalTallyParams(ctx, proposal))
+}
+
 func (keeper Keeper) EmergencyPass(ctx sdk.Context, proposalID uint64) (passes bool, tallyResults types.TallyResult) {
 	results := make(map[types.VoteOption]sdk.Dec)
 	results[types.OptionYes] = sdk.ZeroDec()
@@ -114,7 +148,7 @@ func (keeper Keeper) EmergencyPass(ctx sdk.Context, proposalID uint64) (passes b
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			types.OptionEmpty,
+			nil,
 		)
 
 		return false
@@ -124,7 +158,7 @@ func (keeper Keeper) EmergencyPass(ctx sdk.Context, proposalID uint64) (passes b
 		//if validator, just record it in the map
 		valAddrStr := vote.Voter.String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.GetOptions()
 			currValidators[valAddrStr] = val
 		}
 		return false
@@ -132,7 +166,7 @@ func (keeper Keeper) EmergencyPass(ctx sdk.Context, proposalID uint64) (passes b
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == types.OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -140,7 +174,9 @@ func (keeper Keeper) EmergencyPass(ctx sdk.Context, proposalID uint64) (passes b
 		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
 		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
 	}
 
 	tallyParams := keeper.GetTallyParams(ctx)
@@ -187,3 +223,102 @@ func (keeper Keeper) SlashValidator(ctx sdk.Context, validatorAccount AccountID)
 func (keeper Keeper) Slash(ctx sdk.Context) {
 	keeper.distrKeeper.SetStartNotDistributionTimePoint(ctx, ctx.BlockHeader().Time)
 }
+
+// GetTallyDetail returns the live tally detail of the proposal in voting period, the voting power applied of each
+// bonded validator, the power inherited from the delegators of the validator, and the ratios toward the tally params.
+func (keeper Keeper) GetTallyDetail(ctx sdk.Context, proposal types.Proposal) types.TallyDetail {
+	var (
+		validators      []types.ValidatorTallyDetail
+		indexes         = make(map[string]int)
+		delegatorShares = make(map[string]sdk.Dec)
+		inheritedShares = make(map[string]sdk.Dec)
+	)
+
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator external.StakingValidatorI) (stop bool) {
+		valAddrStr := validator.GetOperatorAccountID().String()
+
+		indexes[valAddrStr] = len(validators)
+		delegatorShares[valAddrStr] = validator.GetDelegatorShares()
+		inheritedShares[valAddrStr] = sdk.ZeroDec()
+		validators = append(validators,
+			types.NewValidatorTallyDetail(validator.GetOperatorAccountID(), validator.GetBondedTokens().ToDec(), nil))
+
+		return false
+	})
+
+	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
+		if i, ok := indexes[vote.Voter.String()]; ok {
+			validators[i].Options = vote.GetOptions()
+		}
+		return false
+	})
+
+	// the delegators inherit the vote of the validator, the self delegation is not counted
+	keeper.sk.IterateAllValidatorDelegations(ctx, func(index int64, delegation external.StakingDelegationI) (stop bool) {
+		valAddrStr := delegation.GetValidatorAccountID().String()
+		i, ok := indexes[valAddrStr]
+		if !ok || delegation.GetDelegatorAccountID().Eq(validators[i].Validator) {
+			return false
+		}
+
+		validators[i].Delegators++
+		inheritedShares[valAddrStr] = inheritedShares[valAddrStr].Add(delegation.GetShares())
+		return false
+	})
+
+	for i, validator := range validators {
+		valAddrStr := validator.Validator.String()
+		if shares := delegatorShares[valAddrStr]; shares.IsPositive() {
+			validators[i].InheritedPower = validator.VotingPower.Mul(inheritedShares[valAddrStr]).Quo(shares)
+		}
+	}
+
+	tallyResults, _ := keeper.Turnout(ctx, proposal)
+	return types.NewTallyDetail(proposal.ProposalID, tallyResults, validators,
+		keeper.sk.TotalBondedTokens(ctx), keeper.GetProposalTallyParams(ctx, proposal))
+}
+
+// GetVoterPower returns the effective voting power of the voter on the proposal in voting period, the power
+// of a bonded validator is its bonded tokens if voted, and the power of a delegator is the delegations
+// to the bonded validators which voted, as the tally only counts the votes of the validators.
+func (keeper Keeper) GetVoterPower(ctx sdk.Context, proposal types.Proposal, voter AccountID) types.VoterPower {
+	var (
+		vote           types.WeightedVoteOptions
+		validatorPower *sdk.Dec
+		delegations    []types.DelegationPower
+	)
+
+	if v, ok := keeper.GetVote(ctx, proposal.ProposalID, voter); ok {
+		vote = v.GetOptions()
+	}
+
+	bonded := make(map[string]external.StakingValidatorI)
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator external.StakingValidatorI) (stop bool) {
+		bonded[validator.GetOperatorAccountID().String()] = validator
+		return false
+	})
+
+	if validator, ok := bonded[voter.String()]; ok {
+		power := validator.GetBondedTokens().ToDec()
+		validatorPower = &power
+	}
+
+	keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation external.StakingDelegationI) (stop bool) {
+		d := types.DelegationPower{
+			Validator: delegation.GetValidatorAccountID(),
+			Power:     sdk.ZeroDec(),
+		}
+
+		if validator, ok := bonded[d.Validator.String()]; ok {
+			d.Power = validator.TokensFromShares(delegation.GetShares())
+			if v, ok := keeper.GetVote(ctx, proposal.ProposalID, d.Validator); ok {
+				d.Options = v.GetOptions()
+			}
+		}
+
+		delegations = append(delegations, d)
+		return false
+	})
+
+	return types.NewVoterPower(proposal.ProposalID, voter, vote, validatorPower, delegations)
+}
@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -10,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/gov"
 	"github.com/KuChainNetwork/kuchain/x/gov/types"
 	. "github.com/smartystreets/goconvey/convey"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -486,4 +489,300 @@ func TestTally(t *testing.T) {
 
 		require.True(t, tallyResults.Equals(expectedTallyResult))
 	})
+	Convey("TestTallyWeightedVotes", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper()
+		stakingKeeper = stakingKeeper.EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		createValidators(app, ctx, stakingKeeper, []int64{5, 5, 5})
+
+		tp := TestProposal
+		proposal, err := keeper.SubmitProposal(ctx, tp)
+		require.NoError(t, err)
+		proposalID := proposal.ProposalID
+		proposal.Status = types.StatusVotingPeriod
+		keeper.SetProposal(ctx, proposal)
+
+		options := types.WeightedVoteOptions{
+			types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+			types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(4, 1)),
+		}
+		require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, valAccAddr1, options))
+		require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
+		require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionNo))
+
+		proposal, ok := keeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+		passes, burnDeposits, tallyResults, punishBp, _, _ := keeper.Tally(ctx, proposal)
+
+		require.True(t, passes)
+		require.False(t, burnDeposits)
+		require.Empty(t, punishBp)
+
+		expectedYes := exported.TokensFromConsensusPower(8)
+		expectedAbstain := exported.TokensFromConsensusPower(2)
+		expectedNo := exported.TokensFromConsensusPower(5)
+		expectedNoWithVeto := exported.TokensFromConsensusPower(0)
+		expectedTallyResult := types.NewTallyResult(expectedYes, expectedAbstain, expectedNo, expectedNoWithVeto)
+
+		require.True(t, tallyResults.Equals(expectedTallyResult))
+	})
+	Convey("TestTallyWeightedVotesVetoed", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper()
+		stakingKeeper = stakingKeeper.EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		createValidators(app, ctx, stakingKeeper, []int64{5, 5, 5})
+
+		tp := TestProposal
+		proposal, err := keeper.SubmitProposal(ctx, tp)
+		require.NoError(t, err)
+		proposalID := proposal.ProposalID
+		proposal.Status = types.StatusVotingPeriod
+		keeper.SetProposal(ctx, proposal)
+
+		// a split vote with a minor NoWithVeto weight is not punished for the veto
+		split := types.WeightedVoteOptions{
+			types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+			types.NewWeightedVoteOption(types.OptionNoWithVeto, sdk.NewDecWithPrec(4, 1)),
+		}
+		vetoed := types.WeightedVoteOptions{
+			types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(2, 1)),
+			types.NewWeightedVoteOption(types.OptionNoWithVeto, sdk.NewDecWithPrec(8, 1)),
+		}
+		require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, valAccAddr1, split))
+		require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, valAccAddr2, vetoed))
+		require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionNoWithVeto))
+
+		proposal, ok := keeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+		passes, burnDeposits, _, _, punish, vetobp := keeper.Tally(ctx, proposal)
+
+		require.False(t, passes)
+		require.True(t, burnDeposits)
+		require.True(t, punish)
+		require.Len(t, vetobp, 2)
+		for _, validator := range vetobp {
+			require.False(t, validator.Eq(valAccAddr1))
+		}
+	})
+	Convey("TestTallyDetail", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper()
+		stakingKeeper = stakingKeeper.EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		createValidators(app, ctx, stakingKeeper, []int64{5, 5, 5})
+
+		val1, found := stakingKeeper.GetValidator(ctx, valOpAddr1)
+		require.True(t, found)
+
+		_, err := stakingKeeper.Delegate(ctx, valAccAddr2, exported.TokensFromConsensusPower(5), exported.Unbonded, val1, true)
+		require.NoError(t, err)
+
+		_ = staking.EndBlocker(ctx, *stakingKeeper)
+
+		proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		proposalID := proposal.ProposalID
+		proposal.Status = types.StatusVotingPeriod
+		keeper.SetProposal(ctx, proposal)
+
+		require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
+
+		proposal, ok := keeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+		detail := keeper.GetTallyDetail(ctx, proposal)
+
+		So(detail.ProposalID, ShouldEqual, proposalID)
+		So(detail.Validators, ShouldHaveLength, 3)
+		So(detail.Tally.Yes, ShouldResemble, exported.TokensFromConsensusPower(10))
+
+		voted := 0
+		for _, validator := range detail.Validators {
+			if !validator.Voted() {
+				So(validator.VotingPower, ShouldResemble, exported.TokensFromConsensusPower(5).ToDec())
+				continue
+			}
+
+			voted++
+			So(validator.Validator, ShouldResemble, valOpAddr1)
+			So(validator.VotingPower, ShouldResemble, exported.TokensFromConsensusPower(10).ToDec())
+			So(validator.Delegators, ShouldEqual, 1)
+			So(validator.InheritedPower, ShouldResemble, exported.TokensFromConsensusPower(5).ToDec())
+		}
+		So(voted, ShouldEqual, 1)
+
+		// 10 of 20 bonded voted yes
+		So(detail.Turnout, ShouldResemble, sdk.NewDecWithPrec(5, 1))
+		So(detail.YesRatio, ShouldResemble, sdk.OneDec())
+		So(detail.QuorumProgress, ShouldResemble, detail.Turnout.Quo(detail.Quorum))
+		So(detail.VetoProgress.IsZero(), ShouldBeTrue)
+	})
+	Convey("TestVoterPower", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper()
+		stakingKeeper = stakingKeeper.EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		createValidators(app, ctx, stakingKeeper, []int64{5, 5, 0})
+
+		val1, found := stakingKeeper.GetValidator(ctx, valOpAddr1)
+		require.True(t, found)
+
+		// the validator 3 is not bonded, its account is a delegator of the validator 1
+		_, err := stakingKeeper.Delegate(ctx, valAccAddr3, exported.TokensFromConsensusPower(5), exported.Unbonded, val1, true)
+		require.NoError(t, err)
+
+		_ = staking.EndBlocker(ctx, *stakingKeeper)
+
+		proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		proposal.Status = types.StatusVotingPeriod
+		keeper.SetProposal(ctx, proposal)
+
+		// the delegation counts nothing before the validator votes
+		power := keeper.GetVoterPower(ctx, proposal, valAccAddr3)
+		So(power.Mode, ShouldEqual, types.VoterPowerModeInherited)
+		So(power.EffectivePower.IsZero(), ShouldBeTrue)
+
+		require.NoError(t, keeper.AddVote(ctx, proposal.ProposalID, valAccAddr1, types.OptionYes))
+		require.NoError(t, keeper.AddVote(ctx, proposal.ProposalID, valAccAddr3, types.OptionNo))
+
+		// the delegator inherits the vote of the validator, its own vote does not override it
+		power = keeper.GetVoterPower(ctx, proposal, valAccAddr3)
+		So(power.Mode, ShouldEqual, types.VoterPowerModeInherited)
+		So(power.Vote, ShouldResemble, types.NewNonSplitVoteOption(types.OptionNo))
+		So(power.VoteCounted, ShouldBeFalse)
+		So(power.EffectivePower, ShouldResemble, exported.TokensFromConsensusPower(5).ToDec())
+
+		counted := 0
+		for _, d := range power.Delegations {
+			if d.Counted() {
+				counted++
+				So(d.Validator, ShouldResemble, valOpAddr1)
+				So(d.Options, ShouldResemble, types.NewNonSplitVoteOption(types.OptionYes))
+			}
+		}
+		So(counted, ShouldEqual, 1)
+
+		// the vote of the validator carries all its bonded tokens
+		power = keeper.GetVoterPower(ctx, proposal, valAccAddr1)
+		So(power.Mode, ShouldEqual, types.VoterPowerModeValidator)
+		So(power.VoteCounted, ShouldBeTrue)
+		So(power.EffectivePower, ShouldResemble, exported.TokensFromConsensusPower(10).ToDec())
+
+		power = keeper.GetVoterPower(ctx, proposal, valAccAddr2)
+		So(power.Mode, ShouldEqual, types.VoterPowerModeValidator)
+		So(power.VoteCounted, ShouldBeFalse)
+		So(power.EffectivePower.IsZero(), ShouldBeTrue)
+	})
+}
+
+func TestVoteReminder(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestVoteReminder", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper()
+		stakingKeeper = stakingKeeper.EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		createValidators(app, ctx, stakingKeeper, []int64{5, 5, 5})
+
+		proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		keeper.ActivateVotingPeriod(ctx, proposal)
+		proposalID := proposal.ProposalID
+
+		require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
+
+		proposal, ok := keeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+		tallyResults, turnout := keeper.Turnout(ctx, proposal)
+		require.True(t, turnout.IsPositive())
+		require.True(t, tallyResults.Yes.IsPositive())
+
+		// the votes are kept after the turnout tallied
+		_, found := keeper.GetVote(ctx, proposalID, valAccAddr1)
+		require.True(t, found)
+
+		votingParams := keeper.GetVotingParams(ctx)
+		votingParams.ReminderInterval = 2
+		keeper.SetVotingParams(ctx, votingParams)
+
+		reminders := func(ctx sdk.Context) []sdk.Event {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			gov.EndBlocker(ctx, *keeper)
+
+			var events []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeVoteReminder {
+					events = append(events, event)
+				}
+			}
+			return events
+		}
+
+		require.Empty(t, reminders(ctx.WithBlockHeight(3)))
+
+		events := reminders(ctx.WithBlockHeight(4).WithBlockTime(proposal.VotingEndTime.Add(-time.Hour)))
+		require.Len(t, events, 1)
+
+		attrs := make(map[string]string)
+		for _, attr := range events[0].Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		require.Equal(t, fmt.Sprintf("%d", proposalID), attrs[types.AttributeKeyProposalID])
+		require.Equal(t, time.Hour.String(), attrs[types.AttributeKeyTimeRemaining])
+		require.Equal(t, turnout.String(), attrs[types.AttributeKeyTurnout])
+		require.Equal(t, keeper.GetTallyParams(ctx).Quorum.String(), attrs[types.AttributeKeyQuorum])
+	})
+}
+
+func TestProposalProgress(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestProposalProgress", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper()
+		stakingKeeper = stakingKeeper.EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		createValidators(app, ctx, stakingKeeper, []int64{5, 5, 0})
+
+		proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		proposalID := proposal.ProposalID
+
+		progress := keeper.GetProposalProgress(ctx, proposal)
+		require.Equal(t, int64(keeper.GetDepositParams(ctx).MaxDepositPeriod/time.Second), progress.DepositSecondsRemaining)
+		require.Zero(t, progress.VotingSecondsRemaining)
+		require.False(t, progress.QuorumReached)
+
+		proposal.Status = types.StatusVotingPeriod
+		proposal.VotingStartTime = ctx.BlockTime()
+		proposal.VotingEndTime = ctx.BlockTime().Add(time.Minute)
+		keeper.SetProposal(ctx, proposal)
+
+		require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
+		require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionNoWithVeto))
+
+		proposal, ok := keeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+
+		progress = keeper.GetProposalProgress(ctx.WithBlockTime(ctx.BlockTime().Add(20*time.Second)), proposal)
+		require.Zero(t, progress.DepositSecondsRemaining)
+		require.Equal(t, int64(40), progress.VotingSecondsRemaining)
+		require.True(t, progress.Turnout.IsPositive())
+		require.True(t, progress.QuorumReached)
+		require.False(t, progress.ThresholdReached)
+		require.True(t, progress.Vetoed)
+
+		// the votes should be kept after the progress computed
+		require.Len(t, keeper.GetVotes(ctx, proposalID), 2)
+
+		progress = keeper.GetProposalProgress(ctx.WithBlockTime(proposal.VotingEndTime.Add(time.Second)), proposal)
+		require.Zero(t, progress.VotingSecondsRemaining)
+	})
 }
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetLastSubmitTime returns the time of the last proposal submitted by the proposer
+func (keeper Keeper) GetLastSubmitTime(ctx sdk.Context, proposer AccountID) (time.Time, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := store.Get(types.LastSubmitTimeKey(proposer))
+	if bz == nil {
+		return time.Time{}, false
+	}
+
+	submitTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return submitTime, true
+}
+
+// SetLastSubmitTime sets the time of the last proposal submitted by the proposer
+func (keeper Keeper) SetLastSubmitTime(ctx sdk.Context, proposer AccountID, submitTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.LastSubmitTimeKey(proposer), sdk.FormatTimeBytes(submitTime))
+}
+
+// CountActiveProposals returns the number of the proposals of the proposer in deposit or voting period,
+// which are all the proposals in the inactive and active queues.
+func (keeper Keeper) CountActiveProposals(ctx sdk.Context, proposer AccountID) uint64 {
+	var count uint64
+
+	countProposer := func(proposal types.Proposal) bool {
+		if proposal.Proposer.Eq(proposer) {
+			count++
+		}
+		return false
+	}
+
+	keeper.IterateAllInactiveProposalsQueue(ctx, countProposer)
+	keeper.IterateAllActiveProposalsQueue(ctx, countProposer)
+
+	return count
+}
+
+// ValidateProposerThrottle checks the proposer can submit a new proposal, the active proposals of the proposer
+// should be less than the max active proposals, and the last submission should be before the cooldown.
+func (keeper Keeper) ValidateProposerThrottle(ctx sdk.Context, proposer AccountID) error {
+	depositParams := keeper.GetDepositParams(ctx)
+
+	if depositParams.MaxActiveProposals > 0 {
+		if count := keeper.CountActiveProposals(ctx, proposer); count >= depositParams.MaxActiveProposals {
+			return sdkerrors.Wrapf(types.ErrTooManyActiveProposals, "%s has %d active proposals, max %d",
+				proposer, count, depositParams.MaxActiveProposals)
+		}
+	}
+
+	if depositParams.SubmitCooldown > 0 {
+		lastSubmitTime, found := keeper.GetLastSubmitTime(ctx, proposer)
+		if found {
+			if nextSubmitTime := lastSubmitTime.Add(depositParams.SubmitCooldown); ctx.BlockTime().Before(nextSubmitTime) {
+				return sdkerrors.Wrapf(types.ErrSubmitCooldown, "%s can submit after %s", proposer, nextSubmitTime)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+	paramproposal "github.com/KuChainNetwork/kuchain/x/params/types/proposal"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestProposalTypeParams(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestProposalTypeParams", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		k := app.GovKeeper()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		denom := app.StakeKeeper().BondDenom(ctx)
+
+		depositParams := k.GetDepositParams(ctx)
+		depositParams.MinDeposit = chainTypes.NewCoins(chainTypes.NewCoin(denom, sdk.NewInt(1000)))
+		k.SetDepositParams(ctx, depositParams)
+
+		// the text proposals need the higher deposit and thresholds in a shorter voting period
+		textParams := types.NewProposalTypeParams(types.ProposalTypeText,
+			chainTypes.NewCoins(chainTypes.NewCoin(denom, sdk.NewInt(3000))), 2*time.Hour, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(75, 2))
+		k.SetTypeParams(ctx, types.NewTypeParams(textParams))
+
+		proposal, err := k.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		So(k.GetProposalMinDeposit(ctx, proposal), ShouldResemble, textParams.MinDeposit)
+
+		votingStarted, err := k.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], depositParams.MinDeposit)
+		require.NoError(t, err)
+		So(votingStarted, ShouldBeFalse)
+
+		votingStarted, err = k.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], depositParams.MinDeposit.Add(depositParams.MinDeposit...))
+		require.NoError(t, err)
+		So(votingStarted, ShouldBeTrue)
+
+		proposal, ok := k.GetProposal(ctx, proposal.ProposalID)
+		require.True(t, ok)
+		So(proposal.VotingEndTime, ShouldResemble, proposal.VotingStartTime.Add(textParams.VotingPeriod))
+
+		tallyParams := k.GetProposalTallyParams(ctx, proposal)
+		So(tallyParams.Quorum, ShouldResemble, textParams.Quorum)
+		So(tallyParams.Threshold, ShouldResemble, textParams.Threshold)
+		So(tallyParams.Veto, ShouldResemble, k.GetTallyParams(ctx).Veto)
+
+		// the expedited proposals never lower the thresholds of the type
+		proposal.Expedited = true
+		So(k.GetProposalTallyParams(ctx, proposal).Threshold, ShouldResemble, textParams.Threshold)
+		So(k.GetProposalTallyParams(ctx, proposal).Quorum, ShouldResemble, k.GetExpeditedParams(ctx).Quorum)
+
+		// the other types use the general params
+		change := paramproposal.NewParameterChangeProposal("param", "description", []paramproposal.ParamChange{})
+		other := types.NewProposal(change, 2, time.Time{}, time.Time{})
+		So(k.GetProposalMinDeposit(ctx, other), ShouldResemble, depositParams.MinDeposit)
+		So(k.GetProposalVotingPeriod(ctx, other), ShouldEqual, k.GetVotingParams(ctx).VotingPeriod)
+		So(k.GetProposalTallyParams(ctx, other), ShouldResemble, k.GetTallyParams(ctx))
+	})
+
+	Convey("TestProposalTypeParamsValidation", t, func() {
+		params := types.DefaultParams()
+		genesis := types.NewGenesisState(1, params.DepositParams, params.VotingParams, params.TallyParams, params.ExpeditedParams,
+			types.NewTypeParams(types.NewProposalTypeParams(types.ProposalTypeText, nil, 0, sdk.NewDecWithPrec(5, 1), sdk.Dec{})))
+		So(types.ValidateGenesis(genesis), ShouldBeNil)
+
+		genesis.TypeParams = types.NewTypeParams(types.NewProposalTypeParams("Unknown", nil, 0, sdk.Dec{}, sdk.Dec{}))
+		So(types.ValidateGenesis(genesis), ShouldNotBeNil)
+
+		genesis.TypeParams = types.NewTypeParams(
+			types.NewProposalTypeParams(types.ProposalTypeText, nil, 0, sdk.Dec{}, sdk.Dec{}),
+			types.NewProposalTypeParams(types.ProposalTypeText, nil, time.Hour, sdk.Dec{}, sdk.Dec{}))
+		So(types.ValidateGenesis(genesis), ShouldNotBeNil)
+
+		genesis.TypeParams = types.NewTypeParams(types.NewProposalTypeParams(types.ProposalTypeText, nil, 0, sdk.NewDec(2), sdk.Dec{}))
+		So(types.ValidateGenesis(genesis), ShouldNotBeNil)
+	})
+}
@@ -10,6 +10,15 @@ import (
 
 // AddVote adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr AccountID, option types.VoteOption) error {
+	if !types.ValidVoteOption(option) {
+		return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
+	}
+
+	return keeper.AddWeightedVote(ctx, proposalID, voterAddr, types.NewNonSplitVoteOption(option))
+}
+
+// AddWeightedVote adds a vote split across the weighted options on a specific proposal
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr AccountID, options types.WeightedVoteOptions) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -18,22 +27,26 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr Accou
 		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
-	if !types.ValidVoteOption(option) {
-		return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
+	if err := types.ValidWeightedVoteOptions(options); err != nil {
+		return err
 	}
 	validatorVoter := keeper.sk.Validator(ctx, voterAddr)
 	if validatorVoter == nil {
 		return sdkerrors.Wrap(types.ErrInvalidVoter, voterAddr.String())
 	}
 
-	vote := types.NewVote(proposalID, voterAddr, option)
+	vote := types.NewWeightedVote(proposalID, voterAddr, options)
 	keeper.SetVote(ctx, vote)
 
+	// called after a vote on a proposal is cast
+	keeper.AfterProposalVote(ctx, proposalID, voterAddr)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, voteOptionAttribute(vote)),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyVoter, voterAddr.String()),
 		),
 	)
 
@@ -122,3 +135,13 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr Ac
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
+
+// voteOptionAttribute returns the option attribute of the vote event,
+// the option of the vote not split is kept as before for the clients
+func voteOptionAttribute(vote types.Vote) string {
+	if len(vote.Options) == 0 {
+		return vote.Option.String()
+	}
+
+	return vote.Options.String()
+}
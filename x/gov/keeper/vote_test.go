@@ -3,12 +3,14 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/KuChainNetwork/kuchain/test/simapp"
 	"github.com/KuChainNetwork/kuchain/x/gov/types"
 	. "github.com/smartystreets/goconvey/convey"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func TestVotes(t *testing.T) {
@@ -72,3 +74,102 @@ func TestVotes(t *testing.T) {
 		require.Equal(t, types.OptionNoWithVeto, votes[0].Option)
 	})
 }
+
+func TestWeightedVotes(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestWeightedVotes", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.GovKeeper()
+		stakingKeeper := app.StakeKeeper()
+		stakingKeeper = stakingKeeper.EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+		createValidators(app, ctx, stakingKeeper, powers)
+		tp := TestProposal
+		proposal, err := keeper.SubmitProposal(ctx, tp)
+		require.NoError(t, err)
+		proposalID := proposal.ProposalID
+		proposal.Status = types.StatusVotingPeriod
+		keeper.SetProposal(ctx, proposal)
+
+		options, err := types.WeightedVoteOptionsFromString("Yes=0.6,Abstain=0.4")
+		require.NoError(t, err)
+
+		invalidSum, err := types.WeightedVoteOptionsFromString("Yes=0.6,Abstain=0.5")
+		require.NoError(t, err)
+		duplicated, err := types.WeightedVoteOptionsFromString("Yes=0.5,Yes=0.5")
+		require.NoError(t, err)
+
+		require.Error(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], invalidSum), "weights not sum to 1")
+		require.Error(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], duplicated), "duplicated options")
+		require.Error(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], types.WeightedVoteOptions{}), "no options")
+
+		require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], options))
+		vote, found := keeper.GetVote(ctx, proposalID, TestAddrs[0])
+		require.True(t, found)
+		require.Equal(t, types.OptionEmpty, vote.Option)
+		require.True(t, options.Equal(vote.GetOptions()))
+		require.True(t, sdk.NewDecWithPrec(6, 1).Equal(vote.GetOptions().Weight(types.OptionYes)))
+
+		// a weighted vote not split is stored as a single option vote
+		require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+		vote, found = keeper.GetVote(ctx, proposalID, TestAddrs[1])
+		require.True(t, found)
+		require.Equal(t, types.OptionNo, vote.Option)
+		require.Empty(t, vote.Options)
+
+		_, err = types.WeightedVoteOptionsFromString("Maybe=1")
+		require.Error(t, err)
+	})
+}
+
+func TestVoteReceipt(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestVoteReceipt", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+
+		header := abci.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		ctx := app.BaseApp.NewContext(false, header)
+
+		vote := types.NewVote(1, TestAddrs[0], types.OptionYes)
+		app.GovKeeper().SetVote(ctx, vote)
+
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+
+		key := types.VoteKey(vote.ProposalID, vote.Voter)
+		res := app.Query(abci.RequestQuery{
+			Path:   "/store/" + types.StoreKey + "/key",
+			Data:   key,
+			Height: header.Height,
+			Prove:  true,
+		})
+		require.True(t, res.IsOK(), res.Log)
+
+		// the app hash of the state at header.Height is in the next header
+		signedHeader := &tmtypes.SignedHeader{
+			Header: &tmtypes.Header{Height: header.Height + 1, AppHash: app.LastCommitID().Hash},
+		}
+
+		receipt := types.NewVoteReceipt(vote, res.Height, key, res.Value, res.Proof, signedHeader)
+		require.NoError(t, receipt.Verify(app.Codec()))
+
+		// the vote option not match the stored
+		forged := receipt
+		forged.Vote = types.NewVote(1, TestAddrs[0], types.OptionNo)
+		require.True(t, types.ErrInvalidVoteReceipt.Is(forged.Verify(app.Codec())))
+
+		// the value not in the state
+		forged = receipt
+		forged.Vote = types.NewVote(1, TestAddrs[0], types.OptionNo)
+		forged.Value = app.Codec().MustMarshalBinaryBare(&forged.Vote)
+		require.True(t, types.ErrInvalidVoteReceipt.Is(forged.Verify(app.Codec())))
+
+		// the header not for the state
+		forged = receipt
+		forged.Header = &tmtypes.SignedHeader{
+			Header: &tmtypes.Header{Height: header.Height + 1, AppHash: []byte("app hash")},
+		}
+		require.True(t, types.ErrInvalidVoteReceipt.Is(forged.Verify(app.Codec())))
+	})
+}
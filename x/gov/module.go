@@ -7,9 +7,11 @@ import (
 	"math/rand"
 
 	"github.com/KuChainNetwork/kuchain/chain/genesis"
+	"github.com/KuChainNetwork/kuchain/chain/grpcserver"
 	"github.com/KuChainNetwork/kuchain/chain/msg"
 	"github.com/KuChainNetwork/kuchain/x/gov/client"
 	"github.com/KuChainNetwork/kuchain/x/gov/client/cli"
+	"github.com/KuChainNetwork/kuchain/x/gov/client/grpcquery"
 	"github.com/KuChainNetwork/kuchain/x/gov/client/rest"
 	"github.com/KuChainNetwork/kuchain/x/gov/simulation"
 	"github.com/KuChainNetwork/kuchain/x/gov/types"
@@ -21,12 +23,14 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
 )
 
 var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ grpcserver.Module          = AppModuleBasic{}
 )
 
 // AppModuleBasic defines the basic application module used by the gov module.
@@ -63,6 +67,11 @@ func (a AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Rout
 	rest.RegisterRoutes(ctx, rtr, proposalRESTHandlers)
 }
 
+// RegisterGRPCServices registers the gRPC query service of the gov module.
+func (AppModuleBasic) RegisterGRPCServices(server *grpc.Server, querier grpcserver.ABCIQuerier) {
+	types.RegisterQueryServer(server, grpcquery.NewQueryServer(types.Cdc(), querier))
+}
+
 // GetTxCmd returns the root tx command for the gov module.
 func (a AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
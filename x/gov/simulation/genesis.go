@@ -114,9 +114,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	govGenesis := types.NewGenesisState(
 		startingProposalID,
-		types.NewDepositParams(minDeposit, depositPeriod),
-		types.NewVotingParams(votingPeriod),
+		types.NewDepositParams(minDeposit, depositPeriod, types.DefaultCancelBurnRate, types.DefaultVetoBurnRate, types.DefaultMinInitialDepositRatio,
+			types.DefaultMaxActiveProposals, types.DefaultSubmitCooldown),
+		types.NewVotingParams(votingPeriod, types.DefaultReminderInterval),
 		types.NewTallyParams(quorum, threshold, veto, emergency, punishPeriod, quorum),
+		types.DefaultExpeditedParams(),
+		types.DefaultTypeParams(),
 	)
 
 	fmt.Printf("Selected randomly generated governance parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, govGenesis))
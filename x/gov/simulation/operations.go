@@ -1,6 +1,7 @@
 package simulation
 
 import (
+	"errors"
 	"math"
 	"math/rand"
 	"time"
@@ -21,8 +22,9 @@ var initialProposalID = uint64(100000000000000)
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgDeposit = "op_weight_msg_deposit"
-	OpWeightMsgVote    = "op_weight_msg_vote"
+	OpWeightMsgDeposit   = "op_weight_msg_deposit"
+	OpWeightMsgVote      = "op_weight_msg_vote"
+	OpWeightMsgGovUnjail = "op_weight_msg_gov_unjail"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -32,8 +34,9 @@ func WeightedOperations(
 ) simulation.WeightedOperations {
 
 	var (
-		weightMsgDeposit int
-		weightMsgVote    int
+		weightMsgDeposit   int
+		weightMsgVote      int
+		weightMsgGovUnjail int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
@@ -48,6 +51,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgGovUnjail, &weightMsgGovUnjail, nil,
+		func(_ *rand.Rand) {
+			weightMsgGovUnjail = simappparams.DefaultWeightMsgUnjail
+		},
+	)
+
 	// generate the weighted operations for the proposal contents
 	var wProposalOps simulation.WeightedOperations
 
@@ -75,6 +84,10 @@ func WeightedOperations(
 			weightMsgVote,
 			SimulateMsgVote(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgGovUnjail,
+			SimulateMsgGovUnjail(ak, bk, k),
+		),
 	}
 
 	return append(wProposalOps, wGovOps...)
@@ -300,6 +313,73 @@ func operationSimulateMsgVote(ak types.AccountKeeper, bk types.BankKeeper, k kee
 	}
 }
 
+// SimulateMsgGovUnjail generates a MsgGovUnjail for a random validator punished by the governance.
+func SimulateMsgGovUnjail(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		punishValidators := k.GetPunishValidators(ctx)
+		if len(punishValidators) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil // skip
+		}
+
+		punishValidator := punishValidators[r.Intn(len(punishValidators))]
+
+		valAccAddress, ok := punishValidator.ValidatorAccount.ToAccAddress()
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil // skip
+		}
+
+		simAccount, found := simulation.FindAccount(accs, valAccAddress)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil // skip
+		}
+
+		msg := types.NewMsgGovUnjail(simAccount.Address, punishValidator.ValidatorAccount)
+
+		account := ak.GetAccount(ctx, punishValidator.ValidatorAccount)
+		spendable := bk.SpendableCoins(ctx, punishValidator.ValidatorAccount)
+
+		fees, err := kuSim.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{0}, // TODO: sim support new seq []uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+
+		// result should fail if the validator is still in jailed period
+		if punishValidator.JailedUntil.After(ctx.BlockHeader().Time) {
+			if err == nil {
+				return simulation.NewOperationMsg(msg, true, ""), nil, errors.New("validator unjailed while validator still in jail period")
+			}
+
+			// msg failed as expected
+			return simulation.NewOperationMsg(msg, false, ""), nil, nil
+		}
+
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		if _, found := k.GetPunishValidator(ctx, punishValidator.ValidatorAccount); found {
+			return simulation.NewOperationMsg(msg, true, ""), nil, errors.New("validator still punished after unjailed")
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // Pick a random deposit with a random denomination with a
 // deposit amount between (0, min(balance, minDepositAmount))
 // This is to simulate multiple users depositing to get the
@@ -19,4 +19,5 @@ var (
 	NewCoin              = types.NewCoin
 	NewCoins             = types.NewCoins
 	NewAccountIDFromByte = types.NewAccountIDFromByte
+	EmptyAccountID       = types.EmptyAccountID
 )
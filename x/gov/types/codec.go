@@ -12,12 +12,24 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "kuchain/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(&MsgDeposit{}, "kuchain/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgVote{}, "kuchain/MsgVote", nil)
+	cdc.RegisterConcrete(&MsgVoteWeighted{}, "kuchain/MsgVoteWeighted", nil)
+	cdc.RegisterConcrete(&MsgCancelProposal{}, "kuchain/MsgCancelProposal", nil)
 	cdc.RegisterConcrete(TextProposal{}, "kuchain/TextProposal", nil)
+	cdc.RegisterConcrete(MultiContentProposal{}, "kuchain/MultiContentProposal", nil)
 
 	cdc.RegisterConcrete(KuMsgSubmitProposal{}, "kuchain/kuMsgSubmitProposal", nil)
 	cdc.RegisterConcrete(KuMsgDeposit{}, "kuchain/kuMsgDeposit", nil)
 	cdc.RegisterConcrete(KuMsgVote{}, "kuchain/kuMsgVote", nil)
+	cdc.RegisterConcrete(KuMsgVoteWeighted{}, "kuchain/kuMsgVoteWeighted", nil)
+	cdc.RegisterConcrete(KuMsgCancelProposal{}, "kuchain/kuMsgCancelProposal", nil)
 	cdc.RegisterConcrete(MsgGovUnJail{}, "kuchain/MsgGovUnJail", nil)
+
+	cdc.RegisterConcrete(MsgSubmitProposalResponse{}, "kuchain/MsgSubmitProposalResponse", nil)
+	cdc.RegisterConcrete(MsgDepositResponse{}, "kuchain/MsgDepositResponse", nil)
+	cdc.RegisterConcrete(MsgVoteResponse{}, "kuchain/MsgVoteResponse", nil)
+	cdc.RegisterConcrete(MsgVoteWeightedResponse{}, "kuchain/MsgVoteWeightedResponse", nil)
+	cdc.RegisterConcrete(MsgCancelProposalResponse{}, "kuchain/MsgCancelProposalResponse", nil)
+	cdc.RegisterConcrete(MsgGovUnjailResponse{}, "kuchain/MsgGovUnjailResponse", nil)
 }
 
 // RegisterProposalTypeCodec registers an external proposal content type defined
@@ -28,6 +40,10 @@ func RegisterCodec(cdc *codec.Codec) {
 // Amino codec for serialization.
 func RegisterProposalTypeCodec(o interface{}, name string) {
 	ModuleCdc.RegisterConcrete(o, name, nil)
+
+	if content, ok := o.(Content); ok {
+		proposalContentTemplates[content.ProposalType()] = content
+	}
 }
 
 var (
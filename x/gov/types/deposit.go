@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
@@ -62,3 +63,45 @@ func (d Deposits) String() string {
 func (d Deposit) Empty() bool {
 	return d.Equal(Deposit{})
 }
+
+// DepositDisposal records how a deposit is disposed at the resolution of the proposal,
+// the deposit is refunded to the depositor or burned, or split by the cancel burn rate.
+type DepositDisposal struct {
+	ProposalID uint64    `json:"proposal_id" yaml:"proposal_id"`
+	Depositor  AccountID `json:"depositor" yaml:"depositor"`
+	Refunded   Coins     `json:"refunded" yaml:"refunded"`
+	Burned     Coins     `json:"burned" yaml:"burned"`
+	Height     int64     `json:"height" yaml:"height"`
+	Time       time.Time `json:"time" yaml:"time"`
+}
+
+// NewDepositDisposal creates a new DepositDisposal instance
+func NewDepositDisposal(deposit Deposit, refunded, burned Coins, height int64, t time.Time) DepositDisposal {
+	return DepositDisposal{
+		ProposalID: deposit.ProposalID,
+		Depositor:  deposit.Depositor,
+		Refunded:   refunded,
+		Burned:     burned,
+		Height:     height,
+		Time:       t,
+	}
+}
+
+func (d DepositDisposal) String() string {
+	out, _ := yaml.Marshal(d)
+	return string(out)
+}
+
+// DepositDisposals is a collection of DepositDisposal objects
+type DepositDisposals []DepositDisposal
+
+func (d DepositDisposals) String() string {
+	if len(d) == 0 {
+		return "[]"
+	}
+	out := fmt.Sprintf("Deposits disposal for Proposal %d at height %d:", d[0].ProposalID, d[0].Height)
+	for _, dis := range d {
+		out += fmt.Sprintf("\n  %s: refunded %s, burned %s", dis.Depositor, dis.Refunded, dis.Burned)
+	}
+	return out
+}
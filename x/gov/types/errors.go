@@ -18,4 +18,9 @@ var (
 	ErrBadValidatorAddr        = sdkerrors.Register(ModuleName, 11, "validator does not exist for that address")
 	ErrValidatorNoPunish       = sdkerrors.Register(ModuleName, 12, "validator does not be punished")
 	ErrValidatorJailed         = sdkerrors.Register(ModuleName, 13, "validator still jailed; cannot be unjailed")
+	ErrInvalidVoteReceipt      = sdkerrors.Register(ModuleName, 14, "invalid vote receipt")
+	ErrInvalidProposer         = sdkerrors.Register(ModuleName, 15, "invalid proposer")
+	ErrMinInitialDeposit       = sdkerrors.Register(ModuleName, 16, "initial deposit is less than the min initial deposit")
+	ErrTooManyActiveProposals  = sdkerrors.Register(ModuleName, 17, "too many active proposals of the proposer")
+	ErrSubmitCooldown          = sdkerrors.Register(ModuleName, 18, "proposer submitted a proposal too recently")
 )
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Governance module event types
 const (
 	EventTypeSubmitProposal   = "submit_proposal"
@@ -7,15 +13,55 @@ const (
 	EventTypeProposalVote     = "proposal_vote"
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
+	EventTypeVoteReminder     = "vote_reminder"
+	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeDepositRefund    = "proposal_deposit_refund"
+	EventTypeDepositBurn      = "proposal_deposit_burn"
+	EventTypeVetoSlash        = "proposal_veto_slash"
+
+	// the typed events of the proposal status changes, for the notifications of the explorers and the wallets
+	EventTypeProposalDepositPeriodEnded = "proposal_deposit_period_ended"
+	EventTypeProposalVotingStarted      = "proposal_voting_started"
+	EventTypeProposalPassed             = "proposal_passed"
+	EventTypeProposalRejected           = "proposal_rejected"
+	EventTypeProposalFailed             = "proposal_failed"
 
-	AttributeKeyProposalResult     = "proposal_result"
-	AttributeKeyOption             = "option"
-	AttributeKeyProposalID         = "proposal_id"
-	AttributeKeyVotingPeriodStart  = "voting_period_start"
-	AttributeValueCategory         = "governance"
-	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
-	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
-	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
-	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
-	AttributeKeyProposalType       = "proposal_type"
+	AttributeKeyProposalResult      = "proposal_result"
+	AttributeKeyOption              = "option"
+	AttributeKeyProposalID          = "proposal_id"
+	AttributeKeyVotingPeriodStart   = "voting_period_start"
+	AttributeValueCategory          = "governance"
+	AttributeValueProposalDropped   = "proposal_dropped"   // didn't meet min deposit
+	AttributeValueProposalPassed    = "proposal_passed"    // met vote quorum
+	AttributeValueProposalRejected  = "proposal_rejected"  // didn't meet vote quorum
+	AttributeValueProposalFailed    = "proposal_failed"    // error on proposal handler
+	AttributeValueProposalConverted = "proposal_converted" // expedited vote failed, converted to a normal proposal
+	AttributeKeyProposalType        = "proposal_type"
+	AttributeKeyVotingEndTime       = "voting_end_time"
+	AttributeKeyTimeRemaining       = "time_remaining"
+	AttributeKeyTurnout             = "turnout"
+	AttributeKeyQuorum              = "quorum"
+	AttributeKeyProposer            = "proposer"
+	AttributeKeyBurned              = "burned"
+	AttributeKeyRefunded            = "refunded"
+	AttributeKeyExpedited           = "expedited"
+	AttributeKeyDepositor           = "depositor"
+	AttributeKeyVoter               = "voter"
 )
+
+// NewProposalStatusEvent creates the typed event of the proposal status changed, with the id, the proposer
+// and the type of the proposal, the result attribute is omitted if empty.
+func NewProposalStatusEvent(eventType string, proposal Proposal, result string, attrs ...sdk.Attribute) sdk.Event {
+	evt := sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+		sdk.NewAttribute(AttributeKeyProposer, proposal.Proposer.String()),
+		sdk.NewAttribute(AttributeKeyProposalType, proposal.ProposalType()),
+	)
+
+	if result != "" {
+		evt = evt.AppendAttributes(sdk.NewAttribute(AttributeKeyProposalResult, result))
+	}
+
+	return evt.AppendAttributes(attrs...)
+}
@@ -1,5 +1,7 @@
 package types
 
+//go:generate mockgen -package testutil -destination ../testutil/expected_keepers_mocks.go . ParamSubspace,SupplyKeeper,StakingKeeper,AccountKeeper,BankKeeper,DistributionKeeper
+
 import (
 	"time"
 
@@ -11,6 +13,7 @@ import (
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
 type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
 	Set(ctx sdk.Context, key []byte, param interface{})
 }
 
@@ -40,6 +43,9 @@ type StakingKeeper interface {
 		ctx sdk.Context, delegator AccountID,
 		fn func(index int64, delegation external.StakingDelegationI) (stop bool),
 	)
+	IterateAllValidatorDelegations(
+		ctx sdk.Context, fn func(index int64, delegation external.StakingDelegationI) (stop bool),
+	)
 
 	Validator(sdk.Context, AccountID) external.StakingValidatorI
 	JailByAccount(ctx sdk.Context, account AccountID)
@@ -66,3 +72,13 @@ type DistributionKeeper interface {
 
 	SetStartNotDistributionTimePoint(ctx sdk.Context, t time.Time)
 }
+
+// GovHooks event hooks for governance proposal object, other modules can react to
+// the governance lifecycle events by the hooks set to the gov keeper (noalias)
+type GovHooks interface {
+	AfterProposalSubmission(ctx sdk.Context, proposalID uint64)                       // Must be called after a proposal is submitted
+	AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr AccountID) // Must be called after a deposit is made
+	AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr AccountID)        // Must be called after a vote on a proposal is cast
+	AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64)                 // Must be called when proposal fails to reach min deposit
+	AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64)                // Must be called when proposal finishes its voting period
+}
@@ -9,22 +9,27 @@ import (
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	StartingProposalID uint64        `json:"starting_proposal_id" yaml:"starting_proposal_id"`
-	Deposits           Deposits      `json:"deposits" yaml:"deposits"`
-	Votes              Votes         `json:"votes" yaml:"votes"`
-	Proposals          Proposals     `json:"proposals" yaml:"proposals"`
-	DepositParams      DepositParams `json:"deposit_params" yaml:"deposit_params"`
-	VotingParams       VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams        TallyParams   `json:"tally_params" yaml:"tally_params"`
+	StartingProposalID uint64          `json:"starting_proposal_id" yaml:"starting_proposal_id"`
+	Deposits           Deposits        `json:"deposits" yaml:"deposits"`
+	Votes              Votes           `json:"votes" yaml:"votes"`
+	Proposals          Proposals       `json:"proposals" yaml:"proposals"`
+	DepositParams      DepositParams   `json:"deposit_params" yaml:"deposit_params"`
+	VotingParams       VotingParams    `json:"voting_params" yaml:"voting_params"`
+	TallyParams        TallyParams     `json:"tally_params" yaml:"tally_params"`
+	ExpeditedParams    ExpeditedParams `json:"expedited_params" yaml:"expedited_params"`
+	TypeParams         TypeParams      `json:"type_params" yaml:"type_params"`
 }
 
 // NewGenesisState creates a new genesis state for the governance module
-func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams) GenesisState {
+func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams, ep ExpeditedParams,
+	typeParams TypeParams) GenesisState {
 	return GenesisState{
 		StartingProposalID: startingProposalID,
 		DepositParams:      dp,
 		VotingParams:       vp,
 		TallyParams:        tp,
+		ExpeditedParams:    ep,
+		TypeParams:         typeParams,
 	}
 }
 
@@ -35,6 +40,8 @@ func DefaultGenesisState() GenesisState {
 		DefaultDepositParams(),
 		DefaultVotingParams(),
 		DefaultTallyParams(),
+		DefaultExpeditedParams(),
+		DefaultTypeParams(),
 	)
 }
 
@@ -45,7 +52,9 @@ func (data GenesisState) Equal(other GenesisState) bool {
 		data.Proposals.Equal(other.Proposals) &&
 		data.DepositParams.Equal(other.DepositParams) &&
 		data.TallyParams.Equal(other.TallyParams) &&
-		data.VotingParams.Equal(other.VotingParams)
+		data.VotingParams.Equal(other.VotingParams) &&
+		data.ExpeditedParams.Equal(other.ExpeditedParams) &&
+		data.TypeParams.Equal(other.TypeParams)
 }
 
 // IsEmpty returns true if a GenesisState is empty
@@ -83,5 +92,38 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	if rate := data.DepositParams.GetCancelBurnRate(); rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance cancel burn rate should be positive and less or equal to one, is %s",
+			rate.String())
+	}
+
+	if rate := data.DepositParams.GetVetoBurnRate(); rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance veto burn rate should be positive and less or equal to one, is %s",
+			rate.String())
+	}
+
+	if ratio := data.DepositParams.GetMinInitialDepositRatio(); ratio.IsNegative() || ratio.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance min initial deposit ratio should be positive and less or equal to one, is %s",
+			ratio.String())
+	}
+
+	if err := validateExpeditedParams(data.ExpeditedParams); err != nil {
+		return fmt.Errorf("governance expedited params invalid: %w", err)
+	}
+
+	if data.ExpeditedParams.VotingPeriod >= data.VotingParams.VotingPeriod {
+		return fmt.Errorf("governance expedited voting period should be shorter than the voting period, is %s",
+			data.ExpeditedParams.VotingPeriod)
+	}
+
+	if data.ExpeditedParams.Threshold.LT(threshold) {
+		return fmt.Errorf("governance expedited vote threshold should not be less than the vote threshold, is %s",
+			data.ExpeditedParams.Threshold.String())
+	}
+
+	if err := validateTypeParams(data.TypeParams); err != nil {
+		return fmt.Errorf("governance proposal type params invalid: %w", err)
+	}
+
 	return nil
 }
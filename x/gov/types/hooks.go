@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ GovHooks = MultiGovHooks{}
+
+// combine multiple gov hooks, all hook functions are run in array sequence
+type MultiGovHooks []GovHooks
+
+func NewMultiGovHooks(hooks ...GovHooks) MultiGovHooks {
+	return hooks
+}
+
+// nolint
+func (h MultiGovHooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
+	for i := range h {
+		h[i].AfterProposalSubmission(ctx, proposalID)
+	}
+}
+func (h MultiGovHooks) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr AccountID) {
+	for i := range h {
+		h[i].AfterProposalDeposit(ctx, proposalID, depositorAddr)
+	}
+}
+func (h MultiGovHooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr AccountID) {
+	for i := range h {
+		h[i].AfterProposalVote(ctx, proposalID, voterAddr)
+	}
+}
+func (h MultiGovHooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {
+	for i := range h {
+		h[i].AfterProposalFailedMinDeposit(ctx, proposalID)
+	}
+}
+func (h MultiGovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	for i := range h {
+		h[i].AfterProposalVotingPeriodEnded(ctx, proposalID)
+	}
+}
@@ -47,6 +47,10 @@ var (
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x40<proposerAddr_Bytes>: last submit time of the proposer
+//
+// - 0x50<proposalID_Bytes><depositorAddr_Bytes>: DepositDisposal
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -58,6 +62,10 @@ var (
 	VotesKeyPrefix = []byte{0x20}
 
 	ValidatorKeyPrefix = []byte{0x30}
+
+	LastSubmitTimeKeyPrefix = []byte{0x40}
+
+	DepositDisposalsKeyPrefix = []byte{0x50}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -179,3 +187,18 @@ func splitKeyWithAddress(key []byte) (proposalID uint64, addr AccountID) {
 func GetValidatorKey(validatorAccount AccountID) []byte {
 	return append(ValidatorKeyPrefix, validatorAccount.StoreKey()...)
 }
+
+// LastSubmitTimeKey key of the last submit time of the proposer
+func LastSubmitTimeKey(proposer AccountID) []byte {
+	return append(LastSubmitTimeKeyPrefix, proposer.StoreKey()...)
+}
+
+// DepositDisposalsKey gets the first part of the deposit disposals key based on the proposalID
+func DepositDisposalsKey(proposalID uint64) []byte {
+	return append(DepositDisposalsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// DepositDisposalKey key of the disposal of a specific deposit from the store
+func DepositDisposalKey(proposalID uint64, depositorAddr AccountID) []byte {
+	return append(DepositDisposalsKey(proposalID), depositorAddr.Value...)
+}
@@ -16,6 +16,16 @@ type KuMsgSubmitProposal struct {
 }
 
 func NewKuMsgSubmitProposal(auth sdk.AccAddress, content Content, initialDeposit Coins, proposer AccountID) KuMsgSubmitProposal {
+	return newKuMsgSubmitProposal(auth, content, initialDeposit, proposer, false)
+}
+
+// NewKuMsgSubmitExpeditedProposal creates a msg to submit an expedited proposal, which is voted
+// by the expedited params, and falls back to a normal proposal if the expedited vote fails.
+func NewKuMsgSubmitExpeditedProposal(auth sdk.AccAddress, content Content, initialDeposit Coins, proposer AccountID) KuMsgSubmitProposal {
+	return newKuMsgSubmitProposal(auth, content, initialDeposit, proposer, true)
+}
+
+func newKuMsgSubmitProposal(auth sdk.AccAddress, content Content, initialDeposit Coins, proposer AccountID, expedited bool) KuMsgSubmitProposal {
 	return KuMsgSubmitProposal{
 		*msg.MustNewKuMsg(
 			RouterKeyName,
@@ -24,6 +34,7 @@ func NewKuMsgSubmitProposal(auth sdk.AccAddress, content Content, initialDeposit
 			msg.WithData(Cdc(), &MsgSubmitProposalBase{
 				InitialDeposit: initialDeposit,
 				Proposer:       proposer,
+				Expedited:      expedited,
 			}),
 		), content,
 	}
@@ -81,6 +92,14 @@ func (msg KuMsgSubmitProposal) GetProposerAccountID() AccountID {
 
 	return msgData.Proposer
 }
+func (msg KuMsgSubmitProposal) GetExpedited() bool {
+	msgData := MsgSubmitProposalBase{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return false
+	}
+
+	return msgData.Expedited
+}
 
 type KuMsgDeposit struct {
 	KuMsg
@@ -111,6 +130,34 @@ func NewKuMsgVote(auth sdk.AccAddress, voter AccountID, proposalID uint64, optio
 	}
 }
 
+type KuMsgVoteWeighted struct {
+	KuMsg
+}
+
+func NewKuMsgVoteWeighted(auth sdk.AccAddress, voter AccountID, proposalID uint64, options WeightedVoteOptions) KuMsgVoteWeighted {
+	return KuMsgVoteWeighted{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgVoteWeighted{proposalID, voter, options}),
+		),
+	}
+}
+
+type KuMsgCancelProposal struct {
+	KuMsg
+}
+
+func NewKuMsgCancelProposal(auth sdk.AccAddress, proposer AccountID, proposalID uint64) KuMsgCancelProposal {
+	return KuMsgCancelProposal{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgCancelProposal{proposalID, proposer}),
+		),
+	}
+}
+
 type MsgGovUnJail struct {
 	KuMsg
 }
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"bytes"
+	"strconv"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _, _, _, _ chainTypes.MsgResponse = MsgSubmitProposalResponse{}, MsgDepositResponse{}, MsgVoteResponse{}, MsgGovUnjailResponse{}
+var _ chainTypes.MsgResponse = MsgVoteWeightedResponse{}
+var _ chainTypes.MsgResponse = MsgCancelProposalResponse{}
+
+// MsgSubmitProposalResponse is the response of the submit proposal msg,
+// it is returned in the result data so the client can get the created proposal id.
+type MsgSubmitProposalResponse struct {
+	ProposalID    uint64 `json:"proposal_id" yaml:"proposal_id"`
+	VotingStarted bool   `json:"voting_started" yaml:"voting_started"`
+}
+
+// CreatedID implements chainTypes.MsgResponse
+func (r MsgSubmitProposalResponse) CreatedID() string {
+	return strconv.FormatUint(r.ProposalID, 10)
+}
+
+// MsgDepositResponse is the response of the deposit msg
+type MsgDepositResponse struct {
+	VotingStarted bool `json:"voting_started" yaml:"voting_started"`
+}
+
+// CreatedID implements chainTypes.MsgResponse
+func (r MsgDepositResponse) CreatedID() string { return "" }
+
+// MsgVoteResponse is the response of the vote msg
+type MsgVoteResponse struct{}
+
+// CreatedID implements chainTypes.MsgResponse
+func (r MsgVoteResponse) CreatedID() string { return "" }
+
+// MsgVoteWeightedResponse is the response of the weighted vote msg
+type MsgVoteWeightedResponse struct{}
+
+// CreatedID implements chainTypes.MsgResponse
+func (r MsgVoteWeightedResponse) CreatedID() string { return "" }
+
+// MsgCancelProposalResponse is the response of the cancel proposal msg
+type MsgCancelProposalResponse struct {
+	Burned   Coins `json:"burned" yaml:"burned"`
+	Refunded Coins `json:"refunded" yaml:"refunded"`
+}
+
+// CreatedID implements chainTypes.MsgResponse
+func (r MsgCancelProposalResponse) CreatedID() string { return "" }
+
+// MsgGovUnjailResponse is the response of the gov unjail msg
+type MsgGovUnjailResponse struct{}
+
+// CreatedID implements chainTypes.MsgResponse
+func (r MsgGovUnjailResponse) CreatedID() string { return "" }
+
+// MarshalMsgResponse encodes the msg response to the result data
+func MarshalMsgResponse(res chainTypes.MsgResponse) []byte {
+	return chainTypes.MarshalMsgResponse(ModuleCdc, res)
+}
+
+// UnmarshalMsgSubmitProposalResponse decodes the submit proposal response from the result data,
+// the data of a tx is the concatenation of its msgs results, so the first response is decoded.
+func UnmarshalMsgSubmitProposalResponse(data []byte) (MsgSubmitProposalResponse, error) {
+	var res MsgSubmitProposalResponse
+	if _, err := ModuleCdc.UnmarshalBinaryLengthPrefixedReader(bytes.NewReader(data), &res, int64(len(data))); err != nil {
+		return res, sdkerrors.Wrap(err, "unmarshal submit proposal response")
+	}
+	return res, nil
+}
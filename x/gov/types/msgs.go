@@ -11,10 +11,14 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "voteweighted"
 	TypeMsgSubmitProposal = "submitproposal"
+	TypeMsgCancelProposal = "cancelproposal"
 )
 
 var _, _, _, _ chainType.KuMsgData = (*MsgSubmitProposalBase)(nil), (*MsgDeposit)(nil), (*MsgVote)(nil), (*MsgSubmitProposal)(nil)
+var _ chainType.KuMsgData = (*MsgVoteWeighted)(nil)
+var _ chainType.KuMsgData = (*MsgCancelProposal)(nil)
 
 // MsgSubmitProposalI defines the specific interface a concrete message must
 // implement in order to process governance proposals. The concrete MsgSubmitProposal
@@ -26,6 +30,7 @@ type MsgSubmitProposalI interface {
 	GetInitialDeposit() Coins
 	GetProposer() sdk.AccAddress
 	GetProposerAccountID() AccountID
+	GetExpedited() bool
 }
 
 // MsgSubmitProposalBase defines an sdk.Msg type that supports submitting arbitrary
@@ -38,6 +43,7 @@ type MsgSubmitProposalI interface {
 type MsgSubmitProposalBase struct {
 	InitialDeposit Coins     `json:"initial_deposit" yaml:"initial_deposit"`
 	Proposer       AccountID `json:"proposer" yaml:"proposer"`
+	Expedited      bool      `json:"expedited,omitempty" yaml:"expedited,omitempty"`
 }
 
 // NewMsgSubmitProposalBase creates a new MsgSubmitProposalBase.
@@ -209,6 +215,109 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{}
 }
 
+// MsgVoteWeighted defines a message to cast a vote split across multiple options
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"`
+	Voter      AccountID           `json:"voter" yaml:"voter"`
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`
+}
+
+// NewMsgVoteWeighted creates a message to cast a weighted vote on an active proposal
+func NewMsgVoteWeighted(voter AccountID, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() Name { return MustName(TypeMsgVoteWeighted) }
+
+func (msg MsgVoteWeighted) Sender() AccountID {
+	return msg.Voter
+}
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter.String())
+	}
+
+	return ValidWeightedVoteOptions(msg.Options)
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	voterAccAddress, ok := msg.Voter.ToAccAddress()
+	if ok {
+		return []sdk.AccAddress{voterAccAddress}
+	}
+	return []sdk.AccAddress{}
+}
+
+// MsgCancelProposal defines a message for the proposer to cancel the proposal in deposit period
+type MsgCancelProposal struct {
+	ProposalID uint64    `json:"proposal_id" yaml:"proposal_id"`
+	Proposer   AccountID `json:"proposer" yaml:"proposer"`
+}
+
+// NewMsgCancelProposal creates a message to cancel a proposal in deposit period
+func NewMsgCancelProposal(proposer AccountID, proposalID uint64) MsgCancelProposal {
+	return MsgCancelProposal{proposalID, proposer}
+}
+
+// Route implements Msg
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelProposal) Type() Name { return MustName(TypeMsgCancelProposal) }
+
+func (msg MsgCancelProposal) Sender() AccountID {
+	return msg.Proposer
+}
+
+// ValidateBasic implements Msg
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if msg.Proposer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Proposer.String())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgCancelProposal) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	proposerAccAddress, ok := msg.Proposer.ToAccAddress()
+	if ok {
+		return []sdk.AccAddress{proposerAccAddress}
+	}
+	return []sdk.AccAddress{}
+}
+
 // ---------------------------------------------------------------------------
 // Deprecated
 //
@@ -221,15 +330,16 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 // TODO: Remove once client-side Protobuf migration has been completed.
 type MsgSubmitProposal struct {
 	Content        Content   `json:"content" yaml:"content"`
-	InitialDeposit Coins     `json:"initial_deposit" yaml:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive
-	Proposer       AccountID `json:"proposer" yaml:"proposer"`               //  Address of the proposer
+	InitialDeposit Coins     `json:"initial_deposit" yaml:"initial_deposit"`         //  Initial deposit paid by sender. Must be strictly positive
+	Proposer       AccountID `json:"proposer" yaml:"proposer"`                       //  Address of the proposer
+	Expedited      bool      `json:"expedited,omitempty" yaml:"expedited,omitempty"` //  Whether the proposal is expedited
 }
 
 // NewMsgSubmitProposal returns a (deprecated) MsgSubmitProposal message.
 //
 // TODO: Remove once client-side Protobuf migration has been completed.
 func NewMsgSubmitProposal(content Content, initialDeposit Coins, proposer AccountID) MsgSubmitProposal {
-	return MsgSubmitProposal{content, initialDeposit, proposer}
+	return MsgSubmitProposal{Content: content, InitialDeposit: initialDeposit, Proposer: proposer}
 }
 
 // ValidateBasic implements Msg
@@ -280,6 +390,7 @@ func (msg MsgSubmitProposal) GetProposer() sdk.AccAddress {
 	return nil
 }
 func (msg MsgSubmitProposal) GetProposerAccountID() AccountID { return msg.Proposer }
+func (msg MsgSubmitProposal) GetExpedited() bool              { return msg.Expedited }
 
 func (msg MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
 	bz := ModuleCdc.MustMarshalJSON(msg)
@@ -0,0 +1,84 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// ProposalTypeMulti the proposal type of the multi-content proposal
+	ProposalTypeMulti string = "Multi"
+
+	// MaxMultiContents the max number of the contents in a multi-content proposal
+	MaxMultiContents int = 16
+)
+
+// Implements Content Interface
+var _ Content = MultiContentProposal{}
+
+// MultiContentProposal defines a proposal carrying several contents, on passage the contents
+// are executed in order by the handlers of their routes, all of them take effect or none.
+type MultiContentProposal struct {
+	Title       string    `json:"title,omitempty" yaml:"title"`
+	Description string    `json:"description,omitempty" yaml:"description"`
+	Contents    []Content `json:"contents" yaml:"contents"`
+}
+
+// NewMultiContentProposal creates a multi-content proposal Content
+func NewMultiContentProposal(title, description string, contents []Content) Content {
+	return MultiContentProposal{title, description, contents}
+}
+
+// GetTitle returns the proposal title
+func (mp MultiContentProposal) GetTitle() string { return mp.Title }
+
+// GetDescription returns the proposal description
+func (mp MultiContentProposal) GetDescription() string { return mp.Description }
+
+// ProposalRoute returns the proposal router key, the contents are dispatched by the gov keeper
+func (mp MultiContentProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "Multi"
+func (mp MultiContentProposal) ProposalType() string { return ProposalTypeMulti }
+
+// ValidateBasic validates the title and description of the proposal and each of its contents,
+// the contents cannot be multi-content proposals.
+func (mp MultiContentProposal) ValidateBasic() error {
+	if err := ValidateAbstract(mp); err != nil {
+		return err
+	}
+
+	if len(mp.Contents) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "multi-content proposal has no content")
+	}
+
+	if len(mp.Contents) > MaxMultiContents {
+		return sdkerrors.Wrapf(ErrInvalidProposalContent, "multi-content proposal has more than %d contents", MaxMultiContents)
+	}
+
+	for i, content := range mp.Contents {
+		if content == nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalContent, "content %d is nil", i)
+		}
+
+		if content.ProposalType() == ProposalTypeMulti {
+			return sdkerrors.Wrapf(ErrInvalidProposalContent, "content %d: nested multi-content proposal", i)
+		}
+
+		if !IsValidProposalType(content.ProposalType()) {
+			return sdkerrors.Wrapf(ErrInvalidProposalType, "content %d: %s", i, content.ProposalType())
+		}
+
+		if err := content.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "content %d", i)
+		}
+	}
+
+	return nil
+}
+
+// String implements Stringer interface
+func (mp MultiContentProposal) String() string {
+	out, _ := yaml.Marshal(mp)
+	return string(out)
+}
@@ -16,6 +16,14 @@ import (
 const (
 	DefaultPeriod       time.Duration = time.Hour * 24 * 14 // 14 days
 	DefaultPunishPeriod time.Duration = time.Hour * 24 * 7  //7 days
+
+	DefaultReminderInterval int64 = 1200 // blocks, about 2 hours
+
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24 // 1 day
+
+	// the submissions are not throttled by default, set by the param change proposals
+	DefaultMaxActiveProposals uint64        = 0
+	DefaultSubmitCooldown     time.Duration = 0
 )
 
 // Default governance params
@@ -26,6 +34,14 @@ var (
 	DefaultVeto             = sdk.NewDecWithPrec(334, 3)
 	DefaultEmergengcy       = sdk.NewDecWithPrec(667, 3)
 	DefaultSlashFraction    = types.NewDec(1).Quo(types.NewDec(10000))
+	DefaultCancelBurnRate   = sdk.NewDecWithPrec(5, 1)
+	DefaultVetoBurnRate     = sdk.OneDec()
+
+	DefaultMinInitialDepositRatio = sdk.ZeroDec() // disabled by default, set by the param change proposals
+
+	DefaultExpeditedMinDepositTokens = external.TokensFromConsensusPower(2500)
+	DefaultExpeditedQuorum           = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
 )
 
 // Parameter store key
@@ -33,6 +49,9 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+
+	ParamStoreKeyExpeditedParams = []byte("expeditedparams")
+	ParamStoreKeyTypeParams      = []byte("typeparams")
 )
 
 // ParamKeyTable - Key declaration for parameters
@@ -41,6 +60,8 @@ func ParamKeyTable() paramtypes.KeyTable {
 		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyExpeditedParams, ExpeditedParams{}, validateExpeditedParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyTypeParams, TypeParams{}, validateTypeParams),
 	)
 }
 
@@ -48,13 +69,26 @@ func ParamKeyTable() paramtypes.KeyTable {
 type DepositParams struct {
 	MinDeposit       Coins         `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`               //  Minimum deposit for a proposal to enter voting period.
 	MaxDepositPeriod time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	CancelBurnRate   sdk.Dec       `json:"cancel_burn_rate,omitempty" yaml:"cancel_burn_rate,omitempty"`     //  Rate of the deposits burned when the proposer cancels the proposal. Initial value: 0.5
+	VetoBurnRate     sdk.Dec       `json:"veto_burn_rate,omitempty" yaml:"veto_burn_rate,omitempty"`         //  Rate of the proposer's deposit burned when the proposal is rejected with veto. Initial value: 1
+
+	MinInitialDepositRatio sdk.Dec `json:"min_initial_deposit_ratio,omitempty" yaml:"min_initial_deposit_ratio,omitempty"` //  Minimum ratio of the min deposit paid as the initial deposit when submitting. Initial value: 0
+
+	MaxActiveProposals uint64        `json:"max_active_proposals,omitempty" yaml:"max_active_proposals,omitempty"` //  Maximum proposals of an account in deposit or voting period, 0 for no limit. Initial value: 0
+	SubmitCooldown     time.Duration `json:"submit_cooldown,omitempty" yaml:"submit_cooldown,omitempty"`           //  Minimum interval between the submissions of an account, 0 for no cooldown. Initial value: 0
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit Coins, maxDepositPeriod time.Duration, cancelBurnRate, vetoBurnRate, minInitialDepositRatio sdk.Dec,
+	maxActiveProposals uint64, submitCooldown time.Duration) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:             minDeposit,
+		MaxDepositPeriod:       maxDepositPeriod,
+		CancelBurnRate:         cancelBurnRate,
+		VetoBurnRate:           vetoBurnRate,
+		MinInitialDepositRatio: minInitialDepositRatio,
+		MaxActiveProposals:     maxActiveProposals,
+		SubmitCooldown:         submitCooldown,
 	}
 }
 
@@ -63,9 +97,54 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		types.NewCoins(types.NewCoin(stakingexport.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		DefaultCancelBurnRate,
+		DefaultVetoBurnRate,
+		DefaultMinInitialDepositRatio,
+		DefaultMaxActiveProposals,
+		DefaultSubmitCooldown,
 	)
 }
 
+// GetCancelBurnRate returns the cancel burn rate, the params stored before the rate added have no rate,
+// which means no deposits are burned.
+func (dp DepositParams) GetCancelBurnRate() sdk.Dec {
+	if dp.CancelBurnRate.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return dp.CancelBurnRate
+}
+
+// GetVetoBurnRate returns the veto burn rate, the params stored before the rate added have no rate,
+// which means the whole deposit of the proposer is burned, as the vetoed deposits were burned before.
+func (dp DepositParams) GetVetoBurnRate() sdk.Dec {
+	if dp.VetoBurnRate.IsNil() {
+		return sdk.OneDec()
+	}
+	return dp.VetoBurnRate
+}
+
+// GetMinInitialDepositRatio returns the min initial deposit ratio, the params stored before the ratio added
+// have no ratio, which means no initial deposit is required.
+func (dp DepositParams) GetMinInitialDepositRatio() sdk.Dec {
+	if dp.MinInitialDepositRatio.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return dp.MinInitialDepositRatio
+}
+
+// MinInitialDeposit returns the min initial deposit by the ratio of the min deposit,
+// the min deposit of the expedited proposals is different from the params.
+func (dp DepositParams) MinInitialDeposit(minDeposit Coins) Coins {
+	ratio := dp.GetMinInitialDepositRatio()
+
+	res := make(Coins, 0, len(minDeposit))
+	for _, coin := range minDeposit {
+		res = append(res, types.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(ratio).TruncateInt()))
+	}
+
+	return types.NewCoins(res...)
+}
+
 // String implements stringer insterface
 func (dp DepositParams) String() string {
 	out, _ := yaml.Marshal(dp)
@@ -74,7 +153,10 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.GetCancelBurnRate().Equal(dp2.GetCancelBurnRate()) && dp.GetVetoBurnRate().Equal(dp2.GetVetoBurnRate()) &&
+		dp.GetMinInitialDepositRatio().Equal(dp2.GetMinInitialDepositRatio()) &&
+		dp.MaxActiveProposals == dp2.MaxActiveProposals && dp.SubmitCooldown == dp2.SubmitCooldown
 }
 
 func validateDepositParams(i interface{}) error {
@@ -89,6 +171,18 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if rate := v.GetCancelBurnRate(); rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("cancel burn rate should be in [0, 1]: %s", rate)
+	}
+	if rate := v.GetVetoBurnRate(); rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("veto burn rate should be in [0, 1]: %s", rate)
+	}
+	if ratio := v.GetMinInitialDepositRatio(); ratio.IsNegative() || ratio.GT(sdk.OneDec()) {
+		return fmt.Errorf("min initial deposit ratio should be in [0, 1]: %s", ratio)
+	}
+	if v.SubmitCooldown < 0 {
+		return fmt.Errorf("submit cooldown must not be negative: %s", v.SubmitCooldown)
+	}
 
 	return nil
 }
@@ -161,24 +255,26 @@ func validateTallyParams(i interface{}) error {
 
 // VotingParams defines the params around Voting in governance
 type VotingParams struct {
-	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period.
+	VotingPeriod     time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"`         //  Length of the voting period.
+	ReminderInterval int64         `json:"reminder_interval,omitempty" yaml:"reminder_interval,omitempty"` //  Blocks between the vote reminder events, 0 to disable.
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod time.Duration, reminderInterval int64) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:     votingPeriod,
+		ReminderInterval: reminderInterval,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultReminderInterval)
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ReminderInterval == other.ReminderInterval
 }
 
 // String implements stringer interface
@@ -197,31 +293,220 @@ func validateVotingParams(i interface{}) error {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
 
+	if v.ReminderInterval < 0 {
+		return fmt.Errorf("reminder interval must not be negative: %d", v.ReminderInterval)
+	}
+
+	return nil
+}
+
+// ExpeditedParams defines the params for the expedited proposals, which need a higher deposit
+// and a higher quorum to pass in a shorter voting period.
+type ExpeditedParams struct {
+	MinDeposit   Coins         `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`     //  Minimum deposit for an expedited proposal to enter voting period.
+	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period of the expedited proposals. Initial value: 1 day
+	Quorum       sdk.Dec       `json:"quorum,omitempty" yaml:"quorum,omitempty"`               //  Minimum percentage of total stake needed to vote for an expedited proposal. Initial value: 0.5
+	Threshold    sdk.Dec       `json:"threshold,omitempty" yaml:"threshold,omitempty"`         //  Minimum proportion of Yes votes for an expedited proposal to pass. Initial value: 2/3
+}
+
+// NewExpeditedParams creates a new ExpeditedParams object
+func NewExpeditedParams(minDeposit Coins, votingPeriod time.Duration, quorum, threshold sdk.Dec) ExpeditedParams {
+	return ExpeditedParams{
+		MinDeposit:   minDeposit,
+		VotingPeriod: votingPeriod,
+		Quorum:       quorum,
+		Threshold:    threshold,
+	}
+}
+
+// DefaultExpeditedParams default parameters for the expedited proposals
+func DefaultExpeditedParams() ExpeditedParams {
+	return NewExpeditedParams(
+		types.NewCoins(types.NewCoin(stakingexport.DefaultBondDenom, DefaultExpeditedMinDepositTokens)),
+		DefaultExpeditedPeriod,
+		DefaultExpeditedQuorum,
+		DefaultExpeditedThreshold,
+	)
+}
+
+// Equal checks equality of ExpeditedParams
+func (ep ExpeditedParams) Equal(other ExpeditedParams) bool {
+	return ep.MinDeposit.IsEqual(other.MinDeposit) && ep.VotingPeriod == other.VotingPeriod &&
+		ep.Quorum.Equal(other.Quorum) && ep.Threshold.Equal(other.Threshold)
+}
+
+// String implements stringer interface
+func (ep ExpeditedParams) String() string {
+	out, _ := yaml.Marshal(ep)
+	return string(out)
+}
+
+func validateExpeditedParams(i interface{}) error {
+	v, ok := i.(ExpeditedParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.MinDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", v.MinDeposit)
+	}
+	if v.VotingPeriod <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.VotingPeriod)
+	}
+	if v.Quorum.IsNil() || v.Quorum.IsNegative() || v.Quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited quorum should be in [0, 1]: %s", v.Quorum)
+	}
+	if v.Threshold.IsNil() || !v.Threshold.IsPositive() || v.Threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold should be in (0, 1]: %s", v.Threshold)
+	}
+
+	return nil
+}
+
+// ProposalTypeParams defines the params of the proposals of a type, which override the general params,
+// so the critical proposals such as the software upgrades can require the higher deposit and thresholds.
+// The zero values are not overridden.
+type ProposalTypeParams struct {
+	ProposalType string        `json:"proposal_type" yaml:"proposal_type"`                     //  Type of the proposal content, such as Text or SoftwareUpgrade.
+	MinDeposit   Coins         `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`     //  Minimum deposit for a proposal of the type to enter voting period.
+	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period of the proposals of the type.
+	Quorum       sdk.Dec       `json:"quorum,omitempty" yaml:"quorum,omitempty"`               //  Minimum percentage of total stake needed to vote for a proposal of the type.
+	Threshold    sdk.Dec       `json:"threshold,omitempty" yaml:"threshold,omitempty"`         //  Minimum proportion of Yes votes for a proposal of the type to pass.
+}
+
+// NewProposalTypeParams creates a new ProposalTypeParams object
+func NewProposalTypeParams(proposalType string, minDeposit Coins, votingPeriod time.Duration, quorum, threshold sdk.Dec) ProposalTypeParams {
+	return ProposalTypeParams{
+		ProposalType: proposalType,
+		MinDeposit:   minDeposit,
+		VotingPeriod: votingPeriod,
+		Quorum:       quorum,
+		Threshold:    threshold,
+	}
+}
+
+// Equal checks equality of ProposalTypeParams
+func (pp ProposalTypeParams) Equal(other ProposalTypeParams) bool {
+	return pp.ProposalType == other.ProposalType && pp.MinDeposit.IsEqual(other.MinDeposit) &&
+		pp.VotingPeriod == other.VotingPeriod && decEqual(pp.Quorum, other.Quorum) && decEqual(pp.Threshold, other.Threshold)
+}
+
+func decEqual(a, b sdk.Dec) bool {
+	if a.IsNil() || b.IsNil() {
+		return a.IsNil() == b.IsNil()
+	}
+	return a.Equal(b)
+}
+
+// TypeParams defines the params overriding the general params by the proposal types
+type TypeParams struct {
+	Overrides []ProposalTypeParams `json:"overrides" yaml:"overrides"` //  Params of the proposal types, at most one for each type. Initial value: none
+}
+
+// NewTypeParams creates a new TypeParams object
+func NewTypeParams(overrides ...ProposalTypeParams) TypeParams {
+	return TypeParams{
+		Overrides: overrides,
+	}
+}
+
+// DefaultTypeParams default parameters for the proposal types, all the types use the general params
+func DefaultTypeParams() TypeParams {
+	return NewTypeParams()
+}
+
+// Get returns the params of the proposal type, returns false if the type has no params
+func (tp TypeParams) Get(proposalType string) (ProposalTypeParams, bool) {
+	for _, p := range tp.Overrides {
+		if p.ProposalType == proposalType {
+			return p, true
+		}
+	}
+
+	return ProposalTypeParams{}, false
+}
+
+// Equal checks equality of TypeParams
+func (tp TypeParams) Equal(other TypeParams) bool {
+	if len(tp.Overrides) != len(other.Overrides) {
+		return false
+	}
+
+	for i, p := range tp.Overrides {
+		if !p.Equal(other.Overrides[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String implements stringer interface
+func (tp TypeParams) String() string {
+	out, _ := yaml.Marshal(tp)
+	return string(out)
+}
+
+func validateTypeParams(i interface{}) error {
+	v, ok := i.(TypeParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v.Overrides))
+	for _, p := range v.Overrides {
+		if !IsValidProposalType(p.ProposalType) {
+			return fmt.Errorf("invalid proposal type: %s", p.ProposalType)
+		}
+		if seen[p.ProposalType] {
+			return fmt.Errorf("duplicate params of proposal type: %s", p.ProposalType)
+		}
+		seen[p.ProposalType] = true
+
+		if !p.MinDeposit.IsValid() {
+			return fmt.Errorf("invalid %s minimum deposit: %s", p.ProposalType, p.MinDeposit)
+		}
+		if p.VotingPeriod < 0 {
+			return fmt.Errorf("%s voting period must not be negative: %s", p.ProposalType, p.VotingPeriod)
+		}
+		if !p.Quorum.IsNil() && (p.Quorum.IsNegative() || p.Quorum.GT(sdk.OneDec())) {
+			return fmt.Errorf("%s quorum should be in [0, 1]: %s", p.ProposalType, p.Quorum)
+		}
+		if !p.Threshold.IsNil() && (!p.Threshold.IsPositive() || p.Threshold.GT(sdk.OneDec())) {
+			return fmt.Errorf("%s vote threshold should be in (0, 1]: %s", p.ProposalType, p.Threshold)
+		}
+	}
+
 	return nil
 }
 
 // Params returns all of the governance params
 type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_parmas"`
+	VotingParams    VotingParams    `json:"voting_params" yaml:"voting_params"`
+	TallyParams     TallyParams     `json:"tally_params" yaml:"tally_params"`
+	DepositParams   DepositParams   `json:"deposit_params" yaml:"deposit_parmas"`
+	ExpeditedParams ExpeditedParams `json:"expedited_params" yaml:"expedited_params"`
+	TypeParams      TypeParams      `json:"type_params" yaml:"type_params"`
 }
 
 func (gp Params) String() string {
 	return gp.VotingParams.String() + "\n" +
-		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
+		gp.TallyParams.String() + "\n" + gp.DepositParams.String() + "\n" +
+		gp.ExpeditedParams.String() + "\n" + gp.TypeParams.String()
 }
 
 // NewParams creates a new gov Params instance
-func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
+func NewParams(vp VotingParams, tp TallyParams, dp DepositParams, ep ExpeditedParams, typeParams TypeParams) Params {
 	return Params{
-		VotingParams:  vp,
-		DepositParams: dp,
-		TallyParams:   tp,
+		VotingParams:    vp,
+		DepositParams:   dp,
+		TallyParams:     tp,
+		ExpeditedParams: ep,
+		TypeParams:      typeParams,
 	}
 }
 
 // DefaultParams default governance params
 func DefaultParams() Params {
-	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
+	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams(), DefaultExpeditedParams(), DefaultTypeParams())
 }
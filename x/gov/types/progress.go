@@ -0,0 +1,81 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// ProposalProgress the progress of a proposal derived from the state, computed by the querier
+// so that the frontends no need to reimplement the tally math.
+type ProposalProgress struct {
+	DepositSecondsRemaining int64   `json:"deposit_seconds_remaining" yaml:"deposit_seconds_remaining"` // only in deposit period
+	VotingSecondsRemaining  int64   `json:"voting_seconds_remaining" yaml:"voting_seconds_remaining"`   // only in voting period
+	Turnout                 sdk.Dec `json:"turnout" yaml:"turnout"`                                     // the voted power by the total bonded tokens, only in voting period
+	QuorumReached           bool    `json:"quorum_reached" yaml:"quorum_reached"`
+	ThresholdReached        bool    `json:"threshold_reached" yaml:"threshold_reached"` // yes by the non-abstaining votes is greater than the threshold
+	Vetoed                  bool    `json:"vetoed" yaml:"vetoed"`                       // veto by all votes is greater than the veto threshold
+}
+
+// NewProposalProgress computes the progress of the proposal at the time by the current tally result and turnout
+func NewProposalProgress(proposal Proposal, now time.Time, tally TallyResult, turnout sdk.Dec, params TallyParams) ProposalProgress {
+	progress := ProposalProgress{
+		Turnout: sdk.ZeroDec(),
+	}
+
+	switch proposal.Status {
+	case StatusDepositPeriod:
+		progress.DepositSecondsRemaining = secondsRemaining(now, proposal.DepositEndTime)
+
+	case StatusVotingPeriod:
+		progress.VotingSecondsRemaining = secondsRemaining(now, proposal.VotingEndTime)
+		progress.Turnout = turnout
+		progress.QuorumReached = !turnout.LT(params.Quorum)
+
+		total := tally.Yes.Add(tally.Abstain).Add(tally.No).Add(tally.NoWithVeto)
+		if nonAbstaining := total.Sub(tally.Abstain); nonAbstaining.IsPositive() {
+			progress.ThresholdReached = tally.Yes.ToDec().Quo(nonAbstaining.ToDec()).GT(params.Threshold)
+			progress.Vetoed = tally.NoWithVeto.ToDec().Quo(total.ToDec()).GT(params.Veto)
+		}
+	}
+
+	return progress
+}
+
+func secondsRemaining(now, end time.Time) int64 {
+	if !end.After(now) {
+		return 0
+	}
+
+	return int64(end.Sub(now) / time.Second)
+}
+
+// String implements stringer interface
+func (p ProposalProgress) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ProposalWithProgress the proposal with the progress in query response, it keeps the json fields of
+// the proposal, so it can be also unmarshaled to proposal.
+type ProposalWithProgress struct {
+	Content `json:"content" yaml:"content"`
+	ProposalBase
+	Progress ProposalProgress `json:"progress" yaml:"progress"`
+}
+
+// NewProposalWithProgress creates a new ProposalWithProgress instance
+func NewProposalWithProgress(proposal Proposal, progress ProposalProgress) ProposalWithProgress {
+	return ProposalWithProgress{
+		Content:      proposal.Content,
+		ProposalBase: proposal.ProposalBase,
+		Progress:     progress,
+	}
+}
+
+// String implements stringer interface
+func (p ProposalWithProgress) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
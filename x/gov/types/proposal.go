@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -44,6 +45,8 @@ type ProposalBase struct {
 	TotalDeposit     Coins          `json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time      `json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time      `json:"voting_end_time" yaml:"voting_end_time"`
+	Proposer         AccountID      `json:"proposer" yaml:"proposer"`
+	Expedited        bool           `json:"expedited,omitempty" yaml:"expedited,omitempty"`
 }
 
 func (p ProposalBase) Equal(other ProposalBase) bool {
@@ -54,7 +57,9 @@ func (p ProposalBase) Equal(other ProposalBase) bool {
 		p.DepositEndTime.Equal(other.DepositEndTime) &&
 		p.TotalDeposit.IsEqual(other.TotalDeposit) &&
 		p.VotingEndTime.Equal(other.VotingEndTime) &&
-		p.VotingEndTime.Equal(other.VotingEndTime)
+		p.VotingEndTime.Equal(other.VotingEndTime) &&
+		p.Proposer.Eq(other.Proposer) &&
+		p.Expedited == other.Expedited
 }
 
 // Proposal defines a struct used by the governance module to allow for voting
@@ -75,6 +80,7 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 			TotalDeposit:     NewCoins(),
 			SubmitTime:       submitTime,
 			DepositEndTime:   depositEndTime,
+			Proposer:         EmptyAccountID(),
 		},
 	}
 }
@@ -273,7 +279,31 @@ func (tp TextProposal) String() string {
 }
 
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText: {},
+	ProposalTypeText:  {},
+	ProposalTypeMulti: {},
+}
+
+// proposalContentTemplates the zero value of the registered proposal contents by the proposal type,
+// used to build the proposal templates for the users.
+var proposalContentTemplates = map[string]Content{
+	ProposalTypeText: TextProposal{},
+}
+
+// ProposalContentTemplate returns the zero value of the registered proposal content of the type
+func ProposalContentTemplate(ty string) (Content, bool) {
+	content, ok := proposalContentTemplates[ty]
+	return content, ok
+}
+
+// RegisteredProposalContentTypes returns the sorted types of the registered proposal contents
+func RegisteredProposalContentTypes() []string {
+	res := make([]string, 0, len(proposalContentTemplates))
+	for ty := range proposalContentTemplates {
+		res = append(res, ty)
+	}
+
+	sort.Strings(res)
+	return res
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -9,15 +9,20 @@ const (
 	QueryProposal         = "proposal"
 	QueryDeposits         = "deposits"
 	QueryDeposit          = "deposit"
+	QueryDepositsHistory  = "depositshistory"
 	QueryVotes            = "votes"
 	QueryVote             = "vote"
 	QueryTally            = "tally"
+	QueryTallyDetail      = "tallydetail"
+	QueryVoterPower       = "voterpower"
 	QueryPunishValidators = "punishvalidators"
 	QueryPunishValidator  = "punishvalidator"
 
-	ParamDeposit  = "deposit"
-	ParamVoting   = "voting"
-	ParamTallying = "tallying"
+	ParamDeposit   = "deposit"
+	ParamVoting    = "voting"
+	ParamTallying  = "tallying"
+	ParamExpedited = "expedited"
+	ParamType      = "type"
 )
 
 // QueryProposalParams Params for queries:
@@ -0,0 +1,385 @@
+package types
+
+import (
+	"context"
+
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// The messages of the gRPC query service defined in query.proto, encoded by the protobuf struct tags,
+// keep the tags in sync with query.proto when the messages changed.
+
+// PageRequest the pagination of the queries
+type PageRequest struct {
+	Page  uint64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *PageRequest) Reset()         { *m = PageRequest{} }
+func (m *PageRequest) String() string { return proto.CompactTextString(m) }
+func (*PageRequest) ProtoMessage()    {}
+
+// PageResponse the pagination of the results
+type PageResponse struct {
+	Total uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *PageResponse) Reset()         { *m = PageResponse{} }
+func (m *PageResponse) String() string { return proto.CompactTextString(m) }
+func (*PageResponse) ProtoMessage()    {}
+
+// TallyResultInfo the tally of a proposal
+type TallyResultInfo struct {
+	Yes        string `protobuf:"bytes,1,opt,name=yes,proto3" json:"yes,omitempty"`
+	Abstain    string `protobuf:"bytes,2,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	No         string `protobuf:"bytes,3,opt,name=no,proto3" json:"no,omitempty"`
+	NoWithVeto string `protobuf:"bytes,4,opt,name=no_with_veto,json=noWithVeto,proto3" json:"no_with_veto,omitempty"`
+}
+
+func (m *TallyResultInfo) Reset()         { *m = TallyResultInfo{} }
+func (m *TallyResultInfo) String() string { return proto.CompactTextString(m) }
+func (*TallyResultInfo) ProtoMessage()    {}
+
+// ProposalInfo a governance proposal
+type ProposalInfo struct {
+	ProposalId       uint64           `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	ContentType      string           `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Title            string           `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string           `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Content          []byte           `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Status           string           `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	FinalTallyResult *TallyResultInfo `protobuf:"bytes,7,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result,omitempty"`
+	SubmitTime       string           `protobuf:"bytes,8,opt,name=submit_time,json=submitTime,proto3" json:"submit_time,omitempty"`
+	DepositEndTime   string           `protobuf:"bytes,9,opt,name=deposit_end_time,json=depositEndTime,proto3" json:"deposit_end_time,omitempty"`
+	TotalDeposit     string           `protobuf:"bytes,10,opt,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit,omitempty"`
+	VotingStartTime  string           `protobuf:"bytes,11,opt,name=voting_start_time,json=votingStartTime,proto3" json:"voting_start_time,omitempty"`
+	VotingEndTime    string           `protobuf:"bytes,12,opt,name=voting_end_time,json=votingEndTime,proto3" json:"voting_end_time,omitempty"`
+	Proposer         string           `protobuf:"bytes,13,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Expedited        bool             `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
+}
+
+func (m *ProposalInfo) Reset()         { *m = ProposalInfo{} }
+func (m *ProposalInfo) String() string { return proto.CompactTextString(m) }
+func (*ProposalInfo) ProtoMessage()    {}
+
+// WeightedVoteOptionInfo an option of the weighted vote
+type WeightedVoteOptionInfo struct {
+	Option string `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *WeightedVoteOptionInfo) Reset()         { *m = WeightedVoteOptionInfo{} }
+func (m *WeightedVoteOptionInfo) String() string { return proto.CompactTextString(m) }
+func (*WeightedVoteOptionInfo) ProtoMessage()    {}
+
+// VoteInfo a vote on a proposal
+type VoteInfo struct {
+	ProposalId uint64                    `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Voter      string                    `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	Option     string                    `protobuf:"bytes,3,opt,name=option,proto3" json:"option,omitempty"`
+	Options    []*WeightedVoteOptionInfo `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
+func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
+func (*VoteInfo) ProtoMessage()    {}
+
+// DepositInfo a deposit on a proposal
+type DepositInfo struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Depositor  string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount     string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *DepositInfo) Reset()         { *m = DepositInfo{} }
+func (m *DepositInfo) String() string { return proto.CompactTextString(m) }
+func (*DepositInfo) ProtoMessage()    {}
+
+type QueryProposalsRequest struct {
+	Status     string       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Voter      string       `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	Depositor  string       `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Pagination *PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
+func (m *QueryProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsRequest) ProtoMessage()    {}
+
+type QueryProposalsResponse struct {
+	Proposals  []*ProposalInfo `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	Pagination *PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{} }
+func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsResponse) ProtoMessage()    {}
+
+type QueryProposalRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryProposalRequest) Reset()         { *m = QueryProposalRequest{} }
+func (m *QueryProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalRequest) ProtoMessage()    {}
+
+type QueryProposalResponse struct {
+	Proposal *ProposalInfo `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+}
+
+func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
+func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalResponse) ProtoMessage()    {}
+
+type QueryVotesRequest struct {
+	ProposalId uint64       `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Pagination *PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVotesRequest) Reset()         { *m = QueryVotesRequest{} }
+func (m *QueryVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotesRequest) ProtoMessage()    {}
+
+type QueryVotesResponse struct {
+	Votes      []*VoteInfo   `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+	Pagination *PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVotesResponse) Reset()         { *m = QueryVotesResponse{} }
+func (m *QueryVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotesResponse) ProtoMessage()    {}
+
+type QueryVoteRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Voter      string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *QueryVoteRequest) Reset()         { *m = QueryVoteRequest{} }
+func (m *QueryVoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteRequest) ProtoMessage()    {}
+
+type QueryVoteResponse struct {
+	Vote *VoteInfo `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+}
+
+func (m *QueryVoteResponse) Reset()         { *m = QueryVoteResponse{} }
+func (m *QueryVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteResponse) ProtoMessage()    {}
+
+type QueryDepositsRequest struct {
+	ProposalId uint64       `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Pagination *PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
+func (m *QueryDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositsRequest) ProtoMessage()    {}
+
+type QueryDepositsResponse struct {
+	Deposits   []*DepositInfo `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	Pagination *PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDepositsResponse) Reset()         { *m = QueryDepositsResponse{} }
+func (m *QueryDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositsResponse) ProtoMessage()    {}
+
+type QueryTallyResultRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryTallyResultRequest) Reset()         { *m = QueryTallyResultRequest{} }
+func (m *QueryTallyResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyResultRequest) ProtoMessage()    {}
+
+type QueryTallyResultResponse struct {
+	Tally *TallyResultInfo `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally,omitempty"`
+}
+
+func (m *QueryTallyResultResponse) Reset()         { *m = QueryTallyResultResponse{} }
+func (m *QueryTallyResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyResultResponse) ProtoMessage()    {}
+
+type QueryParamsRequest struct {
+	ParamsType string `protobuf:"bytes,1,opt,name=params_type,json=paramsType,proto3" json:"params_type,omitempty"`
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+
+type QueryParamsResponse struct {
+	Params []byte `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+
+// QueryClient is the client API for the Query service.
+type QueryClient interface {
+	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
+	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
+	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
+	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewQueryClient creates the client of the Query service
+func NewQueryClient(cc *grpc.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) invoke(ctx context.Context, method string, in, out interface{}, opts ...grpc.CallOption) error {
+	return c.cc.Invoke(ctx, "/"+QueryServiceName+"/"+method, in, out, opts...)
+}
+
+func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
+	out := new(QueryProposalsResponse)
+	return out, c.invoke(ctx, "Proposals", in, out, opts...)
+}
+
+func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
+	out := new(QueryProposalResponse)
+	return out, c.invoke(ctx, "Proposal", in, out, opts...)
+}
+
+func (c *queryClient) Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error) {
+	out := new(QueryVotesResponse)
+	return out, c.invoke(ctx, "Votes", in, out, opts...)
+}
+
+func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
+	out := new(QueryVoteResponse)
+	return out, c.invoke(ctx, "Vote", in, out, opts...)
+}
+
+func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
+	out := new(QueryDepositsResponse)
+	return out, c.invoke(ctx, "Deposits", in, out, opts...)
+}
+
+func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error) {
+	out := new(QueryTallyResultResponse)
+	return out, c.invoke(ctx, "TallyResult", in, out, opts...)
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	return out, c.invoke(ctx, "Params", in, out, opts...)
+}
+
+// QueryServer is the server API for the Query service.
+type QueryServer interface {
+	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
+	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
+	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
+	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct{}
+
+func (*UnimplementedQueryServer) Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+}
+func (*UnimplementedQueryServer) Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+}
+func (*UnimplementedQueryServer) Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Votes not implemented")
+}
+func (*UnimplementedQueryServer) Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (*UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+}
+func (*UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
+}
+func (*UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+// QueryServiceName the full name of the Query service in query.proto
+const QueryServiceName = "kuchain.x.gov.v1.Query"
+
+// RegisterQueryServer registers the Query service to the gRPC server
+func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
+	s.RegisterService(&queryServiceDesc, srv)
+}
+
+// queryHandler returns the gRPC handler of a method, which decodes the request and calls the server
+func queryHandler(method string, newReq func() interface{}, call func(QueryServer, context.Context, interface{}) (interface{}, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: method,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			in := newReq()
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+
+			if interceptor == nil {
+				return call(srv.(QueryServer), ctx, in)
+			}
+
+			info := &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: "/" + QueryServiceName + "/" + method,
+			}
+			return interceptor(ctx, in, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return call(srv.(QueryServer), ctx, req)
+			})
+		},
+	}
+}
+
+var queryServiceDesc = grpc.ServiceDesc{
+	ServiceName: QueryServiceName,
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		queryHandler("Proposals", func() interface{} { return new(QueryProposalsRequest) },
+			func(s QueryServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return s.Proposals(ctx, req.(*QueryProposalsRequest))
+			}),
+		queryHandler("Proposal", func() interface{} { return new(QueryProposalRequest) },
+			func(s QueryServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return s.Proposal(ctx, req.(*QueryProposalRequest))
+			}),
+		queryHandler("Votes", func() interface{} { return new(QueryVotesRequest) },
+			func(s QueryServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return s.Votes(ctx, req.(*QueryVotesRequest))
+			}),
+		queryHandler("Vote", func() interface{} { return new(QueryVoteRequest) },
+			func(s QueryServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return s.Vote(ctx, req.(*QueryVoteRequest))
+			}),
+		queryHandler("Deposits", func() interface{} { return new(QueryDepositsRequest) },
+			func(s QueryServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return s.Deposits(ctx, req.(*QueryDepositsRequest))
+			}),
+		queryHandler("TallyResult", func() interface{} { return new(QueryTallyResultRequest) },
+			func(s QueryServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return s.TallyResult(ctx, req.(*QueryTallyResultRequest))
+			}),
+		queryHandler("Params", func() interface{} { return new(QueryParamsRequest) },
+			func(s QueryServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return s.Params(ctx, req.(*QueryParamsRequest))
+			}),
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "x/gov/types/query.proto",
+}
@@ -7,16 +7,16 @@ import (
 
 // ValidatorGovInfo used for tallying
 type ValidatorGovInfo struct {
-	Address             AccountID  // address of the validator operator
-	BondedTokens        sdk.Int    // Power of a Validator
-	DelegatorShares     sdk.Dec    // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec    // Delegator deductions from validator's delegators voting independently
-	Vote                VoteOption // Vote of the validator
+	Address             AccountID           // address of the validator operator
+	BondedTokens        sdk.Int             // Power of a Validator
+	DelegatorShares     sdk.Dec             // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec             // Delegator deductions from validator's delegators voting independently
+	Vote                WeightedVoteOptions // Vote of the validator
 }
 
 // NewValidatorGovInfo creates a ValidatorGovInfo instance
 func NewValidatorGovInfo(address AccountID, bondedTokens sdk.Int, delegatorShares,
-	delegatorDeductions sdk.Dec, vote VoteOption) ValidatorGovInfo {
+	delegatorDeductions sdk.Dec, vote WeightedVoteOptions) ValidatorGovInfo {
 
 	return ValidatorGovInfo{
 		Address:             address,
@@ -0,0 +1,101 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// ValidatorTallyDetail the voting power of a bonded validator applied in the tally of a proposal,
+// the power of the delegators is inherited by the vote of the validator.
+type ValidatorTallyDetail struct {
+	Validator      AccountID           `json:"validator" yaml:"validator"`
+	VotingPower    sdk.Dec             `json:"voting_power" yaml:"voting_power"`       // the bonded tokens of the validator
+	Options        WeightedVoteOptions `json:"options" yaml:"options"`                 // empty if the validator not voted
+	Delegators     uint64              `json:"delegators" yaml:"delegators"`           // number of the delegators inheriting the vote, not including the validator
+	InheritedPower sdk.Dec             `json:"inherited_power" yaml:"inherited_power"` // the voting power of the delegators, not including the validator
+}
+
+// Voted returns true if the validator voted for the proposal
+func (d ValidatorTallyDetail) Voted() bool {
+	return len(d.Options) > 0
+}
+
+// TallyDetail the detail of the live tally of a proposal in voting period, the voting power of each validator
+// and the ratios toward quorum, threshold and veto.
+type TallyDetail struct {
+	ProposalID  uint64                 `json:"proposal_id" yaml:"proposal_id"`
+	Tally       TallyResult            `json:"tally" yaml:"tally"`
+	Validators  []ValidatorTallyDetail `json:"validators" yaml:"validators"`
+	TotalBonded sdk.Int                `json:"total_bonded" yaml:"total_bonded"`
+
+	Turnout   sdk.Dec `json:"turnout" yaml:"turnout"`       // the voted power by the total bonded tokens
+	YesRatio  sdk.Dec `json:"yes_ratio" yaml:"yes_ratio"`   // yes by the non-abstaining votes
+	VetoRatio sdk.Dec `json:"veto_ratio" yaml:"veto_ratio"` // veto by all votes
+
+	Quorum    sdk.Dec `json:"quorum" yaml:"quorum"`
+	Threshold sdk.Dec `json:"threshold" yaml:"threshold"`
+	Veto      sdk.Dec `json:"veto" yaml:"veto"`
+
+	QuorumProgress    sdk.Dec `json:"quorum_progress" yaml:"quorum_progress"`       // turnout by quorum, not less than 1 for reached
+	ThresholdProgress sdk.Dec `json:"threshold_progress" yaml:"threshold_progress"` // yes ratio by threshold, greater than 1 for reached
+	VetoProgress      sdk.Dec `json:"veto_progress" yaml:"veto_progress"`           // veto ratio by veto, greater than 1 for vetoed
+}
+
+// NewValidatorTallyDetail creates a ValidatorTallyDetail of the bonded validator
+func NewValidatorTallyDetail(validator AccountID, votingPower sdk.Dec, options WeightedVoteOptions) ValidatorTallyDetail {
+	return ValidatorTallyDetail{
+		Validator:      validator,
+		VotingPower:    votingPower,
+		Options:        options,
+		InheritedPower: sdk.ZeroDec(),
+	}
+}
+
+// NewTallyDetail creates the tally detail by the tally result of the validators and the tally params
+func NewTallyDetail(proposalID uint64, tally TallyResult, validators []ValidatorTallyDetail,
+	totalBonded sdk.Int, params TallyParams) TallyDetail {
+	detail := TallyDetail{
+		ProposalID:        proposalID,
+		Tally:             tally,
+		Validators:        validators,
+		TotalBonded:       totalBonded,
+		Turnout:           sdk.ZeroDec(),
+		YesRatio:          sdk.ZeroDec(),
+		VetoRatio:         sdk.ZeroDec(),
+		Quorum:            params.Quorum,
+		Threshold:         params.Threshold,
+		Veto:              params.Veto,
+		QuorumProgress:    sdk.ZeroDec(),
+		ThresholdProgress: sdk.ZeroDec(),
+		VetoProgress:      sdk.ZeroDec(),
+	}
+
+	total := tally.Yes.Add(tally.Abstain).Add(tally.No).Add(tally.NoWithVeto)
+	if totalBonded.IsPositive() {
+		detail.Turnout = total.ToDec().Quo(totalBonded.ToDec())
+	}
+	if nonAbstaining := total.Sub(tally.Abstain); nonAbstaining.IsPositive() {
+		detail.YesRatio = tally.Yes.ToDec().Quo(nonAbstaining.ToDec())
+		detail.VetoRatio = tally.NoWithVeto.ToDec().Quo(total.ToDec())
+	}
+
+	detail.QuorumProgress = progressOf(detail.Turnout, params.Quorum)
+	detail.ThresholdProgress = progressOf(detail.YesRatio, params.Threshold)
+	detail.VetoProgress = progressOf(detail.VetoRatio, params.Veto)
+
+	return detail
+}
+
+// progressOf returns the ratio by the target, the ratio reached if the target is zero
+func progressOf(ratio, target sdk.Dec) sdk.Dec {
+	if !target.IsPositive() {
+		return sdk.OneDec()
+	}
+	return ratio.Quo(target)
+}
+
+// String implements stringer interface
+func (d TallyDetail) String() string {
+	out, _ := yaml.Marshal(d)
+	return string(out)
+}
@@ -3,7 +3,10 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"gopkg.in/yaml.v2"
 )
 
@@ -24,16 +27,41 @@ const (
 )
 
 // Vote defines a vote on a governance proposal. A vote corresponds to a proposal
-// ID, the voter, and the vote option.
+// ID, the voter, and the vote option. A weighted vote splits the vote across
+// multiple options, with the Option empty and the weighted options in Options.
 type Vote struct {
-	ProposalID uint64     `json:"proposal_id,omitempty" yaml:"proposal_id"`
-	Voter      AccountID  `json:"voter" yaml:"voter"`
-	Option     VoteOption `json:"option,omitempty"`
+	ProposalID uint64              `json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Voter      AccountID           `json:"voter" yaml:"voter"`
+	Option     VoteOption          `json:"option,omitempty"`
+	Options    WeightedVoteOptions `json:"options,omitempty" yaml:"options,omitempty"`
 }
 
 // NewVote creates a new Vote instance
 func NewVote(proposalID uint64, voter AccountID, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return Vote{ProposalID: proposalID, Voter: voter, Option: option}
+}
+
+// NewWeightedVote creates a new Vote instance by the weighted options,
+// the vote not split is created with the option only
+func NewWeightedVote(proposalID uint64, voter AccountID, options WeightedVoteOptions) Vote {
+	if len(options) == 1 && options[0].Weight.Equal(sdk.OneDec()) {
+		return NewVote(proposalID, voter, options[0].Option)
+	}
+
+	return Vote{ProposalID: proposalID, Voter: voter, Option: OptionEmpty, Options: options}
+}
+
+// GetOptions returns the weighted options of the vote, a vote not split has the option with the weight 1
+func (v Vote) GetOptions() WeightedVoteOptions {
+	if len(v.Options) > 0 {
+		return v.Options
+	}
+
+	if v.Option == OptionEmpty {
+		return WeightedVoteOptions{}
+	}
+
+	return NewNonSplitVoteOption(v.Option)
 }
 
 func (v Vote) String() string {
@@ -65,7 +93,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.GetOptions())
 	}
 	return out
 }
@@ -76,7 +104,8 @@ func (v Vote) Empty() bool {
 }
 
 func (v Vote) Equal(other Vote) bool {
-	return v.Option == other.Option && v.ProposalID == other.ProposalID && v.Voter.Eq(other.Voter)
+	return v.Option == other.Option && v.ProposalID == other.ProposalID && v.Voter.Eq(other.Voter) &&
+		v.Options.Equal(other.Options)
 }
 
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
@@ -169,3 +198,123 @@ func (vo VoteOption) Format(s fmt.State, verb rune) {
 		s.Write([]byte(fmt.Sprintf("%v", byte(vo))))
 	}
 }
+
+// WeightedVoteOption defines a unit of vote for vote split
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+// IsValid returns true if the option is valid and the weight is in (0, 1]
+func (w WeightedVoteOption) IsValid() bool {
+	return ValidVoteOption(w.Option) && !w.Weight.IsNil() && w.Weight.IsPositive() && w.Weight.LTE(sdk.OneDec())
+}
+
+func (w WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", w.Option, w.Weight)
+}
+
+// WeightedVoteOptions describes array of WeightedVoteOptions
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption creates the weighted options of a single option with the weight 1
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{{Option: option, Weight: sdk.OneDec()}}
+}
+
+// Weight returns the weight of the option, zero if the option not in the options
+func (v WeightedVoteOptions) Weight(option VoteOption) sdk.Dec {
+	for _, o := range v {
+		if o.Option == option {
+			return o.Weight
+		}
+	}
+
+	return sdk.ZeroDec()
+}
+
+// Equal returns true if two slices (order-dependant) of weighted options are equal.
+func (v WeightedVoteOptions) Equal(other WeightedVoteOptions) bool {
+	if len(v) != len(other) {
+		return false
+	}
+
+	for i, o := range v {
+		if o.Option != other[i].Option || !o.Weight.Equal(other[i].Weight) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v WeightedVoteOptions) String() string {
+	out := make([]string, 0, len(v))
+	for _, o := range v {
+		out = append(out, o.String())
+	}
+
+	return strings.Join(out, ",")
+}
+
+// ValidWeightedVoteOptions returns error if the weighted options are not valid,
+// the options should be valid and not duplicated, and the sum of the weights should be 1.
+func ValidWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "no vote options")
+	}
+
+	used := make(map[VoteOption]bool, len(options))
+	totalWeight := sdk.ZeroDec()
+	for _, option := range options {
+		if !option.IsValid() {
+			return sdkerrors.Wrap(ErrInvalidVote, option.String())
+		}
+
+		if used[option.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicated vote option %s", option.Option)
+		}
+
+		used[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight of vote options %s is not 1", totalWeight)
+	}
+
+	return nil
+}
+
+// WeightedVoteOptionsFromString returns weighted vote options from a string like "Yes=0.6,Abstain=0.4",
+// an option without the weight has the weight 1. It returns an error if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	options := WeightedVoteOptions{}
+	for _, option := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(option), "=")
+
+		vo, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return options, err
+		}
+
+		weight := sdk.OneDec()
+		if len(fields) > 2 {
+			return options, fmt.Errorf("'%s' is not a valid weighted vote option", option)
+		} else if len(fields) == 2 {
+			weight, err = sdk.NewDecFromStr(fields[1])
+			if err != nil {
+				return options, err
+			}
+		}
+
+		options = append(options, NewWeightedVoteOption(vo, weight))
+	}
+
+	return options, nil
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// VoteReceipt is a vote with the merkle proof of it in the gov store, so that
+// third parties can verify the vote by the block header without trusting the node.
+//
+// NOTE: the proof of the state at Height is against the app hash in the header at Height + 1.
+type VoteReceipt struct {
+	Vote   Vote                  `json:"vote" yaml:"vote"`
+	Height int64                 `json:"height" yaml:"height"`
+	Key    []byte                `json:"key" yaml:"key"`
+	Value  []byte                `json:"value" yaml:"value"`
+	Proof  *merkle.Proof         `json:"proof" yaml:"proof"`
+	Header *tmtypes.SignedHeader `json:"header" yaml:"header"`
+}
+
+// NewVoteReceipt creates a new vote receipt
+func NewVoteReceipt(vote Vote, height int64, key, value []byte, proof *merkle.Proof, header *tmtypes.SignedHeader) VoteReceipt {
+	return VoteReceipt{
+		Vote:   vote,
+		Height: height,
+		Key:    key,
+		Value:  value,
+		Proof:  proof,
+		Header: header,
+	}
+}
+
+// Verify verifies the vote in receipt is stored in the state by the app hash of the header,
+// the header itself should be verified by the caller, such as by a light client.
+func (r VoteReceipt) Verify(cdc *codec.Codec) error {
+	if r.Proof == nil || r.Header == nil || r.Header.Header == nil {
+		return sdkerrors.Wrap(ErrInvalidVoteReceipt, "no proof or header")
+	}
+
+	if r.Header.Height != r.Height+1 {
+		return sdkerrors.Wrapf(ErrInvalidVoteReceipt, "header height %d not match state height %d", r.Header.Height, r.Height)
+	}
+
+	if !bytes.Equal(r.Key, VoteKey(r.Vote.ProposalID, r.Vote.Voter)) {
+		return sdkerrors.Wrap(ErrInvalidVoteReceipt, "key not match the vote")
+	}
+
+	var vote Vote
+	if err := cdc.UnmarshalBinaryBare(r.Value, &vote); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVoteReceipt, err.Error())
+	}
+
+	if !vote.Equal(r.Vote) {
+		return sdkerrors.Wrap(ErrInvalidVoteReceipt, "value not match the vote")
+	}
+
+	kp := merkle.KeyPath{}.
+		AppendKey([]byte(StoreKey), merkle.KeyEncodingURL).
+		AppendKey(r.Key, merkle.KeyEncodingURL)
+
+	if err := rootmulti.DefaultProofRuntime().VerifyValue(r.Proof, r.Header.AppHash, kp.String(), r.Value); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVoteReceipt, err.Error())
+	}
+
+	return nil
+}
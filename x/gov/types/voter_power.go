@@ -0,0 +1,76 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// The ways the stake of a voter counts in the tally of a proposal
+const (
+	// VoterPowerModeValidator the voter is a bonded validator, its vote carries all its bonded tokens
+	VoterPowerModeValidator = "validator"
+	// VoterPowerModeInherited the delegations of the voter inherit the votes of the validators
+	VoterPowerModeInherited = "inherited"
+)
+
+// DelegationPower the power of a delegation of the voter in the tally of a proposal
+type DelegationPower struct {
+	Validator AccountID           `json:"validator" yaml:"validator"`
+	Power     sdk.Dec             `json:"power" yaml:"power"`     // the token worth of the delegation, zero if the validator is not bonded
+	Options   WeightedVoteOptions `json:"options" yaml:"options"` // the vote of the validator inherited, empty if the validator not voted
+}
+
+// Counted returns true if the delegation counts in the tally
+func (d DelegationPower) Counted() bool {
+	return d.Power.IsPositive() && len(d.Options) > 0
+}
+
+// VoterPower the effective voting power of a voter on a proposal in voting period, the tally only counts
+// the votes of the bonded validators, so the vote of a delegator is recorded but cannot override the
+// vote of its validators, the delegations count by the votes of the validators.
+type VoterPower struct {
+	ProposalID     uint64              `json:"proposal_id" yaml:"proposal_id"`
+	Voter          AccountID           `json:"voter" yaml:"voter"`
+	Mode           string              `json:"mode" yaml:"mode"`
+	Vote           WeightedVoteOptions `json:"vote" yaml:"vote"`                 // the vote of the voter, empty if not voted
+	VoteCounted    bool                `json:"vote_counted" yaml:"vote_counted"` // true if the vote of the voter counts in the tally
+	EffectivePower sdk.Dec             `json:"effective_power" yaml:"effective_power"`
+	Delegations    []DelegationPower   `json:"delegations" yaml:"delegations"`
+}
+
+// NewVoterPower creates the voter power by its vote and delegations, the validator power is the bonded
+// tokens of the voter if it is a bonded validator, or nil.
+func NewVoterPower(proposalID uint64, voter AccountID, vote WeightedVoteOptions, validatorPower *sdk.Dec,
+	delegations []DelegationPower) VoterPower {
+	res := VoterPower{
+		ProposalID:     proposalID,
+		Voter:          voter,
+		Mode:           VoterPowerModeInherited,
+		Vote:           vote,
+		EffectivePower: sdk.ZeroDec(),
+		Delegations:    delegations,
+	}
+
+	if validatorPower != nil {
+		res.Mode = VoterPowerModeValidator
+		res.VoteCounted = len(vote) > 0
+		if res.VoteCounted {
+			res.EffectivePower = *validatorPower
+		}
+		return res
+	}
+
+	for _, d := range delegations {
+		if d.Counted() {
+			res.EffectivePower = res.EffectivePower.Add(d.Power)
+		}
+	}
+
+	return res
+}
+
+// String implements stringer interface
+func (p VoterPower) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
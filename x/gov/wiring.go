@@ -0,0 +1,22 @@
+package gov
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/gov/types"
+)
+
+// Inputs the keepers and proposal router the gov module depends on
+type Inputs struct {
+	SupplyKeeper       types.SupplyKeeper
+	StakingKeeper      types.StakingKeeper
+	DistributionKeeper types.DistributionKeeper
+	Router             types.Router
+}
+
+// ProvideKeeper creates the gov keeper by the store key and params subspace declared to the builder
+func ProvideKeeper(b *wiring.Builder, in Inputs) Keeper {
+	return NewKeeper(
+		b.Codec(), b.KVStoreKey(StoreKey), b.Subspace(DefaultParamspace).WithKeyTable(ParamKeyTable()),
+		in.SupplyKeeper, in.StakingKeeper, in.DistributionKeeper, in.Router,
+	)
+}
@@ -0,0 +1,10 @@
+package insurance
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker deletes the policies and the slash events which are out of the claim window
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.PruneExpired(ctx)
+}
@@ -0,0 +1,62 @@
+package insurance
+
+// nolint
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/insurance/keeper"
+	"github.com/KuChainNetwork/kuchain/x/insurance/types"
+)
+
+const (
+	ModuleName        = types.ModuleName
+	StoreKey          = types.StoreKey
+	RouterKey         = types.RouterKey
+	QuerierRoute      = types.QuerierRoute
+	DefaultParamspace = types.DefaultParamspace
+	QueryParameters   = types.QueryParameters
+	QueryPolicies     = types.QueryPolicies
+	QuerySlashEvents  = types.QuerySlashEvents
+	QueryPool         = types.QueryPool
+)
+
+var (
+	// functions aliases
+	NewKeeper                 = keeper.NewKeeper
+	NewQuerier                = keeper.NewQuerier
+	RegisterCodec             = types.RegisterCodec
+	NewGenesisState           = types.NewGenesisState
+	DefaultGenesisState       = types.DefaultGenesisState
+	ValidateGenesis           = types.ValidateGenesis
+	ParamKeyTable             = types.ParamKeyTable
+	NewParams                 = types.NewParams
+	DefaultParams             = types.DefaultParams
+	NewPolicy                 = types.NewPolicy
+	NewSlashEvent             = types.NewSlashEvent
+	NewSlashedDelegation      = types.NewSlashedDelegation
+	NewClaim                  = types.NewClaim
+	NewMsgBuyPolicy           = types.NewMsgBuyPolicy
+	NewMsgClaim               = types.NewMsgClaim
+	NewKuMsgBuyPolicy         = types.NewKuMsgBuyPolicy
+	NewKuMsgClaim             = types.NewKuMsgClaim
+	NewQueryPoliciesParams    = types.NewQueryPoliciesParams
+	NewQuerySlashEventsParams = types.NewQuerySlashEventsParams
+
+	// variable aliases
+	ModuleCdc       = types.ModuleCdc
+	Cdc             = types.Cdc
+	ModuleAccountID = types.ModuleAccountID
+)
+
+type (
+	Keeper            = keeper.Keeper
+	GenesisState      = types.GenesisState
+	Params            = types.Params
+	Policy            = types.Policy
+	Policies          = types.Policies
+	SlashEvent        = types.SlashEvent
+	SlashEvents       = types.SlashEvents
+	SlashedDelegation = types.SlashedDelegation
+	Claim             = types.Claim
+	MsgBuyPolicy      = types.MsgBuyPolicy
+	MsgClaim          = types.MsgClaim
+)
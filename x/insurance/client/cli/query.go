@@ -0,0 +1,183 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/insurance/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	insuranceQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the insurance module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	insuranceQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryPolicies(cdc),
+			GetCmdQuerySlashEvents(cdc),
+			GetCmdQueryPool(cdc),
+			GetCmdQueryParams(cdc),
+		)...,
+	)
+
+	return insuranceQueryCmd
+}
+
+// GetCmdQueryPolicies implements the query policies of delegator command.
+func GetCmdQueryPolicies(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "policies [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the insurance policies bought by the delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the insurance policies bought by the delegator.
+
+Example:
+$ %s query insurance policies alice
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegator, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryPoliciesParams(delegator))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPolicies)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var policies types.Policies
+			cdc.MustUnmarshalJSON(res, &policies)
+			return cliCtx.PrintOutput(policies)
+		},
+	}
+}
+
+// GetCmdQuerySlashEvents implements the query slash events command.
+func GetCmdQuerySlashEvents(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "slash-events [validator]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the downtime slash events which can be claimed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the downtime slash events in the claim window of the validator, all events if no validator.
+
+Example:
+$ %s query insurance slash-events validator
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var validator chainTypes.AccountID
+			if len(args) > 0 {
+				id, err := chainTypes.NewAccountIDFromStr(args[0])
+				if err != nil {
+					return err
+				}
+				validator = id
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySlashEventsParams(validator))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySlashEvents)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var events types.SlashEvents
+			cdc.MustUnmarshalJSON(res, &events)
+			return cliCtx.PrintOutput(events)
+		},
+	}
+}
+
+// GetCmdQueryPool implements a command to fetch the coins in the insurance pool.
+func GetCmdQueryPool(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pool",
+		Short: "Query the coins in the insurance pool",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the coins in the insurance pool paid by the premiums:
+
+$ %s query insurance pool
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPool)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var pool types.Coins
+			cdc.MustUnmarshalJSON(res, &pool)
+			return cliCtx.PrintOutput(pool)
+		},
+	}
+}
+
+// GetCmdQueryParams implements a command to fetch insurance parameters.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current insurance parameters",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current parameters for the insurance module:
+
+$ %s query insurance params
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
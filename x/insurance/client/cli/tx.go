@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/insurance/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	insuranceTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Slashing insurance transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	insuranceTxCmd.AddCommand(flags.PostCommands(
+		GetCmdBuyPolicy(cdc),
+		GetCmdClaim(cdc),
+	)...)
+
+	return insuranceTxCmd
+}
+
+// GetCmdBuyPolicy implements the buy policy command.
+func GetCmdBuyPolicy(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "buy-policy [delegator] [validator] [premium]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Buy a policy covering the downtime slash loss of the delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Buy a policy covering the downtime slash loss of the delegation to the validator,
+the covered tokens are the premium divided by the premium rate in the insurance params.
+
+Example:
+$ %s tx insurance buy-policy alice validator 10kuchain/kcs --from alice
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			delegator, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "delegator account id error")
+			}
+
+			validator, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "validator account id error")
+			}
+
+			premium, err := chainTypes.ParseCoin(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "premium parse error")
+			}
+
+			delegatorAuth, err := txutil.QueryAccountAuth(cliCtx, delegator)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", delegator)
+			}
+
+			msg := types.NewKuMsgBuyPolicy(delegatorAuth, delegator, validator, premium)
+			cliCtx = cliCtx.WithFromAccount(delegator)
+			if txBldr.FeePayer().Empty() {
+				txBldr = txBldr.WithPayer(args[0])
+			}
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdClaim implements the claim command.
+func GetCmdClaim(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim [delegator] [event-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Claim the compensation of a downtime slash covered by the policies",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the compensation of a downtime slash covered by the policies of the delegator,
+the slash events can be found by running "%s query insurance slash-events".
+
+Example:
+$ %s tx insurance claim alice 1 --from alice
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			delegator, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "delegator account id error")
+			}
+
+			eventID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("event-id %s not a valid uint, please input a valid event-id", args[1])
+			}
+
+			delegatorAuth, err := txutil.QueryAccountAuth(cliCtx, delegator)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", delegator)
+			}
+
+			msg := types.NewKuMsgClaim(delegatorAuth, delegator, eventID)
+			cliCtx = cliCtx.WithFromAccount(delegator)
+			if txBldr.FeePayer().Empty() {
+				txBldr = txBldr.WithPayer(args[0])
+			}
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package insurance
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/insurance/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initialize default parameters, the policies, the slash events and the claims
+func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetNextPolicyID(ctx, data.NextPolicyID)
+	keeper.SetNextSlashEventID(ctx, data.NextSlashEventID)
+
+	for _, policy := range data.Policies {
+		keeper.SetPolicy(ctx, policy)
+	}
+
+	for _, event := range data.SlashEvents {
+		keeper.SetSlashEvent(ctx, event)
+	}
+
+	for _, claim := range data.Claims {
+		keeper.SetClaim(ctx, claim)
+	}
+
+	supplyKeeper.GetModuleAccount(ctx, ModuleName)
+}
+
+// ExportGenesis writes the current store values
+// to a genesis file, which can be imported again
+// with InitGenesis
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	policies := types.Policies{}
+	keeper.IteratePolicies(ctx, func(policy types.Policy) bool {
+		policies = append(policies, policy)
+		return false
+	})
+
+	events := types.SlashEvents{}
+	keeper.IterateSlashEvents(ctx, func(event types.SlashEvent) bool {
+		events = append(events, event)
+		return false
+	})
+
+	claims := []types.Claim{}
+	keeper.IterateClaims(ctx, func(claim types.Claim) bool {
+		claims = append(claims, claim)
+		return false
+	})
+
+	return NewGenesisState(
+		keeper.GetParams(ctx), keeper.GetNextPolicyID(ctx), policies, keeper.GetNextSlashEventID(ctx), events, claims)
+}
@@ -0,0 +1,77 @@
+package insurance
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/insurance/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewHandler(k Keeper) msg.Handler {
+	return func(ctx chainTypes.Context, msg sdk.Msg) (*sdk.Result, error) {
+		switch msg := msg.(type) {
+		case types.KuMsgBuyPolicy:
+			return handleKuMsgBuyPolicy(ctx, k, msg)
+		case types.KuMsgClaim:
+			return handleKuMsgClaim(ctx, k, msg)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleKuMsgBuyPolicy(ctx chainTypes.Context, k Keeper, msg types.KuMsgBuyPolicy) (*sdk.Result, error) {
+	msgData := types.MsgBuyPolicy{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg BuyPolicy data unmarshal error")
+	}
+
+	// the premium should be transferred to module account by the msg
+	if !msg.GetTo().Eq(types.ModuleAccountID) || !msg.GetAmount().IsEqual(types.NewCoins(msgData.Premium)) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPremium, "premium %s not transferred", msgData.Premium)
+	}
+
+	ctx.RequireAuth(msgData.Delegator)
+
+	policy, err := k.BuyPolicy(ctx.Context(), msgData.Delegator, msgData.Validator, msgData.Premium)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msgData.Delegator.String()),
+		),
+	)
+
+	res := types.MsgBuyPolicyResponse{PolicyID: policy.ID}
+	return chainTypes.NewMsgResult(Cdc(), res, ctx.EventManager().Events()), nil
+}
+
+func handleKuMsgClaim(ctx chainTypes.Context, k Keeper, msg types.KuMsgClaim) (*sdk.Result, error) {
+	msgData := types.MsgClaim{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg Claim data unmarshal error")
+	}
+
+	ctx.RequireAuth(msgData.Delegator)
+
+	compensation, err := k.Claim(ctx.Context(), msgData.Delegator, msgData.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msgData.Delegator.String()),
+		),
+	)
+
+	res := types.MsgClaimResponse{Compensation: compensation}
+	return chainTypes.NewMsgResult(Cdc(), res, ctx.EventManager().Events()), nil
+}
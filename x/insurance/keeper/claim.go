@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/insurance/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetSlashEvent get slash event from store by eventID
+func (k Keeper) GetSlashEvent(ctx sdk.Context, eventID uint64) (types.SlashEvent, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.SlashEventKey(eventID))
+	if bz == nil {
+		return types.SlashEvent{}, false
+	}
+
+	var event types.SlashEvent
+	k.cdc.MustUnmarshalBinaryBare(bz, &event)
+
+	return event, true
+}
+
+// SetSlashEvent set a slash event to store
+func (k Keeper) SetSlashEvent(ctx sdk.Context, event types.SlashEvent) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(event)
+	store.Set(types.SlashEventKey(event.ID), bz)
+}
+
+// DeleteSlashEvent deletes a slash event and its claims from store
+func (k Keeper) DeleteSlashEvent(ctx sdk.Context, eventID uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ClaimsPrefix(eventID))
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	store.Delete(types.SlashEventKey(eventID))
+}
+
+// IterateSlashEvents iterates over the slash events in the order of the ids and performs a callback function
+func (k Keeper) IterateSlashEvents(ctx sdk.Context, cb func(event types.SlashEvent) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SlashEventKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var event types.SlashEvent
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &event)
+
+		if cb(event) {
+			break
+		}
+	}
+}
+
+// GetSlashEvents returns the slash events of the validator, all events if validator is empty
+func (k Keeper) GetSlashEvents(ctx sdk.Context, validator types.AccountID) (events types.SlashEvents) {
+	k.IterateSlashEvents(ctx, func(event types.SlashEvent) bool {
+		if validator.Empty() || event.Validator.Eq(validator) {
+			events = append(events, event)
+		}
+		return false
+	})
+	return
+}
+
+// GetClaim get the claim of the delegator on the slash event
+func (k Keeper) GetClaim(ctx sdk.Context, eventID uint64, delegator types.AccountID) (types.Claim, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ClaimKey(eventID, delegator))
+	if bz == nil {
+		return types.Claim{}, false
+	}
+
+	var claim types.Claim
+	k.cdc.MustUnmarshalBinaryBare(bz, &claim)
+
+	return claim, true
+}
+
+// SetClaim set a claim to store
+func (k Keeper) SetClaim(ctx sdk.Context, claim types.Claim) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(claim)
+	store.Set(types.ClaimKey(claim.EventID, claim.Delegator), bz)
+}
+
+// IterateClaims iterates over the all the claims and performs a callback function
+func (k Keeper) IterateClaims(ctx sdk.Context, cb func(claim types.Claim) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ClaimKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.Claim
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &claim)
+
+		if cb(claim) {
+			break
+		}
+	}
+}
+
+// RecordSlashEvent records a downtime slash of the validator for the claims, it should be called before the slash,
+// the delegation shares of the delegators covered by the policies are snapshotted for their claims.
+func (k Keeper) RecordSlashEvent(ctx sdk.Context, validator types.AccountID, fraction sdk.Dec) {
+	val, found := k.stakingKeeper.GetValidator(ctx, validator)
+	if !found || val.DelegatorShares.IsZero() {
+		return
+	}
+
+	eventID := k.GetNextSlashEventID(ctx)
+	event := types.NewSlashEvent(
+		eventID, validator, ctx.BlockHeight(), ctx.BlockTime(), fraction, val.TokensFromShares(sdk.OneDec()))
+
+	k.IteratePolicies(ctx, func(policy types.Policy) bool {
+		if !policy.Covers(event) {
+			return false
+		}
+
+		if _, recorded := event.DelegatorShares(policy.Delegator); recorded {
+			return false
+		}
+
+		if delegation, found := k.stakingKeeper.GetDelegation(ctx, policy.Delegator, validator); found {
+			event.Delegations = append(event.Delegations, types.NewSlashedDelegation(policy.Delegator, delegation.Shares))
+		}
+		return false
+	})
+
+	k.SetSlashEvent(ctx, event)
+	k.SetNextSlashEventID(ctx, eventID+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashEvent,
+			sdk.NewAttribute(types.AttributeKeyEventID, fmt.Sprintf("%d", event.ID)),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.String()),
+			sdk.NewAttribute(types.AttributeKeyFraction, fraction.String()),
+		),
+	)
+}
+
+// Claim pays the compensation of the slash event to the delegator, the loss is computed by the delegation
+// shares at the slash and the exchange rate of the validator before the slash, capped by the coverage of
+// the policies covering the slash event, and compensated by the coverage rate.
+func (k Keeper) Claim(ctx sdk.Context, delegator types.AccountID, eventID uint64) (types.Coins, error) {
+	params := k.GetParams(ctx)
+
+	event, found := k.GetSlashEvent(ctx, eventID)
+	if !found || ctx.BlockTime().After(event.Time.Add(params.ClaimWindow)) {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownSlashEvent, "%d", eventID)
+	}
+
+	if _, claimed := k.GetClaim(ctx, eventID, delegator); claimed {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadyClaimed, "%s on %d", delegator, eventID)
+	}
+
+	coverage := sdk.ZeroInt()
+	for _, policy := range k.GetDelegatorPolicies(ctx, delegator) {
+		if policy.Covers(event) {
+			coverage = coverage.Add(policy.Coverage)
+		}
+	}
+
+	if !coverage.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrNotCovered, "%s on %d", delegator, eventID)
+	}
+
+	shares, found := event.DelegatorShares(delegator)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNoDelegation, "%s to %s at the slash", delegator, event.Validator)
+	}
+
+	// the loss of the covered tokens delegated before the slash
+	delegated := shares.Mul(event.TokensPerShare).TruncateInt()
+	loss := sdk.MinInt(coverage, delegated).ToDec().Mul(event.Fraction)
+
+	amount := loss.Mul(params.CoverageRate).TruncateInt()
+	if !amount.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrNotCovered, "no loss of %s on %d", delegator, eventID)
+	}
+
+	compensation := types.NewCoins(types.NewCoin(k.stakingKeeper.BondDenom(ctx), amount))
+	if pool := k.GetPool(ctx); !pool.IsAllGTE(compensation) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientPool, "%s < %s", pool, compensation)
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegator, compensation); err != nil {
+		return nil, err
+	}
+
+	k.SetClaim(ctx, types.NewClaim(eventID, delegator, compensation))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaim,
+			sdk.NewAttribute(types.AttributeKeyEventID, fmt.Sprintf("%d", eventID)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyCompensation, compensation.String()),
+		),
+	)
+
+	return compensation, nil
+}
+
+// PruneExpired deletes the slash events and the policies out of the claim window
+func (k Keeper) PruneExpired(ctx sdk.Context) {
+	expired := ctx.BlockTime().Add(-k.GetParams(ctx).ClaimWindow)
+
+	k.IteratePolicyQueue(ctx, expired, func(policy types.Policy) bool {
+		k.DeletePolicy(ctx, policy)
+		return false
+	})
+
+	// the slash events are stored in the order of time
+	var eventIDs []uint64
+	k.IterateSlashEvents(ctx, func(event types.SlashEvent) bool {
+		if event.Time.After(expired) {
+			return true
+		}
+
+		eventIDs = append(eventIDs, event.ID)
+		return false
+	})
+
+	for _, eventID := range eventIDs {
+		k.DeleteSlashEvent(ctx, eventID)
+	}
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/insurance/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Hooks wrapper struct for insurance keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the slashing hooks to record the downtime slashes
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeValidatorDowntimeSlashed records the slash event for the claims
+func (h Hooks) BeforeValidatorDowntimeSlashed(ctx sdk.Context, valAddr types.AccountID, fraction sdk.Dec) {
+	h.k.RecordSlashEvent(ctx, valAddr, fraction)
+}
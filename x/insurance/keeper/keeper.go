@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/insurance/types"
+	"github.com/KuChainNetwork/kuchain/x/params"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Keeper of the insurance store
+type Keeper struct {
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
+	paramSpace    params.Subspace
+	stakingKeeper types.StakingKeeper
+	supplyKeeper  types.SupplyKeeper
+	assetKeeper   types.AssetKeeper
+}
+
+// NewKeeper creates a new insurance Keeper instance
+func NewKeeper(
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
+	stakingKeeper types.StakingKeeper, supplyKeeper types.SupplyKeeper, assetKeeper types.AssetKeeper,
+) Keeper {
+
+	// ensure insurance module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		paramSpace:    paramSpace.WithKeyTable(types.ParamKeyTable()),
+		stakingKeeper: stakingKeeper,
+		supplyKeeper:  supplyKeeper,
+		assetKeeper:   assetKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of insurance parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of insurance parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetPool returns the coins in the insurance pool
+func (k Keeper) GetPool(ctx sdk.Context) types.Coins {
+	return k.assetKeeper.GetCoinPowers(ctx, types.ModuleAccountID)
+}
+
+// getNextID gets the next id stored in key, the id starts from 1
+func (k Keeper) getNextID(ctx sdk.Context, key []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return 1
+	}
+
+	return types.GetIDFromBytes(bz)
+}
+
+// GetNextPolicyID gets the next policy ID
+func (k Keeper) GetNextPolicyID(ctx sdk.Context) uint64 {
+	return k.getNextID(ctx, types.PolicyIDKey)
+}
+
+// SetNextPolicyID sets the next policy ID
+func (k Keeper) SetNextPolicyID(ctx sdk.Context, policyID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PolicyIDKey, types.GetIDBytes(policyID))
+}
+
+// GetNextSlashEventID gets the next slash event ID
+func (k Keeper) GetNextSlashEventID(ctx sdk.Context) uint64 {
+	return k.getNextID(ctx, types.SlashEventIDKey)
+}
+
+// SetNextSlashEventID sets the next slash event ID
+func (k Keeper) SetNextSlashEventID(ctx sdk.Context, eventID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SlashEventIDKey, types.GetIDBytes(eventID))
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	insuranceTypes "github.com/KuChainNetwork/kuchain/x/insurance/types"
+	"github.com/KuChainNetwork/kuchain/x/staking/exported"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+)
+
+var (
+	wallet    = simapp.NewWallet()
+	addr1     = wallet.NewAccAddressByName(name1)
+	addr2     = wallet.NewAccAddressByName(name2)
+	addrVal   = wallet.NewAccAddressByName(nameVal)
+	name1     = types.MustName("delegator")
+	name2     = types.MustName("other")
+	nameVal   = types.MustName("validator")
+	account1  = types.NewAccountIDFromName(name1)
+	account2  = types.NewAccountIDFromName(name2)
+	accountV  = types.NewAccountIDFromName(nameVal)
+	delegated = sdk.NewInt(1000000)
+)
+
+func createAppForTest() (*simapp.SimApp, sdk.Context) {
+	asset := types.Coins{
+		types.NewInt64Coin(constants.DefaultBondDenom, 10000000000)}
+
+	genAccs := simapp.NewGenesisAccounts(wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(asset),
+		simapp.NewSimGenesisAccount(account2, addr2).WithAsset(asset),
+		simapp.NewSimGenesisAccount(accountV, addrVal).WithAsset(asset),
+	)
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+	stakingKeeper := app.StakeKeeper()
+	validator := stakingTypes.NewValidator(accountV, ed25519.GenPrivKey().PubKey(), stakingTypes.Description{})
+	stakingKeeper.SetValidator(ctx, validator)
+	stakingKeeper.AfterValidatorCreated(ctx, accountV)
+
+	_, err := stakingKeeper.Delegate(ctx, account1, delegated, exported.Unbonded, validator, false)
+	So(err, ShouldBeNil)
+
+	return app, ctx
+}
+
+func bondCoin(amount int64) types.Coin {
+	return types.NewInt64Coin(constants.DefaultBondDenom, amount)
+}
+
+// payPremium transfers the premium to the module account as the buy policy msg does
+func payPremium(app *simapp.SimApp, ctx sdk.Context, from types.AccountID, premium types.Coin) {
+	err := app.AssetKeeper().Transfer(ctx, from, insuranceTypes.ModuleAccountID, types.NewCoins(premium))
+	So(err, ShouldBeNil)
+}
+
+func TestBuyPolicy(t *testing.T) {
+	Convey("test buy policy", t, func() {
+		app, ctx := createAppForTest()
+		k := app.InsuranceKeeper()
+
+		_, err := k.BuyPolicy(ctx, account1, accountV, types.NewInt64Coin("kuchain/other", 5000))
+		So(err, simapp.ShouldErrIs, insuranceTypes.ErrInvalidPremium)
+
+		_, err = k.BuyPolicy(ctx, account2, accountV, bondCoin(5000))
+		So(err, simapp.ShouldErrIs, insuranceTypes.ErrNoDelegation)
+
+		// covers the premium divided by the premium rate
+		payPremium(app, ctx, account1, bondCoin(5000))
+		policy, err := k.BuyPolicy(ctx, account1, accountV, bondCoin(5000))
+		So(err, ShouldBeNil)
+		So(policy.ID, ShouldEqual, 1)
+		So(policy.Coverage, ShouldResemble, sdk.NewInt(500000))
+		So(policy.EndTime, ShouldResemble, ctx.BlockTime().Add(insuranceTypes.DefaultCoveragePeriod))
+		So(k.GetPool(ctx), ShouldResemble, types.NewCoins(bondCoin(5000)))
+
+		// the active policies cannot cover more than the delegation
+		_, err = k.BuyPolicy(ctx, account1, accountV, bondCoin(6000))
+		So(err, simapp.ShouldErrIs, insuranceTypes.ErrExcessCoverage)
+
+		policies := k.GetDelegatorPolicies(ctx, account1)
+		So(policies, ShouldHaveLength, 1)
+		So(policies[0], ShouldResemble, policy)
+	})
+}
+
+func TestClaim(t *testing.T) {
+	Convey("test claim the downtime slash", t, func() {
+		app, ctx := createAppForTest()
+		k := app.InsuranceKeeper()
+		params := k.GetParams(ctx)
+
+		payPremium(app, ctx, account1, bondCoin(5000))
+		_, err := k.BuyPolicy(ctx, account1, accountV, bondCoin(5000))
+		So(err, ShouldBeNil)
+
+		// slashed in the next block
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		k.Hooks().BeforeValidatorDowntimeSlashed(ctx, accountV, sdk.NewDecWithPrec(1, 2))
+		events := k.GetSlashEvents(ctx, accountV)
+		So(events, ShouldHaveLength, 1)
+		So(events[0].TokensPerShare, ShouldResemble, sdk.OneDec())
+
+		_, err = k.Claim(ctx, account1, events[0].ID+1)
+		So(err, simapp.ShouldErrIs, insuranceTypes.ErrUnknownSlashEvent)
+
+		_, err = k.Claim(ctx, account2, events[0].ID)
+		So(err, simapp.ShouldErrIs, insuranceTypes.ErrNotCovered)
+
+		// the loss of the covered tokens compensated by the coverage rate
+		before := app.AssetKeeper().GetCoinPowers(ctx, account1)
+		compensation, err := k.Claim(ctx, account1, events[0].ID)
+		So(err, ShouldBeNil)
+		So(compensation, ShouldResemble, types.NewCoins(bondCoin(4000)))
+		So(app.AssetKeeper().GetCoinPowers(ctx, account1).Sub(before), ShouldResemble, compensation)
+		So(k.GetPool(ctx), ShouldResemble, types.NewCoins(bondCoin(1000)))
+
+		_, err = k.Claim(ctx, account1, events[0].ID)
+		So(err, simapp.ShouldErrIs, insuranceTypes.ErrAlreadyClaimed)
+
+		// the pool cannot pay the compensation
+		k.Hooks().BeforeValidatorDowntimeSlashed(ctx, accountV, sdk.NewDecWithPrec(1, 2))
+		_, err = k.Claim(ctx, account1, events[0].ID+1)
+		So(err, simapp.ShouldErrIs, insuranceTypes.ErrInsufficientPool)
+
+		// the policy bought after the slash not covers it
+		payPremium(app, ctx, account1, bondCoin(1000))
+		policy, err := k.BuyPolicy(ctx, account1, accountV, bondCoin(1000))
+		So(err, ShouldBeNil)
+		events = k.GetSlashEvents(ctx, accountV)
+		So(events, ShouldHaveLength, 2)
+		So(policy.Covers(events[1]), ShouldBeFalse)
+
+		// pruned out of the claim window
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.CoveragePeriod + params.ClaimWindow))
+		k.PruneExpired(ctx)
+		So(k.GetSlashEvents(ctx, types.AccountID{}), ShouldBeEmpty)
+		So(k.GetDelegatorPolicies(ctx, account1), ShouldBeEmpty)
+		_, found := k.GetClaim(ctx, events[0].ID, account1)
+		So(found, ShouldBeFalse)
+	})
+
+	Convey("test claim with the delegation at the slash", t, func() {
+		app, ctx := createAppForTest()
+		k := app.InsuranceKeeper()
+
+		payPremium(app, ctx, account1, bondCoin(5000))
+		_, err := k.BuyPolicy(ctx, account1, accountV, bondCoin(5000))
+		So(err, ShouldBeNil)
+
+		// only a part of the coverage delegated at the slash
+		stakingKeeper := app.StakeKeeper()
+		_, err = stakingKeeper.Unbond(ctx, account1, accountV, sdk.NewDec(800000))
+		So(err, ShouldBeNil)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		k.Hooks().BeforeValidatorDowntimeSlashed(ctx, accountV, sdk.NewDecWithPrec(1, 2))
+		events := k.GetSlashEvents(ctx, accountV)
+		So(events, ShouldHaveLength, 1)
+
+		// delegates after the slash not increases the loss
+		validator, found := stakingKeeper.GetValidator(ctx, accountV)
+		So(found, ShouldBeTrue)
+		_, err = stakingKeeper.Delegate(ctx, account1, delegated, exported.Unbonded, validator, false)
+		So(err, ShouldBeNil)
+
+		compensation, err := k.Claim(ctx, account1, events[0].ID)
+		So(err, ShouldBeNil)
+		So(compensation, ShouldResemble, types.NewCoins(bondCoin(1600)))
+
+		// the delegator not delegated at the slash cannot claim
+		_, err = stakingKeeper.Delegate(ctx, account2, delegated, exported.Unbonded, validator, false)
+		So(err, ShouldBeNil)
+		payPremium(app, ctx, account2, bondCoin(100))
+		_, err = k.BuyPolicy(ctx, account2, accountV, bondCoin(100))
+		So(err, ShouldBeNil)
+		_, err = k.Claim(ctx, account2, events[0].ID)
+		So(err, simapp.ShouldErrIs, insuranceTypes.ErrNotCovered)
+	})
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/x/insurance/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetPolicy get policy from store by policyID
+func (k Keeper) GetPolicy(ctx sdk.Context, policyID uint64) (types.Policy, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.PolicyKey(policyID))
+	if bz == nil {
+		return types.Policy{}, false
+	}
+
+	var policy types.Policy
+	k.cdc.MustUnmarshalBinaryBare(bz, &policy)
+
+	return policy, true
+}
+
+// SetPolicy set a policy to store with the delegator index and the expiration queue
+func (k Keeper) SetPolicy(ctx sdk.Context, policy types.Policy) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(policy)
+	store.Set(types.PolicyKey(policy.ID), bz)
+	store.Set(types.DelegatorPolicyKey(policy.Delegator, policy.ID), types.GetIDBytes(policy.ID))
+	store.Set(types.PolicyQueueKey(policy.ID, policy.EndTime), types.GetIDBytes(policy.ID))
+}
+
+// DeletePolicy deletes a policy from store
+func (k Keeper) DeletePolicy(ctx sdk.Context, policy types.Policy) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PolicyQueueKey(policy.ID, policy.EndTime))
+	store.Delete(types.DelegatorPolicyKey(policy.Delegator, policy.ID))
+	store.Delete(types.PolicyKey(policy.ID))
+}
+
+// IteratePolicies iterates over the all the policies and performs a callback function
+func (k Keeper) IteratePolicies(ctx sdk.Context, cb func(policy types.Policy) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PolicyKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.Policy
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &policy)
+
+		if cb(policy) {
+			break
+		}
+	}
+}
+
+// GetDelegatorPolicies returns the policies bought by the delegator
+func (k Keeper) GetDelegatorPolicies(ctx sdk.Context, delegator types.AccountID) (policies types.Policies) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegatorPoliciesPrefix(delegator))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		policyID := types.GetIDFromBytes(iterator.Value())
+		policy, found := k.GetPolicy(ctx, policyID)
+		if !found {
+			panic(sdkerrors.Wrapf(types.ErrUnknownPolicy, "policy %d does not exist", policyID))
+		}
+
+		policies = append(policies, policy)
+	}
+	return
+}
+
+// IteratePolicyQueue iterates over the policies which end before endTime
+func (k Keeper) IteratePolicyQueue(ctx sdk.Context, endTime time.Time, cb func(policy types.Policy) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.PolicyQueueKeyPrefix, sdk.PrefixEndBytes(types.PolicyQueuePrefix(endTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		policyID := types.GetIDFromBytes(iterator.Value())
+		policy, found := k.GetPolicy(ctx, policyID)
+		if !found {
+			panic(sdkerrors.Wrapf(types.ErrUnknownPolicy, "policy %d does not exist", policyID))
+		}
+
+		if cb(policy) {
+			break
+		}
+	}
+}
+
+// BuyPolicy buys a policy covering the delegation to the validator, the premium should be transferred
+// to module account before, the coverage is the premium divided by the premium rate, and the active
+// policies of the delegation cannot cover more than the delegated tokens.
+func (k Keeper) BuyPolicy(ctx sdk.Context, delegator, validator types.AccountID, premium types.Coin) (types.Policy, error) {
+	params := k.GetParams(ctx)
+
+	if premium.Denom != k.stakingKeeper.BondDenom(ctx) {
+		return types.Policy{}, sdkerrors.Wrapf(types.ErrInvalidPremium,
+			"premium denom %s should be %s", premium.Denom, k.stakingKeeper.BondDenom(ctx))
+	}
+
+	val, found := k.stakingKeeper.GetValidator(ctx, validator)
+	if !found {
+		return types.Policy{}, sdkerrors.Wrapf(types.ErrNoDelegation, "validator %s not found", validator)
+	}
+
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, delegator, validator)
+	if !found {
+		return types.Policy{}, sdkerrors.Wrapf(types.ErrNoDelegation, "%s to %s", delegator, validator)
+	}
+
+	coverage := premium.Amount.ToDec().Quo(params.PremiumRate).TruncateInt()
+	if !coverage.IsPositive() {
+		return types.Policy{}, sdkerrors.Wrapf(types.ErrInvalidPremium, "premium %s covers nothing", premium)
+	}
+
+	covered := coverage
+	for _, policy := range k.GetDelegatorPolicies(ctx, delegator) {
+		if policy.Validator.Eq(validator) && policy.IsActive(ctx.BlockTime()) {
+			covered = covered.Add(policy.Coverage)
+		}
+	}
+
+	delegated := val.TokensFromShares(delegation.Shares).TruncateInt()
+	if covered.GT(delegated) {
+		return types.Policy{}, sdkerrors.Wrapf(types.ErrExcessCoverage, "%s > %s", covered, delegated)
+	}
+
+	if err := k.supplyKeeper.ModuleCoinsToPower(ctx, types.ModuleName, types.NewCoins(premium)); err != nil {
+		return types.Policy{}, err
+	}
+
+	policyID := k.GetNextPolicyID(ctx)
+	policy := types.NewPolicy(
+		policyID, delegator, validator, premium, coverage, ctx.BlockHeight(), ctx.BlockTime().Add(params.CoveragePeriod))
+
+	k.SetPolicy(ctx, policy)
+	k.SetNextPolicyID(ctx, policyID+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBuyPolicy,
+			sdk.NewAttribute(types.AttributeKeyPolicyID, fmt.Sprintf("%d", policy.ID)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.String()),
+			sdk.NewAttribute(types.AttributeKeyPremium, premium.String()),
+			sdk.NewAttribute(types.AttributeKeyCoverage, coverage.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiration, policy.EndTime.String()),
+		),
+	)
+
+	return policy, nil
+}
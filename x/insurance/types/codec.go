@@ -0,0 +1,32 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgBuyPolicy{}, "insurance/MsgBuyPolicy", nil)
+	cdc.RegisterConcrete(KuMsgBuyPolicy{}, "insurance/KuMsgBuyPolicy", nil)
+	cdc.RegisterConcrete(&MsgClaim{}, "insurance/MsgClaim", nil)
+	cdc.RegisterConcrete(KuMsgClaim{}, "insurance/KuMsgClaim", nil)
+
+	cdc.RegisterConcrete(MsgBuyPolicyResponse{}, "insurance/MsgBuyPolicyResponse", nil)
+	cdc.RegisterConcrete(MsgClaimResponse{}, "insurance/MsgClaimResponse", nil)
+}
+
+var (
+	// ModuleCdc references the global x/insurance module codec.
+	ModuleCdc = codec.New()
+)
+
+// Cdc get codec for types
+func Cdc() *codec.Codec {
+	return ModuleCdc
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/insurance module sentinel errors
+var (
+	ErrUnknownPolicy     = sdkerrors.Register(ModuleName, 2, "unknown insurance policy")
+	ErrUnknownSlashEvent = sdkerrors.Register(ModuleName, 3, "unknown slash event")
+	ErrInvalidPremium    = sdkerrors.Register(ModuleName, 4, "invalid insurance premium")
+	ErrNoDelegation      = sdkerrors.Register(ModuleName, 5, "no delegation to insure")
+	ErrExcessCoverage    = sdkerrors.Register(ModuleName, 6, "coverage exceeds the delegated tokens")
+	ErrNotCovered        = sdkerrors.Register(ModuleName, 7, "slash event not covered by the policies")
+	ErrAlreadyClaimed    = sdkerrors.Register(ModuleName, 8, "slash event already claimed")
+	ErrInsufficientPool  = sdkerrors.Register(ModuleName, 9, "insufficient insurance pool")
+	ErrInvalidAccount    = sdkerrors.Register(ModuleName, 10, "invalid insurance account")
+)
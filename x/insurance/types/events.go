@@ -0,0 +1,20 @@
+package types
+
+// insurance module event types
+const (
+	EventTypeBuyPolicy  = "buy_policy"
+	EventTypeClaim      = "claim_insurance"
+	EventTypeSlashEvent = "insured_slash"
+
+	AttributeKeyPolicyID     = "policy_id"
+	AttributeKeyEventID      = "event_id"
+	AttributeKeyDelegator    = "delegator"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyPremium      = "premium"
+	AttributeKeyCoverage     = "coverage"
+	AttributeKeyExpiration   = "expiration"
+	AttributeKeyFraction     = "fraction"
+	AttributeKeyCompensation = "compensation"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,28 @@
+package types
+
+import (
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+	"github.com/KuChainNetwork/kuchain/x/supply/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingKeeper defines the expected staking keeper to get the delegations insured
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, acc AccountID) (stakingTypes.Validator, bool)
+	GetDelegation(ctx sdk.Context, delAddr AccountID, valAddr AccountID) (stakingTypes.Delegation, bool)
+}
+
+// SupplyKeeper defines the expected supply keeper for the insurance pool (noalias)
+type SupplyKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) exported.ModuleAccountI
+
+	ModuleCoinsToPower(ctx sdk.Context, recipientModule string, amt Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr AccountID, amt Coins) error
+}
+
+// AssetKeeper defines the expected asset keeper to get the balance of the insurance pool
+type AssetKeeper interface {
+	GetCoinPowers(ctx sdk.Context, account AccountID) Coins
+}
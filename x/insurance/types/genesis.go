@@ -0,0 +1,77 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GenesisState - all insurance state that must be provided at genesis
+type GenesisState struct {
+	Params           Params      `json:"params" yaml:"params"`
+	NextPolicyID     uint64      `json:"next_policy_id" yaml:"next_policy_id"`
+	Policies         Policies    `json:"policies" yaml:"policies"`
+	NextSlashEventID uint64      `json:"next_slash_event_id" yaml:"next_slash_event_id"`
+	SlashEvents      SlashEvents `json:"slash_events" yaml:"slash_events"`
+	Claims           []Claim     `json:"claims" yaml:"claims"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(
+	params Params, nextPolicyID uint64, policies Policies, nextSlashEventID uint64, events SlashEvents, claims []Claim,
+) GenesisState {
+	return GenesisState{
+		Params:           params,
+		NextPolicyID:     nextPolicyID,
+		Policies:         policies,
+		NextSlashEventID: nextSlashEventID,
+		SlashEvents:      events,
+		Claims:           claims,
+	}
+}
+
+// DefaultGenesisState - default GenesisState
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), 1, Policies{}, 1, SlashEvents{}, []Claim{})
+}
+
+// ValidateGenesis performs basic validation of insurance genesis data returning an
+// error for any failed validation criteria.
+func (g GenesisState) ValidateGenesis(bz json.RawMessage) error {
+	gs := DefaultGenesisState()
+	if err := Cdc().UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+
+	return ValidateGenesis(gs)
+}
+
+// ValidateGenesis validates the insurance genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, policy := range data.Policies {
+		if policy.ID >= data.NextPolicyID {
+			return fmt.Errorf("policy id %d should be less than next policy id %d", policy.ID, data.NextPolicyID)
+		}
+
+		if !policy.Premium.IsValid() || !policy.Coverage.IsPositive() {
+			return fmt.Errorf("invalid premium or coverage for policy %d", policy.ID)
+		}
+	}
+
+	for _, event := range data.SlashEvents {
+		if event.ID >= data.NextSlashEventID {
+			return fmt.Errorf("slash event id %d should be less than next slash event id %d", event.ID, data.NextSlashEventID)
+		}
+
+		for _, d := range event.Delegations {
+			if d.Delegator.Empty() || !d.Shares.IsPositive() {
+				return fmt.Errorf("invalid delegation of %s for slash event %d", d.Delegator, event.ID)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "insurance"
+
+	// StoreKey is the store key string for insurance
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for insurance
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for insurance
+	QuerierRoute = ModuleName
+)
+
+// Keys for insurance store
+// Items are stored with the following key: values
+//
+// - 0x01<policyID_Bytes>: Policy
+//
+// - 0x02: nextPolicyID
+//
+// - 0x03<delegator_Bytes><policyID_Bytes>: policyID
+//
+// - 0x04<expireTime_Bytes><policyID_Bytes>: policyID
+//
+// - 0x05<eventID_Bytes>: SlashEvent
+//
+// - 0x06: nextSlashEventID
+//
+// - 0x07<eventID_Bytes><delegator_Bytes>: Claim
+var (
+	PolicyKeyPrefix          = []byte{0x01}
+	PolicyIDKey              = []byte{0x02}
+	DelegatorPolicyKeyPrefix = []byte{0x03}
+	PolicyQueueKeyPrefix     = []byte{0x04}
+	SlashEventKeyPrefix      = []byte{0x05}
+	SlashEventIDKey          = []byte{0x06}
+	ClaimKeyPrefix           = []byte{0x07}
+
+	// ModuleAccountID is the account id for module account
+	ModuleAccountID = types.NewAccountIDFromName(types.MustName(ModuleName))
+)
+
+// GetIDBytes returns the byte representation of the policy or slash event id
+func GetIDBytes(id uint64) (idBz []byte) {
+	idBz = make([]byte, 8)
+	binary.BigEndian.PutUint64(idBz, id)
+	return
+}
+
+// GetIDFromBytes returns the id in uint64 format from a byte array
+func GetIDFromBytes(bz []byte) (id uint64) {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// PolicyKey gets a specific policy from the store
+func PolicyKey(policyID uint64) []byte {
+	return append(PolicyKeyPrefix, GetIDBytes(policyID)...)
+}
+
+// DelegatorPoliciesPrefix gets the prefix of the policies of the delegator
+func DelegatorPoliciesPrefix(delegator AccountID) []byte {
+	return append(DelegatorPolicyKeyPrefix, delegator.StoreKey()...)
+}
+
+// DelegatorPolicyKey returns the key for a policyID of the delegator
+func DelegatorPolicyKey(delegator AccountID, policyID uint64) []byte {
+	return append(DelegatorPoliciesPrefix(delegator), GetIDBytes(policyID)...)
+}
+
+// PolicyQueuePrefix gets the prefix of the policies which expire at time
+func PolicyQueuePrefix(expireTime time.Time) []byte {
+	return append(PolicyQueueKeyPrefix, sdk.FormatTimeBytes(expireTime)...)
+}
+
+// PolicyQueueKey returns the key for a policyID in the policy queue
+func PolicyQueueKey(policyID uint64, expireTime time.Time) []byte {
+	return append(PolicyQueuePrefix(expireTime), GetIDBytes(policyID)...)
+}
+
+// SlashEventKey gets a specific slash event from the store
+func SlashEventKey(eventID uint64) []byte {
+	return append(SlashEventKeyPrefix, GetIDBytes(eventID)...)
+}
+
+// ClaimsPrefix gets the prefix of the claims of the slash event
+func ClaimsPrefix(eventID uint64) []byte {
+	return append(ClaimKeyPrefix, GetIDBytes(eventID)...)
+}
+
+// ClaimKey returns the key for the claim of the delegator on the slash event
+func ClaimKey(eventID uint64, delegator AccountID) []byte {
+	return append(ClaimsPrefix(eventID), delegator.StoreKey()...)
+}
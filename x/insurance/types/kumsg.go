@@ -0,0 +1,74 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	RouterKeyName = MustName(RouterKey)
+)
+
+type KuMsgBuyPolicy struct {
+	KuMsg
+}
+
+// NewKuMsgBuyPolicy creates a msg to buy a policy, the premium will be transferred to module account
+func NewKuMsgBuyPolicy(auth sdk.AccAddress, delegator, validator AccountID, premium Coin) KuMsgBuyPolicy {
+	return KuMsgBuyPolicy{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithTransfer(delegator, ModuleAccountID, NewCoins(premium)),
+			msg.WithData(Cdc(), &MsgBuyPolicy{
+				Delegator: delegator,
+				Validator: validator,
+				Premium:   premium,
+			}),
+		),
+	}
+}
+
+func (msg KuMsgBuyPolicy) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgData := MsgBuyPolicy{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return err
+	}
+
+	return msgData.ValidateBasic()
+}
+
+type KuMsgClaim struct {
+	KuMsg
+}
+
+// NewKuMsgClaim creates a msg to claim the compensation of a slash event
+func NewKuMsgClaim(auth sdk.AccAddress, delegator AccountID, eventID uint64) KuMsgClaim {
+	return KuMsgClaim{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgClaim{
+				Delegator: delegator,
+				EventID:   eventID,
+			}),
+		),
+	}
+}
+
+func (msg KuMsgClaim) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgData := MsgClaim{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return err
+	}
+
+	return msgData.ValidateBasic()
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+var _ types.MsgResponse = MsgBuyPolicyResponse{}
+var _ types.MsgResponse = MsgClaimResponse{}
+
+// MsgBuyPolicyResponse is the response of the buy policy msg
+type MsgBuyPolicyResponse struct {
+	PolicyID uint64 `json:"policy_id" yaml:"policy_id"`
+}
+
+// CreatedID implements types.MsgResponse
+func (r MsgBuyPolicyResponse) CreatedID() string {
+	return strconv.FormatUint(r.PolicyID, 10)
+}
+
+// MsgClaimResponse is the response of the claim msg
+type MsgClaimResponse struct {
+	Compensation Coins `json:"compensation" yaml:"compensation"`
+}
+
+// CreatedID implements types.MsgResponse
+func (r MsgClaimResponse) CreatedID() string { return "" }
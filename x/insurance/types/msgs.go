@@ -0,0 +1,71 @@
+package types
+
+import (
+	chainType "github.com/KuChainNetwork/kuchain/chain/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// verify interface at compile time
+var _, _ chainType.KuMsgData = (*MsgBuyPolicy)(nil), (*MsgClaim)(nil)
+
+// MsgBuyPolicy - struct for buying a policy covering the delegation to the validator by the premium
+type MsgBuyPolicy struct {
+	Delegator AccountID `json:"delegator" yaml:"delegator"`
+	Validator AccountID `json:"validator" yaml:"validator"`
+	Premium   Coin      `json:"premium" yaml:"premium"`
+}
+
+// NewMsgBuyPolicy creates a new MsgBuyPolicy instance
+func NewMsgBuyPolicy(delegator, validator AccountID, premium Coin) MsgBuyPolicy {
+	return MsgBuyPolicy{
+		Delegator: delegator,
+		Validator: validator,
+		Premium:   premium,
+	}
+}
+
+// nolint
+func (msg MsgBuyPolicy) Route() string     { return RouterKey }
+func (msg MsgBuyPolicy) Type() Name        { return MustName("buypolicy") }
+func (msg MsgBuyPolicy) Sender() AccountID { return msg.Delegator }
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgBuyPolicy) ValidateBasic() error {
+	if msg.Delegator.Empty() || msg.Validator.Empty() {
+		return ErrInvalidAccount
+	}
+
+	if !msg.Premium.IsValid() || !msg.Premium.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidPremium, msg.Premium.String())
+	}
+
+	return nil
+}
+
+// MsgClaim - struct for claiming the compensation of a downtime slash covered by the policies
+type MsgClaim struct {
+	Delegator AccountID `json:"delegator" yaml:"delegator"`
+	EventID   uint64    `json:"event_id" yaml:"event_id"`
+}
+
+// NewMsgClaim creates a new MsgClaim instance
+func NewMsgClaim(delegator AccountID, eventID uint64) MsgClaim {
+	return MsgClaim{
+		Delegator: delegator,
+		EventID:   eventID,
+	}
+}
+
+// nolint
+func (msg MsgClaim) Route() string     { return RouterKey }
+func (msg MsgClaim) Type() Name        { return MustName("claim") }
+func (msg MsgClaim) Sender() AccountID { return msg.Delegator }
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgClaim) ValidateBasic() error {
+	if msg.Delegator.Empty() {
+		return ErrInvalidAccount
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	params "github.com/KuChainNetwork/kuchain/x/params/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace     = ModuleName
+	DefaultCoveragePeriod = time.Hour * 24 * 30
+	DefaultClaimWindow    = time.Hour * 24 * 7
+)
+
+// Default parameter values
+var (
+	DefaultPremiumRate  = sdk.NewDecWithPrec(1, 2)
+	DefaultCoverageRate = sdk.NewDecWithPrec(8, 1)
+)
+
+// Parameter store keys
+var (
+	KeyPremiumRate    = []byte("PremiumRate")
+	KeyCoverageRate   = []byte("CoverageRate")
+	KeyCoveragePeriod = []byte("CoveragePeriod")
+	KeyClaimWindow    = []byte("ClaimWindow")
+)
+
+// Params insurance parameters, the coverage rules set by gov
+type Params struct {
+	PremiumRate    sdk.Dec       `json:"premium_rate" yaml:"premium_rate"`       // premium paid for each covered token in a coverage period
+	CoverageRate   sdk.Dec       `json:"coverage_rate" yaml:"coverage_rate"`     // rate of the downtime slash loss compensated
+	CoveragePeriod time.Duration `json:"coverage_period" yaml:"coverage_period"` // duration a policy covers from it bought
+	ClaimWindow    time.Duration `json:"claim_window" yaml:"claim_window"`       // duration after a slash the loss can be claimed
+}
+
+// ParamKeyTable ParamTable for insurance module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(premiumRate, coverageRate sdk.Dec, coveragePeriod, claimWindow time.Duration) Params {
+	return Params{
+		PremiumRate:    premiumRate,
+		CoverageRate:   coverageRate,
+		CoveragePeriod: coveragePeriod,
+		ClaimWindow:    claimWindow,
+	}
+}
+
+// DefaultParams default insurance module parameters
+func DefaultParams() Params {
+	return NewParams(DefaultPremiumRate, DefaultCoverageRate, DefaultCoveragePeriod, DefaultClaimWindow)
+}
+
+// Validate validate params
+func (p Params) Validate() error {
+	if err := validatePremiumRate(p.PremiumRate); err != nil {
+		return err
+	}
+	if err := validateCoverageRate(p.CoverageRate); err != nil {
+		return err
+	}
+	if err := validateCoveragePeriod(p.CoveragePeriod); err != nil {
+		return err
+	}
+	if err := validateClaimWindow(p.ClaimWindow); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyPremiumRate, &p.PremiumRate, validatePremiumRate),
+		params.NewParamSetPair(KeyCoverageRate, &p.CoverageRate, validateCoverageRate),
+		params.NewParamSetPair(KeyCoveragePeriod, &p.CoveragePeriod, validateCoveragePeriod),
+		params.NewParamSetPair(KeyClaimWindow, &p.ClaimWindow, validateClaimWindow),
+	}
+}
+
+func validatePremiumRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("premium rate must be positive and less or equal to one: %s", v)
+	}
+
+	return nil
+}
+
+func validateCoverageRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("coverage rate must be in [0, 1]: %s", v)
+	}
+
+	return nil
+}
+
+func validateCoveragePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("coverage period must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateClaimWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("claim window must be positive: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Policy is an insurance policy bought by a delegator, which covers the downtime slash loss
+// of the delegation to the validator up to the coverage tokens until the end time.
+type Policy struct {
+	ID          uint64    `json:"id" yaml:"id"`
+	Delegator   AccountID `json:"delegator" yaml:"delegator"`
+	Validator   AccountID `json:"validator" yaml:"validator"`
+	Premium     Coin      `json:"premium" yaml:"premium"`
+	Coverage    sdk.Int   `json:"coverage" yaml:"coverage"` // tokens of the delegation covered
+	StartHeight int64     `json:"start_height" yaml:"start_height"`
+	EndTime     time.Time `json:"end_time" yaml:"end_time"`
+}
+
+// NewPolicy creates a new Policy instance
+func NewPolicy(id uint64, delegator, validator AccountID, premium Coin, coverage sdk.Int, startHeight int64, endTime time.Time) Policy {
+	return Policy{
+		ID:          id,
+		Delegator:   delegator,
+		Validator:   validator,
+		Premium:     premium,
+		Coverage:    coverage,
+		StartHeight: startHeight,
+		EndTime:     endTime,
+	}
+}
+
+// IsActive returns true if the policy not ended at the time
+func (p Policy) IsActive(t time.Time) bool {
+	return !t.After(p.EndTime)
+}
+
+// Covers returns true if the slash event happened on the validator after the policy bought and before it ended
+func (p Policy) Covers(event SlashEvent) bool {
+	return p.Validator.Eq(event.Validator) && p.StartHeight < event.Height && p.IsActive(event.Time)
+}
+
+func (p Policy) String() string {
+	return fmt.Sprintf(`Policy %d:
+  Delegator:    %s
+  Validator:    %s
+  Premium:      %s
+  Coverage:     %s
+  Start Height: %d
+  End Time:     %s`,
+		p.ID, p.Delegator, p.Validator, p.Premium, p.Coverage, p.StartHeight, p.EndTime,
+	)
+}
+
+// Policies is an array of policy
+type Policies []Policy
+
+func (p Policies) String() string {
+	out := make([]string, 0, len(p))
+	for _, policy := range p {
+		out = append(out, policy.String())
+	}
+	return strings.Join(out, "\n")
+}
+
+// SlashedDelegation is the delegation shares of an insured delegator at the slash
+type SlashedDelegation struct {
+	Delegator AccountID `json:"delegator" yaml:"delegator"`
+	Shares    sdk.Dec   `json:"shares" yaml:"shares"`
+}
+
+// NewSlashedDelegation creates a new SlashedDelegation instance
+func NewSlashedDelegation(delegator AccountID, shares sdk.Dec) SlashedDelegation {
+	return SlashedDelegation{
+		Delegator: delegator,
+		Shares:    shares,
+	}
+}
+
+// SlashEvent is a downtime slash of a validator recorded for the claims,
+// the tokens per share is the exchange rate of the validator before the slash,
+// the delegations are the shares of the delegators covered by the policies at the slash.
+type SlashEvent struct {
+	ID             uint64              `json:"id" yaml:"id"`
+	Validator      AccountID           `json:"validator" yaml:"validator"`
+	Height         int64               `json:"height" yaml:"height"`
+	Time           time.Time           `json:"time" yaml:"time"`
+	Fraction       sdk.Dec             `json:"fraction" yaml:"fraction"`
+	TokensPerShare sdk.Dec             `json:"tokens_per_share" yaml:"tokens_per_share"`
+	Delegations    []SlashedDelegation `json:"delegations" yaml:"delegations"`
+}
+
+// NewSlashEvent creates a new SlashEvent instance
+func NewSlashEvent(id uint64, validator AccountID, height int64, t time.Time, fraction, tokensPerShare sdk.Dec) SlashEvent {
+	return SlashEvent{
+		ID:             id,
+		Validator:      validator,
+		Height:         height,
+		Time:           t,
+		Fraction:       fraction,
+		TokensPerShare: tokensPerShare,
+		Delegations:    []SlashedDelegation{},
+	}
+}
+
+// DelegatorShares returns the delegation shares of the delegator at the slash
+func (e SlashEvent) DelegatorShares(delegator AccountID) (sdk.Dec, bool) {
+	for _, d := range e.Delegations {
+		if d.Delegator.Eq(delegator) {
+			return d.Shares, true
+		}
+	}
+	return sdk.ZeroDec(), false
+}
+
+func (e SlashEvent) String() string {
+	return fmt.Sprintf(`Slash Event %d:
+  Validator:        %s
+  Height:           %d
+  Time:             %s
+  Fraction:         %s
+  Tokens Per Share: %s`,
+		e.ID, e.Validator, e.Height, e.Time, e.Fraction, e.TokensPerShare,
+	)
+}
+
+// SlashEvents is an array of slash event
+type SlashEvents []SlashEvent
+
+func (e SlashEvents) String() string {
+	out := make([]string, 0, len(e))
+	for _, event := range e {
+		out = append(out, event.String())
+	}
+	return strings.Join(out, "\n")
+}
+
+// Claim is the compensation paid to the delegator for a slash event
+type Claim struct {
+	EventID      uint64    `json:"event_id" yaml:"event_id"`
+	Delegator    AccountID `json:"delegator" yaml:"delegator"`
+	Compensation Coins     `json:"compensation" yaml:"compensation"`
+}
+
+// NewClaim creates a new Claim instance
+func NewClaim(eventID uint64, delegator AccountID, compensation Coins) Claim {
+	return Claim{
+		EventID:      eventID,
+		Delegator:    delegator,
+		Compensation: compensation,
+	}
+}
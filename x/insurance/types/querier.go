@@ -0,0 +1,31 @@
+package types
+
+// Query endpoints supported by the insurance querier
+const (
+	QueryParameters  = "parameters"
+	QueryPolicies    = "policies"
+	QuerySlashEvents = "slashEvents"
+	QueryPool        = "pool"
+)
+
+// QueryPoliciesParams defines the params for the following queries:
+// - 'custom/insurance/policies'
+type QueryPoliciesParams struct {
+	Delegator AccountID
+}
+
+// NewQueryPoliciesParams creates a new QueryPoliciesParams instance
+func NewQueryPoliciesParams(delegator AccountID) QueryPoliciesParams {
+	return QueryPoliciesParams{delegator}
+}
+
+// QuerySlashEventsParams defines the params for the following queries:
+// - 'custom/insurance/slashEvents'
+type QuerySlashEventsParams struct {
+	Validator AccountID // slash events of the validator, empty for all events
+}
+
+// NewQuerySlashEventsParams creates a new QuerySlashEventsParams instance
+func NewQuerySlashEventsParams(validator AccountID) QuerySlashEventsParams {
+	return QuerySlashEventsParams{validator}
+}
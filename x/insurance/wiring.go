@@ -0,0 +1,20 @@
+package insurance
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/insurance/types"
+)
+
+// Inputs the keepers the insurance module depends on
+type Inputs struct {
+	StakingKeeper types.StakingKeeper
+	SupplyKeeper  types.SupplyKeeper
+	AssetKeeper   types.AssetKeeper
+}
+
+// ProvideKeeper creates the insurance keeper by the store key and params subspace declared to the builder
+func ProvideKeeper(b *wiring.Builder, in Inputs) Keeper {
+	return NewKeeper(
+		b.Codec(), b.KVStoreKey(StoreKey), b.Subspace(DefaultParamspace), in.StakingKeeper, in.SupplyKeeper, in.AssetKeeper,
+	)
+}
@@ -0,0 +1,46 @@
+package lane
+
+// nolint
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/lane/keeper"
+	"github.com/KuChainNetwork/kuchain/x/lane/types"
+)
+
+const (
+	ModuleName        = types.ModuleName
+	TStoreKey         = types.TStoreKey
+	QuerierRoute      = types.QuerierRoute
+	DefaultParamspace = types.DefaultParamspace
+	QueryParameters   = types.QueryParameters
+	LaneDefault       = types.LaneDefault
+	LaneGov           = types.LaneGov
+	LaneEvidence      = types.LaneEvidence
+	LaneUnjail        = types.LaneUnjail
+)
+
+var (
+	// functions aliases
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	NewGenesisState     = types.NewGenesisState
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+	ParamKeyTable       = types.ParamKeyTable
+	NewParams           = types.NewParams
+	DefaultParams       = types.DefaultParams
+	GetMsgLane          = types.GetMsgLane
+	GetTxLane           = types.GetTxLane
+
+	// variable aliases
+	ModuleCdc   = types.ModuleCdc
+	Cdc         = types.Cdc
+	ErrLaneFull = types.ErrLaneFull
+)
+
+type (
+	Keeper       = keeper.Keeper
+	GenesisState = types.GenesisState
+	Params       = types.Params
+	Lane         = types.Lane
+)
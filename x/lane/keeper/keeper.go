@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/lane/types"
+	"github.com/KuChainNetwork/kuchain/x/params"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Keeper of the lane store, the gas used by lanes is in transient store,
+// so that it will be reset for each block.
+type Keeper struct {
+	cdc        *codec.Codec
+	tStoreKey  sdk.StoreKey
+	paramSpace params.Subspace
+}
+
+// NewKeeper creates a new lane Keeper instance
+func NewKeeper(cdc *codec.Codec, tKey sdk.StoreKey, paramSpace params.Subspace) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		tStoreKey:  tKey,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of lane parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of lane parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetLaneGasUsed returns the gas used by the lane in current block
+func (k Keeper) GetLaneGasUsed(ctx sdk.Context, lane types.Lane) uint64 {
+	store := ctx.TransientStore(k.tStoreKey)
+	bz := store.Get(types.LaneGasUsedKey(lane))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setLaneGasUsed(ctx sdk.Context, lane types.Lane, gas uint64) {
+	store := ctx.TransientStore(k.tStoreKey)
+	store.Set(types.LaneGasUsedKey(lane), sdk.Uint64ToBigEndian(gas))
+}
+
+// DefaultLaneSize returns the gas can be used by default lane in a block,
+// return false if the block has no gas limit.
+func (k Keeper) DefaultLaneSize(ctx sdk.Context) (uint64, bool) {
+	cp := ctx.ConsensusParams()
+	if cp == nil || cp.Block == nil || cp.Block.MaxGas <= 0 {
+		return 0, false
+	}
+
+	maxGas := uint64(cp.Block.MaxGas)
+	reserved := k.GetParams(ctx).ReservedGas()
+	if reserved >= maxGas {
+		return 0, true
+	}
+
+	return maxGas - reserved, true
+}
+
+// ConsumeLaneGas consumes the gas of a tx in its lane, a tx in a priority lane will use
+// the reserved gas first, and then the default lane.
+func (k Keeper) ConsumeLaneGas(ctx sdk.Context, lane types.Lane, gas uint64) error {
+	if lane.IsPriority() {
+		size := k.GetParams(ctx).LaneSize(lane)
+		used := k.GetLaneGasUsed(ctx, lane)
+		if used+gas <= size {
+			k.setLaneGasUsed(ctx, lane, used+gas)
+			return nil
+		}
+	}
+
+	size, limited := k.DefaultLaneSize(ctx)
+	used := k.GetLaneGasUsed(ctx, types.LaneDefault)
+	if limited && used+gas > size {
+		return sdkerrors.Wrapf(types.ErrLaneFull, "lane %s used %d, want %d, size %d", lane, used, gas, size)
+	}
+
+	k.setLaneGasUsed(ctx, types.LaneDefault, used+gas)
+	return nil
+}
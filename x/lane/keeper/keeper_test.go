@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	assetTypes "github.com/KuChainNetwork/kuchain/x/asset/types"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	laneTypes "github.com/KuChainNetwork/kuchain/x/lane/types"
+	slashingTypes "github.com/KuChainNetwork/kuchain/x/slashing/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+var (
+	wallet   = simapp.NewWallet()
+	name1    = types.MustName("lanetest1")
+	addr1    = wallet.NewAccAddressByName(name1)
+	account1 = types.NewAccountIDFromName(name1)
+)
+
+func createAppForTest() *simapp.SimApp {
+	genAccs := simapp.NewGenesisAccounts(wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(types.NewInt64CoreCoins(1000000)),
+	)
+	return simapp.SetupWithGenesisAccounts(genAccs)
+}
+
+func TestGetTxLane(t *testing.T) {
+	Convey("test get lane of txs", t, func() {
+		amt := types.NewInt64CoreCoins(1)
+		transfer := assetTypes.NewMsgTransfer(addr1, account1, constants.SystemAccountID, amt)
+		vote := govTypes.NewKuMsgVote(addr1, account1, 1, govTypes.OptionYes)
+		voteWeighted := govTypes.NewKuMsgVoteWeighted(addr1, account1, 1, govTypes.NewNonSplitVoteOption(govTypes.OptionYes))
+		unjail := slashingTypes.NewKuMsgUnjail(addr1, account1)
+
+		So(laneTypes.GetTxLane([]sdk.Msg{&transfer}), ShouldEqual, laneTypes.LaneDefault)
+		So(laneTypes.GetTxLane([]sdk.Msg{vote}), ShouldEqual, laneTypes.LaneGov)
+		So(laneTypes.GetTxLane([]sdk.Msg{vote, vote}), ShouldEqual, laneTypes.LaneGov)
+		So(laneTypes.GetTxLane([]sdk.Msg{voteWeighted}), ShouldEqual, laneTypes.LaneGov)
+		So(laneTypes.GetTxLane([]sdk.Msg{vote, voteWeighted}), ShouldEqual, laneTypes.LaneGov)
+		So(laneTypes.GetTxLane([]sdk.Msg{unjail}), ShouldEqual, laneTypes.LaneUnjail)
+		So(laneTypes.GetTxLane([]sdk.Msg{vote, &transfer}), ShouldEqual, laneTypes.LaneDefault)
+		So(laneTypes.GetTxLane([]sdk.Msg{vote, unjail}), ShouldEqual, laneTypes.LaneDefault)
+		So(laneTypes.GetTxLane(nil), ShouldEqual, laneTypes.LaneDefault)
+	})
+}
+
+func TestConsumeLaneGas(t *testing.T) {
+	app := createAppForTest()
+	keeper := app.LaneKeeper()
+
+	Convey("test consume lane gas", t, func() {
+		params := laneTypes.NewParams(1000, 500, 500)
+		maxGas := int64(3000)
+
+		ctx := app.NewTestContext().WithConsensusParams(&abci.ConsensusParams{
+			Block: &abci.BlockParams{MaxGas: maxGas},
+		})
+		ctx, _ = ctx.CacheContext()
+		keeper.SetParams(ctx, params)
+
+		size, limited := keeper.DefaultLaneSize(ctx)
+		So(limited, ShouldBeTrue)
+		So(size, ShouldEqual, uint64(maxGas)-params.ReservedGas())
+
+		Convey("default lane cannot use reserved gas", func() {
+			So(keeper.ConsumeLaneGas(ctx, laneTypes.LaneDefault, 800), ShouldBeNil)
+			So(keeper.ConsumeLaneGas(ctx, laneTypes.LaneDefault, 300), simapp.ShouldErrIs, laneTypes.ErrLaneFull)
+			So(keeper.GetLaneGasUsed(ctx, laneTypes.LaneDefault), ShouldEqual, 800)
+
+			// gov lane still have space
+			So(keeper.ConsumeLaneGas(ctx, laneTypes.LaneGov, 1000), ShouldBeNil)
+			So(keeper.GetLaneGasUsed(ctx, laneTypes.LaneGov), ShouldEqual, 1000)
+		})
+
+		Convey("priority lane use default lane when full", func() {
+			So(keeper.ConsumeLaneGas(ctx, laneTypes.LaneUnjail, 400), ShouldBeNil)
+			So(keeper.ConsumeLaneGas(ctx, laneTypes.LaneUnjail, 400), ShouldBeNil)
+			So(keeper.GetLaneGasUsed(ctx, laneTypes.LaneUnjail), ShouldEqual, 400)
+			So(keeper.GetLaneGasUsed(ctx, laneTypes.LaneDefault), ShouldEqual, 400)
+
+			So(keeper.ConsumeLaneGas(ctx, laneTypes.LaneUnjail, 800), simapp.ShouldErrIs, laneTypes.ErrLaneFull)
+		})
+
+		Convey("no limit if block has no max gas", func() {
+			ctx := ctx.WithConsensusParams(&abci.ConsensusParams{
+				Block: &abci.BlockParams{MaxGas: -1},
+			})
+
+			_, limited := keeper.DefaultLaneSize(ctx)
+			So(limited, ShouldBeFalse)
+			So(keeper.ConsumeLaneGas(ctx, laneTypes.LaneDefault, 100000000), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/lane module sentinel errors
+var (
+	ErrLaneFull = sdkerrors.Register(ModuleName, 2, "no block space left in lane")
+)
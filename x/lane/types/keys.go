@@ -0,0 +1,25 @@
+package types
+
+const (
+	// ModuleName is the name of the lane module
+	ModuleName = "lane"
+
+	// TStoreKey is the string transient store representation
+	TStoreKey = "transient_" + ModuleName
+
+	// QuerierRoute is the querier route for the lane module
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the lane querier
+	QueryParameters = "parameters"
+)
+
+// Keys for lane transient store
+var (
+	LaneGasUsedKeyPrefix = []byte{0x01} // key for the gas used by lane in current block
+)
+
+// LaneGasUsedKey returns the key of the gas used by lane in current block
+func LaneGasUsedKey(lane Lane) []byte {
+	return append(LaneGasUsedKeyPrefix, []byte(lane)...)
+}
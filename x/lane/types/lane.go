@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Lane is the block space lane a tx belongs to
+type Lane string
+
+// Lanes for txs, all txs not in a priority lane go to the default lane
+const (
+	LaneDefault  Lane = "default"
+	LaneGov      Lane = "gov"
+	LaneEvidence Lane = "evidence"
+	LaneUnjail   Lane = "unjail"
+)
+
+// routes and types of msgs in priority lanes, as the modules are not imported here
+const (
+	routeGov      = "kugov"
+	routeEvidence = "kuevidence"
+	routeSlashing = "kuslashing"
+
+	typeGovVote         = "vote"
+	typeGovVoteWeighted = "voteweighted"
+	typeGovUnjail       = "govunjail"
+	typeSubmitEvidence  = "submit_evidence"
+	typeUnjail          = "unjail"
+)
+
+// IsPriority returns if the lane has reserved block space
+func (l Lane) IsPriority() bool {
+	return l != LaneDefault
+}
+
+// String implements the Stringer interface.
+func (l Lane) String() string {
+	return string(l)
+}
+
+// GetMsgLane returns the lane of the msg.
+func GetMsgLane(msg sdk.Msg) Lane {
+	switch msg.Route() {
+	case routeGov:
+		switch msg.Type() {
+		case typeGovVote, typeGovVoteWeighted:
+			return LaneGov
+		case typeGovUnjail:
+			return LaneUnjail
+		}
+	case routeEvidence:
+		if msg.Type() == typeSubmitEvidence {
+			return LaneEvidence
+		}
+	case routeSlashing:
+		if msg.Type() == typeUnjail {
+			return LaneUnjail
+		}
+	}
+
+	return LaneDefault
+}
+
+// GetTxLane returns the lane of the tx, a tx is in a priority lane only if
+// all of its msgs are in the same priority lane.
+func GetTxLane(msgs []sdk.Msg) Lane {
+	if len(msgs) == 0 {
+		return LaneDefault
+	}
+
+	lane := GetMsgLane(msgs[0])
+	for _, msg := range msgs[1:] {
+		if GetMsgLane(msg) != lane {
+			return LaneDefault
+		}
+	}
+
+	return lane
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+
+	params "github.com/KuChainNetwork/kuchain/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace      = ModuleName
+	DefaultGovLaneGas      = uint64(2000000)
+	DefaultEvidenceLaneGas = uint64(1000000)
+	DefaultUnjailLaneGas   = uint64(1000000)
+)
+
+// Parameter store keys
+var (
+	KeyGovLaneGas      = []byte("GovLaneGas")
+	KeyEvidenceLaneGas = []byte("EvidenceLaneGas")
+	KeyUnjailLaneGas   = []byte("UnjailLaneGas")
+)
+
+// Params lane parameters, the size of priority lanes is the gas reserved in each block
+type Params struct {
+	GovLaneGas      uint64 `json:"gov_lane_gas" yaml:"gov_lane_gas"`           // gas reserved for gov votes
+	EvidenceLaneGas uint64 `json:"evidence_lane_gas" yaml:"evidence_lane_gas"` // gas reserved for evidence submissions
+	UnjailLaneGas   uint64 `json:"unjail_lane_gas" yaml:"unjail_lane_gas"`     // gas reserved for unjail txs
+}
+
+// ParamKeyTable ParamTable for lane module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(govLaneGas, evidenceLaneGas, unjailLaneGas uint64) Params {
+	return Params{
+		GovLaneGas:      govLaneGas,
+		EvidenceLaneGas: evidenceLaneGas,
+		UnjailLaneGas:   unjailLaneGas,
+	}
+}
+
+// DefaultParams default lane module parameters
+func DefaultParams() Params {
+	return NewParams(DefaultGovLaneGas, DefaultEvidenceLaneGas, DefaultUnjailLaneGas)
+}
+
+// LaneSize returns the gas reserved for the lane, the default lane has no reserved gas
+func (p Params) LaneSize(lane Lane) uint64 {
+	switch lane {
+	case LaneGov:
+		return p.GovLaneGas
+	case LaneEvidence:
+		return p.EvidenceLaneGas
+	case LaneUnjail:
+		return p.UnjailLaneGas
+	default:
+		return 0
+	}
+}
+
+// ReservedGas returns the total gas reserved by priority lanes
+func (p Params) ReservedGas() uint64 {
+	return p.GovLaneGas + p.EvidenceLaneGas + p.UnjailLaneGas
+}
+
+// Validate validate params
+func (p Params) Validate() error {
+	if err := validateLaneGas(p.GovLaneGas); err != nil {
+		return err
+	}
+	if err := validateLaneGas(p.EvidenceLaneGas); err != nil {
+		return err
+	}
+	if err := validateLaneGas(p.UnjailLaneGas); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyGovLaneGas, &p.GovLaneGas, validateLaneGas),
+		params.NewParamSetPair(KeyEvidenceLaneGas, &p.EvidenceLaneGas, validateLaneGas),
+		params.NewParamSetPair(KeyUnjailLaneGas, &p.UnjailLaneGas, validateLaneGas),
+	}
+}
+
+func validateLaneGas(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
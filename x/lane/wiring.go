@@ -0,0 +1,10 @@
+package lane
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+)
+
+// ProvideKeeper creates the lane keeper by the transient store key and params subspace declared to the builder
+func ProvideKeeper(b *wiring.Builder) Keeper {
+	return NewKeeper(b.Codec(), b.TransientStoreKey(TStoreKey), b.Subspace(DefaultParamspace))
+}
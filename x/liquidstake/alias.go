@@ -0,0 +1,51 @@
+package liquidstake
+
+// nolint
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/liquidstake/keeper"
+	"github.com/KuChainNetwork/kuchain/x/liquidstake/types"
+)
+
+const (
+	ModuleName            = types.ModuleName
+	StoreKey              = types.StoreKey
+	RouterKey             = types.RouterKey
+	QuerierRoute          = types.QuerierRoute
+	QueryLiquidValidator  = types.QueryLiquidValidator
+	QueryLiquidValidators = types.QueryLiquidValidators
+)
+
+var (
+	// functions aliases
+	NewKeeper                     = keeper.NewKeeper
+	NewQuerier                    = keeper.NewQuerier
+	RegisterInvariants            = keeper.RegisterInvariants
+	RegisterCodec                 = types.RegisterCodec
+	NewGenesisState               = types.NewGenesisState
+	DefaultGenesisState           = types.DefaultGenesisState
+	ValidateGenesis               = types.ValidateGenesis
+	NewLiquidValidator            = types.NewLiquidValidator
+	NewMsgLiquidStake             = types.NewMsgLiquidStake
+	NewMsgRedeem                  = types.NewMsgRedeem
+	NewKuMsgLiquidStake           = types.NewKuMsgLiquidStake
+	NewKuMsgRedeem                = types.NewKuMsgRedeem
+	NewQueryLiquidValidatorParams = types.NewQueryLiquidValidatorParams
+	NewQueryLiquidValidatorResult = types.NewQueryLiquidValidatorResult
+
+	// variable aliases
+	ModuleCdc         = types.ModuleCdc
+	Cdc               = types.Cdc
+	ModuleAccountID   = types.ModuleAccountID
+	DerivativeCreator = types.DerivativeCreator
+)
+
+type (
+	Keeper                     = keeper.Keeper
+	GenesisState               = types.GenesisState
+	LiquidValidator            = types.LiquidValidator
+	LiquidValidators           = types.LiquidValidators
+	MsgLiquidStake             = types.MsgLiquidStake
+	MsgRedeem                  = types.MsgRedeem
+	QueryLiquidValidatorResult = types.QueryLiquidValidatorResult
+)
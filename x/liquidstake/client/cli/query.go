@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/liquidstake/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	liquidstakeQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the liquid staking module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	liquidstakeQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryLiquidValidator(cdc),
+			GetCmdQueryLiquidValidators(cdc),
+		)...,
+	)
+
+	return liquidstakeQueryCmd
+}
+
+// GetCmdQueryLiquidValidator implements the query liquid validator command.
+func GetCmdQueryLiquidValidator(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquid-validator [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the derivative coin and the exchange rate of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the derivative coin of a validator, the delegation shares held by the module,
+the derivative supply and the tokens per derivative coin.
+
+Example:
+$ %s query liquidstake liquid-validator validator
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validator, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryLiquidValidatorParams(validator))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLiquidValidator)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryLiquidValidatorResult
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
+// GetCmdQueryLiquidValidators implements the query all liquid validators command.
+func GetCmdQueryLiquidValidators(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquid-validators",
+		Args:  cobra.NoArgs,
+		Short: "Query the derivative coins of all the validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the derivative coins and the exchange rates of all the validators.
+
+Example:
+$ %s query liquidstake liquid-validators
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLiquidValidators)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var results []types.QueryLiquidValidatorResult
+			cdc.MustUnmarshalJSON(res, &results)
+			return cliCtx.PrintOutput(results)
+		},
+	}
+}
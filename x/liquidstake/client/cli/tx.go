@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/liquidstake/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	liquidstakeTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Liquid staking transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	liquidstakeTxCmd.AddCommand(flags.PostCommands(
+		GetCmdLiquidStake(cdc),
+		GetCmdRedeem(cdc),
+	)...)
+
+	return liquidstakeTxCmd
+}
+
+// GetCmdLiquidStake implements the liquid stake command.
+func GetCmdLiquidStake(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquid-stake [delegator] [validator] [amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Convert the delegation to the derivative coins of the validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert the amount of the bonded delegation to the validator into the transferable
+derivative coins of the validator, the delegation is held by the liquid staking module.
+
+Example:
+$ %s tx liquidstake liquid-stake alice validator 1000kuchain/kcs --from alice
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			delegator, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "delegator account id error")
+			}
+
+			validator, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "validator account id error")
+			}
+
+			amount, err := chainTypes.ParseCoin(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "amount parse error")
+			}
+
+			delegatorAuth, err := txutil.QueryAccountAuth(cliCtx, delegator)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", delegator)
+			}
+
+			msg := types.NewKuMsgLiquidStake(delegatorAuth, delegator, validator, amount)
+			cliCtx = cliCtx.WithFromAccount(delegator)
+			if txBldr.FeePayer().Empty() {
+				txBldr = txBldr.WithPayer(args[0])
+			}
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRedeem implements the redeem command.
+func GetCmdRedeem(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem [owner] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Redeem the derivative coins into the delegation to the validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn the derivative coins and get the delegation shares of the amount by the exchange rate,
+the delegation is to the validator of the derivative coins.
+
+Example:
+$ %s tx liquidstake redeem bob 1000liquidstake/lsd1 --from bob
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			owner, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "owner account id error")
+			}
+
+			amount, err := chainTypes.ParseCoin(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "amount parse error")
+			}
+
+			ownerAuth, err := txutil.QueryAccountAuth(cliCtx, owner)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", owner)
+			}
+
+			msg := types.NewKuMsgRedeem(ownerAuth, owner, amount)
+			cliCtx = cliCtx.WithFromAccount(owner)
+			if txBldr.FeePayer().Empty() {
+				txBldr = txBldr.WithPayer(args[0])
+			}
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package liquidstake
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/liquidstake/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initialize the liquid validators and the module account which holds the delegations
+func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper, data GenesisState) {
+	keeper.SetNextDerivativeID(ctx, data.NextDerivativeID)
+
+	for _, l := range data.LiquidValidators {
+		keeper.SetLiquidValidator(ctx, l)
+	}
+
+	supplyKeeper.GetModuleAccount(ctx, ModuleName)
+}
+
+// ExportGenesis writes the current store values
+// to a genesis file, which can be imported again
+// with InitGenesis
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	liquidValidators := keeper.GetLiquidValidators(ctx)
+	if liquidValidators == nil {
+		liquidValidators = LiquidValidators{}
+	}
+
+	return NewGenesisState(keeper.GetNextDerivativeID(ctx), liquidValidators)
+}
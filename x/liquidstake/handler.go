@@ -0,0 +1,84 @@
+package liquidstake
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/liquidstake/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewHandler(k Keeper) msg.Handler {
+	return func(ctx chainTypes.Context, msg sdk.Msg) (*sdk.Result, error) {
+		switch msg := msg.(type) {
+		case types.KuMsgLiquidStake:
+			return handleKuMsgLiquidStake(ctx, k, msg)
+		case types.KuMsgRedeem:
+			return handleKuMsgRedeem(ctx, k, msg)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleKuMsgLiquidStake(ctx chainTypes.Context, k Keeper, msg types.KuMsgLiquidStake) (*sdk.Result, error) {
+	msgData := types.MsgLiquidStake{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg LiquidStake data unmarshal error")
+	}
+
+	ctx.RequireAuth(msgData.Delegator)
+
+	derivative, err := k.LiquidStake(ctx.Context(), msgData.Delegator, msgData.Validator, msgData.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msgData.Delegator.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeLiquidStake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msgData.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msgData.Validator.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msgData.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDerivative, derivative.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleKuMsgRedeem(ctx chainTypes.Context, k Keeper, msg types.KuMsgRedeem) (*sdk.Result, error) {
+	msgData := types.MsgRedeem{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg Redeem data unmarshal error")
+	}
+
+	ctx.RequireAuth(msgData.Owner)
+
+	validator, shares, err := k.Redeem(ctx.Context(), msgData.Owner, msgData.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msgData.Owner.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeRedeem,
+			sdk.NewAttribute(types.AttributeKeyOwner, msgData.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.String()),
+			sdk.NewAttribute(types.AttributeKeyDerivative, msgData.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/liquidstake/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants register all liquid staking invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "delegation-shares", DelegationShares(k))
+}
+
+// DelegationShares checks that the delegation shares of the module to each validator
+// equal to the shares of the liquid validator which backs the derivative supply.
+func DelegationShares(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		k.IterateLiquidValidators(ctx, func(l types.LiquidValidator) bool {
+			shares := sdk.ZeroDec()
+			if delegation, found := k.stakingKeeper.GetDelegation(ctx, types.ModuleAccountID, l.Validator); found {
+				shares = delegation.Shares
+			}
+
+			if !shares.Equal(l.Shares) {
+				broken = true
+				msg += fmt.Sprintf("\tliquid validator %s shares %s, module delegation shares %s\n",
+					l.Validator, l.Shares, shares)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "delegation shares", msg), broken
+	}
+}
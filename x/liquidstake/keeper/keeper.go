@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/liquidstake/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Keeper of the liquid staking store
+type Keeper struct {
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
+	stakingKeeper types.StakingKeeper
+	distrKeeper   types.DistributionKeeper
+	assetKeeper   types.AssetKeeper
+}
+
+// NewKeeper creates a new liquid staking Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, stakingKeeper types.StakingKeeper, distrKeeper types.DistributionKeeper,
+	assetKeeper types.AssetKeeper) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		stakingKeeper: stakingKeeper,
+		distrKeeper:   distrKeeper,
+		assetKeeper:   assetKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	distrTypes "github.com/KuChainNetwork/kuchain/x/distribution/types"
+	"github.com/KuChainNetwork/kuchain/x/liquidstake/keeper"
+	liquidTypes "github.com/KuChainNetwork/kuchain/x/liquidstake/types"
+	"github.com/KuChainNetwork/kuchain/x/staking/exported"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+)
+
+var (
+	wallet    = simapp.NewWallet()
+	addr1     = wallet.NewAccAddressByName(name1)
+	addr2     = wallet.NewAccAddressByName(name2)
+	addrVal   = wallet.NewAccAddressByName(nameVal)
+	name1     = types.MustName("delegator")
+	name2     = types.MustName("holder")
+	nameVal   = types.MustName("validator")
+	account1  = types.NewAccountIDFromName(name1)
+	account2  = types.NewAccountIDFromName(name2)
+	accountV  = types.NewAccountIDFromName(nameVal)
+	delegated = sdk.NewInt(1000000)
+)
+
+func createAppForTest() (*simapp.SimApp, sdk.Context) {
+	asset := types.Coins{
+		types.NewInt64Coin(constants.DefaultBondDenom, 10000000000)}
+
+	genAccs := simapp.NewGenesisAccounts(wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(asset),
+		simapp.NewSimGenesisAccount(account2, addr2).WithAsset(asset),
+		simapp.NewSimGenesisAccount(accountV, addrVal).WithAsset(asset),
+	)
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+	stakingKeeper := app.StakeKeeper()
+	validator := stakingTypes.NewValidator(accountV, ed25519.GenPrivKey().PubKey(), stakingTypes.Description{})
+	stakingKeeper.SetValidator(ctx, validator)
+	stakingKeeper.AfterValidatorCreated(ctx, accountV)
+
+	_, err := stakingKeeper.Delegate(ctx, account1, delegated, exported.Unbonded, validator, false)
+	So(err, ShouldBeNil)
+
+	return app, ctx
+}
+
+func bondCoin(amount sdk.Int) types.Coin {
+	return types.NewCoin(constants.DefaultBondDenom, amount)
+}
+
+func TestLiquidStake(t *testing.T) {
+	Convey("test liquid stake and redeem", t, func() {
+		app, ctx := createAppForTest()
+		k := app.LiquidStakeKeeper()
+		stakingKeeper := app.StakeKeeper()
+
+		// more than the delegation
+		_, err := k.LiquidStake(ctx, account1, accountV, bondCoin(delegated.AddRaw(1)))
+		So(err, simapp.ShouldErrIs, stakingTypes.ErrBadSharesAmount)
+
+		// self delegation cannot be transferred
+		_, err = stakingKeeper.Delegate(ctx, accountV, delegated, exported.Unbonded,
+			mustValidator(app, ctx), false)
+		So(err, ShouldBeNil)
+		_, err = k.LiquidStake(ctx, accountV, accountV, bondCoin(delegated))
+		So(err, simapp.ShouldErrIs, stakingTypes.ErrDelegationNotTransferable)
+
+		half := delegated.QuoRaw(2)
+		derivative, err := k.LiquidStake(ctx, account1, accountV, bondCoin(half))
+		So(err, ShouldBeNil)
+		So(derivative.Amount, ShouldResemble, half)
+		So(derivative.Denom, ShouldEqual, types.CoinDenom(liquidTypes.DerivativeCreator, liquidTypes.DerivativeSymbol(1)))
+		So(app.AssetKeeper().GetCoinPowers(ctx, account1).AmountOf(derivative.Denom), ShouldResemble, sdk.ZeroInt())
+		coins, err := app.AssetKeeper().GetCoins(ctx, account1)
+		So(err, ShouldBeNil)
+		So(coins.AmountOf(derivative.Denom), ShouldResemble, half)
+
+		delegation, found := stakingKeeper.GetDelegation(ctx, liquidTypes.ModuleAccountID, accountV)
+		So(found, ShouldBeTrue)
+		So(delegation.Shares, ShouldResemble, half.ToDec())
+
+		// the derivative coins can be transferred
+		So(app.AssetKeeper().Transfer(ctx, account1, account2, types.NewCoins(derivative)), ShouldBeNil)
+
+		// slash the validator, the exchange rate goes down
+		validator := mustValidator(app, ctx)
+		stakingKeeper.RemoveValidatorTokens(ctx, validator, validator.Tokens.QuoRaw(10))
+
+		l, found := k.GetLiquidValidator(ctx, accountV)
+		So(found, ShouldBeTrue)
+		So(l.ExchangeRate(k.LiquidValidatorTokens(ctx, l)), ShouldResemble, sdk.NewDecWithPrec(9, 1))
+
+		// the derivative of the same tokens is more after slashed
+		second, err := k.LiquidStake(ctx, account1, accountV, bondCoin(sdk.NewInt(90000)))
+		So(err, ShouldBeNil)
+		So(second.Amount, ShouldResemble, sdk.NewInt(100000))
+
+		// redeem the derivative into the delegation
+		_, shares, err := k.Redeem(ctx, account2, derivative)
+		So(err, ShouldBeNil)
+		So(shares, ShouldResemble, half.ToDec())
+
+		delegation, found = stakingKeeper.GetDelegation(ctx, account2, accountV)
+		So(found, ShouldBeTrue)
+		So(delegation.Shares, ShouldResemble, half.ToDec())
+		coins, err = app.AssetKeeper().GetCoins(ctx, account2)
+		So(err, ShouldBeNil)
+		So(coins.AmountOf(derivative.Denom), ShouldResemble, sdk.ZeroInt())
+
+		// redeem more than the supply
+		_, _, err = k.Redeem(ctx, account1, second.Add(second))
+		So(err, simapp.ShouldErrIs, liquidTypes.ErrInvalidLiquidAmount)
+
+		_, _, err = k.Redeem(ctx, account1, types.NewCoin(constants.DefaultBondDenom, sdk.NewInt(1)))
+		So(err, simapp.ShouldErrIs, liquidTypes.ErrUnknownDerivative)
+
+		_, broken := keeper.DelegationShares(*k)(ctx)
+		So(broken, ShouldBeFalse)
+	})
+}
+
+func TestCompoundRewards(t *testing.T) {
+	Convey("test the rewards of the module delegations compounded to the holders", t, func() {
+		app, ctx := createAppForTest()
+		k := app.LiquidStakeKeeper()
+		stakingKeeper := app.StakeKeeper()
+		distrKeeper := app.DistrKeeper()
+
+		half := delegated.QuoRaw(2)
+		derivative, err := k.LiquidStake(ctx, account1, accountV, bondCoin(half))
+		So(err, ShouldBeNil)
+
+		// allocate the rewards to the validator in the next block, all to the delegations as no commission
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		rewards := sdk.NewInt(10000)
+		_, err = app.AssetKeeper().IssueCoinPower(ctx, distrTypes.ModuleAccountID, types.NewCoins(bondCoin(rewards)))
+		So(err, ShouldBeNil)
+		distrKeeper.AllocateTokensToValidator(ctx, mustValidator(app, ctx),
+			types.NewDecCoinsFromCoins(bondCoin(rewards)))
+
+		// the holder redeems all the supply, gets the shares of the rewards the module delegation earned
+		So(app.AssetKeeper().Transfer(ctx, account1, account2, types.NewCoins(derivative)), ShouldBeNil)
+		_, shares, err := k.Redeem(ctx, account2, derivative)
+		So(err, ShouldBeNil)
+		So(shares.GT(half.ToDec()), ShouldBeTrue)
+
+		delegation, found := stakingKeeper.GetDelegation(ctx, account2, accountV)
+		So(found, ShouldBeTrue)
+		So(delegation.Shares, ShouldResemble, shares)
+
+		tokens := mustValidator(app, ctx).TokensFromShares(shares)
+		So(tokens.GT(half.ToDec()), ShouldBeTrue)
+		So(tokens.LTE(half.Add(rewards).ToDec()), ShouldBeTrue)
+
+		_, broken := keeper.DelegationShares(*k)(ctx)
+		So(broken, ShouldBeFalse)
+	})
+}
+
+func mustValidator(app *simapp.SimApp, ctx sdk.Context) stakingTypes.Validator {
+	validator, found := app.StakeKeeper().GetValidator(ctx, accountV)
+	So(found, ShouldBeTrue)
+	return validator
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/liquidstake/types"
+	stakingexport "github.com/KuChainNetwork/kuchain/x/staking/exported"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// LiquidStake moves the delegation shares of the amount from the delegator to the module,
+// and mints the derivative coins of the validator to the delegator. The derivative is 1:1 to the tokens
+// before the validator slashed, the rewards of the delegations are compounded into the shares.
+func (k Keeper) LiquidStake(ctx sdk.Context, delegator, valAddr types.AccountID, amount types.Coin) (types.Coin, error) {
+	if bondDenom := k.stakingKeeper.BondDenom(ctx); amount.Denom != bondDenom {
+		return types.Coin{}, sdkerrors.Wrapf(types.ErrInvalidLiquidAmount, "denom %s should be %s", amount.Denom, bondDenom)
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.Coin{}, sdkerrors.Wrapf(types.ErrNoValidatorFound, "validator %s", valAddr)
+	}
+
+	shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, delegator, valAddr, amount.Amount)
+	if err != nil {
+		return types.Coin{}, err
+	}
+
+	l, found := k.GetLiquidValidator(ctx, valAddr)
+	if !found {
+		l = types.NewLiquidValidator(valAddr, k.nextDerivativeDenom(ctx))
+	}
+
+	// the rewards before the stake belong to the holders of the derivative
+	l = k.compoundRewards(ctx, l)
+	validator, _ = k.stakingKeeper.GetValidator(ctx, valAddr)
+
+	derivative := types.NewCoin(l.Denom, l.DerivativeFromShares(shares, validator.TokensFromShares(shares)))
+	if !derivative.IsPositive() {
+		return types.Coin{}, sdkerrors.Wrapf(types.ErrInvalidLiquidAmount, "%s is too small to mint the derivative", amount)
+	}
+
+	if err := k.stakingKeeper.TransferDelegation(ctx, delegator, types.ModuleAccountID, valAddr, shares); err != nil {
+		return types.Coin{}, sdkerrors.Wrap(err, "transfer delegation to module")
+	}
+
+	// the derivative coin is created by the first liquid stake to the validator
+	if !found {
+		if err := k.createDerivative(ctx, valAddr); err != nil {
+			return types.Coin{}, err
+		}
+	}
+
+	if _, err := k.assetKeeper.IssueCoinPower(ctx, delegator, types.Coins{derivative}); err != nil {
+		return types.Coin{}, sdkerrors.Wrap(err, "issue derivative coins")
+	}
+
+	if err := k.assetKeeper.ExerciseCoinPower(ctx, delegator, derivative); err != nil {
+		return types.Coin{}, sdkerrors.Wrap(err, "exercise derivative coins")
+	}
+
+	l.Shares = l.Shares.Add(shares)
+	l.Supply = l.Supply.Add(derivative.Amount)
+	k.SetLiquidValidator(ctx, l)
+
+	return derivative, nil
+}
+
+// Redeem burns the derivative coins of the owner, and moves the delegation shares
+// of the derivative by the proportion of the supply from the module to the owner.
+func (k Keeper) Redeem(ctx sdk.Context, owner types.AccountID, amount types.Coin) (types.AccountID, sdk.Dec, error) {
+	l, found := k.GetLiquidValidatorByDenom(ctx, amount.Denom)
+	if !found {
+		return types.AccountID{}, sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrUnknownDerivative, "denom %s", amount.Denom)
+	}
+
+	l = k.compoundRewards(ctx, l)
+
+	if amount.Amount.GT(l.Supply) {
+		return types.AccountID{}, sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrInvalidLiquidAmount,
+			"%s is greater than the supply %s", amount, l.Supply)
+	}
+
+	shares := l.SharesFromDerivative(amount.Amount)
+	if !shares.IsPositive() {
+		return types.AccountID{}, sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrInvalidLiquidAmount, "%s is too small to redeem", amount)
+	}
+
+	if err := k.assetKeeper.Burn(ctx, owner, amount); err != nil {
+		return types.AccountID{}, sdk.ZeroDec(), sdkerrors.Wrap(err, "burn derivative coins")
+	}
+
+	if err := k.stakingKeeper.TransferDelegation(ctx, types.ModuleAccountID, owner, l.Validator, shares); err != nil {
+		return types.AccountID{}, sdk.ZeroDec(), sdkerrors.Wrap(err, "transfer delegation to owner")
+	}
+
+	l.Shares = l.Shares.Sub(shares)
+	l.Supply = l.Supply.Sub(amount.Amount)
+	k.SetLiquidValidator(ctx, l)
+
+	return l.Validator, shares, nil
+}
+
+// compoundRewards withdraws the rewards of the delegation held by the module and delegates the bond tokens
+// of the rewards back to the validator, the shares are added without minting the derivative, so the rewards
+// go to the holders by the exchange rate. The liquid validator is unchanged if the compounding fails.
+func (k Keeper) compoundRewards(ctx sdk.Context, l types.LiquidValidator) types.LiquidValidator {
+	if l.Shares.IsZero() {
+		return l
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	shares, err := k.delegateRewards(cacheCtx, l.Validator)
+	if err != nil {
+		k.Logger(ctx).Error("compound rewards failed", "validator", l.Validator, "err", err)
+		return l
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	l.Shares = l.Shares.Add(shares)
+	k.SetLiquidValidator(ctx, l)
+
+	return l
+}
+
+// delegateRewards withdraws the rewards of the module delegation and delegates the bond tokens of them
+func (k Keeper) delegateRewards(ctx sdk.Context, valAddr types.AccountID) (sdk.Dec, error) {
+	rewards, err := k.distrKeeper.WithdrawDelegationRewards(ctx, types.ModuleAccountID, valAddr)
+	if err != nil {
+		return sdk.ZeroDec(), sdkerrors.Wrap(err, "withdraw rewards")
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return sdk.ZeroDec(), nil
+	}
+
+	// the rewards are withdrawn as the coin power, the delegated coins are sent to the staking module first
+	coin := types.NewCoin(bondDenom, amount)
+	if err := k.assetKeeper.ExerciseCoinPower(ctx, types.ModuleAccountID, coin); err != nil {
+		return sdk.ZeroDec(), sdkerrors.Wrap(err, "exercise rewards")
+	}
+
+	if err := k.assetKeeper.Transfer(ctx, types.ModuleAccountID, stakingTypes.ModuleAccountID, types.Coins{coin}); err != nil {
+		return sdk.ZeroDec(), sdkerrors.Wrap(err, "transfer rewards to staking")
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrNoValidatorFound, "validator %s", valAddr)
+	}
+
+	shares, err := k.stakingKeeper.Delegate(ctx, types.ModuleAccountID, amount, stakingexport.Unbonded, validator, true)
+	if err != nil {
+		return sdk.ZeroDec(), sdkerrors.Wrap(err, "delegate rewards")
+	}
+
+	return shares, nil
+}
+
+// nextDerivativeDenom returns the denom of the derivative coin to create
+func (k Keeper) nextDerivativeDenom(ctx sdk.Context) string {
+	return types.CoinDenom(types.DerivativeCreator, types.DerivativeSymbol(k.GetNextDerivativeID(ctx)))
+}
+
+// createDerivative creates the derivative coin of the validator by the asset keeper
+func (k Keeper) createDerivative(ctx sdk.Context, valAddr types.AccountID) error {
+	id := k.GetNextDerivativeID(ctx)
+	symbol := types.DerivativeSymbol(id)
+	denom := types.CoinDenom(types.DerivativeCreator, symbol)
+
+	err := k.assetKeeper.Create(ctx, types.DerivativeCreator, symbol,
+		types.NewCoin(denom, types.DerivativeMaxSupply), true, false, 0, types.NewCoin(denom, sdk.ZeroInt()),
+		[]byte("liquid staking derivative of "+valAddr.String()))
+	if err != nil {
+		return sdkerrors.Wrapf(err, "create derivative %s", denom)
+	}
+
+	k.SetNextDerivativeID(ctx, id+1)
+
+	return nil
+}
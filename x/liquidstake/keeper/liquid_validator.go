@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/KuChainNetwork/kuchain/x/liquidstake/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetLiquidValidator gets the liquid validator of the validator
+func (k Keeper) GetLiquidValidator(ctx sdk.Context, validator types.AccountID) (l types.LiquidValidator, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LiquidValidatorKey(validator))
+	if bz == nil {
+		return l, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &l)
+	return l, true
+}
+
+// GetLiquidValidatorByDenom gets the liquid validator by the derivative denom
+func (k Keeper) GetLiquidValidatorByDenom(ctx sdk.Context, denom string) (l types.LiquidValidator, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DerivativeDenomKey(denom))
+	if bz == nil {
+		return l, false
+	}
+
+	var validator types.AccountID
+	k.cdc.MustUnmarshalBinaryBare(bz, &validator)
+	return k.GetLiquidValidator(ctx, validator)
+}
+
+// SetLiquidValidator sets the liquid validator to store
+func (k Keeper) SetLiquidValidator(ctx sdk.Context, l types.LiquidValidator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LiquidValidatorKey(l.Validator), k.cdc.MustMarshalBinaryBare(l))
+	store.Set(types.DerivativeDenomKey(l.Denom), k.cdc.MustMarshalBinaryBare(l.Validator))
+}
+
+// IterateLiquidValidators iterates all the liquid validators
+func (k Keeper) IterateLiquidValidators(ctx sdk.Context, cb func(l types.LiquidValidator) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.LiquidValidatorKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var l types.LiquidValidator
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &l)
+		if cb(l) {
+			break
+		}
+	}
+}
+
+// GetLiquidValidators gets all the liquid validators
+func (k Keeper) GetLiquidValidators(ctx sdk.Context) (liquidValidators types.LiquidValidators) {
+	k.IterateLiquidValidators(ctx, func(l types.LiquidValidator) bool {
+		liquidValidators = append(liquidValidators, l)
+		return false
+	})
+	return liquidValidators
+}
+
+// GetNextDerivativeID gets the id of the next derivative coin
+func (k Keeper) GetNextDerivativeID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextDerivativeIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextDerivativeID sets the id of the next derivative coin
+func (k Keeper) SetNextDerivativeID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextDerivativeIDKey, types.GetNextDerivativeIDBytes(id))
+}
+
+// LiquidValidatorTokens returns the tokens of the delegation shares held by the liquid validator
+func (k Keeper) LiquidValidatorTokens(ctx sdk.Context, l types.LiquidValidator) sdk.Dec {
+	validator, found := k.stakingKeeper.GetValidator(ctx, l.Validator)
+	if !found || l.Shares.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	return validator.TokensFromShares(l.Shares)
+}
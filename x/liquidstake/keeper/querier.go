@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/x/liquidstake/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewQuerier creates a new querier for liquid staking clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryLiquidValidator:
+			return queryLiquidValidator(ctx, req, k)
+
+		case types.QueryLiquidValidators:
+			return queryLiquidValidators(ctx, k)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+	}
+}
+
+func queryLiquidValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryLiquidValidatorParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	l, found := k.GetLiquidValidator(ctx, params.Validator)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidLiquidValidator, "validator %s has no derivative", params.Validator)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewQueryLiquidValidatorResult(l, k.LiquidValidatorTokens(ctx, l)))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryLiquidValidators(ctx sdk.Context, k Keeper) ([]byte, error) {
+	results := make([]types.QueryLiquidValidatorResult, 0)
+	k.IterateLiquidValidators(ctx, func(l types.LiquidValidator) bool {
+		results = append(results, types.NewQueryLiquidValidatorResult(l, k.LiquidValidatorTokens(ctx, l)))
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, results)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
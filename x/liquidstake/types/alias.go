@@ -0,0 +1,19 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+type (
+	AccountID = types.AccountID
+	KuMsg     = types.KuMsg
+	Name      = types.Name
+	Coin      = types.Coin
+	Coins     = types.Coins
+)
+
+var (
+	MustName  = types.MustName
+	NewCoin   = types.NewCoin
+	CoinDenom = types.CoinDenom
+)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgLiquidStake{}, "liquidstake/MsgLiquidStake", nil)
+	cdc.RegisterConcrete(KuMsgLiquidStake{}, "liquidstake/KuMsgLiquidStake", nil)
+	cdc.RegisterConcrete(&MsgRedeem{}, "liquidstake/MsgRedeem", nil)
+	cdc.RegisterConcrete(KuMsgRedeem{}, "liquidstake/KuMsgRedeem", nil)
+}
+
+var (
+	// ModuleCdc references the global x/liquidstake module codec.
+	ModuleCdc = codec.New()
+)
+
+// Cdc get codec for types
+func Cdc() *codec.Codec {
+	return ModuleCdc
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
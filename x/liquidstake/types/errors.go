@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/liquidstake module sentinel errors
+var (
+	ErrInvalidLiquidValidator = sdkerrors.Register(ModuleName, 2, "invalid liquid validator")
+	ErrUnknownDerivative      = sdkerrors.Register(ModuleName, 3, "unknown derivative denom")
+	ErrInvalidLiquidAmount    = sdkerrors.Register(ModuleName, 4, "invalid liquid staking amount")
+	ErrNoValidatorFound       = sdkerrors.Register(ModuleName, 5, "validator does not exist")
+)
@@ -0,0 +1,16 @@
+package types
+
+// liquid staking module event types
+const (
+	EventTypeLiquidStake = "liquid_stake"
+	EventTypeRedeem      = "redeem"
+
+	AttributeKeyDelegator  = "delegator"
+	AttributeKeyOwner      = "owner"
+	AttributeKeyValidator  = "validator"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyShares     = "shares"
+	AttributeKeyDerivative = "derivative"
+
+	AttributeValueCategory = ModuleName
+)
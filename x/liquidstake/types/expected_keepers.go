@@ -0,0 +1,38 @@
+package types
+
+import (
+	stakingexport "github.com/KuChainNetwork/kuchain/x/staking/exported"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
+	"github.com/KuChainNetwork/kuchain/x/supply/exported"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingKeeper defines the expected staking keeper to move the delegations
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, acc AccountID) (stakingTypes.Validator, bool)
+	GetDelegation(ctx sdk.Context, delAddr AccountID, valAddr AccountID) (stakingTypes.Delegation, bool)
+	ValidateUnbondAmount(ctx sdk.Context, delAddr AccountID, valAddr AccountID, amt sdk.Int) (sdk.Dec, error)
+	TransferDelegation(ctx sdk.Context, from, to, valAddr AccountID, shares sdk.Dec) error
+	Delegate(ctx sdk.Context, delAddr AccountID, bondAmt sdk.Int, tokenSrc stakingexport.BondStatus,
+		validator stakingTypes.Validator, subtractAccount bool) (sdk.Dec, error)
+}
+
+// DistributionKeeper defines the expected distribution keeper to withdraw the rewards of the delegations
+type DistributionKeeper interface {
+	WithdrawDelegationRewards(ctx sdk.Context, delAddr AccountID, valAddr AccountID) (Coins, error)
+}
+
+// AssetKeeper defines the expected asset keeper to create, issue and burn the derivative coins
+type AssetKeeper interface {
+	Create(ctx sdk.Context, creator, symbol Name, maxSupply Coin, canIssue, canLock bool, issue2Height int64, initSupply Coin, desc []byte) error
+	Burn(ctx sdk.Context, id AccountID, amount Coin) error
+	Transfer(ctx sdk.Context, from, to AccountID, amount Coins) error
+	IssueCoinPower(ctx sdk.Context, id AccountID, amt Coins) (Coins, error)
+	ExerciseCoinPower(ctx sdk.Context, id AccountID, amt Coin) error
+}
+
+// SupplyKeeper defines the expected supply keeper for the module account
+type SupplyKeeper interface {
+	GetModuleAccount(ctx sdk.Context, name string) exported.ModuleAccountI
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GenesisState - all liquid staking state that must be provided at genesis
+type GenesisState struct {
+	NextDerivativeID uint64           `json:"next_derivative_id" yaml:"next_derivative_id"`
+	LiquidValidators LiquidValidators `json:"liquid_validators" yaml:"liquid_validators"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(nextDerivativeID uint64, liquidValidators LiquidValidators) GenesisState {
+	return GenesisState{
+		NextDerivativeID: nextDerivativeID,
+		LiquidValidators: liquidValidators,
+	}
+}
+
+// DefaultGenesisState - default GenesisState
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(1, LiquidValidators{})
+}
+
+// ValidateGenesis performs basic validation of liquid staking genesis data returning an
+// error for any failed validation criteria.
+func (g GenesisState) ValidateGenesis(bz json.RawMessage) error {
+	gs := DefaultGenesisState()
+	if err := Cdc().UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+
+	return ValidateGenesis(gs)
+}
+
+// ValidateGenesis validates the liquid staking genesis data
+func ValidateGenesis(data GenesisState) error {
+	if data.NextDerivativeID == 0 {
+		return fmt.Errorf("next derivative id should be positive")
+	}
+
+	validators := make(map[string]bool, len(data.LiquidValidators))
+	denoms := make(map[string]bool, len(data.LiquidValidators))
+	for _, l := range data.LiquidValidators {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+
+		if validators[l.Validator.String()] {
+			return fmt.Errorf("duplicated liquid validator %s", l.Validator)
+		}
+		validators[l.Validator.String()] = true
+
+		if denoms[l.Denom] {
+			return fmt.Errorf("duplicated derivative denom %s", l.Denom)
+		}
+		denoms[l.Denom] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "liquidstake"
+
+	// StoreKey is the store key string for liquid staking
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for liquid staking
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for liquid staking
+	QuerierRoute = ModuleName
+)
+
+var (
+	// ModuleAccountID the module account which holds the delegations of the derivatives
+	ModuleAccountID = types.NewAccountIDFromName(types.MustName(ModuleName))
+
+	// DerivativeCreator the creator of the derivative coins
+	DerivativeCreator = types.MustName(ModuleName)
+)
+
+// Keys for liquid staking store
+// Items are stored with the following key: values
+//
+// - 0x01<validator_Bytes>: LiquidValidator
+//
+// - 0x02<denom_Bytes>: validator AccountID
+//
+// - 0x03: next derivative id
+var (
+	LiquidValidatorKeyPrefix = []byte{0x01}
+	DerivativeDenomKeyPrefix = []byte{0x02}
+	NextDerivativeIDKey      = []byte{0x03}
+)
+
+// LiquidValidatorKey gets the key of the liquid validator
+func LiquidValidatorKey(validator AccountID) []byte {
+	return append(LiquidValidatorKeyPrefix, validator.StoreKey()...)
+}
+
+// DerivativeDenomKey gets the key of the validator by the derivative denom
+func DerivativeDenomKey(denom string) []byte {
+	return append(DerivativeDenomKeyPrefix, []byte(denom)...)
+}
+
+// DerivativeSymbol gets the symbol of the derivative coin by the id
+func DerivativeSymbol(id uint64) Name {
+	return types.MustName(fmt.Sprintf("lsd%d", id))
+}
+
+// GetNextDerivativeIDBytes gets the bytes of the next derivative id
+func GetNextDerivativeIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
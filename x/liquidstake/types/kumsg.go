@@ -0,0 +1,73 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	RouterKeyName = MustName(RouterKey)
+)
+
+type KuMsgLiquidStake struct {
+	KuMsg
+}
+
+// NewKuMsgLiquidStake creates a msg for the delegator to convert the delegation to the derivative coins
+func NewKuMsgLiquidStake(auth sdk.AccAddress, delegator, validator AccountID, amount Coin) KuMsgLiquidStake {
+	return KuMsgLiquidStake{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgLiquidStake{
+				Delegator: delegator,
+				Validator: validator,
+				Amount:    amount,
+			}),
+		),
+	}
+}
+
+func (msg KuMsgLiquidStake) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgData := MsgLiquidStake{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return err
+	}
+
+	return msgData.ValidateBasic()
+}
+
+type KuMsgRedeem struct {
+	KuMsg
+}
+
+// NewKuMsgRedeem creates a msg for the holder to redeem the derivative coins into the delegation
+func NewKuMsgRedeem(auth sdk.AccAddress, owner AccountID, amount Coin) KuMsgRedeem {
+	return KuMsgRedeem{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgRedeem{
+				Owner:  owner,
+				Amount: amount,
+			}),
+		),
+	}
+}
+
+func (msg KuMsgRedeem) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgData := MsgRedeem{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return err
+	}
+
+	return msgData.ValidateBasic()
+}
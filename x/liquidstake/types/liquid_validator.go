@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// DerivativeMaxSupply the max supply of each derivative coin, the supply is only limited by the delegations
+var DerivativeMaxSupply = sdk.NewIntWithDecimal(1, 36)
+
+// LiquidValidator the delegation shares to the validator held by the module and the supply of
+// the derivative coin minted against the shares, the shares are not changed by slashing but the
+// tokens of the shares are, so the exchange rate of the derivative is the tokens of the shares by the supply.
+type LiquidValidator struct {
+	Validator AccountID `json:"validator" yaml:"validator"`
+	Denom     string    `json:"denom" yaml:"denom"`
+	Shares    sdk.Dec   `json:"shares" yaml:"shares"`
+	Supply    sdk.Int   `json:"supply" yaml:"supply"`
+}
+
+// NewLiquidValidator creates a new LiquidValidator instance without shares
+func NewLiquidValidator(validator AccountID, denom string) LiquidValidator {
+	return LiquidValidator{
+		Validator: validator,
+		Denom:     denom,
+		Shares:    sdk.ZeroDec(),
+		Supply:    sdk.ZeroInt(),
+	}
+}
+
+// Validate validates the liquid validator
+func (l LiquidValidator) Validate() error {
+	if l.Validator.Empty() {
+		return fmt.Errorf("validator should not be empty")
+	}
+
+	if err := chainTypes.ValidateDenom(l.Denom); err != nil {
+		return err
+	}
+
+	if l.Shares.IsNil() || l.Shares.IsNegative() {
+		return fmt.Errorf("shares %s should not be negative", l.Shares)
+	}
+
+	if l.Supply.IsNegative() {
+		return fmt.Errorf("supply %s should not be negative", l.Supply)
+	}
+
+	if l.Shares.IsZero() != l.Supply.IsZero() {
+		return fmt.Errorf("shares %s and supply %s should be both zero or positive", l.Shares, l.Supply)
+	}
+
+	return nil
+}
+
+// DerivativeFromShares returns the derivative amount to mint against the shares,
+// the first mint is 1:1 to the tokens of the shares, then it's by the proportion of the shares.
+func (l LiquidValidator) DerivativeFromShares(shares, tokens sdk.Dec) sdk.Int {
+	if l.Supply.IsZero() {
+		return tokens.TruncateInt()
+	}
+
+	return shares.MulInt(l.Supply).Quo(l.Shares).TruncateInt()
+}
+
+// SharesFromDerivative returns the shares to redeem by the derivative amount,
+// redeeming all the supply gets all the shares.
+func (l LiquidValidator) SharesFromDerivative(amount sdk.Int) sdk.Dec {
+	if amount.Equal(l.Supply) {
+		return l.Shares
+	}
+
+	return l.Shares.MulInt(amount).QuoInt(l.Supply)
+}
+
+// ExchangeRate returns the tokens per derivative by the tokens of the shares, one if no supply
+func (l LiquidValidator) ExchangeRate(tokens sdk.Dec) sdk.Dec {
+	if l.Supply.IsZero() {
+		return sdk.OneDec()
+	}
+
+	return tokens.QuoInt(l.Supply)
+}
+
+func (l LiquidValidator) String() string {
+	out, _ := yaml.Marshal(l)
+	return string(out)
+}
+
+// LiquidValidators is a collection of LiquidValidator
+type LiquidValidators []LiquidValidator
+
+func (l LiquidValidators) String() string {
+	out, _ := yaml.Marshal(l)
+	return string(out)
+}
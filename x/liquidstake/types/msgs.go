@@ -0,0 +1,82 @@
+package types
+
+import (
+	chainType "github.com/KuChainNetwork/kuchain/chain/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// verify interface at compile time
+var (
+	_ chainType.KuMsgData = (*MsgLiquidStake)(nil)
+	_ chainType.KuMsgData = (*MsgRedeem)(nil)
+)
+
+// MsgLiquidStake - struct for the delegator to convert the bonded delegation to the derivative coins
+type MsgLiquidStake struct {
+	Delegator AccountID `json:"delegator" yaml:"delegator"`
+	Validator AccountID `json:"validator" yaml:"validator"`
+	Amount    Coin      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgLiquidStake creates a new MsgLiquidStake instance
+func NewMsgLiquidStake(delegator, validator AccountID, amount Coin) MsgLiquidStake {
+	return MsgLiquidStake{
+		Delegator: delegator,
+		Validator: validator,
+		Amount:    amount,
+	}
+}
+
+// nolint
+func (msg MsgLiquidStake) Route() string     { return RouterKey }
+func (msg MsgLiquidStake) Type() Name        { return MustName("liquidstake") }
+func (msg MsgLiquidStake) Sender() AccountID { return msg.Delegator }
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgLiquidStake) ValidateBasic() error {
+	if msg.Delegator.Empty() {
+		return sdkerrors.Wrap(ErrInvalidLiquidAmount, "delegator should not be empty")
+	}
+
+	if msg.Validator.Empty() {
+		return sdkerrors.Wrap(ErrInvalidLiquidValidator, "validator should not be empty")
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidLiquidAmount, "amount %s", msg.Amount)
+	}
+
+	return nil
+}
+
+// MsgRedeem - struct for the holder to redeem the derivative coins back into the delegation
+type MsgRedeem struct {
+	Owner  AccountID `json:"owner" yaml:"owner"`
+	Amount Coin      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgRedeem creates a new MsgRedeem instance
+func NewMsgRedeem(owner AccountID, amount Coin) MsgRedeem {
+	return MsgRedeem{
+		Owner:  owner,
+		Amount: amount,
+	}
+}
+
+// nolint
+func (msg MsgRedeem) Route() string     { return RouterKey }
+func (msg MsgRedeem) Type() Name        { return MustName("redeem") }
+func (msg MsgRedeem) Sender() AccountID { return msg.Owner }
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgRedeem) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(ErrInvalidLiquidAmount, "owner should not be empty")
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidLiquidAmount, "amount %s", msg.Amount)
+	}
+
+	return nil
+}
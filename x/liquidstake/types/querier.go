@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Query endpoints supported by the liquid staking querier
+const (
+	QueryLiquidValidator  = "liquidValidator"
+	QueryLiquidValidators = "liquidValidators"
+)
+
+// QueryLiquidValidatorParams defines the params for the following queries:
+// - 'custom/liquidstake/liquidValidator'
+type QueryLiquidValidatorParams struct {
+	Validator AccountID
+}
+
+// NewQueryLiquidValidatorParams creates a new QueryLiquidValidatorParams instance
+func NewQueryLiquidValidatorParams(validator AccountID) QueryLiquidValidatorParams {
+	return QueryLiquidValidatorParams{validator}
+}
+
+// QueryLiquidValidatorResult the liquid validator with the tokens of the shares and the exchange rate
+type QueryLiquidValidatorResult struct {
+	LiquidValidator LiquidValidator `json:"liquid_validator" yaml:"liquid_validator"`
+	Tokens          sdk.Dec         `json:"tokens" yaml:"tokens"`
+	ExchangeRate    sdk.Dec         `json:"exchange_rate" yaml:"exchange_rate"`
+}
+
+// NewQueryLiquidValidatorResult creates a new QueryLiquidValidatorResult instance
+func NewQueryLiquidValidatorResult(l LiquidValidator, tokens sdk.Dec) QueryLiquidValidatorResult {
+	return QueryLiquidValidatorResult{
+		LiquidValidator: l,
+		Tokens:          tokens,
+		ExchangeRate:    l.ExchangeRate(tokens),
+	}
+}
@@ -0,0 +1,18 @@
+package liquidstake
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/liquidstake/types"
+)
+
+// Inputs the keepers the liquidstake module depends on
+type Inputs struct {
+	StakingKeeper      types.StakingKeeper
+	DistributionKeeper types.DistributionKeeper
+	AssetKeeper        types.AssetKeeper
+}
+
+// ProvideKeeper creates the liquidstake keeper by the store key declared to the builder
+func ProvideKeeper(b *wiring.Builder, in Inputs) Keeper {
+	return NewKeeper(b.Codec(), b.KVStoreKey(StoreKey), in.StakingKeeper, in.DistributionKeeper, in.AssetKeeper)
+}
@@ -15,6 +15,7 @@ const (
 	QueryParameters       = types.QueryParameters
 	QueryInflation        = types.QueryInflation
 	QueryAnnualProvisions = types.QueryAnnualProvisions
+	QueryInflationReport  = types.QueryInflationReport
 )
 
 var (
@@ -31,6 +32,7 @@ var (
 	ParamKeyTable        = types.ParamKeyTable
 	NewParams            = types.NewParams
 	DefaultParams        = types.DefaultParams
+	NewInflationReport   = types.NewInflationReport
 
 	// variable aliases
 	ModuleCdc              = types.ModuleCdc
@@ -46,8 +48,9 @@ var (
 )
 
 type (
-	Keeper       = keeper.Keeper
-	GenesisState = types.GenesisState
-	Minter       = types.Minter
-	Params       = types.Params
+	Keeper          = keeper.Keeper
+	GenesisState    = types.GenesisState
+	Minter          = types.Minter
+	Params          = types.Params
+	InflationReport = types.InflationReport
 )
@@ -27,6 +27,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetCmdQueryParams(cdc),
 			GetCmdQueryInflation(cdc),
 			GetCmdQueryAnnualProvisions(cdc),
+			GetCmdQueryInflationReport(cdc),
 		)...,
 	)
 
@@ -110,3 +111,29 @@ func GetCmdQueryAnnualProvisions(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryInflationReport implements a command to return the current minting
+// inflation report, with the bonded ratio and the estimated changes for the next block.
+func GetCmdQueryInflationReport(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "report",
+		Short: "Query the current minting inflation, annual provisions, bonded ratio and provisions per block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInflationReport)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var report types.InflationReport
+			if err := cdc.UnmarshalJSON(res, &report); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(report)
+		},
+	}
+}
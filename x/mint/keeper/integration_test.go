@@ -5,6 +5,7 @@ import (
 
 	"github.com/KuChainNetwork/kuchain/test/simapp"
 	"github.com/KuChainNetwork/kuchain/x/mint/types"
+	stakingTypes "github.com/KuChainNetwork/kuchain/x/staking/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -15,6 +16,7 @@ func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
 	app.MintKeeper().SetParams(ctx, types.DefaultParams())
 	app.MintKeeper().SetMinter(ctx, types.DefaultInitialMinter())
+	app.StakeKeeper().SetParams(ctx, stakingTypes.DefaultParams())
 
 	return app, ctx
 }
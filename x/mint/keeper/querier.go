@@ -21,6 +21,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryAnnualProvisions:
 			return queryAnnualProvisions(ctx, k)
 
+		case types.QueryInflationReport:
+			return queryInflationReport(ctx, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -59,3 +62,14 @@ func queryAnnualProvisions(ctx sdk.Context, k Keeper) ([]byte, error) {
 
 	return res, nil
 }
+
+func queryInflationReport(ctx sdk.Context, k Keeper) ([]byte, error) {
+	report := types.NewInflationReport(k.GetMinter(ctx), k.GetParams(ctx), k.BondedRatio(ctx))
+
+	res, err := codec.MarshalJSONIndent(k.cdc, report)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
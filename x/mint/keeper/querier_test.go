@@ -30,6 +30,9 @@ func TestNewQuerier(t *testing.T) {
 	_, err = querier(ctx, []string{types.QueryAnnualProvisions}, query)
 	require.NoError(t, err)
 
+	_, err = querier(ctx, []string{types.QueryInflationReport}, query)
+	require.NoError(t, err)
+
 	_, err = querier(ctx, []string{"foo"}, query)
 	require.Error(t, err)
 }
@@ -78,3 +81,27 @@ func TestQueryAnnualProvisions(t *testing.T) {
 
 	require.Equal(t, app.MintKeeper().GetMinter(ctx).AnnualProvisions, annualProvisions)
 }
+
+func TestQueryInflationReport(t *testing.T) {
+	app, ctx := createTestApp(true)
+	querier := keep.NewQuerier(*app.MintKeeper())
+
+	var report types.InflationReport
+
+	res, sdkErr := querier(ctx, []string{types.QueryInflationReport}, abci.RequestQuery{})
+	require.NoError(t, sdkErr)
+
+	err := app.Codec().UnmarshalJSON(res, &report)
+	require.NoError(t, err)
+
+	minter := app.MintKeeper().GetMinter(ctx)
+	params := app.MintKeeper().GetParams(ctx)
+	bondedRatio := app.MintKeeper().BondedRatio(ctx)
+
+	require.Equal(t, minter.Inflation, report.Inflation)
+	require.Equal(t, minter.AnnualProvisions, report.AnnualProvisions)
+	require.Equal(t, bondedRatio, report.BondedRatio)
+	require.Equal(t, minter.NextInflationRate(params, bondedRatio), report.NextInflation)
+	require.Equal(t, report.NextInflation.Sub(report.Inflation), report.InflationChange)
+	require.Equal(t, minter.BlockProvision(params), report.ProvisionsPerBlock)
+}
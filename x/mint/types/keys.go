@@ -21,4 +21,5 @@ const (
 	QueryParameters       = "parameters"
 	QueryInflation        = "inflation"
 	QueryAnnualProvisions = "annual_provisions"
+	QueryInflationReport  = "report"
 )
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// InflationReport the report of the minting state, combines the current inflation,
+// the annual provisions and the bonded ratio with the estimated changes for the next block.
+type InflationReport struct {
+	Inflation          sdk.Dec `json:"inflation" yaml:"inflation"`                       // current annual inflation rate
+	AnnualProvisions   sdk.Dec `json:"annual_provisions" yaml:"annual_provisions"`       // current annual expected provisions
+	BondedRatio        sdk.Dec `json:"bonded_ratio" yaml:"bonded_ratio"`                 // current ratio of the bonded tokens
+	NextInflation      sdk.Dec `json:"next_inflation" yaml:"next_inflation"`             // estimated inflation rate of the next block
+	InflationChange    sdk.Dec `json:"inflation_change" yaml:"inflation_change"`         // estimated inflation rate change of the next block
+	ProvisionsPerBlock Coin    `json:"provisions_per_block" yaml:"provisions_per_block"` // coins minted in each block by the current annual provisions
+}
+
+// NewInflationReport creates the inflation report by the current minter, params and bonded ratio.
+func NewInflationReport(minter Minter, params Params, bondedRatio sdk.Dec) InflationReport {
+	nextInflation := minter.NextInflationRate(params, bondedRatio)
+
+	return InflationReport{
+		Inflation:          minter.Inflation,
+		AnnualProvisions:   minter.AnnualProvisions,
+		BondedRatio:        bondedRatio,
+		NextInflation:      nextInflation,
+		InflationChange:    nextInflation.Sub(minter.Inflation),
+		ProvisionsPerBlock: minter.BlockProvision(params),
+	}
+}
+
+// String implements the Stringer interface.
+func (r InflationReport) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
@@ -0,0 +1,20 @@
+package mint
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/mint/types"
+)
+
+// Inputs the keepers and fee collector the mint module depends on
+type Inputs struct {
+	StakingKeeper    types.StakingKeeper
+	SupplyKeeper     types.SupplyKeeper
+	FeeCollectorName string
+}
+
+// ProvideKeeper creates the mint keeper by the store key and params subspace declared to the builder
+func ProvideKeeper(b *wiring.Builder, in Inputs) Keeper {
+	return NewKeeper(
+		b.Codec(), b.KVStoreKey(StoreKey), b.Subspace(DefaultParamspace), in.StakingKeeper, in.SupplyKeeper, in.FeeCollectorName,
+	)
+}
@@ -38,6 +38,15 @@ func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p proposal.
 		if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
 			return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
 		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				proposal.EventTypeParamChange,
+				sdk.NewAttribute(proposal.AttributeKeySubspace, c.Subspace),
+				sdk.NewAttribute(proposal.AttributeKeyKey, c.Key),
+				sdk.NewAttribute(proposal.AttributeKeyValue, c.Value),
+			),
+		)
 	}
 
 	return nil
@@ -0,0 +1,10 @@
+package proposal
+
+// Param change proposal event types
+const (
+	EventTypeParamChange = "param_change"
+
+	AttributeKeySubspace = "subspace"
+	AttributeKeyKey      = "key"
+	AttributeKeyValue    = "value"
+)
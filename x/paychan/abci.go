@@ -0,0 +1,19 @@
+package paychan
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker refunds the channels which are timeout or out of dispute window to sender
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	logger := k.Logger(ctx)
+
+	k.IterateSettleQueue(ctx, ctx.BlockHeight(), func(channel Channel) bool {
+		if err := k.SettleChannel(ctx, channel, nil); err != nil {
+			panic(err)
+		}
+
+		logger.Info("payment channel refunded", "channel", channel.ID, "sender", channel.Sender, "deposit", channel.Deposit)
+		return false
+	})
+}
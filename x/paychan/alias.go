@@ -0,0 +1,58 @@
+package paychan
+
+// nolint
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/paychan/keeper"
+	"github.com/KuChainNetwork/kuchain/x/paychan/types"
+)
+
+const (
+	ModuleName        = types.ModuleName
+	StoreKey          = types.StoreKey
+	RouterKey         = types.RouterKey
+	QuerierRoute      = types.QuerierRoute
+	DefaultParamspace = types.DefaultParamspace
+	QueryParameters   = types.QueryParameters
+	QueryChannel      = types.QueryChannel
+	QueryChannels     = types.QueryChannels
+)
+
+var (
+	// functions aliases
+	NewKeeper              = keeper.NewKeeper
+	NewQuerier             = keeper.NewQuerier
+	RegisterCodec          = types.RegisterCodec
+	NewGenesisState        = types.NewGenesisState
+	DefaultGenesisState    = types.DefaultGenesisState
+	ValidateGenesis        = types.ValidateGenesis
+	ParamKeyTable          = types.ParamKeyTable
+	NewParams              = types.NewParams
+	DefaultParams          = types.DefaultParams
+	NewChannel             = types.NewChannel
+	NewVoucher             = types.NewVoucher
+	NewSignedVoucher       = types.NewSignedVoucher
+	NewMsgOpenChannel      = types.NewMsgOpenChannel
+	NewMsgCloseChannel     = types.NewMsgCloseChannel
+	NewKuMsgOpenChannel    = types.NewKuMsgOpenChannel
+	NewKuMsgCloseChannel   = types.NewKuMsgCloseChannel
+	NewQueryChannelParams  = types.NewQueryChannelParams
+	NewQueryChannelsParams = types.NewQueryChannelsParams
+
+	// variable aliases
+	ModuleCdc       = types.ModuleCdc
+	Cdc             = types.Cdc
+	ModuleAccountID = types.ModuleAccountID
+)
+
+type (
+	Keeper          = keeper.Keeper
+	GenesisState    = types.GenesisState
+	Params          = types.Params
+	Channel         = types.Channel
+	Channels        = types.Channels
+	Voucher         = types.Voucher
+	SignedVoucher   = types.SignedVoucher
+	MsgOpenChannel  = types.MsgOpenChannel
+	MsgCloseChannel = types.MsgCloseChannel
+)
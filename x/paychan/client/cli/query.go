@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/paychan/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	paychanQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the payment channel module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	paychanQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryChannel(cdc),
+			GetCmdQueryChannels(cdc),
+			GetCmdQueryParams(cdc),
+		)...,
+	)
+
+	return paychanQueryCmd
+}
+
+// GetCmdQueryChannel implements the query channel command.
+func GetCmdQueryChannel(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "channel [channel-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query details of a payment channel",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for a payment channel.
+
+Example:
+$ %s query paychan channel 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			channelID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("channel-id %s not a valid uint, please input a valid channel-id", args[0])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryChannelParams(channelID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryChannel)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var channel types.Channel
+			cdc.MustUnmarshalJSON(res, &channel)
+			return cliCtx.PrintOutput(channel)
+		},
+	}
+}
+
+// GetCmdQueryChannels implements the query channels of account command.
+func GetCmdQueryChannels(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "channels [account]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query payment channels which account is sender or recipient",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query payment channels which account is sender or recipient, all channels if no account.
+
+Example:
+$ %s query paychan channels alice
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var account chainTypes.AccountID
+			if len(args) > 0 {
+				id, err := chainTypes.NewAccountIDFromStr(args[0])
+				if err != nil {
+					return err
+				}
+				account = id
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryChannelsParams(account))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryChannels)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var channels types.Channels
+			cdc.MustUnmarshalJSON(res, &channels)
+			return cliCtx.PrintOutput(channels)
+		},
+	}
+}
+
+// GetCmdQueryParams implements a command to fetch paychan parameters.
+func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Query the current payment channel parameters",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current parameters for the payment channel module:
+
+$ %s query paychan params
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
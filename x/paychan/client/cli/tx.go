@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/paychan/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	paychanTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Payment channel transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	paychanTxCmd.AddCommand(flags.PostCommands(
+		GetCmdOpenChannel(cdc),
+		GetCmdCloseChannel(cdc),
+	)...)
+	paychanTxCmd.AddCommand(GetCmdSignVoucher(cdc))
+
+	return paychanTxCmd
+}
+
+// GetCmdOpenChannel implements the open channel command.
+func GetCmdOpenChannel(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "open [sender] [recipient] [deposit] [timeout]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Open a payment channel with deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Open a unidirectional payment channel from sender to recipient,
+the deposit can be refunded to sender after timeout blocks.
+
+Example:
+$ %s tx paychan open alice bob 1000kuchain/kcs 10000 --from alice
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			sender, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "sender account id error")
+			}
+
+			recipient, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "recipient account id error")
+			}
+
+			deposit, err := chainTypes.ParseCoins(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "deposit parse error")
+			}
+
+			timeout, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "timeout parse error")
+			}
+
+			senderAuth, err := txutil.QueryAccountAuth(cliCtx, sender)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", sender)
+			}
+
+			msg := types.NewKuMsgOpenChannel(senderAuth, sender, recipient, deposit, timeout)
+			cliCtx = cliCtx.WithFromAccount(sender)
+			if txBldr.FeePayer().Empty() {
+				txBldr = txBldr.WithPayer(args[0])
+			}
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCloseChannel implements the close channel command.
+func GetCmdCloseChannel(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "close [closer] [channel-id] [voucher-file]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Close a payment channel",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Close a payment channel, the recipient closes the channel with the latest
+voucher signed by sender to get paid, the sender closes the channel to start the dispute window,
+the deposit will be refunded to sender after the dispute window.
+
+Example:
+$ %s tx paychan close bob 1 voucher.json --from bob
+$ %s tx paychan close alice 1 --from alice
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			closer, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "closer account id error")
+			}
+
+			channelID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("channel-id %s not a valid uint, please input a valid channel-id", args[1])
+			}
+
+			var voucher *types.SignedVoucher
+			if len(args) > 2 {
+				bz, err := ioutil.ReadFile(args[2])
+				if err != nil {
+					return err
+				}
+
+				voucher = &types.SignedVoucher{}
+				if err := cdc.UnmarshalJSON(bz, voucher); err != nil {
+					return sdkerrors.Wrap(err, "voucher parse error")
+				}
+			}
+
+			closerAuth, err := txutil.QueryAccountAuth(cliCtx, closer)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "query account %s auth error", closer)
+			}
+
+			msg := types.NewKuMsgCloseChannel(closerAuth, closer, channelID, voucher)
+			cliCtx = cliCtx.WithFromAccount(closer)
+			if txBldr.FeePayer().Empty() {
+				txBldr = txBldr.WithPayer(args[0])
+			}
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSignVoucher implements the off-chain voucher signing command.
+func GetCmdSignVoucher(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-voucher [key-name] [channel-id] [amount]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Sign a payment channel voucher off-chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign a voucher by the key of the channel sender, the amount is the total coins
+paid to the recipient in the channel, the voucher is signed with the chain-id and can only be
+used in that chain, the voucher is printed and can be sent to the recipient.
+
+Example:
+$ %s tx paychan sign-voucher alice 1 100kuchain/kcs --chain-id testing > voucher.json
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf)
+
+			channelID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("channel-id %s not a valid uint, please input a valid channel-id", args[1])
+			}
+
+			amount, err := chainTypes.ParseCoins(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "amount parse error")
+			}
+
+			if txBldr.ChainID() == "" {
+				return fmt.Errorf("chain ID required but not specified")
+			}
+
+			voucher := types.NewVoucher(channelID, amount)
+			sig, pubKey, err := txBldr.Keybase().Sign(args[0], keys.DefaultKeyPass, voucher.GetSignBytes(txBldr.ChainID()))
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSONIndent(types.NewSignedVoucher(voucher, pubKey, sig), "", "  ")
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", bz)
+			return err
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
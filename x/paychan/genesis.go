@@ -0,0 +1,31 @@
+package paychan
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/paychan/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initialize default parameters and the channels
+func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetNextChannelID(ctx, data.NextChannelID)
+
+	for _, channel := range data.Channels {
+		keeper.SetChannel(ctx, channel)
+		keeper.InsertSettleQueue(ctx, channel.ID, channel.RefundHeight())
+	}
+
+	supplyKeeper.GetModuleAccount(ctx, ModuleName)
+}
+
+// ExportGenesis writes the current store values
+// to a genesis file, which can be imported again
+// with InitGenesis
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	channels := keeper.GetChannels(ctx, types.AccountID{})
+	if channels == nil {
+		channels = types.Channels{}
+	}
+
+	return NewGenesisState(keeper.GetParams(ctx), keeper.GetNextChannelID(ctx), channels)
+}
@@ -0,0 +1,91 @@
+package paychan
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/x/paychan/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewHandler(k Keeper) msg.Handler {
+	return func(ctx chainTypes.Context, msg sdk.Msg) (*sdk.Result, error) {
+		switch msg := msg.(type) {
+		case types.KuMsgOpenChannel:
+			return handleKuMsgOpenChannel(ctx, k, msg)
+		case types.KuMsgCloseChannel:
+			return handleKuMsgCloseChannel(ctx, k, msg)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleKuMsgOpenChannel(ctx chainTypes.Context, k Keeper, msg types.KuMsgOpenChannel) (*sdk.Result, error) {
+	msgData := types.MsgOpenChannel{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg OpenChannel data unmarshal error")
+	}
+
+	// the deposit should be transferred to module account by the msg
+	if !msg.GetTo().Eq(types.ModuleAccountID) || !msg.GetAmount().IsEqual(msgData.Deposit) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidChannelDeposit, "deposit %s not transferred", msgData.Deposit)
+	}
+
+	ctx.RequireAuth(msgData.From)
+
+	channel, err := k.OpenChannel(ctx.Context(), msgData.From, msgData.To, msgData.Deposit, msgData.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msgData.From.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeOpenChannel,
+			sdk.NewAttribute(types.AttributeKeyChannelID, fmt.Sprintf("%d", channel.ID)),
+			sdk.NewAttribute(types.AttributeKeySender, channel.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, channel.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, channel.Deposit.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiration, fmt.Sprintf("%d", channel.Expiration)),
+		),
+	})
+
+	res := types.MsgOpenChannelResponse{ChannelID: channel.ID}
+	return chainTypes.NewMsgResult(Cdc(), res, ctx.EventManager().Events()), nil
+}
+
+func handleKuMsgCloseChannel(ctx chainTypes.Context, k Keeper, msg types.KuMsgCloseChannel) (*sdk.Result, error) {
+	msgData := types.MsgCloseChannel{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return nil, sdkerrors.Wrapf(err, "msg CloseChannel data unmarshal error")
+	}
+
+	ctx.RequireAuth(msgData.Closer)
+
+	channel, err := k.CloseChannel(ctx.Context(), msgData.Closer, msgData.ChannelID, msgData.Voucher)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msgData.Closer.String()),
+		),
+		sdk.NewEvent(
+			types.EventTypeCloseChannel,
+			sdk.NewAttribute(types.AttributeKeyChannelID, fmt.Sprintf("%d", channel.ID)),
+			sdk.NewAttribute(types.AttributeKeySettleHeight, fmt.Sprintf("%d", channel.SettleHeight)),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
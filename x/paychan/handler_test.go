@@ -0,0 +1,156 @@
+package paychan_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/paychan"
+	paychanTypes "github.com/KuChainNetwork/kuchain/x/paychan/types"
+)
+
+var (
+	wallet   = simapp.NewWallet()
+	addr1    = wallet.NewAccAddressByName(name1)
+	addr2    = wallet.NewAccAddressByName(name2)
+	addr3    = wallet.NewAccAddressByName(name3)
+	name1    = types.MustName("sender")
+	name2    = types.MustName("recipient")
+	name3    = types.MustName("other")
+	account1 = types.NewAccountIDFromName(name1)
+	account2 = types.NewAccountIDFromName(name2)
+	account3 = types.NewAccountIDFromName(name3)
+
+	deposit = types.NewCoins(types.NewInt64Coin(constants.DefaultBondDenom, 1000000))
+)
+
+func createAppForTest() (*simapp.SimApp, sdk.Context) {
+	asset := types.Coins{
+		types.NewInt64Coin(constants.DefaultBondDenom, 10000000000)}
+
+	genAccs := simapp.NewGenesisAccounts(wallet.GetRootAuth(),
+		simapp.NewSimGenesisAccount(account1, addr1).WithAsset(asset),
+		simapp.NewSimGenesisAccount(account2, addr2).WithAsset(asset),
+		simapp.NewSimGenesisAccount(account3, addr3).WithAsset(asset),
+	)
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+
+	ctxCheck := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+	return app, ctxCheck
+}
+
+func openChannel(t *testing.T, app *simapp.SimApp, isSuccess bool, sender, recipient types.AccountID, amt types.Coins, timeout int64) error {
+	ctx := app.NewTestContext()
+	auth := app.AccountKeeper().GetAccount(ctx, sender).GetAuth()
+
+	msg := paychanTypes.NewKuMsgOpenChannel(auth, sender, recipient, amt, timeout)
+	tx := simapp.NewTxForTest(sender, []sdk.Msg{msg}, wallet.PrivKey(auth))
+	if !isSuccess {
+		tx = tx.WithCannotPass()
+	}
+
+	return simapp.CheckTxs(t, app, ctx, tx)
+}
+
+func closeChannel(t *testing.T, app *simapp.SimApp, isSuccess bool, closer types.AccountID, channelID uint64, voucher *paychanTypes.SignedVoucher) error {
+	ctx := app.NewTestContext()
+	auth := app.AccountKeeper().GetAccount(ctx, closer).GetAuth()
+
+	msg := paychanTypes.NewKuMsgCloseChannel(auth, closer, channelID, voucher)
+	tx := simapp.NewTxForTest(closer, []sdk.Msg{msg}, wallet.PrivKey(auth))
+	if !isSuccess {
+		tx = tx.WithCannotPass()
+	}
+
+	return simapp.CheckTxs(t, app, ctx, tx)
+}
+
+func signVoucher(app *simapp.SimApp, auth types.AccAddress, channelID uint64, amt types.Coins) *paychanTypes.SignedVoucher {
+	return signVoucherInChain(app.NewTestContext().ChainID(), auth, channelID, amt)
+}
+
+func signVoucherInChain(chainID string, auth types.AccAddress, channelID uint64, amt types.Coins) *paychanTypes.SignedVoucher {
+	priv := wallet.PrivKey(auth)
+	voucher := paychanTypes.NewVoucher(channelID, amt)
+
+	sig, err := priv.Sign(voucher.GetSignBytes(chainID))
+	So(err, ShouldBeNil)
+
+	signed := paychanTypes.NewSignedVoucher(voucher, priv.PubKey(), sig)
+	return &signed
+}
+
+func TestPaychanHandler(t *testing.T) {
+	Convey("test open and close channel by recipient", t, func() {
+		app, _ := createAppForTest()
+
+		So(openChannel(t, app, true, account1, account2, deposit, paychan.DefaultParams().MinTimeout), ShouldBeNil)
+
+		ctx := app.NewTestContext()
+		channel, found := app.PaychanKeeper().GetChannel(ctx, 1)
+		So(found, ShouldBeTrue)
+		So(channel.Sender.Eq(account1), ShouldBeTrue)
+		So(channel.Recipient.Eq(account2), ShouldBeTrue)
+		So(channel.Deposit.IsEqual(deposit), ShouldBeTrue)
+		So(app.PaychanKeeper().GetChannels(ctx, account2), ShouldHaveLength, 1)
+
+		paid := types.NewCoins(types.NewInt64Coin(constants.DefaultBondDenom, 300000))
+
+		// voucher not signed by sender
+		So(closeChannel(t, app, false, account2, 1, signVoucher(app, addr3, 1, paid)),
+			simapp.ShouldErrIs, paychanTypes.ErrVoucherSignature)
+
+		// voucher signed in other chain
+		So(closeChannel(t, app, false, account2, 1, signVoucherInChain("other-chain", addr1, 1, paid)),
+			simapp.ShouldErrIs, paychanTypes.ErrVoucherSignature)
+
+		// voucher more than deposit
+		So(closeChannel(t, app, false, account2, 1, signVoucher(app, addr1, 1, deposit.Add(deposit...))),
+			simapp.ShouldErrIs, paychanTypes.ErrVoucherExceedsDeposit)
+
+		// other account cannot close
+		So(closeChannel(t, app, false, account3, 1, nil),
+			simapp.ShouldErrIs, paychanTypes.ErrInvalidCloser)
+
+		powers1 := app.AssetKeeper().GetCoinPowers(app.NewTestContext(), account1)
+		So(closeChannel(t, app, true, account2, 1, signVoucher(app, addr1, 1, paid)), ShouldBeNil)
+
+		ctx = app.NewTestContext()
+		_, found = app.PaychanKeeper().GetChannel(ctx, 1)
+		So(found, ShouldBeFalse)
+
+		So(app.AssetKeeper().GetCoinPowers(ctx, account2).IsEqual(paid), ShouldBeTrue)
+		So(app.AssetKeeper().GetCoinPowers(ctx, account1).IsEqual(powers1.Add(deposit.Sub(paid)...)), ShouldBeTrue)
+	})
+
+	Convey("test open channel with timeout less than min timeout", t, func() {
+		app, _ := createAppForTest()
+
+		So(openChannel(t, app, false, account1, account2, deposit, paychan.DefaultParams().MinTimeout-1),
+			simapp.ShouldErrIs, paychanTypes.ErrInvalidChannelTimeout)
+	})
+
+	Convey("test close channel by sender and refund after dispute window", t, func() {
+		app, _ := createAppForTest()
+
+		So(openChannel(t, app, true, account1, account2, deposit, paychan.DefaultParams().MinTimeout*2), ShouldBeNil)
+		So(closeChannel(t, app, true, account1, 1, nil), ShouldBeNil)
+		So(closeChannel(t, app, false, account1, 1, nil), simapp.ShouldErrIs, paychanTypes.ErrChannelClosing)
+
+		channel, found := app.PaychanKeeper().GetChannel(app.NewTestContext(), 1)
+		So(found, ShouldBeTrue)
+		So(channel.IsClosing(), ShouldBeTrue)
+
+		simapp.AfterBlockCommitted(app, int(paychan.DefaultParams().DisputeWindow))
+
+		ctx := app.NewTestContext()
+		_, found = app.PaychanKeeper().GetChannel(ctx, 1)
+		So(found, ShouldBeFalse)
+		So(app.AssetKeeper().GetCoinPowers(ctx, account1).IsAllGTE(deposit), ShouldBeTrue)
+	})
+}
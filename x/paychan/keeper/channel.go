@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/paychan/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetChannel get channel from store by channelID
+func (k Keeper) GetChannel(ctx sdk.Context, channelID uint64) (types.Channel, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ChannelKey(channelID))
+	if bz == nil {
+		return types.Channel{}, false
+	}
+
+	var channel types.Channel
+	k.cdc.MustUnmarshalBinaryBare(bz, &channel)
+
+	return channel, true
+}
+
+// SetChannel set a channel to store
+func (k Keeper) SetChannel(ctx sdk.Context, channel types.Channel) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(channel)
+	store.Set(types.ChannelKey(channel.ID), bz)
+}
+
+// DeleteChannel deletes a channel from store
+func (k Keeper) DeleteChannel(ctx sdk.Context, channel types.Channel) {
+	store := ctx.KVStore(k.storeKey)
+	k.RemoveFromSettleQueue(ctx, channel.ID, channel.RefundHeight())
+	store.Delete(types.ChannelKey(channel.ID))
+}
+
+// IterateChannels iterates over the all the channels and performs a callback function
+func (k Keeper) IterateChannels(ctx sdk.Context, cb func(channel types.Channel) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ChannelKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var channel types.Channel
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &channel)
+
+		if cb(channel) {
+			break
+		}
+	}
+}
+
+// GetChannels returns the channels which account is the sender or the recipient, all channels if account is empty
+func (k Keeper) GetChannels(ctx sdk.Context, account types.AccountID) (channels types.Channels) {
+	k.IterateChannels(ctx, func(channel types.Channel) bool {
+		if account.Empty() || channel.Sender.Eq(account) || channel.Recipient.Eq(account) {
+			channels = append(channels, channel)
+		}
+		return false
+	})
+	return
+}
+
+// IterateSettleQueue iterates over the channels which need to be settled before endHeight
+func (k Keeper) IterateSettleQueue(ctx sdk.Context, endHeight int64, cb func(channel types.Channel) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.SettleQueueKeyPrefix, sdk.PrefixEndBytes(types.SettleQueuePrefix(endHeight)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		channelID, _ := types.SplitSettleQueueKey(iterator.Key())
+		channel, found := k.GetChannel(ctx, channelID)
+		if !found {
+			panic(sdkerrors.Wrapf(types.ErrUnknownChannel, "channel %d does not exist", channelID))
+		}
+
+		if cb(channel) {
+			break
+		}
+	}
+}
+
+// InsertSettleQueue inserts a channelID into the settle queue at height
+func (k Keeper) InsertSettleQueue(ctx sdk.Context, channelID uint64, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SettleQueueKey(channelID, height), types.GetChannelIDBytes(channelID))
+}
+
+// RemoveFromSettleQueue removes a channelID from the settle queue
+func (k Keeper) RemoveFromSettleQueue(ctx sdk.Context, channelID uint64, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SettleQueueKey(channelID, height))
+}
+
+// OpenChannel opens a new channel, the deposit should be transferred to module account before
+func (k Keeper) OpenChannel(ctx sdk.Context, sender, recipient types.AccountID, deposit types.Coins, timeout int64) (types.Channel, error) {
+	params := k.GetParams(ctx)
+	if timeout < params.MinTimeout {
+		return types.Channel{}, sdkerrors.Wrapf(types.ErrInvalidChannelTimeout,
+			"timeout %d is less than min timeout %d", timeout, params.MinTimeout)
+	}
+
+	if err := k.supplyKeeper.ModuleCoinsToPower(ctx, types.ModuleName, deposit); err != nil {
+		return types.Channel{}, err
+	}
+
+	channelID := k.GetNextChannelID(ctx)
+	channel := types.NewChannel(channelID, sender, recipient, deposit, ctx.BlockHeight()+timeout)
+
+	k.SetChannel(ctx, channel)
+	k.InsertSettleQueue(ctx, channel.ID, channel.RefundHeight())
+	k.SetNextChannelID(ctx, channelID+1)
+
+	return channel, nil
+}
+
+// CloseChannel closes a channel, if the closer is the recipient, the channel is settled by the voucher,
+// if the closer is the sender, the channel will be refunded to sender after the dispute window.
+func (k Keeper) CloseChannel(ctx sdk.Context, closer types.AccountID, channelID uint64, voucher *types.SignedVoucher) (types.Channel, error) {
+	channel, found := k.GetChannel(ctx, channelID)
+	if !found {
+		return types.Channel{}, sdkerrors.Wrapf(types.ErrUnknownChannel, "channel %d", channelID)
+	}
+
+	switch {
+	case closer.Eq(channel.Recipient):
+		if voucher == nil {
+			// recipient give up the channel
+			return channel, k.SettleChannel(ctx, channel, types.Coins{})
+		}
+
+		if err := k.verifyVoucher(ctx, channel, *voucher); err != nil {
+			return types.Channel{}, err
+		}
+
+		return channel, k.SettleChannel(ctx, channel, voucher.Voucher.Amount)
+
+	case closer.Eq(channel.Sender):
+		if channel.IsClosing() {
+			return types.Channel{}, sdkerrors.Wrapf(types.ErrChannelClosing, "channel %d", channelID)
+		}
+
+		k.RemoveFromSettleQueue(ctx, channel.ID, channel.RefundHeight())
+		channel.SettleHeight = ctx.BlockHeight() + k.GetParams(ctx).DisputeWindow
+		k.SetChannel(ctx, channel)
+		k.InsertSettleQueue(ctx, channel.ID, channel.RefundHeight())
+
+		return channel, nil
+
+	default:
+		return types.Channel{}, sdkerrors.Wrapf(types.ErrInvalidCloser, "%s for channel %d", closer, channelID)
+	}
+}
+
+// SettleChannel pays the coins to recipient, refunds the remaining deposit to sender and deletes the channel
+func (k Keeper) SettleChannel(ctx sdk.Context, channel types.Channel, paid types.Coins) error {
+	refund, hasNeg := channel.Deposit.SafeSub(paid)
+	if hasNeg {
+		return sdkerrors.Wrapf(types.ErrVoucherExceedsDeposit, "%s > %s", paid, channel.Deposit)
+	}
+
+	if !paid.IsZero() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, channel.Recipient, paid); err != nil {
+			return err
+		}
+	}
+
+	if !refund.IsZero() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, channel.Sender, refund); err != nil {
+			return err
+		}
+	}
+
+	k.DeleteChannel(ctx, channel)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettleChannel,
+			sdk.NewAttribute(types.AttributeKeyChannelID, fmt.Sprintf("%d", channel.ID)),
+			sdk.NewAttribute(types.AttributeKeyPaid, paid.String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		),
+	)
+
+	return nil
+}
+
+// verifyVoucher checks the voucher is signed by the auth of channel sender
+func (k Keeper) verifyVoucher(ctx sdk.Context, channel types.Channel, voucher types.SignedVoucher) error {
+	if voucher.Voucher.ChannelID != channel.ID {
+		return sdkerrors.Wrapf(types.ErrInvalidVoucher, "voucher for channel %d", voucher.Voucher.ChannelID)
+	}
+
+	if err := voucher.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := voucher.VerifySignature(ctx.ChainID()); err != nil {
+		return err
+	}
+
+	auth, err := k.getAuth(ctx, channel.Sender)
+	if err != nil {
+		return err
+	}
+
+	if !auth.Equals(voucher.Signer()) {
+		return sdkerrors.Wrapf(types.ErrVoucherSignature, "voucher not signed by %s", channel.Sender)
+	}
+
+	return nil
+}
+
+func (k Keeper) getAuth(ctx sdk.Context, id types.AccountID) (types.AccAddress, error) {
+	if addr, ok := id.ToAccAddress(); ok {
+		return addr, nil
+	}
+
+	name, ok := id.ToName()
+	if !ok {
+		return types.AccAddress{}, sdkerrors.Wrapf(types.ErrInvalidChannelAccount, "account %s", id)
+	}
+
+	return k.accountKeeper.GetAuth(ctx, name)
+}
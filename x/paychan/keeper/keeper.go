@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/params"
+	"github.com/KuChainNetwork/kuchain/x/paychan/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Keeper of the paychan store
+type Keeper struct {
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
+	paramSpace    params.Subspace
+	supplyKeeper  types.SupplyKeeper
+	accountKeeper types.AccountKeeper
+}
+
+// NewKeeper creates a new paychan Keeper instance
+func NewKeeper(
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
+	supplyKeeper types.SupplyKeeper, accountKeeper types.AccountKeeper,
+) Keeper {
+
+	// ensure paychan module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		paramSpace:    paramSpace.WithKeyTable(types.ParamKeyTable()),
+		supplyKeeper:  supplyKeeper,
+		accountKeeper: accountKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of paychan parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of paychan parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetNextChannelID gets the next channel ID
+func (k Keeper) GetNextChannelID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChannelIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return types.GetChannelIDFromBytes(bz)
+}
+
+// SetNextChannelID sets the next channel ID
+func (k Keeper) SetNextChannelID(ctx sdk.Context, channelID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ChannelIDKey, types.GetChannelIDBytes(channelID))
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+type (
+	AccountID  = types.AccountID
+	AccAddress = types.AccAddress
+	KuMsg      = types.KuMsg
+	Name       = types.Name
+	Coins      = types.Coins
+)
+
+var (
+	MustName            = types.MustName
+	NewAccountIDFromStr = types.NewAccountIDFromStr
+)
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Channel is a unidirectional payment channel, the sender locks the deposit in module account,
+// then pays the recipient by off-chain vouchers, the recipient close the channel by the latest voucher.
+type Channel struct {
+	ID           uint64    `json:"id" yaml:"id"`
+	Sender       AccountID `json:"sender" yaml:"sender"`
+	Recipient    AccountID `json:"recipient" yaml:"recipient"`
+	Deposit      Coins     `json:"deposit" yaml:"deposit"`
+	Expiration   int64     `json:"expiration" yaml:"expiration"`       // height at which the deposit can be refunded to sender
+	SettleHeight int64     `json:"settle_height" yaml:"settle_height"` // height at which the channel is settled, zero if no one closed the channel
+}
+
+// NewChannel creates a new Channel instance
+func NewChannel(id uint64, sender, recipient AccountID, deposit Coins, expiration int64) Channel {
+	return Channel{
+		ID:         id,
+		Sender:     sender,
+		Recipient:  recipient,
+		Deposit:    deposit,
+		Expiration: expiration,
+	}
+}
+
+// IsClosing returns if the sender has closed the channel and it is in dispute window
+func (c Channel) IsClosing() bool {
+	return c.SettleHeight != 0
+}
+
+// RefundHeight returns the height at which the channel will be refunded to sender
+func (c Channel) RefundHeight() int64 {
+	if c.IsClosing() && c.SettleHeight < c.Expiration {
+		return c.SettleHeight
+	}
+	return c.Expiration
+}
+
+// String implements stringer interface
+func (c Channel) String() string {
+	return fmt.Sprintf(`Channel %d:
+  Sender:        %s
+  Recipient:     %s
+  Deposit:       %s
+  Expiration:    %d
+  SettleHeight:  %d`,
+		c.ID, c.Sender, c.Recipient, c.Deposit, c.Expiration, c.SettleHeight)
+}
+
+// Channels is an array of channel
+type Channels []Channel
+
+// String implements stringer interface
+func (c Channels) String() string {
+	out := "ID - (Sender -> Recipient) Deposit\n"
+	for _, ch := range c {
+		out += fmt.Sprintf("%d - (%s -> %s) %s\n", ch.ID, ch.Sender, ch.Recipient, ch.Deposit)
+	}
+	return out
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgOpenChannel{}, "paychan/MsgOpenChannel", nil)
+	cdc.RegisterConcrete(KuMsgOpenChannel{}, "paychan/KuMsgOpenChannel", nil)
+	cdc.RegisterConcrete(&MsgCloseChannel{}, "paychan/MsgCloseChannel", nil)
+	cdc.RegisterConcrete(KuMsgCloseChannel{}, "paychan/KuMsgCloseChannel", nil)
+
+	cdc.RegisterConcrete(MsgOpenChannelResponse{}, "paychan/MsgOpenChannelResponse", nil)
+}
+
+var (
+	// ModuleCdc references the global x/paychan module codec.
+	ModuleCdc = codec.New()
+)
+
+// Cdc get codec for types
+func Cdc() *codec.Codec {
+	return ModuleCdc
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
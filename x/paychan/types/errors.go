@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/paychan module sentinel errors
+var (
+	ErrUnknownChannel        = sdkerrors.Register(ModuleName, 2, "unknown payment channel")
+	ErrInvalidChannelAccount = sdkerrors.Register(ModuleName, 3, "invalid payment channel account")
+	ErrInvalidChannelDeposit = sdkerrors.Register(ModuleName, 4, "invalid payment channel deposit")
+	ErrInvalidChannelTimeout = sdkerrors.Register(ModuleName, 5, "invalid payment channel timeout")
+	ErrInvalidVoucher        = sdkerrors.Register(ModuleName, 6, "invalid payment channel voucher")
+	ErrVoucherSignature      = sdkerrors.Register(ModuleName, 7, "voucher signature verification failed")
+	ErrVoucherExceedsDeposit = sdkerrors.Register(ModuleName, 8, "voucher amount exceeds channel deposit")
+	ErrChannelClosing        = sdkerrors.Register(ModuleName, 9, "payment channel is already closing")
+	ErrInvalidCloser         = sdkerrors.Register(ModuleName, 10, "closer is neither sender nor recipient of channel")
+)
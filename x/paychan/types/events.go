@@ -0,0 +1,19 @@
+package types
+
+// paychan module event types
+const (
+	EventTypeOpenChannel   = "open_channel"
+	EventTypeCloseChannel  = "close_channel"
+	EventTypeSettleChannel = "settle_channel"
+
+	AttributeKeyChannelID    = "channel_id"
+	AttributeKeySender       = "sender"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeyDeposit      = "deposit"
+	AttributeKeyPaid         = "paid"
+	AttributeKeyRefund       = "refund"
+	AttributeKeyExpiration   = "expiration"
+	AttributeKeySettleHeight = "settle_height"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GenesisState - all paychan state that must be provided at genesis
+type GenesisState struct {
+	Params        Params   `json:"params" yaml:"params"`
+	NextChannelID uint64   `json:"next_channel_id" yaml:"next_channel_id"`
+	Channels      Channels `json:"channels" yaml:"channels"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, nextChannelID uint64, channels Channels) GenesisState {
+	return GenesisState{
+		Params:        params,
+		NextChannelID: nextChannelID,
+		Channels:      channels,
+	}
+}
+
+// DefaultGenesisState - default GenesisState
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), 1, Channels{})
+}
+
+// ValidateGenesis performs basic validation of paychan genesis data returning an
+// error for any failed validation criteria.
+func (g GenesisState) ValidateGenesis(bz json.RawMessage) error {
+	gs := DefaultGenesisState()
+	if err := Cdc().UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+
+	return ValidateGenesis(gs)
+}
+
+// ValidateGenesis validates the paychan genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, ch := range data.Channels {
+		if ch.ID >= data.NextChannelID {
+			return fmt.Errorf("channel id %d should be less than next channel id %d", ch.ID, data.NextChannelID)
+		}
+
+		if !ch.Deposit.IsValid() {
+			return fmt.Errorf("invalid deposit for channel %d: %s", ch.ID, ch.Deposit)
+		}
+	}
+
+	return nil
+}
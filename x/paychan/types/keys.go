@@ -0,0 +1,76 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "paychan"
+
+	// StoreKey is the store key string for payment channels
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for payment channels
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for payment channels
+	QuerierRoute = ModuleName
+)
+
+// Keys for paychan store
+// Items are stored with the following key: values
+//
+// - 0x01<channelID_Bytes>: Channel
+//
+// - 0x02: nextChannelID
+//
+// - 0x03<settleHeight_Bytes><channelID_Bytes>: channelID
+var (
+	ChannelKeyPrefix     = []byte{0x01}
+	ChannelIDKey         = []byte{0x02}
+	SettleQueueKeyPrefix = []byte{0x03}
+
+	// ModuleAccountID is the account id for module account
+	ModuleAccountID = types.NewAccountIDFromName(types.MustName(ModuleName))
+)
+
+// GetChannelIDBytes returns the byte representation of the channelID
+func GetChannelIDBytes(channelID uint64) (channelIDBz []byte) {
+	channelIDBz = make([]byte, 8)
+	binary.BigEndian.PutUint64(channelIDBz, channelID)
+	return
+}
+
+// GetChannelIDFromBytes returns channelID in uint64 format from a byte array
+func GetChannelIDFromBytes(bz []byte) (channelID uint64) {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// ChannelKey gets a specific channel from the store
+func ChannelKey(channelID uint64) []byte {
+	return append(ChannelKeyPrefix, GetChannelIDBytes(channelID)...)
+}
+
+// SettleQueuePrefix gets the prefix of the channels which will be settled at height
+func SettleQueuePrefix(height int64) []byte {
+	return append(SettleQueueKeyPrefix, GetChannelIDBytes(uint64(height))...)
+}
+
+// SettleQueueKey returns the key for a channelID in the settle queue
+func SettleQueueKey(channelID uint64, height int64) []byte {
+	return append(SettleQueuePrefix(height), GetChannelIDBytes(channelID)...)
+}
+
+// SplitSettleQueueKey split the settle queue key and returns the channel id and the settle height
+func SplitSettleQueueKey(key []byte) (channelID uint64, height int64) {
+	if len(key[1:]) != 16 {
+		panic("unexpected settle queue key length")
+	}
+
+	height = int64(GetChannelIDFromBytes(key[1:9]))
+	channelID = GetChannelIDFromBytes(key[9:])
+	return
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/msg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	RouterKeyName = MustName(RouterKey)
+)
+
+type KuMsgOpenChannel struct {
+	KuMsg
+}
+
+// NewKuMsgOpenChannel creates a msg to open a channel, the deposit will be transferred to module account
+func NewKuMsgOpenChannel(auth sdk.AccAddress, sender, recipient AccountID, deposit Coins, timeout int64) KuMsgOpenChannel {
+	return KuMsgOpenChannel{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithTransfer(sender, ModuleAccountID, deposit),
+			msg.WithData(Cdc(), &MsgOpenChannel{
+				From:    sender,
+				To:      recipient,
+				Deposit: deposit,
+				Timeout: timeout,
+			}),
+		),
+	}
+}
+
+func (msg KuMsgOpenChannel) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgData := MsgOpenChannel{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return err
+	}
+
+	return msgData.ValidateBasic()
+}
+
+type KuMsgCloseChannel struct {
+	KuMsg
+}
+
+// NewKuMsgCloseChannel creates a msg to close a channel
+func NewKuMsgCloseChannel(auth sdk.AccAddress, closer AccountID, channelID uint64, voucher *SignedVoucher) KuMsgCloseChannel {
+	return KuMsgCloseChannel{
+		*msg.MustNewKuMsg(
+			RouterKeyName,
+			msg.WithAuth(auth),
+			msg.WithData(Cdc(), &MsgCloseChannel{
+				Closer:    closer,
+				ChannelID: channelID,
+				Voucher:   voucher,
+			}),
+		),
+	}
+}
+
+func (msg KuMsgCloseChannel) ValidateBasic() error {
+	if err := msg.KuMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgData := MsgCloseChannel{}
+	if err := msg.UnmarshalData(Cdc(), &msgData); err != nil {
+		return err
+	}
+
+	return msgData.ValidateBasic()
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+var _ types.MsgResponse = MsgOpenChannelResponse{}
+
+// MsgOpenChannelResponse is the response of the open channel msg
+type MsgOpenChannelResponse struct {
+	ChannelID uint64 `json:"channel_id" yaml:"channel_id"`
+}
+
+// CreatedID implements types.MsgResponse
+func (r MsgOpenChannelResponse) CreatedID() string {
+	return strconv.FormatUint(r.ChannelID, 10)
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	chainType "github.com/KuChainNetwork/kuchain/chain/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// verify interface at compile time
+var _, _ chainType.KuMsgData = (*MsgOpenChannel)(nil), (*MsgCloseChannel)(nil)
+
+// MsgOpenChannel - struct for open a payment channel with deposit
+type MsgOpenChannel struct {
+	From    AccountID `json:"from" yaml:"from"` // channel sender
+	To      AccountID `json:"to" yaml:"to"`     // channel recipient
+	Deposit Coins     `json:"deposit" yaml:"deposit"`
+	Timeout int64     `json:"timeout" yaml:"timeout"` // blocks from open after which the deposit can be refunded
+}
+
+// NewMsgOpenChannel creates a new MsgOpenChannel instance
+func NewMsgOpenChannel(sender, recipient AccountID, deposit Coins, timeout int64) MsgOpenChannel {
+	return MsgOpenChannel{
+		From:    sender,
+		To:      recipient,
+		Deposit: deposit,
+		Timeout: timeout,
+	}
+}
+
+// nolint
+func (msg MsgOpenChannel) Route() string     { return RouterKey }
+func (msg MsgOpenChannel) Type() Name        { return MustName("open") }
+func (msg MsgOpenChannel) Sender() AccountID { return msg.From }
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgOpenChannel) ValidateBasic() error {
+	if msg.From.Empty() || msg.To.Empty() {
+		return ErrInvalidChannelAccount
+	}
+
+	if msg.From.Eq(msg.To) {
+		return sdkerrors.Wrap(ErrInvalidChannelAccount, "sender and recipient cannot be the same")
+	}
+
+	if !msg.Deposit.IsValid() || msg.Deposit.Empty() {
+		return sdkerrors.Wrap(ErrInvalidChannelDeposit, msg.Deposit.String())
+	}
+
+	if msg.Timeout <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidChannelTimeout, "timeout %d", msg.Timeout)
+	}
+
+	return nil
+}
+
+// MsgCloseChannel - struct for close a payment channel,
+// the recipient closes the channel with the latest voucher to get paid,
+// the sender closes the channel to start the dispute window.
+type MsgCloseChannel struct {
+	Closer    AccountID      `json:"closer" yaml:"closer"`
+	ChannelID uint64         `json:"channel_id" yaml:"channel_id"`
+	Voucher   *SignedVoucher `json:"voucher,omitempty" yaml:"voucher,omitempty"`
+}
+
+// NewMsgCloseChannel creates a new MsgCloseChannel instance
+func NewMsgCloseChannel(closer AccountID, channelID uint64, voucher *SignedVoucher) MsgCloseChannel {
+	return MsgCloseChannel{
+		Closer:    closer,
+		ChannelID: channelID,
+		Voucher:   voucher,
+	}
+}
+
+// nolint
+func (msg MsgCloseChannel) Route() string     { return RouterKey }
+func (msg MsgCloseChannel) Type() Name        { return MustName("close") }
+func (msg MsgCloseChannel) Sender() AccountID { return msg.Closer }
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgCloseChannel) ValidateBasic() error {
+	if msg.Closer.Empty() {
+		return ErrInvalidChannelAccount
+	}
+
+	if msg.Voucher != nil {
+		if msg.Voucher.Voucher.ChannelID != msg.ChannelID {
+			return sdkerrors.Wrapf(ErrInvalidVoucher, "voucher for channel %d", msg.Voucher.Voucher.ChannelID)
+		}
+
+		if err := msg.Voucher.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+
+	params "github.com/KuChainNetwork/kuchain/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace    = ModuleName
+	DefaultDisputeWindow = int64(1000)
+	DefaultMinTimeout    = int64(1000)
+)
+
+// Parameter store keys
+var (
+	KeyDisputeWindow = []byte("DisputeWindow")
+	KeyMinTimeout    = []byte("MinTimeout")
+)
+
+// Params paychan parameters
+type Params struct {
+	DisputeWindow int64 `json:"dispute_window" yaml:"dispute_window"` // blocks the recipient has to submit a voucher after the sender closed a channel
+	MinTimeout    int64 `json:"min_timeout" yaml:"min_timeout"`       // minimum blocks a channel should be opened before it can be refunded
+}
+
+// ParamKeyTable ParamTable for paychan module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(disputeWindow, minTimeout int64) Params {
+	return Params{
+		DisputeWindow: disputeWindow,
+		MinTimeout:    minTimeout,
+	}
+}
+
+// DefaultParams default paychan module parameters
+func DefaultParams() Params {
+	return NewParams(DefaultDisputeWindow, DefaultMinTimeout)
+}
+
+// Validate validate params
+func (p Params) Validate() error {
+	if err := validateDisputeWindow(p.DisputeWindow); err != nil {
+		return err
+	}
+	if err := validateMinTimeout(p.MinTimeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyDisputeWindow, &p.DisputeWindow, validateDisputeWindow),
+		params.NewParamSetPair(KeyMinTimeout, &p.MinTimeout, validateMinTimeout),
+	}
+}
+
+func validateDisputeWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("dispute window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinTimeout(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("min timeout must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+// Query endpoints supported by the paychan querier
+const (
+	QueryParameters = "parameters"
+	QueryChannel    = "channel"
+	QueryChannels   = "channels"
+)
+
+// QueryChannelParams defines the params for the following queries:
+// - 'custom/paychan/channel'
+type QueryChannelParams struct {
+	ChannelID uint64
+}
+
+// NewQueryChannelParams creates a new QueryChannelParams instance
+func NewQueryChannelParams(channelID uint64) QueryChannelParams {
+	return QueryChannelParams{channelID}
+}
+
+// QueryChannelsParams defines the params for the following queries:
+// - 'custom/paychan/channels'
+type QueryChannelsParams struct {
+	Account AccountID // channels which account is the sender or recipient, empty for all channels
+}
+
+// NewQueryChannelsParams creates a new QueryChannelsParams instance
+func NewQueryChannelsParams(account AccountID) QueryChannelsParams {
+	return QueryChannelsParams{account}
+}
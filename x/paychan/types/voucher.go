@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// Voucher is signed by the channel sender off-chain, the amount is the total coins paid to recipient
+type Voucher struct {
+	ChannelID uint64 `json:"channel_id" yaml:"channel_id"`
+	Amount    Coins  `json:"amount" yaml:"amount"`
+}
+
+// NewVoucher creates a new Voucher instance
+func NewVoucher(channelID uint64, amount Coins) Voucher {
+	return Voucher{
+		ChannelID: channelID,
+		Amount:    amount,
+	}
+}
+
+// voucherSignDoc is the voucher with the chain-id, so the voucher cannot be replayed on other chains
+type voucherSignDoc struct {
+	ChainID string  `json:"chain_id" yaml:"chain_id"`
+	Voucher Voucher `json:"voucher" yaml:"voucher"`
+}
+
+// GetSignBytes gets the bytes for the channel sender to sign on in the chain of chainID
+func (v Voucher) GetSignBytes(chainID string) []byte {
+	bz := ModuleCdc.MustMarshalJSON(voucherSignDoc{
+		ChainID: chainID,
+		Voucher: v,
+	})
+	return sdk.MustSortJSON(bz)
+}
+
+// String implements stringer interface
+func (v Voucher) String() string {
+	return fmt.Sprintf("Voucher of channel %d: %s", v.ChannelID, v.Amount)
+}
+
+// SignedVoucher is a voucher with the signature of the channel sender
+type SignedVoucher struct {
+	Voucher   Voucher       `json:"voucher" yaml:"voucher"`
+	PubKey    crypto.PubKey `json:"pub_key" yaml:"pub_key"`
+	Signature []byte        `json:"signature" yaml:"signature"`
+}
+
+// NewSignedVoucher creates a new SignedVoucher instance
+func NewSignedVoucher(voucher Voucher, pubKey crypto.PubKey, sig []byte) SignedVoucher {
+	return SignedVoucher{
+		Voucher:   voucher,
+		PubKey:    pubKey,
+		Signature: sig,
+	}
+}
+
+// ValidateBasic validate voucher, the signature is verified by VerifySignature with the chain-id
+func (v SignedVoucher) ValidateBasic() error {
+	if !v.Voucher.Amount.IsValid() || v.Voucher.Amount.Empty() {
+		return sdkerrors.Wrap(ErrInvalidVoucher, v.Voucher.Amount.String())
+	}
+
+	if v.PubKey == nil || len(v.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVoucher, "no signature")
+	}
+
+	return nil
+}
+
+// VerifySignature verify the signature of the voucher signed in the chain of chainID
+func (v SignedVoucher) VerifySignature(chainID string) error {
+	if !v.PubKey.VerifyBytes(v.Voucher.GetSignBytes(chainID), v.Signature) {
+		return sdkerrors.Wrapf(ErrVoucherSignature, "voucher not signed in chain %s", chainID)
+	}
+
+	return nil
+}
+
+// Signer returns the address of the voucher signer
+func (v SignedVoucher) Signer() AccAddress {
+	return AccAddress(v.PubKey.Address())
+}
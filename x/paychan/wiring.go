@@ -0,0 +1,17 @@
+package paychan
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/paychan/types"
+)
+
+// Inputs the keepers the paychan module depends on
+type Inputs struct {
+	SupplyKeeper  types.SupplyKeeper
+	AccountKeeper types.AccountKeeper
+}
+
+// ProvideKeeper creates the paychan keeper by the store key and params subspace declared to the builder
+func ProvideKeeper(b *wiring.Builder, in Inputs) Keeper {
+	return NewKeeper(b.Codec(), b.KVStoreKey(StoreKey), b.Subspace(DefaultParamspace), in.SupplyKeeper, in.AccountKeeper)
+}
@@ -35,6 +35,13 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 	// That way we avoid needing to read/write the whole array each time
 	previous := k.GetValidatorMissedBlockBitArray(ctx, consAddr, index)
 	missed := !signed
+
+	// the blocks missed in the grace period after unjail don't count toward the next jailing
+	if missed && height <= signInfo.GraceEndHeight {
+		logger.Info(
+			fmt.Sprintf("Absent validator %s at height %d in unjail grace period until %d", consAddr, height, signInfo.GraceEndHeight))
+		missed = false
+	}
 	switch {
 	case !previous && missed:
 		// Array value has changed from not missed to missed, increment counter
@@ -90,6 +97,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 				),
 			)
+			if k.hooks != nil {
+				k.hooks.BeforeValidatorDowntimeSlashed(ctx, validator.GetOperatorAccountID(), k.SlashFractionDowntime(ctx))
+			}
 			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
 			k.sk.Jail(ctx, consAddr)
 
@@ -17,6 +17,7 @@ type Keeper struct {
 	cdc        *codec.Codec
 	sk         types.StakingKeeper
 	paramspace types.ParamSubspace
+	hooks      types.SlashingHooks
 }
 
 // NewKeeper creates a slashing keeper
@@ -29,6 +30,15 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk types.StakingKeeper, param
 	}
 }
 
+// SetHooks sets the slashing hooks
+func (k *Keeper) SetHooks(sh types.SlashingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set slashing hooks twice")
+	}
+	k.hooks = sh
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/test/testutil"
+	"github.com/KuChainNetwork/kuchain/x/slashing/external"
+	"github.com/KuChainNetwork/kuchain/x/slashing/keeper"
+	slashingtestutil "github.com/KuChainNetwork/kuchain/x/slashing/testutil"
+	"github.com/KuChainNetwork/kuchain/x/slashing/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestKeeperWithMockStaking(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stakingKeeper := slashingtestutil.NewMockStakingKeeper(ctrl)
+	paramSpace := slashingtestutil.NewMockParamSubspace(ctrl)
+	paramSpace.EXPECT().WithKeyTable(gomock.Any()).Return(external.ParamsSubspace{})
+
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.NewContext(abci.Header{Height: 10}, key)
+	k := keeper.NewKeeper(types.ModuleCdc, key, stakingKeeper, paramSpace)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	consAddr := sdk.ConsAddress(pubKey.Address())
+
+	// pubkey relation only need the store
+	k.AddPubkey(ctx, pubKey)
+	got, err := k.GetPubkey(ctx, pubKey.Address())
+	require.NoError(t, err)
+	require.Equal(t, pubKey, got)
+
+	// slash and jail are delegated to the staking keeper
+	fraction := sdk.NewDecWithPrec(5, 2)
+	stakingKeeper.EXPECT().Slash(gomock.Any(), consAddr, int64(8), int64(100), fraction).Times(1)
+	k.Slash(ctx, consAddr, fraction, 100, 8)
+
+	stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Times(1)
+	k.Jail(ctx, consAddr)
+
+	require.Len(t, ctx.EventManager().Events(), 2)
+}
@@ -5,8 +5,11 @@ import (
 	"time"
 
 	"github.com/KuChainNetwork/kuchain/chain/constants"
+	"github.com/KuChainNetwork/kuchain/x/params"
+	"github.com/KuChainNetwork/kuchain/x/slashing/types"
 	"github.com/KuChainNetwork/kuchain/x/staking"
 	"github.com/KuChainNetwork/kuchain/x/staking/exported"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -219,4 +222,56 @@ func TestKeeper(t *testing.T) {
 		require.Equal(t, exported.Unbonding, validator.Status)
 	})
 
+	Convey("TestHandleUnjailGracePeriod", t, func() {
+		addAlice, _, _, accAlice, _, _, app := NewTestApp(wallet)
+		keeper := app.SlashKeeper()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		pk, _ := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, "kuchainvalconspub1zcjduepqn4usdx22zdntysj7n795xj77wrc62sytheeevr7zlna4yhwppdrs8mpds3")
+		rightRate, _ := sdk.NewDecFromStr("0.65")
+		err := CreateValidator(t, wallet, app, addAlice, accAlice, rightRate, pk, true)
+		So(err, ShouldBeNil)
+
+		consAddr := sdk.ConsAddress(pk.Address())
+		height := keeper.SignedBlocksWindow(ctx) + 1
+		ctx = app.BaseApp.NewContext(true, abci.Header{Height: height})
+
+		// the validator is in the grace period after unjail for 10 blocks
+		info := types.NewValidatorSigningInfo(consAddr, height, 0, time.Unix(0, 0).UTC(), false, 0)
+		info.GraceEndHeight = height + 9
+		keeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+		for ; height <= info.GraceEndHeight; height++ {
+			ctx = app.BaseApp.NewContext(true, abci.Header{Height: height})
+			keeper.HandleValidatorSignature(ctx, pk.Address(), 100, false)
+		}
+
+		// the blocks missed in the grace period don't count
+		info, _ = keeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.Equal(t, int64(10), info.IndexOffset)
+		require.Equal(t, int64(0), info.MissedBlocksCounter)
+
+		// the blocks missed after the grace period count again
+		ctx = app.BaseApp.NewContext(true, abci.Header{Height: height})
+		keeper.HandleValidatorSignature(ctx, pk.Address(), 100, false)
+
+		info, _ = keeper.GetValidatorSigningInfo(ctx, consAddr)
+		require.Equal(t, int64(1), info.MissedBlocksCounter)
+	})
+}
+
+func TestParamsMissingUnjailGracePeriod(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestParamsMissingUnjailGracePeriod", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.SlashKeeper()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		// the chains started before the grace period added have no such param in store
+		store := prefix.NewStore(ctx.KVStore(app.GetKey(params.StoreKey)), []byte(types.ModuleName+"/"))
+		store.Delete(types.KeyUnjailGracePeriod)
+
+		So(keeper.UnjailGracePeriod(ctx), ShouldEqual, types.DefaultUnjailGracePeriod)
+		So(keeper.GetParams(ctx).UnjailGracePeriod, ShouldEqual, types.DefaultUnjailGracePeriod)
+	})
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"time"
 
 	"github.com/KuChainNetwork/kuchain/x/slashing/types"
@@ -42,9 +43,24 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// UnjailGracePeriod - blocks after unjail in which the missed blocks don't count toward the next jailing,
+// the chains started before the grace period added use the default value.
+func (k Keeper) UnjailGracePeriod(ctx sdk.Context) int64 {
+	res := types.DefaultUnjailGracePeriod
+	k.paramspace.GetIfExists(ctx, types.KeyUnjailGracePeriod, &res)
+	return res
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramspace.GetParamSet(ctx, &params)
+	params = types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyUnjailGracePeriod) {
+			k.paramspace.GetIfExists(ctx, pair.Key, pair.Value)
+			continue
+		}
+		k.paramspace.Get(ctx, pair.Key, pair.Value)
+	}
 	return params
 }
 
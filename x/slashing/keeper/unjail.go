@@ -36,5 +36,12 @@ func (k Keeper) Unjail(ctx sdk.Context, valAccountID types.AccountID) error {
 	}
 
 	k.sk.Unjail(ctx, consAddr)
+
+	// the validator still catching up is not jailed again for the blocks missed in the grace period
+	if grace := k.UnjailGracePeriod(ctx); grace > 0 {
+		info.GraceEndHeight = ctx.BlockHeight() + grace
+		k.SetValidatorSigningInfo(ctx, consAddr, info)
+	}
+
 	return nil
 }
@@ -22,6 +22,7 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+	UnjailGracePeriod       = "unjail_grace_period"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -49,6 +50,11 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenUnjailGracePeriod randomized UnjailGracePeriod
+func GenUnjailGracePeriod(r *rand.Rand) int64 {
+	return int64(r.Intn(100))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -81,9 +87,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var unjailGracePeriod int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, UnjailGracePeriod, &unjailGracePeriod, simState.Rand,
+		func(r *rand.Rand) { unjailGracePeriod = GenUnjailGracePeriod(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, unjailGracePeriod,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, nil, nil)
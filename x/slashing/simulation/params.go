@@ -14,6 +14,7 @@ const (
 	keySignedBlocksWindow    = "SignedBlocksWindow"
 	keyMinSignedPerWindow    = "MinSignedPerWindow"
 	keySlashFractionDowntime = "SlashFractionDowntime"
+	keyUnjailGracePeriod     = "UnjailGracePeriod"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -35,5 +36,10 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenSlashFractionDowntime(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyUnjailGracePeriod,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenUnjailGracePeriod(r))
+			},
+		),
 	}
 }
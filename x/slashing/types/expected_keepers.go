@@ -2,6 +2,8 @@
 // DONTCOVER
 package types
 
+//go:generate mockgen -package testutil -destination ../testutil/expected_keepers_mocks.go . AccountKeeper,BankKeeper,ParamSubspace,StakingKeeper
+
 import (
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/account/exported"
@@ -27,6 +29,7 @@ type BankKeeper interface {
 type ParamSubspace interface {
 	WithKeyTable(table external.ParamsKeyTable) external.ParamsSubspace
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
 	GetParamSet(ctx sdk.Context, ps external.ParamSet)
 	SetParamSet(ctx sdk.Context, ps external.ParamSet)
 }
@@ -53,6 +56,11 @@ type StakingKeeper interface {
 	MaxValidators(sdk.Context) uint32
 }
 
+// SlashingHooks event hooks for the slashes of validators (noalias)
+type SlashingHooks interface {
+	BeforeValidatorDowntimeSlashed(ctx sdk.Context, valAddr AccountID, fraction sdk.Dec) // Must be called before a validator is slashed for downtime
+}
+
 // StakingHooks event hooks for staking validator object (noalias)
 type StakingHooks interface {
 	AfterValidatorCreated(ctx sdk.Context, valAddr AccountID)                           // Must be called when a validator is created
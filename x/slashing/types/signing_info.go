@@ -21,6 +21,8 @@ type ValidatorSigningInfo struct {
 	Tombstoned bool `json:"tombstoned,omitempty"`
 	// missed blocks counter (to avoid scanning the array every time)
 	MissedBlocksCounter int64 `json:"missed_blocks_counter,omitempty" yaml:"missed_blocks_counter"`
+	// height until which the missed blocks don't count, after the validator was unjailed
+	GraceEndHeight int64 `json:"grace_end_height,omitempty" yaml:"grace_end_height"`
 }
 
 // NewValidatorSigningInfo creates a new ValidatorSigningInfo instance
@@ -47,9 +49,10 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Grace End Height:      %d`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.GraceEndHeight)
 }
 
 // unmarshal a validator signing info from a store value
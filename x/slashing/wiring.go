@@ -0,0 +1,16 @@
+package slashing
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/slashing/types"
+)
+
+// Inputs the keepers the slashing module depends on
+type Inputs struct {
+	StakingKeeper types.StakingKeeper
+}
+
+// ProvideKeeper creates the slashing keeper by the store key and params subspace declared to the builder
+func ProvideKeeper(b *wiring.Builder, in Inputs) Keeper {
+	return NewKeeper(b.Codec(), b.KVStoreKey(StoreKey), in.StakingKeeper, b.Subspace(DefaultParamspace))
+}
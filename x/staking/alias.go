@@ -35,6 +35,8 @@ const (
 	QueryPool                          = types.QueryPool
 	QueryParameters                    = types.QueryParameters
 	QueryHistoricalInfo                = types.QueryHistoricalInfo
+	QueryAdmittedValidators            = types.QueryAdmittedValidators
+	ProposalTypeValidatorAdmission     = types.ProposalTypeValidatorAdmission
 	MaxMonikerLength                   = types.MaxMonikerLength
 	MaxIdentityLength                  = types.MaxIdentityLength
 	MaxWebsiteLength                   = types.MaxWebsiteLength
@@ -50,6 +52,7 @@ var (
 	NonNegativePowerInvariant          = keeper.NonNegativePowerInvariant
 	PositiveDelegationInvariant        = keeper.PositiveDelegationInvariant
 	DelegatorSharesInvariant           = keeper.DelegatorSharesInvariant
+	DelegationReferencesInvariant      = keeper.DelegationReferencesInvariant
 	NewKeeper                          = keeper.NewKeeper
 	ParamKeyTable                      = keeper.ParamKeyTable
 	NewQuerier                         = keeper.NewQuerier
@@ -125,6 +128,10 @@ var (
 	ErrNoHistoricalInfo                = types.ErrNoHistoricalInfo
 	ErrEmptyValidatorPubKey            = types.ErrEmptyValidatorPubKey
 	ErrUnKnowAccount                   = types.ErrUnKnowAccount
+	ErrValidatorNotAdmitted            = types.ErrValidatorNotAdmitted
+	ErrValidatorAlreadyAdmitted        = types.ErrValidatorAlreadyAdmitted
+	ErrNotPermissioned                 = types.ErrNotPermissioned
+	NewValidatorAdmissionProposal      = types.NewValidatorAdmissionProposal
 	NewGenesisState                    = types.NewGenesisState
 	DefaultGenesisState                = types.DefaultGenesisState
 	NewMultiStakingHooks               = types.NewMultiStakingHooks
@@ -242,8 +249,66 @@ type (
 	Description               = types.Description
 	DelegationI               = exported.DelegationI
 	ValidatorI                = exported.ValidatorI
+
+	ValidatorAdmissionProposal = types.ValidatorAdmissionProposal
 )
 
 var (
 	Cdc = types.Cdc
 )
+
+const (
+	QueryValidatorSetChanges    = types.QueryValidatorSetChanges
+	EventTypeValidatorSetChange = types.EventTypeValidatorSetChange
+	SetChangeReasonJoined       = types.SetChangeReasonJoined
+	SetChangeReasonLeft         = types.SetChangeReasonLeft
+	SetChangeReasonJailed       = types.SetChangeReasonJailed
+	SetChangeReasonTombstoned   = types.SetChangeReasonTombstoned
+	SetChangeReasonPowerChanged = types.SetChangeReasonPowerChanged
+)
+
+var (
+	NewValidatorSetChange             = types.NewValidatorSetChange
+	NewQueryValidatorSetChangesParams = types.NewQueryValidatorSetChangesParams
+	GetValidatorSetChangeKey          = types.GetValidatorSetChangeKey
+	ValidatorSetChangeKey             = types.ValidatorSetChangeKey
+)
+
+type (
+	ValidatorSetChange             = types.ValidatorSetChange
+	ValidatorSetChanges            = types.ValidatorSetChanges
+	QueryValidatorSetChangesParams = types.QueryValidatorSetChangesParams
+)
+
+const (
+	EventTypeDelegationCapReached = types.EventTypeDelegationCapReached
+)
+
+var (
+	DefaultMaxDelegationRate = types.DefaultMaxDelegationRate
+	KeyMaxDelegationRate     = types.KeyMaxDelegationRate
+	ValidateDelegationCap    = types.ValidateDelegationCap
+	ErrDelegationCapExceeded = types.ErrDelegationCapExceeded
+	ErrInvalidDelegationCap  = types.ErrInvalidDelegationCap
+)
+
+var (
+	ErrDelegationNotTransferable = types.ErrDelegationNotTransferable
+)
+
+const (
+	QueryUnbondingQueue = types.QueryUnbondingQueue
+)
+
+var (
+	NewUnbondingQueueSummary        = types.NewUnbondingQueueSummary
+	NewQueryUnbondingQueueParams    = types.NewQueryUnbondingQueueParams
+	ParseUnbondingDelegationTimeKey = types.ParseUnbondingDelegationTimeKey
+)
+
+type (
+	UnbondingQueueBucket      = types.UnbondingQueueBucket
+	UnbondingQueueValidator   = types.UnbondingQueueValidator
+	UnbondingQueueSummary     = types.UnbondingQueueSummary
+	QueryUnbondingQueueParams = types.QueryUnbondingQueueParams
+)
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/completion"
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+)
+
+// completeValidators completes the operator accounts of the validators by a light query
+func completeValidators(cdc *codec.Codec) completion.ArgCompleter {
+	return func(_ *cobra.Command, _ string) []string {
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+		resKVs, _, err := cliCtx.QuerySubspace(types.ValidatorsKey, types.StoreKey)
+		if err != nil {
+			return nil
+		}
+
+		validators := make([]string, 0, len(resKVs))
+		for _, kv := range resKVs {
+			validator, err := types.UnmarshalValidator(types.Cdc(), kv.Value)
+			if err != nil {
+				return nil
+			}
+
+			validators = append(validators, fmt.Sprintf("%s\t%s", validator.OperatorAccount, validator.Description.Moniker))
+			if len(validators) >= completion.MaxCandidates {
+				break
+			}
+		}
+
+		return validators
+	}
+}
@@ -24,6 +24,12 @@ const (
 
 	FlagCommissionRate = "commission-rate"
 
+	FlagMaxDelegation     = "max-delegation"
+	FlagMaxDelegationRate = "max-delegation-rate"
+
+	FlagWithin     = "within"
+	FlagBucketSize = "bucket-size"
+
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
@@ -39,6 +45,7 @@ var (
 	fsCommissionUpdate  = flag.NewFlagSet("", flag.ContinueOnError)
 	FsMinSelfDelegation = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDescriptionEdit   = flag.NewFlagSet("", flag.ContinueOnError)
+	fsDelegationCap     = flag.NewFlagSet("", flag.ContinueOnError)
 	fsValidator         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsRedelegation      = flag.NewFlagSet("", flag.ContinueOnError)
 )
@@ -60,6 +67,8 @@ func init() {
 	fsDescriptionEdit.String(FlagWebsite, types.DoNotModifyDesc, "The validator's (optional) website")
 	fsDescriptionEdit.String(FlagSecurityContact, types.DoNotModifyDesc, "The validator's (optional) security contact email")
 	fsDescriptionEdit.String(FlagDetails, types.DoNotModifyDesc, "The validator's (optional) details")
+	fsDelegationCap.String(FlagMaxDelegation, "", "The max tokens delegated to the validator, 0 for no limit")
+	fsDelegationCap.String(FlagMaxDelegationRate, "", "The max rate of the total stake delegated to the validator, 0 for no limit")
 	fsValidator.String(FlagAddressValidator, "", "The Bech32 address of the validator")
 	fsRedelegation.String(FlagAddressValidatorSrc, "", "The Bech32 address of the source validator")
 	fsRedelegation.String(FlagAddressValidatorDst, "", "The Bech32 address of the destination validator")
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	govCli "github.com/KuChainNetwork/kuchain/x/gov/client/cli"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// GetCmdSubmitValidatorAdmissionProposal implements a command handler for submitting a validator admission proposal transaction.
+func GetCmdSubmitValidatorAdmissionProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-admission [proposer] [validator] [admit]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to admit or remove a validator in permissioned mode",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to admit a validator to or remove it from the validator set,
+only valid if the chain started in permissioned mode. The removed validator will be unbonded
+at the end of the block, but its delegations are kept.
+
+Example:
+$ %s tx kugov submit-proposal validator-admission jack validator true --title="Admit validator" --description="admit the validator" --deposit="1000kuchain/kcs" --from=<key>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+			cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+			proposer, err := chainTypes.NewAccountIDFromStr(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposer account id error")
+			}
+
+			validator, err := chainTypes.NewAccountIDFromStr(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "validator account id error")
+			}
+
+			admit, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "admit parse error")
+			}
+
+			deposit, err := chainTypes.ParseCoins(viper.GetString(govCli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			content := types.NewValidatorAdmissionProposal(
+				viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription), validator, admit)
+
+			msg := govTypes.NewKuMsgSubmitProposal(cliCtx.GetFromAddress(), content, deposit, proposer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx = cliCtx.WithFromAccount(proposer)
+			return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govCli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govCli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
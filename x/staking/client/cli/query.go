@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
+	"github.com/KuChainNetwork/kuchain/chain/client/completion"
 	"github.com/KuChainNetwork/kuchain/chain/client/flags"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
 	"github.com/KuChainNetwork/kuchain/x/staking/types"
@@ -39,6 +42,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorRedelegations(queryRoute, cdc),
 		GetCmdQueryHistoricalInfo(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdQueryAdmittedValidators(queryRoute, cdc),
+		GetCmdQueryValidatorSetChanges(queryRoute, cdc),
+		GetCmdQueryUnbondingQueue(queryRoute, cdc),
 		GetCmdQueryPool(queryRoute, cdc))...)
 
 	return stakingQueryCmd
@@ -59,7 +65,8 @@ $ %s query kustaking validator jack
 				version.ClientName,
 			),
 		),
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Args(completeValidators(cdc)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -139,7 +146,8 @@ $ %s query kustaking unbonding-delegations-from jack
 				version.ClientName,
 			),
 		),
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Args(completeValidators(cdc)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -181,7 +189,8 @@ $ %s query kustaking redelegations-from jack
 				version.ClientName,
 			),
 		),
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Args(completeValidators(cdc)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -225,7 +234,8 @@ $ %s query kustaking delegation alice jack
 				version.ClientName,
 			),
 		),
-		Args: cobra.ExactArgs(2),
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completion.Args(nil, completeValidators(cdc)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -320,7 +330,8 @@ $ %s query kustaking delegations-to jack
 				version.ClientName,
 			),
 		),
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Args(completeValidators(cdc)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -365,7 +376,8 @@ $ %s query kustaking unbonding-delegation alice jack
 				version.ClientName,
 			),
 		),
-		Args: cobra.ExactArgs(2),
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completion.Args(nil, completeValidators(cdc)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -460,7 +472,8 @@ $ %s query kustaking redelegation alice  jack validator
 				version.ClientName,
 			),
 		),
-		Args: cobra.ExactArgs(3),
+		Args:              cobra.ExactArgs(3),
+		ValidArgsFunction: completion.Args(nil, completeValidators(cdc), completeValidators(cdc)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -652,3 +665,136 @@ $ %s query kustaking params
 		},
 	}
 }
+
+// GetCmdQueryAdmittedValidators implements the admitted validators query command.
+func GetCmdQueryAdmittedValidators(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "admitted-validators",
+		Args:  cobra.NoArgs,
+		Short: "Query the validators admitted by governance in permissioned mode",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query if the validator set is permissioned and the validators admitted by governance.
+
+Example:
+$ %s query kustaking admitted-validators
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryAdmittedValidators)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var res types.QueryAdmittedValidatorsResponse
+			cdc.MustUnmarshalJSON(bz, &res)
+			return cliCtx.PrintOutput(res)
+		},
+	}
+}
+
+// GetCmdQueryValidatorSetChanges implements the validator set changes query command.
+func GetCmdQueryValidatorSetChanges(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-changes [from-height] [to-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the changes of the active validator set in a height range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the changes of the active validator set with the reasons in a height range,
+the reason is one of joined, left, jailed, tombstoned and power_changed.
+
+Example:
+$ %s query kustaking set-changes 100 200
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			fromHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || fromHeight < 0 {
+				return fmt.Errorf("from-height argument provided must be a non-negative-integer: %v", err)
+			}
+
+			toHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil || toHeight < fromHeight {
+				return fmt.Errorf("to-height argument provided must be an integer not less than from-height: %v", err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryValidatorSetChangesParams(fromHeight, toHeight))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryValidatorSetChanges)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var changes types.ValidatorSetChanges
+			if err := cdc.UnmarshalJSON(res, &changes); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(changes)
+		},
+	}
+}
+
+// GetCmdQueryUnbondingQueue implements the unbonding queue summary query command.
+func GetCmdQueryUnbondingQueue(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-queue",
+		Args:  cobra.NoArgs,
+		Short: "Query the summary of the stake to complete unbonding in the upcoming duration",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total stake to complete unbonding within the duration from the latest block time,
+summarized by the completion time buckets and by the validators.
+
+Example:
+$ %s query kustaking unbonding-queue --within 24h
+$ %s query kustaking unbonding-queue --within 168h --bucket-size 24h
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			within := viper.GetDuration(FlagWithin)
+			bucketSize := viper.GetDuration(FlagBucketSize)
+			if within <= 0 || bucketSize <= 0 {
+				return fmt.Errorf("--%s and --%s should be positive durations", FlagWithin, FlagBucketSize)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryUnbondingQueueParams(within, bucketSize))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryUnbondingQueue)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var summary types.UnbondingQueueSummary
+			if err := cdc.UnmarshalJSON(res, &summary); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(summary)
+		},
+	}
+
+	cmd.Flags().Duration(FlagWithin, 24*time.Hour, "the duration from the latest block time to summarize")
+	cmd.Flags().Duration(FlagBucketSize, time.Hour, "the size of the completion time buckets")
+
+	return cmd
+}
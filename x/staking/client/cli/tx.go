@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/KuChainNetwork/kuchain/chain/client/completion"
 	"github.com/KuChainNetwork/kuchain/chain/client/flags"
 	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
 	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
@@ -128,12 +129,18 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 
 				newRate = &rate
 			}
+
+			maxDelegation, maxDelegationRate, err := parseDelegationCapFlags()
+			if err != nil {
+				return err
+			}
+
 			valAccAddress, err := txutil.QueryAccountAuth(cliCtx, valAccount)
 			if err != nil {
 				return sdkerrors.Wrapf(err, "query account %s auth error", valAccount)
 			}
 
-			msg := types.NewKuMsgEditValidator(valAccAddress, valAccount, description, newRate)
+			msg := types.NewKuMsgEditValidator(valAccAddress, valAccount, description, newRate, maxDelegation, maxDelegationRate)
 			cliCtx = cliCtx.WithFromAccount(valAccount)
 			if txBldr.FeePayer().Empty() {
 				txBldr = txBldr.WithPayer(args[0])
@@ -146,10 +153,34 @@ func GetCmdEditValidator(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(fsDescriptionEdit)
 	cmd.Flags().AddFlagSet(fsCommissionUpdate)
 	cmd.Flags().AddFlagSet(FsMinSelfDelegation)
+	cmd.Flags().AddFlagSet(fsDelegationCap)
 
 	return cmd
 }
 
+// parseDelegationCapFlags parses the max delegation flags, the value not set is nil
+func parseDelegationCapFlags() (maxDelegation *sdk.Int, maxDelegationRate *sdk.Dec, err error) {
+	if amtStr := viper.GetString(FlagMaxDelegation); amtStr != "" {
+		amt, ok := sdk.NewIntFromString(amtStr)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid max delegation: %s", amtStr)
+		}
+
+		maxDelegation = &amt
+	}
+
+	if rateStr := viper.GetString(FlagMaxDelegationRate); rateStr != "" {
+		rate, err := sdk.NewDecFromStr(rateStr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid max delegation rate: %v", err)
+		}
+
+		maxDelegationRate = &rate
+	}
+
+	return maxDelegation, maxDelegationRate, nil
+}
+
 // GetCmdDelegate implements the delegate command.
 func GetCmdDelegate(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -165,6 +196,7 @@ $ %s tx kustaking delegate jack validator 1000stake --from jack
 				version.ClientName,
 			),
 		),
+		ValidArgsFunction: completion.Args(nil, completeValidators(cdc)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
@@ -214,6 +246,7 @@ $ %s tx kustaking redelegate jack validator1 validator2 100stake --from jack
 				version.ClientName,
 			),
 		),
+		ValidArgsFunction: completion.Args(nil, completeValidators(cdc), completeValidators(cdc)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
@@ -267,6 +300,7 @@ $ %s tx kustaking unbond jack validator 100stake --from jack
 				version.ClientName,
 			),
 		),
+		ValidArgsFunction: completion.Args(nil, completeValidators(cdc)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
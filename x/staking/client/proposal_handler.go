@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/gov/client"
+	"github.com/KuChainNetwork/kuchain/x/staking/client/cli"
+	"github.com/KuChainNetwork/kuchain/x/staking/client/rest"
+)
+
+// ValidatorAdmissionProposalHandler the validator admission proposal handler for permissioned mode
+var ValidatorAdmissionProposalHandler = client.NewProposalHandler(cli.GetCmdSubmitValidatorAdmissionProposal, rest.ValidatorAdmissionProposalRESTHandler)
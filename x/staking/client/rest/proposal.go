@@ -0,0 +1,57 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	govRest "github.com/KuChainNetwork/kuchain/x/gov/client/rest"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorAdmissionProposalReq defines a validator admission proposal request body.
+type ValidatorAdmissionProposalReq struct {
+	BaseReq chainTypes.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title              string               `json:"title" yaml:"title"`
+	Description        string               `json:"description" yaml:"description"`
+	Validator          chainTypes.AccountID `json:"validator" yaml:"validator"`
+	Admit              bool                 `json:"admit" yaml:"admit"`
+	Proposer           chainTypes.AccountID `json:"proposer" yaml:"proposer"`
+	Deposit            chainTypes.Coins     `json:"deposit" yaml:"deposit"`
+	ProposerAccAddress sdk.AccAddress       `json:"proposer_accaddress" yaml:"proposer_accaddress"`
+}
+
+// ValidatorAdmissionProposalRESTHandler returns a ProposalRESTHandler that exposes the validator admission REST handler with a given sub-route.
+func ValidatorAdmissionProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "validator_admission",
+		Handler:  postValidatorAdmissionProposalHandlerFn(cliCtx),
+	}
+}
+
+func postValidatorAdmissionProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ValidatorAdmissionProposalReq
+		if !chainTypes.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewValidatorAdmissionProposal(req.Title, req.Description, req.Validator, req.Admit)
+		msg := govTypes.NewKuMsgSubmitProposal(req.ProposerAccAddress, content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			chainTypes.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txutil.WriteGenerateStdTxResponse(w, txutil.NewKuCLICtx(cliCtx), req.BaseReq, []sdk.Msg{msg})
+	}
+}
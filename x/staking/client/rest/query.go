@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -106,6 +107,24 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		paramsHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Get the validators admitted in permissioned mode
+	r.HandleFunc(
+		"/staking/admitted_validators",
+		admittedValidatorsHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// Get the validator set changes in a height range
+	r.HandleFunc(
+		"/staking/validator_set_changes",
+		validatorSetChangesHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// Get the summary of the stake to complete unbonding in the upcoming duration
+	r.HandleFunc(
+		"/staking/unbonding_queue",
+		unbondingQueueHandlerFn(cliCtx),
+	).Methods("GET")
+
 }
 
 // HTTP request handler to query a delegator delegations
@@ -391,3 +410,99 @@ func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to query the validators admitted in permissioned mode
+func admittedValidatorsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAdmittedValidators), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the validator set changes in a height range
+func validatorSetChangesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		fromHeight, err := strconv.ParseInt(r.FormValue("from_height"), 10, 64)
+		if err != nil || fromHeight < 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Must provide non-negative integer for from_height: %v", err))
+			return
+		}
+
+		toHeight, err := strconv.ParseInt(r.FormValue("to_height"), 10, 64)
+		if err != nil || toHeight < fromHeight {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Must provide integer not less than from_height for to_height: %v", err))
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSetChangesParams(fromHeight, toHeight))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetChanges)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the summary of the unbonding queue,
+// the within and bucket_size are durations like 24h, default to 24h and 1h.
+func unbondingQueueHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		within, bucketSize := 24*time.Hour, time.Hour
+
+		var err error
+		if v := r.FormValue("within"); v != "" {
+			if within, err = time.ParseDuration(v); err != nil || within <= 0 {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Must provide positive duration for within: %v", err))
+				return
+			}
+		}
+
+		if v := r.FormValue("bucket_size"); v != "" {
+			if bucketSize, err = time.ParseDuration(v); err != nil || bucketSize <= 0 {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Must provide positive duration for bucket_size: %v", err))
+				return
+			}
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryUnbondingQueueParams(within, bucketSize))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUnbondingQueue)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
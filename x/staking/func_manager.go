@@ -75,6 +75,48 @@ func (FuncManager) MsgDelegateWithBalance(m sdk.Msg,
 	return nil
 }
 
+// GetGenTxValidatorInfo get the validator info from the create-validator and delegate msgs of gentx
+func (FuncManager) GetGenTxValidatorInfo(msgs []sdk.Msg) (genutiltypes.GenTxValidatorInfo, error) {
+	res := genutiltypes.GenTxValidatorInfo{}
+	if len(msgs) != 2 {
+		return res, fmt.Errorf("gentx should contain a create-validator msg and a delegate msg")
+	}
+
+	createMsg, ok := msgs[0].(stakingtypes.KuMsgCreateValidator)
+	if !ok {
+		return res, fmt.Errorf("the first msg of gentx should be create-validator")
+	}
+
+	delegateMsg, ok := msgs[1].(stakingtypes.KuMsgDelegate)
+	if !ok {
+		return res, fmt.Errorf("the second msg of gentx should be delegate")
+	}
+
+	createData := types.MsgCreateValidator{}
+	if err := createMsg.UnmarshalData(Cdc(), &createData); err != nil {
+		return res, sdkerrors.Wrapf(err, "msg CreateValidator data unmarshal error")
+	}
+
+	delegateData := types.MsgDelegate{}
+	if err := delegateMsg.UnmarshalData(Cdc(), &delegateData); err != nil {
+		return res, sdkerrors.Wrapf(err, "msg Delegate data unmarshal error")
+	}
+
+	if !delegateData.ValidatorAccount.Eq(createData.ValidatorAccount) {
+		return res, fmt.Errorf("gentx delegate to %s, but create validator %s",
+			delegateData.ValidatorAccount, createData.ValidatorAccount)
+	}
+
+	res.Validator = createData.ValidatorAccount
+	res.Delegator = delegateData.DelegatorAccount
+	res.Moniker = createData.Description.Moniker
+	res.ConsensusPubkey = createData.Pubkey
+	res.Commission = createData.CommissionRates
+	res.SelfDelegation = delegateData.Amount
+
+	return res, nil
+}
+
 // GetBondDenom
 func (FuncManager) GetBondDenom(appGenesisState map[string]json.RawMessage) string {
 	var stakingData stakingtypes.GenesisState
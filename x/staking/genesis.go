@@ -34,6 +34,11 @@ func InitGenesis(
 	keeper.SetParams(ctx, data.Params)
 	keeper.SetLastTotalPower(ctx, data.LastTotalPower)
 
+	keeper.SetPermissioned(ctx, data.Permissioned)
+	for _, val := range data.AdmittedValidators {
+		keeper.AdmitValidator(ctx, val)
+	}
+
 	for _, validator := range data.Validators {
 		keeper.SetValidator(ctx, validator)
 
@@ -166,6 +171,11 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		return false
 	})
 
+	var admittedValidators []chainTypes.AccountID
+	if keeper.IsPermissioned(ctx) {
+		admittedValidators = keeper.GetAdmittedValidators(ctx)
+	}
+
 	return types.GenesisState{
 		Params:               params,
 		LastTotalPower:       lastTotalPower,
@@ -175,6 +185,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+		Permissioned:         keeper.IsPermissioned(ctx),
+		AdmittedValidators:   admittedValidators,
 	}
 }
 
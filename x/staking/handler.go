@@ -97,6 +97,10 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 		return nil, ErrUnKnowAccount
 	}
 
+	if !k.IsValidatorAdmitted(ctx, msg.ValidatorAccount) {
+		return nil, ErrValidatorNotAdmitted
+	}
+
 	pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, msg.Pubkey)
 	if err != nil {
 		return nil, err
@@ -150,7 +154,8 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 		),
 	})
 
-	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+	res := types.MsgCreateValidatorResponse{Validator: validator.OperatorAccount}
+	return chainTypes.NewMsgResult(Cdc(), res, ctx.EventManager().Events()), nil
 }
 
 func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keeper.Keeper) (*sdk.Result, error) {
@@ -179,6 +184,15 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 		validator.Commission = commission
 	}
 
+	if msg.MaxDelegationRate != nil {
+		maxRate := k.MaxDelegationRate(ctx)
+		if maxRate.IsPositive() && msg.MaxDelegationRate.GT(maxRate) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidDelegationCap, "max delegation rate should not be greater than %s", maxRate)
+		}
+	}
+
+	validator = validator.SetDelegationCap(msg.MaxDelegation, msg.MaxDelegationRate)
+
 	k.SetValidator(ctx, validator)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
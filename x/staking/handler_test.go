@@ -100,7 +100,7 @@ func exitValidator(t *testing.T, wallet *simapp.Wallet, app *simapp.SimApp, addA
 	origAuthSeq, origAuthNum, err := app.AccountKeeper().GetAuthSequence(ctxCheck, addAlice)
 	So(err, ShouldBeNil)
 	description := stakingTypes.NewDescription("Newmoniker", "Newidentity", "Newwebsite", "NewsecurityContact", "Newdetails")
-	msg := stakingTypes.NewKuMsgEditValidator(addAlice, accAlice, description, &rate)
+	msg := stakingTypes.NewKuMsgEditValidator(addAlice, accAlice, description, &rate, nil, nil)
 	fee := types.Coins{types.NewInt64Coin(constants.DefaultBondDenom, 1000000)}
 	header := abci.Header{Height: app.LastBlockHeight() + 1}
 	_, _, err = simapp.SignCheckDeliver(t, app.Codec(), app.BaseApp,
@@ -135,6 +135,18 @@ func (k Keeper) IterateDelegations(ctx sdk.Context, delAcc types.AccountID,
 	}
 }
 
+// iterate through all of the delegations to the validators, by the delegators
+func (k Keeper) IterateAllValidatorDelegations(ctx sdk.Context,
+	fn func(index int64, del exported.DelegationI) (stop bool)) {
+
+	i := int64(0)
+	k.IterateAllDelegations(ctx, func(delegation types.Delegation) bool {
+		stop := fn(i, delegation)
+		i++
+		return stop
+	})
+}
+
 // return all delegations used during genesis dump
 // TODO: remove this func, change all usage for iterate functionality
 func (k Keeper) GetAllSDKDelegations(ctx sdk.Context) (delegations []types.Delegation) {
@@ -481,6 +481,10 @@ func (k Keeper) Delegate(
 		return sdk.ZeroDec(), types.ErrDelegatorShareExRateInvalid
 	}
 
+	if err := k.checkDelegationCap(ctx, delAddr, bondAmt, validator, subtractAccount); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
 	// Get or create the delegation object
 	delegation, found := k.GetDelegation(ctx, delAddr, validator.OperatorAccount)
 	if !found {
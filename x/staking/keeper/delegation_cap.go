@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// checkDelegationCap checks the delegation not push the validator over its max delegation,
+// the self-delegation of the validator operator is not limited.
+// If the validator reaches the max delegation after the delegation, an event is emitted.
+func (k Keeper) checkDelegationCap(ctx sdk.Context, delAddr AccountID, bondAmt sdk.Int, validator types.Validator, subtractAccount bool) error {
+	if delAddr.Eq(validator.OperatorAccount) {
+		return nil
+	}
+
+	// the redelegation not changes the total stake
+	totalStake := k.TotalBondedTokens(ctx).Add(k.TotalNotBondedTokens(ctx))
+	if subtractAccount {
+		totalStake = totalStake.Add(bondAmt)
+	}
+
+	maxTokens, limited := validator.DelegationCap(totalStake, k.MaxDelegationRate(ctx))
+	if !limited {
+		return nil
+	}
+
+	tokens := validator.Tokens.Add(bondAmt)
+	if tokens.GT(maxTokens) {
+		return sdkerrors.Wrapf(types.ErrDelegationCapExceeded,
+			"validator %s tokens %s, max delegation %s", validator.OperatorAccount, tokens, maxTokens)
+	}
+
+	if tokens.Equal(maxTokens) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDelegationCapReached,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAccount.String()),
+				sdk.NewAttribute(types.AttributeKeyMaxDelegation, maxTokens.String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/staking/exported"
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestDelegationCap(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestDelegationCap", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.StakeKeeper()
+		keeper = keeper.EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		tokens := exported.TokensFromConsensusPower(10000)
+		moduleName, _ := types.ModuleAccountID.ToName()
+		app.AssetKeeper().Issue(ctx, moduleName, moduleName, chainTypes.NewCoin(keeper.BondDenom(ctx), tokens))
+
+		maxDelegation := exported.TokensFromConsensusPower(100)
+		validator := types.NewValidator(addrVal1, pk1, types.Description{}).SetDelegationCap(&maxDelegation, nil)
+		keeper.SetValidator(ctx, validator)
+
+		_, err := keeper.Delegate(ctx, addrAcc2, exported.TokensFromConsensusPower(101), exported.Unbonded, validator, true)
+		So(err, simapp.ShouldErrIs, types.ErrDelegationCapExceeded)
+
+		// the event emitted when the validator reaches the max delegation
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		_, err = keeper.Delegate(ctx, addrAcc2, maxDelegation, exported.Unbonded, validator, true)
+		So(err, ShouldBeNil)
+		So(ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Type, ShouldEqual, types.EventTypeDelegationCapReached)
+
+		// the self-delegation is not limited
+		validator, _ = keeper.GetValidator(ctx, addrVal1)
+		_, err = keeper.Delegate(ctx, addrVal1, exported.TokensFromConsensusPower(1), exported.Unbonded, validator, true)
+		So(err, ShouldBeNil)
+
+		// remove the limit
+		validator, _ = keeper.GetValidator(ctx, addrVal1)
+		noLimit := sdk.ZeroInt()
+		validator = validator.SetDelegationCap(&noLimit, nil)
+		So(validator.MaxDelegation, ShouldBeNil)
+		keeper.SetValidator(ctx, validator)
+		_, err = keeper.Delegate(ctx, addrAcc2, exported.TokensFromConsensusPower(1), exported.Unbonded, validator, true)
+		So(err, ShouldBeNil)
+
+		// the max delegation rate in params limits all validators
+		params := keeper.GetParams(ctx)
+		params.MaxDelegationRate = sdk.NewDecWithPrec(5, 1)
+		keeper.SetParams(ctx, params)
+
+		validator, _ = keeper.GetValidator(ctx, addrVal1)
+		_, err = keeper.Delegate(ctx, addrAcc2, exported.TokensFromConsensusPower(1), exported.Unbonded, validator, true)
+		So(err, simapp.ShouldErrIs, types.ErrDelegationCapExceeded)
+	})
+
+	Convey("TestValidatorDelegationCap", t, func() {
+		validator := types.NewValidator(addrVal1, pk1, types.Description{})
+		_, limited := validator.DelegationCap(sdk.NewInt(1000), sdk.ZeroDec())
+		So(limited, ShouldBeFalse)
+
+		maxDelegation, rate := sdk.NewInt(300), sdk.NewDecWithPrec(2, 1)
+		validator = validator.SetDelegationCap(&maxDelegation, &rate)
+		maxTokens, limited := validator.DelegationCap(sdk.NewInt(1000), sdk.ZeroDec())
+		So(limited, ShouldBeTrue)
+		So(maxTokens, ShouldResemble, sdk.NewInt(200))
+
+		maxTokens, _ = validator.DelegationCap(sdk.NewInt(1000), sdk.NewDecWithPrec(1, 1))
+		So(maxTokens, ShouldResemble, sdk.NewInt(100))
+
+		invalidRate := maxDelegation.ToDec()
+		So(types.ValidateDelegationCap(nil, &invalidRate), simapp.ShouldErrIs, types.ErrInvalidDelegationCap)
+	})
+}
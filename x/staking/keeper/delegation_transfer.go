@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TransferDelegation moves the delegation shares to the validator from one delegator to another,
+// the tokens of the validator are not changed, so there is no unbonding. The self delegation of the
+// validator operator and the shares in receiving redelegations, which can be slashed, can not be transferred.
+func (k Keeper) TransferDelegation(ctx sdk.Context, from, to, valAddr AccountID, shares sdk.Dec) error {
+	if !shares.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrBadSharesAmount, "shares %s", shares)
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if from.Eq(validator.OperatorAccount) {
+		return sdkerrors.Wrap(types.ErrDelegationNotTransferable, "self delegation of the validator")
+	}
+
+	if k.HasReceivingRedelegation(ctx, from, valAddr) {
+		return sdkerrors.Wrap(types.ErrDelegationNotTransferable, "delegation has receiving redelegations")
+	}
+
+	fromDelegation, found := k.GetDelegation(ctx, from, valAddr)
+	if !found {
+		return types.ErrNoDelegatorForAddress
+	}
+
+	if fromDelegation.Shares.LT(shares) {
+		return sdkerrors.Wrap(types.ErrNotEnoughDelegationShares, fromDelegation.Shares.String())
+	}
+
+	k.BeforeDelegationSharesModified(ctx, from, valAddr)
+	fromDelegation.Shares = fromDelegation.Shares.Sub(shares)
+	if fromDelegation.Shares.IsZero() {
+		k.RemoveDelegation(ctx, fromDelegation)
+	} else {
+		k.SetDelegation(ctx, fromDelegation)
+		k.AfterDelegationModified(ctx, from, valAddr)
+	}
+
+	toDelegation, found := k.GetDelegation(ctx, to, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, to, valAddr)
+	} else {
+		toDelegation = types.NewDelegation(to, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, to, valAddr)
+	}
+
+	toDelegation.Shares = toDelegation.Shares.Add(shares)
+	k.SetDelegation(ctx, toDelegation)
+	k.AfterDelegationModified(ctx, to, valAddr)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/staking/exported"
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestTransferDelegation(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestTransferDelegation", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.StakeKeeper()
+		keeper = keeper.EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		validator := types.NewValidator(addrVal1, pk1, types.Description{})
+		keeper.SetValidator(ctx, validator)
+
+		// addrVal1 and addrAcc1 are the same account, use the other one as the delegator
+		delegator, receiver := Accd[2], Accd[3]
+		shares, err := keeper.Delegate(ctx, delegator, exported.TokensFromConsensusPower(10), exported.Unbonded, validator, false)
+		So(err, ShouldBeNil)
+
+		So(keeper.TransferDelegation(ctx, delegator, receiver, addrVal1, shares.Add(sdk.OneDec())),
+			simapp.ShouldErrIs, types.ErrNotEnoughDelegationShares)
+		So(keeper.TransferDelegation(ctx, delegator, receiver, addrVal1, sdk.ZeroDec()),
+			simapp.ShouldErrIs, types.ErrBadSharesAmount)
+
+		half := shares.QuoInt64(2)
+		So(keeper.TransferDelegation(ctx, delegator, receiver, addrVal1, half), ShouldBeNil)
+
+		delegation, found := keeper.GetDelegation(ctx, receiver, addrVal1)
+		So(found, ShouldBeTrue)
+		So(delegation.Shares, ShouldResemble, half)
+
+		// the delegation is removed when all shares transferred
+		So(keeper.TransferDelegation(ctx, delegator, receiver, addrVal1, shares.Sub(half)), ShouldBeNil)
+		_, found = keeper.GetDelegation(ctx, delegator, addrVal1)
+		So(found, ShouldBeFalse)
+
+		// the validator tokens are not changed
+		validator, _ = keeper.GetValidator(ctx, addrVal1)
+		So(validator.Tokens, ShouldResemble, exported.TokensFromConsensusPower(10))
+
+		// the self delegation cannot be transferred
+		_, err = keeper.Delegate(ctx, addrVal1, exported.TokensFromConsensusPower(1), exported.Unbonded, validator, false)
+		So(err, ShouldBeNil)
+		So(keeper.TransferDelegation(ctx, addrVal1, receiver, addrVal1, sdk.OneDec()),
+			simapp.ShouldErrIs, types.ErrDelegationNotTransferable)
+	})
+}
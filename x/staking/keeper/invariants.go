@@ -21,6 +21,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "delegation-references",
+		DelegationReferencesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -42,7 +44,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return DelegationReferencesInvariant(k)(ctx)
 	}
 }
 
@@ -181,3 +188,29 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// DelegationReferencesInvariant checks that every delegation references an existing validator
+// and an existing delegator account.
+func DelegationReferencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		delegations := k.GetAllDelegations(ctx)
+		for _, delegation := range delegations {
+			if _, found := k.GetValidator(ctx, delegation.ValidatorAccount); !found {
+				count++
+				msg += fmt.Sprintf("\tdelegation of %s references a missing validator %s\n",
+					delegation.DelegatorAccount, delegation.ValidatorAccount)
+			}
+			if k.accountKeeper.GetAccount(ctx, delegation.DelegatorAccount) == nil {
+				count++
+				msg += fmt.Sprintf("\tdelegation to %s references a missing delegator account %s\n",
+					delegation.ValidatorAccount, delegation.DelegatorAccount)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "delegation references", fmt.Sprintf(
+			"%d invalid delegation references found\n%s", count, msg)), count != 0
+	}
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/staking/keeper"
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestDelegationReferencesInvariant(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestDelegationReferencesInvariant", t, func() {
+		_, _, _, accAlice, _, _, app := NewTestApp(wallet)
+		ctx := app.BaseApp.NewContext(false, abci.Header{Height: app.LastBlockHeight() + 1})
+		stakingKeeper := app.StakeKeeper()
+		invariant := keeper.DelegationReferencesInvariant(*stakingKeeper)
+
+		validator := types.NewValidator(Accd[0], PKs[0], types.Description{})
+		validator, _ = validator.AddTokensFromDel(sdk.NewInt(10))
+		validator = TestingUpdateValidator(app, ctx, validator, true)
+
+		stakingKeeper.SetDelegation(ctx, types.NewDelegation(accAlice, Accd[0], sdk.NewDec(10)))
+		_, broken := invariant(ctx)
+		So(broken, ShouldBeFalse)
+
+		// the validator is missing
+		stakingKeeper.SetDelegation(ctx, types.NewDelegation(accAlice, Accd[1], sdk.NewDec(10)))
+		msg, broken := invariant(ctx)
+		So(broken, ShouldBeTrue)
+		So(msg, ShouldContainSubstring, "1 invalid delegation references found")
+
+		// the delegator account is missing
+		stakingKeeper.SetDelegation(ctx, types.NewDelegation(Accdel[0], Accd[0], sdk.NewDec(10)))
+		msg, broken = invariant(ctx)
+		So(broken, ShouldBeTrue)
+		So(msg, ShouldContainSubstring, "2 invalid delegation references found")
+	})
+}
@@ -26,6 +26,7 @@ type Keeper struct {
 	bankKeeper         types.BankKeeper
 	supplyKeeper       types.SupplyKeeper
 	hooks              types.StakingHooks
+	tombstoneChecker   types.TombstoneChecker
 	accountKeeper      types.AccountStatKeeper
 	paramstore         external.ParamsSubspace
 	validatorCache     map[string]cachedValidator
@@ -77,6 +78,12 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) *Keeper {
 	return k
 }
 
+// SetTombstoneChecker sets the checker used to tell the tombstoned validators in the validator set changes
+func (k *Keeper) SetTombstoneChecker(tc types.TombstoneChecker) *Keeper {
+	k.tombstoneChecker = tc
+	return k
+}
+
 func (k *Keeper) EmptyHooks() *Keeper {
 	k.hooks = nil
 	return k
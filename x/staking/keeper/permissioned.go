@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsPermissioned returns if the validator set is controlled by governance rather than stake ranking
+func (k Keeper) IsPermissioned(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.PermissionedKey)
+}
+
+// SetPermissioned sets the permissioned validator set mode, it should only be switched at genesis
+func (k Keeper) SetPermissioned(ctx sdk.Context, permissioned bool) {
+	store := ctx.KVStore(k.storeKey)
+	if permissioned {
+		store.Set(types.PermissionedKey, []byte{0x01})
+	} else {
+		store.Delete(types.PermissionedKey)
+	}
+}
+
+// IsValidatorAdmitted returns if the validator can join the bonded validator set,
+// all validators are admitted if not in permissioned mode.
+func (k Keeper) IsValidatorAdmitted(ctx sdk.Context, operator AccountID) bool {
+	if !k.IsPermissioned(ctx) {
+		return true
+	}
+
+	return ctx.KVStore(k.storeKey).Has(types.GetAdmittedValidatorKey(operator))
+}
+
+// AdmitValidator admits the validator to join the bonded validator set in permissioned mode
+func (k Keeper) AdmitValidator(ctx sdk.Context, operator AccountID) {
+	ctx.KVStore(k.storeKey).Set(types.GetAdmittedValidatorKey(operator), k.cdc.MustMarshalBinaryBare(operator))
+}
+
+// RemoveValidatorAdmission removes the admission of the validator, the validator will
+// leave the bonded validator set at the end of the block but keep its delegations.
+func (k Keeper) RemoveValidatorAdmission(ctx sdk.Context, operator AccountID) {
+	ctx.KVStore(k.storeKey).Delete(types.GetAdmittedValidatorKey(operator))
+}
+
+// GetAdmittedValidators returns all the operators of the validators admitted
+func (k Keeper) GetAdmittedValidators(ctx sdk.Context) []AccountID {
+	res := make([]AccountID, 0)
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AdmittedValidatorsKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var operator AccountID
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &operator)
+		res = append(res, operator)
+	}
+
+	return res
+}
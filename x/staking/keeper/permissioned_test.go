@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/staking/exported"
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestPermissionedValidators(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestPermissionedValidatorSet", t, func() {
+		_, _, _, valAddr, _, _, app := NewTestApp(wallet)
+		keeper := app.StakeKeeper()
+		keeper = keeper.EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		So(keeper.IsPermissioned(ctx), ShouldBeFalse)
+		So(keeper.IsValidatorAdmitted(ctx, valAddr), ShouldBeTrue)
+
+		keeper.SetPermissioned(ctx, true)
+		So(keeper.IsValidatorAdmitted(ctx, valAddr), ShouldBeFalse)
+
+		valTokens := exported.TokensFromConsensusPower(10)
+		validator := types.NewValidator(valAddr, PKs[0], types.Description{})
+		validator, _ = validator.AddTokensFromDel(valTokens)
+		notBondedPool := keeper.GetNotBondedPool(ctx)
+		app.AssetKeeper().IssueCoinPower(ctx, notBondedPool.GetID(), chainTypes.NewCoins(chainTypes.NewCoin(keeper.BondDenom(ctx), valTokens)))
+		keeper.SetValidator(ctx, validator)
+		keeper.SetValidatorByPowerIndex(ctx, validator)
+
+		// the validator not admitted cannot be bonded
+		updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		So(len(updates), ShouldEqual, 0)
+		validator, _ = keeper.GetValidator(ctx, valAddr)
+		So(validator.IsUnbonded(), ShouldBeTrue)
+
+		keeper.AdmitValidator(ctx, valAddr)
+		So(keeper.GetAdmittedValidators(ctx), ShouldResemble, []chainTypes.AccountID{valAddr})
+
+		updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		So(len(updates), ShouldEqual, 1)
+		validator, _ = keeper.GetValidator(ctx, valAddr)
+		So(validator.IsBonded(), ShouldBeTrue)
+		So(updates[0].Equal(validator.ABCIValidatorUpdate()), ShouldBeTrue)
+
+		// the validator removed will leave the validator set
+		keeper.RemoveValidatorAdmission(ctx, valAddr)
+		So(len(keeper.GetAdmittedValidators(ctx)), ShouldEqual, 0)
+
+		updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		So(len(updates), ShouldEqual, 1)
+		validator, _ = keeper.GetValidator(ctx, valAddr)
+		So(validator.IsUnbonding(), ShouldBeTrue)
+		So(updates[0].Equal(validator.ABCIValidatorUpdateZero()), ShouldBeTrue)
+	})
+}
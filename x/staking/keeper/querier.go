@@ -58,6 +58,15 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryParameters:
 			return queryParameters(ctx, k)
 
+		case types.QueryAdmittedValidators:
+			return queryAdmittedValidators(ctx, k)
+
+		case types.QueryValidatorSetChanges:
+			return queryValidatorSetChanges(ctx, req, k)
+
+		case types.QueryUnbondingQueue:
+			return queryUnbondingQueue(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -397,6 +406,61 @@ func queryParameters(ctx sdk.Context, k Keeper) ([]byte, error) {
 	return res, nil
 }
 
+func queryAdmittedValidators(ctx sdk.Context, k Keeper) ([]byte, error) {
+	resp := types.QueryAdmittedValidatorsResponse{
+		Permissioned: k.IsPermissioned(ctx),
+		Validators:   k.GetAdmittedValidators(ctx),
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryValidatorSetChanges(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryValidatorSetChangesParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.FromHeight < 0 || params.ToHeight < params.FromHeight {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid height range [%d, %d]", params.FromHeight, params.ToHeight)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetValidatorSetChanges(ctx, params.FromHeight, params.ToHeight))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryUnbondingQueue(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryUnbondingQueueParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.Within <= 0 || params.BucketSize <= 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "within %s and bucket size %s should be positive",
+			params.Within, params.BucketSize)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetUnbondingQueueSummary(ctx, params.Within, params.BucketSize))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 //______________________________________________________
 // util
 
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetUnbondingQueueSummary summarizes the stake to complete unbonding within the duration from the block time,
+// by the completion time buckets of the bucket size and by the validators.
+func (k Keeper) GetUnbondingQueueSummary(ctx sdk.Context, within, bucketSize time.Duration) types.UnbondingQueueSummary {
+	from := ctx.BlockHeader().Time
+	to := from.Add(within)
+	summary := types.NewUnbondingQueueSummary(from, to)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetUnbondingDelegationTimeKey(from),
+		sdk.InclusiveEndBytes(types.GetUnbondingDelegationTimeKey(to)))
+	defer iterator.Close()
+
+	// a timeslice has one pair for each entry, so the same pair in a timeslice only counted once
+	for ; iterator.Valid(); iterator.Next() {
+		completionTime, err := types.ParseUnbondingDelegationTimeKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		timeslice := types.DVPairs{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &timeslice)
+
+		bucketStart := from.Add(completionTime.Sub(from) / bucketSize * bucketSize)
+		counted := make(map[string]bool, len(timeslice.Pairs))
+		for _, pair := range timeslice.Pairs {
+			if counted[pair.String()] {
+				continue
+			}
+			counted[pair.String()] = true
+
+			ubd, found := k.GetUnbondingDelegation(ctx, pair.DelegatorAccount, pair.ValidatorAccount)
+			if !found {
+				continue
+			}
+
+			for _, entry := range ubd.Entries {
+				if entry.CompletionTime.Equal(completionTime) {
+					summary.AddEntry(bucketStart, pair.ValidatorAccount, entry.Balance)
+				}
+			}
+		}
+	}
+
+	return summary
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestUnbondingQueueSummary(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestUnbondingQueueSummary", t, func() {
+		_, _, _, _, _, _, app := NewTestApp(wallet)
+		keeper := app.StakeKeeper()
+		now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1, Time: now})
+
+		unbond := func(del, val types.AccountID, after time.Duration, balance int64) {
+			ubd, found := keeper.GetUnbondingDelegation(ctx, del, val)
+			if found {
+				ubd.AddEntry(ctx.BlockHeight(), now.Add(after), sdk.NewInt(balance))
+			} else {
+				ubd = types.NewUnbondingDelegation(del, val, ctx.BlockHeight(), now.Add(after), sdk.NewInt(balance))
+			}
+			keeper.SetUnbondingDelegation(ctx, ubd)
+			keeper.InsertUBDQueue(ctx, ubd, now.Add(after))
+		}
+
+		del1, del2, val1, val2 := Accd[2], Accd[3], Accd[4], Accd[5]
+		unbond(del1, val1, 10*time.Minute, 100)
+		unbond(del2, val1, 30*time.Minute, 200)
+		unbond(del1, val2, 90*time.Minute, 300)
+		// two entries of the same pair complete at the same time
+		unbond(del1, val2, 90*time.Minute, 400)
+		// out of the duration
+		unbond(del2, val2, 25*time.Hour, 500)
+
+		summary := keeper.GetUnbondingQueueSummary(ctx, 24*time.Hour, time.Hour)
+		So(summary.From, ShouldResemble, now)
+		So(summary.To, ShouldResemble, now.Add(24*time.Hour))
+		So(summary.Total, ShouldResemble, sdk.NewInt(1000))
+
+		So(summary.Buckets, ShouldHaveLength, 2)
+		So(summary.Buckets[0].Start, ShouldResemble, now)
+		So(summary.Buckets[0].Balance, ShouldResemble, sdk.NewInt(300))
+		So(summary.Buckets[0].Entries, ShouldEqual, 2)
+		So(summary.Buckets[1].Start, ShouldResemble, now.Add(time.Hour))
+		So(summary.Buckets[1].Balance, ShouldResemble, sdk.NewInt(700))
+		So(summary.Buckets[1].Entries, ShouldEqual, 2)
+
+		So(summary.Validators, ShouldHaveLength, 2)
+		So(summary.Validators[0].Validator, ShouldResemble, val1)
+		So(summary.Validators[0].Balance, ShouldResemble, sdk.NewInt(300))
+		So(summary.Validators[1].Validator, ShouldResemble, val2)
+		So(summary.Validators[1].Balance, ShouldResemble, sdk.NewInt(700))
+
+		summary = keeper.GetUnbondingQueueSummary(ctx, 48*time.Hour, 24*time.Hour)
+		So(summary.Total, ShouldResemble, sdk.NewInt(1500))
+		So(summary.Buckets, ShouldHaveLength, 2)
+		So(summary.Buckets[1].Balance, ShouldResemble, sdk.NewInt(500))
+	})
+}
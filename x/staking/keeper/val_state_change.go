@@ -113,6 +113,11 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			panic("should never retrieve a jailed validator from the power store")
 		}
 
+		// in permissioned mode only the validators admitted by governance can be bonded
+		if !k.IsValidatorAdmitted(ctx, valAccount) {
+			continue
+		}
+
 		// if we get to a zero-power validator (which we don't bond),
 		// there are no more possible bonded validators
 		if validator.PotentialConsensusPower() == 0 {
@@ -145,6 +150,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
 			updates = append(updates, validator.ABCIValidatorUpdate())
 			k.SetLastValidatorPower(ctx, valAccount, newPower)
+			k.trackValidatorPowerChange(ctx, valAccount, oldPowerBytes, found, newPower)
 		}
 
 		delete(last, valAddrBytes)
@@ -156,6 +162,9 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	noLongerBonded := sortNoLongerBonded(last)
 	for _, valAddrBytes := range noLongerBonded {
 		validatorNoLonger := k.mustGetValidator(ctx, NewAccountIDFromByte(valAddrBytes))
+		var lastAddrBytes [types.AccountIDlen]byte
+		copy(lastAddrBytes[:], valAddrBytes)
+		k.trackValidatorLeft(ctx, validatorNoLonger, last[lastAddrBytes])
 		validatorNoLonger = k.bondedToUnbonding(ctx, validatorNoLonger)
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validatorNoLonger.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validatorNoLonger.GetOperatorAccountID())
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/gogo/protobuf/types"
+)
+
+// SetValidatorSetChange stores the validator set change and emits the event of it
+func (k Keeper) SetValidatorSetChange(ctx sdk.Context, change types.ValidatorSetChange) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorSetChangeKey(change.Height, change.Validator), k.cdc.MustMarshalBinaryBare(change))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorSetChange,
+			sdk.NewAttribute(types.AttributeKeyValidator, change.Validator.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, change.Reason),
+			sdk.NewAttribute(types.AttributeKeyOldPower, fmt.Sprintf("%d", change.OldPower)),
+			sdk.NewAttribute(types.AttributeKeyNewPower, fmt.Sprintf("%d", change.NewPower)),
+		),
+	)
+}
+
+// GetValidatorSetChanges gets the validator set changes in the height range [fromHeight, toHeight]
+func (k Keeper) GetValidatorSetChanges(ctx sdk.Context, fromHeight, toHeight int64) types.ValidatorSetChanges {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.GetValidatorSetChangeHeightKey(fromHeight),
+		types.GetValidatorSetChangeHeightKey(toHeight+1),
+	)
+	defer iterator.Close()
+
+	changes := types.ValidatorSetChanges{}
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ValidatorSetChange
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// trackValidatorPowerChange records the validator joined the set or its power changed beyond the threshold
+func (k Keeper) trackValidatorPowerChange(ctx sdk.Context, validator AccountID, oldPowerBytes []byte, found bool, newPower int64) {
+	if !found {
+		k.SetValidatorSetChange(ctx,
+			types.NewValidatorSetChange(ctx.BlockHeight(), validator, types.SetChangeReasonJoined, 0, newPower))
+		return
+	}
+
+	oldPower := k.lastPowerFromBytes(oldPowerBytes)
+	if types.IsPowerChangeSignificant(oldPower, newPower) {
+		k.SetValidatorSetChange(ctx,
+			types.NewValidatorSetChange(ctx.BlockHeight(), validator, types.SetChangeReasonPowerChanged, oldPower, newPower))
+	}
+}
+
+// trackValidatorLeft records the validator left the set with the cause
+func (k Keeper) trackValidatorLeft(ctx sdk.Context, validator types.Validator, oldPowerBytes []byte) {
+	reason := types.SetChangeReasonLeft
+	switch {
+	case k.tombstoneChecker != nil && k.tombstoneChecker.IsTombstoned(ctx, validator.GetConsAddr()):
+		reason = types.SetChangeReasonTombstoned
+	case validator.IsJailed():
+		reason = types.SetChangeReasonJailed
+	}
+
+	k.SetValidatorSetChange(ctx,
+		types.NewValidatorSetChange(ctx.BlockHeight(), validator.GetOperatorAccountID(), reason, k.lastPowerFromBytes(oldPowerBytes), 0))
+}
+
+func (k Keeper) lastPowerFromBytes(bz []byte) int64 {
+	intV := gogotypes.Int64Value{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &intV)
+	return intV.GetValue()
+}
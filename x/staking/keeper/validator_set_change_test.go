@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/staking/exported"
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestValidatorSetChanges(t *testing.T) {
+	wallet := simapp.NewWallet()
+	Convey("TestValidatorSetChanges", t, func() {
+		_, _, _, valAddr, _, _, app := NewTestApp(wallet)
+		keeper := app.StakeKeeper()
+		keeper = keeper.EmptyHooks()
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight() + 1})
+
+		addTokens := func(validator types.Validator, power int64) types.Validator {
+			pool := keeper.GetNotBondedPool(ctx)
+			if validator.IsBonded() {
+				pool = keeper.GetBondedPool(ctx)
+			}
+
+			tokens := exported.TokensFromConsensusPower(power)
+			app.AssetKeeper().IssueCoinPower(ctx, pool.GetID(), chainTypes.NewCoins(chainTypes.NewCoin(keeper.BondDenom(ctx), tokens)))
+			keeper.DeleteValidatorByPowerIndex(ctx, validator)
+			validator, _ = validator.AddTokensFromDel(tokens)
+			keeper.SetValidator(ctx, validator)
+			keeper.SetValidatorByPowerIndex(ctx, validator)
+			return validator
+		}
+
+		// the validator joined the set
+		validator := addTokens(types.NewValidator(valAddr, PKs[0], types.Description{}), 100)
+		keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+		changes := keeper.GetValidatorSetChanges(ctx, ctx.BlockHeight(), ctx.BlockHeight())
+		So(len(changes), ShouldEqual, 1)
+		So(changes[0], ShouldResemble, types.NewValidatorSetChange(ctx.BlockHeight(), valAddr, types.SetChangeReasonJoined, 0, 100))
+
+		// the power change below the threshold is not recorded
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		validator, _ = keeper.GetValidator(ctx, valAddr)
+		addTokens(validator, 5)
+		keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		So(len(keeper.GetValidatorSetChanges(ctx, ctx.BlockHeight(), ctx.BlockHeight())), ShouldEqual, 0)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		validator, _ = keeper.GetValidator(ctx, valAddr)
+		addTokens(validator, 50)
+		keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+		changes = keeper.GetValidatorSetChanges(ctx, ctx.BlockHeight(), ctx.BlockHeight())
+		So(len(changes), ShouldEqual, 1)
+		So(changes[0].Reason, ShouldEqual, types.SetChangeReasonPowerChanged)
+		So(changes[0].OldPower, ShouldEqual, 105)
+		So(changes[0].NewPower, ShouldEqual, 155)
+
+		// the validator jailed left the set
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		keeper.JailByAccount(ctx, valAddr)
+		keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+		changes = keeper.GetValidatorSetChanges(ctx, ctx.BlockHeight(), ctx.BlockHeight())
+		So(len(changes), ShouldEqual, 1)
+		So(changes[0], ShouldResemble, types.NewValidatorSetChange(ctx.BlockHeight(), valAddr, types.SetChangeReasonJailed, 155, 0))
+
+		// query all the changes in the height range
+		So(len(keeper.GetValidatorSetChanges(ctx, 0, ctx.BlockHeight())), ShouldEqual, 3)
+	})
+}
+
+func TestIsPowerChangeSignificant(t *testing.T) {
+	Convey("TestIsPowerChangeSignificant", t, func() {
+		So(types.IsPowerChangeSignificant(100, 109), ShouldBeFalse)
+		So(types.IsPowerChangeSignificant(100, 110), ShouldBeTrue)
+		So(types.IsPowerChangeSignificant(100, 90), ShouldBeTrue)
+		So(types.IsPowerChangeSignificant(0, 1), ShouldBeTrue)
+		So(types.PowerChangeThreshold, ShouldResemble, sdk.NewDecWithPrec(1, 1))
+	})
+}
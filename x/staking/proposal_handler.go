@@ -0,0 +1,54 @@
+package staking
+
+import (
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewValidatorAdmissionProposalHandler creates a governance handler to admit or remove validators in permissioned mode
+func NewValidatorAdmissionProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) error {
+		switch c := content.(type) {
+		case types.ValidatorAdmissionProposal:
+			return handleValidatorAdmissionProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized staking proposal content type: %T", c)
+		}
+	}
+}
+
+func handleValidatorAdmissionProposal(ctx sdk.Context, k Keeper, p types.ValidatorAdmissionProposal) error {
+	if !k.IsPermissioned(ctx) {
+		return types.ErrNotPermissioned
+	}
+
+	// IsValidatorAdmitted is always true out of permissioned mode, checked above
+	admitted := k.IsValidatorAdmitted(ctx, p.Validator)
+
+	eventType := types.EventTypeAdmitValidator
+	if p.Admit {
+		if admitted {
+			return sdkerrors.Wrapf(types.ErrValidatorAlreadyAdmitted, "validator %s", p.Validator)
+		}
+		k.AdmitValidator(ctx, p.Validator)
+	} else {
+		if !admitted {
+			return sdkerrors.Wrapf(types.ErrValidatorNotAdmitted, "validator %s", p.Validator)
+		}
+		k.RemoveValidatorAdmission(ctx, p.Validator)
+		eventType = types.EventTypeRemoveValidator
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidator, p.Validator.String()),
+		),
+	)
+
+	return nil
+}
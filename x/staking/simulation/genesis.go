@@ -51,7 +51,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
 
-	params := types.NewParams(simState.UnbondTime, maxValidators, 7, 3, stakingexport.DefaultBondDenom)
+	params := types.NewParams(simState.UnbondTime, maxValidators, 7, 3, stakingexport.DefaultBondDenom, types.DefaultMaxDelegationRate)
 
 	// validators & delegations
 	var (
@@ -221,7 +221,7 @@ func SimulateMsgEditValidator(ak types.AccountKeeper, bk types.BankKeeper, k kee
 
 		accountID := val.GetOperatorAccountID()
 		//lose accaddress
-		msg := types.NewKuMsgEditValidator(sdk.AccAddress(address), accountID, description, &newCommissionRate)
+		msg := types.NewKuMsgEditValidator(sdk.AccAddress(address), accountID, description, &newCommissionRate, nil, nil)
 
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
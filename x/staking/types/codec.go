@@ -18,6 +18,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(KuMsgEditValidator{}, "kuchain/KuMsgEditValidator", nil)
 	cdc.RegisterConcrete(KuMsgRedelegate{}, "kuchain/KuMsgRedelegate", nil)
 	cdc.RegisterConcrete(KuMsgUnbond{}, "kuchain/KuMsgUnbond", nil)
+
+	cdc.RegisterConcrete(MsgCreateValidatorResponse{}, "kuchain/MsgCreateValidatorResponse", nil)
 }
 
 var (
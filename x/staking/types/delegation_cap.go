@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetDelegationCap sets the max delegation of the validator in absolute amount and in rate of the total stake,
+// a nil value keeps the current setting, and a zero value removes the limit.
+func (v Validator) SetDelegationCap(maxDelegation *sdk.Int, maxDelegationRate *sdk.Dec) Validator {
+	if maxDelegation != nil {
+		if maxDelegation.IsZero() {
+			v.MaxDelegation = nil
+		} else {
+			amt := *maxDelegation
+			v.MaxDelegation = &amt
+		}
+	}
+
+	if maxDelegationRate != nil {
+		if maxDelegationRate.IsZero() {
+			v.MaxDelegationRate = nil
+		} else {
+			rate := *maxDelegationRate
+			v.MaxDelegationRate = &rate
+		}
+	}
+
+	return v
+}
+
+// DelegationCap returns the max tokens could be delegated to the validator by the total stake,
+// the paramsRate is the max rate of the total stake for all validators, zero for no limit.
+// It returns false if the delegations to the validator is not limited.
+func (v Validator) DelegationCap(totalStake sdk.Int, paramsRate sdk.Dec) (sdk.Int, bool) {
+	var (
+		maxTokens sdk.Int
+		limited   bool
+	)
+
+	limit := func(amt sdk.Int) {
+		if !limited || amt.LT(maxTokens) {
+			maxTokens = amt
+		}
+		limited = true
+	}
+
+	if v.MaxDelegation != nil {
+		limit(*v.MaxDelegation)
+	}
+
+	if v.MaxDelegationRate != nil {
+		limit(v.MaxDelegationRate.MulInt(totalStake).TruncateInt())
+	}
+
+	if paramsRate.IsPositive() {
+		limit(paramsRate.MulInt(totalStake).TruncateInt())
+	}
+
+	return maxTokens, limited
+}
+
+// ValidateDelegationCap validates the max delegation setting of the validator, which can be nil
+func ValidateDelegationCap(maxDelegation *sdk.Int, maxDelegationRate *sdk.Dec) error {
+	if maxDelegation != nil && maxDelegation.IsNegative() {
+		return ErrInvalidDelegationCap
+	}
+
+	if maxDelegationRate != nil && (maxDelegationRate.IsNegative() || maxDelegationRate.GT(sdk.OneDec())) {
+		return ErrInvalidDelegationCap
+	}
+
+	return nil
+}
@@ -58,4 +58,10 @@ var (
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 46, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 47, "empty validator public key")
 	ErrUnKnowAccount                   = sdkerrors.Register(ModuleName, 48, "validator operator is not a known account")
+	ErrValidatorNotAdmitted            = sdkerrors.Register(ModuleName, 49, "validator is not admitted in permissioned mode")
+	ErrValidatorAlreadyAdmitted        = sdkerrors.Register(ModuleName, 50, "validator is already admitted")
+	ErrNotPermissioned                 = sdkerrors.Register(ModuleName, 51, "validator set is not in permissioned mode")
+	ErrDelegationCapExceeded           = sdkerrors.Register(ModuleName, 52, "delegation exceeds the max delegation of the validator")
+	ErrInvalidDelegationCap            = sdkerrors.Register(ModuleName, 53, "invalid max delegation of the validator")
+	ErrDelegationNotTransferable       = sdkerrors.Register(ModuleName, 54, "delegation is not transferable")
 )
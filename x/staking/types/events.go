@@ -9,6 +9,10 @@ const (
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
+	EventTypeAdmitValidator       = "admit_validator"
+	EventTypeRemoveValidator      = "remove_validator"
+	EventTypeValidatorSetChange   = "validator_set_change"
+	EventTypeDelegationCapReached = "delegation_cap_reached"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -17,5 +21,9 @@ const (
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyReason            = "reason"
+	AttributeKeyOldPower          = "old_power"
+	AttributeKeyNewPower          = "new_power"
+	AttributeKeyMaxDelegation     = "max_delegation"
 	AttributeValueCategory        = ModuleName
 )
@@ -121,3 +121,8 @@ type StakingHooks interface {
 	AfterDelegationModified(ctx sdk.Context, delAddr AccountID, valAddr AccountID)
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr AccountID, fraction sdk.Dec)
 }
+
+// TombstoneChecker checks if the validator is tombstoned, implemented by the slashing keeper (noalias)
+type TombstoneChecker interface {
+	IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool
+}
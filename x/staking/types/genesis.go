@@ -17,6 +17,11 @@ type GenesisState struct {
 	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations" yaml:"unbonding_delegations"`
 	Redelegations        []Redelegation        `json:"redelegations" yaml:"redelegations"`
 	Exported             bool                  `json:"exported" yaml:"exported"`
+
+	// Permissioned the validator set is controlled by governance rather than stake ranking,
+	// only the AdmittedValidators can join the bonded validator set.
+	Permissioned       bool        `json:"permissioned,omitempty" yaml:"permissioned"`
+	AdmittedValidators []AccountID `json:"admitted_validators,omitempty" yaml:"admitted_validators"`
 }
 
 // LastValidatorPower required for validator set update logic
@@ -63,6 +68,10 @@ func ValidateGenesis(data GenesisState) error {
 	if err != nil {
 		return err
 	}
+	err = validateGenesisStateAdmittedValidators(data)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -88,3 +97,22 @@ func validateGenesisStateValidators(validators []Validator) (err error) {
 
 	return
 }
+
+func validateGenesisStateAdmittedValidators(data GenesisState) error {
+	if !data.Permissioned && len(data.AdmittedValidators) > 0 {
+		return fmt.Errorf("admitted validators in genesis state need permissioned mode")
+	}
+
+	admitted := make(map[string]bool, len(data.AdmittedValidators))
+	for _, val := range data.AdmittedValidators {
+		if val.Empty() {
+			return fmt.Errorf("empty admitted validator in genesis state")
+		}
+		if admitted[val.String()] {
+			return fmt.Errorf("duplicate admitted validator in genesis state: %s", val)
+		}
+		admitted[val.String()] = true
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -32,7 +33,7 @@ var (
 	ModuleAccountID   = chainTypes.NewAccountIDFromName(ModuleAccountName)
 )
 
-//nolint
+// nolint
 var (
 	// Keys for store prefixes
 	// Last* values are constant during a block.
@@ -54,8 +55,11 @@ var (
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
-	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+	HistoricalInfoKey     = []byte{0x50} // prefix for the historical info
+	ValidatorSetChangeKey = []byte{0x51} // prefix for the validator set changes, by height
 
+	PermissionedKey       = []byte{0x60} // key for the permissioned validator set mode
+	AdmittedValidatorsKey = []byte{0x61} // prefix for the validators admitted by governance in permissioned mode
 )
 
 const (
@@ -68,6 +72,11 @@ func GetValidatorKey(operatorAddr AccountID) []byte {
 	return append(ValidatorsKey, operatorAddr.StoreKey()...)
 }
 
+// gets the key for the validator admitted in permissioned mode
+func GetAdmittedValidatorKey(operatorAddr AccountID) []byte {
+	return append(AdmittedValidatorsKey, operatorAddr.StoreKey()...)
+}
+
 // gets the key for the validator with pubkey
 // VALUE: validator operator address ([]byte)
 func GetValidatorByConsAddrKey(addr sdk.ConsAddress) []byte {
@@ -194,6 +203,15 @@ func GetUnbondingDelegationTimeKey(timestamp time.Time) []byte {
 	return append(UnbondingQueueKey, bz...)
 }
 
+// parses the completion time from the key of an unbonding delegation queue timeslice
+func ParseUnbondingDelegationTimeKey(key []byte) (time.Time, error) {
+	if len(key) <= len(UnbondingQueueKey) {
+		return time.Time{}, fmt.Errorf("invalid unbonding queue key length %d", len(key))
+	}
+
+	return sdk.ParseTimeBytes(key[len(UnbondingQueueKey):])
+}
+
 //________________________________________________________________________________
 
 // gets the key for a redelegation
@@ -295,6 +313,16 @@ func GetREDsByDelToValDstIndexKey(delAddr AccountID, valDstAddr AccountID) []byt
 
 //________________________________________________________________________________
 
+// GetValidatorSetChangeHeightKey gets the key prefix for the validator set changes at the height
+func GetValidatorSetChangeHeightKey(height int64) []byte {
+	return append(ValidatorSetChangeKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetValidatorSetChangeKey gets the key for the validator set change of the validator at the height
+func GetValidatorSetChangeKey(height int64, validator AccountID) []byte {
+	return append(GetValidatorSetChangeHeightKey(height), validator.StoreKey()...)
+}
+
 // GetHistoricalInfoKey gets the key for the historical info
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
@@ -64,17 +64,15 @@ type KuMsgEditValidator struct {
 	chainTypes.KuMsg
 }
 
-func NewKuMsgEditValidator(auth sdk.AccAddress, valAddr chainTypes.AccountID, description Description, newRate *sdk.Dec) KuMsgEditValidator {
+func NewKuMsgEditValidator(auth sdk.AccAddress, valAddr chainTypes.AccountID, description Description, newRate *sdk.Dec,
+	maxDelegation *sdk.Int, maxDelegationRate *sdk.Dec) KuMsgEditValidator {
+	msgData := NewMsgEditValidator(valAddr, description, newRate, maxDelegation, maxDelegationRate)
 
 	return KuMsgEditValidator{
 		*msg.MustNewKuMsg(
 			RouterKeyName,
 			msg.WithAuth(auth),
-			msg.WithData(Cdc(), &MsgEditValidator{
-				Description:      description,
-				CommissionRate:   newRate,
-				ValidatorAccount: valAddr,
-			}),
+			msg.WithData(Cdc(), &msgData),
 		),
 	}
 }
@@ -103,14 +103,21 @@ type MsgEditValidator struct {
 	Description      Description `json:"description" yaml:"description"`
 	ValidatorAccount AccountID   `json:"validator_account" yaml:"address"`
 	CommissionRate   *Dec        `json:"commission_rate,omitempty" yaml:"commission_rate"`
+
+	// the max delegation of the validator, nil for not modify and zero for no limit
+	MaxDelegation     *sdk.Int `json:"max_delegation,omitempty" yaml:"max_delegation"`
+	MaxDelegationRate *Dec     `json:"max_delegation_rate,omitempty" yaml:"max_delegation_rate"`
 }
 
 // NewMsgEditValidator creates a new MsgEditValidator instance
-func NewMsgEditValidator(valAddr chainTypes.AccountID, description Description, newRate *sdk.Dec) MsgEditValidator {
+func NewMsgEditValidator(valAddr chainTypes.AccountID, description Description, newRate *sdk.Dec,
+	maxDelegation *sdk.Int, maxDelegationRate *sdk.Dec) MsgEditValidator {
 	return MsgEditValidator{
-		Description:      description,
-		CommissionRate:   newRate,
-		ValidatorAccount: valAddr,
+		Description:       description,
+		CommissionRate:    newRate,
+		ValidatorAccount:  valAddr,
+		MaxDelegation:     maxDelegation,
+		MaxDelegationRate: maxDelegationRate,
 	}
 }
 
@@ -149,6 +156,9 @@ func (msg MsgEditValidator) ValidateBasic() error {
 			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "commission rate must be between 0 and 1 (inclusive)")
 		}
 	}
+	if err := ValidateDelegationCap(msg.MaxDelegation, msg.MaxDelegationRate); err != nil {
+		return sdkerrors.Wrap(err, "max delegation must be non-negative and max delegation rate must be between 0 and 1 (inclusive)")
+	}
 
 	return nil
 }
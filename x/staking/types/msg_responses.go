@@ -0,0 +1,17 @@
+package types
+
+import (
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+)
+
+var _ chainTypes.MsgResponse = MsgCreateValidatorResponse{}
+
+// MsgCreateValidatorResponse is the response of the create validator msg
+type MsgCreateValidatorResponse struct {
+	Validator AccountID `json:"validator" yaml:"validator"`
+}
+
+// CreatedID implements chainTypes.MsgResponse
+func (r MsgCreateValidatorResponse) CreatedID() string {
+	return r.Validator.String()
+}
@@ -11,6 +11,7 @@ import (
 	stakingexport "github.com/KuChainNetwork/kuchain/x/staking/exported"
 	"github.com/KuChainNetwork/kuchain/x/staking/external"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	yaml "gopkg.in/yaml.v2"
 )
 
@@ -32,6 +33,9 @@ const (
 	DefaultHistoricalEntries uint32 = 0
 )
 
+// DefaultMaxDelegationRate is zero, the delegations of validators are not limited by default
+var DefaultMaxDelegationRate = sdk.ZeroDec()
+
 // nolint - Keys for parameter access
 var (
 	KeyUnbondingTime     = []byte("UnbondingTime")
@@ -39,6 +43,7 @@ var (
 	KeyMaxEntries        = []byte("KeyMaxEntries")
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
+	KeyMaxDelegationRate = []byte("MaxDelegationRate")
 )
 
 var _ external.ParamsSet = (*Params)(nil)
@@ -50,11 +55,13 @@ type Params struct {
 	MaxEntries        uint32        `json:"max_entries,omitempty" yaml:"max_entries"`
 	HistoricalEntries uint32        `json:"historical_entries,omitempty" yaml:"historical_entries"`
 	BondDenom         string        `json:"bond_denom,omitempty" yaml:"bond_denom"`
+	MaxDelegationRate sdk.Dec       `json:"max_delegation_rate" yaml:"max_delegation_rate"` // max rate of the total stake delegated to a validator, zero for no limit
 }
 
 // NewParams creates a new Params instance
 func NewParams(
 	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	maxDelegationRate sdk.Dec,
 ) Params {
 
 	return Params{
@@ -63,6 +70,7 @@ func NewParams(
 		MaxEntries:        maxEntries,
 		HistoricalEntries: historicalEntries,
 		BondDenom:         bondDenom,
+		MaxDelegationRate: maxDelegationRate,
 	}
 }
 
@@ -74,6 +82,7 @@ func (p *Params) ParamSetPairs() external.ParamsSetPairs {
 		external.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		external.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		external.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		external.NewParamSetPair(KeyMaxDelegationRate, &p.MaxDelegationRate, validateMaxDelegationRate),
 	}
 }
 
@@ -85,6 +94,7 @@ func DefaultParams() Params {
 		DefaultMaxEntries,
 		DefaultHistoricalEntries,
 		stakingexport.DefaultBondDenom,
+		DefaultMaxDelegationRate,
 	)
 }
 
@@ -126,6 +136,9 @@ func (p Params) Validate() error {
 	if err := validateBondDenom(p.BondDenom); err != nil {
 		return err
 	}
+	if err := validateMaxDelegationRate(p.MaxDelegationRate); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -194,6 +207,22 @@ func validateBondDenom(i interface{}) error {
 	return nil
 }
 
+func validateMaxDelegationRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("max delegation rate cannot be nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max delegation rate must be between 0 and 1 (inclusive): %s", v)
+	}
+
+	return nil
+}
+
 // Equal returns a boolean determining if two Param types are identical.
 // TODO: This is slower than comparing struct fields directly
 func (p Params) Equal(p2 Params) bool {
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ProposalTypeValidatorAdmission defines the type for a ValidatorAdmissionProposal
+	ProposalTypeValidatorAdmission = "ValidatorAdmission"
+)
+
+// Assert ValidatorAdmissionProposal implements govtypes.Content at compile-time
+var _ govTypes.Content = ValidatorAdmissionProposal{}
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeValidatorAdmission)
+	govTypes.RegisterProposalTypeCodec(ValidatorAdmissionProposal{}, "kuchain/ValidatorAdmissionProposal")
+}
+
+// ValidatorAdmissionProposal admits a validator to or removes it from the validator set
+// in permissioned mode, the power of the admitted validators is still by their delegations.
+type ValidatorAdmissionProposal struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Validator   AccountID `json:"validator" yaml:"validator"`
+	Admit       bool      `json:"admit" yaml:"admit"`
+}
+
+// NewValidatorAdmissionProposal creates a new validator admission proposal.
+func NewValidatorAdmissionProposal(title, description string, validator AccountID, admit bool) ValidatorAdmissionProposal {
+	return ValidatorAdmissionProposal{title, description, validator, admit}
+}
+
+// GetTitle returns the title of a validator admission proposal.
+func (p ValidatorAdmissionProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a validator admission proposal.
+func (p ValidatorAdmissionProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a validator admission proposal.
+func (p ValidatorAdmissionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a validator admission proposal.
+func (p ValidatorAdmissionProposal) ProposalType() string { return ProposalTypeValidatorAdmission }
+
+// ValidateBasic runs basic stateless validity checks
+func (p ValidatorAdmissionProposal) ValidateBasic() error {
+	if err := govTypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if p.Validator.Empty() {
+		return sdkerrors.Wrap(ErrEmptyValidatorAddr, "admission validator")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ValidatorAdmissionProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Validator Admission Proposal:
+  Title:       %s
+  Description: %s
+  Validator:   %s
+  Admit:       %t
+`, p.Title, p.Description, p.Validator, p.Admit))
+	return b.String()
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/KuChainNetwork/kuchain/chain/types"
 )
 
@@ -21,6 +23,9 @@ const (
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
 	QueryHistoricalInfo                = "historicalInfo"
+	QueryAdmittedValidators            = "admittedValidators"
+	QueryValidatorSetChanges           = "validatorSetChanges"
+	QueryUnbondingQueue                = "unbondingQueue"
 )
 
 // defines the params for the following queries:
@@ -108,3 +113,34 @@ type QueryHistoricalInfoParams struct {
 func NewQueryHistoricalInfoParams(height int64) QueryHistoricalInfoParams {
 	return QueryHistoricalInfoParams{height}
 }
+
+// QueryAdmittedValidatorsResponse defines the response of the admitted validators query,
+// the validators admitted only take effect in permissioned mode.
+type QueryAdmittedValidatorsResponse struct {
+	Permissioned bool              `json:"permissioned" yaml:"permissioned"`
+	Validators   []types.AccountID `json:"validators" yaml:"validators"`
+}
+
+// QueryValidatorSetChangesParams defines the params for the following queries:
+// - 'custom/staking/validatorSetChanges'
+type QueryValidatorSetChangesParams struct {
+	FromHeight int64 `json:"from_height" yaml:"from_height"`
+	ToHeight   int64 `json:"to_height" yaml:"to_height"`
+}
+
+// NewQueryValidatorSetChangesParams creates a new QueryValidatorSetChangesParams instance
+func NewQueryValidatorSetChangesParams(fromHeight, toHeight int64) QueryValidatorSetChangesParams {
+	return QueryValidatorSetChangesParams{fromHeight, toHeight}
+}
+
+// QueryUnbondingQueueParams defines the params for the following queries:
+// - 'custom/staking/unbondingQueue'
+type QueryUnbondingQueueParams struct {
+	Within     time.Duration `json:"within" yaml:"within"`
+	BucketSize time.Duration `json:"bucket_size" yaml:"bucket_size"`
+}
+
+// NewQueryUnbondingQueueParams creates a new QueryUnbondingQueueParams instance
+func NewQueryUnbondingQueueParams(within, bucketSize time.Duration) QueryUnbondingQueueParams {
+	return QueryUnbondingQueueParams{within, bucketSize}
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UnbondingQueueBucket is the stake to complete unbonding in a completion time bucket
+type UnbondingQueueBucket struct {
+	Start   time.Time `json:"start" yaml:"start"`
+	Balance sdk.Int   `json:"balance" yaml:"balance"`
+	Entries uint64    `json:"entries" yaml:"entries"`
+}
+
+// UnbondingQueueValidator is the stake to complete unbonding from a validator
+type UnbondingQueueValidator struct {
+	Validator AccountID `json:"validator" yaml:"validator"`
+	Balance   sdk.Int   `json:"balance" yaml:"balance"`
+	Entries   uint64    `json:"entries" yaml:"entries"`
+}
+
+// UnbondingQueueSummary is the summary of the stake to complete unbonding in [From, To]
+type UnbondingQueueSummary struct {
+	From       time.Time                 `json:"from" yaml:"from"`
+	To         time.Time                 `json:"to" yaml:"to"`
+	Total      sdk.Int                   `json:"total" yaml:"total"`
+	Buckets    []UnbondingQueueBucket    `json:"buckets" yaml:"buckets"`
+	Validators []UnbondingQueueValidator `json:"validators" yaml:"validators"`
+}
+
+// NewUnbondingQueueSummary creates an empty summary of the unbonding queue
+func NewUnbondingQueueSummary(from, to time.Time) UnbondingQueueSummary {
+	return UnbondingQueueSummary{
+		From:       from,
+		To:         to,
+		Total:      sdk.ZeroInt(),
+		Buckets:    []UnbondingQueueBucket{},
+		Validators: []UnbondingQueueValidator{},
+	}
+}
+
+// AddEntry adds an unbonding entry from the validator to the bucket started at bucketStart,
+// the buckets are added in the time order by the unbonding queue.
+func (s *UnbondingQueueSummary) AddEntry(bucketStart time.Time, validator AccountID, balance sdk.Int) {
+	s.Total = s.Total.Add(balance)
+
+	if n := len(s.Buckets); n == 0 || !s.Buckets[n-1].Start.Equal(bucketStart) {
+		s.Buckets = append(s.Buckets, UnbondingQueueBucket{Start: bucketStart, Balance: sdk.ZeroInt()})
+	}
+	bucket := &s.Buckets[len(s.Buckets)-1]
+	bucket.Balance = bucket.Balance.Add(balance)
+	bucket.Entries++
+
+	for i := range s.Validators {
+		if s.Validators[i].Validator.Eq(validator) {
+			s.Validators[i].Balance = s.Validators[i].Balance.Add(balance)
+			s.Validators[i].Entries++
+			return
+		}
+	}
+
+	s.Validators = append(s.Validators, UnbondingQueueValidator{Validator: validator, Balance: balance, Entries: 1})
+}
+
+// String implements fmt.Stringer
+func (s UnbondingQueueSummary) String() string {
+	var out strings.Builder
+	fmt.Fprintf(&out, "Unbonding from %s to %s: %s\n", s.From.Format(time.RFC3339), s.To.Format(time.RFC3339), s.Total)
+
+	out.WriteString("Buckets:\n")
+	for _, b := range s.Buckets {
+		fmt.Fprintf(&out, "  %s: %s (%d entries)\n", b.Start.Format(time.RFC3339), b.Balance, b.Entries)
+	}
+
+	out.WriteString("Validators:\n")
+	for _, v := range s.Validators {
+		fmt.Fprintf(&out, "  %s: %s (%d entries)\n", v.Validator, v.Balance, v.Entries)
+	}
+
+	return strings.TrimSpace(out.String())
+}
@@ -50,6 +50,8 @@ type Validator struct {
 	UnbondingTime     time.Time                `json:"unbonding_time" yaml:"unbonding_time"`
 	Commission        Commission               `json:"commission" yaml:"commission"`
 	MinSelfDelegation sdk.Int                  `json:"min_self_delegation" yaml:"min_self_delegation"`
+	MaxDelegation     *sdk.Int                 `json:"max_delegation,omitempty" yaml:"max_delegation"`
+	MaxDelegationRate *sdk.Dec                 `json:"max_delegation_rate,omitempty" yaml:"max_delegation_rate"`
 }
 
 func NewValidator(operator types.AccountID, pubKey crypto.PubKey, description Description) Validator {
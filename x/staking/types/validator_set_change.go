@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The reasons of the validator set changes
+const (
+	SetChangeReasonJoined       = "joined"
+	SetChangeReasonLeft         = "left"
+	SetChangeReasonJailed       = "jailed"
+	SetChangeReasonTombstoned   = "tombstoned"
+	SetChangeReasonPowerChanged = "power_changed"
+)
+
+// PowerChangeThreshold the relative power change of a bonded validator to be recorded as a set change
+var PowerChangeThreshold = sdk.NewDecWithPrec(1, 1)
+
+// ValidatorSetChange is a change of the active validator set with the reason
+type ValidatorSetChange struct {
+	Height    int64     `json:"height" yaml:"height"`
+	Validator AccountID `json:"validator" yaml:"validator"`
+	Reason    string    `json:"reason" yaml:"reason"`
+	OldPower  int64     `json:"old_power" yaml:"old_power"`
+	NewPower  int64     `json:"new_power" yaml:"new_power"`
+}
+
+// NewValidatorSetChange creates a new validator set change
+func NewValidatorSetChange(height int64, validator AccountID, reason string, oldPower, newPower int64) ValidatorSetChange {
+	return ValidatorSetChange{
+		Height:    height,
+		Validator: validator,
+		Reason:    reason,
+		OldPower:  oldPower,
+		NewPower:  newPower,
+	}
+}
+
+// String implements fmt.Stringer
+func (c ValidatorSetChange) String() string {
+	return fmt.Sprintf("%d %s %s: %d -> %d", c.Height, c.Validator, c.Reason, c.OldPower, c.NewPower)
+}
+
+// ValidatorSetChanges is a collection of ValidatorSetChange
+type ValidatorSetChanges []ValidatorSetChange
+
+// IsPowerChangeSignificant returns true if the relative power change reaches the PowerChangeThreshold
+func IsPowerChangeSignificant(oldPower, newPower int64) bool {
+	if oldPower == 0 {
+		return newPower != 0
+	}
+
+	diff := newPower - oldPower
+	if diff < 0 {
+		diff = -diff
+	}
+
+	return sdk.NewDec(diff).QuoInt64(oldPower).GTE(PowerChangeThreshold)
+}
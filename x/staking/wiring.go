@@ -0,0 +1,21 @@
+package staking
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/staking/types"
+)
+
+// Inputs the keepers the staking module depends on
+type Inputs struct {
+	BankKeeper    types.BankKeeper
+	SupplyKeeper  types.SupplyKeeper
+	AccountKeeper types.AccountStatKeeper
+}
+
+// ProvideKeeper creates the staking keeper by the store key and params subspace declared to the builder,
+// the hooks should be set by the app after the keepers of hooks created.
+func ProvideKeeper(b *wiring.Builder, in Inputs) Keeper {
+	return NewKeeper(
+		b.Codec(), b.KVStoreKey(StoreKey), in.BankKeeper, in.SupplyKeeper, b.Subspace(DefaultParamspace), in.AccountKeeper,
+	)
+}
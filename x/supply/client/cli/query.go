@@ -27,6 +27,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	supplyQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryTotalSupply(cdc),
+		GetCmdQueryBurnedSupply(cdc),
 	)...)
 
 	return supplyQueryCmd
@@ -62,6 +63,39 @@ $ %s query %s total stake
 	}
 }
 
+// GetCmdQueryBurnedSupply implements the query burned supply command.
+func GetCmdQueryBurnedSupply(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "burned",
+		Args:  cobra.NoArgs,
+		Short: "Query the cumulative coins burned of the chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the cumulative coins which have been burned by fees and modules.
+
+Example:
+$ %s query %s burned
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBurned), nil)
+			if err != nil {
+				return err
+			}
+
+			var burned types.Coins
+			if err := cdc.UnmarshalJSON(res, &burned); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(burned)
+		},
+	}
+}
+
 func queryTotalSupply(cliCtx context.CLIContext, cdc *codec.Codec) error {
 	params := types.NewQueryTotalSupplyParams(1, 0) // no pagination
 	bz, err := cdc.MarshalJSON(params)
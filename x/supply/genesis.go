@@ -1,6 +1,8 @@
 package supply
 
 import (
+	"fmt"
+
 	"github.com/KuChainNetwork/kuchain/x/supply/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -9,15 +11,24 @@ import (
 //
 // CONTRACT: all typesxx of accounts must have been already initialized/created
 func InitGenesis(ctx sdk.Context, keeper Keeper, bk types.BankKeeper, data GenesisState) {
+	if !data.Burned.IsZero() {
+		keeper.SetBurnedSupply(ctx, data.Burned)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSupply(ctx).GetTotal())
+	gs := NewGenesisState(keeper.GetSupply(ctx).GetTotal())
+	gs.Burned = keeper.GetBurnedSupply(ctx)
+	return gs
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	if !data.Burned.IsValid() {
+		return fmt.Errorf("invalid burned supply: %s", data.Burned)
+	}
+
 	return types.NewSupply(data.Supply).ValidateBasic()
 }
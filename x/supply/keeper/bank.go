@@ -49,6 +49,8 @@ func (k Keeper) SendCoinsFromModuleToModule(
 			"SendCoinsFromModuleToModule %s to %s by %s", senderAcc.String(), recipientAcc.String(), amt.String())
 	}
 
+	k.trackBurnedSupply(ctx, recipientModule, amt)
+
 	return nil
 }
 
@@ -69,6 +71,8 @@ func (k Keeper) SendCoinsFromAccountToModule(
 			"SendCoinsFromAccountToModule %s to %s by %s", sender.String(), recipientModule, amt.String())
 	}
 
+	k.trackBurnedSupply(ctx, recipientModule, amt)
+
 	return nil
 }
 
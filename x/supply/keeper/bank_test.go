@@ -259,3 +259,39 @@ func TestBurnCoins(t *testing.T) {
 	require.Equal(t, chainType.Coins{chainType.NewCoin(constants.DefaultBondDenom, sdk.NewInt(0))}, multiPermAccCoins)
 	require.Equal(t, chainType.Coins{chainType.NewCoin(constants.DefaultBondDenom, sdk.NewInt(300000000))}, keeper.GetSupply(ctx).GetTotal())
 }
+
+func TestBurnedSupply(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := *app.SupplyKeeper()
+
+	require.True(t, keeper.GetBurnedSupply(ctx).IsZero())
+
+	{
+		SymbolName, _ := chainType.NewName(constants.DefaultBondSymbol)
+		MasterName, _ := chainType.NewName(constants.ChainMainNameStr)
+
+		intNum2, _ := sdk.NewIntFromString("80000000000000000000000")
+		intMaxNum, _ := sdk.NewIntFromString("100000000000000000000000")
+
+		app.AssetKeeper().Create(ctx, MasterName, SymbolName, assettypes.NewCoin(constants.DefaultBondDenom, intNum2),
+			true, true, 0, chainType.NewCoin(constants.DefaultBondDenom, intMaxNum), []byte("create"))
+	}
+
+	keeper.SetModuleAccount(ctx, burnerAcc)
+
+	_, err := app.AssetKeeper().IssueCoinPower(ctx, burnerAcc.GetID(), initCoins.Add(initCoins...))
+	require.NoError(t, err)
+
+	bName, _ := chainType.NewName(types.Burner)
+	bAcc := app.AccountKeeper().NewAccountByName(ctx, bName)
+
+	require.NoError(t, keeper.BurnCoins(ctx, bAcc.GetID(), initCoins))
+	require.Equal(t, initCoins, keeper.GetBurnedSupply(ctx))
+
+	require.NoError(t, keeper.BurnCoins(ctx, bAcc.GetID(), initCoins))
+	require.Equal(t, initCoins.Add(initCoins...), keeper.GetBurnedSupply(ctx))
+
+	// the send to other module is not burned
+	require.NoError(t, keeper.SendCoinsFromModuleToModule(ctx, types.BlackHole, types.Burner, initCoins))
+	require.Equal(t, initCoins.Add(initCoins...), keeper.GetBurnedSupply(ctx))
+}
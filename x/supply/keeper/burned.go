@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/supply/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetBurnedSupply returns the cumulative coins which have been burned to the black hole
+func (k Keeper) GetBurnedSupply(ctx sdk.Context) Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BurnedSupplyKey)
+	if bz == nil {
+		return Coins{}
+	}
+
+	var burned Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &burned)
+	return burned
+}
+
+// SetBurnedSupply sets the cumulative burned coins
+func (k Keeper) SetBurnedSupply(ctx sdk.Context, burned Coins) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BurnedSupplyKey, k.cdc.MustMarshalBinaryBare(burned))
+}
+
+// trackBurnedSupply adds the coins sent to the black hole to the burned supply
+func (k Keeper) trackBurnedSupply(ctx sdk.Context, recipientModule string, amt Coins) {
+	if recipientModule != types.BlackHole || amt.IsZero() {
+		return
+	}
+
+	k.SetBurnedSupply(ctx, k.GetBurnedSupply(ctx).Add(amt...))
+}
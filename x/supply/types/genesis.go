@@ -10,11 +10,12 @@ import (
 // GenesisState is the supply state that must be provided at genesis.
 type GenesisState struct {
 	Supply types.Coins `json:"supply" yaml:"supply"`
+	Burned types.Coins `json:"burned,omitempty" yaml:"burned"`
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(supply types.Coins) GenesisState {
-	return GenesisState{supply}
+	return GenesisState{Supply: supply}
 }
 
 // DefaultGenesisState returns a default genesis state
@@ -30,5 +31,9 @@ func (g GenesisState) ValidateGenesis(bz json.RawMessage) error {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
 	}
 
+	if !gs.Burned.IsValid() {
+		return fmt.Errorf("invalid burned supply: %s", gs.Burned)
+	}
+
 	return NewSupply(gs.Supply).ValidateBasic()
 }
@@ -15,3 +15,8 @@ const (
 
 	BlackHole = "blackhole"
 )
+
+var (
+	// BurnedSupplyKey is the key for the cumulative coins burned to black hole
+	BurnedSupplyKey = []byte{0x01}
+)
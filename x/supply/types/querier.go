@@ -4,6 +4,7 @@ package types
 const (
 	QueryTotalSupply = "total_supply"
 	QuerySupplyOf    = "supply_of"
+	QueryBurned      = "burned_supply"
 )
 
 // QueryTotalSupply defines the params for the following queries:
@@ -0,0 +1,18 @@
+package supply
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+	"github.com/KuChainNetwork/kuchain/x/supply/types"
+)
+
+// Inputs the keepers and module account permissions the supply module depends on
+type Inputs struct {
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
+	MaccPerms     map[string][]string
+}
+
+// ProvideKeeper creates the supply keeper by the store key declared to the builder
+func ProvideKeeper(b *wiring.Builder, in Inputs) Keeper {
+	return NewKeeper(b.Codec(), b.KVStoreKey(StoreKey), in.AccountKeeper, in.BankKeeper, in.MaccPerms)
+}
@@ -0,0 +1,64 @@
+package upgrade
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker checks the upgrade plan scheduled, if the plan height reached:
+// if the upgrade handler is set by the binary, the upgrade will be applied,
+// else writes the upgrade info to upgrade-info.json and refuse to continue,
+// so that the supervisors can swap binaries by the info.
+func BeginBlocker(ctx sdk.Context, k Keeper, _ abci.RequestBeginBlock) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return
+	}
+
+	logger := k.Logger(ctx)
+
+	if !plan.ShouldExecute(ctx) {
+		// the new binary should not be started before the upgrade height
+		if k.HasHandler(plan.Name) {
+			msg := fmt.Sprintf("BINARY UPDATED BEFORE TRIGGER! UPGRADE \"%s\" - in binary but not executed on chain", plan.Name)
+			logger.Error(msg)
+			panic(msg)
+		}
+		return
+	}
+
+	if k.IsSkipHeight(plan.Height) {
+		logger.Info(fmt.Sprintf("UPGRADE \"%s\" SKIPPED at %d: %s", plan.Name, plan.Height, plan.Info))
+		k.ClearUpgradePlan(ctx)
+		return
+	}
+
+	if !k.HasHandler(plan.Name) {
+		if err := k.DumpUpgradeInfoToDisk(plan); err != nil {
+			logger.Error("failed to write upgrade info", "path", k.UpgradeInfoPath(), "err", err)
+		}
+
+		// we don't have an upgrade handler for this upgrade name, meaning this software is out of date so shutdown
+		msg := fmt.Sprintf("UPGRADE \"%s\" NEEDED at %s: %s", plan.Name, plan.DueAt(), plan.Info)
+		logger.Error(msg)
+		panic(msg)
+	}
+
+	logger.Info(fmt.Sprintf("applying upgrade \"%s\" at %s", plan.Name, plan.DueAt()))
+	k.ApplyUpgrade(ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter()), plan)
+}
+
+// EndBlocker clears the halt scheduled when its height reached, so that the
+// nodes can continue after restart, then marks the node to halt after commit.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	height := k.GetHaltHeight(ctx)
+	if height == 0 || ctx.BlockHeight() < height {
+		return
+	}
+
+	k.SetHaltHeight(ctx, 0)
+	k.MarkHalt(ctx, height)
+}
@@ -0,0 +1,116 @@
+package upgrade_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/KuChainNetwork/kuchain/test/simapp"
+	"github.com/KuChainNetwork/kuchain/x/upgrade"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "github.com/smartystreets/goconvey/convey"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestHaltProposal(t *testing.T) {
+	app := simapp.SetupWithGenesisAccounts(simapp.NewGenesisAccounts(simapp.NewWallet().GetRootAuth()))
+	k := app.UpgradeKeeper()
+	handler := upgrade.NewUpgradeProposalHandler(*k)
+
+	Convey("test halt proposal", t, func() {
+		ctx, _ := app.NewTestContext().CacheContext()
+		ctx = ctx.WithBlockHeight(10)
+
+		So(upgrade.NewHaltProposal("halt", "maintenance", 0).ValidateBasic(), simapp.ShouldErrIs, upgrade.ErrInvalidHaltHeight)
+		So(handler(ctx, upgrade.NewHaltProposal("halt", "maintenance", 10)), simapp.ShouldErrIs, upgrade.ErrInvalidHaltHeight)
+
+		So(handler(ctx, upgrade.NewHaltProposal("halt", "maintenance", 12)), ShouldBeNil)
+		So(k.GetHaltHeight(ctx), ShouldEqual, 12)
+
+		Convey("no halt before the height", func() {
+			upgrade.EndBlocker(ctx.WithBlockHeight(11), *k)
+			_, ok := k.HaltPending()
+			So(ok, ShouldBeFalse)
+			So(k.GetHaltHeight(ctx), ShouldEqual, 12)
+		})
+
+		Convey("halt at the height and cleared for restart", func() {
+			upgrade.EndBlocker(ctx.WithBlockHeight(12), *k)
+			height, ok := k.HaltPending()
+			So(ok, ShouldBeTrue)
+			So(height, ShouldEqual, 12)
+			So(k.GetHaltHeight(ctx), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestSoftwareUpgradeProposal(t *testing.T) {
+	app := simapp.SetupWithGenesisAccounts(simapp.NewGenesisAccounts(simapp.NewWallet().GetRootAuth()))
+
+	Convey("test software upgrade proposal", t, func() {
+		home, cleanup := simapp.NewTestCaseDir(t)
+		defer cleanup()
+
+		k := upgrade.NewKeeper(app.Codec(), app.GetKey(upgrade.StoreKey), map[int64]bool{20: true}, home)
+		handler := upgrade.NewUpgradeProposalHandler(k)
+
+		ctx, _ := app.NewTestContext().CacheContext()
+		ctx = ctx.WithBlockHeight(10)
+
+		So(upgrade.NewPlan("", 12, "").ValidateBasic(), simapp.ShouldErrIs, upgrade.ErrInvalidUpgradePlan)
+		So(handler(ctx, upgrade.NewSoftwareUpgradeProposal("upgrade", "v2", upgrade.NewPlan("v2", 10, ""))),
+			simapp.ShouldErrIs, upgrade.ErrInvalidUpgradePlan)
+		So(handler(ctx, upgrade.NewCancelSoftwareUpgradeProposal("cancel", "v2")), simapp.ShouldErrIs, upgrade.ErrNoUpgradePlan)
+
+		So(handler(ctx, upgrade.NewSoftwareUpgradeProposal("upgrade", "v2", upgrade.NewPlan("v2", 12, "binaries"))), ShouldBeNil)
+		plan, found := k.GetUpgradePlan(ctx)
+		So(found, ShouldBeTrue)
+		So(plan.Name, ShouldEqual, "v2")
+
+		Convey("halt with upgrade info at the height without handler", func() {
+			upgrade.BeginBlocker(ctx.WithBlockHeight(11), k, abci.RequestBeginBlock{})
+			So(func() { upgrade.BeginBlocker(ctx.WithBlockHeight(12), k, abci.RequestBeginBlock{}) }, ShouldPanic)
+
+			bz, err := ioutil.ReadFile(k.UpgradeInfoPath())
+			So(err, ShouldBeNil)
+
+			var info upgrade.UpgradeInfo
+			So(json.Unmarshal(bz, &info), ShouldBeNil)
+			So(info, ShouldResemble, upgrade.UpgradeInfo{Name: "v2", Height: 12, Info: "binaries"})
+		})
+
+		Convey("apply upgrade at the height with handler", func() {
+			applied := false
+			k.SetUpgradeHandler("v2", func(ctx sdk.Context, plan upgrade.Plan) { applied = true })
+
+			So(func() { upgrade.BeginBlocker(ctx.WithBlockHeight(11), k, abci.RequestBeginBlock{}) }, ShouldPanic)
+
+			upgrade.BeginBlocker(ctx.WithBlockHeight(12), k, abci.RequestBeginBlock{})
+			So(applied, ShouldBeTrue)
+			So(k.GetDoneHeight(ctx, "v2"), ShouldEqual, 12)
+
+			_, found := k.GetUpgradePlan(ctx)
+			So(found, ShouldBeFalse)
+
+			So(handler(ctx, upgrade.NewSoftwareUpgradeProposal("upgrade", "v2", upgrade.NewPlan("v2", 20, ""))),
+				simapp.ShouldErrIs, upgrade.ErrInvalidUpgradePlan)
+		})
+
+		Convey("skip upgrade at skip height", func() {
+			So(handler(ctx, upgrade.NewSoftwareUpgradeProposal("upgrade", "v3", upgrade.NewPlan("v3", 20, ""))), ShouldBeNil)
+			upgrade.BeginBlocker(ctx.WithBlockHeight(20), k, abci.RequestBeginBlock{})
+
+			_, found := k.GetUpgradePlan(ctx)
+			So(found, ShouldBeFalse)
+			So(k.GetDoneHeight(ctx, "v3"), ShouldEqual, 0)
+		})
+
+		Convey("cancel upgrade", func() {
+			So(handler(ctx, upgrade.NewCancelSoftwareUpgradeProposal("cancel", "v2")), ShouldBeNil)
+			upgrade.BeginBlocker(ctx.WithBlockHeight(12), k, abci.RequestBeginBlock{})
+
+			_, found := k.GetUpgradePlan(ctx)
+			So(found, ShouldBeFalse)
+		})
+	})
+}
@@ -0,0 +1,58 @@
+package upgrade
+
+// nolint
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/upgrade/keeper"
+	"github.com/KuChainNetwork/kuchain/x/upgrade/types"
+)
+
+const (
+	ModuleName       = types.ModuleName
+	StoreKey         = types.StoreKey
+	RouterKey        = types.RouterKey
+	QuerierRoute     = types.QuerierRoute
+	QueryHalt        = types.QueryHalt
+	QueryPlan        = types.QueryPlan
+	QueryApplied     = types.QueryApplied
+	ProposalTypeHalt = types.ProposalTypeHalt
+	HaltExitCode     = keeper.HaltExitCode
+
+	ProposalTypeSoftwareUpgrade       = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeCancelSoftwareUpgrade = types.ProposalTypeCancelSoftwareUpgrade
+	UpgradeInfoFileName               = types.UpgradeInfoFileName
+)
+
+var (
+	// functions aliases
+	NewKeeper                        = keeper.NewKeeper
+	NewQuerier                       = keeper.NewQuerier
+	HaltNode                         = keeper.HaltNode
+	NewHaltProposal                  = types.NewHaltProposal
+	NewPlan                          = types.NewPlan
+	NewSoftwareUpgradeProposal       = types.NewSoftwareUpgradeProposal
+	NewCancelSoftwareUpgradeProposal = types.NewCancelSoftwareUpgradeProposal
+	NewGenesisState                  = types.NewGenesisState
+	DefaultGenesisState              = types.DefaultGenesisState
+	ValidateGenesis                  = types.ValidateGenesis
+	RegisterCodec                    = types.RegisterCodec
+
+	// variable aliases
+	ModuleCdc             = types.ModuleCdc
+	Cdc                   = types.Cdc
+	ErrInvalidHaltHeight  = types.ErrInvalidHaltHeight
+	ErrInvalidUpgradePlan = types.ErrInvalidUpgradePlan
+	ErrNoUpgradePlan      = types.ErrNoUpgradePlan
+)
+
+type (
+	Keeper         = keeper.Keeper
+	GenesisState   = types.GenesisState
+	HaltProposal   = types.HaltProposal
+	Plan           = types.Plan
+	UpgradeInfo    = types.UpgradeInfo
+	UpgradeHandler = keeper.UpgradeHandler
+
+	SoftwareUpgradeProposal       = types.SoftwareUpgradeProposal
+	CancelSoftwareUpgradeProposal = types.CancelSoftwareUpgradeProposal
+)
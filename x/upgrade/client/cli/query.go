@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/blockutil"
+	"github.com/KuChainNetwork/kuchain/chain/client/flags"
+	"github.com/KuChainNetwork/kuchain/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	upgradeQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the upgrade module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	upgradeQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryHalt(cdc),
+			GetCmdQueryPlan(cdc),
+			GetCmdQueryApplied(cdc),
+		)...,
+	)
+
+	return upgradeQueryCmd
+}
+
+// GetCmdQueryHalt implements a command to fetch the halt height scheduled.
+func GetCmdQueryHalt(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "halt",
+		Short: "Query the halt height scheduled by governance",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the height the chain will halt at, 0 if no halt scheduled:
+
+$ %s query upgrade halt
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHalt)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var height int64
+			cdc.MustUnmarshalJSON(res, &height)
+			if err := cliCtx.PrintOutput(height); err != nil {
+				return err
+			}
+
+			blockutil.PrintHeightNote(cliCtx, cmd.ErrOrStderr(), "halt", height)
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryPlan implements a command to fetch the upgrade plan scheduled.
+func GetCmdQueryPlan(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Short: "Query the upgrade plan scheduled by governance",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the upgrade plan scheduled, if there is one:
+
+$ %s query upgrade plan
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPlan)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			var plan types.Plan
+			cdc.MustUnmarshalJSON(res, &plan)
+			if err := cliCtx.PrintOutput(plan); err != nil {
+				return err
+			}
+
+			blockutil.PrintHeightNote(cliCtx, cmd.ErrOrStderr(), "upgrade "+plan.Name, plan.Height)
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryApplied implements a command to fetch the height the upgrade applied at.
+func GetCmdQueryApplied(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied [upgrade-name]",
+		Short: "Query the height the upgrade applied at",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the height the upgrade applied at, 0 if not applied:
+
+$ %s query upgrade applied v2
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryApplied, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var height int64
+			cdc.MustUnmarshalJSON(res, &height)
+			return cliCtx.PrintOutput(height)
+		},
+	}
+}
@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	govCli "github.com/KuChainNetwork/kuchain/x/gov/client/cli"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/KuChainNetwork/kuchain/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagUpgradeHeight = "upgrade-height"
+	flagUpgradeInfo   = "upgrade-info"
+)
+
+// GetCmdSubmitHaltProposal implements a command handler for submitting a halt proposal transaction.
+func GetCmdSubmitHaltProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halt [proposer] [height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to halt the chain at a height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to halt all nodes after the block of the height committed,
+for a coordinated maintenance, the nodes can be restarted to continue the chain.
+
+Example:
+$ %s tx kugov submit-proposal halt jack 100000 --title="Halt" --description="maintenance" --deposit="1000kuchain/kcs" --from=<key>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "halt height error")
+			}
+
+			content := types.NewHaltProposal(
+				viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription), height)
+
+			return submitProposal(cmd, cdc, args[0], content)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// GetCmdSubmitUpgradeProposal implements a command handler for submitting a software upgrade proposal transaction.
+func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade [proposer] [name]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a software upgrade proposal, at the upgrade height the nodes without
+the upgrade handler of the name will write the plan to data/upgrade-info.json and stop,
+so that the supervisors like cosmovisor can swap the binary.
+
+Example:
+$ %s tx kugov submit-proposal software-upgrade jack v2 --upgrade-height=100000 --upgrade-info="https://..." --title="Upgrade v2" --description="upgrade to v2" --deposit="1000kuchain/kcs" --from=<key>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			plan := types.NewPlan(args[1], viper.GetInt64(flagUpgradeHeight), viper.GetString(flagUpgradeInfo))
+			content := types.NewSoftwareUpgradeProposal(
+				viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription), plan)
+
+			return submitProposal(cmd, cdc, args[0], content)
+		},
+	}
+
+	addProposalFlags(cmd)
+	cmd.Flags().Int64(flagUpgradeHeight, 0, "the height at which the upgrade must happen")
+	cmd.Flags().String(flagUpgradeInfo, "", "optional info for the planned upgrade such as commit hash or binaries url")
+
+	return cmd
+}
+
+// GetCmdSubmitCancelUpgradeProposal implements a command handler for submitting a cancel software upgrade proposal transaction.
+func GetCmdSubmitCancelUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade [proposer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel the software upgrade scheduled",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel the software upgrade scheduled.
+
+Example:
+$ %s tx kugov submit-proposal cancel-software-upgrade jack --title="Cancel v2" --description="cancel the upgrade" --deposit="1000kuchain/kcs" --from=<key>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			content := types.NewCancelSoftwareUpgradeProposal(
+				viper.GetString(govCli.FlagTitle), viper.GetString(govCli.FlagDescription))
+
+			return submitProposal(cmd, cdc, args[0], content)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govCli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govCli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+}
+
+// submitProposal builds the submit proposal msg by the content and broadcasts it
+func submitProposal(cmd *cobra.Command, cdc *codec.Codec, proposer string, content govTypes.Content) error {
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	txBldr := txutil.NewTxBuilderFromCLI(inBuf).WithTxEncoder(txutil.GetTxEncoder(cdc))
+	cliCtx := txutil.NewKuCLICtxByBuf(cdc, inBuf)
+
+	proposerAccount, err := chainTypes.NewAccountIDFromStr(proposer)
+	if err != nil {
+		return sdkerrors.Wrap(err, "proposer account id error")
+	}
+
+	deposit, err := chainTypes.ParseCoins(viper.GetString(govCli.FlagDeposit))
+	if err != nil {
+		return err
+	}
+
+	from := cliCtx.GetFromAddress()
+	msg := govTypes.NewKuMsgSubmitProposal(from, content, deposit, proposerAccount)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	cliCtx = cliCtx.WithFromAccount(proposerAccount)
+	return txutil.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
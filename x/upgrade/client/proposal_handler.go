@@ -0,0 +1,14 @@
+package client
+
+import (
+	"github.com/KuChainNetwork/kuchain/x/gov/client"
+	"github.com/KuChainNetwork/kuchain/x/upgrade/client/cli"
+	"github.com/KuChainNetwork/kuchain/x/upgrade/client/rest"
+)
+
+// upgrade proposal handlers
+var (
+	HaltProposalHandler          = client.NewProposalHandler(cli.GetCmdSubmitHaltProposal, rest.HaltProposalRESTHandler)
+	UpgradeProposalHandler       = client.NewProposalHandler(cli.GetCmdSubmitUpgradeProposal, rest.UpgradeProposalRESTHandler)
+	CancelUpgradeProposalHandler = client.NewProposalHandler(cli.GetCmdSubmitCancelUpgradeProposal, rest.CancelUpgradeProposalRESTHandler)
+)
@@ -0,0 +1,207 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/KuChainNetwork/kuchain/chain/client/txutil"
+	chainTypes "github.com/KuChainNetwork/kuchain/chain/types"
+	govRest "github.com/KuChainNetwork/kuchain/x/gov/client/rest"
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	"github.com/KuChainNetwork/kuchain/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+)
+
+// HaltProposalReq defines a halt proposal request body.
+type HaltProposalReq struct {
+	BaseReq chainTypes.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title              string               `json:"title" yaml:"title"`
+	Description        string               `json:"description" yaml:"description"`
+	Height             int64                `json:"height" yaml:"height"`
+	Proposer           chainTypes.AccountID `json:"proposer" yaml:"proposer"`
+	Deposit            chainTypes.Coins     `json:"deposit" yaml:"deposit"`
+	ProposerAccAddress sdk.AccAddress       `json:"proposer_accaddress" yaml:"proposer_accaddress"`
+}
+
+// SoftwareUpgradeProposalReq defines a software upgrade proposal request body.
+type SoftwareUpgradeProposalReq struct {
+	BaseReq chainTypes.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title              string               `json:"title" yaml:"title"`
+	Description        string               `json:"description" yaml:"description"`
+	Name               string               `json:"name" yaml:"name"`
+	UpgradeHeight      int64                `json:"upgrade_height" yaml:"upgrade_height"`
+	UpgradeInfo        string               `json:"upgrade_info" yaml:"upgrade_info"`
+	Proposer           chainTypes.AccountID `json:"proposer" yaml:"proposer"`
+	Deposit            chainTypes.Coins     `json:"deposit" yaml:"deposit"`
+	ProposerAccAddress sdk.AccAddress       `json:"proposer_accaddress" yaml:"proposer_accaddress"`
+}
+
+// CancelSoftwareUpgradeProposalReq defines a cancel software upgrade proposal request body.
+type CancelSoftwareUpgradeProposalReq struct {
+	BaseReq chainTypes.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title              string               `json:"title" yaml:"title"`
+	Description        string               `json:"description" yaml:"description"`
+	Proposer           chainTypes.AccountID `json:"proposer" yaml:"proposer"`
+	Deposit            chainTypes.Coins     `json:"deposit" yaml:"deposit"`
+	ProposerAccAddress sdk.AccAddress       `json:"proposer_accaddress" yaml:"proposer_accaddress"`
+}
+
+// RegisterRoutes registers upgrade REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// Query the halt height scheduled by governance
+	r.HandleFunc(
+		"/upgrade/halt",
+		queryHandlerFn(cliCtx, types.QueryHalt),
+	).Methods("GET")
+
+	// Query the upgrade plan scheduled
+	r.HandleFunc(
+		"/upgrade/plan",
+		queryHandlerFn(cliCtx, types.QueryPlan),
+	).Methods("GET")
+
+	// Query the height at which the upgrade applied
+	r.HandleFunc(
+		"/upgrade/applied/{name}",
+		appliedHandlerFn(cliCtx),
+	).Methods("GET")
+}
+
+// HaltProposalRESTHandler returns a ProposalRESTHandler that exposes the halt REST handler with a given sub-route.
+func HaltProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "halt",
+		Handler:  postHaltProposalHandlerFn(cliCtx),
+	}
+}
+
+// UpgradeProposalRESTHandler returns a ProposalRESTHandler that exposes the software upgrade REST handler with a given sub-route.
+func UpgradeProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "software_upgrade",
+		Handler:  postUpgradeProposalHandlerFn(cliCtx),
+	}
+}
+
+// CancelUpgradeProposalRESTHandler returns a ProposalRESTHandler that exposes the cancel software upgrade REST handler with a given sub-route.
+func CancelUpgradeProposalRESTHandler(cliCtx context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "cancel_software_upgrade",
+		Handler:  postCancelUpgradeProposalHandlerFn(cliCtx),
+	}
+}
+
+// HTTP request handler to query the upgrade module by path
+func queryHandlerFn(cliCtx context.CLIContext, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the height at which the upgrade applied
+func appliedHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryApplied, name), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func postHaltProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req HaltProposalReq
+		if !chainTypes.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewHaltProposal(req.Title, req.Description, req.Height)
+		msg := govTypes.NewKuMsgSubmitProposal(req.ProposerAccAddress, content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			chainTypes.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txutil.WriteGenerateStdTxResponse(w, txutil.NewKuCLICtx(cliCtx), req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postUpgradeProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SoftwareUpgradeProposalReq
+		if !chainTypes.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		plan := types.NewPlan(req.Name, req.UpgradeHeight, req.UpgradeInfo)
+		content := types.NewSoftwareUpgradeProposal(req.Title, req.Description, plan)
+		writeProposal(w, cliCtx, req.BaseReq, req.ProposerAccAddress, content, req.Deposit, req.Proposer)
+	}
+}
+
+func postCancelUpgradeProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelSoftwareUpgradeProposalReq
+		if !chainTypes.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description)
+		writeProposal(w, cliCtx, req.BaseReq, req.ProposerAccAddress, content, req.Deposit, req.Proposer)
+	}
+}
+
+func writeProposal(w http.ResponseWriter, cliCtx context.CLIContext, baseReq chainTypes.BaseReq,
+	from sdk.AccAddress, content govTypes.Content, deposit chainTypes.Coins, proposer chainTypes.AccountID) {
+	msg := govTypes.NewKuMsgSubmitProposal(from, content, deposit, proposer)
+	if err := msg.ValidateBasic(); err != nil {
+		chainTypes.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	txutil.WriteGenerateStdTxResponse(w, txutil.NewKuCLICtx(cliCtx), baseReq, []sdk.Msg{msg})
+}
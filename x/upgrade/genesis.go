@@ -0,0 +1,28 @@
+package upgrade
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis sets the halt height and the upgrade plan scheduled
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetHaltHeight(ctx, data.HaltHeight)
+
+	if data.Plan != nil {
+		if err := keeper.ScheduleUpgrade(ctx, *data.Plan); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis writes the current store values
+// to a genesis file, which can be imported again
+// with InitGenesis
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	var plan *Plan
+	if p, found := keeper.GetUpgradePlan(ctx); found {
+		plan = &p
+	}
+
+	return NewGenesisState(keeper.GetHaltHeight(ctx), plan)
+}
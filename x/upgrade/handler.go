@@ -0,0 +1,30 @@
+package upgrade
+
+import (
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewUpgradeProposalHandler creates a governance handler to manage halt and software upgrade proposals
+func NewUpgradeProposalHandler(k Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) error {
+		switch c := content.(type) {
+		case HaltProposal:
+			return k.ScheduleHalt(ctx, c.Height)
+
+		case SoftwareUpgradeProposal:
+			return k.ScheduleUpgrade(ctx, c.Plan)
+
+		case CancelSoftwareUpgradeProposal:
+			if _, found := k.GetUpgradePlan(ctx); !found {
+				return ErrNoUpgradePlan
+			}
+			k.ClearUpgradePlan(ctx)
+			return nil
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized upgrade proposal content type: %T", c)
+		}
+	}
+}
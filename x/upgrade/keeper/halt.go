@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"os"
+	"syscall"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// HaltExitCode the exit code if the node cannot be stopped by signals
+const HaltExitCode = 3
+
+// MarkHalt marks the node to halt after current block committed
+func (k Keeper) MarkHalt(ctx sdk.Context, height int64) {
+	k.Logger(ctx).Error("chain halt height reached by governance, the node will halt after the block committed",
+		"height", height)
+	k.halt.height = height
+}
+
+// HaltNode stops the node for the halt height reached, same as the halt by
+// --halt-height, it sends SIGINT to shutdown the node gracefully, so the
+// node exits with the code 130 (128 + SIGINT).
+func HaltNode(logger log.Logger, height int64) {
+	logger.Error("halting node per governance halt proposal, restart the node to continue after maintenance",
+		"height", height)
+
+	p, err := os.FindProcess(os.Getpid())
+	if err == nil {
+		// attempt cascading signals in case SIGINT fails (os dependent)
+		sigIntErr := p.Signal(syscall.SIGINT)
+		sigTermErr := p.Signal(syscall.SIGTERM)
+
+		if sigIntErr == nil || sigTermErr == nil {
+			return
+		}
+	}
+
+	// Resort to exiting immediately if the process could not be found or killed
+	// via SIGINT/SIGTERM signals.
+	logger.Error("failed to send SIGINT/SIGTERM, exiting", "code", HaltExitCode)
+	os.Exit(HaltExitCode)
+}
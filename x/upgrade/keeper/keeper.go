@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/KuChainNetwork/kuchain/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// haltState the halt height reached in the block, which is not in store
+// as the node should halt after the block committed.
+type haltState struct {
+	height int64
+}
+
+// UpgradeHandler the handler to migrate the state for an upgrade plan
+type UpgradeHandler func(ctx sdk.Context, plan types.Plan)
+
+// Keeper of the upgrade store
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+
+	// homePath the home of node, the upgrade info will be written to its data dir
+	homePath           string
+	skipUpgradeHeights map[int64]bool
+	upgradeHandlers    map[string]UpgradeHandler
+
+	halt *haltState
+}
+
+// NewKeeper creates a new upgrade Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, skipUpgradeHeights map[int64]bool, homePath string) Keeper {
+	return Keeper{
+		cdc:                cdc,
+		storeKey:           key,
+		homePath:           homePath,
+		skipUpgradeHeights: skipUpgradeHeights,
+		upgradeHandlers:    make(map[string]UpgradeHandler),
+		halt:               &haltState{},
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetHaltHeight returns the halt height scheduled, 0 if no halt scheduled
+func (k Keeper) GetHaltHeight(ctx sdk.Context) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.HaltHeightKey)
+	if bz == nil {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// SetHaltHeight sets the halt height, 0 to clear the halt scheduled
+func (k Keeper) SetHaltHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	if height == 0 {
+		store.Delete(types.HaltHeightKey)
+		return
+	}
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	store.Set(types.HaltHeightKey, bz)
+}
+
+// ScheduleHalt schedules all nodes to halt after the block of height committed
+func (k Keeper) ScheduleHalt(ctx sdk.Context, height int64) error {
+	if height <= ctx.BlockHeight() {
+		return sdkerrors.Wrapf(types.ErrInvalidHaltHeight,
+			"halt height %d should be greater than current height %d", height, ctx.BlockHeight())
+	}
+
+	k.Logger(ctx).Info("schedule chain halt", "height", height)
+	k.SetHaltHeight(ctx, height)
+
+	return nil
+}
+
+// HaltPending returns the height if the node should halt after current block committed
+func (k Keeper) HaltPending() (int64, bool) {
+	return k.halt.height, k.halt.height > 0
+}
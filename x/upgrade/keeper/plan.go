@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/KuChainNetwork/kuchain/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetUpgradeHandler sets an UpgradeHandler for the upgrade specified by name, the handler
+// is called when the upgrade plan of the name reached, it should be set by the new binary.
+func (k Keeper) SetUpgradeHandler(name string, upgradeHandler UpgradeHandler) {
+	k.upgradeHandlers[name] = upgradeHandler
+}
+
+// HasHandler returns true if the upgrade handler of the name is set
+func (k Keeper) HasHandler(name string) bool {
+	_, ok := k.upgradeHandlers[name]
+	return ok
+}
+
+// IsSkipHeight returns true if the upgrade at the height should be skipped
+func (k Keeper) IsSkipHeight(height int64) bool {
+	return k.skipUpgradeHeights[height]
+}
+
+// ScheduleUpgrade schedules an upgrade based on the specified plan,
+// if there is another plan already scheduled, it will overwrite it.
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if plan.Height <= ctx.BlockHeight() {
+		return sdkerrors.Wrapf(types.ErrInvalidUpgradePlan,
+			"upgrade height %d should be greater than current height %d", plan.Height, ctx.BlockHeight())
+	}
+
+	if k.GetDoneHeight(ctx, plan.Name) != 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidUpgradePlan, "upgrade with name %s has already been completed", plan.Name)
+	}
+
+	k.Logger(ctx).Info("schedule upgrade", "name", plan.Name, "height", plan.Height)
+	ctx.KVStore(k.storeKey).Set(types.PlanKey, k.cdc.MustMarshalBinaryBare(plan))
+
+	return nil
+}
+
+// GetUpgradePlan returns the currently scheduled Plan if any
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PlanKey)
+	if bz == nil {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &plan)
+	return plan, true
+}
+
+// ClearUpgradePlan clears any schedule upgrade
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete(types.PlanKey)
+}
+
+// GetDoneHeight returns the height at which the given upgrade was executed, 0 if not applied
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.DoneKey(name))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// setDone marks the upgrade applied at the height
+func (k Keeper) setDone(ctx sdk.Context, name string, height int64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	ctx.KVStore(k.storeKey).Set(types.DoneKey(name), bz)
+}
+
+// ApplyUpgrade will execute the handler associated with the Plan and mark the plan as done.
+func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
+	handler, ok := k.upgradeHandlers[plan.Name]
+	if !ok {
+		panic("ApplyUpgrade should never be called without first checking HasHandler")
+	}
+
+	handler(ctx, plan)
+
+	k.ClearUpgradePlan(ctx)
+	k.setDone(ctx, plan.Name, ctx.BlockHeight())
+}
+
+// UpgradeInfoPath returns the path of upgrade-info.json in the data dir of home
+func (k Keeper) UpgradeInfoPath() string {
+	return filepath.Join(k.homePath, "data", types.UpgradeInfoFileName)
+}
+
+// DumpUpgradeInfoToDisk writes the upgrade info to upgrade-info.json, so that
+// the cosmovisor-style supervisors can swap the binary by it.
+func (k Keeper) DumpUpgradeInfoToDisk(plan types.Plan) error {
+	path := k.UpgradeInfoPath()
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+
+	info, err := json.Marshal(types.UpgradeInfo{
+		Name:   plan.Name,
+		Height: plan.Height,
+		Info:   plan.Info,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, info, 0600)
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+var (
+	// ModuleCdc references the global x/upgrade module codec, it is only used for JSON encoding.
+	ModuleCdc = codec.New()
+)
+
+func Cdc() *codec.Codec {
+	return ModuleCdc
+}
+
+// RegisterCodec registers concrete types on the codec.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(HaltProposal{}, "kuchain/HaltProposal", nil)
+	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "kuchain/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(CancelSoftwareUpgradeProposal{}, "kuchain/CancelSoftwareUpgradeProposal", nil)
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/upgrade module sentinel errors
+var (
+	ErrInvalidHaltHeight  = sdkerrors.Register(ModuleName, 2, "invalid halt height")
+	ErrInvalidUpgradePlan = sdkerrors.Register(ModuleName, 3, "invalid upgrade plan")
+	ErrNoUpgradePlan      = sdkerrors.Register(ModuleName, 4, "no upgrade plan found")
+)
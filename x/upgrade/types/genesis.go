@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GenesisState - all upgrade state that must be provided at genesis
+type GenesisState struct {
+	HaltHeight int64 `json:"halt_height" yaml:"halt_height"`
+	Plan       *Plan `json:"plan,omitempty" yaml:"plan"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(haltHeight int64, plan *Plan) GenesisState {
+	return GenesisState{
+		HaltHeight: haltHeight,
+		Plan:       plan,
+	}
+}
+
+// DefaultGenesisState - default GenesisState, no halt and upgrade scheduled
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(0, nil)
+}
+
+// ValidateGenesis performs basic validation of upgrade genesis data returning an
+// error for any failed validation criteria.
+func (g GenesisState) ValidateGenesis(bz json.RawMessage) error {
+	gs := DefaultGenesisState()
+	if err := Cdc().UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+
+	return ValidateGenesis(gs)
+}
+
+// ValidateGenesis validates the upgrade genesis state
+func ValidateGenesis(data GenesisState) error {
+	if data.HaltHeight < 0 {
+		return fmt.Errorf("halt height should not be negative: %d", data.HaltHeight)
+	}
+
+	if data.Plan != nil {
+		return data.Plan.ValidateBasic()
+	}
+
+	return nil
+}
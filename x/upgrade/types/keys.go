@@ -0,0 +1,39 @@
+package types
+
+const (
+	// ModuleName is the name of the upgrade module
+	ModuleName = "upgrade"
+
+	// StoreKey is the store key string for upgrade
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for upgrade
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the upgrade module
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the upgrade querier
+	QueryHalt    = "halt"
+	QueryPlan    = "plan"
+	QueryApplied = "applied"
+
+	// UpgradeInfoFileName the file name of upgrade info, which is put in the data dir of home
+	UpgradeInfoFileName = "upgrade-info.json"
+)
+
+var (
+	// HaltHeightKey the key of the halt height scheduled by governance
+	HaltHeightKey = []byte{0x01}
+
+	// PlanKey the key of the upgrade plan scheduled by governance
+	PlanKey = []byte{0x02}
+
+	// DoneKeyPrefix the prefix of the upgrades applied, name -> height
+	DoneKeyPrefix = []byte{0x03}
+)
+
+// DoneKey returns the key of the upgrade applied by the name
+func DoneKey(name string) []byte {
+	return append(DoneKeyPrefix, []byte(name)...)
+}
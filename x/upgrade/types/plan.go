@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Plan specifies information about a planned upgrade and when it should occur
+type Plan struct {
+	// Name the name for the upgrade, the new binary should register an upgrade handler by it
+	Name string `json:"name" yaml:"name"`
+
+	// Height the height at which the upgrade must be performed
+	Height int64 `json:"height" yaml:"height"`
+
+	// Info any application specific upgrade info to be included on-chain
+	// such as a git commit or the binaries url, it is written to upgrade-info.json
+	Info string `json:"info,omitempty" yaml:"info"`
+}
+
+// NewPlan creates a new upgrade plan
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+// ValidateBasic does basic validation of a Plan
+func (p Plan) ValidateBasic() error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgradePlan, "name cannot be empty")
+	}
+
+	if p.Height <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidUpgradePlan, "height %d should be positive", p.Height)
+	}
+
+	return nil
+}
+
+// ShouldExecute returns true if the Plan is ready to execute given the current context
+func (p Plan) ShouldExecute(ctx sdk.Context) bool {
+	return p.Height > 0 && p.Height <= ctx.BlockHeight()
+}
+
+// DueAt is a string representation of when this plan is due to be executed
+func (p Plan) DueAt() string {
+	return fmt.Sprintf("height: %d", p.Height)
+}
+
+// String implements the Stringer interface.
+func (p Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan
+  Name:   %s
+  Height: %d
+  Info:   %s`, p.Name, p.Height, p.Info)
+}
+
+// UpgradeInfo the upgrade info written to upgrade-info.json for the supervisors
+// like cosmovisor, so that they can swap binaries automatically.
+type UpgradeInfo struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+	Info   string `json:"info"`
+}
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govTypes "github.com/KuChainNetwork/kuchain/x/gov/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ProposalTypeHalt defines the type for a HaltProposal
+	ProposalTypeHalt = "Halt"
+	// ProposalTypeSoftwareUpgrade defines the type for a SoftwareUpgradeProposal
+	ProposalTypeSoftwareUpgrade = "SoftwareUpgrade"
+	// ProposalTypeCancelSoftwareUpgrade defines the type for a CancelSoftwareUpgradeProposal
+	ProposalTypeCancelSoftwareUpgrade = "CancelSoftwareUpgrade"
+)
+
+// Assert proposals implements govtypes.Content at compile-time
+var (
+	_ govTypes.Content = HaltProposal{}
+	_ govTypes.Content = SoftwareUpgradeProposal{}
+	_ govTypes.Content = CancelSoftwareUpgradeProposal{}
+)
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeHalt)
+	govTypes.RegisterProposalTypeCodec(HaltProposal{}, "kuchain/HaltProposal")
+	govTypes.RegisterProposalType(ProposalTypeSoftwareUpgrade)
+	govTypes.RegisterProposalTypeCodec(SoftwareUpgradeProposal{}, "kuchain/SoftwareUpgradeProposal")
+	govTypes.RegisterProposalType(ProposalTypeCancelSoftwareUpgrade)
+	govTypes.RegisterProposalTypeCodec(CancelSoftwareUpgradeProposal{}, "kuchain/CancelSoftwareUpgradeProposal")
+}
+
+// HaltProposal halts all nodes at the same height for coordinated maintenance,
+// the nodes will stop after the block of the height committed.
+type HaltProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Height      int64  `json:"height" yaml:"height"`
+}
+
+// NewHaltProposal creates a new halt proposal.
+func NewHaltProposal(title, description string, height int64) HaltProposal {
+	return HaltProposal{title, description, height}
+}
+
+// GetTitle returns the title of a halt proposal.
+func (hp HaltProposal) GetTitle() string { return hp.Title }
+
+// GetDescription returns the description of a halt proposal.
+func (hp HaltProposal) GetDescription() string { return hp.Description }
+
+// ProposalRoute returns the routing key of a halt proposal.
+func (hp HaltProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a halt proposal.
+func (hp HaltProposal) ProposalType() string { return ProposalTypeHalt }
+
+// ValidateBasic runs basic stateless validity checks
+func (hp HaltProposal) ValidateBasic() error {
+	if err := govTypes.ValidateAbstract(hp); err != nil {
+		return err
+	}
+
+	if hp.Height <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidHaltHeight, "height %d should be positive", hp.Height)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (hp HaltProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Halt Proposal:
+  Title:       %s
+  Description: %s
+  Height:      %d
+`, hp.Title, hp.Description, hp.Height))
+	return b.String()
+}
+
+// SoftwareUpgradeProposal schedules an upgrade plan, the nodes will stop at the
+// height of plan until the new binary with the upgrade handler started.
+type SoftwareUpgradeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Plan        Plan   `json:"plan" yaml:"plan"`
+}
+
+// NewSoftwareUpgradeProposal creates a new software upgrade proposal.
+func NewSoftwareUpgradeProposal(title, description string, plan Plan) SoftwareUpgradeProposal {
+	return SoftwareUpgradeProposal{title, description, plan}
+}
+
+// GetTitle returns the title of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) GetTitle() string { return sup.Title }
+
+// GetDescription returns the description of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) GetDescription() string { return sup.Description }
+
+// ProposalRoute returns the routing key of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) ProposalType() string { return ProposalTypeSoftwareUpgrade }
+
+// ValidateBasic runs basic stateless validity checks
+func (sup SoftwareUpgradeProposal) ValidateBasic() error {
+	if err := govTypes.ValidateAbstract(sup); err != nil {
+		return err
+	}
+
+	return sup.Plan.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (sup SoftwareUpgradeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+  Plan:        %s
+`, sup.Title, sup.Description, sup.Plan))
+	return b.String()
+}
+
+// CancelSoftwareUpgradeProposal cancels the upgrade plan scheduled.
+type CancelSoftwareUpgradeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+}
+
+// NewCancelSoftwareUpgradeProposal creates a new cancel software upgrade proposal.
+func NewCancelSoftwareUpgradeProposal(title, description string) CancelSoftwareUpgradeProposal {
+	return CancelSoftwareUpgradeProposal{title, description}
+}
+
+// GetTitle returns the title of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) GetTitle() string { return csup.Title }
+
+// GetDescription returns the description of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) GetDescription() string { return csup.Description }
+
+// ProposalRoute returns the routing key of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) ProposalType() string {
+	return ProposalTypeCancelSoftwareUpgrade
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (csup CancelSoftwareUpgradeProposal) ValidateBasic() error {
+	return govTypes.ValidateAbstract(csup)
+}
+
+// String implements the Stringer interface.
+func (csup CancelSoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Cancel Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+`, csup.Title, csup.Description)
+}
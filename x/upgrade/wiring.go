@@ -0,0 +1,16 @@
+package upgrade
+
+import (
+	"github.com/KuChainNetwork/kuchain/chain/wiring"
+)
+
+// Inputs the node configurations the upgrade module depends on
+type Inputs struct {
+	SkipUpgradeHeights map[int64]bool
+	HomePath           string
+}
+
+// ProvideKeeper creates the upgrade keeper by the store key declared to the builder
+func ProvideKeeper(b *wiring.Builder, in Inputs) Keeper {
+	return NewKeeper(b.Codec(), b.KVStoreKey(StoreKey), in.SkipUpgradeHeights, in.HomePath)
+}